@@ -0,0 +1,59 @@
+package ante
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	circuittypes "github.com/okex/exchain/x/circuit/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+// CircuitKeeper defines the expected circuit keeper to check the msg types disabled
+type CircuitKeeper interface {
+	IsMsgDisabled(ctx sdk.Context, route, msgType string) bool
+}
+
+// CircuitBreakerDecorator rejects the txs containing the msgs whose routing is disabled by the circuit breaker.
+type CircuitBreakerDecorator struct {
+	circuitKeeper CircuitKeeper
+}
+
+// NewCircuitBreakerDecorator creates a new CircuitBreakerDecorator instance
+func NewCircuitBreakerDecorator(circuitKeeper CircuitKeeper) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitKeeper: circuitKeeper,
+	}
+}
+
+// AnteHandle checks whether any msg of tx(contains cosmos-tx and eth-tx) is disabled by its route, its route/type,
+// or by the contract creation type when it's an ethereum tx creating a contract.
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if cbd.circuitKeeper == nil || !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+		return next(ctx, tx, simulate)
+	}
+	pinAnte(ctx.AnteTracer(), "CircuitBreakerDecorator")
+
+	currentGasMeter := ctx.GasMeter()
+	infGasMeter := sdk.GetReusableInfiniteGasMeter()
+	ctx.SetGasMeter(infGasMeter)
+	err := cbd.checkMsgs(ctx, tx.GetMsgs())
+	ctx.SetGasMeter(currentGasMeter)
+	sdk.ReturnInfiniteGasMeter(infGasMeter)
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (cbd CircuitBreakerDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if cbd.circuitKeeper.IsMsgDisabled(ctx, msg.Route(), msg.Type()) {
+			return circuittypes.ErrMsgTypeDisabled(circuittypes.GetMsgType(msg.Route(), msg.Type()))
+		}
+		if ethTx, ok := msg.(*evmtypes.MsgEthereumTx); ok && ethTx.To() == nil &&
+			cbd.circuitKeeper.IsMsgDisabled(ctx, evmtypes.RouterKey, circuittypes.TypeEvmContractCreation) {
+			return circuittypes.ErrMsgTypeDisabled(circuittypes.MsgTypeEvmContractCreation)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package ante
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	authante "github.com/okex/exchain/libs/cosmos-sdk/x/auth/ante"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	feeabstypes "github.com/okex/exchain/x/feeabs/types"
+)
+
+// FeeAbsKeeper defines the expected feeabs keeper to convert the fees paid in the whitelisted denoms, and to get the
+// minimum gas prices set by governance
+type FeeAbsKeeper interface {
+	GetMinGasPrices(ctx sdk.Context, msgs []sdk.Msg) sdk.SysCoins
+	GetFeeDenom(ctx sdk.Context, denom string) (feeabstypes.FeeDenom, bool)
+	ConvertFee(ctx sdk.Context, fee sdk.SysCoin) (nativeFee sdk.SysCoin, rate sdk.Dec, priceSource string, err sdk.Error)
+	PayNativeFee(ctx sdk.Context, fee, nativeFee sdk.SysCoin) sdk.Error
+}
+
+// getAbstractedFee returns the fee of tx if it's paid in a single denom whitelisted by the feeabs module
+func getAbstractedFee(ctx sdk.Context, feeAbsKeeper FeeAbsKeeper, tx sdk.Tx) (sdk.SysCoin, bool) {
+	if feeAbsKeeper == nil || !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+		return sdk.SysCoin{}, false
+	}
+	feeTx, ok := tx.(authante.FeeTx)
+	if !ok {
+		return sdk.SysCoin{}, false
+	}
+	fees := feeTx.GetFee()
+	if len(fees) != 1 || fees[0].Denom == sdk.DefaultBondDenom {
+		return sdk.SysCoin{}, false
+	}
+	if _, found := feeAbsKeeper.GetFeeDenom(ctx, fees[0].Denom); !found {
+		return sdk.SysCoin{}, false
+	}
+	return fees[0], true
+}
+
+// FeeAbstractionMempoolFeeDecorator checks the fees paid in a whitelisted denom against the minimum gas prices of
+// the validator by their value in the native token. The fees paid in the other denoms are checked by the
+// MempoolFeeDecorator of the auth module.
+type FeeAbstractionMempoolFeeDecorator struct {
+	feeAbsKeeper FeeAbsKeeper
+	mempoolFee   authante.MempoolFeeDecorator
+}
+
+// NewFeeAbstractionMempoolFeeDecorator creates a new FeeAbstractionMempoolFeeDecorator instance
+func NewFeeAbstractionMempoolFeeDecorator(feeAbsKeeper FeeAbsKeeper) FeeAbstractionMempoolFeeDecorator {
+	return FeeAbstractionMempoolFeeDecorator{
+		feeAbsKeeper: feeAbsKeeper,
+		mempoolFee:   authante.NewMempoolFeeDecorator(),
+	}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface
+func (fmfd FeeAbstractionMempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	fee, ok := getAbstractedFee(ctx, fmfd.feeAbsKeeper, tx)
+	if !ok {
+		return fmfd.mempoolFee.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if ctx.IsCheckTx() && !simulate {
+		minGasPrices := ctx.MinGasPrices()
+		if !minGasPrices.IsZero() {
+			nativeFee, _, _, err := fmfd.feeAbsKeeper.ConvertFee(ctx, fee)
+			if err != nil {
+				return ctx, err
+			}
+
+			// the fee meets the minimum gas prices either in its own denom or by its value in the native token
+			requiredFees := make(sdk.Coins, len(minGasPrices))
+			glDec := sdk.NewDec(int64(tx.(authante.FeeTx).GetGas()))
+			for i, gp := range minGasPrices {
+				requiredFees[i] = sdk.NewDecCoinFromDec(gp.Denom, gp.Amount.Mul(glDec))
+			}
+			if !sdk.NewCoins(fee).IsAnyGTE(requiredFees) && !sdk.NewCoins(nativeFee).IsAnyGTE(requiredFees) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee,
+					"insufficient fees; got: %s (%s) required: %s", fee, nativeFee, requiredFees)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// FeeAbstractionDeductFeeDecorator converts the fees paid in a whitelisted denom to the native token at the price
+// got from the oracle or the ammswap pool. The fee collector receives the converted fees in the native token from the
+// reserve of the feeabs module, and the fees deducted from the payer by the DeductFeeDecorator of the auth module are
+// moved to the reserve at the end of the block.
+type FeeAbstractionDeductFeeDecorator struct {
+	feeAbsKeeper FeeAbsKeeper
+	deductFee    authante.DeductFeeDecorator
+}
+
+// NewFeeAbstractionDeductFeeDecorator creates a new FeeAbstractionDeductFeeDecorator instance
+func NewFeeAbstractionDeductFeeDecorator(ak auth.AccountKeeper, sk types.SupplyKeeper, feeAbsKeeper FeeAbsKeeper) FeeAbstractionDeductFeeDecorator {
+	return FeeAbstractionDeductFeeDecorator{
+		feeAbsKeeper: feeAbsKeeper,
+		deductFee:    authante.NewDeductFeeDecorator(ak, sk),
+	}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface
+func (fdfd FeeAbstractionDeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	fee, ok := getAbstractedFee(ctx, fdfd.feeAbsKeeper, tx)
+	if !ok {
+		return fdfd.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+	pinAnte(ctx.AnteTracer(), "FeeAbstractionDeductFeeDecorator")
+
+	// the fees can't be paid in a denom whose price is unavailable, e.g. the pool has been drained
+	nativeFee, rate, priceSource, err := fdfd.feeAbsKeeper.ConvertFee(ctx, fee)
+	if err != nil {
+		return ctx, err
+	}
+	if err := fdfd.feeAbsKeeper.PayNativeFee(ctx, fee, nativeFee); err != nil {
+		return ctx, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		feeabstypes.EventTypeFeeConversion,
+		sdk.NewAttribute(feeabstypes.AttributeKeyFeePayer, tx.(authante.FeeTx).FeePayer(ctx).String()),
+		sdk.NewAttribute(feeabstypes.AttributeKeyFee, fee.String()),
+		sdk.NewAttribute(feeabstypes.AttributeKeyNativeFee, nativeFee.String()),
+		sdk.NewAttribute(feeabstypes.AttributeKeyRate, rate.String()),
+		sdk.NewAttribute(feeabstypes.AttributeKeyPriceSource, priceSource),
+	))
+
+	return fdfd.deductFee.AnteHandle(ctx, tx, simulate, next)
+}
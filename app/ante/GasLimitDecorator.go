@@ -12,6 +12,7 @@ type EVMKeeper interface {
 	innertx.InnerTxKeeper
 	GetParams(ctx sdk.Context) evmtypes.Params
 	IsAddressBlocked(ctx sdk.Context, addr sdk.AccAddress) bool
+	ValidateUserOps(ctx sdk.Context, msg evmtypes.MsgHandleUserOps) error
 }
 
 // NewGasLimitDecorator creates a new GasLimitDecorator.
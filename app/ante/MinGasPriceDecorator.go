@@ -0,0 +1,75 @@
+package ante
+
+import (
+	"math/big"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	authante "github.com/okex/exchain/libs/cosmos-sdk/x/auth/ante"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+// MinGasPriceDecorator checks the fees of tx against the minimum gas prices set by governance. Unlike the minimum
+// gas prices configured by the node operators, it's enforced in both CheckTx and DeliverTx so that all the nodes
+// admit the same txs. When the minimum gas prices are set by governance, the local ones are ignored.
+type MinGasPriceDecorator struct {
+	feeAbsKeeper FeeAbsKeeper
+}
+
+// NewMinGasPriceDecorator creates a new MinGasPriceDecorator instance
+func NewMinGasPriceDecorator(feeAbsKeeper FeeAbsKeeper) MinGasPriceDecorator {
+	return MinGasPriceDecorator{
+		feeAbsKeeper: feeAbsKeeper,
+	}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface
+func (mgpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// the fees are unknown when simulating
+	if mgpd.feeAbsKeeper == nil || simulate || !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+		return next(ctx, tx, simulate)
+	}
+
+	minGasPrices := mgpd.feeAbsKeeper.GetMinGasPrices(ctx, tx.GetMsgs())
+	if minGasPrices.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+	pinAnte(ctx.AnteTracer(), "MinGasPriceDecorator")
+
+	var (
+		fees sdk.Coins
+		gas  uint64
+	)
+	switch feeTx := tx.(type) {
+	case *evmtypes.MsgEthereumTx:
+		fees = sdk.NewCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom,
+			sdk.NewDecWithBigIntAndPrec(feeTx.CalcFee(new(big.Int)), sdk.Precision)))
+		gas = feeTx.Data.GasLimit
+	case authante.FeeTx:
+		fees, gas = feeTx.GetFee(), feeTx.GetGas()
+		// the fee paid in a whitelisted denom meets the minimum gas prices by its value in the native token as well
+		if fee, ok := getAbstractedFee(ctx, mgpd.feeAbsKeeper, tx); ok {
+			nativeFee, _, _, err := mgpd.feeAbsKeeper.ConvertFee(ctx, fee)
+			if err != nil {
+				return ctx, err
+			}
+			fees = fees.Add(nativeFee)
+		}
+	default:
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid transaction type: %T", tx)
+	}
+
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+	glDec := sdk.NewDec(int64(gas))
+	for i, gp := range minGasPrices {
+		requiredFees[i] = sdk.NewDecCoinFromDec(gp.Denom, gp.Amount.Mul(glDec))
+	}
+	if !fees.IsAnyGTE(requiredFees) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fees, requiredFees)
+	}
+
+	// the minimum gas prices set by governance replace the local ones in the mempool fee decorators
+	ctx.SetMinGasPrices(sdk.DecCoins{})
+	return next(ctx, tx, simulate)
+}
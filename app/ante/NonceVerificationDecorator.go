@@ -87,13 +87,7 @@ func (nvd NonceVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 					// to add pending txs len in the mempool.
 					// but, if disable recheck, we will not increase sequence of checkState (even in force recheck case, we
 					// will also reset checkState), so we will need to add pending txs len to get the right nonce
-					gPool := baseapp.GetGlobalMempool()
-					if gPool != nil {
-						addr := evmtypes.EthAddressStringer(common.BytesToAddress(msgEthTx.AccountAddress().Bytes())).String()
-						if pendingNonce, ok := gPool.GetPendingNonce(addr); ok {
-							checkTxModeNonce = pendingNonce + 1
-						}
-					}
+					checkTxModeNonce = pendingNonce(address, seq)
 				}
 
 				if baseapp.IsMempoolEnableSort() {
@@ -0,0 +1,68 @@
+package ante
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/baseapp"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+)
+
+// PendingNonceDecorator raises the sequences of the signers of a cosmos tx in the check state to the nonces following
+// their txs pending in the mempool. The evm txs don't increase the sequence of the check state unless the mempool
+// rechecks, and the check state is reset to the committed state after a block, so a cosmos tx following the pending
+// txs of its signer is signed with a sequence ahead of the check state.
+//
+// CONTRACT: must be called before the signature verification, which signs with the sequence of the check state.
+type PendingNonceDecorator struct {
+	ak auth.AccountKeeper
+}
+
+// NewPendingNonceDecorator creates a new PendingNonceDecorator
+func NewPendingNonceDecorator(ak auth.AccountKeeper) PendingNonceDecorator {
+	return PendingNonceDecorator{
+		ak: ak,
+	}
+}
+
+// AnteHandle raises the sequences of the signers in checkTx mode, the recheckTx and deliverTx modes use the sequences
+// of their states as they are.
+func (pnd PendingNonceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if simulate || !ctx.IsCheckTx() || ctx.IsReCheckTx() || baseapp.IsMempoolEnableRecheck() {
+		return next(ctx, tx, simulate)
+	}
+	pinAnte(ctx.AnteTracer(), "PendingNonceDecorator")
+
+	// get and set account must be called with an infinite gas meter in order to prevent
+	// additional gas from being deducted.
+	gasMeter := ctx.GasMeter()
+	ctx.SetGasMeter(sdk.NewInfiniteGasMeter())
+	for _, addr := range tx.GetSigners() {
+		acc := pnd.ak.GetAccount(ctx, addr)
+		if acc == nil {
+			continue
+		}
+		seq := acc.GetSequence()
+		if nonce := pendingNonce(addr, seq); nonce > seq {
+			if err := acc.SetSequence(nonce); err != nil {
+				panic(err)
+			}
+			pnd.ak.SetAccount(ctx, acc)
+		}
+	}
+	ctx.SetGasMeter(gasMeter)
+
+	return next(ctx, tx, simulate)
+}
+
+// pendingNonce returns the nonce following the txs of the account pending in the mempool, or the sequence of the
+// account if it's ahead of them. The cosmos and evm txs of an account share the nonce, the mempool keys both by the
+// checksummed hex address of the account.
+func pendingNonce(addr sdk.AccAddress, seq uint64) uint64 {
+	gPool := baseapp.GetGlobalMempool()
+	if gPool == nil {
+		return seq
+	}
+	if nonce, ok := gPool.GetPendingNonce(addr.HexString()); ok && nonce+1 > seq {
+		return nonce + 1
+	}
+	return seq
+}
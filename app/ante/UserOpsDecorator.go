@@ -0,0 +1,49 @@
+package ante
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+// UserOpsDecorator checks the user operations of the contract accounts in checkTx mode, so that the ones with
+// wrong senders, nonces or insufficient prefunds are rejected before they're included in a block.
+type UserOpsDecorator struct {
+	evmKeeper EVMKeeper
+}
+
+// NewUserOpsDecorator creates a new UserOpsDecorator instance
+func NewUserOpsDecorator(evmKeeper EVMKeeper) UserOpsDecorator {
+	return UserOpsDecorator{
+		evmKeeper: evmKeeper,
+	}
+}
+
+func (uod UserOpsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+	pinAnte(ctx.AnteTracer(), "UserOpsDecorator")
+
+	currentGasMeter := ctx.GasMeter()
+	infGasMeter := sdk.GetReusableInfiniteGasMeter()
+	ctx.SetGasMeter(infGasMeter)
+	err := uod.validateUserOps(ctx, tx)
+	ctx.SetGasMeter(currentGasMeter)
+	sdk.ReturnInfiniteGasMeter(infGasMeter)
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (uod UserOpsDecorator) validateUserOps(ctx sdk.Context, tx sdk.Tx) error {
+	for _, msg := range tx.GetMsgs() {
+		if userOpsMsg, ok := msg.(evmtypes.MsgHandleUserOps); ok {
+			if err := uod.evmKeeper.ValidateUserOps(ctx, userOpsMsg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -88,13 +88,7 @@ func nonceVerificationInCheckTx(ctx sdk.Context, seq uint64, msgEthTx *evmtypes.
 				// to add pending txs len in the mempool.
 				// but, if disable recheck, we will not increase sequence of checkState (even in force recheck case, we
 				// will also reset checkState), so we will need to add pending txs len to get the right nonce
-				gPool := baseapp.GetGlobalMempool()
-				if gPool != nil {
-					addr := msgEthTx.GetSender(ctx)
-					if pendingNonce, ok := gPool.GetPendingNonce(addr); ok {
-						checkTxModeNonce = pendingNonce + 1
-					}
-				}
+				checkTxModeNonce = pendingNonce(msgEthTx.AccountAddress(), seq)
 			}
 
 			if baseapp.IsMempoolEnableSort() {
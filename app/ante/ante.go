@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"fmt"
+
 	"github.com/okex/exchain/app/crypto/ethsecp256k1"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
@@ -28,38 +30,52 @@ const (
 // NewAnteHandler returns an ante handler responsible for attempting to route an
 // Ethereum or SDK transaction to an internal ante handler for performing
 // transaction-level processing (e.g. fee payment, signature verification) before
-// being passed onto it's respective handler.
-func NewAnteHandler(ak auth.AccountKeeper, evmKeeper EVMKeeper, sk types.SupplyKeeper, validateMsgHandler ValidateMsgHandler, option wasmkeeper.HandlerOption, ibcChannelKeepr *ibc.Keeper) sdk.AnteHandler {
-	var stdTxAnteHandler, evmTxAnteHandler sdk.AnteHandler
-
-	stdTxAnteHandler = sdk.ChainAnteDecorators(
-		authante.NewSetUpContextDecorator(),                                             // outermost AnteDecorator. SetUpContext must be called first
-		wasmkeeper.NewLimitSimulationGasDecorator(option.WasmConfig.SimulationGasLimit), // after setup context to enforce limits early
-		wasmkeeper.NewCountTXDecorator(option.TXCounterStoreKey),
-		NewAccountBlockedVerificationDecorator(evmKeeper), //account blocked check AnteDecorator
-		authante.NewMempoolFeeDecorator(),
-		authante.NewValidateBasicDecorator(),
-		authante.NewValidateMemoDecorator(ak),
-		authante.NewConsumeGasForTxSizeDecorator(ak),
-		authante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
-		authante.NewValidateSigCountDecorator(ak),
-		authante.NewDeductFeeDecorator(ak, sk),
-		authante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
-		authante.NewSigVerificationDecorator(ak),
-		authante.NewIncrementSequenceDecorator(ak), // innermost AnteDecorator
-		NewValidateMsgHandlerDecorator(validateMsgHandler),
-		ibcante.NewAnteDecorator(ibcChannelKeepr),
-	)
-
-	evmTxAnteHandler = sdk.ChainAnteDecorators(
-		NewEthSetupContextDecorator(), // outermost AnteDecorator. EthSetUpContext must be called first
-		NewGasLimitDecorator(evmKeeper),
-		NewEthMempoolFeeDecorator(evmKeeper),
-		authante.NewValidateBasicDecorator(),
-		NewEthSigVerificationDecorator(),
-		NewAccountBlockedVerificationDecorator(evmKeeper), //account blocked check AnteDecorator
-		NewAccountAnteDecorator(ak, evmKeeper, sk),
-	)
+// being passed onto it's respective handler. The chain options are applied in order
+// to customize the default ante chains, it panics if any option fails.
+func NewAnteHandler(ak auth.AccountKeeper, evmKeeper EVMKeeper, sk types.SupplyKeeper, validateMsgHandler ValidateMsgHandler, option wasmkeeper.HandlerOption, ibcChannelKeepr *ibc.Keeper, circuitKeeper CircuitKeeper, feeAbsKeeper FeeAbsKeeper, chainOptions ...ChainOption) sdk.AnteHandler {
+	chains := AnteChains{
+		StdTx: DecoratorChain{
+			NewNamedDecorator(DecoratorSetupContext, authante.NewSetUpContextDecorator()),                                                   // outermost AnteDecorator. SetUpContext must be called first
+			NewNamedDecorator(DecoratorLimitSimulationGas, wasmkeeper.NewLimitSimulationGasDecorator(option.WasmConfig.SimulationGasLimit)), // after setup context to enforce limits early
+			NewNamedDecorator(DecoratorCountTX, wasmkeeper.NewCountTXDecorator(option.TXCounterStoreKey)),
+			NewNamedDecorator(DecoratorAccountBlocked, NewAccountBlockedVerificationDecorator(evmKeeper)), //account blocked check AnteDecorator
+			NewNamedDecorator(DecoratorCircuitBreaker, NewCircuitBreakerDecorator(circuitKeeper)),         // disabled msg types check AnteDecorator
+			NewNamedDecorator(DecoratorMinGasPrice, NewMinGasPriceDecorator(feeAbsKeeper)),                // min gas prices set by governance check AnteDecorator
+			NewNamedDecorator(DecoratorMempoolFee, NewFeeAbstractionMempoolFeeDecorator(feeAbsKeeper)),    // fees paid in the whitelisted denoms are checked by their native value
+			NewNamedDecorator(DecoratorValidateBasic, authante.NewValidateBasicDecorator()),
+			NewNamedDecorator(DecoratorValidateTxSize, authante.NewValidateTxSizeDecorator(ak)),
+			NewNamedDecorator(DecoratorValidateMemo, authante.NewValidateMemoDecorator(ak)),
+			NewNamedDecorator(DecoratorConsumeGasForTxSize, authante.NewConsumeGasForTxSizeDecorator(ak)),
+			NewNamedDecorator(DecoratorSetPubKey, authante.NewSetPubKeyDecorator(ak)), // SetPubKeyDecorator must be called before all signature verification decorators
+			NewNamedDecorator(DecoratorValidateSigCount, authante.NewValidateSigCountDecorator(ak)),
+			NewNamedDecorator(DecoratorDeductFee, NewFeeAbstractionDeductFeeDecorator(ak, sk, feeAbsKeeper)),
+			NewNamedDecorator(DecoratorSigGasConsume, authante.NewSigGasConsumeDecorator(ak, sigGasConsumer)),
+			NewNamedDecorator(DecoratorPendingNonce, NewPendingNonceDecorator(ak)), // raises the sequences over the pending txs before the signatures are verified
+			NewNamedDecorator(DecoratorSigVerification, authante.NewSigVerificationDecorator(ak)),
+			NewNamedDecorator(DecoratorIncrementSequence, authante.NewIncrementSequenceDecorator(ak)), // innermost AnteDecorator
+			NewNamedDecorator(DecoratorValidateMsgHandler, NewValidateMsgHandlerDecorator(validateMsgHandler)),
+			NewNamedDecorator(DecoratorUserOps, NewUserOpsDecorator(evmKeeper)),
+			NewNamedDecorator(DecoratorIBC, ibcante.NewAnteDecorator(ibcChannelKeepr)),
+		},
+		EvmTx: DecoratorChain{
+			NewNamedDecorator(DecoratorEthSetupContext, NewEthSetupContextDecorator()), // outermost AnteDecorator. EthSetUpContext must be called first
+			NewNamedDecorator(DecoratorCircuitBreaker, NewCircuitBreakerDecorator(circuitKeeper)),
+			NewNamedDecorator(DecoratorGasLimit, NewGasLimitDecorator(evmKeeper)),
+			NewNamedDecorator(DecoratorMinGasPrice, NewMinGasPriceDecorator(feeAbsKeeper)),
+			NewNamedDecorator(DecoratorEthMempoolFee, NewEthMempoolFeeDecorator(evmKeeper)),
+			NewNamedDecorator(DecoratorValidateBasic, authante.NewValidateBasicDecorator()),
+			NewNamedDecorator(DecoratorValidateTxSize, authante.NewValidateTxSizeDecorator(ak)),
+			NewNamedDecorator(DecoratorEthSigVerification, NewEthSigVerificationDecorator()),
+			NewNamedDecorator(DecoratorAccountBlocked, NewAccountBlockedVerificationDecorator(evmKeeper)), //account blocked check AnteDecorator
+			NewNamedDecorator(DecoratorAccount, NewAccountAnteDecorator(ak, evmKeeper, sk)),
+		},
+	}
+	for _, chainOption := range chainOptions {
+		if err := chainOption(&chains); err != nil {
+			panic(fmt.Sprintf("failed to configure the ante chains: %s", err))
+		}
+	}
+	stdTxAnteHandler, evmTxAnteHandler := chains.StdTx.AnteHandler(), chains.EvmTx.AnteHandler()
 
 	return func(
 		ctx sdk.Context, tx sdk.Tx, sim bool,
@@ -1,6 +1,7 @@
 package ante_test
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
@@ -11,12 +12,20 @@ import (
 	ethcmn "github.com/ethereum/go-ethereum/common"
 
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	tmcfg "github.com/okex/exchain/libs/tendermint/config"
 	tmcrypto "github.com/okex/exchain/libs/tendermint/crypto"
+	"github.com/okex/exchain/libs/tendermint/mempool"
+	"github.com/okex/exchain/libs/tendermint/proxy"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
 
+	"github.com/okex/exchain/libs/cosmos-sdk/baseapp"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/bank"
 
 	"github.com/okex/exchain/app"
 	"github.com/okex/exchain/app/ante"
+	appconfig "github.com/okex/exchain/app/config"
 	"github.com/okex/exchain/app/types"
 	evmtypes "github.com/okex/exchain/x/evm/types"
 )
@@ -258,7 +267,7 @@ func (suite *AnteTestSuite) TestEthInvalidMempoolFees() {
 	suite.ctx = suite.app.BaseApp.NewContext(true, abci.Header{Height: 1, ChainID: "ethermint-3", Time: time.Now().UTC()})
 	suite.app.EvmKeeper.SetParams(suite.ctx, evmtypes.DefaultParams())
 
-	suite.anteHandler = ante.NewAnteHandler(suite.app.AccountKeeper, suite.app.EvmKeeper, suite.app.SupplyKeeper, nil, suite.app.WasmHandler, suite.app.IBCKeeper)
+	suite.anteHandler = ante.NewAnteHandler(suite.app.AccountKeeper, suite.app.EvmKeeper, suite.app.SupplyKeeper, nil, suite.app.WasmHandler, suite.app.IBCKeeper, suite.app.CircuitKeeper, suite.app.FeeAbsKeeper)
 	suite.ctx.SetMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec(types.NativeToken, sdk.NewDecFromBigIntWithPrec(big.NewInt(500000), sdk.Precision))))
 	addr1, priv1 := newTestAddrKey()
 	addr2, _ := newTestAddrKey()
@@ -315,3 +324,90 @@ func (suite *AnteTestSuite) TestAnteHandlerSequences() {
 		})
 	}
 }
+
+func (suite *AnteTestSuite) TestSDKTxFollowingPendingTxs() {
+	chainID := "okexchain-3"
+	suite.app = app.Setup(false, app.WithChainId(chainID))
+	// the check state is reset to the block following the genesis
+	suite.app.Commit(abci.RequestCommit{})
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2, ChainID: chainID}})
+	suite.app.Commit(abci.RequestCommit{})
+	checkCtx := suite.app.BaseApp.NewContext(true, abci.Header{Height: 2, ChainID: chainID})
+	// the evm txs are decoded since venus
+	tmtypes.UnittestOnlySetMilestoneVenusHeight(-1)
+	defer tmtypes.UnittestOnlySetMilestoneVenusHeight(0)
+
+	addr1, priv1 := newTestAddrKey()
+	addr2, _ := newTestAddrKey()
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(checkCtx, addr1)
+	_ = acc1.SetCoins(newTestCoins())
+	suite.app.AccountKeeper.SetAccount(checkCtx, acc1)
+
+	// the txs are checked by the app into a mempool which doesn't recheck them, so the evm txs don't increase the
+	// sequence of the check state
+	appConn, err := proxy.NewLocalClientCreator(suite.app).NewABCIClient()
+	suite.Require().NoError(err)
+	suite.Require().NoError(appConn.Start())
+	defer appConn.Stop()
+	oecConfig := appconfig.GetOecConfig()
+	size, recheck := oecConfig.GetMempoolSize(), oecConfig.GetMempoolRecheck()
+	oecConfig.SetMempoolSize(100)
+	oecConfig.SetMempoolRecheck(false)
+	defer func() {
+		oecConfig.SetMempoolSize(size)
+		oecConfig.SetMempoolRecheck(recheck)
+	}()
+	mem := mempool.NewCListMempool(tmcfg.TestConfig().Mempool, appConn, 1)
+	mem.SetTxInfoParser(suite.app)
+	baseapp.SetGlobalMempool(mem, false, false)
+	defer baseapp.SetGlobalMempool(nil, false, false)
+
+	ethTxEncoder := authtypes.EthereumTxEncoder(suite.app.Codec())
+	sdkTxEncoder := authtypes.DefaultTxEncoder(suite.app.Codec())
+	checkTx := func(tx sdk.Tx, encoder sdk.TxEncoder) error {
+		txBytes, err := encoder(tx)
+		suite.Require().NoError(err)
+		var res *abci.ResponseCheckTx
+		if err := mem.CheckTx(txBytes, func(r *abci.Response) { res = r.GetCheckTx() }, mempool.TxInfo{}); err != nil {
+			return err
+		}
+		suite.Require().NotNil(res)
+		if !res.IsOK() {
+			return errors.New(res.Log)
+		}
+		return nil
+	}
+
+	// an evm tx with the nonce 0 is pending
+	to := ethcmn.BytesToAddress(addr2.Bytes())
+	ethMsg := evmtypes.NewMsgEthereumTx(0, &to, big.NewInt(32), 22000, big.NewInt(20), nil)
+	ethTx, err := newTestEthTx(checkCtx, ethMsg, priv1)
+	suite.Require().NoError(err)
+	suite.Require().NoError(checkTx(ethTx, ethTxEncoder))
+
+	fee := newTestStdFee()
+	msgs := []sdk.Msg{bank.NewMsgSend(addr1, addr2, sdk.NewCoins(types.NewPhotonCoinInt64(1)))}
+	privKeys := []tmcrypto.PrivKey{priv1}
+	accNums := []uint64{acc1.GetAccountNumber()}
+
+	// a cosmos tx replaying the nonce of the pending evm tx is rejected
+	tx := newTestSDKTx(checkCtx, msgs, privKeys, accNums, []uint64{0}, fee)
+	suite.Require().Error(checkTx(tx, sdkTxEncoder))
+
+	// a cosmos tx follows the pending evm tx
+	tx = newTestSDKTx(checkCtx, msgs, privKeys, accNums, []uint64{1}, fee)
+	suite.Require().NoError(checkTx(tx, sdkTxEncoder))
+
+	// an evm tx follows the pending cosmos tx
+	ethMsg = evmtypes.NewMsgEthereumTx(2, &to, big.NewInt(32), 22000, big.NewInt(20), nil)
+	ethTx, err = newTestEthTx(checkCtx, ethMsg, priv1)
+	suite.Require().NoError(err)
+	suite.Require().NoError(checkTx(ethTx, ethTxEncoder))
+
+	// the txs of both kinds are pending under the same address
+	suite.Require().Equal(3, mem.Size())
+	nonce, ok := mem.GetPendingNonce(ethcmn.BytesToAddress(addr1.Bytes()).String())
+	suite.Require().True(ok)
+	suite.Require().Equal(uint64(2), nonce)
+}
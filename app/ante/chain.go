@@ -0,0 +1,210 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/system/telemetry"
+)
+
+// names of the built-in decorators, which are the anchors to insert the custom decorators into the ante chains
+const (
+	DecoratorSetupContext        = "setup_context"
+	DecoratorLimitSimulationGas  = "limit_simulation_gas"
+	DecoratorCountTX             = "count_tx"
+	DecoratorAccountBlocked      = "account_blocked"
+	DecoratorCircuitBreaker      = "circuit_breaker"
+	DecoratorMinGasPrice         = "min_gas_price"
+	DecoratorMempoolFee          = "mempool_fee"
+	DecoratorValidateBasic       = "validate_basic"
+	DecoratorValidateTxSize      = "validate_tx_size"
+	DecoratorValidateMemo        = "validate_memo"
+	DecoratorConsumeGasForTxSize = "consume_gas_for_tx_size"
+	DecoratorSetPubKey           = "set_pub_key"
+	DecoratorValidateSigCount    = "validate_sig_count"
+	DecoratorDeductFee           = "deduct_fee"
+	DecoratorSigGasConsume       = "sig_gas_consume"
+	DecoratorPendingNonce        = "pending_nonce"
+	DecoratorSigVerification     = "sig_verification"
+	DecoratorIncrementSequence   = "increment_sequence"
+	DecoratorValidateMsgHandler  = "validate_msg_handler"
+	DecoratorUserOps             = "user_ops"
+	DecoratorIBC                 = "ibc"
+	DecoratorEthSetupContext     = "eth_setup_context"
+	DecoratorGasLimit            = "gas_limit"
+	DecoratorEthMempoolFee       = "eth_mempool_fee"
+	DecoratorEthSigVerification  = "eth_sig_verification"
+	DecoratorAccount             = "account"
+)
+
+// NamedDecorator is an AnteDecorator with the name it's referred to when the ante chains are configured
+type NamedDecorator struct {
+	Name      string
+	Decorator sdk.AnteDecorator
+}
+
+// NewNamedDecorator creates a new NamedDecorator instance
+func NewNamedDecorator(name string, decorator sdk.AnteDecorator) NamedDecorator {
+	return NamedDecorator{
+		Name:      name,
+		Decorator: decorator,
+	}
+}
+
+// DecoratorChain is an ordered chain of decorators, the outermost decorator comes first
+type DecoratorChain []NamedDecorator
+
+// InsertBefore inserts the decorators right before the decorator with the name
+func (dc DecoratorChain) InsertBefore(name string, decorators ...NamedDecorator) (DecoratorChain, error) {
+	i, err := dc.indexOf(name)
+	if err != nil {
+		return nil, err
+	}
+	if i == 0 {
+		return nil, fmt.Errorf("decorator %s is the outermost decorator, nothing can be inserted before it", name)
+	}
+	return dc.insert(i, decorators)
+}
+
+// InsertAfter inserts the decorators right after the decorator with the name
+func (dc DecoratorChain) InsertAfter(name string, decorators ...NamedDecorator) (DecoratorChain, error) {
+	i, err := dc.indexOf(name)
+	if err != nil {
+		return nil, err
+	}
+	return dc.insert(i+1, decorators)
+}
+
+// Append appends the decorators to the end of the chain as the innermost decorators
+func (dc DecoratorChain) Append(decorators ...NamedDecorator) (DecoratorChain, error) {
+	return dc.insert(len(dc), decorators)
+}
+
+// Replace replaces the decorator with the name, e.g. by a decorator wrapping it
+func (dc DecoratorChain) Replace(name string, decorator sdk.AnteDecorator) (DecoratorChain, error) {
+	i, err := dc.indexOf(name)
+	if err != nil {
+		return nil, err
+	}
+	chain := append(DecoratorChain{}, dc...)
+	chain[i] = NewNamedDecorator(name, decorator)
+	return chain, nil
+}
+
+// Remove removes the decorator with the name. The outermost decorator setting up the context can't be removed
+func (dc DecoratorChain) Remove(name string) (DecoratorChain, error) {
+	i, err := dc.indexOf(name)
+	if err != nil {
+		return nil, err
+	}
+	if i == 0 {
+		return nil, fmt.Errorf("decorator %s is the outermost decorator, it can't be removed", name)
+	}
+	chain := make(DecoratorChain, 0, len(dc)-1)
+	chain = append(chain, dc[:i]...)
+	return append(chain, dc[i+1:]...), nil
+}
+
+// Names returns the names of the decorators in order
+func (dc DecoratorChain) Names() []string {
+	names := make([]string, 0, len(dc))
+	for _, d := range dc {
+		names = append(names, d.Name)
+	}
+	return names
+}
+
+// AnteHandler chains the decorators into an ante handler
+func (dc DecoratorChain) AnteHandler() sdk.AnteHandler {
+	decorators := make([]sdk.AnteDecorator, 0, len(dc))
+	for _, d := range dc {
+		decorators = append(decorators, tracedDecorator{d})
+	}
+	return sdk.ChainAnteDecorators(decorators...)
+}
+
+// tracedDecorator wraps a decorator in a tracing span named after it. As the decorators call the next ones, the span
+// of a decorator contains the spans of the decorators after it.
+type tracedDecorator struct {
+	NamedDecorator
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface
+func (td tracedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !telemetry.Enabled() {
+		return td.Decorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	parent := ctx.Context()
+	span := ctx.StartSpan("ante." + td.Name)
+	newCtx, err := td.Decorator.AnteHandle(ctx, tx, simulate, next)
+	telemetry.EndSpan(span, err)
+	newCtx.SetContext(parent)
+	return newCtx, err
+}
+
+func (dc DecoratorChain) indexOf(name string) (int, error) {
+	for i, d := range dc {
+		if d.Name == name {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("decorator %s is not in the chain %v", name, dc.Names())
+}
+
+func (dc DecoratorChain) insert(i int, decorators []NamedDecorator) (DecoratorChain, error) {
+	for _, d := range decorators {
+		if len(d.Name) == 0 || d.Decorator == nil {
+			return nil, fmt.Errorf("decorator to insert should have a name and be non-nil")
+		}
+		if _, err := dc.indexOf(d.Name); err == nil {
+			return nil, fmt.Errorf("decorator %s is already in the chain", d.Name)
+		}
+	}
+	chain := make(DecoratorChain, 0, len(dc)+len(decorators))
+	chain = append(chain, dc[:i]...)
+	chain = append(chain, decorators...)
+	return append(chain, dc[i:]...), nil
+}
+
+// AnteChains is the ante chains of the std txs and the evm txs
+type AnteChains struct {
+	StdTx DecoratorChain
+	EvmTx DecoratorChain
+}
+
+// ChainOption customizes the ante chains before they are chained into the ante handler, e.g. to insert a fee
+// abstraction or a tx size limit decorator
+type ChainOption func(chains *AnteChains) error
+
+// InsertStdTxDecoratorsBefore returns an option inserting the decorators before a decorator of the std tx chain
+func InsertStdTxDecoratorsBefore(name string, decorators ...NamedDecorator) ChainOption {
+	return func(chains *AnteChains) (err error) {
+		chains.StdTx, err = chains.StdTx.InsertBefore(name, decorators...)
+		return
+	}
+}
+
+// InsertStdTxDecoratorsAfter returns an option inserting the decorators after a decorator of the std tx chain
+func InsertStdTxDecoratorsAfter(name string, decorators ...NamedDecorator) ChainOption {
+	return func(chains *AnteChains) (err error) {
+		chains.StdTx, err = chains.StdTx.InsertAfter(name, decorators...)
+		return
+	}
+}
+
+// InsertEvmTxDecoratorsBefore returns an option inserting the decorators before a decorator of the evm tx chain
+func InsertEvmTxDecoratorsBefore(name string, decorators ...NamedDecorator) ChainOption {
+	return func(chains *AnteChains) (err error) {
+		chains.EvmTx, err = chains.EvmTx.InsertBefore(name, decorators...)
+		return
+	}
+}
+
+// InsertEvmTxDecoratorsAfter returns an option inserting the decorators after a decorator of the evm tx chain
+func InsertEvmTxDecoratorsAfter(name string, decorators ...NamedDecorator) ChainOption {
+	return func(chains *AnteChains) (err error) {
+		chains.EvmTx, err = chains.EvmTx.InsertAfter(name, decorators...)
+		return
+	}
+}
@@ -0,0 +1,78 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	ante "github.com/okex/exchain/app/ante"
+)
+
+type recordDecorator struct {
+	name    string
+	records *[]string
+}
+
+func (rd recordDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*rd.records = append(*rd.records, rd.name)
+	return next(ctx, tx, simulate)
+}
+
+func newRecordDecorator(name string, records *[]string) ante.NamedDecorator {
+	return ante.NewNamedDecorator(name, recordDecorator{name: name, records: records})
+}
+
+func TestDecoratorChain(t *testing.T) {
+	var records []string
+	chain := ante.DecoratorChain{newRecordDecorator("a", &records), newRecordDecorator("b", &records)}
+
+	chain, err := chain.InsertBefore("b", newRecordDecorator("c", &records))
+	require.NoError(t, err)
+	chain, err = chain.InsertAfter("b", newRecordDecorator("d", &records))
+	require.NoError(t, err)
+	chain, err = chain.Append(newRecordDecorator("e", &records))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "c", "b", "d", "e"}, chain.Names())
+
+	chain, err = chain.Remove("d")
+	require.NoError(t, err)
+	chain, err = chain.Replace("e", recordDecorator{name: "f", records: &records})
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "c", "b", "e"}, chain.Names())
+
+	_, err = chain.AnteHandler()(sdk.Context{}, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "c", "b", "f"}, records)
+
+	// the outermost decorator stays first, the names are unique and the anchors must exist
+	_, err = chain.InsertBefore("a", newRecordDecorator("g", &records))
+	require.Error(t, err)
+	_, err = chain.Remove("a")
+	require.Error(t, err)
+	_, err = chain.InsertAfter("a", newRecordDecorator("b", &records))
+	require.Error(t, err)
+	_, err = chain.InsertAfter("x", newRecordDecorator("g", &records))
+	require.Error(t, err)
+	_, err = chain.Append(ante.NewNamedDecorator("g", nil))
+	require.Error(t, err)
+}
+
+func (suite *AnteTestSuite) TestChainOptions() {
+	var records []string
+	option := ante.InsertStdTxDecoratorsAfter(ante.DecoratorSetupContext, newRecordDecorator("std", &records))
+	anteHandler := ante.NewAnteHandler(suite.app.AccountKeeper, suite.app.EvmKeeper, suite.app.SupplyKeeper, nil,
+		suite.app.WasmHandler, suite.app.IBCKeeper, suite.app.CircuitKeeper, suite.app.FeeAbsKeeper, option)
+
+	// the tx without signatures fails after the custom decorator is run
+	addr, _ := newTestAddrKey()
+	tx := newTestSDKTx(suite.ctx, []sdk.Msg{newTestMsg(addr)}, nil, nil, nil, newTestStdFee())
+	requireInvalidTx(suite.T(), anteHandler, suite.ctx, tx, false)
+	suite.Require().Equal([]string{"std"}, records)
+
+	suite.Require().Panics(func() {
+		ante.NewAnteHandler(suite.app.AccountKeeper, suite.app.EvmKeeper, suite.app.SupplyKeeper, nil,
+			suite.app.WasmHandler, suite.app.IBCKeeper, suite.app.CircuitKeeper, suite.app.FeeAbsKeeper,
+			ante.InsertEvmTxDecoratorsAfter(ante.DecoratorValidateMemo, newRecordDecorator("evm", &records)))
+	})
+}
@@ -0,0 +1,66 @@
+package ante_test
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	feeabstypes "github.com/okex/exchain/x/feeabs/types"
+
+	ante "github.com/okex/exchain/app/ante"
+)
+
+func (suite *AnteTestSuite) TestMinGasPriceDecorator() {
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+	suite.ctx.SetBlockHeight(2)
+	suite.ctx.SetMinGasPrices(sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDec(1)))
+
+	var minGasPrices sdk.DecCoins
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewMinGasPriceDecorator(suite.app.FeeAbsKeeper),
+		minGasPricesRecorder{&minGasPrices},
+	)
+	addr, _ := newTestAddrKey()
+	// 150okt for 220000 gas
+	tx := newTestSDKTx(suite.ctx, []sdk.Msg{newTestMsg(addr)}, nil, nil, nil, newTestStdFee())
+	setMinGasPrices := func(global sdk.SysCoins, modules ...feeabstypes.ModuleMinGasPrices) {
+		params := feeabstypes.DefaultParams()
+		params.MinGasPrices, params.ModuleMinGasPrices = global, modules
+		suite.app.FeeAbsKeeper.SetParams(suite.ctx, params)
+	}
+
+	// the local min gas prices are kept when governance sets none
+	setMinGasPrices(sdk.SysCoins{})
+	_, err := anteHandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.ctx.MinGasPrices(), minGasPrices)
+
+	// enforced in DeliverTx as well, replacing the local min gas prices
+	setMinGasPrices(sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3)))
+	_, err = anteHandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+
+	setMinGasPrices(sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 4)))
+	_, err = anteHandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().True(minGasPrices.IsZero())
+
+	// the min gas prices of the module override the global ones
+	setMinGasPrices(sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3)),
+		feeabstypes.NewModuleMinGasPrices(newTestMsg(addr).Route(), sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 4))))
+	_, err = anteHandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// not checked when simulating
+	setMinGasPrices(sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3)))
+	_, err = anteHandler(suite.ctx, tx, true)
+	suite.Require().NoError(err)
+}
+
+type minGasPricesRecorder struct {
+	minGasPrices *sdk.DecCoins
+}
+
+func (r minGasPricesRecorder) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*r.minGasPrices = ctx.MinGasPrices()
+	return ctx, nil
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"os"
 	"sync"
+	"sync/atomic"
 
 	"github.com/okex/exchain/x/vmbridge"
 
@@ -13,6 +14,7 @@ import (
 	icacontroller "github.com/okex/exchain/libs/ibc-go/modules/apps/27-interchain-accounts/controller"
 	icahost "github.com/okex/exchain/libs/ibc-go/modules/apps/27-interchain-accounts/host"
 	"github.com/okex/exchain/libs/ibc-go/modules/apps/common"
+	"github.com/okex/exchain/x/ibchooks"
 	"github.com/okex/exchain/x/icamauth"
 
 	ibccommon "github.com/okex/exchain/libs/ibc-go/modules/core/common"
@@ -31,6 +33,12 @@ import (
 	ibcfeetypes "github.com/okex/exchain/libs/ibc-go/modules/apps/29-fee/types"
 
 	ibcfee "github.com/okex/exchain/libs/ibc-go/modules/apps/29-fee"
+	packetforward "github.com/okex/exchain/libs/ibc-go/modules/apps/packet-forward"
+	packetforwardkeeper "github.com/okex/exchain/libs/ibc-go/modules/apps/packet-forward/keeper"
+	ratelimit "github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit"
+	ratelimitclient "github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit/client"
+	ratelimitkeeper "github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit/keeper"
+	ratelimittypes "github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc/encoding"
@@ -68,6 +76,7 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/x/upgrade"
 	"github.com/okex/exchain/libs/iavl"
 	ibctransfer "github.com/okex/exchain/libs/ibc-go/modules/apps/transfer"
+	ibctransferclient "github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/client"
 	ibctransferkeeper "github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
 	ibc "github.com/okex/exchain/libs/ibc-go/modules/core"
@@ -85,7 +94,11 @@ import (
 	tmtypes "github.com/okex/exchain/libs/tendermint/types"
 	dbm "github.com/okex/exchain/libs/tm-db"
 	"github.com/okex/exchain/x/ammswap"
+	"github.com/okex/exchain/x/atomicswap"
+	"github.com/okex/exchain/x/circuit"
+	circuitclient "github.com/okex/exchain/x/circuit/client"
 	commonversion "github.com/okex/exchain/x/common/version"
+	"github.com/okex/exchain/x/cron"
 	"github.com/okex/exchain/x/dex"
 	dexclient "github.com/okex/exchain/x/dex/client"
 	distr "github.com/okex/exchain/x/distribution"
@@ -94,21 +107,27 @@ import (
 	"github.com/okex/exchain/x/evidence"
 	"github.com/okex/exchain/x/evm"
 	evmclient "github.com/okex/exchain/x/evm/client"
+	"github.com/okex/exchain/x/evm/tracesink"
 	evmtypes "github.com/okex/exchain/x/evm/types"
 	"github.com/okex/exchain/x/farm"
 	farmclient "github.com/okex/exchain/x/farm/client"
+	"github.com/okex/exchain/x/feeabs"
 	"github.com/okex/exchain/x/feesplit"
 	fsclient "github.com/okex/exchain/x/feesplit/client"
 	"github.com/okex/exchain/x/genutil"
 	"github.com/okex/exchain/x/gov"
 	"github.com/okex/exchain/x/gov/keeper"
 	"github.com/okex/exchain/x/infura"
+	"github.com/okex/exchain/x/oracle"
 	"github.com/okex/exchain/x/order"
 	"github.com/okex/exchain/x/params"
 	paramsclient "github.com/okex/exchain/x/params/client"
+	"github.com/okex/exchain/x/rent"
 	"github.com/okex/exchain/x/slashing"
 	"github.com/okex/exchain/x/staking"
+	"github.com/okex/exchain/x/stream"
 	"github.com/okex/exchain/x/token"
+	tokenclient "github.com/okex/exchain/x/token/client"
 	"github.com/okex/exchain/x/wasm"
 	wasmclient "github.com/okex/exchain/x/wasm/client"
 	wasmkeeper "github.com/okex/exchain/x/wasm/keeper"
@@ -150,15 +169,23 @@ var (
 			distr.WithdrawRewardEnabledProposalHandler,
 			distr.RewardTruncatePrecisionProposalHandler,
 			dexclient.DelistProposalHandler, farmclient.ManageWhiteListProposalHandler,
+			tokenclient.TokenControlProposalHandler,
+			circuitclient.CircuitBreakerProposalHandler,
 			evmclient.ManageContractDeploymentWhitelistProposalHandler,
 			evmclient.ManageContractBlockedListProposalHandler,
 			evmclient.ManageContractMethodBlockedListProposalHandler,
 			evmclient.ManageSysContractAddressProposalHandler,
+			evmclient.ScheduleChainConfigUpgradeProposalHandler,
+			evmclient.UpdateGasScheduleProposalHandler,
+			evmclient.UpdateMaxGasLimitPerTxProposalHandler,
 			govclient.ManageTreasuresProposalHandler,
 			erc20client.TokenMappingProposalHandler,
 			erc20client.ProxyContractRedirectHandler,
 			erc20client.ContractTemplateProposalHandler,
 			client.UpdateClientProposalHandler,
+			ibctransferclient.DenomMetadataProposalHandler,
+			ibctransferclient.ReceiverDenylistProposalHandler,
+			ratelimitclient.ManageRateLimitProposalHandler,
 			fsclient.FeeSplitSharesProposalHandler,
 			wasmclient.MigrateContractProposalHandler,
 			wasmclient.UpdateContractAdminProposalHandler,
@@ -189,6 +216,13 @@ var (
 		ica.AppModuleBasic{},
 		ibcfee.AppModuleBasic{},
 		icamauth.AppModuleBasic{},
+		stream.AppModuleBasic{},
+		oracle.AppModuleBasic{},
+		circuit.AppModuleBasic{},
+		feeabs.AppModuleBasic{},
+		cron.AppModuleBasic{},
+		rent.AppModuleBasic{},
+		atomicswap.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -212,6 +246,11 @@ var (
 		feesplit.ModuleName:         nil,
 		ibcfeetypes.ModuleName:      nil,
 		icatypes.ModuleName:         nil,
+		stream.ModuleName:           nil,
+		cron.ModuleName:             nil,
+		rent.ModuleName:             nil,
+		atomicswap.ModuleName:       nil,
+		feeabs.ModuleName:           nil,
 	}
 
 	GlobalGp = &big.Int{}
@@ -259,6 +298,13 @@ type OKExChainApp struct {
 	WasmPermissionKeeper wasm.ContractOpsKeeper
 	InfuraKeeper         infura.Keeper
 	FeeSplitKeeper       feesplit.Keeper
+	StreamKeeper         stream.Keeper
+	OracleKeeper         oracle.Keeper
+	CircuitKeeper        circuit.Keeper
+	FeeAbsKeeper         feeabs.Keeper
+	CronKeeper           cron.Keeper
+	RentKeeper           rent.Keeper
+	AtomicSwapKeeper     atomicswap.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -277,13 +323,18 @@ type OKExChainApp struct {
 	CapabilityKeeper     *capabilitykeeper.Keeper
 	IBCKeeper            *ibc.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	IBCFeeKeeper         ibcfeekeeper.Keeper
+	PacketForwardKeeper  packetforwardkeeper.Keeper
+	RateLimitKeeper      ratelimitkeeper.Keeper
 	marshal              *codec.CodecProxy
 	heightTasks          map[int64]*upgradetypes.HeightTasks
-	Erc20Keeper          erc20.Keeper
-	ICAMauthKeeper       icamauthkeeper.Keeper
-	ICAControllerKeeper  icacontrollerkeeper.Keeper
-	ICAHostKeeper        icahostkeeper.Keeper
-	VMBridgeKeeper       *vmbridge.Keeper
+	preUpgradeVerifiers  map[int64][]upgradetypes.ModuleVerifier
+	// the pending halt schedule of the chain as of the latest block, announced to the peers
+	haltSchedule        atomic.Value
+	Erc20Keeper         erc20.Keeper
+	ICAMauthKeeper      icamauthkeeper.Keeper
+	ICAControllerKeeper icacontrollerkeeper.Keeper
+	ICAHostKeeper       icahostkeeper.Keeper
+	VMBridgeKeeper      *vmbridge.Keeper
 
 	WasmHandler wasmkeeper.HandlerOption
 }
@@ -306,6 +357,7 @@ func NewOKExChainApp(
 		"Venus2Height", tmtypes.GetVenus2Height(),
 		"Venus3Height", tmtypes.GetVenus3Height(),
 		"Veneus4Height", tmtypes.GetVenus4Height(),
+		"Venus5Height", tmtypes.GetVenus5Height(),
 		"EarthHeight", tmtypes.GetEarthHeight(),
 		"MarsHeight", tmtypes.GetMarsHeight(),
 	)
@@ -339,9 +391,15 @@ func NewOKExChainApp(
 		feesplit.StoreKey,
 		icacontrollertypes.StoreKey, icahosttypes.StoreKey, ibcfeetypes.StoreKey,
 		icamauthtypes.StoreKey,
+		stream.StoreKey,
+		oracle.StoreKey,
+		circuit.StoreKey,
+		cron.StoreKey,
+		rent.StoreKey,
+		atomicswap.StoreKey,
 	)
 
-	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey, feeabs.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &OKExChainApp{
@@ -375,7 +433,13 @@ func NewOKExChainApp(
 	app.subspaces[ibctransfertypes.ModuleName] = app.ParamsKeeper.Subspace(ibctransfertypes.ModuleName)
 	app.subspaces[erc20.ModuleName] = app.ParamsKeeper.Subspace(erc20.DefaultParamspace)
 	app.subspaces[wasm.ModuleName] = app.ParamsKeeper.Subspace(wasm.ModuleName)
+	app.subspaces[vmbridge.ModuleName] = app.ParamsKeeper.Subspace(vmbridge.ModuleName)
 	app.subspaces[feesplit.ModuleName] = app.ParamsKeeper.Subspace(feesplit.ModuleName)
+	app.subspaces[oracle.ModuleName] = app.ParamsKeeper.Subspace(oracle.ModuleName)
+	app.subspaces[circuit.ModuleName] = app.ParamsKeeper.Subspace(circuit.ModuleName)
+	app.subspaces[feeabs.ModuleName] = app.ParamsKeeper.Subspace(feeabs.ModuleName)
+	app.subspaces[cron.ModuleName] = app.ParamsKeeper.Subspace(cron.ModuleName)
+	app.subspaces[rent.ModuleName] = app.ParamsKeeper.Subspace(rent.ModuleName)
 	app.subspaces[icacontrollertypes.SubModuleName] = app.ParamsKeeper.Subspace(icacontrollertypes.SubModuleName)
 	app.subspaces[icahosttypes.SubModuleName] = app.ParamsKeeper.Subspace(icahosttypes.SubModuleName)
 
@@ -421,6 +485,8 @@ func NewOKExChainApp(
 
 	app.TokenKeeper = token.NewKeeper(app.BankKeeper, app.subspaces[token.ModuleName], auth.FeeCollectorName, app.SupplyKeeper,
 		keys[token.StoreKey], keys[token.KeyLock], app.marshal.GetCdc(), false, &app.AccountKeeper)
+	// the paused tokens and the frozen addresses are enforced on all the transfers of the bank
+	app.BankKeeper.AppendSendRestriction(app.TokenKeeper.SendRestrictionFn)
 
 	app.DexKeeper = dex.NewKeeper(auth.FeeCollectorName, app.SupplyKeeper, app.subspaces[dex.ModuleName], app.TokenKeeper, &stakingKeeper,
 		app.BankKeeper, app.keys[dex.StoreKey], app.keys[dex.TokenPairStoreKey], app.marshal.GetCdc())
@@ -468,6 +534,8 @@ func NewOKExChainApp(
 		app.SupplyKeeper, supplyKeeperAdapter, scopedTransferKeeper, interfaceReg,
 	)
 	ibctransfertypes.SetMarshal(codecProxy)
+	// the rate limits are kept in the transfer store
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(keys[ibctransfertypes.StoreKey])
 	app.IBCFeeKeeper = ibcfeekeeper.NewKeeper(codecProxy, keys[ibcfeetypes.StoreKey], app.GetSubspace(ibcfeetypes.ModuleName),
 		v2keeper.ChannelKeeper, // may be replaced with IBC middleware
 		v2keeper.ChannelKeeper,
@@ -498,12 +566,27 @@ func NewOKExChainApp(
 
 	app.Erc20Keeper = erc20.NewKeeper(app.marshal.GetCdc(), app.keys[erc20.ModuleName], app.subspaces[erc20.ModuleName],
 		app.AccountKeeper, app.SupplyKeeper, app.BankKeeper, app.EvmKeeper, app.TransferKeeper)
+	// the denom metadata registered by the governance is mirrored into the evm, the hooks must be set before the
+	// transfer keeper is copied into the proposal handler
+	app.TransferKeeper.SetDenomMetadataHooks(erc20.NewDenomMetadataHooks(app.Erc20Keeper))
 
 	app.FeeSplitKeeper = feesplit.NewKeeper(
 		app.keys[feesplit.StoreKey], app.marshal.GetCdc(), app.subspaces[feesplit.ModuleName],
 		app.EvmKeeper, app.SupplyKeeper, app.AccountKeeper)
 	app.ParamsKeeper.RegisterSignal(feesplit.SetParamsNeedUpdate)
 
+	app.StreamKeeper = stream.NewKeeper(app.SupplyKeeper, app.TokenKeeper, app.keys[stream.StoreKey], app.marshal.GetCdc())
+
+	app.OracleKeeper = oracle.NewKeeper(app.keys[oracle.StoreKey], app.marshal.GetCdc(), app.subspaces[oracle.ModuleName],
+		&stakingKeeper, app.EvmKeeper)
+
+	app.CircuitKeeper = circuit.NewKeeper(app.keys[circuit.StoreKey], app.marshal.GetCdc(), app.subspaces[circuit.ModuleName])
+
+	app.FeeAbsKeeper = feeabs.NewKeeper(app.marshal.GetCdc(), app.tkeys[feeabs.TStoreKey], app.subspaces[feeabs.ModuleName],
+		app.OracleKeeper, app.SwapKeeper, app.SupplyKeeper)
+	app.CronKeeper = cron.NewKeeper(app.SupplyKeeper, app.keys[cron.StoreKey], app.marshal.GetCdc(), app.subspaces[cron.ModuleName])
+	app.RentKeeper = rent.NewKeeper(app.SupplyKeeper, app.keys[rent.StoreKey], app.marshal.GetCdc(), app.subspaces[rent.ModuleName])
+
 	//wasm keeper
 	wasmDir := wasm.WasmDir()
 	wasmConfig := wasm.WasmConfig()
@@ -511,6 +594,9 @@ func NewOKExChainApp(
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	supportedFeatures := wasm.SupportedFeatures
+	// the modules expose their custom messages and queries to the contracts as plugins
+	wasmPlugins := wasm.NewCustomPlugins()
+	oracle.RegisterWasmPlugin(wasmPlugins, app.OracleKeeper)
 	app.WasmKeeper = wasm.NewKeeper(
 		app.marshal,
 		keys[wasm.StoreKey],
@@ -527,10 +613,22 @@ func NewOKExChainApp(
 		wasmConfig,
 		supportedFeatures,
 		vmbridge.GetWasmOpts(app.marshal.GetProtocMarshal()),
+		wasm.WithCustomPlugins(wasmPlugins),
+		cron.GetWasmOpts(&app.CronKeeper),
 	)
 	(&app.WasmKeeper).SetInnerTxKeeper(app.EvmKeeper)
+	app.CronKeeper.SetWasmKeeper(wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper))
 
 	app.ParamsKeeper.RegisterSignal(wasm.SetNeedParamsUpdate)
+	// the params are read from the subspaces rather than the params caches of the keepers
+	app.ParamsKeeper.RegisterParamsValidator(staking.DefaultParamspace, func(ctx sdk.Context) error {
+		return app.StakingKeeper.GetParams(ctx).Validate()
+	})
+	app.ParamsKeeper.RegisterParamsValidator(evm.DefaultParamspace, func(ctx sdk.Context) error {
+		var params evmtypes.Params
+		app.subspaces[evm.ModuleName].GetParamSet(ctx, &params)
+		return params.Validate()
+	})
 
 	// register the proposal types
 	// 3.register the proposal types
@@ -540,22 +638,30 @@ func NewOKExChainApp(
 		AddRoute(distr.RouterKey, distr.NewDistributionProposalHandler(app.DistrKeeper)).
 		AddRoute(dex.RouterKey, dex.NewProposalHandler(&app.DexKeeper)).
 		AddRoute(farm.RouterKey, farm.NewManageWhiteListProposalHandler(&app.FarmKeeper)).
+		AddRoute(token.RouterKey, token.NewTokenControlProposalHandler(&app.TokenKeeper)).
+		AddRoute(circuit.RouterKey, circuit.NewCircuitBreakerProposalHandler(&app.CircuitKeeper)).
 		AddRoute(evm.RouterKey, evm.NewManageContractDeploymentWhitelistProposalHandler(app.EvmKeeper)).
 		AddRoute(mint.RouterKey, mint.NewManageTreasuresProposalHandler(&app.MintKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.IBCKeeper.V2Keeper.ClientKeeper)).
+		AddRoute(ibctransfertypes.RouterKey, ibctransfer.NewProposalHandler(app.TransferKeeper)).
+		AddRoute(ratelimittypes.RouterKey, ratelimit.NewManageRateLimitProposalHandler(app.RateLimitKeeper)).
 		AddRoute(erc20.RouterKey, erc20.NewProposalHandler(&app.Erc20Keeper)).
 		AddRoute(feesplit.RouterKey, feesplit.NewProposalHandler(&app.FeeSplitKeeper)).
 		AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(&app.WasmKeeper, wasm.NecessaryProposals))
 
+	ibcClientProposalHandler := ibcclient.NewProposalHandler(app.IBCKeeper.V2Keeper.ClientKeeper)
 	govProposalHandlerRouter := keeper.NewProposalHandlerRouter()
 	govProposalHandlerRouter.AddRoute(params.RouterKey, &app.ParamsKeeper).
 		AddRoute(dex.RouterKey, &app.DexKeeper).
 		AddRoute(farm.RouterKey, &app.FarmKeeper).
+		AddRoute(token.RouterKey, &app.TokenKeeper).
+		AddRoute(circuit.RouterKey, &app.CircuitKeeper).
 		AddRoute(evm.RouterKey, app.EvmKeeper).
 		AddRoute(mint.RouterKey, &app.MintKeeper).
 		AddRoute(erc20.RouterKey, &app.Erc20Keeper).
 		AddRoute(feesplit.RouterKey, &app.FeeSplitKeeper).
-		AddRoute(distr.RouterKey, &app.DistrKeeper)
+		AddRoute(distr.RouterKey, &app.DistrKeeper).
+		AddRoute(ibcclienttypes.RouterKey, ibcClientProposalHandler)
 
 	app.GovKeeper = gov.NewKeeper(
 		app.marshal.GetCdc(), app.keys[gov.StoreKey], app.ParamsKeeper, app.subspaces[gov.DefaultParamspace],
@@ -565,19 +671,31 @@ func NewOKExChainApp(
 	app.ParamsKeeper.SetGovKeeper(app.GovKeeper)
 	app.DexKeeper.SetGovKeeper(app.GovKeeper)
 	app.FarmKeeper.SetGovKeeper(app.GovKeeper)
+	app.TokenKeeper.SetGovKeeper(app.GovKeeper)
+	app.CircuitKeeper.SetGovKeeper(app.GovKeeper)
 	app.EvmKeeper.SetGovKeeper(app.GovKeeper)
 	app.MintKeeper.SetGovKeeper(app.GovKeeper)
 	app.Erc20Keeper.SetGovKeeper(app.GovKeeper)
 	app.FeeSplitKeeper.SetGovKeeper(app.GovKeeper)
 	app.DistrKeeper.SetGovKeeper(app.GovKeeper)
+	ibcClientProposalHandler.SetGovKeeper(app.GovKeeper)
 
 	// Set IBC hooks
-	app.TransferKeeper = *app.TransferKeeper.SetHooks(erc20.NewIBCTransferHooks(app.Erc20Keeper))
+	app.TransferKeeper = *app.TransferKeeper.SetHooks(ibctransfertypes.NewMultiTransferHooks(
+		app.RateLimitKeeper.Hooks(),
+		erc20.NewIBCTransferHooks(app.Erc20Keeper),
+	))
 	transferModule := ibctransfer.NewAppModule(app.TransferKeeper, codecProxy)
+	// the packet forward middleware keeps its in flight packets in the transfer store and
+	// writes the acknowledgements of the forwarded packets through the ics29 fee middleware
+	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(keys[ibctransfertypes.StoreKey], app.TransferKeeper,
+		v2keeper.ChannelKeeper, app.IBCFeeKeeper, supplyKeeperAdapter)
 
 	left := common.NewDisaleProxyMiddleware()
 	middle := ibctransfer.NewIBCModule(app.TransferKeeper, transferModule)
-	right := ibcfee.NewIBCMiddleware(middle, app.IBCFeeKeeper)
+	forward := packetforward.NewIBCMiddleware(middle, app.PacketForwardKeeper)
+	hooks := ibchooks.NewIBCMiddleware(forward, wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper))
+	right := ibcfee.NewIBCMiddleware(hooks, app.IBCFeeKeeper)
 	transferStack := ibcporttypes.NewFacadedMiddleware(left,
 		ibccommon.DefaultFactory(tmtypes.HigherThanVenus4, ibc.IBCV4, right),
 		ibccommon.DefaultFactory(tmtypes.HigherThanVenus1, ibc.IBCV2, middle))
@@ -609,7 +727,7 @@ func NewOKExChainApp(
 
 	wasmModule := wasm.NewAppModule(*app.marshal, &app.WasmKeeper)
 	app.WasmPermissionKeeper = wasmModule.GetPermissionKeeper()
-	app.VMBridgeKeeper = vmbridge.NewKeeper(app.marshal, app.Logger(), app.EvmKeeper, app.WasmPermissionKeeper, app.AccountKeeper)
+	app.VMBridgeKeeper = vmbridge.NewKeeper(app.marshal, app.Logger(), app.subspaces[vmbridge.ModuleName], app.EvmKeeper, app.WasmPermissionKeeper, app.AccountKeeper)
 
 	// Set EVM hooks
 	app.EvmKeeper.SetHooks(
@@ -618,10 +736,16 @@ func NewOKExChainApp(
 				erc20.NewSendToIbcEventHandler(app.Erc20Keeper),
 				erc20.NewSendNative20ToIbcEventHandler(app.Erc20Keeper),
 				vmbridge.NewSendToWasmEventHandler(*app.VMBridgeKeeper),
+				distr.NewWithdrawRewardsEventHandler(app.DistrKeeper),
+				distr.NewWithdrawRewardsForEventHandler(app.DistrKeeper),
 			),
 			app.FeeSplitKeeper.Hooks(),
+			app.RentKeeper.Hooks(),
 		),
 	)
+	app.EvmKeeper.SetContractHibernation(app.RentKeeper)
+	app.AtomicSwapKeeper = atomicswap.NewKeeper(app.SupplyKeeper, app.BankKeeper, app.VMBridgeKeeper,
+		app.keys[atomicswap.StoreKey], app.marshal.GetCdc())
 
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
@@ -631,7 +755,7 @@ func NewOKExChainApp(
 		bank.NewAppModule(app.BankKeeper, app.AccountKeeper, app.SupplyKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper),
 		supply.NewAppModule(app.SupplyKeeper, app.AccountKeeper),
-		gov.NewAppModule(app.GovKeeper, app.SupplyKeeper),
+		gov.NewAppModule(app.GovKeeper, app.AccountKeeper, app.SupplyKeeper),
 		mint.NewAppModule(app.MintKeeper),
 		slashing.NewAppModule(app.SlashingKeeper, app.AccountKeeper, app.StakingKeeper),
 		distr.NewAppModule(app.DistrKeeper, app.SupplyKeeper),
@@ -655,6 +779,13 @@ func NewOKExChainApp(
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
 		ica.NewAppModule(codecProxy, &app.ICAControllerKeeper, &app.ICAHostKeeper),
 		icamauth.NewAppModule(codecProxy, app.ICAMauthKeeper),
+		stream.NewAppModule(app.StreamKeeper),
+		oracle.NewAppModule(app.OracleKeeper),
+		circuit.NewAppModule(app.CircuitKeeper),
+		feeabs.NewAppModule(app.FeeAbsKeeper),
+		cron.NewAppModule(app.CronKeeper),
+		rent.NewAppModule(app.RentKeeper),
+		atomicswap.NewAppModule(app.AtomicSwapKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -685,6 +816,9 @@ func NewOKExChainApp(
 		order.ModuleName,
 		staking.ModuleName,
 		wasm.ModuleName,
+		cron.ModuleName,
+		rent.ModuleName,
+		oracle.ModuleName,
 		evm.ModuleName, // we must sure evm.endblocker must be last endblocker for innerTx.infura can not gengerate tx, so infura can be last in the list.
 		infura.ModuleName,
 	)
@@ -704,6 +838,13 @@ func NewOKExChainApp(
 		feesplit.ModuleName,
 		ibchost.ModuleName,
 		icatypes.ModuleName, ibcfeetypes.ModuleName,
+		stream.ModuleName,
+		oracle.ModuleName,
+		circuit.ModuleName,
+		feeabs.ModuleName,
+		cron.ModuleName,
+		rent.ModuleName,
+		atomicswap.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -718,11 +859,11 @@ func NewOKExChainApp(
 	//
 	// NOTE: this is not required apps that don't use the simulator for fuzz testing
 	// transactions
+	// NOTE: the bank module isn't simulated as it doesn't route any msg, the coins are transferred by the token module
 	app.sm = module.NewSimulationManager(
 		auth.NewAppModule(app.AccountKeeper),
-		bank.NewAppModule(app.BankKeeper, app.AccountKeeper, app.SupplyKeeper),
 		supply.NewAppModule(app.SupplyKeeper, app.AccountKeeper),
-		gov.NewAppModule(app.GovKeeper, app.SupplyKeeper),
+		gov.NewAppModule(app.GovKeeper, app.AccountKeeper, app.SupplyKeeper),
 		mint.NewAppModule(app.MintKeeper),
 		staking.NewAppModule(app.StakingKeeper, app.AccountKeeper, app.SupplyKeeper),
 		distr.NewAppModule(app.DistrKeeper, app.SupplyKeeper),
@@ -730,6 +871,8 @@ func NewOKExChainApp(
 		params.NewAppModule(app.ParamsKeeper), // NOTE: only used for simulation to generate randomized param change proposals
 		ibc.NewAppModule(app.IBCKeeper),
 		wasm.NewAppModule(*app.marshal, &app.WasmKeeper),
+		erc20.NewAppModule(app.Erc20Keeper),
+		vmbridge.NewAppModuleSimulation(), // NOTE: only used for simulation to generate randomized param change proposals
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -746,19 +889,22 @@ func NewOKExChainApp(
 		WasmConfig:        &wasmConfig,
 		TXCounterStoreKey: keys[wasm.StoreKey],
 	}
-	app.SetAnteHandler(ante.NewAnteHandler(app.AccountKeeper, app.EvmKeeper, app.SupplyKeeper, validateMsgHook(app.OrderKeeper), app.WasmHandler, app.IBCKeeper))
+	app.SetAnteHandler(ante.NewAnteHandler(app.AccountKeeper, app.EvmKeeper, app.SupplyKeeper, validateMsgHook(app.OrderKeeper), app.WasmHandler, app.IBCKeeper, app.CircuitKeeper, app.FeeAbsKeeper,
+		getAnteChainOptions()...))
 	app.SetEndBlocker(app.EndBlocker)
 	app.SetGasRefundHandler(refund.NewGasRefundHandler(app.AccountKeeper, app.SupplyKeeper, app.EvmKeeper))
 	app.SetAccNonceHandler(NewAccNonceHandler(app.AccountKeeper))
 	app.AddCustomizeModuleOnStopLogic(NewEvmModuleStopLogic(app.EvmKeeper))
 	app.SetMptCommitHandler(NewMptCommitHandler(app.EvmKeeper))
+	app.SetPreCommitHandler(app.runUpgradeTasks)
 	app.SetUpdateFeeCollectorAccHandler(updateFeeCollectorHandler(app.BankKeeper, app.SupplyKeeper))
 	app.SetParallelTxLogHandlers(fixLogForParallelTxHandler(app.EvmKeeper))
-	app.SetPreDeliverTxHandler(preDeliverTxHandler(app.AccountKeeper))
+	app.SetPreDeliverTxHandler(preDeliverTxHandler(app.AccountKeeper, app.EvmKeeper))
 	app.SetPartialConcurrentHandlers(getTxFeeAndFromHandler(app.AccountKeeper))
 	app.SetGetTxFeeHandler(getTxFeeHandler())
 	app.SetEvmSysContractAddressHandler(NewEvmSysContractAddressHandler(app.EvmKeeper))
 	app.SetEvmWatcherCollector(app.EvmKeeper.Watcher.Collect)
+	app.SetCosmosGasFactorHandler(cosmosGasFactorHandler(app.ParamsKeeper))
 
 	gpoConfig := gasprice.NewGPOConfig(appconfig.GetOecConfig().GetDynamicGpWeight(), appconfig.GetOecConfig().GetDynamicGpCheckBlocks())
 	app.gpo = gasprice.NewOracle(gpoConfig)
@@ -786,6 +932,10 @@ func NewOKExChainApp(
 	enableAnalyzer := sm.DeliverTxsExecMode(viper.GetInt(sm.FlagDeliverTxsExecMode)) == sm.DeliverTxsExecModeSerial
 	trace.EnableAnalyzer(enableAnalyzer)
 
+	if err := tracesink.Init(logger); err != nil {
+		panic(err)
+	}
+
 	return app
 }
 
@@ -825,7 +975,9 @@ func (app *OKExChainApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) a
 		app.gpo.CurrentBlockGPs.Clear()
 	}
 
-	return app.mm.EndBlock(ctx, req)
+	res := app.mm.EndBlock(ctx, req)
+	app.checkHaltSchedule(ctx)
+	return res
 }
 
 // InitChainer updates at chain initialization
@@ -845,6 +997,10 @@ func (app *OKExChainApp) LoadHeight(height int64) error {
 func (app *OKExChainApp) ModuleAccountAddrs() map[string]bool {
 	modAccAddrs := make(map[string]bool)
 	for acc := range maccPerms {
+		// the reserve of the feeabs module is funded by the bank sends
+		if acc == feeabs.ModuleName {
+			continue
+		}
 		modAccAddrs[supply.NewModuleAddress(acc).String()] = true
 	}
 
@@ -921,6 +1077,11 @@ func validateMsgHook(orderKeeper order.Keeper) ante.ValidateMsgHandler {
 					return wrongMsgErr
 				}
 				err = order.ValidateMsgCancelOrders(newCtx, orderKeeper, assertedMsg)
+			case order.MsgNewMarketOrder:
+				if len(msgs) > 1 {
+					return wrongMsgErr
+				}
+				err = order.ValidateMsgNewMarketOrder(newCtx, orderKeeper, assertedMsg)
 			case *evmtypes.MsgEthereumTx:
 				if len(msgs) > 1 {
 					return wrongMsgErr
@@ -7,6 +7,7 @@ import (
 	appconfig "github.com/okex/exchain/app/config"
 	"github.com/okex/exchain/libs/system/trace"
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
 	"github.com/okex/exchain/x/wasm/watcher"
 )
 
@@ -57,16 +58,8 @@ func (app *OKExChainApp) Commit(req abci.RequestCommit) abci.ResponseCommit {
 	//defer trace.GetTraceSummary().Dump()
 	defer trace.OnCommitDone()
 
-	tasks := app.heightTasks[app.BaseApp.LastBlockHeight()+1]
-	if tasks != nil {
-		ctx := app.BaseApp.GetDeliverStateCtx()
-		for _, t := range *tasks {
-			if err := t.Execute(ctx); nil != err {
-				panic("bad things")
-			}
-		}
-	}
 	res := app.BaseApp.Commit(req)
+	evmtypes.CommitTraceSink()
 
 	// we call watch#Commit here ,because
 	// 1. this round commit a valid block
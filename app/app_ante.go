@@ -0,0 +1,30 @@
+package app
+
+import (
+	"sync"
+
+	"github.com/okex/exchain/app/ante"
+)
+
+var (
+	anteChainOptions    []ante.ChainOption
+	anteChainOptionsMtx sync.Mutex
+)
+
+// RegisterAnteChainOptions registers the options customizing the ante chains of the app, so that the app developers
+// can insert their own decorators without patching the app wiring. The options are applied in the order they are
+// registered, so they must be registered before NewOKExChainApp is called, e.g. in the main of a custom binary:
+//
+//	app.RegisterAnteChainOptions(ante.InsertStdTxDecoratorsAfter(ante.DecoratorValidateBasic,
+//		ante.NewNamedDecorator("tx_size_limit", NewTxSizeLimitDecorator(maxTxSize))))
+func RegisterAnteChainOptions(opts ...ante.ChainOption) {
+	anteChainOptionsMtx.Lock()
+	defer anteChainOptionsMtx.Unlock()
+	anteChainOptions = append(anteChainOptions, opts...)
+}
+
+func getAnteChainOptions() []ante.ChainOption {
+	anteChainOptionsMtx.Lock()
+	defer anteChainOptionsMtx.Unlock()
+	return append([]ante.ChainOption{}, anteChainOptions...)
+}
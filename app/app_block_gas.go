@@ -0,0 +1,19 @@
+package app
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+
+	"github.com/okex/exchain/x/params"
+)
+
+// cosmosGasFactorHandler returns the factor set by governance converting the gas of the cosmos txs to the gas of the
+// block, the evm and cosmos txs share the gas limit of the block with it
+func cosmosGasFactorHandler(pk params.Keeper) sdk.CosmosGasFactorHandler {
+	return func(ctx sdk.Context) sdk.Dec {
+		if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+			return sdk.OneDec()
+		}
+		return pk.GetCosmosGasFactor(ctx)
+	}
+}
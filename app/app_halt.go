@@ -0,0 +1,30 @@
+package app
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/halt"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+)
+
+// checkHaltSchedule halts all the nodes after the commit of the block reaching the halt schedule set by governance,
+// and keeps the pending schedule to be announced to the peers and served by the rpc
+func (app *OKExChainApp) checkHaltSchedule(ctx sdk.Context) {
+	if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+		return
+	}
+
+	schedule, reached := app.ParamsKeeper.ConsumeHaltSchedule(ctx)
+	if reached {
+		app.Logger().Info("halting the chain per the schedule of governance", "height", ctx.BlockHeight(),
+			"halt height", schedule.Height, "halt time", schedule.Time)
+		app.BaseApp.HaltAfterCommit()
+		schedule.Height, schedule.Time = 0, 0
+	}
+	app.haltSchedule.Store(halt.Schedule{Height: schedule.Height, Time: schedule.Time})
+}
+
+// HaltSchedule returns the pending halt schedule of the chain as of the latest block
+func (app *OKExChainApp) HaltSchedule() halt.Schedule {
+	schedule, _ := app.haltSchedule.Load().(halt.Schedule)
+	return schedule
+}
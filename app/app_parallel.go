@@ -2,12 +2,14 @@ package app
 
 import (
 	"encoding/hex"
+	"fmt"
 	"sort"
 	"strings"
 
 	appconfig "github.com/okex/exchain/app/config"
 	"github.com/okex/exchain/app/gasprice"
 	ethermint "github.com/okex/exchain/app/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/store/mpt"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	authante "github.com/okex/exchain/libs/cosmos-sdk/x/auth/ante"
@@ -22,13 +24,27 @@ import (
 // feeCollectorHandler set or get the value of feeCollectorAcc
 func updateFeeCollectorHandler(bk bank.Keeper, sk supply.Keeper) sdk.UpdateFeeCollectorAccHandler {
 	return func(ctx sdk.Context, balance sdk.Coins, txFeesplit []*sdk.FeeSplitInfo) error {
-		err := bk.SetCoins(ctx, sk.GetModuleAccount(ctx, auth.FeeCollectorName).GetAddress(), balance)
+		collector := sk.GetModuleAccount(ctx, auth.FeeCollectorName).GetAddress()
+		deferred := authante.IsFeeCollectionDeferred(ctx.BlockHeight())
+		before := bk.GetCoins(ctx, collector)
+
+		var err error
+		if deferred {
+			// balance is the fees collected since the last settlement, which are added to the fee collector
+			if !balance.IsValid() {
+				return fmt.Errorf("invalid fees collected in the block: %s", balance)
+			}
+			_, err = bk.AddCoins(ctx, collector, balance)
+		} else {
+			err = bk.SetCoins(ctx, collector, balance)
+		}
 		if err != nil {
 			return err
 		}
 
 		// split fee
 		// come from feesplit module
+		splits := sdk.Coins{}
 		if txFeesplit != nil {
 			feesplits, sortAddrs := groupByAddrAndSortFeeSplits(txFeesplit)
 			for _, addr := range sortAddrs {
@@ -37,12 +53,31 @@ func updateFeeCollectorHandler(bk bank.Keeper, sk supply.Keeper) sdk.UpdateFeeCo
 				if err != nil {
 					return err
 				}
+				splits = splits.Add(feesplits[addr]...)
 			}
 		}
+
+		if deferred {
+			return checkFeeCollectorInvariant(before, balance, splits, bk.GetCoins(ctx, collector))
+		}
 		return nil
 	}
 }
 
+// checkFeeCollectorInvariant checks the fee collector is credited exactly the fees collected in the block minus the
+// fees split to the contract deployers by the settlement
+func checkFeeCollectorInvariant(before, fees, splits, after sdk.Coins) error {
+	expected, hasNeg := before.Add(fees...).SafeSub(splits)
+	if hasNeg {
+		return fmt.Errorf("fee collector invariant broken: the split fees %s exceed the balance %s plus the fees %s",
+			splits, before, fees)
+	}
+	if diff, hasNeg := after.SafeSub(expected); hasNeg || !diff.IsZero() {
+		return fmt.Errorf("fee collector invariant broken: expected balance %s, got %s", expected, after)
+	}
+	return nil
+}
+
 // fixLogForParallelTxHandler fix log for parallel tx
 func fixLogForParallelTxHandler(ek *evm.Keeper) sdk.LogFix {
 	return func(tx []sdk.Tx, logIndex []int, hasEnterEvmTx []bool, anteErrs []error, resp []abci.ResponseDeliverTx) (logs [][]byte) {
@@ -50,7 +85,7 @@ func fixLogForParallelTxHandler(ek *evm.Keeper) sdk.LogFix {
 	}
 }
 
-func preDeliverTxHandler(ak auth.AccountKeeper) sdk.PreDeliverTxHandler {
+func preDeliverTxHandler(ak auth.AccountKeeper, ek *evm.Keeper) sdk.PreDeliverTxHandler {
 	return func(ctx sdk.Context, tx sdk.Tx, onlyVerifySig bool) {
 		if evmTx, ok := tx.(*evmtypes.MsgEthereumTx); ok {
 			if evmTx.BaseTx.From == "" {
@@ -58,6 +93,9 @@ func preDeliverTxHandler(ak auth.AccountKeeper) sdk.PreDeliverTxHandler {
 			}
 
 			if types.HigherThanMars(ctx.BlockHeight()) {
+				if mpt.TrieBlockPrefetch && !ctx.IsCheckTx() {
+					prefetchEvmTxState(ek, evmTx)
+				}
 				return
 			}
 
@@ -75,6 +113,24 @@ func preDeliverTxHandler(ak auth.AccountKeeper) sdk.PreDeliverTxHandler {
 	}
 }
 
+// prefetchEvmTxState warms the tries of the accounts and of the contract storage read by the evm tx, while the
+// previous txs of the block are delivered
+func prefetchEvmTxState(ek *evm.Keeper, evmTx *evmtypes.MsgEthereumTx) {
+	var keys [][]byte
+	if from := evmTx.AccountAddress(); from != nil {
+		keys = append(keys, auth.AddressStoreKey(from))
+	}
+	if to := evmTx.Data.Recipient; to != nil {
+		keys = append(keys, auth.AddressStoreKey(to.Bytes()))
+		if ek != nil && ek.EvmStateDb != nil {
+			ek.EvmStateDb.PrefetchStorageRoots(*to)
+		}
+	}
+	if len(keys) > 0 {
+		mpt.PrefetchAccounts(keys)
+	}
+}
+
 func evmTxVerifySigHandler(chainID string, blockHeight int64, evmTx *evmtypes.MsgEthereumTx) error {
 	chainIDEpoch, err := ethermint.ParseChainID(chainID)
 	if err != nil {
@@ -339,3 +339,17 @@ func newTestStdTx(msgs []cosmossdk.Msg, privs []crypto.PrivKey, accNums []uint64
 	tx := auth.NewStdTx(msgs, fee, sigs, memo)
 	return tx
 }
+
+func TestCheckFeeCollectorInvariant(t *testing.T) {
+	okt := func(amount int64) cosmossdk.Coins {
+		return cosmossdk.NewDecCoinsFromDec(cosmossdk.DefaultBondDenom, cosmossdk.NewDec(amount))
+	}
+
+	require.NoError(t, checkFeeCollectorInvariant(okt(1), okt(10), okt(3), okt(8)))
+	require.NoError(t, checkFeeCollectorInvariant(cosmossdk.Coins{}, okt(10), cosmossdk.Coins{}, okt(10)))
+	// the fee collector is credited more or less than the fees of the block
+	require.Error(t, checkFeeCollectorInvariant(okt(1), okt(10), okt(3), okt(9)))
+	require.Error(t, checkFeeCollectorInvariant(okt(1), okt(10), cosmossdk.Coins{}, okt(10)))
+	// the split fees exceed the fees of the block
+	require.Error(t, checkFeeCollectorInvariant(cosmossdk.Coins{}, okt(1), okt(3), cosmossdk.Coins{}))
+}
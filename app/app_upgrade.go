@@ -1,6 +1,7 @@
 package app
 
 import (
+	"fmt"
 	"sort"
 
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
@@ -16,6 +17,7 @@ import (
 	upgradetypes "github.com/okex/exchain/libs/cosmos-sdk/types/upgrade"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/params"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/params/subspace"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
 )
 
 func (app *OKExChainApp) RegisterTxService(clientCtx cliContext.CLIContext) {
@@ -33,6 +35,7 @@ func (app *OKExChainApp) setupUpgradeModules() {
 	heightTasks, paramMap, cf, pf, vf := app.CollectUpgradeModules(app.mm)
 
 	app.heightTasks = heightTasks
+	app.preUpgradeVerifiers = app.CollectPreUpgradeVerifiers(app.mm)
 
 	app.GetCMS().AppendCommitFilters(cf)
 	app.GetCMS().AppendPruneFilters(pf)
@@ -124,3 +127,82 @@ func (o *OKExChainApp) CollectUpgradeModules(m *module.Manager) (map[int64]*upgr
 
 	return hm, paramsRet, commitFilters, pruneFilters, versionFilters
 }
+
+// CollectPreUpgradeVerifiers collects the pre-upgrade verifications of the upgrade modules by the upgrade heights,
+// the tasks of the modules are executed at the block after
+func (o *OKExChainApp) CollectPreUpgradeVerifiers(m *module.Manager) map[int64][]upgradetypes.ModuleVerifier {
+	verifiers := make(map[int64][]upgradetypes.ModuleVerifier)
+	for _, mm := range m.Modules {
+		ada, ok := mm.(upgradetypes.UpgradeModule)
+		if !ok {
+			continue
+		}
+		verifier, ok := mm.(upgradetypes.PreUpgradeVerifier)
+		if !ok {
+			continue
+		}
+		// the modules upgraded at genesis have no block before their tasks
+		h := ada.UpgradeHeight()
+		if h <= 0 {
+			continue
+		}
+		verifiers[h] = append(verifiers[h], upgradetypes.ModuleVerifier{Module: ada.ModuleName(), Verify: verifier.PreUpgradeVerify})
+	}
+
+	for _, v := range verifiers {
+		sort.Slice(v, func(i, j int) bool { return v[i].Module < v[j].Module })
+	}
+	return verifiers
+}
+
+// verifyPreUpgrade runs the pre-upgrade verifications of the upgrade tasks executed at the block after height
+// runUpgradeTasks verifies the upgrades and executes the upgrade tasks of the block right before it's committed. It's
+// set as the pre-commit handler of the BaseApp, so the tasks are executed by whoever commits the blocks, the simulator
+// included.
+func (app *OKExChainApp) runUpgradeTasks(ctx sdk.Context) {
+	app.verifyPreUpgrade(app.BaseApp.LastBlockHeight() + 1)
+	tasks := app.heightTasks[app.BaseApp.LastBlockHeight()+1]
+	if tasks != nil {
+		for _, t := range *tasks {
+			if err := t.Execute(ctx); nil != err {
+				panic("bad things")
+			}
+		}
+	}
+}
+
+func (app *OKExChainApp) verifyPreUpgrade(height int64) {
+	verifiers := app.preUpgradeVerifiers[height]
+	if len(verifiers) == 0 {
+		return
+	}
+	ctx := app.BaseApp.GetDeliverStateCtx()
+	runPreUpgradeVerifiers(ctx, app.Logger(), verifiers, GetUpgradeMetrics())
+}
+
+// runPreUpgradeVerifiers runs the verifications on a cache of the state which is discarded, so the verifications never
+// change the state. A failed or panicked verification is logged and counted, it doesn't halt the chain.
+func runPreUpgradeVerifiers(ctx sdk.Context, logger log.Logger, verifiers []upgradetypes.ModuleVerifier, metrics *UpgradeMetrics) (failed int) {
+	for _, v := range verifiers {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx.SetGasMeter(sdk.NewInfiniteGasMeter())
+		if err := verifyModule(cacheCtx, v); err != nil {
+			failed++
+			metrics.PreUpgradeVerifyFailures.With("module", v.Module).Add(1)
+			logger.Error("pre-upgrade verification failed, the upgrade at the next block may halt the chain",
+				"module", v.Module, "height", ctx.BlockHeight(), "err", err)
+			continue
+		}
+		logger.Info("pre-upgrade verification passed", "module", v.Module, "height", ctx.BlockHeight())
+	}
+	return failed
+}
+
+func verifyModule(ctx sdk.Context, v upgradetypes.ModuleVerifier) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return v.Verify(ctx)
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -34,6 +35,7 @@ import (
 	okexchain "github.com/okex/exchain/app/types"
 	bam "github.com/okex/exchain/libs/cosmos-sdk/baseapp"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/store"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/types/module"
 	upgradetypes "github.com/okex/exchain/libs/cosmos-sdk/types/upgrade"
@@ -77,7 +79,8 @@ import (
 )
 
 var (
-	_ upgradetypes.UpgradeModule = (*SimpleBaseUpgradeModule)(nil)
+	_ upgradetypes.UpgradeModule      = (*SimpleBaseUpgradeModule)(nil)
+	_ upgradetypes.PreUpgradeVerifier = (*SimpleBaseUpgradeModule)(nil)
 
 	test_prefix       = "upgrade_module_"
 	blockModules      map[string]struct{}
@@ -97,6 +100,7 @@ type SimpleBaseUpgradeModule struct {
 	taskExecutedNotify func()
 	appModule          module.AppModuleBasic
 	storeKey           *sdk.KVStoreKey
+	verifiedHeights    []int64
 }
 
 func (b *SimpleBaseUpgradeModule) CommitFilter() *cosmost.StoreFilter {
@@ -164,6 +168,14 @@ func (b *SimpleBaseUpgradeModule) RegisterTask() upgradetypes.HeightTask {
 	})
 }
 
+func (b *SimpleBaseUpgradeModule) PreUpgradeVerify(ctx sdk.Context) error {
+	b.verifiedHeights = append(b.verifiedHeights, ctx.BlockHeight())
+	if ctx.KVStore(b.storeKey).Has([]byte(test_prefix + b.ModuleName())) {
+		return errors.New("the task is executed before the verification")
+	}
+	return nil
+}
+
 func (b *SimpleBaseUpgradeModule) UpgradeHeight() int64 {
 	return b.h
 }
@@ -505,7 +517,7 @@ func newTestOkcChainApp(
 		bank.NewAppModule(app.BankKeeper, app.AccountKeeper, app.SupplyKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper),
 		supply.NewAppModule(app.SupplyKeeper, app.AccountKeeper),
-		gov.NewAppModule(app.GovKeeper, app.SupplyKeeper),
+		gov.NewAppModule(app.GovKeeper, app.AccountKeeper, app.SupplyKeeper),
 		mint.NewAppModule(app.MintKeeper),
 		slashing.NewAppModule(app.SlashingKeeper, app.AccountKeeper, app.StakingKeeper),
 		distr.NewAppModule(app.DistrKeeper, app.SupplyKeeper),
@@ -538,7 +550,7 @@ func newTestOkcChainApp(
 		auth.NewAppModule(app.AccountKeeper),
 		bank.NewAppModule(app.BankKeeper, app.AccountKeeper, app.SupplyKeeper),
 		supply.NewAppModule(app.SupplyKeeper, app.AccountKeeper),
-		gov.NewAppModule(app.GovKeeper, app.SupplyKeeper),
+		gov.NewAppModule(app.GovKeeper, app.AccountKeeper, app.SupplyKeeper),
 		mint.NewAppModule(app.MintKeeper),
 		staking.NewAppModule(app.StakingKeeper, app.AccountKeeper, app.SupplyKeeper),
 		distr.NewAppModule(app.DistrKeeper, app.SupplyKeeper),
@@ -561,7 +573,7 @@ func newTestOkcChainApp(
 	app.SetAnteHandler(ante.NewAnteHandler(app.AccountKeeper, app.EvmKeeper, app.SupplyKeeper, validateMsgHook(app.OrderKeeper), wasmkeeper.HandlerOption{
 		WasmConfig:        &wasmConfig,
 		TXCounterStoreKey: keys[wasm.StoreKey],
-	}, app.IBCKeeper))
+	}, app.IBCKeeper, nil, nil))
 	app.SetEndBlocker(app.EndBlocker)
 	app.SetGasRefundHandler(refund.NewGasRefundHandler(app.AccountKeeper, app.SupplyKeeper, app.EvmKeeper))
 	app.SetAccNonceHandler(NewAccNonceHandler(app.AccountKeeper))
@@ -569,6 +581,7 @@ func newTestOkcChainApp(
 	app.SetUpdateFeeCollectorAccHandler(updateFeeCollectorHandler(app.BankKeeper, app.SupplyKeeper))
 	app.SetParallelTxLogHandlers(fixLogForParallelTxHandler(app.EvmKeeper))
 	app.SetEvmWatcherCollector(app.EvmKeeper.Watcher.Collect)
+	app.SetPreCommitHandler(app.runUpgradeTasks)
 
 	if loadLatest {
 		err := app.LoadLatestVersion(app.keys[bam.MainStoreKey])
@@ -657,6 +670,40 @@ func TestUpgradeWithConcreteHeight(t *testing.T) {
 		require.Equal(t, 1, v)
 	}
 	require.Equal(t, count, len(cases))
+	// the verifications run once at the block before the tasks
+	for _, module := range modules {
+		require.Equal(t, []int64{module.h}, module.verifiedHeights)
+	}
+}
+
+func TestRunPreUpgradeVerifiers(t *testing.T) {
+	key := sdk.NewKVStoreKey("verified")
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, abci.Header{Height: 10}, false, log.NewNopLogger())
+
+	verified := make([]string, 0)
+	verifiers := []upgradetypes.ModuleVerifier{
+		{Module: "passed", Verify: func(ctx sdk.Context) error {
+			verified = append(verified, "passed")
+			ctx.KVStore(key).Set([]byte("key"), []byte("value"))
+			return nil
+		}},
+		{Module: "failed", Verify: func(ctx sdk.Context) error {
+			verified = append(verified, "failed")
+			return errors.New("inconsistent state")
+		}},
+		{Module: "panicked", Verify: func(ctx sdk.Context) error {
+			verified = append(verified, "panicked")
+			panic("inconsistent state")
+		}},
+	}
+	require.Equal(t, 2, runPreUpgradeVerifiers(ctx, log.NewNopLogger(), verifiers, NopUpgradeMetrics()))
+	require.Equal(t, []string{"passed", "failed", "panicked"}, verified)
+	// the verifications don't change the state
+	require.False(t, ctx.KVStore(key).Has([]byte("key")))
 }
 
 func setupTestApp(db dbm.DB, cases []UpgradeCase, modules []*simpleAppModule) *testSimApp {
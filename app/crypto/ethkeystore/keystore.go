@@ -48,17 +48,40 @@ func EncodeTmKeyToEthKey(privKey tmcrypto.PrivKey) (*ecdsa.PrivateKey, error) {
 	return emintKey.ToECDSA(), nil
 }
 
-// ExportKeyStoreFile Export Key to  keystore file
-func ExportKeyStoreFile(privateKeyECDSA *ecdsa.PrivateKey, encryptPassword, fileName string) error {
+// DecodeEthKeyToTmKey transfer a ethereum key to a tendermint key
+func DecodeEthKeyToTmKey(privateKeyECDSA *ecdsa.PrivateKey) tmcrypto.PrivKey {
+	return ethsecp256k1.PrivKey(ethcrypto.FromECDSA(privateKeyECDSA))
+}
+
+// EncryptKeyStore encrypts the key to the json of a keystore v3 file
+func EncryptKeyStore(privateKeyECDSA *ecdsa.PrivateKey, encryptPassword string) ([]byte, error) {
 	//new keystore key
 	ethKey, err := newEthKeyFromECDSA(privateKeyECDSA)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	// encrypt Key to get keystore file
 	content, err := keystore.EncryptKey(ethKey, encryptPassword, keystore.StandardScryptN, keystore.StandardScryptP)
 	if err != nil {
-		return fmt.Errorf("failed to encrypt key: %s", err.Error())
+		return nil, fmt.Errorf("failed to encrypt key: %s", err.Error())
+	}
+	return content, nil
+}
+
+// DecryptKeyStore decrypts the json of a keystore v3 file to a tendermint key
+func DecryptKeyStore(keyJSON []byte, decryptPassword string) (tmcrypto.PrivKey, error) {
+	ethKey, err := keystore.DecryptKey(keyJSON, decryptPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore: %s", err.Error())
+	}
+	return DecodeEthKeyToTmKey(ethKey.PrivateKey), nil
+}
+
+// ExportKeyStoreFile Export Key to  keystore file
+func ExportKeyStoreFile(privateKeyECDSA *ecdsa.PrivateKey, encryptPassword, fileName string) error {
+	content, err := EncryptKeyStore(privateKeyECDSA, encryptPassword)
+	if err != nil {
+		return err
 	}
 
 	// write to keystore file
@@ -54,3 +54,22 @@ func testGetEthKey(t *testing.T) {
 
 	}
 }
+
+func TestKeyStoreRoundTrip(t *testing.T) {
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	ethKey, err := EncodeTmKeyToEthKey(privKey)
+	require.NoError(t, err)
+
+	keyJSON, err := EncryptKeyStore(ethKey, "12345678")
+	require.NoError(t, err)
+
+	// the wrong password fails to decrypt
+	_, err = DecryptKeyStore(keyJSON, "abcdefgh")
+	require.Error(t, err)
+
+	decrypted, err := DecryptKeyStore(keyJSON, "12345678")
+	require.NoError(t, err)
+	require.True(t, privKey.Equals(decrypted))
+	require.Equal(t, privKey.PubKey().Address(), decrypted.PubKey().Address())
+}
@@ -0,0 +1,149 @@
+package abiregistry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+// DecodedInputKey is the key the decoded input of a call frame is added to a trace with
+const DecodedInputKey = "decodedInput"
+
+// ContractABI is the abi of a verified contract. In the verified-contracts file, the abi is either the json array or
+// a string of it.
+type ContractABI struct {
+	Address common.Address  `json:"address"`
+	Name    string          `json:"name,omitempty"`
+	ABI     json.RawMessage `json:"abi"`
+}
+
+type registeredContract struct {
+	info ContractABI
+	abi  *evmtypes.ABI
+}
+
+// Registry keeps the abis of the verified contracts, to decode the calldata of the calls to them in the rpc responses
+type Registry struct {
+	mtx       sync.RWMutex
+	contracts map[common.Address]registeredContract
+}
+
+// NewRegistry creates a registry loaded with the verified-contracts file, the registry is empty if file is empty
+func NewRegistry(file string) (*Registry, error) {
+	r := &Registry{contracts: make(map[common.Address]registeredContract)}
+	if file == "" {
+		return r, nil
+	}
+
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var contracts []ContractABI
+	if err := json.Unmarshal(bz, &contracts); err != nil {
+		return nil, fmt.Errorf("invalid verified-contracts file %s: %s", file, err)
+	}
+	for _, contract := range contracts {
+		if err := r.Register(contract); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Register adds the abi of the contract, replacing the abi registered before
+func (r *Registry) Register(contract ContractABI) error {
+	abiJSON := []byte(contract.ABI)
+	if bytes.HasPrefix(bytes.TrimSpace(abiJSON), []byte(`"`)) {
+		var s string
+		if err := json.Unmarshal(abiJSON, &s); err != nil {
+			return err
+		}
+		abiJSON = []byte(s)
+	}
+	parsed, err := evmtypes.NewABI(string(abiJSON))
+	if err != nil {
+		return fmt.Errorf("invalid abi of contract %s: %s", contract.Address.Hex(), err)
+	}
+	contract.ABI = abiJSON
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.contracts[contract.Address] = registeredContract{info: contract, abi: parsed}
+	return nil
+}
+
+// Remove removes the abi of the contract, it returns false if the contract isn't registered
+func (r *Registry) Remove(address common.Address) bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if _, ok := r.contracts[address]; !ok {
+		return false
+	}
+	delete(r.contracts, address)
+	return true
+}
+
+// List returns the registered contracts in order of address
+func (r *Registry) List() []ContractABI {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	contracts := make([]ContractABI, 0, len(r.contracts))
+	for _, contract := range r.contracts {
+		contracts = append(contracts, contract.info)
+	}
+	sort.Slice(contracts, func(i, j int) bool {
+		return bytes.Compare(contracts[i].Address.Bytes(), contracts[j].Address.Bytes()) < 0
+	})
+	return contracts
+}
+
+// DecodeCallData decodes the calldata of a call to the contract, it returns nil if the contract isn't registered or
+// the calldata doesn't match its abi
+func (r *Registry) DecodeCallData(address common.Address, data []byte) *evmtypes.DecodedCallData {
+	r.mtx.RLock()
+	contract, ok := r.contracts[address]
+	r.mtx.RUnlock()
+	if !ok {
+		return nil
+	}
+	decoded, err := contract.abi.DecodeCallData(data)
+	if err != nil {
+		return nil
+	}
+	return decoded
+}
+
+// AnnotateTrace adds the decoded input to the call frames of a trace, as the callTracer returns, whose callee is
+// registered
+func (r *Registry) AnnotateTrace(trace interface{}) {
+	switch v := trace.(type) {
+	case map[string]interface{}:
+		to, okTo := v["to"].(string)
+		input, okInput := v["input"].(string)
+		if okTo && okInput && common.IsHexAddress(to) {
+			if data, err := hexutil.Decode(input); err == nil {
+				if decoded := r.DecodeCallData(common.HexToAddress(to), data); decoded != nil {
+					v[DecodedInputKey] = decoded
+				}
+			}
+		}
+		for key, value := range v {
+			if key != DecodedInputKey {
+				r.AnnotateTrace(value)
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			r.AnnotateTrace(value)
+		}
+	}
+}
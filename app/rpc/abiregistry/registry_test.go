@@ -0,0 +1,82 @@
+package abiregistry
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+const erc20TransferABI = `[{"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`
+
+func TestRegistry(t *testing.T) {
+	token := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	token2 := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	to := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	// the abi is either the json array or a string of it in the verified-contracts file
+	abiString, err := json.Marshal(erc20TransferABI)
+	require.NoError(t, err)
+	contracts := []ContractABI{
+		{Address: token, Name: "token", ABI: json.RawMessage(erc20TransferABI)},
+		{Address: token2, ABI: abiString},
+	}
+	bz, err := json.Marshal(contracts)
+	require.NoError(t, err)
+	dir, err := ioutil.TempDir("", "abiregistry")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "contracts.json")
+	require.NoError(t, ioutil.WriteFile(file, bz, 0600))
+
+	r, err := NewRegistry(file)
+	require.NoError(t, err)
+	list := r.List()
+	require.Equal(t, 2, len(list))
+	require.Equal(t, token, list[0].Address)
+	require.Equal(t, "token", list[0].Name)
+	require.JSONEq(t, erc20TransferABI, string(list[1].ABI))
+
+	parsed, err := evmtypes.NewABI(erc20TransferABI)
+	require.NoError(t, err)
+	input, err := parsed.Pack("transfer", to, big.NewInt(100))
+	require.NoError(t, err)
+	expected := &evmtypes.DecodedCallData{
+		Method:    "transfer",
+		Signature: "transfer(address,uint256)",
+		Args: []evmtypes.DecodedArg{
+			{Name: "to", Type: "address", Value: to.Hex()},
+			{Name: "amount", Type: "uint256", Value: "100"},
+		},
+	}
+	require.Equal(t, expected, r.DecodeCallData(token, input))
+	// the contract isn't registered, or the calldata doesn't match the abi
+	require.Nil(t, r.DecodeCallData(to, input))
+	require.Nil(t, r.DecodeCallData(token, []byte{0x1, 0x2, 0x3, 0x4}))
+
+	// the decoded input is added to the call frames of the registered contracts
+	var trace interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{"to":"`+to.Hex()+`","input":"`+hexutil.Encode(input)+`",
+		"calls":[{"to":"`+token.Hex()+`","input":"`+hexutil.Encode(input)+`"}]}`), &trace))
+	r.AnnotateTrace(trace)
+	frame := trace.(map[string]interface{})
+	require.NotContains(t, frame, DecodedInputKey)
+	require.Equal(t, expected, frame["calls"].([]interface{})[0].(map[string]interface{})[DecodedInputKey])
+
+	require.Error(t, r.Register(ContractABI{Address: to, ABI: json.RawMessage(`[{"type":"unknown"}]`)}))
+	require.True(t, r.Remove(token))
+	require.False(t, r.Remove(token))
+	require.Nil(t, r.DecodeCallData(token, input))
+	require.Equal(t, 1, len(r.List()))
+
+	_, err = NewRegistry(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
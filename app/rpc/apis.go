@@ -13,12 +13,16 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+	"github.com/okex/exchain/app/rpc/abiregistry"
 	"github.com/okex/exchain/app/rpc/backend"
+	"github.com/okex/exchain/app/rpc/namespaces/admin"
 	"github.com/okex/exchain/app/rpc/namespaces/debug"
 	"github.com/okex/exchain/app/rpc/namespaces/eth"
 	"github.com/okex/exchain/app/rpc/namespaces/eth/filters"
 	"github.com/okex/exchain/app/rpc/namespaces/net"
+	"github.com/okex/exchain/app/rpc/namespaces/okexchain"
 	"github.com/okex/exchain/app/rpc/namespaces/personal"
+	"github.com/okex/exchain/app/rpc/namespaces/wasm"
 	"github.com/okex/exchain/app/rpc/namespaces/web3"
 	rpctypes "github.com/okex/exchain/app/rpc/types"
 	cosmost "github.com/okex/exchain/libs/cosmos-sdk/store/types"
@@ -26,12 +30,15 @@ import (
 
 // RPC namespaces and API version
 const (
-	Web3Namespace     = "web3"
-	EthNamespace      = "eth"
-	PersonalNamespace = "personal"
-	NetNamespace      = "net"
-	TxpoolNamespace   = "txpool"
-	DebugNamespace    = "debug"
+	Web3Namespace      = "web3"
+	EthNamespace       = "eth"
+	PersonalNamespace  = "personal"
+	NetNamespace       = "net"
+	TxpoolNamespace    = "txpool"
+	DebugNamespace     = "debug"
+	AdminNamespace     = "admin"
+	OkexchainNamespace = "okexchain"
+	WasmNamespace      = "wasm"
 
 	apiVersion = "1.0"
 )
@@ -51,6 +58,8 @@ func GetAPIs(clientCtx context.CLIContext, log log.Logger, keys ...ethsecp256k1.
 	disableAPI := getDisableAPI()
 	ethBackend = backend.New(clientCtx, log, rateLimiters, disableAPI)
 	ethAPI := eth.NewAPI(clientCtx, log, ethBackend, nonceLock, keys...)
+	abiRegistry := getABIRegistry()
+	ethAPI.SetABIRegistry(abiRegistry)
 	if evmtypes.GetEnableBloomFilter() {
 		ethBackend.StartBloomHandlers(evmtypes.BloomBitsBlocks, evmtypes.GetIndexer().GetDB())
 	}
@@ -86,6 +95,18 @@ func GetAPIs(clientCtx context.CLIContext, log log.Logger, keys ...ethsecp256k1.
 			Service:   txpool.NewAPI(clientCtx, log, ethBackend),
 			Public:    true,
 		},
+		{
+			Namespace: OkexchainNamespace,
+			Version:   apiVersion,
+			Service:   okexchain.NewAPI(clientCtx, log, ethBackend, ethAPI),
+			Public:    true,
+		},
+		{
+			Namespace: WasmNamespace,
+			Version:   apiVersion,
+			Service:   wasm.NewAPI(clientCtx, log, ethBackend),
+			Public:    true,
+		},
 	}
 
 	if viper.GetBool(FlagPersonalAPI) {
@@ -101,14 +122,37 @@ func GetAPIs(clientCtx context.CLIContext, log log.Logger, keys ...ethsecp256k1.
 		apis = append(apis, rpc.API{
 			Namespace: DebugNamespace,
 			Version:   apiVersion,
-			Service:   debug.NewAPI(clientCtx, log, ethBackend),
+			Service:   debug.NewAPI(clientCtx, log, ethBackend, abiRegistry),
 			Public:    true,
 		})
 	}
 
+	if viper.GetBool(FlagAdminAPI) {
+		apis = append(apis, rpc.API{
+			Namespace: AdminNamespace,
+			Version:   apiVersion,
+			Service:   admin.NewAPI(log, abiRegistry),
+			Public:    false,
+		})
+	}
+
 	return apis
 }
 
+// getABIRegistry returns the abi registry loaded with the verified-contracts file, it's nil unless the admin api
+// or the file is enabled
+func getABIRegistry() *abiregistry.Registry {
+	file := viper.GetString(FlagABIRegistryFile)
+	if !viper.GetBool(FlagAdminAPI) && file == "" {
+		return nil
+	}
+	abiRegistry, err := abiregistry.NewRegistry(file)
+	if err != nil {
+		panic(err)
+	}
+	return abiRegistry
+}
+
 func getRateLimiter() map[string]*rate.Limiter {
 	rateLimitApi := viper.GetString(FlagRateLimitAPI)
 	rateLimitCount := viper.GetInt(FlagRateLimitCount)
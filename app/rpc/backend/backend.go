@@ -50,6 +50,7 @@ type Backend interface {
 	LatestBlockNumber() (int64, error)
 	HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Header, error)
 	HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
+	BlockBloom(height int64) (ethtypes.Bloom, error)
 	GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (*watcher.Block, error)
 	GetBlockByHash(hash common.Hash, fullTx bool) (*watcher.Block, error)
 
@@ -230,19 +231,31 @@ func (b *EthermintBackend) HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethty
 		return nil, err
 	}
 
-	res, _, err := b.clientCtx.Query(fmt.Sprintf("custom/%s/%s/%d", evmtypes.ModuleName, evmtypes.QueryBloom, resBlock.Block.Height))
+	bloom, err := b.BlockBloom(resBlock.Block.Height)
 	if err != nil {
 		return nil, err
 	}
 
-	var bloomRes evmtypes.QueryBloomFilter
-	b.clientCtx.Codec.MustUnmarshalJSON(res, &bloomRes)
-
 	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header)
-	ethHeader.Bloom = bloomRes.Bloom
+	ethHeader.Bloom = bloom
 	return ethHeader, nil
 }
 
+// BlockBloom returns the bloom filter of the logs of the block, which the evm module keeps for every block. Unlike
+// HeaderByNumber it doesn't query the block.
+func (b *EthermintBackend) BlockBloom(height int64) (ethtypes.Bloom, error) {
+	res, _, err := b.clientCtx.Query(fmt.Sprintf("custom/%s/%s/%d", evmtypes.ModuleName, evmtypes.QueryBloom, height))
+	if err != nil {
+		return ethtypes.Bloom{}, err
+	}
+
+	var bloomRes evmtypes.QueryBloomFilter
+	if err := b.clientCtx.Codec.UnmarshalJSON(res, &bloomRes); err != nil {
+		return ethtypes.Bloom{}, err
+	}
+	return bloomRes.Bloom, nil
+}
+
 // HeaderByHash returns the block header identified by hash.
 func (b *EthermintBackend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error) {
 	res, _, err := b.clientCtx.Query(fmt.Sprintf("custom/%s/%s/%s", evmtypes.ModuleName, evmtypes.QueryHashToHeight, blockHash.Hex()))
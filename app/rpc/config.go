@@ -10,6 +10,7 @@ import (
 	"github.com/okex/exchain/app/crypto/ethsecp256k1"
 	"github.com/okex/exchain/app/crypto/hd"
 	"github.com/okex/exchain/app/rpc/nacos"
+	"github.com/okex/exchain/app/rpc/namespaces/eth"
 	"github.com/okex/exchain/app/rpc/pendingtx"
 	"github.com/okex/exchain/app/rpc/websockets"
 	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
@@ -28,6 +29,8 @@ const (
 	FlagWebsocket             = "wsport"
 	FlagPersonalAPI           = "personal-api"
 	FlagDebugAPI              = "debug-api"
+	FlagAdminAPI              = "admin-api"
+	FlagABIRegistryFile       = "rpc.abi-registry-file"
 	FlagRateLimitAPI          = "rpc.rate-limit-api"
 	FlagRateLimitCount        = "rpc.rate-limit-count"
 	FlagRateLimitBurst        = "rpc.rate-limit-burst"
@@ -90,7 +93,7 @@ func RegisterRoutes(rs *lcd.RestServer) {
 	}
 
 	// Web3 RPC API route
-	rs.Mux.HandleFunc("/", server.ServeHTTP).Methods("POST", "OPTIONS")
+	rs.Mux.Handle("/", eth.WithAuthToken(server)).Methods("POST", "OPTIONS")
 
 	// start websockets server
 	websocketAddr := viper.GetString(FlagWebsocket)
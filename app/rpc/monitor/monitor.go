@@ -6,13 +6,14 @@ import (
 
 	"github.com/go-kit/kit/metrics"
 	"github.com/go-kit/kit/metrics/prometheus"
+	sysmetrics "github.com/okex/exchain/libs/system/metrics"
 	"github.com/okex/exchain/libs/tendermint/libs/log"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/viper"
 )
 
 const (
 	FlagEnableMonitor = "rpc.enable-monitor"
-	MetricsNamespace  = "x"
 	// MetricsSubsystem is a subsystem shared by all metrics exposed by this package.
 	MetricsSubsystem = "rpc"
 
@@ -36,17 +37,22 @@ type Monitor struct {
 	metrics  *RpcMetrics
 }
 
+// Enabled returns whether the rpc metrics are registered, which is enabled by the flag or the app metrics of the node
+func Enabled() bool {
+	return viper.GetBool(FlagEnableMonitor) || sysmetrics.Enabled()
+}
+
 func MakeMonitorMetrics(namespace string) *RpcMetrics {
 
 	return &RpcMetrics{
 		Counter: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
-			Namespace: MetricsNamespace,
+			Namespace: sysmetrics.Namespace(),
 			Subsystem: MetricsSubsystem,
 			Name:      fmt.Sprintf(MetricsCounterNamePattern, namespace, MetricsFieldName),
 			Help:      fmt.Sprintf("Total request number of %s/%s method.", namespace, MetricsFieldName),
 		}, []string{MetricsMethodLabel}),
 		Histogram: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
-			Namespace: MetricsNamespace,
+			Namespace: sysmetrics.Namespace(),
 			Subsystem: MetricsSubsystem,
 			Name:      fmt.Sprintf(MetricsHistogramNamePattern, namespace, MetricsFieldName),
 			Help:      fmt.Sprintf("Request duration of %s/%s method.", namespace, MetricsFieldName),
@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/okex/exchain/app/rpc/abiregistry"
+	"github.com/okex/exchain/app/rpc/monitor"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+)
+
+const (
+	NameSpace = "admin"
+)
+
+// PrivateAdminAPI is the admin_ prefixed set of APIs managing the abi registry of the node.
+type PrivateAdminAPI struct {
+	logger      log.Logger
+	abiRegistry *abiregistry.Registry
+	Metrics     *monitor.RpcMetrics
+}
+
+// NewAPI creates an instance of the admin API.
+func NewAPI(log log.Logger, abiRegistry *abiregistry.Registry) *PrivateAdminAPI {
+	api := &PrivateAdminAPI{
+		logger:      log.With("module", "json-rpc", "namespace", NameSpace),
+		abiRegistry: abiRegistry,
+	}
+	if monitor.Enabled() {
+		api.Metrics = monitor.MakeMonitorMetrics(NameSpace)
+	}
+	return api
+}
+
+// RegisterContractABI registers the abi of a verified contract, so that the calldata of the calls to it is decoded
+// in eth_getDecodedTransaction and debug_traceTransaction.
+func (api *PrivateAdminAPI) RegisterContractABI(contract abiregistry.ContractABI) error {
+	monitor := monitor.GetMonitor("admin_registerContractABI", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("address", contract.Address)
+	return api.abiRegistry.Register(contract)
+}
+
+// RemoveContractABI removes the abi of a contract, it returns false if the contract isn't registered.
+func (api *PrivateAdminAPI) RemoveContractABI(address common.Address) bool {
+	monitor := monitor.GetMonitor("admin_removeContractABI", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("address", address)
+	return api.abiRegistry.Remove(address)
+}
+
+// ContractABIs returns the abis of the registered contracts.
+func (api *PrivateAdminAPI) ContractABIs() []abiregistry.ContractABI {
+	monitor := monitor.GetMonitor("admin_contractABIs", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd()
+	return api.abiRegistry.List()
+}
@@ -5,13 +5,15 @@ import (
 	"fmt"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
-	"github.com/spf13/viper"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	clientcontext "github.com/okex/exchain/libs/cosmos-sdk/client/context"
 
+	"github.com/okex/exchain/app/rpc/abiregistry"
 	"github.com/okex/exchain/app/rpc/backend"
 	"github.com/okex/exchain/app/rpc/monitor"
+	"github.com/okex/exchain/app/utils/statediff"
 	"github.com/okex/exchain/libs/tendermint/libs/log"
 
 	evmtypes "github.com/okex/exchain/x/evm/types"
@@ -23,27 +25,31 @@ const (
 
 // PublicTxPoolAPI offers and API for the transaction pool. It only operates on data that is non confidential.
 type PublicDebugAPI struct {
-	clientCtx clientcontext.CLIContext
-	logger    log.Logger
-	backend   backend.Backend
-	Metrics   *monitor.RpcMetrics
+	clientCtx   clientcontext.CLIContext
+	logger      log.Logger
+	backend     backend.Backend
+	abiRegistry *abiregistry.Registry
+	Metrics     *monitor.RpcMetrics
 }
 
 // NewPublicTxPoolAPI creates a new tx pool service that gives information about the transaction pool.
-func NewAPI(clientCtx clientcontext.CLIContext, log log.Logger, backend backend.Backend) *PublicDebugAPI {
+func NewAPI(clientCtx clientcontext.CLIContext, log log.Logger, backend backend.Backend,
+	abiRegistry *abiregistry.Registry) *PublicDebugAPI {
 	api := &PublicDebugAPI{
-		clientCtx: clientCtx,
-		backend:   backend,
-		logger:    log.With("module", "json-rpc", "namespace", "debug"),
+		clientCtx:   clientCtx,
+		backend:     backend,
+		abiRegistry: abiRegistry,
+		logger:      log.With("module", "json-rpc", "namespace", "debug"),
 	}
-	if viper.GetBool(monitor.FlagEnableMonitor) {
+	if monitor.Enabled() {
 		api.Metrics = monitor.MakeMonitorMetrics(NameSpace)
 	}
 	return api
 }
 
 // TraceTransaction returns the structured logs created during the execution of EVM
-// and returns them as a JSON object.
+// and returns them as a JSON object. The call frames to the contracts registered in the abi registry are annotated
+// with the decoded input.
 func (api *PublicDebugAPI) TraceTransaction(txHash common.Hash, config evmtypes.TraceConfig) (interface{}, error) {
 	monitor := monitor.GetMonitor("debug_traceTransaction", api.logger, api.Metrics).OnBegin()
 	defer monitor.OnEnd()
@@ -80,6 +86,36 @@ func (api *PublicDebugAPI) TraceTransaction(txHash common.Hash, config evmtypes.
 	if err := json.Unmarshal(res.Data, &decodedResult); err != nil {
 		return nil, err
 	}
+	if api.abiRegistry != nil {
+		api.abiRegistry.AnnotateTrace(decodedResult)
+	}
 
 	return decodedResult, nil
 }
+
+// StateDiffArgs are the options of the state diff, all the stores are diffed if Stores is empty, and the values are
+// decoded unless Raw is set.
+type StateDiffArgs struct {
+	Stores []string      `json:"stores"`
+	Prefix hexutil.Bytes `json:"prefix"`
+	Limit  int           `json:"limit"`
+	Raw    bool          `json:"raw"`
+}
+
+// StateDiff returns the keys of the stores changed between the heights from and to, with the accounts, the evm
+// contract storage and the wasm contract storage decoded.
+func (api *PublicDebugAPI) StateDiff(from, to hexutil.Uint64, args *StateDiffArgs) (*statediff.Result, error) {
+	monitor := monitor.GetMonitor("debug_stateDiff", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd()
+	if args == nil {
+		args = &StateDiffArgs{}
+	}
+	queryParam := sdk.QueryStateDiff{
+		From:   int64(from),
+		To:     int64(to),
+		Stores: args.Stores,
+		Prefix: args.Prefix,
+		Limit:  args.Limit,
+	}
+	return statediff.Query(api.clientCtx, queryParam, !args.Raw)
+}
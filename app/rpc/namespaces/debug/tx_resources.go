@@ -0,0 +1,79 @@
+package debug
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/okex/exchain/app/rpc/monitor"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+var (
+	// descriptors of the gas consumed by the evm
+	evmGasDescriptors = []string{
+		"Intrinsic gas match",
+		"EVM execution consumption",
+	}
+	// descriptor prefixes of the gas consumed by the wasm vm
+	wasmGasDescriptors = []string{
+		"Compiling WASM Bytecode",
+		"Loading CosmWasm module",
+		"wasm contract",
+		"Custom contract event attributes",
+		"From limited Sub-Message",
+		"Sub-Message OutOfGas panic",
+		"contract sub-query",
+	}
+)
+
+// TxResources is the resource consumption of a tx, with the gas consumed by the msgs broken down into the gas of the
+// evm, the wasm vm and the others.
+type TxResources struct {
+	*sdk.TxResources
+	EvmGas   uint64 `json:"evm_gas"`
+	WasmGas  uint64 `json:"wasm_gas"`
+	OtherGas uint64 `json:"other_gas"`
+}
+
+// TxResources returns the resource consumption of a recently delivered tx, which is only recorded by the nodes
+// started with a positive --tx-resources-cache-size.
+func (api *PublicDebugAPI) TxResources(txHash common.Hash) (*TxResources, error) {
+	monitor := monitor.GetMonitor("debug_txResources", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd()
+	res, _, err := api.clientCtx.QueryWithData("app/txresources", txHash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	var resources sdk.TxResources
+	if err := json.Unmarshal(res, &resources); err != nil {
+		return nil, err
+	}
+	return newTxResources(&resources), nil
+}
+
+func newTxResources(resources *sdk.TxResources) *TxResources {
+	res := &TxResources{TxResources: resources}
+	for descriptor, gas := range resources.MsgGas {
+		switch {
+		case hasPrefix(descriptor, evmGasDescriptors):
+			res.EvmGas += gas
+		case hasPrefix(descriptor, wasmGasDescriptors):
+			res.WasmGas += gas
+		default:
+			res.OtherGas += gas
+		}
+	}
+	return res
+}
+
+func hasPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
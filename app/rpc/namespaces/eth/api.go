@@ -29,6 +29,7 @@ import (
 	"github.com/okex/exchain/app/crypto/ethsecp256k1"
 	"github.com/okex/exchain/app/crypto/hd"
 	"github.com/okex/exchain/app/gasprice"
+	"github.com/okex/exchain/app/rpc/abiregistry"
 	"github.com/okex/exchain/app/rpc/backend"
 	"github.com/okex/exchain/app/rpc/monitor"
 	"github.com/okex/exchain/app/rpc/namespaces/eth/simulation"
@@ -88,6 +89,7 @@ type PublicEthereumAPI struct {
 	callCache          *lru.Cache
 	cdc                *codec.Codec
 	fastQueryThreshold uint64
+	abiRegistry        *abiregistry.Registry
 }
 
 // NewAPI creates an instance of the public ETH Web3 API.
@@ -135,7 +137,7 @@ func NewAPI(
 		go api.txPool.broadcastPeriod(api)
 	}
 
-	if viper.GetBool(monitor.FlagEnableMonitor) {
+	if monitor.Enabled() {
 		api.Metrics = monitor.MakeMonitorMetrics(NameSpace)
 	}
 	return api
@@ -215,12 +217,22 @@ func (api *PublicEthereumAPI) Syncing() (interface{}, error) {
 		return false, nil
 	}
 
+	// the progress is reported by the fast sync of the blockchain reactor
+	startingBlock := status.SyncInfo.StartingBlockHeight
+	if startingBlock == 0 {
+		startingBlock = status.SyncInfo.EarliestBlockHeight
+	}
+	highestBlock := status.SyncInfo.HighestBlockHeight
+	if highestBlock < status.SyncInfo.LatestBlockHeight {
+		highestBlock = status.SyncInfo.LatestBlockHeight
+	}
 	return map[string]interface{}{
-		"startingBlock": hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
+		"startingBlock": hexutil.Uint64(startingBlock),
 		"currentBlock":  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
-		"highestBlock":  hexutil.Uint64(0), // NA
-		// "pulledStates":  nil, // NA
-		// "knownStates":   nil, // NA
+		"highestBlock":  hexutil.Uint64(highestBlock),
+		// there's no state sync, the states are executed block by block
+		"pulledStates": hexutil.Uint64(0),
+		"knownStates":  hexutil.Uint64(0),
 	}, nil
 }
 
@@ -809,6 +821,39 @@ func (api *PublicEthereumAPI) SendRawTransaction(data hexutil.Bytes) (common.Has
 	return common.HexToHash(res.TxHash), nil
 }
 
+// SendRawMetaTransaction relays a meta transaction signed by its sender through the forwarder. The tx calling the
+// forwarder is signed by the sponsor, whose key must be unlocked on the node.
+func (api *PublicEthereumAPI) SendRawMetaTransaction(args rpctypes.MetaTxArgs) (common.Hash, error) {
+	monitor := monitor.GetMonitor("eth_sendRawMetaTransaction", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("args", args)
+
+	mtx := evmtypes.MetaTransaction{
+		From:     args.From,
+		To:       args.To,
+		Gas:      uint64(args.Gas),
+		Nonce:    uint64(args.Nonce),
+		Deadline: uint64(args.Deadline),
+		Data:     args.Data,
+	}
+	// the meta transaction with a wrong signature is rejected before the sponsor signs for it
+	if err := mtx.VerifySignature(api.chainIDEpoch, args.Signature); err != nil {
+		return common.Hash{}, err
+	}
+	input, err := evmtypes.GetForwarderInput(mtx, args.Signature)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	data, forwarder := hexutil.Bytes(input), evmtypes.ForwarderAddress
+	return api.SendTransaction(rpctypes.SendTxArgs{
+		From:     &args.Sponsor,
+		To:       &forwarder,
+		Gas:      args.SponsorGas,
+		GasPrice: args.SponsorGasPrice,
+		Data:     &data,
+	})
+}
+
 func (api *PublicEthereumAPI) buildKey(args rpctypes.CallArgs) common.Hash {
 	latest, err := api.wrappedBackend.GetLatestBlockNumber()
 	if err != nil {
@@ -1178,6 +1223,34 @@ func (api *PublicEthereumAPI) GetTransactionByHash(hash common.Hash) (*watcher.T
 	return pendingTx, nil
 }
 
+// DecodedTransaction is a transaction with the calldata decoded, if the abi of the contract called is registered
+type DecodedTransaction struct {
+	*watcher.Transaction
+	DecodedInput *evmtypes.DecodedCallData `json:"decodedInput,omitempty"`
+}
+
+// SetABIRegistry sets the abi registry the calldata of the transactions is decoded with
+func (api *PublicEthereumAPI) SetABIRegistry(abiRegistry *abiregistry.Registry) {
+	api.abiRegistry = abiRegistry
+}
+
+// GetDecodedTransaction returns the transaction identified by hash, with the calldata decoded if the abi of the
+// contract called is registered.
+func (api *PublicEthereumAPI) GetDecodedTransaction(hash common.Hash) (*DecodedTransaction, error) {
+	monitor := monitor.GetMonitor("eth_getDecodedTransaction", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("hash", hash)
+	tx, err := api.GetTransactionByHash(hash)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+
+	decoded := &DecodedTransaction{Transaction: tx}
+	if api.abiRegistry != nil && tx.To != nil {
+		decoded.DecodedInput = api.abiRegistry.DecodeCallData(*tx.To, tx.Input)
+	}
+	return decoded, nil
+}
+
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
 func (api *PublicEthereumAPI) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*watcher.Transaction, error) {
 	monitor := monitor.GetMonitor("eth_getTransactionByBlockHashAndIndex", api.logger, api.Metrics).OnBegin()
@@ -1643,8 +1716,8 @@ func (api *PublicEthereumAPI) accountNonce(
 	clientCtx clientcontext.CLIContext, address common.Address, pending bool, useWatchBackend bool,
 ) (uint64, error) {
 	if pending {
-		// nonce is continuous in mempool txs
-		pendingNonce, ok := api.backend.GetPendingNonce(address.String())
+		// nonce is continuous in mempool txs, the cosmos and evm txs of the account are keyed by its checksummed hex address
+		pendingNonce, ok := api.backend.GetPendingNonce(evmtypes.EthAddressStringer(address).String())
 		if ok {
 			return pendingNonce + 1, nil
 		}
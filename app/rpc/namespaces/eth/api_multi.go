@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/okex/exchain/app/rpc/monitor"
 	rpctypes "github.com/okex/exchain/app/rpc/types"
@@ -24,6 +25,9 @@ import (
 
 const (
 	FlagEnableMultiCall = "rpc.enable-multi-call"
+
+	maxBalanceHistoryBlocks = 100
+	maxTransfersLimit       = 100
 )
 
 // GetBalanceBatch returns the provided account's balance up to the provided block number.
@@ -109,6 +113,115 @@ func (api *PublicEthereumAPI) GetBalanceBatch(addresses []common.Address, blockN
 	return balances, nil
 }
 
+// GetBalanceHistory returns the balances of the provided account at each of the blocks given by their heights or
+// timestamps, ordered by height. Each block is queried once from the versioned state, even if it's given several times.
+func (api *PublicEthereumAPI) GetBalanceHistory(address common.Address, args rpctypes.BalanceHistoryArgs) ([]rpctypes.BalanceAtBlock, error) {
+	if !viper.GetBool(FlagEnableMultiCall) {
+		return nil, errors.New("the method is not allowed")
+	}
+
+	monitor := monitor.GetMonitor("eth_getBalanceHistory", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("address", address, "args", args)
+
+	if len(args.Heights)+len(args.Timestamps) > maxBalanceHistoryBlocks {
+		return nil, fmt.Errorf("too many blocks, at most %d blocks can be queried", maxBalanceHistoryBlocks)
+	}
+	latest, err := api.backend.LatestBlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	heights := make(map[int64]struct{})
+	for _, blockNum := range args.Heights {
+		height := blockNum.Int64()
+		if blockNum == rpctypes.LatestBlockNumber || blockNum == rpctypes.PendingBlockNumber {
+			height = latest
+		}
+		if height <= 0 || height > latest {
+			return nil, fmt.Errorf("invalid block number %d, latest block number is %d", height, latest)
+		}
+		heights[height] = struct{}{}
+	}
+	if len(args.Timestamps) > 0 {
+		status, err := api.clientCtx.Client.Status()
+		if err != nil {
+			return nil, err
+		}
+		earliest := status.SyncInfo.EarliestBlockHeight
+		for _, ts := range args.Timestamps {
+			height, err := searchHeightByTime(earliest, latest, int64(ts), api.blockTime)
+			if err != nil {
+				return nil, err
+			}
+			if height < earliest {
+				return nil, fmt.Errorf("no block found at or before timestamp %d", ts)
+			}
+			heights[height] = struct{}{}
+		}
+	}
+
+	sortedHeights := make([]int64, 0, len(heights))
+	for height := range heights {
+		sortedHeights = append(sortedHeights, height)
+	}
+	sort.Slice(sortedHeights, func(i, j int) bool { return sortedHeights[i] < sortedHeights[j] })
+
+	bs, err := api.clientCtx.Codec.MarshalJSON(auth.NewQueryAccountParams(address.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	balances := make([]rpctypes.BalanceAtBlock, 0, len(sortedHeights))
+	for _, height := range sortedHeights {
+		blockTime, err := api.blockTime(height)
+		if err != nil {
+			return nil, err
+		}
+		coins := sdk.SysCoins{}
+		res, _, err := api.clientCtx.WithHeight(height).QueryWithData(fmt.Sprintf("custom/%s/%s", auth.QuerierRoute, auth.QueryAccount), bs)
+		if err == nil {
+			var account authexported.Account
+			if err := api.clientCtx.Codec.UnmarshalJSON(res, &account); err != nil {
+				return nil, err
+			}
+			coins = account.GetCoins()
+		} else if !isAccountNotExistErr(err) {
+			return nil, err
+		}
+		balances = append(balances, rpctypes.BalanceAtBlock{
+			Number:    hexutil.Uint64(height),
+			Timestamp: hexutil.Uint64(blockTime),
+			Balance:   (*hexutil.Big)(coins.AmountOf(sdk.DefaultBondDenom).BigInt()),
+			Coins:     coins,
+		})
+	}
+	return balances, nil
+}
+
+// GetTransfersByAddress returns the native, erc20 and cw20 transfers sent or received by the account from the latest
+// one, skipping the offset latest transfers. It requires the transfer store.
+func (api *PublicEthereumAPI) GetTransfersByAddress(address common.Address, offset, limit hexutil.Uint) ([]*watcher.Transfer, error) {
+	monitor := monitor.GetMonitor("eth_getTransfersByAddress", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("address", address, "offset", offset, "limit", limit)
+
+	store := watcher.InstanceOfTransferStore()
+	if store == nil {
+		return nil, errors.New("the transfer store is not enabled")
+	}
+	if limit > maxTransfersLimit {
+		return nil, fmt.Errorf("limit %d exceeds the max limit %d", limit, maxTransfersLimit)
+	}
+	return store.GetTransfers(address, int(offset), int(limit))
+}
+
+// blockTime returns the time of the block at the height in unix seconds
+func (api *PublicEthereumAPI) blockTime(height int64) (int64, error) {
+	block, err := api.backend.Block(&height)
+	if err != nil {
+		return 0, err
+	}
+	return block.Block.Time.Unix(), nil
+}
+
 // MultiCall performs multiple raw contract call.
 func (api *PublicEthereumAPI) MultiCall(args []rpctypes.CallArgs, blockNr rpctypes.BlockNumber, _ *[]evmtypes.StateOverrides) ([]hexutil.Bytes, error) {
 	if !viper.GetBool(FlagEnableMultiCall) {
@@ -0,0 +1,104 @@
+package eth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/viper"
+
+	"github.com/okex/exchain/app/rpc/monitor"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	authclient "github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+const (
+	// FlagPrivateTxTokens are the comma separated tokens authorizing eth_sendPrivateTransaction,
+	// the method is disabled without any token
+	FlagPrivateTxTokens = "rpc.private-tx-tokens"
+)
+
+type authTokenKey struct{}
+
+// privateTxBroadcaster is implemented by the clients of the node able to submit the txs without gossiping them
+type privateTxBroadcaster interface {
+	BroadcastPrivateTxSync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error)
+}
+
+// WithAuthToken passes the bearer token of the Authorization header of the requests to the methods of the handler
+func WithAuthToken(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if token := strings.TrimPrefix(auth, "Bearer "); token != auth && token != "" {
+			r = r.WithContext(context.WithValue(r.Context(), authTokenKey{}, token))
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// isPrivateTxAuthorized returns whether the request carries one of the tokens of FlagPrivateTxTokens
+func isPrivateTxAuthorized(ctx context.Context) bool {
+	token, ok := ctx.Value(authTokenKey{}).(string)
+	if !ok {
+		return false
+	}
+	authorized := false
+	for _, t := range strings.Split(viper.GetString(FlagPrivateTxTokens), ",") {
+		t = strings.TrimSpace(t)
+		if t != "" && subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			authorized = true
+		}
+	}
+	return authorized
+}
+
+// SendPrivateTransaction sends a raw Ethereum transaction which is held in the mempool of the node without being
+// gossiped to its peers, so it's only included in the blocks proposed by the node. The request must be authorized
+// by one of the tokens of the node.
+func (api *PublicEthereumAPI) SendPrivateTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error) {
+	if !isPrivateTxAuthorized(ctx) {
+		return common.Hash{}, errors.New("the method is not allowed")
+	}
+	monitor := monitor.GetMonitor("eth_sendPrivateTransaction", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("data", data)
+
+	broadcaster, ok := api.clientCtx.Client.(privateTxBroadcaster)
+	if !ok {
+		return common.Hash{}, errors.New("the node doesn't support the private transactions")
+	}
+	height, err := api.BlockNumber()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	tx := new(evmtypes.MsgEthereumTx)
+	if err := authtypes.EthereumTxDecode(data, tx); err != nil {
+		return common.Hash{}, err
+	}
+	txBytes := []byte(data)
+	if !tmtypes.HigherThanVenus(int64(height)) {
+		txBytes, err = authclient.GetTxEncoder(api.clientCtx.Codec)(tx)
+		if err != nil {
+			return common.Hash{}, err
+		}
+	}
+
+	res, err := broadcaster.BroadcastPrivateTxSync(txBytes)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	txRes := sdk.NewResponseFormatBroadcastTx(res)
+	if txRes.Code != abci.CodeTypeOK {
+		return CheckError(txRes)
+	}
+	return common.HexToHash(txRes.TxHash), nil
+}
@@ -0,0 +1,41 @@
+package eth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivateTxAuthorization(t *testing.T) {
+	viper.Set(FlagPrivateTxTokens, "token-1, token-2")
+	defer viper.Set(FlagPrivateTxTokens, "")
+
+	authorized := func(header string) bool {
+		var ctx context.Context
+		handler := WithAuthToken(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx = r.Context()
+		}))
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+		return isPrivateTxAuthorized(ctx)
+	}
+
+	require.True(t, authorized("Bearer token-1"))
+	require.True(t, authorized("Bearer token-2"))
+	require.False(t, authorized(""))
+	require.False(t, authorized("token-1"))
+	require.False(t, authorized("Bearer token-3"))
+	require.False(t, authorized("Bearer "))
+
+	// the method is disabled without any token
+	viper.Set(FlagPrivateTxTokens, "")
+	require.False(t, authorized("Bearer token-1"))
+	require.False(t, authorized("Bearer "))
+}
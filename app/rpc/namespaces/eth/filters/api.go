@@ -12,7 +12,6 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
-	"github.com/spf13/viper"
 
 	"github.com/okex/exchain/app/rpc/monitor"
 	rpctypes "github.com/okex/exchain/app/rpc/types"
@@ -37,6 +36,8 @@ type Backend interface {
 	GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (*watcher.Block, error)
 	HeaderByNumber(blockNr rpctypes.BlockNumber) (*ethtypes.Header, error)
 	HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
+	LatestBlockNumber() (int64, error)
+	BlockBloom(height int64) (ethtypes.Bloom, error)
 	GetLogs(height int64) ([][]*ethtypes.Log, error)
 
 	GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, error)
@@ -53,6 +54,10 @@ type Backend interface {
 // consider a filter inactive if it has not been polled for within deadline
 var deadline = 5 * time.Minute
 
+// an inactive logs filter is resumed under its id if it is polled again within retention, e.g. by a client
+// reconnecting to the node
+var retention = time.Hour
+
 // filter is a helper struct that holds meta information over the filter type
 // and associated subscription in the event system.
 type filter struct {
@@ -62,6 +67,15 @@ type filter struct {
 	crit     filters.FilterCriteria
 	logs     []*ethtypes.Log
 	s        *Subscription // associated subscription in event system
+	height   int64         // height of the latest block whose logs are added to the filter
+	polled   int64         // height of the latest block whose logs are returned by the last poll
+}
+
+// inactiveFilter is an inactive logs filter kept to be resumed within retention
+type inactiveFilter struct {
+	crit    filters.FilterCriteria
+	polled  int64
+	removal time.Time
 }
 
 // PublicFilterAPI offers support to create and manage filters. This will allow external clients to retrieve various
@@ -72,6 +86,7 @@ type PublicFilterAPI struct {
 	events    *EventSystem
 	filtersMu sync.Mutex
 	filters   map[rpc.ID]*filter
+	inactive  map[rpc.ID]*inactiveFilter
 	logger    log.Logger
 	Metrics   *monitor.RpcMetrics
 }
@@ -88,11 +103,12 @@ func NewAPI(clientCtx clientcontext.CLIContext, log log.Logger, backend Backend)
 		clientCtx: clientCtx,
 		backend:   backend,
 		filters:   make(map[rpc.ID]*filter),
+		inactive:  make(map[rpc.ID]*inactiveFilter),
 		events:    NewEventSystem(clientCtx.Client),
 		logger:    log.With("module", "json-rpc", "namespace", NameSpace),
 	}
 
-	if viper.GetBool(monitor.FlagEnableMonitor) {
+	if monitor.Enabled() {
 		api.Metrics = monitor.MakeMonitorMetrics(NameSpace)
 	}
 
@@ -102,6 +118,7 @@ func NewAPI(clientCtx clientcontext.CLIContext, log log.Logger, backend Backend)
 }
 
 // timeoutLoop runs every 5 minutes and deletes filters that have not been recently used.
+// The logs filters are kept inactive to be resumed within retention.
 // Tt is started when the api is created.
 func (api *PublicFilterAPI) timeoutLoop() {
 	ticker := time.NewTicker(deadline)
@@ -115,10 +132,18 @@ func (api *PublicFilterAPI) timeoutLoop() {
 			case <-f.deadline.C:
 				f.s.Unsubscribe(api.events)
 				delete(api.filters, id)
+				if f.typ == filters.LogsSubscription {
+					api.inactive[id] = &inactiveFilter{crit: f.crit, polled: f.polled, removal: time.Now().Add(retention)}
+				}
 			default:
 				continue
 			}
 		}
+		for id, f := range api.inactive {
+			if time.Now().After(f.removal) {
+				delete(api.inactive, id)
+			}
+		}
 		api.filtersMu.Unlock()
 	}
 }
@@ -319,6 +344,7 @@ func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, er
 }
 
 // Logs creates a subscription that fires for all new log that match the given filter criteria.
+// The blocks are final once committed, so no log is ever notified with the removed property set to true.
 func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteria) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
@@ -388,8 +414,12 @@ func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteri
 // Default criteria for the from and to block are "latest".
 // Using "latest" as block number will return logs for mined blocks.
 // Using "pending" as block number returns logs for not yet mined (pending) blocks.
-// In case logs are removed (chain reorg) previously returned logs are returned
-// again but with the removed property set to true.
+// The blocks are final once committed and never reorganized, so no log is ever
+// returned again with the removed property set to true.
+//
+// A filter that is not polled within the deadline becomes inactive. If it is
+// polled again within the retention, e.g. after the client reconnects, it is
+// resumed under the same id and returns the logs since it was last polled.
 //
 // In case "fromBlock" > "toBlock" an error is returned.
 //
@@ -404,20 +434,28 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 	if rateLimiter != nil && !rateLimiter.Allow() {
 		return rpc.ID(""), ErrServerBusy
 	}
-	var (
-		filterID = rpc.ID("")
-		err      error
-	)
+	height, err := api.backend.LatestBlockNumber()
+	if err != nil {
+		return rpc.ID(""), err
+	}
+	return api.installLogsFilter(rpc.ID(""), criteria, height)
+}
 
+// installLogsFilter installs a logs filter under id, or under the id of its subscription if id is empty. The logs of
+// the blocks up to height are not added to the filter.
+func (api *PublicFilterAPI) installLogsFilter(id rpc.ID, criteria filters.FilterCriteria, height int64) (rpc.ID, error) {
 	logsSub, cancelSubs, err := api.events.SubscribeLogs(criteria)
 	if err != nil {
 		return rpc.ID(""), err
 	}
 
-	filterID = logsSub.ID()
+	filterID := id
+	if filterID == "" {
+		filterID = logsSub.ID()
+	}
 
 	api.filtersMu.Lock()
-	api.filters[filterID] = &filter{typ: filters.LogsSubscription, crit: criteria, deadline: time.NewTimer(deadline), logs: make([]*ethtypes.Log, 0), s: logsSub}
+	api.filters[filterID] = &filter{typ: filters.LogsSubscription, crit: criteria, deadline: time.NewTimer(deadline), logs: make([]*ethtypes.Log, 0), s: logsSub, height: height, polled: height}
 	api.filtersMu.Unlock()
 
 	go func(eventCh <-chan coretypes.ResultEvent) {
@@ -431,6 +469,9 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 					err = fmt.Errorf("invalid event data %T, expected EventDataTx", event.Data)
 					return
 				}
+				if dataTx.Height <= height {
+					continue
+				}
 
 				var resultData evmtypes.ResultData
 				resultData, err = evmtypes.DecodeResultData(dataTx.TxResult.Result.Data)
@@ -441,20 +482,79 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 				logs := FilterLogs(resultData.Logs, criteria.FromBlock, criteria.ToBlock, criteria.Addresses, criteria.Topics)
 
 				api.filtersMu.Lock()
-				if f, found := api.filters[filterID]; found {
+				if f, found := api.filters[filterID]; found && f.s == logsSub {
 					f.logs = append(f.logs, logs...)
+					f.height = dataTx.Height
 				}
 				api.filtersMu.Unlock()
 			case <-logsSub.Err():
 				api.filtersMu.Lock()
-				delete(api.filters, filterID)
+				// the filter may be resumed under the id with another subscription
+				if f, found := api.filters[filterID]; found && f.s == logsSub {
+					delete(api.filters, filterID)
+				}
 				api.filtersMu.Unlock()
 				return
 			}
 		}
 	}(logsSub.eventCh)
 
-	return filterID, err
+	return filterID, nil
+}
+
+// resumeLogsFilter resumes the inactive logs filter under its id, with the logs of the blocks since the filter was
+// last polled added to it. It returns false if the filter is not inactive.
+func (api *PublicFilterAPI) resumeLogsFilter(ctx context.Context, id rpc.ID) (bool, error) {
+	api.filtersMu.Lock()
+	f, found := api.inactive[id]
+	delete(api.inactive, id)
+	api.filtersMu.Unlock()
+	if !found {
+		return false, nil
+	}
+
+	head, err := api.backend.LatestBlockNumber()
+	if err != nil {
+		api.filtersMu.Lock()
+		api.inactive[id] = f
+		api.filtersMu.Unlock()
+		return true, err
+	}
+	if _, err := api.installLogsFilter(id, f.crit, head); err != nil {
+		api.filtersMu.Lock()
+		api.inactive[id] = f
+		api.filtersMu.Unlock()
+		return true, err
+	}
+
+	begin, end := f.polled+1, head
+	if f.crit.ToBlock != nil && f.crit.ToBlock.Int64() >= 0 && f.crit.ToBlock.Int64() < end {
+		end = f.crit.ToBlock.Int64()
+	}
+	if f.crit.FromBlock != nil && f.crit.FromBlock.Int64() > begin {
+		begin = f.crit.FromBlock.Int64()
+	}
+	if begin > end {
+		return true, nil
+	}
+	logs, err := NewRangeFilter(api.backend, begin, end, f.crit.Addresses, f.crit.Topics).Logs(ctx)
+
+	api.filtersMu.Lock()
+	defer api.filtersMu.Unlock()
+	resumed, ok := api.filters[id]
+	if err != nil {
+		// keep the filter inactive to retry resuming it
+		if ok {
+			delete(api.filters, id)
+			resumed.s.Unsubscribe(api.events)
+		}
+		api.inactive[id] = f
+		return true, err
+	}
+	if ok {
+		resumed.logs = append(logs, resumed.logs...)
+	}
+	return true, nil
 }
 
 // GetLogs returns logs matching the given argument that are stored within the state.
@@ -508,10 +608,12 @@ func (api *PublicFilterAPI) UninstallFilter(id rpc.ID) bool {
 	if found {
 		delete(api.filters, id)
 	}
+	_, inactive := api.inactive[id]
+	delete(api.inactive, id)
 	api.filtersMu.Unlock()
 
 	if !found {
-		return false
+		return inactive
 	}
 	f.s.Unsubscribe(api.events)
 	return true
@@ -527,6 +629,12 @@ func (api *PublicFilterAPI) GetFilterLogs(ctx context.Context, id rpc.ID) ([]*et
 	api.filtersMu.Unlock()
 
 	if !found {
+		if resumed, err := api.resumeLogsFilter(ctx, id); resumed {
+			if err != nil {
+				return nil, err
+			}
+			return api.GetFilterLogs(ctx, id)
+		}
 		return returnLogs(nil), fmt.Errorf("filter %s not found", id)
 	}
 
@@ -576,6 +684,10 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 	if rateLimiter != nil && !rateLimiter.Allow() {
 		return nil, ErrServerBusy
 	}
+	if resumed, err := api.resumeLogsFilter(context.Background(), id); resumed && err != nil {
+		return nil, err
+	}
+
 	api.filtersMu.Lock()
 	defer api.filtersMu.Unlock()
 
@@ -600,6 +712,7 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 		logs := make([]*ethtypes.Log, len(f.logs))
 		copy(logs, f.logs)
 		f.logs = []*ethtypes.Log{}
+		f.polled = f.height
 		return returnLogs(logs), nil
 	default:
 		return nil, fmt.Errorf("invalid filter %s type %d", id, f.typ)
@@ -92,7 +92,7 @@ func (f *Filter) Logs(ctx context.Context) ([]*ethtypes.Log, error) {
 		if header == nil {
 			return nil, fmt.Errorf("unknown block header %s", f.criteria.BlockHash.String())
 		}
-		return f.blockLogs(header)
+		return f.blockLogs(header.Number.Int64(), header.Bloom)
 	}
 
 	// Figure out the limits of the filter range
@@ -149,12 +149,12 @@ func (f *Filter) Logs(ctx context.Context) ([]*ethtypes.Log, error) {
 	return logs, err
 }
 
-// blockLogs returns the logs matching the filter criteria within a single block.
-func (f *Filter) blockLogs(header *ethtypes.Header) ([]*ethtypes.Log, error) {
-	if !bloomFilter(header.Bloom, f.criteria.Addresses, f.criteria.Topics) {
+// blockLogs returns the logs matching the filter criteria within a single block. The logs of the block are only
+// retrieved if its bloom matches.
+func (f *Filter) blockLogs(height int64, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
+	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
 		return []*ethtypes.Log{}, nil
 	}
-	height := header.Number.Int64()
 	logsList, err := f.backend.GetLogs(height)
 	if err != nil {
 		return []*ethtypes.Log{}, err
@@ -243,7 +243,8 @@ func (f *Filter) indexedLogs(ctx context.Context, end uint64) ([]*ethtypes.Log,
 }
 
 // unindexedLogs returns the logs matching the filter criteria based on raw block
-// iteration and bloom matching.
+// iteration and bloom matching. Only the bloom of a block is queried to skip it if
+// it doesn't match.
 func (f *Filter) unindexedLogs(ctx context.Context, end uint64) ([]*ethtypes.Log, error) {
 	var logs []*ethtypes.Log
 	begin := f.criteria.FromBlock.Int64()
@@ -257,11 +258,11 @@ func (f *Filter) unindexedLogs(ctx context.Context, end uint64) ([]*ethtypes.Log
 		case <-ctx.Done():
 			return nil, backend.ErrTimeout
 		default:
-			header, err := f.backend.HeaderByNumber(rpctypes.BlockNumber(begin))
-			if header == nil || err != nil {
+			bloom, err := f.backend.BlockBloom(begin)
+			if err != nil {
 				return logs, err
 			}
-			found, err := f.blockLogs(header)
+			found, err := f.blockLogs(begin, bloom)
 			if err != nil {
 				return logs, err
 			}
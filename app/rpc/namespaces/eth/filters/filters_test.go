@@ -0,0 +1,59 @@
+package filters
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/stretchr/testify/require"
+)
+
+// bloomBackend serves the blooms and logs of the blocks, recording the blocks whose logs are retrieved
+type bloomBackend struct {
+	Backend
+	logs      map[int64][]*ethtypes.Log
+	retrieved []int64
+}
+
+func (b *bloomBackend) BlockBloom(height int64) (ethtypes.Bloom, error) {
+	return ethtypes.BytesToBloom(ethtypes.LogsBloom(b.logs[height])), nil
+}
+
+func (b *bloomBackend) GetLogs(height int64) ([][]*ethtypes.Log, error) {
+	b.retrieved = append(b.retrieved, height)
+	return [][]*ethtypes.Log{b.logs[height]}, nil
+}
+
+func (b *bloomBackend) LogsLimit() int { return 0 }
+
+func (b *bloomBackend) LogsTimeout() time.Duration { return time.Minute }
+
+func TestUnindexedLogs(t *testing.T) {
+	token := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	transfer := common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	backend := &bloomBackend{logs: map[int64][]*ethtypes.Log{
+		3: {{Address: other, Topics: []common.Hash{transfer}, BlockNumber: 3}},
+		5: {{Address: token, Topics: []common.Hash{transfer}, BlockNumber: 5}},
+		8: {
+			{Address: other, BlockNumber: 8},
+			{Address: token, Topics: []common.Hash{transfer}, BlockNumber: 8},
+		},
+	}}
+
+	f := newFilter(backend, filters.FilterCriteria{
+		FromBlock: big.NewInt(2),
+		ToBlock:   big.NewInt(10),
+		Addresses: []common.Address{token},
+		Topics:    [][]common.Hash{{transfer}},
+	}, nil)
+	logs, err := f.unindexedLogs(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, []*ethtypes.Log{backend.logs[5][0], backend.logs[8][1]}, logs)
+	// the logs of the blocks whose bloom doesn't match aren't retrieved
+	require.Equal(t, []int64{5, 8}, backend.retrieved)
+}
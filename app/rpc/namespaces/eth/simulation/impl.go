@@ -134,6 +134,19 @@ func (p SubspaceProxy) GetParamSet(ctx sdk.Context, ps params.ParamSet) {
 
 }
 
+// Has returns false, so that the params absent from the watcher take their default values
+func (p SubspaceProxy) Has(ctx sdk.Context, key []byte) bool {
+	return false
+}
+
+func (p SubspaceProxy) Get(ctx sdk.Context, key []byte, ptr interface{}) {
+
+}
+
+func (p SubspaceProxy) Set(ctx sdk.Context, key []byte, value interface{}) {
+
+}
+
 func (p SubspaceProxy) RegisterSignal(handler func()) {
 
 }
@@ -174,6 +187,10 @@ func (b BankKeeperProxy) BlacklistedAddr(addr sdk.AccAddress) bool {
 	return b.blacklistedAddrs[addr.String()]
 }
 
+func (b BankKeeperProxy) ApplySendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	return toAddr, nil
+}
+
 type StakingKeeperProxy struct {
 }
 
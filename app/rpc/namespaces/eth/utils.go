@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -33,7 +34,6 @@ const (
 	RPCEthGetBlockByHash = "eth_getBlockByHash"
 
 	RPCUnknowErr = "unknow"
-	RPCNullData  = "null"
 )
 
 //gasPrice: to get "minimum-gas-prices" config or to get ethermint.DefaultGasPrice
@@ -84,28 +84,39 @@ func parseCosmosError(err error) (*cosmosError, bool) {
 	return &realErr, true
 }
 
-type wrappedEthError struct {
-	Wrap ethDataError `json:"0x00000000000000000000000000000000"`
-}
-
-type ethDataError struct {
-	Error          string `json:"error"`
-	ProgramCounter int    `json:"program_counter"`
-	Reason         string `json:"reason"`
-	Ret            string `json:"return"`
+// ErrorData is the structured data of the errors returned by the api, so that the clients can branch on the failures
+// without parsing the messages
+type ErrorData struct {
+	Message string `json:"message"`
+	// Reason is decoded from the return data of Error(string)
+	Reason    string `json:"reason,omitempty"`
+	Codespace string `json:"codespace,omitempty"`
+	ABCICode  uint32 `json:"abciCode,omitempty"`
 }
 
 type DataError struct {
-	code int         `json:"code"`
-	Msg  string      `json:"msg"`
-	data interface{} `json:"data,omitempty"`
+	code int       `json:"code"`
+	Msg  string    `json:"msg"`
+	data ErrorData `json:"data,omitempty"`
+	// revertData is the hex encoded return data of the reverted execution
+	revertData string
 }
 
 func (d DataError) Error() string {
 	return d.Msg
 }
 
+// ErrorData returns the revert data as the error data, as the clients decoding the custom errors of the contracts
+// expect it, and the structured data of the errors without the revert data
 func (d DataError) ErrorData() interface{} {
+	if d.revertData != "" {
+		return d.revertData
+	}
+	return d.data
+}
+
+// Details returns the structured data of the error, including the one of the reverted executions
+func (d DataError) Details() ErrorData {
 	return d.data
 }
 
@@ -113,66 +124,58 @@ func (d DataError) ErrorCode() int {
 	return d.code
 }
 
-func newDataError(revert string, data string) *wrappedEthError {
-	return &wrappedEthError{
-		Wrap: ethDataError{
-			Error:          "revert",
-			ProgramCounter: 0,
-			Reason:         revert,
-			Ret:            data,
-		}}
+func newDataError(code int, msg string, cosmosErr *cosmosError) DataError {
+	e := DataError{
+		code: code,
+		Msg:  msg,
+		data: ErrorData{Message: msg},
+	}
+	if cosmosErr != nil {
+		e.data.Codespace = cosmosErr.Codespace
+		e.data.ABCICode = uint32(cosmosErr.Code)
+	}
+	return e
 }
 
+// TransformDataError transforms the error of the method to a DataError. The code of a reverted execution is
+// VMExecuteException for eth_call and VMExecuteExceptionInEstimate for eth_estimateGas, the code of the other errors
+// is DefaultEVMErrorCode.
 func TransformDataError(err error, method string) error {
 	realErr, ok := parseCosmosError(err)
 	if !ok {
-		return DataError{
-			code: DefaultEVMErrorCode,
-			Msg:  err.Error(),
-			data: RPCNullData,
-		}
+		return newDataError(DefaultEVMErrorCode, err.Error(), nil)
 	}
 
 	if method == RPCEthGetBlockByHash {
-		return DataError{
-			code: DefaultEVMErrorCode,
-			Msg:  realErr.Error(),
-			data: RPCNullData,
-		}
+		return newDataError(DefaultEVMErrorCode, realErr.Error(), realErr)
 	}
 	m, retErr := preProcessError(realErr, err.Error())
 	if retErr != nil {
-		return realErr
+		return newDataError(DefaultEVMErrorCode, realErr.Error(), realErr)
 	}
 	//if there have multi error type of EVM, this need a reactor mode to process error
 	revert, f := m[vm.ErrExecutionReverted.Error()]
 	if !f {
 		revert = RPCUnknowErr
 	}
-	data, f := m[types.ErrorHexData]
-	if !f {
-		data = RPCNullData
-	}
+
+	code := DefaultEVMErrorCode
 	switch method {
 	case RPCEthEstimateGas:
-		return DataError{
-			code: VMExecuteExceptionInEstimate,
-			Msg:  revert,
-			data: data,
-		}
+		code = VMExecuteExceptionInEstimate
 	case RPCEthCall:
-		return DataError{
-			code: VMExecuteException,
-			Msg:  revert,
-			data: newDataError(revert, data),
-		}
-	default:
-		return DataError{
-			code: DefaultEVMErrorCode,
-			Msg:  revert,
-			data: newDataError(revert, data),
+		code = VMExecuteException
+	}
+	dataErr := newDataError(code, revert, realErr)
+	if data, f := m[types.ErrorHexData]; f {
+		dataErr.revertData = data
+		if bz, err := hexutil.Decode(data); err == nil {
+			if reason, err := abi.UnpackRevert(bz); err == nil {
+				dataErr.data.Reason = reason
+			}
 		}
 	}
+	return dataErr
 }
 
 //Preprocess error string, the string of realErr.Log is most like:
@@ -182,28 +185,16 @@ func preProcessError(realErr *cosmosError, origErrorMsg string) (map[string]stri
 	var logs []string
 	lastSeg := strings.LastIndexAny(realErr.Log, "]")
 	if lastSeg < 0 {
-		return nil, DataError{
-			code: DefaultEVMErrorCode,
-			Msg:  origErrorMsg,
-			data: RPCNullData,
-		}
+		return nil, newDataError(DefaultEVMErrorCode, origErrorMsg, realErr)
 	}
 	marshaler := realErr.Log[0 : lastSeg+1]
 	e := json.Unmarshal([]byte(marshaler), &logs)
 	if e != nil {
-		return nil, DataError{
-			code: DefaultEVMErrorCode,
-			Msg:  origErrorMsg,
-			data: RPCNullData,
-		}
+		return nil, newDataError(DefaultEVMErrorCode, origErrorMsg, realErr)
 	}
 	m := genericStringMap(logs)
 	if m == nil {
-		return nil, DataError{
-			code: DefaultEVMErrorCode,
-			Msg:  origErrorMsg,
-			data: RPCNullData,
-		}
+		return nil, newDataError(DefaultEVMErrorCode, origErrorMsg, realErr)
 	}
 	return m, nil
 }
@@ -219,16 +210,23 @@ func genericStringMap(s []string) map[string]string {
 	return ret
 }
 
+// CheckError returns the DataError of the failed tx, with the codespace and the code of the tx result
 func CheckError(txRes sdk.TxResponse) (common.Hash, error) {
+	var err error
 	switch txRes.Code {
 	case sdkerror.ErrTxInMempoolCache.ABCICode():
-		return common.Hash{}, sdkerror.ErrTxInMempoolCache
+		err = sdkerror.ErrTxInMempoolCache
 	case sdkerror.ErrMempoolIsFull.ABCICode():
-		return common.Hash{}, sdkerror.ErrMempoolIsFull
+		err = sdkerror.ErrMempoolIsFull
 	case sdkerror.ErrTxTooLarge.ABCICode():
-		return common.Hash{}, sdkerror.Wrapf(sdkerror.ErrTxTooLarge, txRes.RawLog)
+		err = sdkerror.Wrapf(sdkerror.ErrTxTooLarge, txRes.RawLog)
+	default:
+		err = fmt.Errorf(txRes.RawLog)
 	}
-	return common.Hash{}, fmt.Errorf(txRes.RawLog)
+	dataErr := newDataError(DefaultEVMErrorCode, err.Error(), nil)
+	dataErr.data.Codespace = txRes.Codespace
+	dataErr.data.ABCICode = txRes.Code
+	return common.Hash{}, dataErr
 }
 
 func getStorageByAddressKey(addr common.Address, key []byte) common.Hash {
@@ -263,3 +261,21 @@ func isAccountNotExistErr(err error) bool {
 	}
 	return cosmosErr.Code == AccountNotExistsCode
 }
+
+// searchHeightByTime returns the last height in [lo, hi] whose block time is not after ts, or lo-1 if all the blocks
+// are after ts. The block times must be non-decreasing with the heights.
+func searchHeightByTime(lo, hi int64, ts int64, blockTime func(height int64) (int64, error)) (int64, error) {
+	for lo <= hi {
+		mid := lo + (hi-lo)/2
+		t, err := blockTime(mid)
+		if err != nil {
+			return 0, err
+		}
+		if t <= ts {
+			lo = mid + 1
+		} else {
+			hi = mid - 1
+		}
+	}
+	return hi, nil
+}
@@ -1,8 +1,13 @@
 package eth
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerror "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 	evmtypes "github.com/okex/exchain/x/evm/types"
 
 	"github.com/stretchr/testify/require"
@@ -13,11 +18,81 @@ func Test_TransformDataError(t *testing.T) {
 	sdkerr := newWrappedCosmosError(7, `["execution reverted","message","HexData","0x00000000000"];failed message tail`, evmtypes.ModuleName)
 	err := TransformDataError(sdkerr, "eth_estimateGas").(DataError)
 	require.NotNil(t, err.ErrorData())
-	require.Equal(t, err.ErrorData(), "0x00000000000")
+	require.Equal(t, "0x00000000000", err.ErrorData())
+	require.Equal(t, ErrorData{Message: "message", Codespace: evmtypes.ModuleName, ABCICode: 7}, err.Details())
 	require.Equal(t, err.ErrorCode(), VMExecuteExceptionInEstimate)
 	err = TransformDataError(sdkerr, "eth_call").(DataError)
-	require.NotNil(t, err.ErrorData())
-	data, ok := err.ErrorData().(*wrappedEthError)
-	require.True(t, ok)
-	require.NotNil(t, data)
+	require.Equal(t, VMExecuteException, err.ErrorCode())
+	require.Equal(t, "0x00000000000", err.ErrorData())
+	require.Equal(t, ErrorData{Message: "message", Codespace: evmtypes.ModuleName, ABCICode: 7}, err.Details())
+
+	// the reason is decoded from the return data of Error(string)
+	revertData := append(crypto.Keccak256([]byte("Error(string)"))[:4],
+		hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000020"+
+			"0000000000000000000000000000000000000000000000000000000000000004"+
+			"6661696c00000000000000000000000000000000000000000000000000000000")...)
+	sdkerr = newWrappedCosmosError(7, `["execution reverted","execution reverted:fail","HexData","`+hexutil.Encode(revertData)+`"]`, evmtypes.ModuleName)
+	err = TransformDataError(sdkerr, "eth_call").(DataError)
+	require.Equal(t, "execution reverted:fail", err.Error())
+	require.Equal(t, hexutil.Encode(revertData), err.ErrorData())
+	require.Equal(t, ErrorData{
+		Message:   "execution reverted:fail",
+		Reason:    "fail",
+		Codespace: evmtypes.ModuleName,
+		ABCICode:  7,
+	}, err.Details())
+
+	// the errors without the revert data
+	sdkerr = newWrappedCosmosError(5, "insufficient funds", "sdk")
+	err = TransformDataError(sdkerr, "eth_call").(DataError)
+	require.Equal(t, DefaultEVMErrorCode, err.ErrorCode())
+	require.Equal(t, ErrorData{Message: "insufficient funds", Codespace: "sdk", ABCICode: 5}, err.ErrorData())
+
+	err = TransformDataError(errors.New("not a cosmos error"), "eth_call").(DataError)
+	require.Equal(t, DefaultEVMErrorCode, err.ErrorCode())
+	require.Equal(t, ErrorData{Message: "not a cosmos error"}, err.ErrorData())
+}
+
+func TestCheckError(t *testing.T) {
+	_, err := CheckError(sdk.TxResponse{
+		Code:      sdkerror.ErrMempoolIsFull.ABCICode(),
+		Codespace: sdkerror.ErrMempoolIsFull.Codespace(),
+	})
+	dataErr := err.(DataError)
+	require.Equal(t, DefaultEVMErrorCode, dataErr.ErrorCode())
+	require.Equal(t, ErrorData{
+		Message:   sdkerror.ErrMempoolIsFull.Error(),
+		Codespace: sdkerror.ErrMempoolIsFull.Codespace(),
+		ABCICode:  sdkerror.ErrMempoolIsFull.ABCICode(),
+	}, dataErr.ErrorData())
+}
+
+func Test_searchHeightByTime(t *testing.T) {
+	// the blocks 10 to 20 are produced every 3 seconds from 1000
+	blockTime := func(height int64) (int64, error) {
+		if height < 10 || height > 20 {
+			return 0, errors.New("block not found")
+		}
+		return 1000 + (height-10)*3, nil
+	}
+
+	for _, tc := range []struct {
+		ts     int64
+		height int64
+	}{
+		{999, 9},
+		{1000, 10},
+		{1001, 10},
+		{1005, 11},
+		{1006, 12},
+		{1030, 20},
+		{2000, 20},
+	} {
+		height, err := searchHeightByTime(10, 20, tc.ts, blockTime)
+		require.NoError(t, err)
+		require.Equal(t, tc.height, height, tc.ts)
+	}
+
+	_, err := searchHeightByTime(10, 21, 2000, blockTime)
+	require.Error(t, err)
 }
@@ -3,20 +3,36 @@ package net
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/okex/exchain/app/rpc/monitor"
 	ethermint "github.com/okex/exchain/app/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
 	"github.com/okex/exchain/libs/tendermint/libs/log"
 	rpcclient "github.com/okex/exchain/libs/tendermint/rpc/client"
-	"github.com/spf13/viper"
 )
 
 const (
 	NameSpace = "net"
 )
 
+// NodeInfo is the info of the node returned by net_nodeInfo
+type NodeInfo struct {
+	ID            string         `json:"id"`
+	Moniker       string         `json:"moniker"`
+	ChainID       string         `json:"chainId"`
+	NetworkID     string         `json:"networkId"`
+	ClientVersion string         `json:"clientVersion"`
+	LatestBlock   hexutil.Uint64 `json:"latestBlock"`
+	CatchingUp    bool           `json:"catchingUp"`
+	// NoReorg guarantees that the blocks are final once committed by the consensus and never reorganized, so no log
+	// is ever returned or notified with the removed property set to true
+	NoReorg bool `json:"noReorg"`
+}
+
 // PublicNetAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec.
 type PublicNetAPI struct {
+	chainID        string
 	networkVersion uint64
 	logger         log.Logger
 	Metrics        *monitor.RpcMetrics
@@ -32,11 +48,12 @@ func NewAPI(clientCtx context.CLIContext, log log.Logger) *PublicNetAPI {
 	}
 
 	api := &PublicNetAPI{
+		chainID:        clientCtx.ChainID,
 		networkVersion: chainIDEpoch.Uint64(),
 		logger:         log.With("module", "json-rpc", "namespace", NameSpace),
 		tmClient:       clientCtx.Client,
 	}
-	if viper.GetBool(monitor.FlagEnableMonitor) {
+	if monitor.Enabled() {
 		api.Metrics = monitor.MakeMonitorMetrics(NameSpace)
 	}
 	return api
@@ -70,3 +87,24 @@ func (api *PublicNetAPI) PeerCount() int {
 	}
 	return len(netInfo.Peers)
 }
+
+// NodeInfo returns the info of the node, with the guarantee that the blocks are never reorganized.
+func (api *PublicNetAPI) NodeInfo() (*NodeInfo, error) {
+	monitor := monitor.GetMonitor("net_nodeInfo", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd()
+	status, err := api.tmClient.Status()
+	if err != nil {
+		return nil, err
+	}
+	info := version.NewInfo()
+	return &NodeInfo{
+		ID:            string(status.NodeInfo.DefaultNodeID),
+		Moniker:       status.NodeInfo.Moniker,
+		ChainID:       api.chainID,
+		NetworkID:     fmt.Sprintf("%d", api.networkVersion),
+		ClientVersion: fmt.Sprintf("%s-%s", info.Name, info.Version),
+		LatestBlock:   hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
+		CatchingUp:    status.SyncInfo.CatchingUp,
+		NoReorg:       true,
+	}, nil
+}
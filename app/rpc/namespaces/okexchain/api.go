@@ -0,0 +1,271 @@
+package okexchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/okex/exchain/app/rpc/backend"
+	"github.com/okex/exchain/app/rpc/monitor"
+	"github.com/okex/exchain/app/rpc/namespaces/eth"
+	rpctypes "github.com/okex/exchain/app/rpc/types"
+	clientcontext "github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	authexported "github.com/okex/exchain/libs/cosmos-sdk/x/auth/exported"
+	sdkparams "github.com/okex/exchain/libs/cosmos-sdk/x/params"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	distrtypes "github.com/okex/exchain/x/distribution/types"
+	erc20types "github.com/okex/exchain/x/erc20/types"
+	paramstypes "github.com/okex/exchain/x/params/types"
+	stakingtypes "github.com/okex/exchain/x/staking/types"
+)
+
+const (
+	NameSpace = "okexchain"
+
+	erc20BalanceOfMethod = "balanceOf"
+	erc20BalanceOfABI    = `[{"inputs":[{"name":"account","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+)
+
+var erc20ABI = mustParseABI(erc20BalanceOfABI)
+
+// TokenBalance is the balance of an erc20 token mapped to a native denom
+type TokenBalance struct {
+	Denom    string         `json:"denom"`
+	Contract common.Address `json:"contract"`
+	Balance  *hexutil.Big   `json:"balance"`
+}
+
+// AccountSummary is everything a wallet loads for an account, all queried at the same block. The staking fields are
+// nil if the account has no delegation, unbonding or rewards.
+type AccountSummary struct {
+	Address     common.Address                                 `json:"address"`
+	BlockNumber hexutil.Uint64                                 `json:"blockNumber"`
+	Balance     *hexutil.Big                                   `json:"balance"`
+	Coins       sdk.SysCoins                                   `json:"coins"`
+	Tokens      []TokenBalance                                 `json:"tokens"`
+	Delegation  *stakingtypes.Delegator                        `json:"delegation"`
+	Unbonding   *stakingtypes.UndelegationInfo                 `json:"unbonding"`
+	Rewards     *distrtypes.QueryDelegatorTotalRewardsResponse `json:"rewards"`
+}
+
+// HaltSchedule is the planned halt of the chain set by governance. The chain halts after the commit of the first
+// block at or above Height, or with a block time at or after Time in unix seconds, 0 means not set for both.
+type HaltSchedule struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	Height      hexutil.Uint64 `json:"height"`
+	Time        hexutil.Uint64 `json:"time"`
+}
+
+// PublicOkexchainAPI is the okexchain_ prefixed set of APIs aggregating the cosmos modules state of the chain.
+type PublicOkexchainAPI struct {
+	clientCtx clientcontext.CLIContext
+	logger    log.Logger
+	backend   backend.Backend
+	ethAPI    *eth.PublicEthereumAPI
+	Metrics   *monitor.RpcMetrics
+}
+
+// NewAPI creates an instance of the okexchain API.
+func NewAPI(clientCtx clientcontext.CLIContext, log log.Logger, backend backend.Backend, ethAPI *eth.PublicEthereumAPI) *PublicOkexchainAPI {
+	api := &PublicOkexchainAPI{
+		clientCtx: clientCtx,
+		logger:    log.With("module", "json-rpc", "namespace", NameSpace),
+		backend:   backend,
+		ethAPI:    ethAPI,
+	}
+	if monitor.Enabled() {
+		api.Metrics = monitor.MakeMonitorMetrics(NameSpace)
+	}
+	return api
+}
+
+// GetAccountSummary returns the okt balance, the balances of the erc20 tokens of the registered token mappings, the
+// staking delegation, the unbonding and the pending rewards of the account in a single call.
+func (api *PublicOkexchainAPI) GetAccountSummary(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*AccountSummary, error) {
+	monitor := monitor.GetMonitor("okexchain_getAccountSummary", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("address", address, "block number", blockNrOrHash)
+
+	blockNum, err := api.backend.ConvertToBlockNumber(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	// the latest block is pinned, so every part of the summary is of the same block
+	height := blockNum.Int64()
+	if blockNum == rpctypes.LatestBlockNumber || blockNum == rpctypes.PendingBlockNumber {
+		if height, err = api.backend.LatestBlockNumber(); err != nil {
+			return nil, err
+		}
+	}
+	clientCtx := api.clientCtx.WithHeight(height)
+	accAddr := sdk.AccAddress(address.Bytes())
+	summary := &AccountSummary{
+		Address:     address,
+		BlockNumber: hexutil.Uint64(height),
+		Coins:       sdk.SysCoins{},
+		Tokens:      []TokenBalance{},
+	}
+
+	bz, err := clientCtx.Codec.MarshalJSON(auth.NewQueryAccountParams(accAddr))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", auth.QuerierRoute, auth.QueryAccount), bz)
+	if err == nil {
+		var account authexported.Account
+		if err := clientCtx.Codec.UnmarshalJSON(res, &account); err != nil {
+			return nil, err
+		}
+		summary.Coins = account.GetCoins()
+	} else if !isQueryErr(err, sdkerrors.RootCodespace, sdkerrors.ErrUnknownAddress.ABCICode()) {
+		return nil, err
+	}
+	summary.Balance = (*hexutil.Big)(summary.Coins.AmountOf(sdk.DefaultBondDenom).BigInt())
+
+	if summary.Tokens, err = api.tokenBalances(clientCtx, address, height); err != nil {
+		return nil, err
+	}
+
+	bz, err = clientCtx.Codec.MarshalJSON(stakingtypes.NewQueryDelegatorParams(accAddr))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err = clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", stakingtypes.QuerierRoute, stakingtypes.QueryDelegator), bz)
+	if err == nil {
+		var delegator stakingtypes.Delegator
+		if err := clientCtx.Codec.UnmarshalJSON(res, &delegator); err != nil {
+			return nil, err
+		}
+		summary.Delegation = &delegator
+	} else if !isQueryErr(err, stakingtypes.DefaultCodespace, stakingtypes.CodeNoDelegatorExisted) {
+		return nil, err
+	}
+
+	res, _, err = clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", stakingtypes.QuerierRoute, stakingtypes.QueryUnbondingDelegation), bz)
+	if err == nil {
+		var undelegation stakingtypes.UndelegationInfo
+		if err := clientCtx.Codec.UnmarshalJSON(res, &undelegation); err != nil {
+			return nil, err
+		}
+		summary.Unbonding = &undelegation
+	} else if !isQueryErr(err, stakingtypes.DefaultCodespace, stakingtypes.CodeNoUnbondingDelegation) {
+		return nil, err
+	}
+
+	// the rewards of the delegations are only accumulated after the distribution proposal is enabled
+	bz, err = clientCtx.Codec.MarshalJSON(distrtypes.NewQueryDelegatorParams(accAddr))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err = clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", distrtypes.QuerierRoute, distrtypes.QueryDelegatorTotalRewards), bz)
+	if err == nil {
+		var rewards distrtypes.QueryDelegatorTotalRewardsResponse
+		if err := json.Unmarshal(res, &rewards); err != nil {
+			return nil, err
+		}
+		summary.Rewards = &rewards
+	} else if !isQueryErr(err, distrtypes.DefaultCodespace,
+		distrtypes.CodeEmptyDelegationDistInfo, distrtypes.CodeNotSupportDistributionProposal) {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// GetHaltSchedule returns the halt of the chain scheduled by governance as of the latest block, or nil if no halt is
+// scheduled, so that the rpc providers can display the maintenance windows.
+func (api *PublicOkexchainAPI) GetHaltSchedule() (*HaltSchedule, error) {
+	monitor := monitor.GetMonitor("okexchain_getHaltSchedule", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd()
+
+	res, height, err := api.clientCtx.Query(fmt.Sprintf("custom/%s/%s", sdkparams.RouterKey, paramstypes.QueryHaltSchedule))
+	if err != nil {
+		return nil, err
+	}
+	var schedule paramstypes.HaltSchedule
+	if err := api.clientCtx.Codec.UnmarshalJSON(res, &schedule); err != nil {
+		return nil, err
+	}
+	if schedule.IsZero() {
+		return nil, nil
+	}
+
+	return &HaltSchedule{
+		BlockNumber: hexutil.Uint64(height),
+		Height:      hexutil.Uint64(schedule.Height),
+		Time:        hexutil.Uint64(schedule.Time),
+	}, nil
+}
+
+// tokenBalances returns the balances of the account of all the erc20 contracts mapped to a native denom
+func (api *PublicOkexchainAPI) tokenBalances(clientCtx clientcontext.CLIContext, address common.Address, height int64) ([]TokenBalance, error) {
+	res, _, err := clientCtx.Query(fmt.Sprintf("custom/%s/%s", erc20types.ModuleName, erc20types.QueryTokenMapping))
+	if err != nil {
+		return nil, err
+	}
+	var mappings []erc20types.QueryTokenMappingResponse
+	if err := clientCtx.Codec.UnmarshalJSON(res, &mappings); err != nil {
+		return nil, err
+	}
+
+	input, err := erc20ABI.Pack(erc20BalanceOfMethod, address)
+	if err != nil {
+		return nil, err
+	}
+	data := hexutil.Bytes(input)
+	blockNrOrHash := rpctypes.BlockNumberOrHashWithNumber(rpctypes.BlockNumber(height))
+
+	balances := make([]TokenBalance, 0, len(mappings))
+	for _, mapping := range mappings {
+		contract := common.HexToAddress(mapping.Contract)
+		ret, err := api.ethAPI.Call(rpctypes.CallArgs{To: &contract, Data: &data}, blockNrOrHash, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the balance of %s: %s", mapping.Contract, err)
+		}
+		outputs, err := erc20ABI.Unpack(erc20BalanceOfMethod, ret)
+		if err != nil || len(outputs) != 1 {
+			return nil, fmt.Errorf("invalid balance of %s: %x", mapping.Contract, []byte(ret))
+		}
+		balance, ok := outputs[0].(*big.Int)
+		if !ok {
+			return nil, fmt.Errorf("invalid balance of %s: %x", mapping.Contract, []byte(ret))
+		}
+		balances = append(balances, TokenBalance{
+			Denom:    mapping.Denom,
+			Contract: contract,
+			Balance:  (*hexutil.Big)(balance),
+		})
+	}
+	return balances, nil
+}
+
+func mustParseABI(s string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// isQueryErr returns whether the query failed with one of the codes of the codespace, the failed query returns the
+// abci response as the error
+func isQueryErr(err error, codespace string, codes ...uint32) bool {
+	var resp abci.ResponseQuery
+	if err := json.Unmarshal([]byte(err.Error()), &resp); err != nil || resp.Codespace != codespace {
+		return false
+	}
+	for _, code := range codes {
+		if resp.Code == code {
+			return true
+		}
+	}
+	return false
+}
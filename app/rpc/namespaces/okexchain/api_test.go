@@ -0,0 +1,44 @@
+package okexchain
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	stakingtypes "github.com/okex/exchain/x/staking/types"
+)
+
+func TestIsQueryErr(t *testing.T) {
+	queryErr := func(resp abci.ResponseQuery) error {
+		bz, err := json.Marshal(resp)
+		require.NoError(t, err)
+		return errors.New(string(bz))
+	}
+
+	err := queryErr(abci.ResponseQuery{Code: stakingtypes.CodeNoDelegatorExisted, Codespace: stakingtypes.DefaultCodespace})
+	require.True(t, isQueryErr(err, stakingtypes.DefaultCodespace, stakingtypes.CodeNoUnbondingDelegation, stakingtypes.CodeNoDelegatorExisted))
+	require.False(t, isQueryErr(err, stakingtypes.DefaultCodespace, stakingtypes.CodeNoUnbondingDelegation))
+	require.False(t, isQueryErr(err, sdkerrors.RootCodespace, stakingtypes.CodeNoDelegatorExisted))
+
+	// the errors of the connection to the node aren't query errors
+	require.False(t, isQueryErr(errors.New("connection refused"), stakingtypes.DefaultCodespace, stakingtypes.CodeNoDelegatorExisted))
+}
+
+func TestERC20BalanceOf(t *testing.T) {
+	address := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	input, err := erc20ABI.Pack(erc20BalanceOfMethod, address)
+	require.NoError(t, err)
+	require.Equal(t, "70a08231000000000000000000000000"+strings.ToLower(address.Hex()[2:]), hex.EncodeToString(input))
+
+	outputs, err := erc20ABI.Unpack(erc20BalanceOfMethod, common.BigToHash(big.NewInt(100)).Bytes())
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), outputs[0])
+}
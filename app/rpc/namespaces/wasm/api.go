@@ -0,0 +1,152 @@
+package wasm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/okex/exchain/app/rpc/backend"
+	"github.com/okex/exchain/app/rpc/monitor"
+	rpctypes "github.com/okex/exchain/app/rpc/types"
+	clientcontext "github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/okex/exchain/x/wasm/keeper"
+	wasmtypes "github.com/okex/exchain/x/wasm/types"
+)
+
+const (
+	NameSpace = "wasm"
+)
+
+// AddressResolution links a wasm contract and its 20 bytes evm representation. The evm address collides if it's the
+// representation of several wasm contracts, or it's also an evm contract.
+type AddressResolution struct {
+	EvmAddress    common.Address `json:"evmAddress"`
+	WasmContracts []string       `json:"wasmContracts"`
+	IsEvmContract bool           `json:"isEvmContract"`
+	Collision     bool           `json:"collision"`
+}
+
+// PublicWasmAPI is the wasm_ prefixed set of APIs reading the state of the wasm contracts, so the front-ends connected
+// to the evm json-rpc endpoint don't need another endpoint for the cosmwasm contracts.
+type PublicWasmAPI struct {
+	clientCtx clientcontext.CLIContext
+	logger    log.Logger
+	backend   backend.Backend
+	Metrics   *monitor.RpcMetrics
+}
+
+// NewAPI creates an instance of the wasm API.
+func NewAPI(clientCtx clientcontext.CLIContext, log log.Logger, backend backend.Backend) *PublicWasmAPI {
+	api := &PublicWasmAPI{
+		clientCtx: clientCtx,
+		logger:    log.With("module", "json-rpc", "namespace", NameSpace),
+		backend:   backend,
+	}
+	if monitor.Enabled() {
+		api.Metrics = monitor.MakeMonitorMetrics(NameSpace)
+	}
+	return api
+}
+
+// SmartQuery runs the json query msg against the wasm contract, given by its bech32 or hex address, and returns the
+// json response of the contract.
+func (api *PublicWasmAPI) SmartQuery(contract string, msg json.RawMessage, blockNrOrHash rpctypes.BlockNumberOrHash) (json.RawMessage, error) {
+	monitor := monitor.GetMonitor("wasm_smartQuery", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("contract", contract, "msg", string(msg), "block number", blockNrOrHash)
+
+	contractAddr, err := parseContractAddress(contract)
+	if err != nil {
+		return nil, err
+	}
+	query := wasmtypes.RawContractMessage(msg)
+	if err := query.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	clientCtx, err := api.clientCtxAt(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s/%s/%s", wasmtypes.QuerierRoute, keeper.QueryGetContractState,
+		contractAddr.String(), keeper.QueryMethodContractStateSmart)
+	res, _, err := clientCtx.QueryWithData(route, query)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(res) {
+		return nil, errors.New("the response of the contract is not json")
+	}
+	return res, nil
+}
+
+// ResolveAddress resolves a bech32 wasm contract address or an evm address to the evm representation and the wasm
+// contracts represented by it, so the explorers can link a contract between the vms.
+func (api *PublicWasmAPI) ResolveAddress(address string, blockNrOrHash rpctypes.BlockNumberOrHash) (*AddressResolution, error) {
+	monitor := monitor.GetMonitor("wasm_resolveAddress", api.logger, api.Metrics).OnBegin()
+	defer monitor.OnEnd("address", address, "block number", blockNrOrHash)
+
+	addr, err := parseContractAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	clientCtx, err := api.clientCtxAt(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	resolution := &AddressResolution{EvmAddress: wasmtypes.EvmAddress(addr), WasmContracts: []string{}}
+
+	route := fmt.Sprintf("custom/%s/%s/%s", wasmtypes.QuerierRoute, keeper.QueryContractsByEvmAddress,
+		resolution.EvmAddress.String())
+	res, _, err := clientCtx.Query(route)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) > 0 {
+		if err := json.Unmarshal(res, &resolution.WasmContracts); err != nil {
+			return nil, err
+		}
+	}
+
+	res, _, err = clientCtx.Query(fmt.Sprintf("custom/%s/%s/%s", evmtypes.ModuleName, evmtypes.QueryCode,
+		resolution.EvmAddress.String()))
+	if err != nil {
+		return nil, err
+	}
+	var code evmtypes.QueryResCode
+	if err := clientCtx.Codec.UnmarshalJSON(res, &code); err != nil {
+		return nil, err
+	}
+	resolution.IsEvmContract = len(code.Code) > 0
+	resolution.Collision = len(resolution.WasmContracts) > 1 || (len(resolution.WasmContracts) > 0 && resolution.IsEvmContract)
+	return resolution, nil
+}
+
+// clientCtxAt returns the client context querying the state at the block
+func (api *PublicWasmAPI) clientCtxAt(blockNrOrHash rpctypes.BlockNumberOrHash) (clientcontext.CLIContext, error) {
+	blockNum, err := api.backend.ConvertToBlockNumber(blockNrOrHash)
+	if err != nil {
+		return api.clientCtx, err
+	}
+	if blockNum == rpctypes.PendingBlockNumber || blockNum == rpctypes.LatestBlockNumber {
+		return api.clientCtx, nil
+	}
+	return api.clientCtx.WithHeight(blockNum.Int64()), nil
+}
+
+// parseContractAddress parses a bech32 or hex address
+func parseContractAddress(s string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(s) {
+		return common.HexToAddress(s).Bytes(), nil
+	}
+	addr, err := sdk.AccAddressFromBech32(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contract address %s: %s", s, err)
+	}
+	return addr, nil
+}
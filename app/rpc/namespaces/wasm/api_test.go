@@ -0,0 +1,25 @@
+package wasm
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+func TestParseContractAddress(t *testing.T) {
+	contract := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	addr, err := parseContractAddress(contract.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(contract.Bytes()), addr)
+
+	addr, err = parseContractAddress(sdk.AccAddress(contract.Bytes()).String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(contract.Bytes()), addr)
+
+	_, err = parseContractAddress("contract")
+	require.Error(t, err)
+}
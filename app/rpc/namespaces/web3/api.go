@@ -8,7 +8,6 @@ import (
 	"github.com/okex/exchain/app/rpc/monitor"
 	"github.com/okex/exchain/libs/cosmos-sdk/version"
 	"github.com/okex/exchain/libs/tendermint/libs/log"
-	"github.com/spf13/viper"
 )
 
 const (
@@ -26,7 +25,7 @@ func NewAPI(log log.Logger) *PublicWeb3API {
 	api := &PublicWeb3API{
 		logger: log.With("module", "json-rpc", "namespace", NameSpace),
 	}
-	if viper.GetBool(monitor.FlagEnableMonitor) {
+	if monitor.Enabled() {
 		api.Metrics = monitor.MakeMonitorMetrics(NameSpace)
 	}
 	return api
@@ -217,10 +217,12 @@ func (c *MockClient) CommitBlock() {
 		}
 		//thisBlock.Height = state.LastBlockHeight + 1
 		c.env.BlockStore.SaveBlock(thisBlock, thisParts, lastCommit)
+		tmstate.SaveABCIResponses(c.env.StateDB, blockHeight, blockResp)
 		c.CommitTx(blockHeight, thisBlock.Txs, resDeliverTxs)
 		c.chain.App().Commit(abci.RequestCommit{})
 	} else {
 		c.env.BlockStore.SaveBlock(thisBlock, thisParts, lastCommit)
+		tmstate.SaveABCIResponses(c.env.StateDB, blockHeight, &tmstate.ABCIResponses{EndBlock: &abci.ResponseEndBlock{}})
 		c.state = tmstate.State{
 			Version:                          c.state.Version,
 			ChainID:                          c.state.ChainID,
@@ -454,6 +456,25 @@ func (c *MockClient) Block(heightPtr *int64) (*ctypes.ResultBlock, error) {
 	}
 	return &ctypes.ResultBlock{BlockID: blockMeta.BlockID, Block: block}, nil
 }
+func (c *MockClient) BlockResults(heightPtr *int64) (*ctypes.ResultBlockResults, error) {
+	height, err := c.getHeight(c.env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := sm.LoadABCIResponses(c.env.StateDB, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.ResultBlockResults{
+		Height:                height,
+		TxsResults:            results.DeliverTxs,
+		EndBlockEvents:        results.EndBlock.Events,
+		ValidatorUpdates:      results.EndBlock.ValidatorUpdates,
+		ConsensusParamUpdates: results.EndBlock.ConsensusParamUpdates,
+	}, nil
+}
 func (c *MockClient) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	// if index is disabled, return error
 	if _, ok := c.env.TxIndexer.(*null.TxIndex); ok {
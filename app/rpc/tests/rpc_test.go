@@ -1195,6 +1195,17 @@ func (suite *RPCTestSuite) TestEth_GetProof() {
 	suite.Require().Error(err)
 }
 
+func (suite *RPCTestSuite) TestNet_NodeInfo() {
+	rpcRes := Call(suite.T(), suite.addr, "net_nodeInfo", nil)
+
+	var info map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(rpcRes.Result, &info))
+	suite.Require().Equal(suite.chain.ChainID(), info["chainId"])
+	suite.Require().NotEmpty(info["latestBlock"])
+	// the blocks are never reorganized
+	suite.Require().Equal(true, info["noReorg"])
+}
+
 /*
 func (suite *RPCTestSuite) TestEth_NewFilter() {
 	param := make([]map[string]interface{}, 1)
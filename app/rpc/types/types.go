@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	watcher "github.com/okex/exchain/x/evm/watcher"
 )
 
@@ -111,6 +112,27 @@ func (ca CallArgs) String() string {
 	return strings.TrimRight(arg, ", ")
 }
 
+// MetaTxArgs represents the arguments to relay a meta transaction signed by its sender, the sponsor signs the tx
+// calling the forwarder and pays for the gas.
+type MetaTxArgs struct {
+	Sponsor   common.Address `json:"sponsor"`
+	From      common.Address `json:"from"`
+	To        common.Address `json:"to"`
+	Gas       hexutil.Uint64 `json:"gas"`
+	Nonce     hexutil.Uint64 `json:"nonce"`
+	Deadline  hexutil.Uint64 `json:"deadline"`
+	Data      hexutil.Bytes  `json:"data"`
+	Signature hexutil.Bytes  `json:"signature"`
+	// gas limit and gas price of the tx of the sponsor, which are estimated and defaulted if not set
+	SponsorGas      *hexutil.Uint64 `json:"sponsorGas"`
+	SponsorGasPrice *hexutil.Big    `json:"sponsorGasPrice"`
+}
+
+func (ma MetaTxArgs) String() string {
+	return fmt.Sprintf("Sponsor: %s, From: %s, To: %s, Gas: %s, Nonce: %s, Deadline: %s, Data: %s",
+		ma.Sponsor.String(), ma.From.String(), ma.To.String(), ma.Gas.String(), ma.Nonce.String(), ma.Deadline.String(), ma.Data.String())
+}
+
 // EthHeaderWithBlockHash represents a block header in the Ethereum blockchain with block hash generated from Tendermint Block
 type EthHeaderWithBlockHash struct {
 	ParentHash  common.Hash         `json:"parentHash"`
@@ -156,3 +178,18 @@ func NewGPIn3Gears(safelow, avg, fastest *big.Int) GPIn3Gears {
 		Fastest: (*hexutil.Big)(fastest),
 	}
 }
+
+// BalanceHistoryArgs represents the blocks at which the balances of an account are queried, given by their heights or
+// by timestamps in unix seconds, a timestamp stands for the last block produced at or before it.
+type BalanceHistoryArgs struct {
+	Heights    []BlockNumber    `json:"heights"`
+	Timestamps []hexutil.Uint64 `json:"timestamps"`
+}
+
+// BalanceAtBlock is the balance of an account at a block.
+type BalanceAtBlock struct {
+	Number    hexutil.Uint64 `json:"number"`
+	Timestamp hexutil.Uint64 `json:"timestamp"`
+	Balance   *hexutil.Big   `json:"balance"`
+	Coins     sdk.SysCoins   `json:"coins"`
+}
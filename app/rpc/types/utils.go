@@ -72,15 +72,59 @@ func RpcBlockFromTendermint(clientCtx clientcontext.CLIContext, block *tmtypes.B
 	}
 
 	var bloom ethtypes.Bloom
-	clientCtx = clientCtx.WithHeight(block.Height)
-	res, _, err := clientCtx.Query(fmt.Sprintf("custom/%s/%s/%d", evmtypes.ModuleName, evmtypes.QueryBloom, block.Height))
+	receiptsRoot := ethtypes.EmptyRootHash
+	receipts, err := EthReceiptsFromTendermint(clientCtx, block)
 	if err == nil {
-		var bloomRes evmtypes.QueryBloomFilter
-		clientCtx.Codec.MustUnmarshalJSON(res, &bloomRes)
-		bloom = bloomRes.Bloom
+		bloom = ethtypes.CreateBloom(receipts)
+		receiptsRoot = watcher.DeriveReceiptsRoot(receipts)
+	} else {
+		// the results of the block may be pruned, fall back to the bloom stored by the evm module
+		clientCtx = clientCtx.WithHeight(block.Height)
+		res, _, err := clientCtx.Query(fmt.Sprintf("custom/%s/%s/%d", evmtypes.ModuleName, evmtypes.QueryBloom, block.Height))
+		if err == nil {
+			var bloomRes evmtypes.QueryBloomFilter
+			clientCtx.Codec.MustUnmarshalJSON(res, &bloomRes)
+			bloom = bloomRes.Bloom
+		}
 	}
 
-	return FormatBlock(block.Header, block.Size(), block.Hash(), gasLimit, gasUsed, ethTxs, bloom, fullTx), nil
+	ret := FormatBlock(block.Header, block.Size(), block.Hash(), gasLimit, gasUsed, ethTxs, bloom, fullTx)
+	ret.ReceiptsRoot = receiptsRoot
+	return ret, nil
+}
+
+// EthReceiptsFromTendermint returns the receipts of the evm txs of a tendermint block, from which the receipts root
+// of the block is derived
+func EthReceiptsFromTendermint(clientCtx clientcontext.CLIContext, block *tmtypes.Block) (ethtypes.Receipts, error) {
+	results, err := clientCtx.Client.BlockResults(&block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	var receipts ethtypes.Receipts
+	var cumulativeGas uint64
+	for i, tx := range block.Txs {
+		if i >= len(results.TxsResults) {
+			break
+		}
+		if _, err := RawTxToEthTx(clientCtx, tx, block.Height); err != nil {
+			continue
+		}
+
+		res := results.TxsResults[i]
+		status := watcher.TransactionFailed
+		data := evmtypes.ResultData{}
+		if res.IsOK() {
+			status = watcher.TransactionSuccess
+			// the result of an evm tx converted to a cosmos tx isn't result data
+			if decoded, err := evmtypes.DecodeResultData(res.Data); err == nil {
+				data = decoded
+			}
+		}
+		cumulativeGas += uint64(res.GasUsed)
+		receipts = append(receipts, watcher.NewEthReceipt(status, uint64(len(receipts)), cumulativeGas, &data))
+	}
+	return receipts, nil
 }
 
 // EthHeaderFromTendermint is an util function that returns an Ethereum Header
@@ -153,9 +197,19 @@ func FormatBlock(
 	header tmtypes.Header, size int, curBlockHash tmbytes.HexBytes, gasLimit int64,
 	gasUsed *big.Int, transactions []*watcher.Transaction, bloom ethtypes.Bloom, fullTx bool,
 ) *watcher.Block {
-	transactionsRoot := ethtypes.EmptyRootHash
-	if len(header.DataHash) > 0 {
-		transactionsRoot = common.BytesToHash(header.DataHash)
+	txs := make([]*evmtypes.TxData, len(transactions))
+	for i, tx := range transactions {
+		txs[i] = &evmtypes.TxData{
+			AccountNonce: uint64(tx.Nonce),
+			Price:        (*big.Int)(tx.GasPrice),
+			GasLimit:     uint64(tx.Gas),
+			Recipient:    tx.To,
+			Amount:       (*big.Int)(tx.Value),
+			Payload:      tx.Input,
+			V:            (*big.Int)(tx.V),
+			R:            (*big.Int)(tx.R),
+			S:            (*big.Int)(tx.S),
+		}
 	}
 
 	parentHash := header.LastBlockID.Hash
@@ -169,7 +223,7 @@ func FormatBlock(
 		Nonce:            watcher.BlockNonce{},    // PoW specific
 		UncleHash:        ethtypes.EmptyUncleHash, // No uncles in Tendermint
 		LogsBloom:        bloom,
-		TransactionsRoot: transactionsRoot,
+		TransactionsRoot: watcher.DeriveTransactionsRoot(txs),
 		StateRoot:        common.BytesToHash(header.AppHash),
 		Miner:            common.BytesToAddress(header.ProposerAddress),
 		MixHash:          common.Hash{},
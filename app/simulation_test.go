@@ -3,20 +3,18 @@ package app
 import (
 	"encoding/json"
 	"fmt"
-	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	"github.com/okex/exchain/libs/cosmos-sdk/store/prefix"
-	"github.com/okex/exchain/libs/cosmos-sdk/types/module"
 	"github.com/okex/exchain/x/wasm"
 	wasmtypes "github.com/okex/exchain/x/wasm/types"
 	"math/rand"
 	"os"
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 	"github.com/okex/exchain/libs/tendermint/libs/log"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
 	dbm "github.com/okex/exchain/libs/tm-db"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/baseapp"
@@ -69,6 +67,10 @@ func TestFullAppSimulation(t *testing.T) {
 		require.NoError(t, os.RemoveAll(dir))
 	}()
 
+	// the wasm msgs are handled since the earth upgrade
+	tmtypes.UnittestOnlySetMilestoneEarthHeight(1)
+	defer tmtypes.UnittestOnlySetMilestoneEarthHeight(0)
+
 	app := NewOKExChainApp(logger, db, nil, true, map[int64]bool{}, simapp.FlagPeriodValue, fauxMerkleModeOpt)
 	require.Equal(t, appName, app.Name())
 
@@ -329,15 +331,3 @@ func TestAppStateDeterminism(t *testing.T) {
 		}
 	}
 }
-
-// AppStateFn returns the initial application state using a genesis or the simulation parameters.
-// It panics if the user provides files for both of them.
-// If a file is not given for the genesis or the sim params, it creates a randomized one.
-func AppStateFn(codec *codec.Codec, manager *module.SimulationManager) simulation.AppStateFn {
-	// quick hack to setup app state genesis with our app modules
-	simapp.ModuleBasics = ModuleBasics
-	if simapp.FlagGenesisTimeValue == 0 { // always set to have a block time
-		simapp.FlagGenesisTimeValue = time.Now().Unix()
-	}
-	return simapp.AppStateFn(codec, manager)
-}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"sync"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	sysmetrics "github.com/okex/exchain/libs/system/metrics"
+)
+
+// UpgradeMetricsSubsystem is a subsystem shared by all the metrics of the upgrades
+const UpgradeMetricsSubsystem = "upgrade"
+
+// UpgradeMetrics contains the metrics of the upgrades
+type UpgradeMetrics struct {
+	// Number of the failed pre-upgrade verifications by module.
+	PreUpgradeVerifyFailures metrics.Counter
+}
+
+// PrometheusUpgradeMetrics returns UpgradeMetrics build using Prometheus client library.
+func PrometheusUpgradeMetrics(namespace string) *UpgradeMetrics {
+	return &UpgradeMetrics{
+		PreUpgradeVerifyFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: UpgradeMetricsSubsystem,
+			Name:      "pre_upgrade_verify_failures",
+			Help:      "Number of the failed pre-upgrade verifications by module.",
+		}, []string{"module"}),
+	}
+}
+
+// NopUpgradeMetrics returns no-op UpgradeMetrics.
+func NopUpgradeMetrics() *UpgradeMetrics {
+	return &UpgradeMetrics{
+		PreUpgradeVerifyFailures: discard.NewCounter(),
+	}
+}
+
+var (
+	upgradeMetrics     *UpgradeMetrics
+	upgradeMetricsOnce sync.Once
+)
+
+// GetUpgradeMetrics returns the metrics of the upgrades, which are reported if the metrics of the app are enabled
+func GetUpgradeMetrics() *UpgradeMetrics {
+	upgradeMetricsOnce.Do(func() {
+		if sysmetrics.Enabled() {
+			upgradeMetrics = PrometheusUpgradeMetrics(sysmetrics.Namespace())
+		} else {
+			upgradeMetrics = NopUpgradeMetrics()
+		}
+	})
+	return upgradeMetrics
+}
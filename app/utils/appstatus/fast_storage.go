@@ -19,6 +19,7 @@ import (
 	ibchost "github.com/okex/exchain/libs/ibc-go/modules/core/24-host"
 	dbm "github.com/okex/exchain/libs/tm-db"
 	"github.com/okex/exchain/x/ammswap"
+	"github.com/okex/exchain/x/circuit"
 	dex "github.com/okex/exchain/x/dex/types"
 	distr "github.com/okex/exchain/x/distribution"
 	"github.com/okex/exchain/x/erc20"
@@ -27,9 +28,11 @@ import (
 	"github.com/okex/exchain/x/farm"
 	"github.com/okex/exchain/x/feesplit"
 	"github.com/okex/exchain/x/gov"
+	"github.com/okex/exchain/x/oracle"
 	"github.com/okex/exchain/x/order"
 	"github.com/okex/exchain/x/slashing"
 	staking "github.com/okex/exchain/x/staking/types"
+	"github.com/okex/exchain/x/stream"
 	token "github.com/okex/exchain/x/token/types"
 	"github.com/spf13/viper"
 )
@@ -51,6 +54,9 @@ func GetAllStoreKeys() []string {
 		// mpt.StoreKey,
 		// wasm.StoreKey,
 		feesplit.StoreKey,
+		stream.StoreKey,
+		oracle.StoreKey,
+		circuit.StoreKey,
 	}
 }
 
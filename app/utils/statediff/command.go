@@ -0,0 +1,70 @@
+package statediff
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	flagStores = "stores"
+	flagPrefix = "prefix"
+	flagLimit  = "limit"
+	flagRaw    = "raw"
+)
+
+// Command returns the command to query the keys of the stores changed between two heights.
+func Command(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-diff [from-height] [to-height]",
+		Short: "Query the keys of the stores changed between two heights",
+		Long: `Query the keys of the iavl stores changed between two heights, e.g. to verify the state transition of an
+upgrade. The accounts, the evm contract storage and the wasm contract storage are decoded unless --raw is set.
+The node must keep the states of both heights.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			params, err := parseParams(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			result, err := Query(cliCtx, params, !viper.GetBool(flagRaw))
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(result)
+		},
+	}
+
+	cmd.Flags().String(flagStores, "", "Comma-separated stores to diff, all the stores by default")
+	cmd.Flags().String(flagPrefix, "", "Hex prefix of the keys to diff")
+	cmd.Flags().Int(flagLimit, 1000, "Max number of the changes")
+	cmd.Flags().Bool(flagRaw, false, "Don't decode the values")
+	return flags.GetCommands(cmd)[0]
+}
+
+func parseParams(fromArg, toArg string) (params sdk.QueryStateDiff, err error) {
+	if params.From, err = strconv.ParseInt(fromArg, 10, 64); err != nil {
+		return params, fmt.Errorf("invalid from height %s", fromArg)
+	}
+	if params.To, err = strconv.ParseInt(toArg, 10, 64); err != nil {
+		return params, fmt.Errorf("invalid to height %s", toArg)
+	}
+	if stores := viper.GetString(flagStores); stores != "" {
+		params.Stores = strings.Split(stores, ",")
+	}
+	if params.Prefix, err = hex.DecodeString(strings.TrimPrefix(viper.GetString(flagPrefix), "0x")); err != nil {
+		return params, fmt.Errorf("invalid prefix: %w", err)
+	}
+	params.Limit = viper.GetInt(flagLimit)
+	return params, nil
+}
@@ -0,0 +1,161 @@
+package statediff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	wasmtypes "github.com/okex/exchain/x/wasm/types"
+)
+
+const (
+	queryPath = "/app/statediff"
+
+	KindAccount     = "account"
+	KindEvmStorage  = "evm_storage"
+	KindWasmStorage = "wasm_storage"
+)
+
+// Result is the keys of the stores changed between two heights.
+type Result struct {
+	From      int64    `json:"from"`
+	To        int64    `json:"to"`
+	Changes   []Change `json:"changes"`
+	Skipped   []string `json:"skipped,omitempty"`
+	Truncated bool     `json:"truncated"`
+}
+
+// Change is a key of a store changed between two heights, with the decoded values if the key is of a known kind.
+type Change struct {
+	Store   string         `json:"store"`
+	Key     hexutil.Bytes  `json:"key"`
+	Before  hexutil.Bytes  `json:"before,omitempty"`
+	After   hexutil.Bytes  `json:"after,omitempty"`
+	Decoded *DecodedChange `json:"decoded,omitempty"`
+}
+
+// DecodedChange is a change of an account, an evm contract storage slot or a wasm contract storage key.
+type DecodedChange struct {
+	Kind    string      `json:"kind"`
+	Address string      `json:"address"`
+	Key     string      `json:"key,omitempty"`
+	Before  interface{} `json:"before,omitempty"`
+	After   interface{} `json:"after,omitempty"`
+}
+
+// Query queries the keys changed between two heights from the node, and decodes the known ones if decode is true.
+func Query(cliCtx context.CLIContext, params sdk.QueryStateDiff, decode bool) (*Result, error) {
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(queryPath, bz)
+	if err != nil {
+		return nil, err
+	}
+	var diff sdk.StateDiff
+	if err := json.Unmarshal(res, &diff); err != nil {
+		return nil, fmt.Errorf("invalid state diff: %w", err)
+	}
+
+	result := &Result{
+		From:      diff.From,
+		To:        diff.To,
+		Changes:   make([]Change, len(diff.Changes)),
+		Skipped:   diff.Skipped,
+		Truncated: diff.Truncated,
+	}
+	for i, c := range diff.Changes {
+		result.Changes[i] = Change{Store: c.Store, Key: c.Key, Before: c.Before, After: c.After}
+		if decode {
+			result.Changes[i].Decoded = Decode(cliCtx.Codec, c)
+		}
+	}
+	return result, nil
+}
+
+// Decode decodes the change of an account, an evm contract storage slot or a wasm contract storage key, and returns
+// nil for the other keys.
+func Decode(cdc *codec.Codec, c sdk.KVChange) *DecodedChange {
+	switch {
+	case c.Store == authtypes.StoreKey && len(c.Key) == 1+sdk.AddrLen &&
+		bytes.HasPrefix(c.Key, authtypes.AddressStoreKeyPrefix):
+		before, err := decodeAccount(cdc, c.Before)
+		if err != nil {
+			return nil
+		}
+		after, err := decodeAccount(cdc, c.After)
+		if err != nil {
+			return nil
+		}
+		return &DecodedChange{
+			Kind:    KindAccount,
+			Address: ethcmn.BytesToAddress(c.Key[1:]).Hex(),
+			Before:  before,
+			After:   after,
+		}
+
+	case c.Store == evmtypes.StoreKey && len(c.Key) == 1+ethcmn.AddressLength+ethcmn.HashLength &&
+		bytes.HasPrefix(c.Key, evmtypes.KeyPrefixStorage):
+		return &DecodedChange{
+			Kind:    KindEvmStorage,
+			Address: ethcmn.BytesToAddress(c.Key[1 : 1+ethcmn.AddressLength]).Hex(),
+			Key:     ethcmn.BytesToHash(c.Key[1+ethcmn.AddressLength:]).Hex(),
+			Before:  decodeStorageValue(c.Before),
+			After:   decodeStorageValue(c.After),
+		}
+
+	case c.Store == wasmtypes.StoreKey && len(c.Key) > 1+wasmtypes.ContractAddrLen &&
+		bytes.HasPrefix(c.Key, wasmtypes.ContractStorePrefix):
+		return &DecodedChange{
+			Kind:    KindWasmStorage,
+			Address: sdk.AccAddress(c.Key[1 : 1+wasmtypes.ContractAddrLen]).String(),
+			Key:     hexutil.Encode(c.Key[1+wasmtypes.ContractAddrLen:]),
+			Before:  decodeWasmValue(c.Before),
+			After:   decodeWasmValue(c.After),
+		}
+	}
+	return nil
+}
+
+func decodeAccount(cdc *codec.Codec, bz []byte) (interface{}, error) {
+	if bz == nil {
+		return nil, nil
+	}
+	var acc exported.Account
+	if err := cdc.UnmarshalBinaryBare(bz, &acc); err != nil {
+		return nil, err
+	}
+	res, err := cdc.MarshalJSON(acc)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), nil
+}
+
+func decodeStorageValue(bz []byte) interface{} {
+	if bz == nil {
+		return nil
+	}
+	return ethcmn.BytesToHash(bz).Hex()
+}
+
+// decodeWasmValue returns the json values of the contracts as they are, and the others in hex
+func decodeWasmValue(bz []byte) interface{} {
+	if bz == nil {
+		return nil
+	}
+	if json.Valid(bz) {
+		return json.RawMessage(bz)
+	}
+	return hexutil.Bytes(bz)
+}
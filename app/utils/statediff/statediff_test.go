@@ -0,0 +1,71 @@
+package statediff
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	okexchain "github.com/okex/exchain/app/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	wasmtypes "github.com/okex/exchain/x/wasm/types"
+)
+
+func TestDecode(t *testing.T) {
+	cdc := codec.New()
+	cdc.RegisterInterface((*exported.Account)(nil), nil)
+	okexchain.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	addr := ethcmn.BytesToAddress(bytes.Repeat([]byte{1}, ethcmn.AddressLength))
+	base := authtypes.NewBaseAccountWithAddress(addr.Bytes())
+	base.Sequence = 1
+	accBz := cdc.MustMarshalBinaryBare(exported.Account(&okexchain.EthAccount{BaseAccount: &base}))
+
+	decoded := Decode(cdc, sdk.KVChange{Store: authtypes.StoreKey, Key: authtypes.AddressStoreKey(addr.Bytes()), After: accBz})
+	require.NotNil(t, decoded)
+	require.Equal(t, KindAccount, decoded.Kind)
+	require.Equal(t, addr.Hex(), decoded.Address)
+	require.Nil(t, decoded.Before)
+	require.True(t, json.Valid(decoded.After.(json.RawMessage)))
+
+	// an account which can't be decoded
+	require.Nil(t, Decode(cdc, sdk.KVChange{Store: authtypes.StoreKey, Key: authtypes.AddressStoreKey(addr.Bytes()), After: []byte{1}}))
+
+	slot := ethcmn.BytesToHash([]byte{2})
+	decoded = Decode(cdc, sdk.KVChange{
+		Store:  evmtypes.StoreKey,
+		Key:    append(evmtypes.AddressStoragePrefix(addr), slot.Bytes()...),
+		Before: ethcmn.BytesToHash([]byte{3}).Bytes(),
+		After:  ethcmn.BytesToHash([]byte{4}).Bytes(),
+	})
+	require.Equal(t, &DecodedChange{
+		Kind:    KindEvmStorage,
+		Address: addr.Hex(),
+		Key:     slot.Hex(),
+		Before:  ethcmn.BytesToHash([]byte{3}).Hex(),
+		After:   ethcmn.BytesToHash([]byte{4}).Hex(),
+	}, decoded)
+
+	contract := sdk.AccAddress(bytes.Repeat([]byte{5}, wasmtypes.ContractAddrLen))
+	decoded = Decode(cdc, sdk.KVChange{
+		Store: wasmtypes.StoreKey,
+		Key:   append(wasmtypes.GetContractStorePrefix(contract), []byte("config")...),
+		After: []byte(`{"owner":"me"}`),
+	})
+	require.NotNil(t, decoded)
+	require.Equal(t, KindWasmStorage, decoded.Kind)
+	require.Equal(t, contract.String(), decoded.Address)
+	require.Equal(t, "0x636f6e666967", decoded.Key)
+	require.Equal(t, json.RawMessage(`{"owner":"me"}`), decoded.After)
+
+	// the keys of the other kinds aren't decoded
+	require.Nil(t, Decode(cdc, sdk.KVChange{Store: evmtypes.StoreKey, Key: evmtypes.KeyPrefixCode}))
+	require.Nil(t, Decode(cdc, sdk.KVChange{Store: "staking", Key: []byte{1}}))
+}
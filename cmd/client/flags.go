@@ -11,11 +11,13 @@ import (
 	"github.com/okex/exchain/app/rpc/websockets"
 	"github.com/okex/exchain/app/types"
 	"github.com/okex/exchain/app/utils/sanity"
+	"github.com/okex/exchain/libs/system/telemetry"
 	"github.com/okex/exchain/libs/system/trace"
 	"github.com/okex/exchain/libs/tendermint/consensus"
 	"github.com/okex/exchain/libs/tendermint/libs/automation"
 	tmtypes "github.com/okex/exchain/libs/tendermint/types"
 	tmdb "github.com/okex/exchain/libs/tm-db"
+	"github.com/okex/exchain/x/evm/tracesink"
 	evmtypes "github.com/okex/exchain/x/evm/types"
 	"github.com/okex/exchain/x/evm/watcher"
 	"github.com/okex/exchain/x/infura"
@@ -31,9 +33,18 @@ func RegisterAppFlag(cmd *cobra.Command) {
 	cmd.Flags().Int(backend.FlagApiBackendBlockLruCache, 30000, "Set the size of block LRU cache for backend mem cache")
 	cmd.Flags().Int(backend.FlagApiBackendTxLruCache, 100000, "Set the size of tx LRU cache for backend mem cache")
 	cmd.Flags().Bool(watcher.FlagCheckWd, false, "Enable check watchDB in log")
+	cmd.Flags().Bool(watcher.FlagReceiptStore, false, "Persist the receipts of the evm txs by tx hash, to look them up without fast-query or the tx indexer")
+	cmd.Flags().Bool(watcher.FlagTransferStore, false, "Index the native, erc20 and cw20 transfers by account, to query the transfer history of the accounts with eth_getTransfersByAddress")
 	cmd.Flags().Bool(rpc.FlagPersonalAPI, true, "Enable the personal_ prefixed set of APIs in the Web3 JSON-RPC spec")
 	cmd.Flags().Bool(rpc.FlagDebugAPI, false, "Enable the debug_ prefixed set of APIs in the Web3 JSON-RPC spec")
+	cmd.Flags().Bool(rpc.FlagAdminAPI, false, "Enable the admin_ prefixed set of APIs managing the ABI registry of the verified contracts")
+	cmd.Flags().String(rpc.FlagABIRegistryFile, "", "The json file of the verified contracts' ABIs to decode the calldata in eth_getDecodedTransaction and debug traces")
 	cmd.Flags().Bool(evmtypes.FlagEnableBloomFilter, true, "Enable bloom filter for event logs")
+	cmd.Flags().Bool(evmtypes.FlagEnableLogEvents, false, "Emit the logs of the evm txs as evm_log events in the tx results, to filter them with the event subscriptions and the tx indexer")
+	cmd.Flags().String(tracesink.FlagTraceSink, "", "Trace the delivered evm txs and stream the traces of the committed blocks to a sink: file, grpc or kafka")
+	cmd.Flags().String(tracesink.FlagTraceSinkTarget, "", "The file path, the grpc address or the comma separated kafka brokers of the trace sink")
+	cmd.Flags().String(tracesink.FlagTraceSinkTopic, tracesink.DefaultKafkaTopic, "The kafka topic of the trace sink")
+	cmd.Flags().String(tracesink.FlagTraceSinkConfig, "", "The trace config of the trace sink in json, e.g. {\"tracer\":\"callTracer\"}, the struct logger by default")
 	cmd.Flags().Int64(filters.FlagGetLogsHeightSpan, 2000, "config the block height span for get logs")
 	// register application rpc to nacos
 	cmd.Flags().String(rpc.FlagRestApplicationName, "", "rest application name in  nacos")
@@ -69,6 +80,7 @@ func RegisterAppFlag(cmd *cobra.Command) {
 
 	cmd.Flags().Bool(config.FlagEnableHasBlockPartMsg, false, "Enable peer to broadcast HasBlockPartMessage")
 	cmd.Flags().Bool(eth.FlagEnableMultiCall, false, "Enable node to support the eth_multiCall RPC API")
+	cmd.Flags().String(eth.FlagPrivateTxTokens, "", "Comma separated tokens authorizing the eth_sendPrivateTransaction RPC API, whose txs aren't gossiped to the peers")
 
 	cmd.Flags().Bool(token.FlagOSSEnable, false, "Enable the function of exporting account data and uploading to oss")
 	cmd.Flags().String(token.FlagOSSEndpoint, "", "The OSS datacenter endpoint such as http://oss-cn-hangzhou.aliyuncs.com")
@@ -115,6 +127,10 @@ func RegisterAppFlag(cmd *cobra.Command) {
 	cmd.Flags().Bool(app.FlagEnableRepairState, false, "Enable auto repair state on start")
 
 	cmd.Flags().Bool(trace.FlagEnableAnalyzer, false, "Enable auto open log analyzer")
+	cmd.Flags().Bool(telemetry.FlagEnable, false, "Enable exporting the OpenTelemetry spans of tx execution over OTLP")
+	cmd.Flags().String(telemetry.FlagEndpoint, telemetry.DefaultEndpoint, "OTLP gRPC endpoint of the OpenTelemetry collector")
+	cmd.Flags().Bool(telemetry.FlagInsecure, false, "Connect to the OpenTelemetry collector without TLS")
+	cmd.Flags().Float64(telemetry.FlagSampleRatio, 1, "Ratio of the blocks and txs whose spans are sampled")
 	cmd.Flags().Bool(sanity.FlagDisableSanity, false, "Disable sanity check")
 	cmd.Flags().Int(tmtypes.FlagSigCacheSize, 200000, "Maximum number of signatures in the cache")
 
@@ -6,20 +6,36 @@ import (
 	"fmt"
 	"github.com/okex/exchain/libs/tendermint/p2p"
 	"io"
+	"io/ioutil"
+	"strings"
 
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/input"
 	clientkeys "github.com/okex/exchain/libs/cosmos-sdk/client/keys"
 	"github.com/okex/exchain/libs/cosmos-sdk/crypto/keys"
+	"github.com/okex/exchain/libs/cosmos-sdk/crypto/keys/mintkey"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmcrypto "github.com/okex/exchain/libs/tendermint/crypto"
 
+	"github.com/okex/exchain/app/crypto/ethkeystore"
 	"github.com/okex/exchain/app/crypto/hd"
 )
 
 const (
 	flagDryRun = "dry-run"
+	flagFormat = "format"
+
+	keyFormatArmor    = "armor"
+	keyFormatKeystore = "keystore"
+	keyFormatHex      = "hex"
+
+	// importArmorPassphrase only protects the armor a keystore or hex key is wrapped in before it's imported
+	importArmorPassphrase = "import"
 )
 
 // KeyCommands registers a sub-tree of commands to interact with
@@ -47,11 +63,28 @@ func KeyCommands() *cobra.Command {
 	}
 	addCmd.RunE = runAddCmd
 
+	// support exporting and importing the keys of Ethereum wallets, e.g. Metamask
+	exportCmd := clientkeys.ExportKeyCommand()
+	exportCmd.Long = `Export a private key from the local keybase in ASCII-armored encrypted format.
+
+The key can also be exported as an Ethereum keystore v3 json with --format=keystore,
+or **UNSAFE** as an unencrypted hex private key with --format=hex.`
+	exportCmd.Flags().String(flagFormat, keyFormatArmor, "The format of the exported key: armor|keystore|hex")
+	exportCmd.RunE = runExportCmd
+
+	importCmd := clientkeys.ImportKeyCommand()
+	importCmd.Long = `Import a ASCII armored private key into the local keybase.
+
+An Ethereum keystore v3 json can also be imported with --format=keystore,
+or a hex private key with --format=hex.`
+	importCmd.Flags().String(flagFormat, keyFormatArmor, "The format of the key file: armor|keystore|hex")
+	importCmd.RunE = runImportCmd
+
 	cmd.AddCommand(
 		clientkeys.MnemonicKeyCommand(),
 		addCmd,
-		clientkeys.ExportKeyCommand(),
-		clientkeys.ImportKeyCommand(),
+		exportCmd,
+		importCmd,
 		clientkeys.ListKeysCmd(),
 		clientkeys.ShowKeysCmd(),
 		flags.LineBreak,
@@ -76,6 +109,124 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	return clientkeys.RunAddCmd(cmd, args, kb, inBuf)
 }
 
+func runExportCmd(cmd *cobra.Command, args []string) error {
+	format := viper.GetString(flagFormat)
+	if format != keyFormatArmor && format != keyFormatKeystore && format != keyFormatHex {
+		return fmt.Errorf("invalid key format %s, must be one of armor, keystore and hex", format)
+	}
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	kb, err := getKeybase(false, inBuf)
+	if err != nil {
+		return err
+	}
+
+	decryptPassword, err := input.GetPassword("Enter passphrase to decrypt your key:", inBuf)
+	if err != nil {
+		return err
+	}
+	privKey, err := kb.ExportPrivateKeyObject(args[0], decryptPassword)
+	if err != nil {
+		return err
+	}
+
+	var exported string
+	switch format {
+	case keyFormatArmor:
+		encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported key:", inBuf)
+		if err != nil {
+			return err
+		}
+		if exported, err = kb.ExportPrivKey(args[0], decryptPassword, encryptPassword); err != nil {
+			return err
+		}
+	default:
+		ethKey, err := ethkeystore.EncodeTmKeyToEthKey(privKey)
+		if err != nil {
+			return fmt.Errorf("invalid private key type, must be Ethereum key: %T", privKey)
+		}
+		if format == keyFormatHex {
+			exported = hex.EncodeToString(ethcrypto.FromECDSA(ethKey))
+			break
+		}
+
+		encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported keystore:", inBuf)
+		if err != nil {
+			return err
+		}
+		keyJSON, err := ethkeystore.EncryptKeyStore(ethKey, encryptPassword)
+		if err != nil {
+			return err
+		}
+		exported = string(keyJSON)
+	}
+
+	printKeyAddresses(cmd, privKey.PubKey())
+	fmt.Println(exported)
+	return nil
+}
+
+func runImportCmd(cmd *cobra.Command, args []string) error {
+	format := viper.GetString(flagFormat)
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	kb, err := getKeybase(false, inBuf)
+	if err != nil {
+		return err
+	}
+
+	bz, err := ioutil.ReadFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	var privKey tmcrypto.PrivKey
+	switch format {
+	case keyFormatArmor:
+		passphrase, err := input.GetPassword("Enter passphrase to decrypt your key:", inBuf)
+		if err != nil {
+			return err
+		}
+		if err := kb.ImportPrivKey(args[0], string(bz), passphrase); err != nil {
+			return err
+		}
+		info, err := kb.Get(args[0])
+		if err != nil {
+			return err
+		}
+		printKeyAddresses(cmd, info.GetPubKey())
+		return nil
+	case keyFormatKeystore:
+		passphrase, err := input.GetPassword("Enter passphrase to decrypt your keystore:", inBuf)
+		if err != nil {
+			return err
+		}
+		if privKey, err = ethkeystore.DecryptKeyStore(bz, passphrase); err != nil {
+			return err
+		}
+	case keyFormatHex:
+		ethKey, err := ethcrypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(string(bz)), "0x"))
+		if err != nil {
+			return fmt.Errorf("invalid hex private key: %s", err)
+		}
+		privKey = ethkeystore.DecodeEthKeyToTmKey(ethKey)
+	default:
+		return fmt.Errorf("invalid key format %s, must be one of armor, keystore and hex", format)
+	}
+
+	armor := mintkey.EncryptArmorPrivKey(privKey, importArmorPassphrase, string(hd.EthSecp256k1))
+	if err := kb.ImportPrivKey(args[0], armor, importArmorPassphrase); err != nil {
+		return err
+	}
+	printKeyAddresses(cmd, privKey.PubKey())
+	return nil
+}
+
+// printKeyAddresses prints the address of the key in both the ethereum (0x) and the bech32 (ex1) format
+func printKeyAddresses(cmd *cobra.Command, pubKey tmcrypto.PubKey) {
+	cmd.PrintErrf("address: %s\n", sdk.AccAddress(pubKey.Address()).String())
+	cmd.PrintErrf("eth_address: %s\n", ethcmn.BytesToAddress(pubKey.Address()).Hex())
+}
+
 func getKeybase(transient bool, buf io.Reader) (keys.Keybase, error) {
 	if transient {
 		return keys.NewInMemory(
@@ -11,8 +11,10 @@ import (
 	"github.com/okex/exchain/app/codec"
 	"github.com/okex/exchain/app/crypto/ethsecp256k1"
 	okexchain "github.com/okex/exchain/app/types"
+	"github.com/okex/exchain/app/utils/statediff"
 	"github.com/okex/exchain/cmd/client"
 	sdkclient "github.com/okex/exchain/libs/cosmos-sdk/client"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/debug"
 	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
 	clientkeys "github.com/okex/exchain/libs/cosmos-sdk/client/keys"
 	clientrpc "github.com/okex/exchain/libs/cosmos-sdk/client/rpc"
@@ -29,6 +31,7 @@ import (
 	"github.com/okex/exchain/libs/tendermint/crypto/multisig"
 	"github.com/okex/exchain/libs/tendermint/libs/cli"
 	"github.com/okex/exchain/x/dex"
+	evmcli "github.com/okex/exchain/x/evm/client/cli"
 	evmtypes "github.com/okex/exchain/x/evm/types"
 	"github.com/okex/exchain/x/order"
 	tokencmd "github.com/okex/exchain/x/token/client/cli"
@@ -79,6 +82,7 @@ func main() {
 		flags.LineBreak,
 		client.KeyCommands(),
 		client.AddrCommands(),
+		debug.Cmd(cdc),
 		flags.LineBreak,
 		version.Cmd,
 		flags.NewCompletionCmd(rootCmd, true),
@@ -106,6 +110,9 @@ func queryCmd(proxy *sdkcodec.CodecProxy, reg interfacetypes.InterfaceRegistry)
 		authcmd.QueryTxsByEventsCmd(cdc),
 		authcmd.QueryTxCmd(proxy),
 		flags.LineBreak,
+		clientrpc.EventSchemasCommand(cdc),
+		statediff.Command(cdc),
+		flags.LineBreak,
 	)
 
 	// add modules' query commands
@@ -130,6 +137,7 @@ func txCmd(proxy *sdkcodec.CodecProxy, reg interfacetypes.InterfaceRegistry) *co
 		authcmd.GetBroadcastCommand(cdc),
 		authcmd.GetEncodeCommand(cdc),
 		authcmd.GetDecodeCommand(cdc),
+		evmcli.GetCmdDecodeAnyTx(proxy),
 		flags.LineBreak,
 	)
 
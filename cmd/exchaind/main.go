@@ -10,6 +10,7 @@ import (
 	"github.com/okex/exchain/app/logevents"
 	"github.com/okex/exchain/cmd/exchaind/fss"
 	"github.com/okex/exchain/cmd/exchaind/mpt"
+	"github.com/okex/exchain/cmd/exchaind/snapshot"
 
 	"github.com/okex/exchain/app/rpc"
 	evmtypes "github.com/okex/exchain/x/evm/types"
@@ -88,10 +89,12 @@ func main() {
 		genutilcli.ValidateGenesisCmd(ctx, codecProxy.GetCdc(), app.ModuleBasics),
 		client.TestnetCmd(ctx, codecProxy.GetCdc(), app.ModuleBasics, auth.GenesisAccountIterator{}),
 		replayCmd(ctx, client.RegisterAppFlag, codecProxy, newApp, registry, registerRoutes),
+		replayBlockCmd(ctx, client.RegisterAppFlag),
 		repairStateCmd(ctx),
 		displayStateCmd(ctx),
 		mpt.MptCmd(ctx),
 		fss.Command(ctx),
+		snapshot.Command(ctx),
 		// AddGenesisAccountCmd allows users to add accounts to the genesis file
 		AddGenesisAccountCmd(ctx, codecProxy.GetCdc(), app.DefaultNodeHome, app.DefaultCLIHome),
 		flags.NewCompletionCmd(rootCmd, true),
@@ -99,6 +102,7 @@ func main() {
 		exportAppCmd(ctx),
 		iaviewerCmd(ctx, codecProxy.GetCdc()),
 		subscribeCmd(codecProxy.GetCdc()),
+		testSimCmd(),
 	)
 
 	subFunc := func(logger log.Logger) log.Subscriber {
@@ -165,6 +169,8 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(viper.GetString(server.FlagMinGasPrices)),
 		baseapp.SetHaltHeight(uint64(viper.GetInt(server.FlagHaltHeight))),
+		baseapp.SetQueryGasLimit(viper.GetUint64(server.FlagQueryGasLimit)),
+		baseapp.SetQueryGasBudget(viper.GetUint64(server.FlagQueryGasBudget), viper.GetDuration(server.FlagQueryGasBudgetWindow)),
 	)
 }
 
@@ -0,0 +1,32 @@
+package mpt
+
+import (
+	"log"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/server"
+	"github.com/okex/exchain/libs/cosmos-sdk/store/mpt"
+	evmkeeper "github.com/okex/exchain/x/evm/keeper"
+	"github.com/spf13/cobra"
+)
+
+const flagBloomSize = "bloom-size"
+
+func cleanDestructedStorageCmd(ctx *server.Context) *cobra.Command {
+	var bloomSize uint64
+	cmd := &cobra.Command{
+		Use:   "clean-destructed",
+		Short: "reclaim the storage of the destructed contracts from the evm mpt store, the node must be stopped",
+		Long: `Reclaim the storage trie nodes of the contracts destructed at or before the latest stored height of the
+evm mpt store, keeping the nodes shared with the latest stored state. The node must be stopped. The state of the
+destructed contracts at the historical heights isn't queryable afterwards, so it's not meant for the archive nodes.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			log.Println("--------- clean destructed storage start ---------")
+			stats, err := evmkeeper.CleanDestructedStorages(mpt.InstanceOfMptStore(), bloomSize, ctx.Logger)
+			panicError(err)
+			log.Printf("reclaimed %s\n", stats)
+			log.Println("--------- clean destructed storage end ---------")
+		},
+	}
+	cmd.Flags().Uint64Var(&bloomSize, flagBloomSize, 2048, "Megabytes of memory allocated to the bloom filter of the live trie nodes")
+	return cmd
+}
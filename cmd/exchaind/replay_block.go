@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+
+	"github.com/okex/exchain/app/config"
+	okexchain "github.com/okex/exchain/app/types"
+	"github.com/okex/exchain/app/utils/appstatus"
+	"github.com/okex/exchain/app/utils/sanity"
+	"github.com/okex/exchain/libs/cosmos-sdk/server"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/iavl"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	tcmd "github.com/okex/exchain/libs/tendermint/cmd/tendermint/commands"
+	"github.com/okex/exchain/libs/tendermint/global"
+	"github.com/okex/exchain/libs/tendermint/proxy"
+	sm "github.com/okex/exchain/libs/tendermint/state"
+	"github.com/okex/exchain/libs/tendermint/store"
+	"github.com/okex/exchain/libs/tendermint/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagReplayHeight = "height"
+	flagTrace        = "trace_txs"
+	flagTraceConfig  = "trace_config"
+	flagReportOutput = "output"
+)
+
+// blockTracer is implemented by the app tracing the txs of a block
+type blockTracer interface {
+	TraceBlock(block *types.Block, configBytes []byte) ([]*sdk.Result, []error, error)
+}
+
+// replayBlockReport is the result of re-executing a block
+type replayBlockReport struct {
+	Height          int64          `json:"height"`
+	AppHash         string         `json:"app_hash"`
+	ExpectedAppHash string         `json:"expected_app_hash,omitempty"`
+	AppHashMatched  bool           `json:"app_hash_matched"`
+	TxDiffs         []txResultDiff `json:"tx_diffs,omitempty"`
+	Traces          []txTrace      `json:"traces,omitempty"`
+}
+
+// txResultDiff is a field of the result of a tx which differs from the one recorded by the original execution
+type txResultDiff struct {
+	Index    int    `json:"index"`
+	Hash     string `json:"hash"`
+	Field    string `json:"field"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+// txTrace is the traced execution of a tx, the trace of an evm tx is its trace logs
+type txTrace struct {
+	Index  int             `json:"index"`
+	Hash   string          `json:"hash"`
+	Log    string          `json:"log,omitempty"`
+	Events sdk.Events      `json:"events,omitempty"`
+	Trace  json.RawMessage `json:"trace,omitempty"`
+	Data   string          `json:"data,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+func replayBlockCmd(ctx *server.Context, registerAppFlagFn func(cmd *cobra.Command)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-block",
+		Short: "Re-execute a historical block against the prior app state to debug app hash mismatches",
+		Long: `Re-execute the block at --height from the block store of --replayed_block_dir on the application of --home,
+which must be at the previous height, e.g. restored from a snapshot or replayed with --halt-height. The resulting app
+hash and tx results are diffed with the ones of the original execution. With --trace_txs, the txs are traced before the
+block is executed, with the evm trace logs of the evm txs.
+
+The block is committed to the application of --home, so it's at the height of the block afterwards.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := sanity.CheckStart(); err != nil {
+				return err
+			}
+			iavl.SetEnableFastStorage(appstatus.IsFastStorageStrategy())
+			server.SetExternalPackageValue(cmd)
+			types.InitSignatureCache()
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height := viper.GetInt64(flagReplayHeight)
+			if height <= 0 {
+				return fmt.Errorf("--%s must be positive", flagReplayHeight)
+			}
+			report, err := replaySingleBlock(ctx, height, viper.GetString(replayedBlockDir))
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			if output := viper.GetString(flagReportOutput); output != "" {
+				if err := ioutil.WriteFile(output, bz, 0644); err != nil {
+					return err
+				}
+			} else {
+				fmt.Println(string(bz))
+			}
+
+			if !report.AppHashMatched || len(report.TxDiffs) > 0 {
+				return fmt.Errorf("the re-execution of block %d differs from the original one", height)
+			}
+			log.Printf("the re-execution of block %d matches the original one\n", height)
+			return nil
+		},
+	}
+
+	server.RegisterServerFlags(cmd)
+	registerAppFlagFn(cmd)
+	tcmd.AddNodeFlags(cmd)
+	cmd.Flags().Int64(flagReplayHeight, 0, "Height of the block to re-execute")
+	cmd.Flags().StringP(replayedBlockDir, "d", ".exchaind/data", "Directory of the original block and state data")
+	cmd.Flags().Bool(flagTrace, false, "Trace the txs of the block")
+	cmd.Flags().String(flagTraceConfig, "", `Config of the evm tracer in json, e.g. '{"disableStorage":true}'`)
+	cmd.Flags().String(flagReportOutput, "", "File of the report, which is printed by default")
+	return cmd
+}
+
+// replaySingleBlock re-executes the block at height on the app at height-1, and diffs the results with the ones of
+// the original execution in originDataDir
+func replaySingleBlock(ctx *server.Context, height int64, originDataDir string) (*replayBlockReport, error) {
+	config.RegisterDynamicConfig(ctx.Logger.With("module", "config"))
+	// the responses are collected in the order of the txs
+	config.GetOecConfig().SetDeliverTxsExecuteMode(0)
+
+	dataDir := filepath.Join(ctx.Config.RootDir, "data")
+	appDB, err := sdk.NewDB(applicationDB, dataDir)
+	if err != nil {
+		return nil, err
+	}
+	app := newApp(ctx.Logger, appDB, nil)
+	proxyApp, err := createAndStartProxyAppConns(proxy.NewLocalClientCreator(app))
+	if err != nil {
+		return nil, err
+	}
+	defer proxyApp.Stop()
+
+	info, err := proxyApp.Query().InfoSync(proxy.RequestInfo)
+	if err != nil {
+		return nil, err
+	}
+	if info.LastBlockHeight != height-1 {
+		return nil, fmt.Errorf("the application is at height %d, the state of height %d is needed to re-execute block %d",
+			info.LastBlockHeight, height-1, height)
+	}
+
+	originBlockStoreDB, err := sdk.NewDB(blockStoreDB, originDataDir)
+	if err != nil {
+		return nil, err
+	}
+	defer originBlockStoreDB.Close()
+	originBlockStore := store.NewBlockStore(originBlockStoreDB)
+	block := originBlockStore.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("block %d isn't found in %s", height, originDataDir)
+	}
+
+	originStateDB, err := sdk.NewDB(stateDB, originDataDir)
+	if err != nil {
+		return nil, err
+	}
+	defer originStateDB.Close()
+	genDoc, err := types.GenesisDocFromFile(ctx.Config.GenesisFile())
+	if err != nil {
+		return nil, err
+	}
+	if err := okexchain.SetChainId(genDoc.ChainID); err != nil {
+		return nil, err
+	}
+
+	report := &replayBlockReport{Height: height}
+	if viper.GetBool(flagTrace) {
+		tracer, ok := app.(blockTracer)
+		if !ok {
+			return nil, fmt.Errorf("the app doesn't support tracing")
+		}
+		report.Traces, err = traceBlock(tracer, block, []byte(viper.GetString(flagTraceConfig)))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	global.SetGlobalHeight(height)
+	// the validators of the last commit are loaded from the original state
+	abciResponses, appHash, err := sm.ExecCommitBlockWithResponses(proxyApp.Consensus(), block, ctx.Logger, originStateDB)
+	if err != nil {
+		return nil, err
+	}
+	report.AppHash = fmt.Sprintf("%X", appHash)
+	if next := originBlockStore.LoadBlockMeta(height + 1); next != nil {
+		report.ExpectedAppHash = fmt.Sprintf("%X", next.Header.AppHash)
+		report.AppHashMatched = bytes.Equal(appHash, next.Header.AppHash)
+	} else {
+		log.Printf("block %d isn't found, the app hash can't be verified\n", height+1)
+		report.AppHashMatched = true
+	}
+
+	originResponses, err := sm.LoadABCIResponses(originStateDB, height)
+	if err != nil {
+		log.Printf("the original results of block %d aren't found, the tx results can't be verified: %s\n", height, err)
+	} else {
+		report.TxDiffs = diffTxResults(block, originResponses.DeliverTxs, abciResponses.DeliverTxs)
+	}
+	return report, nil
+}
+
+func traceBlock(tracer blockTracer, block *types.Block, configBytes []byte) ([]txTrace, error) {
+	if len(configBytes) == 0 {
+		configBytes = nil
+	}
+	results, errs, err := tracer.TraceBlock(block, configBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := make([]txTrace, len(block.Txs))
+	for i, tx := range block.Txs {
+		traces[i] = txTrace{Index: i, Hash: fmt.Sprintf("%X", tx.Hash(block.Height))}
+		if errs[i] != nil {
+			traces[i].Error = errs[i].Error()
+		}
+		if res := results[i]; res != nil {
+			traces[i].Log = res.Log
+			traces[i].Events = res.Events
+			if json.Valid(res.Data) {
+				traces[i].Trace = res.Data
+			} else if len(res.Data) > 0 {
+				traces[i].Data = hex.EncodeToString(res.Data)
+			}
+		}
+	}
+	return traces, nil
+}
+
+// diffTxResults returns the fields of the tx results which differ from the expected ones
+func diffTxResults(block *types.Block, expected, actual []*abci.ResponseDeliverTx) []txResultDiff {
+	var diffs []txResultDiff
+	for i, tx := range block.Txs {
+		var exp, act abci.ResponseDeliverTx
+		if i < len(expected) && expected[i] != nil {
+			exp = *expected[i]
+		}
+		if i < len(actual) && actual[i] != nil {
+			act = *actual[i]
+		}
+
+		add := func(field string, expected, actual interface{}) {
+			e, a := fmt.Sprintf("%v", expected), fmt.Sprintf("%v", actual)
+			if e != a {
+				diffs = append(diffs, txResultDiff{
+					Index:    i,
+					Hash:     fmt.Sprintf("%X", tx.Hash(block.Height)),
+					Field:    field,
+					Expected: e,
+					Actual:   a,
+				})
+			}
+		}
+		add("code", exp.Code, act.Code)
+		add("codespace", exp.Codespace, act.Codespace)
+		add("gas_wanted", exp.GasWanted, act.GasWanted)
+		add("gas_used", exp.GasUsed, act.GasUsed)
+		add("data", hex.EncodeToString(exp.Data), hex.EncodeToString(act.Data))
+		add("log", exp.Log, act.Log)
+		add("events", marshalEvents(exp.Events), marshalEvents(act.Events))
+	}
+	return diffs
+}
+
+func marshalEvents(events []abci.Event) string {
+	bz, _ := json.Marshal(events)
+	return string(bz)
+}
@@ -23,6 +23,7 @@ import (
 	bankrest "github.com/okex/exchain/libs/cosmos-sdk/x/bank/client/rest"
 	supplyrest "github.com/okex/exchain/libs/cosmos-sdk/x/supply/client/rest"
 	ammswaprest "github.com/okex/exchain/x/ammswap/client/rest"
+	circuitclient "github.com/okex/exchain/x/circuit/client"
 	dexclient "github.com/okex/exchain/x/dex/client"
 	dexrest "github.com/okex/exchain/x/dex/client/rest"
 	dist "github.com/okex/exchain/x/distribution"
@@ -37,6 +38,7 @@ import (
 	paramsclient "github.com/okex/exchain/x/params/client"
 	stakingrest "github.com/okex/exchain/x/staking/client/rest"
 	"github.com/okex/exchain/x/token"
+	tokenclient "github.com/okex/exchain/x/token/client"
 	tokensrest "github.com/okex/exchain/x/token/client/rest"
 	wasmrest "github.com/okex/exchain/x/wasm/client/rest"
 	"github.com/spf13/viper"
@@ -90,8 +92,13 @@ func registerRoutesV1(rs *lcd.RestServer, pathPrefix string) {
 			distr.RewardTruncatePrecisionProposalHandler.RESTHandler(rs.CliCtx),
 			dexclient.DelistProposalHandler.RESTHandler(rs.CliCtx),
 			farmclient.ManageWhiteListProposalHandler.RESTHandler(rs.CliCtx),
+			tokenclient.TokenControlProposalHandler.RESTHandler(rs.CliCtx),
+			circuitclient.CircuitBreakerProposalHandler.RESTHandler(rs.CliCtx),
 			evmclient.ManageContractDeploymentWhitelistProposalHandler.RESTHandler(rs.CliCtx),
 			evmclient.ManageSysContractAddressProposalHandler.RESTHandler(rs.CliCtx),
+			evmclient.ScheduleChainConfigUpgradeProposalHandler.RESTHandler(rs.CliCtx),
+			evmclient.UpdateGasScheduleProposalHandler.RESTHandler(rs.CliCtx),
+			evmclient.UpdateMaxGasLimitPerTxProposalHandler.RESTHandler(rs.CliCtx),
 			mintclient.ManageTreasuresProposalHandler.RESTHandler(rs.CliCtx),
 			erc20client.TokenMappingProposalHandler.RESTHandler(rs.CliCtx),
 		},
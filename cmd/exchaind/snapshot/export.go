@@ -0,0 +1,261 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/server"
+	"github.com/okex/exchain/libs/cosmos-sdk/store/rootmulti"
+	"github.com/okex/exchain/libs/tendermint/node"
+	sm "github.com/okex/exchain/libs/tendermint/state"
+	"github.com/okex/exchain/libs/tendermint/store"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func exportCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the data of the stopped node to a snapshot bundle",
+		Long: `Export the databases of the data directory to a snapshot bundle, which is a compressed archive split into
+checksummed chunks and a manifest.json describing them. The node must be stopped, e.g. with --halt-height, at the
+height of the snapshot. The private validator state and the consensus WAL are never exported.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			m, err := loadSnapshotInfo(ctx, viper.GetInt64(flagHeight))
+			if err != nil {
+				return err
+			}
+			m.Databases, err = listDatabases(config.DBDir(), strings.Split(viper.GetString(flagExclude), ","))
+			if err != nil {
+				return err
+			}
+			m.ChunkSize = viper.GetInt64(flagChunkSize)
+
+			output := viper.GetString(flagOutput)
+			log.Printf("Exporting the snapshot at height %d to %s, databases %v\n", m.Height, output, m.Databases)
+			if err := writeBundle(config.DBDir(), output, m); err != nil {
+				return err
+			}
+			log.Printf("Exported %d chunks, checksum %s\n", len(m.Chunks), m.Checksum)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height of the snapshot, which must be the height of the data. 0 exports the latest height")
+	cmd.Flags().String(flagOutput, "./snapshot", "Directory of the snapshot bundle")
+	cmd.Flags().Int64(flagChunkSize, 512<<20, "Size of the chunks of the bundle in bytes")
+	cmd.Flags().String(flagExclude, "tx_index.db,block_index.db", "Comma-separated databases not exported")
+	return cmd
+}
+
+// loadSnapshotInfo checks the block store, the state and the application are all at the height, and returns the
+// manifest of the snapshot at it
+func loadSnapshotInfo(ctx *server.Context, height int64) (*Manifest, error) {
+	config := ctx.Config
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return nil, err
+	}
+	defer blockStoreDB.Close()
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return nil, err
+	}
+	defer stateDB.Close()
+	appDB, err := node.DefaultDBProvider(&node.DBContext{ID: "application", Config: config})
+	if err != nil {
+		return nil, err
+	}
+	defer appDB.Close()
+
+	blockHeight := store.LoadBlockStoreStateJSON(blockStoreDB).Height
+	state := sm.LoadState(stateDB)
+	appHeight := rootmulti.NewStore(appDB).GetLatestVersion()
+	if blockHeight != state.LastBlockHeight || blockHeight != appHeight {
+		return nil, fmt.Errorf("inconsistent heights of the block store %d, the state %d and the application %d, "+
+			"the node must be stopped cleanly", blockHeight, state.LastBlockHeight, appHeight)
+	}
+	if height != 0 && height != blockHeight {
+		return nil, fmt.Errorf("the data is at height %d instead of %d, stop the node at the height with --halt-height",
+			blockHeight, height)
+	}
+
+	return &Manifest{
+		Version: bundleVersion,
+		ChainID: state.ChainID,
+		Height:  blockHeight,
+		AppHash: hex.EncodeToString(state.AppHash),
+	}, nil
+}
+
+// listDatabases returns the database directories in dataDir except the excluded ones
+func listDatabases(dataDir string, excluded []string) ([]string, error) {
+	infos, err := ioutil.ReadDir(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	var dbs []string
+	for _, info := range infos {
+		name := info.Name()
+		if !info.IsDir() || !strings.HasSuffix(name, ".db") || contains(excluded, name) {
+			continue
+		}
+		dbs = append(dbs, name)
+	}
+	if len(dbs) == 0 {
+		return nil, fmt.Errorf("no database in %s", dataDir)
+	}
+	return dbs, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if strings.TrimSpace(e) == s {
+			return true
+		}
+	}
+	return false
+}
+
+// writeBundle archives m.Databases of dataDir into the chunks of the bundle in outDir, and writes the manifest
+func writeBundle(dataDir, outDir string, m *Manifest) error {
+	if m.ChunkSize <= 0 {
+		return fmt.Errorf("invalid chunk size %d", m.ChunkSize)
+	}
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return err
+	}
+
+	cw := &chunkWriter{dir: outDir, chunkSize: m.ChunkSize, total: sha256.New()}
+	gw := gzip.NewWriter(cw)
+	tw := tar.NewWriter(gw)
+	for _, db := range m.Databases {
+		if err := archiveDir(tw, dataDir, db); err != nil {
+			cw.Close()
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gw.Close(); err != nil {
+		return err
+	}
+	if err := cw.Close(); err != nil {
+		return err
+	}
+
+	m.Chunks = cw.chunks
+	m.Checksum = hex.EncodeToString(cw.total.Sum(nil))
+	return writeManifest(filepath.Join(outDir, manifestName), m)
+}
+
+// archiveDir writes the regular files of the directory dir of root to tw, with the paths relative to root
+func archiveDir(tw *tar.Writer, root, dir string) error {
+	return filepath.Walk(filepath.Join(root, dir), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			return nil
+		}
+		name, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+}
+
+// chunkWriter splits the written bytes into the chunk files of chunkSize, and computes their checksums
+type chunkWriter struct {
+	dir       string
+	chunkSize int64
+	total     hash.Hash
+
+	file    *os.File
+	hash    hash.Hash
+	written int64
+	chunks  []Chunk
+}
+
+func (cw *chunkWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		if cw.file == nil {
+			name := fmt.Sprintf(chunkNameFmt, len(cw.chunks))
+			f, err := os.Create(filepath.Join(cw.dir, name))
+			if err != nil {
+				return n, err
+			}
+			cw.file, cw.hash, cw.written = f, sha256.New(), 0
+			cw.chunks = append(cw.chunks, Chunk{Name: name})
+		}
+
+		bz := p
+		if left := cw.chunkSize - cw.written; int64(len(bz)) > left {
+			bz = bz[:left]
+		}
+		if _, err := io.MultiWriter(cw.file, cw.hash, cw.total).Write(bz); err != nil {
+			return n, err
+		}
+		n += len(bz)
+		p = p[len(bz):]
+		cw.written += int64(len(bz))
+
+		if cw.written == cw.chunkSize {
+			if err := cw.closeChunk(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (cw *chunkWriter) Close() error {
+	if cw.file == nil {
+		return nil
+	}
+	return cw.closeChunk()
+}
+
+func (cw *chunkWriter) closeChunk() error {
+	chunk := &cw.chunks[len(cw.chunks)-1]
+	chunk.Size = cw.written
+	chunk.Checksum = hex.EncodeToString(cw.hash.Sum(nil))
+	err := cw.file.Close()
+	cw.file = nil
+	return err
+}
@@ -0,0 +1,310 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/server"
+	tmos "github.com/okex/exchain/libs/tendermint/libs/os"
+	"github.com/okex/exchain/libs/tendermint/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func restoreCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [bundle]",
+		Short: "Restore the data of the node from a snapshot bundle",
+		Long: `Restore the databases of the data directory from a snapshot bundle, which is a local directory or an
+http(s) URL of the directory containing manifest.json. The chunks are downloaded to the download directory first,
+and an interrupted download is resumed by running the command again. The checksums of the chunks and of the whole
+archive are verified before the data is restored.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			source := strings.TrimSuffix(args[0], "/")
+			m, err := fetchManifest(source)
+			if err != nil {
+				return err
+			}
+			if tmos.FileExists(config.GenesisFile()) {
+				genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+				if err != nil {
+					return err
+				}
+				if genDoc.ChainID != m.ChainID {
+					return fmt.Errorf("the snapshot of chain %s can't be restored to chain %s", m.ChainID, genDoc.ChainID)
+				}
+			}
+
+			downloadDir := viper.GetString(flagDownload)
+			if downloadDir == "" {
+				downloadDir = filepath.Join(config.RootDir, "snapshot-download")
+			}
+			log.Printf("Downloading the snapshot at height %d to %s\n", m.Height, downloadDir)
+			if err := fetchChunks(source, downloadDir, m); err != nil {
+				return err
+			}
+
+			log.Printf("Restoring the databases %v to %s\n", m.Databases, config.DBDir())
+			if err := extractBundle(downloadDir, config.DBDir(), m); err != nil {
+				return err
+			}
+			if !viper.GetBool(flagKeepChunks) {
+				os.RemoveAll(downloadDir)
+			}
+			log.Printf("Restored the snapshot at height %d, app hash %s\n", m.Height, m.AppHash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagDownload, "", "Directory of the downloaded chunks, <home>/snapshot-download by default")
+	cmd.Flags().Bool(flagKeepChunks, false, "Keep the downloaded chunks after the restore")
+	return cmd
+}
+
+func isRemote(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+}
+
+func fetchManifest(source string) (*Manifest, error) {
+	if !isRemote(source) {
+		return readManifest(filepath.Join(source, manifestName))
+	}
+
+	resp, err := http.Get(source + "/" + manifestName)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download the manifest: %s", resp.Status)
+	}
+	bz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return parseManifest(bz)
+}
+
+// fetchChunks downloads the chunks of the bundle from source to dir, the verified chunks already in dir are skipped
+// and the partial ones are resumed
+func fetchChunks(source, dir string, m *Manifest) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	for i, chunk := range m.Chunks {
+		path := filepath.Join(dir, chunk.Name)
+		if err := verifyChunk(path, chunk); err == nil {
+			continue
+		}
+
+		var err error
+		if isRemote(source) {
+			err = downloadChunk(source+"/"+chunk.Name, path, chunk)
+		} else {
+			err = copyChunk(filepath.Join(source, chunk.Name), path)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch chunk %s: %w", chunk.Name, err)
+		}
+		if err := verifyChunk(path, chunk); err != nil {
+			// the chunk is downloaded again next time
+			os.Remove(path)
+			return err
+		}
+		log.Printf("Fetched chunk %d/%d\n", i+1, len(m.Chunks))
+	}
+	return nil
+}
+
+// downloadChunk downloads the chunk from url to path, resuming from the size of path if it exists
+func downloadChunk(url, path string, chunk Chunk) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if offset >= chunk.Size {
+		// a corrupted chunk is downloaded again
+		if err := f.Truncate(0); err != nil {
+			return err
+		}
+		if offset, err = f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		// the server doesn't support ranges, download the whole chunk
+		if err := f.Truncate(0); err != nil {
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unexpected response %s", resp.Status)
+	}
+	_, err = io.Copy(f, resp.Body)
+	return err
+}
+
+func copyChunk(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func verifyChunk(path string, chunk Chunk) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return err
+	}
+	if size != chunk.Size || hex.EncodeToString(h.Sum(nil)) != chunk.Checksum {
+		return fmt.Errorf("checksum mismatch of chunk %s", chunk.Name)
+	}
+	return nil
+}
+
+// extractBundle extracts the archive of the chunks in dir to dataDir, which mustn't contain the databases of the
+// bundle. The databases are extracted to a temporary directory first, and moved to dataDir once the checksum of the
+// archive is verified.
+func extractBundle(dir, dataDir string, m *Manifest) error {
+	for _, db := range m.Databases {
+		if _, err := os.Stat(filepath.Join(dataDir, db)); err == nil {
+			return fmt.Errorf("%s already exists in %s, remove it before restoring", db, dataDir)
+		}
+	}
+
+	readers := make([]io.Reader, 0, len(m.Chunks))
+	for _, chunk := range m.Chunks {
+		f, err := os.Open(filepath.Join(dir, chunk.Name))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		readers = append(readers, f)
+	}
+	h := sha256.New()
+	gr, err := gzip.NewReader(io.TeeReader(io.MultiReader(readers...), h))
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dataDir, 0755); err != nil {
+		return err
+	}
+	tmpDir, err := ioutil.TempDir(dataDir, "snapshot-restore")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	if err := extractTar(tar.NewReader(gr), tmpDir); err != nil {
+		return err
+	}
+	// read the rest of the archive after the tar trailer, so that it's all hashed
+	if _, err := io.Copy(ioutil.Discard, gr); err != nil {
+		return err
+	}
+	if hex.EncodeToString(h.Sum(nil)) != m.Checksum {
+		return fmt.Errorf("checksum mismatch of the snapshot archive")
+	}
+
+	for _, db := range m.Databases {
+		if err := os.Rename(filepath.Join(tmpDir, db), filepath.Join(dataDir, db)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractTar(tr *tar.Reader, dir string) error {
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(dir, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(path, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid file path %s in the archive", header.Name)
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(header.Mode)&0644)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, tr); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported file %s in the archive", header.Name)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+const (
+	manifestName   = "manifest.json"
+	chunkNameFmt   = "chunk-%05d"
+	bundleVersion  = 1
+	flagHeight     = "height"
+	flagOutput     = "output"
+	flagChunkSize  = "chunk-size"
+	flagExclude    = "exclude"
+	flagDownload   = "download-dir"
+	flagKeepChunks = "keep-chunks"
+)
+
+// Manifest describes a snapshot bundle, which is a tar.gz archive of the databases of the data directory split into
+// chunks. It's written next to the chunks as manifest.json.
+type Manifest struct {
+	Version   int      `json:"version"`
+	ChainID   string   `json:"chain_id"`
+	Height    int64    `json:"height"`
+	AppHash   string   `json:"app_hash"`
+	Databases []string `json:"databases"`
+	ChunkSize int64    `json:"chunk_size"`
+	Chunks    []Chunk  `json:"chunks"`
+	// sha256 of the whole archive
+	Checksum string `json:"checksum"`
+}
+
+// Chunk is a part of the archive of a snapshot bundle.
+type Chunk struct {
+	Name     string `json:"name"`
+	Size     int64  `json:"size"`
+	Checksum string `json:"checksum"`
+}
+
+// Command returns the snapshot command, which exports the data of a stopped node to a bundle and restores a node from
+// a bundle.
+func Command(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Export or restore a snapshot bundle of the node data for a fast bootstrap",
+	}
+
+	cmd.AddCommand(
+		exportCmd(ctx),
+		restoreCmd(ctx),
+	)
+	return cmd
+}
+
+func readManifest(path string) (*Manifest, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseManifest(bz)
+}
+
+func parseManifest(bz []byte) (*Manifest, error) {
+	m := &Manifest{}
+	if err := json.Unmarshal(bz, m); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	if m.Version != bundleVersion {
+		return nil, fmt.Errorf("unsupported snapshot bundle version %d", m.Version)
+	}
+	if len(m.Chunks) == 0 {
+		return nil, fmt.Errorf("no chunk in the manifest")
+	}
+	// the names are joined to the paths of the local directories
+	for _, c := range m.Chunks {
+		if !isBaseName(c.Name) {
+			return nil, fmt.Errorf("invalid chunk name %q", c.Name)
+		}
+	}
+	for _, db := range m.Databases {
+		if !isBaseName(db) || !strings.HasSuffix(db, ".db") {
+			return nil, fmt.Errorf("invalid database name %q", db)
+		}
+	}
+	return m, nil
+}
+
+func isBaseName(name string) bool {
+	return name == filepath.Base(name) && name != "." && name != ".." && name != string(filepath.Separator)
+}
+
+func writeManifest(path string, m *Manifest) error {
+	bz, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, bz, 0644)
+}
@@ -0,0 +1,103 @@
+package snapshot
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFile(t *testing.T, path string, size int) []byte {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	bz := make([]byte, size)
+	rand.Read(bz)
+	require.NoError(t, ioutil.WriteFile(path, bz, 0644))
+	return bz
+}
+
+func TestSnapshotBundle(t *testing.T) {
+	root, err := ioutil.TempDir("", "snapshot_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	dataDir := filepath.Join(root, "data")
+	files := map[string][]byte{
+		"application.db/000001.ldb": writeTestFile(t, filepath.Join(dataDir, "application.db/000001.ldb"), 100000),
+		"application.db/CURRENT":    writeTestFile(t, filepath.Join(dataDir, "application.db/CURRENT"), 16),
+		"blockstore.db/000002.ldb":  writeTestFile(t, filepath.Join(dataDir, "blockstore.db/000002.ldb"), 50000),
+	}
+	writeTestFile(t, filepath.Join(dataDir, "tx_index.db/000003.ldb"), 1000)
+	writeTestFile(t, filepath.Join(dataDir, "priv_validator_state.json"), 100)
+
+	dbs, err := listDatabases(dataDir, []string{"tx_index.db"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"application.db", "blockstore.db"}, dbs)
+
+	bundleDir := filepath.Join(root, "bundle")
+	m := &Manifest{Version: bundleVersion, ChainID: "exchain-test", Height: 10, Databases: dbs, ChunkSize: 40000}
+	require.NoError(t, writeBundle(dataDir, bundleDir, m))
+	require.True(t, len(m.Chunks) > 1)
+
+	m, err = readManifest(filepath.Join(bundleDir, manifestName))
+	require.NoError(t, err)
+
+	assertRestored := func(restoredDir string) {
+		for name, bz := range files {
+			restored, err := ioutil.ReadFile(filepath.Join(restoredDir, name))
+			require.NoError(t, err)
+			require.Equal(t, bz, restored, name)
+		}
+		_, err := os.Stat(filepath.Join(restoredDir, "tx_index.db"))
+		require.True(t, os.IsNotExist(err))
+		_, err = os.Stat(filepath.Join(restoredDir, "priv_validator_state.json"))
+		require.True(t, os.IsNotExist(err))
+	}
+
+	// restore from the local bundle
+	downloadDir := filepath.Join(root, "download")
+	require.NoError(t, fetchChunks(bundleDir, downloadDir, m))
+	require.NoError(t, extractBundle(downloadDir, filepath.Join(root, "restored"), m))
+	assertRestored(filepath.Join(root, "restored"))
+	// the databases aren't overwritten
+	require.Error(t, extractBundle(downloadDir, filepath.Join(root, "restored"), m))
+
+	// restore from the remote bundle, resuming a partial chunk and downloading a corrupted chunk again
+	server := httptest.NewServer(http.FileServer(http.Dir(bundleDir)))
+	defer server.Close()
+	remoteManifest, err := fetchManifest(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, m, remoteManifest)
+
+	downloadDir = filepath.Join(root, "remote-download")
+	require.NoError(t, os.MkdirAll(downloadDir, 0755))
+	chunk0, err := ioutil.ReadFile(filepath.Join(bundleDir, m.Chunks[0].Name))
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(downloadDir, m.Chunks[0].Name), chunk0[:1000], 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(downloadDir, m.Chunks[1].Name), make([]byte, m.Chunks[1].Size), 0644))
+
+	require.NoError(t, fetchChunks(server.URL, downloadDir, m))
+	require.NoError(t, extractBundle(downloadDir, filepath.Join(root, "remote-restored"), m))
+	assertRestored(filepath.Join(root, "remote-restored"))
+
+	// the corrupted archive isn't restored
+	m.Checksum = "00"
+	require.Error(t, extractBundle(downloadDir, filepath.Join(root, "corrupted"), m))
+	_, err = os.Stat(filepath.Join(root, "corrupted", "application.db"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestParseManifest(t *testing.T) {
+	_, err := parseManifest([]byte(`{"version":1,"chunks":[{"name":"../chunk"}]}`))
+	require.Error(t, err)
+	_, err = parseManifest([]byte(`{"version":1,"chunks":[{"name":"chunk"}],"databases":["../state.db"]}`))
+	require.Error(t, err)
+	_, err = parseManifest([]byte(`{"version":2,"chunks":[{"name":"chunk"}]}`))
+	require.Error(t, err)
+	_, err = parseManifest([]byte(`{"version":1,"chunks":[{"name":"chunk"}],"databases":["state.db"]}`))
+	require.NoError(t, err)
+}
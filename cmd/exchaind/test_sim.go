@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/okex/exchain/app"
+	"github.com/okex/exchain/libs/cosmos-sdk/baseapp"
+	"github.com/okex/exchain/libs/cosmos-sdk/simapp"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+)
+
+const (
+	flagSimGenesis            = "genesis"
+	flagSimParams             = "params"
+	flagSimExportParamsPath   = "export-params-path"
+	flagSimExportParamsHeight = "export-params-height"
+	flagSimExportStatePath    = "export-state-path"
+	flagSimExportStatsPath    = "export-stats-path"
+	flagSimSeed               = "seed"
+	flagSimInitialHeight      = "initial-height"
+	flagSimNumBlocks          = "num-blocks"
+	flagSimBlockSize          = "block-size"
+	flagSimLean               = "lean"
+	flagSimCommit             = "commit"
+	flagSimAllInvariants      = "all-invariants"
+	flagSimVerbose            = "verbose"
+	flagSimPeriod             = "period"
+	flagSimGenesisTime        = "genesis-time"
+	flagSimEarthHeight        = "earth-height"
+)
+
+// simTB is the testing.TB the simulation runs with out of the go tests. The simulation fails through Fatalf and FailNow
+// only, which panic to halt it.
+type simTB struct {
+	testing.TB
+}
+
+func (simTB) Fatalf(format string, args ...interface{}) {
+	panic(fmt.Sprintf(format, args...))
+}
+
+func (simTB) FailNow() {
+	panic("simulation failed")
+}
+
+func testSimCmd() *cobra.Command {
+	var earthHeight int64
+	cmd := &cobra.Command{
+		Use:   "test-sim",
+		Short: "Run a multi-block randomized simulation of the app for regression testing",
+		Long: `Run a multi-block randomized simulation of the app on a temporary database. The genesis, the params and
+the msgs of the simulated modules are randomized from the seed. The invariants are asserted every period blocks when a
+period is set. A failed simulation is replayed by running it again with the same seed and flags.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// the wasm msgs are handled since the earth upgrade
+			tmtypes.UnittestOnlySetMilestoneEarthHeight(earthHeight)
+			return runSimulation()
+		},
+	}
+
+	cmd.Flags().StringVar(&simapp.FlagGenesisFileValue, flagSimGenesis, "", "custom simulation genesis file; cannot be used with params file")
+	cmd.Flags().StringVar(&simapp.FlagParamsFileValue, flagSimParams, "", "custom simulation params file which overrides any random params; cannot be used with genesis")
+	cmd.Flags().StringVar(&simapp.FlagExportParamsPathValue, flagSimExportParamsPath, "", "custom file path to save the exported params JSON")
+	cmd.Flags().IntVar(&simapp.FlagExportParamsHeightValue, flagSimExportParamsHeight, 0, "height to which export the randomly generated params")
+	cmd.Flags().StringVar(&simapp.FlagExportStatePathValue, flagSimExportStatePath, "", "custom file path to save the exported app state JSON")
+	cmd.Flags().StringVar(&simapp.FlagExportStatsPathValue, flagSimExportStatsPath, "", "custom file path to save the exported simulation statistics JSON")
+	cmd.Flags().Int64Var(&simapp.FlagSeedValue, flagSimSeed, 42, "simulation random seed")
+	cmd.Flags().IntVar(&simapp.FlagInitialBlockHeightValue, flagSimInitialHeight, 1, "initial block to start the simulation")
+	cmd.Flags().IntVar(&simapp.FlagNumBlocksValue, flagSimNumBlocks, 500, "number of new blocks to simulate from the initial block height")
+	cmd.Flags().IntVar(&simapp.FlagBlockSizeValue, flagSimBlockSize, 200, "operations per block")
+	cmd.Flags().BoolVar(&simapp.FlagLeanValue, flagSimLean, false, "lean simulation log output")
+	cmd.Flags().BoolVar(&simapp.FlagCommitValue, flagSimCommit, true, "have the simulation commit")
+	cmd.Flags().BoolVar(&simapp.FlagAllInvariantsValue, flagSimAllInvariants, false, "print all invariants if a broken invariant is found")
+	cmd.Flags().BoolVar(&simapp.FlagVerboseValue, flagSimVerbose, false, "verbose log output")
+	cmd.Flags().UintVar(&simapp.FlagPeriodValue, flagSimPeriod, 0, "assert the invariants every period blocks, 0 to skip them")
+	cmd.Flags().Int64Var(&simapp.FlagGenesisTimeValue, flagSimGenesisTime, 0, "override genesis UNIX time instead of using the current time")
+	cmd.Flags().Int64Var(&earthHeight, flagSimEarthHeight, 1, "height of the earth upgrade the wasm msgs are simulated from, 0 to disable them")
+	return cmd
+}
+
+// runSimulation runs the simulation configured by the flags on a temporary database
+func runSimulation() (err error) {
+	simapp.FlagEnabledValue = true
+	config, db, dir, logger, _, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	if err != nil {
+		return fmt.Errorf("simulation setup failed: %w", err)
+	}
+	defer func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}()
+
+	simApp := app.NewOKExChainApp(logger, db, nil, true, map[int64]bool{}, simapp.FlagPeriodValue,
+		func(bapp *baseapp.BaseApp) { bapp.SetFauxMerkleMode() })
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("simulation failed with seed %d: %v", config.Seed, r)
+		}
+	}()
+
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		simTB{}, os.Stdout, simApp.BaseApp, app.AppStateFn(simApp.Codec(), simApp.SimulationManager()),
+		simapp.SimulationOperations(simApp, simApp.Codec(), config),
+		simApp.ModuleAccountAddrs(), config,
+	)
+
+	// export state and simParams before the simulation error is checked
+	if err = simapp.CheckExportSimulation(simApp, config, simParams); err != nil {
+		return err
+	}
+	if simErr != nil {
+		return fmt.Errorf("simulation failed with seed %d: %w", config.Seed, simErr)
+	}
+
+	if config.Commit {
+		simapp.PrintStats(db)
+	}
+	return nil
+}
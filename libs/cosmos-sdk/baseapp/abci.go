@@ -17,6 +17,7 @@ import (
 	"github.com/okex/exchain/app/rpc/simulator"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	"github.com/okex/exchain/libs/cosmos-sdk/store/mpt"
+	"github.com/okex/exchain/libs/cosmos-sdk/store/rootmulti"
 	"github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
@@ -25,6 +26,7 @@ import (
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 	tmtypes "github.com/okex/exchain/libs/tendermint/types"
 	"github.com/tendermint/go-amino"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // InitChain implements the ABCI interface. It runs the initialization logic
@@ -159,9 +161,19 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	}
 
 	app.deliverState.ctx.SetBlockGasMeter(gasMeter)
+	app.updateCosmosGasFactor(app.deliverState.ctx)
+
+	// the spans of the block are the children of the block span, which is ended on Commit
+	if app.blockSpan != nil {
+		app.blockSpan.End()
+	}
+	app.blockSpan = app.deliverState.ctx.StartSpan("block", attribute.Int64("height", req.Header.Height))
 
 	if app.beginBlocker != nil {
-		res = app.beginBlocker(app.deliverState.ctx, req)
+		ctx := app.deliverState.ctx
+		span := ctx.StartSpan("beginBlock")
+		res = app.beginBlocker(ctx, req)
+		span.End()
 	}
 
 	// set the signed validators for addition to context in deliverTx
@@ -189,6 +201,30 @@ func (app *BaseApp) UpdateFeeCollector(fee sdk.Coins, add bool) {
 	}
 }
 
+// isFeeCollectionDeferred returns whether the fees of the txs in the block are credited to the fee collector at once
+// at the end of the block, the ante handlers only deduct them from the payers
+func (app *BaseApp) isFeeCollectionDeferred() bool {
+	return tmtypes.HigherThanVenus5(app.deliverState.ctx.BlockHeight())
+}
+
+// settleFeeCollectorAccount credits the fees accumulated since the last settlement to the fee collector. Unlike
+// updateFeeCollectorAccount, a failed settlement isn't recovered: it breaks the fee collector invariant, so the
+// chain halts rather than lose the fees.
+func (app *BaseApp) settleFeeCollectorAccount() {
+	if app.updateFeeCollectorAccHandler == nil || !app.feeChanged {
+		return
+	}
+
+	ctx, cache := app.cacheTxContext(app.getContextForTx(runTxModeDeliver, []byte{}), []byte{})
+	if err := app.updateFeeCollectorAccHandler(ctx, app.feeCollector, app.FeeSplitCollector); err != nil {
+		panic(fmt.Sprintf("settle fee collector account failed: %s", err))
+	}
+	cache.Write()
+
+	app.feeCollector = sdk.Coins{}
+	app.feeChanged = false
+}
+
 func (app *BaseApp) updateFeeCollectorAccount(isEndBlock bool) {
 	if app.updateFeeCollectorAccHandler == nil || !app.feeChanged {
 		return
@@ -217,19 +253,36 @@ func (app *BaseApp) updateFeeCollectorAccount(isEndBlock bool) {
 
 // EndBlock implements the ABCI interface.
 func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBlock) {
-	app.updateFeeCollectorAccount(true)
+	if app.isFeeCollectionDeferred() {
+		app.settleFeeCollectorAccount()
+	} else {
+		app.updateFeeCollectorAccount(true)
+	}
 
 	if app.deliverState.ms.TracingEnabled() {
 		app.deliverState.ms = app.deliverState.ms.SetTracingContext(nil).(sdk.CacheMultiStore)
 	}
 
 	if app.endBlocker != nil {
-		res = app.endBlocker(app.deliverState.ctx, req)
+		ctx := app.deliverState.ctx
+		span := ctx.StartSpan("endBlock")
+		res = app.endBlocker(ctx, req)
+		span.End()
 	}
 
 	return
 }
 
+// observeCommitMetrics observes the time spent on the commit and each stage of it
+func observeCommitMetrics(start time.Time) {
+	m := rootmulti.GetMetrics()
+	m.CommitDuration.Observe(time.Since(start).Seconds())
+	stats := persist.GetStatistics()
+	for _, tag := range stats.GetTags() {
+		m.CommitStageDuration.With("stage", tag).Observe(time.Duration(stats.GetValue(tag)).Seconds())
+	}
+}
+
 func (app *BaseApp) addCommitTraceInfo() {
 	nodeReadCountStr := strconv.Itoa(app.cms.GetNodeReadCount())
 	dbReadCountStr := strconv.Itoa(app.cms.GetDBReadCount())
@@ -277,11 +330,24 @@ func (app *BaseApp) addCommitTraceInfo() {
 func (app *BaseApp) Commit(req abci.RequestCommit) abci.ResponseCommit {
 
 	persist.GetStatistics().Init(trace.PreChange, trace.FlushCache, trace.CommitStores, trace.FlushMeta)
-	defer func() {
+	defer func(start time.Time) {
 		trace.GetElapsedInfo().AddInfo(trace.PersistDetails, persist.GetStatistics().Format())
-	}()
+		observeCommitMetrics(start)
+	}(time.Now())
 	header := app.deliverState.ctx.BlockHeader()
+	commitCtx := app.deliverState.ctx
+	commitSpan := commitCtx.StartSpan("commit")
+	defer func() {
+		commitSpan.End()
+		if app.blockSpan != nil {
+			app.blockSpan.End()
+			app.blockSpan = nil
+		}
+	}()
 
+	if app.preCommitHandler != nil {
+		app.preCommitHandler(app.deliverState.ctx)
+	}
 	if app.mptCommitHandler != nil {
 		app.mptCommitHandler(app.deliverState.ctx)
 	}
@@ -331,6 +397,9 @@ func (app *BaseApp) Commit(req abci.RequestCommit) abci.ResponseCommit {
 
 	case app.haltTime > 0 && header.Time.Unix() >= int64(app.haltTime):
 		halt = true
+
+	case app.haltAfterCommit:
+		halt = true
 	}
 
 	if halt {
@@ -350,7 +419,8 @@ func (app *BaseApp) Commit(req abci.RequestCommit) abci.ResponseCommit {
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
 // back on os.Exit if both fail.
 func (app *BaseApp) halt() {
-	app.logger.Info("halting node per configuration", "height", app.haltHeight, "time", app.haltTime)
+	app.logger.Info("halting node per configuration", "height", app.haltHeight, "time", app.haltTime,
+		"scheduled", app.haltAfterCommit)
 
 	p, err := os.FindProcess(os.Getpid())
 	if err == nil {
@@ -373,6 +443,9 @@ func (app *BaseApp) halt() {
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
 // implements Queryable.
 func (app *BaseApp) Query(req abci.RequestQuery) abci.ResponseQuery {
+	app.replicaMtx.RLock()
+	defer app.replicaMtx.RUnlock()
+
 	ceptor := app.interceptors[req.Path]
 	if nil != ceptor {
 		// interceptor is like `aop`,it may record the request or rewrite the data in the request
@@ -445,22 +518,21 @@ func handleSimulate(app *BaseApp, path []string, height int64, txBytes []byte, o
 			wasmSimulator := simulator.NewWasmSimulator()
 			wasmSimulator.Context().GasMeter().ConsumeGas(73000, "general ante check cost")
 			wasmSimulator.Context().GasMeter().ConsumeGas(uint64(10*len(txBytes)), "tx size cost")
-			res, err := wasmSimulator.Simulate(msgs)
-			if err != nil {
-				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to simulate wasm tx"))
-			}
-
-			gasMeter := wasmSimulator.Context().GasMeter()
-			simRes := sdk.SimulationResponse{
-				GasInfo: sdk.GasInfo{
-					GasUsed: gasMeter.GasConsumed(),
-				},
-				Result: res,
-			}
-			return abci.ResponseQuery{
-				Codespace: sdkerrors.RootCodespace,
-				Height:    height,
-				Value:     codec.Cdc.MustMarshalBinaryBare(simRes),
+			// the wasm simulator can't run the messages the contracts dispatch to the other modules, like the vmbridge
+			// calls to the evm, so the tx is simulated by the app when it fails
+			if res, err := wasmSimulator.Simulate(msgs); err == nil {
+				gasMeter := wasmSimulator.Context().GasMeter()
+				simRes := sdk.SimulationResponse{
+					GasInfo: sdk.GasInfo{
+						GasUsed: gasMeter.GasConsumed(),
+					},
+					Result: res,
+				}
+				return abci.ResponseQuery{
+					Codespace: sdkerrors.RootCodespace,
+					Height:    height,
+					Value:     codec.Cdc.MustMarshalBinaryBare(simRes),
+				}
 			}
 		}
 
@@ -485,6 +557,9 @@ func handleSimulate(app *BaseApp, path []string, height int64, txBytes []byte, o
 		Value:     codec.Cdc.MustMarshalBinaryBare(simRes),
 	}
 }
+// maxStateDiffChanges is the max number of the changes returned by a state diff query
+const maxStateDiffChanges = 10000
+
 func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.ResponseQuery {
 	if len(path) >= 2 {
 		switch path[1] {
@@ -534,6 +609,58 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 				Value:     []byte(app.appVersion),
 			}
 
+		case "txresources":
+			resources, err := app.GetTxResources(req.Data)
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, err.Error()))
+			}
+			bz, err := json.Marshal(resources)
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to marshal the tx resources"))
+			}
+			return abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "statediff":
+			var queryParam sdk.QueryStateDiff
+			if err := json.Unmarshal(req.Data, &queryParam); err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "invalid state diff params"))
+			}
+			rs, ok := app.cms.(*rootmulti.Store)
+			if !ok {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "state diff isn't supported by the store"))
+			}
+			if queryParam.Limit <= 0 || queryParam.Limit > maxStateDiffChanges {
+				queryParam.Limit = maxStateDiffChanges
+			}
+			diff, err := rs.DiffVersions(queryParam.From, queryParam.To, queryParam.Stores, queryParam.Prefix, queryParam.Limit)
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to diff the state"))
+			}
+			bz, err := json.Marshal(diff)
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to marshal the state diff"))
+			}
+			return abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
+		case "event_schemas":
+			bz, err := json.Marshal(sdk.GetEventSchemas())
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to marshal event schemas"))
+			}
+			return abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
 		default:
 			return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path))
 		}
@@ -652,7 +779,11 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 	//
 	// For example, in the path "custom/gov/proposal/test", the gov querier gets
 	// []string{"proposal", "test"} as the path.
-	resBytes, err := querier(ctx, path[2:], req)
+	var resBytes []byte
+	err = app.runMeteredQuery(&ctx, "", func() (err error) {
+		resBytes, err = querier(ctx, path[2:], req)
+		return err
+	})
 	if err != nil {
 		space, code, log := sdkerrors.ABCIInfo(err, false)
 		return abci.ResponseQuery{
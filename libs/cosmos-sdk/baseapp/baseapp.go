@@ -8,9 +8,12 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/spf13/viper"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/codec/types"
@@ -30,6 +33,7 @@ import (
 	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
 	tmtypes "github.com/okex/exchain/libs/tendermint/types"
 	dbm "github.com/okex/exchain/libs/tm-db"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -175,6 +179,11 @@ type BaseApp struct { // nolint: maligned
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
 
+	// gas limit of each query, and gas budgets of the queries of each gRPC connection. This is mainly used for DoS
+	// prevention on the public nodes.
+	queryGasLimit   uint64
+	queryGasBudgets *queryGasBudgets
+
 	// flag for sealing options and parameters to a BaseApp
 	sealed bool
 
@@ -184,6 +193,9 @@ type BaseApp struct { // nolint: maligned
 	// minimum block time (in Unix seconds) at which to halt the chain and gracefully shutdown
 	haltTime uint64
 
+	// set by the application during the block whose commit halts the chain per its own schedule
+	haltAfterCommit bool
+
 	// application's version string
 	appVersion string
 
@@ -200,6 +212,7 @@ type BaseApp struct { // nolint: maligned
 
 	customizeModuleOnStop []sdk.CustomizeOnStop
 	mptCommitHandler      sdk.MptCommitHandler // handler for mpt trie commit
+	preCommitHandler      sdk.PreCommitHandler // handler running before the block is committed
 	feeCollector          sdk.Coins
 	feeChanged            bool // used to judge whether should update the fee-collector account
 	FeeSplitCollector     []*sdk.FeeSplitInfo
@@ -210,10 +223,15 @@ type BaseApp struct { // nolint: maligned
 	checkTxNum        int64
 	wrappedCheckTxNum int64
 	anteTracer        *trace.Tracer
+	blockSpan         oteltrace.Span
 
 	preDeliverTxHandler sdk.PreDeliverTxHandler
 	blockDataCache      *blockDataCache
 
+	// factor converting the gas of the cosmos txs to the gas of the block, read at the beginning of each block
+	cosmosGasFactorHandler sdk.CosmosGasFactorHandler
+	cosmosGasFactor        atomic.Value
+
 	interfaceRegistry types.InterfaceRegistry
 	grpcQueryRouter   *GRPCQueryRouter  // router for redirecting gRPC query calls
 	msgServiceRouter  *MsgServiceRouter // router for redirecting Msg service messages
@@ -226,6 +244,12 @@ type BaseApp struct { // nolint: maligned
 	watcherCollector sdk.EvmWatcherCollector
 
 	tmClient client.Client
+
+	// resources of the latest delivered txs by hash, nil if the resource accounting is disabled
+	txResources *lru.Cache
+
+	// guards the queries against the reloads of the latest version of a read-only replica
+	replicaMtx sync.RWMutex
 }
 
 type recordHandle func(string)
@@ -262,6 +286,7 @@ func NewBaseApp(
 
 		checkTxCacheMultiStores: newCacheMultiStoreList(),
 		FeeSplitCollector:       make([]*sdk.FeeSplitInfo, 0),
+		txResources:             newTxResourcesCache(),
 	}
 
 	for _, option := range options {
@@ -395,6 +420,19 @@ func (app *BaseApp) LoadLatestVersion(baseKey *sdk.KVStoreKey) error {
 	return app.initFromMainStore(baseKey)
 }
 
+// ReloadLatestVersion loads the latest version of the state of a read-only replica after its db caught up with the
+// db of the primary node, the queries are blocked in the meantime
+func (app *BaseApp) ReloadLatestVersion() error {
+	app.replicaMtx.Lock()
+	defer app.replicaMtx.Unlock()
+
+	if err := app.cms.LoadLatestVersion(); err != nil {
+		return err
+	}
+	app.setCheckState(abci.Header{Height: app.LastBlockHeight()})
+	return nil
+}
+
 // DefaultStoreLoader will be used by default and loads the latest version
 func DefaultStoreLoader(ms sdk.CommitMultiStore) error {
 	return ms.LoadLatestVersion()
@@ -529,6 +567,12 @@ func (app *BaseApp) setHaltTime(haltTime uint64) {
 	app.haltTime = haltTime
 }
 
+// HaltAfterCommit halts the node once the current block is committed, it's meant to be called by the application
+// while delivering a block, so that all the nodes halt at the same height.
+func (app *BaseApp) HaltAfterCommit() {
+	app.haltAfterCommit = true
+}
+
 func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
@@ -704,6 +748,14 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context
 	}
 	ctx.SetFeeSplitInfo(&sdk.FeeSplitInfo{})
 
+	// the structured fields of the logs of the tx, the hash is computed only when something is logged
+	if logger := ctx.Logger(); logger != nil {
+		height := ctx.BlockHeight()
+		ctx.SetLogger(logger.With("height", height, "txhash", log.Lazy(func() interface{} {
+			return fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash(height))
+		})))
+	}
+
 	return ctx
 }
 
@@ -1000,6 +1052,12 @@ func (app *BaseApp) GetRawTxInfo(rawTx tmtypes.Tx) mempool.ExTxInfo {
 	return app.GetTxInfo(ctx, tx)
 }
 
+// ShouldLogTx implements the mempool.WalFilter, only the evm txs are logged to the wal of the mempool
+func (app *BaseApp) ShouldLogTx(tx abci.TxEssentials) bool {
+	stdTx, ok := tx.(sdk.Tx)
+	return ok && stdTx.GetType() == sdk.EvmTxType
+}
+
 func (app *BaseApp) GetRealTxFromRawTx(rawTx tmtypes.Tx) abci.TxEssentials {
 	if tx, ok := app.blockDataCache.GetTx(rawTx); ok {
 		return tx
@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
@@ -15,6 +16,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	grpcstatus "google.golang.org/grpc/status"
 )
@@ -40,7 +42,11 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQu
 		return sdkerrors.QueryResult(err)
 	}
 
-	res, err := handler(ctx, req)
+	var res abci.ResponseQuery
+	err = app.runMeteredQuery(&ctx, "", func() (err error) {
+		res, err = handler(ctx, req)
+		return err
+	})
 	if err != nil {
 		res = sdkerrors.QueryResult(gRPCErrorToSDKError(err))
 		res.Height = req.Height
@@ -143,7 +149,9 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 
 		// Create the sdk.Context. Passing false as 2nd arg, as we can't
 		// actually support proofs with gRPC right now.
+		app.replicaMtx.RLock()
 		sdkCtx, err := app.createQueryContext(height, false)
+		app.replicaMtx.RUnlock()
 		if err != nil {
 			return nil, err
 		}
@@ -153,15 +161,27 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			height = sdkCtx.BlockHeight() // If height was not set in the request, set it to the latest
 		}
 
-		// Attach the sdk.Context into the gRPC's context.Context.
-		grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
-
-		md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
-		if err = grpc.SetHeader(grpcCtx, md); err != nil {
-			app.logger.Error("failed to set gRPC header", "err", err)
+		// the queries of each connection are metered against its gas budget
+		var conn string
+		if p, ok := peer.FromContext(grpcCtx); ok && p.Addr != nil {
+			conn = p.Addr.String()
 		}
+		err = app.runMeteredQuery(&sdkCtx, conn, func() error {
+			// Attach the sdk.Context into the gRPC's context.Context.
+			grpcCtx = context.WithValue(grpcCtx, sdk.SdkContextKey, sdkCtx)
 
-		return handler(grpcCtx, req)
+			md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+			if err := grpc.SetHeader(grpcCtx, md); err != nil {
+				app.logger.Error("failed to set gRPC header", "err", err)
+			}
+
+			resp, err = handler(grpcCtx, req)
+			return err
+		})
+		if errors.Is(err, sdkerrors.ErrOutOfGas) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return resp, err
 	}
 
 	// Loop through all services and methods, add the interceptor, and register
@@ -175,7 +175,7 @@ func (m *modeHandlerBase) handleDeferRefund(*runTxInfo) {}
 //===========================================================================================
 // other members
 func (m *modeHandlerBase) setGasConsumed(info *runTxInfo) {
-	info.ctx.BlockGasMeter().ConsumeGas(info.ctx.GasMeter().GasConsumedToLimit(), "block gas meter")
+	info.ctx.BlockGasMeter().ConsumeGas(m.app.txBlockGas(info.tx, info.ctx.GasMeter().GasConsumedToLimit()), "block gas meter")
 	if info.ctx.BlockGasMeter().GasConsumed() < info.startingGas {
 		panic(sdk.ErrorGasOverflow{Descriptor: "tx gas summation"})
 	}
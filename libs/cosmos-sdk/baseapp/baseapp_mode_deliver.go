@@ -57,6 +57,7 @@ func (m *modeHandlerDeliver) handleDeferRefund(info *runTxInfo) {
 		return
 	}
 	refund := handleGasRefund(info, m.app.cacheTxContext, m.app.GasRefundHandler)
+	recordRefund(info, refund)
 	m.app.UpdateFeeCollector(refund, false)
 	if info.ctx.GetFeeSplitInfo().HasFee {
 		m.app.FeeSplitCollector = append(m.app.FeeSplitCollector, info.ctx.GetFeeSplitInfo())
@@ -27,6 +27,7 @@ func (m *modeHandlerDeliverInAsync) handleDeferRefund(info *runTxInfo) {
 	}
 	info.msCache.Write()
 	info.ctx.ParaMsg().RefundFee = refundGas
+	recordRefund(info, refundGas)
 }
 
 func (m *modeHandlerDeliverInAsync) handleDeferGasConsumed(info *runTxInfo) {
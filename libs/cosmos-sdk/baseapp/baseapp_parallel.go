@@ -196,11 +196,11 @@ func (app *BaseApp) fixFeeCollector() {
 func (app *BaseApp) runTxs() []*abci.ResponseDeliverTx {
 	maxGas := app.getMaximumBlockGas()
 	currentGas := uint64(0)
-	overFlow := func(sumGas uint64, currGas int64, maxGas uint64) bool {
+	overFlow := func(sumGas uint64, currGas uint64, maxGas uint64) bool {
 		if maxGas <= 0 {
 			return false
 		}
-		if sumGas+uint64(currGas) >= maxGas { // TODO : fix later
+		if sumGas+currGas >= maxGas || sumGas+currGas < sumGas { // TODO : fix later
 			return true
 		}
 		return false
@@ -225,11 +225,11 @@ func (app *BaseApp) runTxs() []*abci.ResponseDeliverTx {
 				break
 			}
 			isReRun := false
-			if pm.isConflict(res) || overFlow(currentGas, res.resp.GasUsed, maxGas) {
+			if pm.isConflict(res) || overFlow(currentGas, app.txBlockGas(pm.extraTxsInfo[pm.upComingTxIndex].stdTx, uint64(res.resp.GasUsed)), maxGas) {
 				rerunIdx++
 				isReRun = true
 				// conflict rerun tx
-				if !pm.extraTxsInfo[pm.upComingTxIndex].isEvm {
+				if !pm.extraTxsInfo[pm.upComingTxIndex].isEvm && !app.isFeeCollectionDeferred() {
 					app.fixFeeCollector()
 				}
 				res = app.deliverTxWithCache(pm.upComingTxIndex)
@@ -241,13 +241,14 @@ func (app *BaseApp) runTxs() []*abci.ResponseDeliverTx {
 			pm.deliverTxs[pm.upComingTxIndex] = &res.resp
 			pm.finalResult[pm.upComingTxIndex] = res
 
+			blockGas := app.txBlockGas(pm.extraTxsInfo[pm.upComingTxIndex].stdTx, uint64(res.resp.GasUsed))
 			pm.blockGasMeterMu.Lock()
 			// Note : don't take care of the case of ErrorGasOverflow
-			app.deliverState.ctx.BlockGasMeter().ConsumeGas(sdk.Gas(res.resp.GasUsed), "unexpected error")
+			app.deliverState.ctx.BlockGasMeter().ConsumeGas(sdk.Gas(blockGas), "unexpected error")
 			pm.blockGasMeterMu.Unlock()
 
 			pm.SetCurrentIndex(pm.upComingTxIndex, res)
-			currentGas += uint64(res.resp.GasUsed)
+			currentGas += blockGas
 
 			if isReRun {
 				if pm.nextTxInGroup[pm.upComingTxIndex] != 0 {
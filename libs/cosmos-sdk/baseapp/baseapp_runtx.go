@@ -5,7 +5,9 @@ import (
 	"runtime/debug"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/okex/exchain/libs/system/telemetry"
 	"github.com/okex/exchain/libs/system/trace"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
@@ -86,7 +88,7 @@ func (app *BaseApp) runtxWithInfo(info *runTxInfo, mode runTxMode, txBytes []byt
 	handler := info.handler
 	app.pin(trace.ValTxMsgs, true, mode)
 
-	if tx.GetType() != sdk.EvmTxType && mode == runTxModeDeliver {
+	if tx.GetType() != sdk.EvmTxType && mode == runTxModeDeliver && !app.isFeeCollectionDeferred() {
 		// should update the balance of FeeCollector's account when run non-evm tx
 		// which uses non-infiniteGasMeter during AnteHandleChain
 		app.updateFeeCollectorAccount(false)
@@ -97,6 +99,15 @@ func (app *BaseApp) runtxWithInfo(info *runTxInfo, mode runTxMode, txBytes []byt
 	if err != nil {
 		return err
 	}
+	if app.txResources != nil && (mode == runTxModeDeliver || mode == runTxModeDeliverInAsync) {
+		defer app.startTxResources(info)()
+	}
+	txSpan := info.ctx.StartSpan("runTx",
+		attribute.String("mode", mode.String()),
+		attribute.Int64("height", info.ctx.BlockHeight()),
+		attribute.Int("tx_type", int(tx.GetType())),
+	)
+	defer func() { telemetry.EndSpan(txSpan, err) }()
 	//info with cache saved in app to load predesessor tx state
 	if mode != runTxModeTrace {
 		//in trace mode,  info ctx cache was already set to traceBlockCache instead of app.blockCache in app.tracetx()
@@ -180,8 +191,13 @@ func (app *BaseApp) runtxWithInfo(info *runTxInfo, mode runTxMode, txBytes []byt
 	}
 
 	isAnteSucceed = true
+	recordMsgGas(info)
 	app.pin(trace.RunMsg, true, mode)
+	txCtx := info.ctx.Context()
+	msgSpan := info.ctx.StartSpan("runMsgs")
 	err = handler.handleRunMsg(info)
+	telemetry.EndSpan(msgSpan, err)
+	info.ctx.SetContext(txCtx)
 	app.pin(trace.RunMsg, false, mode)
 	return err
 }
@@ -238,7 +254,10 @@ func (app *BaseApp) runAnte(info *runTxInfo, mode runTxMode) error {
 	if mode == runTxModeDeliver {
 		anteCtx.SetAnteTracer(app.anteTracer)
 	}
+	txCtx := anteCtx.Context()
+	anteSpan := anteCtx.StartSpan("anteHandler")
 	newCtx, err := app.anteHandler(anteCtx, info.tx, mode == runTxModeSimulate) // NewAnteHandler
+	telemetry.EndSpan(anteSpan, err)
 	app.pin(trace.AnteChain, false, mode)
 
 	// 3. AnteOther
@@ -256,6 +275,7 @@ func (app *BaseApp) runAnte(info *runTxInfo, mode runTxMode) error {
 		// prior to returning.
 		info.ctx = newCtx
 		info.ctx.SetMultiStore(ms)
+		info.ctx.SetContext(txCtx)
 	}
 
 	// GasMeter expected to be set in AnteHandler
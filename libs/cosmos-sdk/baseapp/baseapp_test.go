@@ -1316,6 +1316,71 @@ func TestMaxBlockGasLimits(t *testing.T) {
 	}
 }
 
+// stdTxTest is a txTest of the cosmos txs, whose gas is scaled by the cosmos gas factor in the block
+type stdTxTest struct {
+	*txTest
+}
+
+func (tx stdTxTest) GetType() sdk.TransactionType {
+	return sdk.StdTxType
+}
+
+func TestCosmosGasFactorBlockGas(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			newCtx = ctx
+			newCtx.SetGasMeter(sdk.NewGasMeter(10))
+			return
+		})
+		bapp.SetCosmosGasFactorHandler(func(ctx sdk.Context) sdk.Dec {
+			return sdk.NewDecWithPrec(25, 1)
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx.GasMeter().ConsumeGas(uint64(msg.(msgCounter).Counter), "counter-handler")
+			return &sdk.Result{}, nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{
+			Block: &abci.BlockParams{
+				MaxGas: 100,
+			},
+		},
+	})
+	// the factor applies from the beginning of the block
+	require.True(t, app.getCosmosGasFactor().Equal(sdk.OneDec()))
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: app.LastBlockHeight() + 1}})
+	require.True(t, app.getCosmosGasFactor().Equal(sdk.NewDecWithPrec(25, 1)))
+	require.Equal(t, int64(25), app.GetTxBlockGas(stdTxTest{newTxCounter(0, 10)}, 10))
+	require.Equal(t, int64(8), app.GetTxBlockGas(stdTxTest{newTxCounter(0, 3)}, 3))
+	require.Equal(t, int64(10), app.GetTxBlockGas(newTxCounter(0, 10), 10))
+
+	ctx := app.getState(runTxModeDeliver).ctx
+	// the other txs take their gas as it is
+	_, _, err := app.Deliver(newTxCounter(0, 10))
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), ctx.BlockGasMeter().GasConsumed())
+
+	// the cosmos txs take their gas scaled by the factor
+	for i := 0; i < 3; i++ {
+		gInfo, _, err := app.Deliver(stdTxTest{newTxCounter(int64(i), 10)})
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), gInfo.GasUsed)
+	}
+	require.Equal(t, uint64(85), ctx.BlockGasMeter().GasConsumed())
+
+	// the block gas limit is shared by the txs of both kinds
+	_, _, err = app.Deliver(stdTxTest{newTxCounter(3, 10)})
+	require.Error(t, err)
+	require.True(t, ctx.BlockGasMeter().IsOutOfGas())
+	_, _, err = app.Deliver(newTxCounter(4, 1))
+	require.Error(t, err)
+}
+
 func TestBaseAppAnteHandler(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *BaseApp) {
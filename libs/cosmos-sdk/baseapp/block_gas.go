@@ -0,0 +1,60 @@
+package baseapp
+
+import (
+	"math"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+)
+
+// updateCosmosGasFactor reads the factor converting the gas of the cosmos txs to the gas of the block, it's called at
+// the beginning of each block so that a change by governance applies from the next block on
+func (app *BaseApp) updateCosmosGasFactor(ctx sdk.Context) {
+	factor := sdk.OneDec()
+	if app.cosmosGasFactorHandler != nil {
+		if f := app.cosmosGasFactorHandler(ctx); f.IsPositive() {
+			factor = f
+		}
+	}
+	app.cosmosGasFactor.Store(factor)
+}
+
+// getCosmosGasFactor returns the factor of the current block, which is 1 until the first block begins
+func (app *BaseApp) getCosmosGasFactor() sdk.Dec {
+	if factor, ok := app.cosmosGasFactor.Load().(sdk.Dec); ok {
+		return factor
+	}
+	return sdk.OneDec()
+}
+
+// txBlockGas converts the gas of the tx to the gas it takes from the block gas limit, the evm txs take their gas as
+// it is and the cosmos txs take it scaled by the cosmos gas factor, so that the limit bounds the execution time of the
+// block whatever the mix of the txs.
+func (app *BaseApp) txBlockGas(tx sdk.Tx, gas uint64) uint64 {
+	if tx == nil || tx.GetType() != sdk.StdTxType {
+		return gas
+	}
+	factor := app.getCosmosGasFactor()
+	if factor.Equal(sdk.OneDec()) {
+		return gas
+	}
+	blockGas := sdk.NewDecFromInt(sdk.NewIntFromUint64(gas)).Mul(factor).Ceil().TruncateInt()
+	if !blockGas.IsUint64() {
+		return math.MaxUint64
+	}
+	return blockGas.Uint64()
+}
+
+// GetTxBlockGas implements the mempool.BlockGasWeigher, the mempool weighs the txs with it against the gas limit of
+// the block it proposes
+func (app *BaseApp) GetTxBlockGas(tx abci.TxEssentials, gas int64) int64 {
+	stdTx, ok := tx.(sdk.Tx)
+	if !ok || gas <= 0 {
+		return gas
+	}
+	blockGas := app.txBlockGas(stdTx, uint64(gas))
+	if blockGas > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(blockGas)
+}
@@ -58,6 +58,38 @@ func (app *BaseApp) TraceTx(queryTraceTx sdk.QueryTraceTx, targetTx sdk.Tx, txIn
 	}
 	return info.result, err
 }
+// TraceBlock re-executes the txs of the block on the state of the previous height in the trace mode, and returns
+// their results and errors, the data of the results of the evm txs are their trace logs. The state isn't modified,
+// so it must be called before the block is committed.
+func (app *BaseApp) TraceBlock(block *tmtypes.Block, configBytes []byte) ([]*sdk.Result, []error, error) {
+	results := make([]*sdk.Result, len(block.Txs))
+	errs := make([]error, len(block.Txs))
+	if len(block.Txs) == 0 {
+		return results, errs, nil
+	}
+
+	traceState, err := app.beginBlockForTracing(block.Txs[0], block)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "failed to beginblock for tracing")
+	}
+	traceState.ctx.SetIsTraceTxLog(true)
+	traceState.ctx.SetTraceTxLogConfig(configBytes)
+
+	for i, txBytes := range block.Txs {
+		tx, err := app.txDecoder(txBytes, block.Height)
+		if err != nil {
+			errs[i] = sdkerrors.Wrap(err, "failed to decode tx")
+			continue
+		}
+		info, err := app.tracetx(txBytes, tx, block.Height, traceState)
+		if info != nil {
+			results[i] = info.result
+		}
+		errs[i] = err
+	}
+	return results, errs, nil
+}
+
 func (app *BaseApp) tracetx(txBytes []byte, tx sdk.Tx, height int64, traceState *state) (info *runTxInfo, err error) {
 
 	mode := runTxModeTrace
@@ -198,6 +198,20 @@ func (app *BaseApp) SetMptCommitHandler(mch sdk.MptCommitHandler) {
 	app.mptCommitHandler = mch
 }
 
+func (app *BaseApp) SetPreCommitHandler(handler sdk.PreCommitHandler) {
+	if app.sealed {
+		panic("SetPreCommitHandler() on sealed BaseApp")
+	}
+	app.preCommitHandler = handler
+}
+
+func (app *BaseApp) SetCosmosGasFactorHandler(handler sdk.CosmosGasFactorHandler) {
+	if app.sealed {
+		panic("SetCosmosGasFactorHandler() on sealed BaseApp")
+	}
+	app.cosmosGasFactorHandler = handler
+}
+
 func (app *BaseApp) SetPreDeliverTxHandler(handler sdk.PreDeliverTxHandler) {
 	if app.sealed {
 		panic("SetPreDeliverTxHandler() on sealed BaseApp")
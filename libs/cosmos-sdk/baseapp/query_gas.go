@@ -0,0 +1,132 @@
+package baseapp
+
+import (
+	"sync"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+// SetQueryGasLimit returns a BaseApp option function that sets the gas limit of each query, 0 means unlimited.
+func SetQueryGasLimit(limit uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.queryGasLimit = limit }
+}
+
+// SetQueryGasBudget returns a BaseApp option function that sets the gas each gRPC connection may consume with its
+// queries within the window, 0 means unlimited.
+func SetQueryGasBudget(budget uint64, window time.Duration) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if budget > 0 && window > 0 {
+			app.queryGasBudgets = newQueryGasBudgets(budget, window)
+		}
+	}
+}
+
+// queryGasBudgets tracks the gas consumed by the queries of each connection within a fixed window.
+type queryGasBudgets struct {
+	budget uint64
+	window time.Duration
+
+	mtx       sync.Mutex
+	conns     map[string]*connQueryGas
+	lastSweep time.Time
+}
+
+type connQueryGas struct {
+	windowStart time.Time
+	used        uint64
+}
+
+func newQueryGasBudgets(budget uint64, window time.Duration) *queryGasBudgets {
+	return &queryGasBudgets{
+		budget:    budget,
+		window:    window,
+		conns:     make(map[string]*connQueryGas),
+		lastSweep: time.Now(),
+	}
+}
+
+// remaining returns the gas left to the connection in the current window.
+func (b *queryGasBudgets) remaining(conn string, now time.Time) uint64 {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	// forget the connections idle for a whole window
+	if now.Sub(b.lastSweep) > b.window {
+		for c, g := range b.conns {
+			if now.Sub(g.windowStart) > b.window {
+				delete(b.conns, c)
+			}
+		}
+		b.lastSweep = now
+	}
+
+	g, ok := b.conns[conn]
+	if !ok || now.Sub(g.windowStart) > b.window {
+		return b.budget
+	}
+	if g.used >= b.budget {
+		return 0
+	}
+	return b.budget - g.used
+}
+
+// consume charges the gas consumed by a query to the connection.
+func (b *queryGasBudgets) consume(conn string, gas uint64, now time.Time) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	g, ok := b.conns[conn]
+	if !ok || now.Sub(g.windowStart) > b.window {
+		g = &connQueryGas{windowStart: now}
+		b.conns[conn] = g
+	}
+	g.used += gas
+}
+
+// newQueryGasMeter returns the gas meter of a query of the connection, limited by the gas limit of the queries and
+// the gas left to the connection. The queries without a connection are only limited by the gas limit.
+func (app *BaseApp) newQueryGasMeter(conn string) (sdk.GasMeter, error) {
+	limit := app.queryGasLimit
+	if app.queryGasBudgets != nil && conn != "" {
+		remaining := app.queryGasBudgets.remaining(conn, time.Now())
+		if remaining == 0 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas,
+				"query gas budget of %d per %s exhausted", app.queryGasBudgets.budget, app.queryGasBudgets.window)
+		}
+		if limit == 0 || remaining < limit {
+			limit = remaining
+		}
+	}
+	if limit == 0 {
+		return sdk.NewInfiniteGasMeter(), nil
+	}
+	return sdk.NewGasMeter(limit), nil
+}
+
+// runMeteredQuery runs the query with the gas meter of the connection, and returns the structured out of gas error
+// if the query runs out of gas.
+func (app *BaseApp) runMeteredQuery(ctx *sdk.Context, conn string, query func() error) (err error) {
+	gasMeter, err := app.newQueryGasMeter(conn)
+	if err != nil {
+		return err
+	}
+	ctx.SetGasMeter(gasMeter)
+
+	defer func() {
+		if app.queryGasBudgets != nil && conn != "" {
+			app.queryGasBudgets.consume(conn, gasMeter.GasConsumedToLimit(), time.Now())
+		}
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas,
+				"query out of gas in location: %v; gasLimit: %d, gasUsed: %d",
+				oog.Descriptor, gasMeter.Limit(), gasMeter.GasConsumed())
+		}
+	}()
+	return query()
+}
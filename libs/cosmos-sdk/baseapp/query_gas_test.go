@@ -0,0 +1,89 @@
+package baseapp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+func TestQueryGasLimit(t *testing.T) {
+	querierOpt := func(bapp *BaseApp) {
+		bapp.QueryRouter().AddRoute("gas", func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+			ctx.GasMeter().ConsumeGas(1000, "test query")
+			return []byte("ok"), nil
+		})
+	}
+
+	app := setupBaseApp(t, querierOpt)
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	app.Commit(abci.RequestCommit{})
+
+	query := abci.RequestQuery{Path: "/custom/gas"}
+	res := app.Query(query)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte("ok"), res.Value)
+
+	// the query runs out of gas with a structured error
+	SetQueryGasLimit(999)(app)
+	res = app.Query(query)
+	require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), res.Code)
+	require.Contains(t, res.Log, "query out of gas in location: test query")
+
+	SetQueryGasLimit(1000)(app)
+	res = app.Query(query)
+	require.True(t, res.IsOK(), res.Log)
+}
+
+func TestQueryGasBudget(t *testing.T) {
+	app := newBaseApp(t.Name(), SetQueryGasLimit(1000), SetQueryGasBudget(2500, time.Minute))
+	query := func(conn string, gas uint64) error {
+		ctx := sdk.Context{}
+		return app.runMeteredQuery(&ctx, conn, func() error {
+			ctx.GasMeter().ConsumeGas(gas, "test query")
+			return nil
+		})
+	}
+
+	require.NoError(t, query("conn1", 1000))
+	require.NoError(t, query("conn1", 1000))
+	// the gas left to the connection limits its next query
+	err := query("conn1", 1000)
+	require.True(t, sdkerrors.ErrOutOfGas.Is(err))
+	require.Contains(t, err.Error(), "gasLimit: 500")
+	err = query("conn1", 1)
+	require.True(t, sdkerrors.ErrOutOfGas.Is(err))
+	require.Contains(t, err.Error(), "budget of 2500 per 1m0s exhausted")
+
+	// the budgets are per connection, and the queries without a connection are only limited by the gas limit
+	require.NoError(t, query("conn2", 1000))
+	require.NoError(t, query("", 1000))
+	require.Error(t, query("", 1001))
+
+	// a panic other than out of gas isn't recovered
+	require.Panics(t, func() {
+		ctx := sdk.Context{}
+		app.runMeteredQuery(&ctx, "conn2", func() error { panic("test") })
+	})
+}
+
+func TestQueryGasBudgetsWindow(t *testing.T) {
+	budgets := newQueryGasBudgets(100, time.Minute)
+	now := time.Now()
+
+	require.Equal(t, uint64(100), budgets.remaining("conn", now))
+	budgets.consume("conn", 60, now)
+	require.Equal(t, uint64(40), budgets.remaining("conn", now.Add(time.Second)))
+	budgets.consume("conn", 60, now.Add(time.Second))
+	require.Equal(t, uint64(0), budgets.remaining("conn", now.Add(time.Second)))
+
+	// the budget is restored with a new window
+	require.Equal(t, uint64(100), budgets.remaining("conn", now.Add(2*time.Minute)))
+	require.Empty(t, budgets.conns)
+}
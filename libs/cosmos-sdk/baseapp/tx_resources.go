@@ -0,0 +1,70 @@
+package baseapp
+
+import (
+	"fmt"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+)
+
+const FlagTxResourcesCacheSize = "tx-resources-cache-size"
+
+// TxResourcesCacheSize is the number of the latest delivered txs whose resource consumption is kept for queries,
+// the resource accounting is disabled if it's 0.
+var TxResourcesCacheSize = 0
+
+func newTxResourcesCache() *lru.Cache {
+	if TxResourcesCacheSize <= 0 {
+		return nil
+	}
+	cache, err := lru.New(TxResourcesCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return cache
+}
+
+// startTxResources starts recording the resource consumption of the tx delivered with info, and returns the function
+// to finish the recording after the tx is delivered.
+func (app *BaseApp) startTxResources(info *runTxInfo) func() {
+	resources := sdk.NewTxResources()
+	info.ctx.SetTxResources(resources)
+	start := time.Now()
+	return func() {
+		resources.CPUTime = time.Since(start)
+		resources.Height = info.ctx.BlockHeight()
+		resources.GasWanted, resources.GasUsed = info.gInfo.GasWanted, info.gInfo.GasUsed
+		hash := tmtypes.Tx(info.txBytes).Hash(resources.Height)
+		resources.Hash = fmt.Sprintf("%X", hash)
+		app.txResources.Add(string(hash), resources)
+	}
+}
+
+// recordMsgGas records the gas consumed by the msgs of the tx delivered with info if its resources are recorded.
+func recordMsgGas(info *runTxInfo) {
+	if resources := info.ctx.TxResources(); resources != nil {
+		info.ctx.SetGasMeter(resources.MsgGasMeter(info.ctx.GasMeter()))
+	}
+}
+
+// recordRefund records the refund of the tx delivered with info if its resources are recorded.
+func recordRefund(info *runTxInfo, refund sdk.DecCoins) {
+	if resources := info.ctx.TxResources(); resources != nil {
+		resources.Refund = refund
+	}
+}
+
+// GetTxResources returns the resource consumption of a recently delivered tx.
+func (app *BaseApp) GetTxResources(hash []byte) (*sdk.TxResources, error) {
+	if app.txResources == nil {
+		return nil, fmt.Errorf("the tx resource accounting is disabled, enable it with --%s", FlagTxResourcesCacheSize)
+	}
+	resources, ok := app.txResources.Get(string(hash))
+	if !ok {
+		return nil, fmt.Errorf("the resources of tx %X aren't recorded", hash)
+	}
+	return resources.(*sdk.TxResources), nil
+}
@@ -0,0 +1,62 @@
+package baseapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	store "github.com/okex/exchain/libs/cosmos-sdk/store/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+)
+
+func TestTxResources(t *testing.T) {
+	TxResourcesCacheSize = 10
+	defer func() { TxResourcesCacheSize = 0 }()
+
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+	}
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	txBytes, err := codec.MarshalBinaryLengthPrefixed(newTxCounter(0, 0))
+	require.NoError(t, err)
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit(abci.RequestCommit{})
+
+	hash := tmtypes.Tx(txBytes).Hash(header.Height)
+	resources, err := app.GetTxResources(hash)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%X", hash), resources.Hash)
+	require.Equal(t, header.Height, resources.Height)
+	require.Equal(t, uint64(res.GasUsed), resources.GasUsed)
+	require.True(t, resources.CPUTime > 0)
+	// both the ante handler and the msg handler read and write a counter
+	require.GreaterOrEqual(t, resources.Reads, uint64(2))
+	require.GreaterOrEqual(t, resources.Writes, uint64(2))
+	require.True(t, resources.WriteBytes > 0)
+	// only the gas consumed by the msg handler is recorded by descriptor
+	require.Equal(t, store.KVGasConfig().WriteCostFlat, resources.MsgGas[store.GasWriteCostFlatDesc])
+
+	_, err = app.GetTxResources([]byte("unknown"))
+	require.Error(t, err)
+
+	// the resources aren't recorded if the accounting is disabled
+	TxResourcesCacheSize = 0
+	app = setupBaseApp(t)
+	_, err = app.GetTxResources(hash)
+	require.Error(t, err)
+}
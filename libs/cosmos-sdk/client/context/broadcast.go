@@ -1,13 +1,17 @@
 package context
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 	"github.com/okex/exchain/libs/tendermint/mempool"
+	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
 	"github.com/okex/exchain/libs/tendermint/types"
 )
 
@@ -26,8 +30,11 @@ func (ctx CLIContext) BroadcastTx(txBytes []byte) (res sdk.TxResponse, err error
 	case flags.BroadcastBlock:
 		res, err = ctx.BroadcastTxCommit(txBytes)
 
+	case flags.BroadcastBlockConfirm:
+		res, err = ctx.BroadcastTxBlockConfirm(txBytes)
+
 	default:
-		return sdk.TxResponse{}, fmt.Errorf("unsupported return type %s; supported types: sync, async, block", ctx.BroadcastMode)
+		return sdk.TxResponse{}, fmt.Errorf("unsupported return type %s; supported types: sync, async, block, block-confirm", ctx.BroadcastMode)
 	}
 
 	return res, err
@@ -117,6 +124,70 @@ func (ctx CLIContext) BroadcastTxCommit(txBytes []byte) (sdk.TxResponse, error)
 	return sdk.NewResponseFormatBroadcastTxCommit(res), nil
 }
 
+const defaultConfirmTimeout = time.Minute
+
+// confirmPollInterval is the interval BroadcastTxBlockConfirm polls the node at
+var confirmPollInterval = time.Second
+
+// BroadcastTxBlockConfirm broadcasts transaction bytes to a Tendermint node
+// synchronously, then polls the node until the tx is committed in a block and
+// has the confirmations of the context, which count the block committing the
+// tx and the blocks after it. The response holds the result of the tx, with
+// the gas used and the events. Unlike BroadcastTxCommit, it doesn't hold a
+// connection open while waiting, and an error is returned when the timeout of
+// the context, or one minute if it's not set, is reached.
+func (ctx CLIContext) BroadcastTxBlockConfirm(txBytes []byte) (sdk.TxResponse, error) {
+	res, err := ctx.BroadcastTxSync(txBytes)
+	if err != nil || res.Code != abci.CodeTypeOK {
+		return res, err
+	}
+
+	node, err := ctx.GetNode()
+	if err != nil {
+		return res, err
+	}
+	hash, err := hex.DecodeString(res.TxHash)
+	if err != nil {
+		return res, err
+	}
+
+	confirmations := int64(ctx.Confirmations)
+	if confirmations == 0 {
+		confirmations = 1
+	}
+	timeout := ctx.BroadcastTimeout
+	if timeout <= 0 {
+		timeout = defaultConfirmTimeout
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(confirmPollInterval)
+	defer ticker.Stop()
+
+	var resTx *ctypes.ResultTx
+	for {
+		// the tx isn't found until it's committed
+		if resTx == nil {
+			resTx, _ = node.Tx(hash, false)
+		}
+		if resTx != nil {
+			if latest, err := node.LatestBlockNumber(); err == nil && latest-resTx.Height+1 >= confirmations {
+				return sdk.NewResponseResultTx(resTx, nil, ""), nil
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-timer.C:
+			if resTx == nil {
+				return res, fmt.Errorf("timed out after %s waiting for tx %s to be committed", timeout, res.TxHash)
+			}
+			return sdk.NewResponseResultTx(resTx, nil, ""), fmt.Errorf("timed out after %s waiting for %d confirmations of tx %s committed at height %d",
+				timeout, confirmations, res.TxHash, resTx.Height)
+		}
+	}
+}
+
 // BroadcastTxSync broadcasts transaction bytes to a Tendermint node
 // synchronously (i.e. returns after CheckTx execution).
 func (ctx CLIContext) BroadcastTxSync(txBytes []byte) (sdk.TxResponse, error) {
@@ -3,7 +3,9 @@ package context
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 	"github.com/okex/exchain/libs/tendermint/mempool"
 	"github.com/okex/exchain/libs/tendermint/rpc/client/mock"
 	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
@@ -60,6 +62,7 @@ func TestBroadcastError(t *testing.T) {
 		flags.BroadcastAsync,
 		flags.BroadcastBlock,
 		flags.BroadcastSync,
+		flags.BroadcastBlockConfirm,
 	}
 
 	txBytes := []byte{0xA, 0xB}
@@ -76,3 +79,64 @@ func TestBroadcastError(t *testing.T) {
 	}
 
 }
+
+// ConfirmMockClient commits the tx at height 2 and adds a block on every poll
+type ConfirmMockClient struct {
+	mock.Client
+	height *int64
+}
+
+func (c ConfirmMockClient) BroadcastTxSync(tx tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return &ctypes.ResultBroadcastTx{Hash: tx.Hash(0)}, nil
+}
+
+func (c ConfirmMockClient) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
+	if *c.height < 2 {
+		if *c.height++; *c.height < 2 {
+			return nil, fmt.Errorf("tx (%X) not found", hash)
+		}
+	}
+	return &ctypes.ResultTx{
+		Hash:   hash,
+		Height: 2,
+		TxResult: abci.ResponseDeliverTx{
+			GasUsed: 100,
+			Log:     `[{"msg_index":0,"log":"","events":[{"type":"message","attributes":[{"key":"action","value":"send"}]}]}]`,
+		},
+	}, nil
+}
+
+func (c ConfirmMockClient) LatestBlockNumber() (int64, error) {
+	*c.height++
+	return *c.height, nil
+}
+
+func TestBroadcastTxBlockConfirm(t *testing.T) {
+	confirmPollInterval = time.Millisecond
+
+	txBytes := []byte{0xA, 0xB}
+	txHash := fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash(0))
+
+	height := int64(0)
+	ctx := CLIContext{
+		Client:           ConfirmMockClient{height: &height},
+		BroadcastMode:    flags.BroadcastBlockConfirm,
+		Confirmations:    3,
+		BroadcastTimeout: time.Minute,
+	}
+	res, err := ctx.BroadcastTx(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, txHash, res.TxHash)
+	require.Equal(t, int64(2), res.Height)
+	require.Equal(t, int64(100), res.GasUsed)
+	require.Equal(t, "message", res.Logs[0].Events[0].Type)
+	require.Equal(t, int64(4), height)
+
+	// times out waiting for the confirmations
+	height = 0
+	ctx.Confirmations = 1000
+	ctx.BroadcastTimeout = 10 * time.Millisecond
+	res, err = ctx.BroadcastTx(txBytes)
+	require.Error(t, err)
+	require.Equal(t, int64(2), res.Height)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/codec/types"
 	tmtypes "github.com/okex/exchain/libs/tendermint/types"
@@ -44,11 +45,17 @@ type CLIContext struct {
 	UseLedger     bool
 	Simulate      bool
 	GenerateOnly  bool
+	Offline       bool
+	SignMode      string
 	Indent        bool
 	SkipConfirm   bool
 
 	InterfaceRegistry types.InterfaceRegistry
 	CodecProy         *codec.CodecProxy
+
+	// Confirmations and BroadcastTimeout are only used in block-confirm broadcasting mode
+	Confirmations    uint64
+	BroadcastTimeout time.Duration
 }
 
 // NewCLIContextWithInputAndFrom returns a new initialized CLIContext with parameters from the
@@ -62,13 +69,14 @@ func NewCLIContextWithInputAndFrom(input io.Reader, from string) CLIContext {
 	var rpc rpcclient.Client
 
 	genOnly := viper.GetBool(flags.FlagGenerateOnly)
+	offline := viper.GetBool(flags.FlagOffline)
 	fromAddress, fromName, err := GetFromFields(input, from, genOnly)
 	if err != nil {
 		fmt.Printf("failed to get from fields: %v\n", err)
 		os.Exit(1)
 	}
 
-	if !genOnly {
+	if !genOnly && !offline {
 		nodeURI = viper.GetString(flags.FlagNode)
 		if nodeURI != "" {
 			rpc, err = rpchttp.New(nodeURI, "/websocket")
@@ -90,24 +98,28 @@ func NewCLIContextWithInputAndFrom(input io.Reader, from string) CLIContext {
 	}
 
 	ctx := CLIContext{
-		Client:        rpc,
-		ChainID:       viper.GetString(flags.FlagChainID),
-		Input:         input,
-		Output:        os.Stdout,
-		NodeURI:       nodeURI,
-		From:          viper.GetString(flags.FlagFrom),
-		OutputFormat:  viper.GetString(cli.OutputFlag),
-		Height:        viper.GetInt64(flags.FlagHeight),
-		HomeDir:       viper.GetString(flags.FlagHome),
-		TrustNode:     viper.GetBool(flags.FlagTrustNode),
-		UseLedger:     viper.GetBool(flags.FlagUseLedger),
-		BroadcastMode: viper.GetString(flags.FlagBroadcastMode),
-		Simulate:      viper.GetBool(flags.FlagDryRun),
-		GenerateOnly:  genOnly,
-		FromAddress:   fromAddress,
-		FromName:      fromName,
-		Indent:        viper.GetBool(flags.FlagIndentResponse),
-		SkipConfirm:   viper.GetBool(flags.FlagSkipConfirmation),
+		Client:           rpc,
+		ChainID:          viper.GetString(flags.FlagChainID),
+		Input:            input,
+		Output:           os.Stdout,
+		NodeURI:          nodeURI,
+		From:             viper.GetString(flags.FlagFrom),
+		OutputFormat:     viper.GetString(cli.OutputFlag),
+		Height:           viper.GetInt64(flags.FlagHeight),
+		HomeDir:          viper.GetString(flags.FlagHome),
+		TrustNode:        viper.GetBool(flags.FlagTrustNode),
+		UseLedger:        viper.GetBool(flags.FlagUseLedger),
+		BroadcastMode:    viper.GetString(flags.FlagBroadcastMode),
+		Confirmations:    viper.GetUint64(flags.FlagConfirmations),
+		BroadcastTimeout: viper.GetDuration(flags.FlagBroadcastTimeout),
+		Simulate:         viper.GetBool(flags.FlagDryRun),
+		GenerateOnly:     genOnly,
+		Offline:          offline,
+		SignMode:         viper.GetString(flags.FlagSignMode),
+		FromAddress:      fromAddress,
+		FromName:         fromName,
+		Indent:           viper.GetBool(flags.FlagIndentResponse),
+		SkipConfirm:      viper.GetBool(flags.FlagSkipConfirmation),
 	}
 
 	// create a verifier for the specific chain ID and RPC client
@@ -221,6 +233,18 @@ func (ctx CLIContext) WithGenerateOnly(generateOnly bool) CLIContext {
 	return ctx
 }
 
+// WithOffline returns a copy of the context with updated Offline value
+func (ctx CLIContext) WithOffline(offline bool) CLIContext {
+	ctx.Offline = offline
+	return ctx
+}
+
+// WithSignMode returns a copy of the context with updated SignMode value
+func (ctx CLIContext) WithSignMode(signMode string) CLIContext {
+	ctx.SignMode = signMode
+	return ctx
+}
+
 // WithSimulation returns a copy of the context with updated Simulate value
 func (ctx CLIContext) WithSimulation(simulate bool) CLIContext {
 	ctx.Simulate = simulate
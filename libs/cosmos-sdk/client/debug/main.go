@@ -122,42 +122,27 @@ $ %s debug pubkey cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
 func AddrCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "addr [address]",
-		Short: "Convert an address between hex and bech32",
-		Long: fmt.Sprintf(`Convert an address between hex encoding and bech32.
-			
+		Short: "Convert an address between hex and the bech32 formats",
+		Long: fmt.Sprintf(`Convert an address in the 0x hex format, or the bech32 format of an account, a validator
+operator or a validator consensus address to all the formats. The checksum of a bech32 address is
+validated, so is the EIP-55 checksum of a mixed case hex address.
+
 Example:
-$ %s debug addr cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
-			`, version.ClientName),
+$ %s debug addr ex1qg6ymzllm5facmhjwn0x6nt700nrue0dwwalcs
+$ %s debug addr 0x02344d8BFFDd13dc6eF274DE6d4D7e7BE63e65eD
+			`, version.ClientName, version.ClientName),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-
-			addrString := args[0]
-			var addr []byte
-
-			// try hex, then bech32
-			var err error
-			addr, err = hex.DecodeString(addrString)
+			addr, err := sdk.ConvertAddress(args[0])
 			if err != nil {
-				var err2 error
-				addr, err2 = sdk.AccAddressFromBech32(addrString)
-				if err2 != nil {
-					var err3 error
-					addr, err3 = sdk.ValAddressFromBech32(addrString)
-
-					if err3 != nil {
-						return fmt.Errorf("expected hex or bech32. Got errors: hex: %v, bech32 acc: %v, bech32 val: %v", err, err2, err3)
-
-					}
-				}
+				return err
 			}
 
-			accAddr := sdk.AccAddress(addr)
-			valAddr := sdk.ValAddress(addr)
-
-			cmd.Println("Address:", addr)
-			cmd.Printf("Address (hex): %X\n", addr)
-			cmd.Printf("Bech32 Acc: %s\n", accAddr)
-			cmd.Printf("Bech32 Val: %s\n", valAddr)
+			cmd.Println("Format:", addr.Format)
+			cmd.Println("Hex:", addr.Hex)
+			cmd.Println("Bech32 Acc:", addr.Bech32Acc)
+			cmd.Println("Bech32 Val:", addr.Bech32Val)
+			cmd.Println("Bech32 Cons:", addr.Bech32Cons)
 			return nil
 		},
 	}
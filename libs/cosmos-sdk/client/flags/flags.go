@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -36,6 +37,10 @@ const (
 	// BroadcastAsync defines a tx broadcasting mode where the client returns
 	// immediately.
 	BroadcastAsync = "async"
+	// BroadcastBlockConfirm defines a tx broadcasting mode where the client
+	// waits for a CheckTx execution response, then polls until the tx is
+	// committed in a block and has the number of confirmations wanted.
+	BroadcastBlockConfirm = "block-confirm"
 )
 
 // List of CLI flags
@@ -55,8 +60,12 @@ const (
 	FlagFees               = "fees"
 	FlagGasPrices          = "gas-prices"
 	FlagBroadcastMode      = "broadcast-mode"
+	FlagConfirmations      = "confirmations"
+	FlagBroadcastTimeout   = "timeout"
 	FlagDryRun             = "dry-run"
 	FlagGenerateOnly       = "generate-only"
+	FlagOffline            = "offline"
+	FlagSignMode           = "sign-mode"
 	FlagIndentResponse     = "indent"
 	FlagListenAddr         = "laddr"
 	FlagMaxOpenConnections = "max-open"
@@ -76,6 +85,12 @@ const (
 Using false verifies the proof of results, safely but slowly(2~3s). False is recommended to connect to unfamiliar nodes.`
 )
 
+// List of sign modes, which decide how the sign doc is shown to the signer
+const (
+	SignModeJSON    = "json"
+	SignModeTextual = "textual"
+)
+
 const (
 	FlagPageKey       = "page-key"
 	FlagOffset        = "offset"
@@ -123,10 +138,14 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 		c.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
 		c.Flags().Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
-		c.Flags().StringP(FlagBroadcastMode, "b", BroadcastSync, "Transaction broadcasting mode (sync|async|block)")
+		c.Flags().StringP(FlagBroadcastMode, "b", BroadcastSync, "Transaction broadcasting mode (sync|async|block|block-confirm)")
+		c.Flags().Uint64(FlagConfirmations, 1, "Number of blocks, including the one committing the tx, to wait for in block-confirm broadcasting mode")
+		c.Flags().Duration(FlagBroadcastTimeout, time.Minute, "Time to wait for the confirmations in block-confirm broadcasting mode")
 		c.Flags().Bool(FlagTrustNode, true, TrustNodeUsage)
 		c.Flags().Bool(FlagDryRun, false, "ignore the --gas flag and perform a simulation of a transaction, but don't broadcast it")
 		c.Flags().Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase is not accessible and the node operates offline)")
+		c.Flags().Bool(FlagOffline, false, "Offline mode; do not query a full node, sign the transaction with --account-number and --sequence and write it to STDOUT instead of broadcasting it")
+		c.Flags().String(FlagSignMode, SignModeJSON, "How the transaction being signed is shown (json|textual)")
 		c.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
 		c.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
 
@@ -255,9 +274,13 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 	cmd.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
 	cmd.Flags().Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
-	cmd.Flags().StringP(FlagBroadcastMode, "b", BroadcastSync, "Transaction broadcasting mode (sync|async|block)")
+	cmd.Flags().StringP(FlagBroadcastMode, "b", BroadcastSync, "Transaction broadcasting mode (sync|async|block|block-confirm)")
+	cmd.Flags().Uint64(FlagConfirmations, 1, "Number of blocks, including the one committing the tx, to wait for in block-confirm broadcasting mode")
+	cmd.Flags().Duration(FlagBroadcastTimeout, time.Minute, "Time to wait for the confirmations in block-confirm broadcasting mode")
 	cmd.Flags().Bool(FlagDryRun, false, "ignore the --gas flag and perform a simulation of a transaction, but don't broadcast it")
 	cmd.Flags().Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase is not accessible)")
+	cmd.Flags().Bool(FlagOffline, false, "Offline mode; do not query a full node, sign the transaction with --account-number and --sequence and write it to STDOUT instead of broadcasting it")
+	cmd.Flags().String(FlagSignMode, SignModeJSON, "How the transaction being signed is shown (json|textual)")
 	cmd.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
 	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
 	cmd.Flags().Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
@@ -0,0 +1,83 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/rest"
+)
+
+const eventSchemasQueryPath = "/app/event_schemas"
+
+// EventSchemasCommand returns the command to query the schemas of the typed events registered by the modules.
+func EventSchemasCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "event-schemas [module]",
+		Short: "Query the schemas of the typed events emitted by all the modules or by a module",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			schemas, _, err := queryEventSchemas(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				schemas = filterEventSchemas(schemas, args[0])
+			}
+			return cliCtx.PrintOutput(schemas)
+		},
+	}
+	return flags.GetCommands(cmd)[0]
+}
+
+// EventSchemasRequestHandlerFn is the REST handler to query the schemas of the typed events, optionally of a module.
+func EventSchemasRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		schemas, height, err := queryEventSchemas(cliCtx)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if module, ok := mux.Vars(r)["module"]; ok {
+			schemas = filterEventSchemas(schemas, module)
+		}
+
+		rest.PostProcessResponseBare(w, cliCtx.WithHeight(height), schemas)
+	}
+}
+
+func queryEventSchemas(cliCtx context.CLIContext) ([]sdk.EventSchema, int64, error) {
+	res, height, err := cliCtx.QueryWithData(eventSchemasQueryPath, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var schemas []sdk.EventSchema
+	if err := json.Unmarshal(res, &schemas); err != nil {
+		return nil, 0, err
+	}
+	return schemas, height, nil
+}
+
+func filterEventSchemas(schemas []sdk.EventSchema, module string) []sdk.EventSchema {
+	filtered := make([]sdk.EventSchema, 0, len(schemas))
+	for _, schema := range schemas {
+		if schema.Module == module {
+			filtered = append(filtered, schema)
+		}
+	}
+	return filtered
+}
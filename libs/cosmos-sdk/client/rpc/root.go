@@ -15,4 +15,7 @@ func RegisterRPCRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/block_info/{height}", BlockInfoRequestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/validatorsets/latest", LatestValidatorSetRequestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/validatorsets/{height}", ValidatorSetRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/event_schemas", EventSchemasRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/event_schemas/{module}", EventSchemasRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/addr/{address}", AddrRequestHandlerFn(cliCtx)).Methods("GET")
 }
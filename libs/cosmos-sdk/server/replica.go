@@ -0,0 +1,128 @@
+package server
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/spf13/viper"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/lcd"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/server/grpc"
+	app2 "github.com/okex/exchain/libs/cosmos-sdk/server/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/node"
+	"github.com/okex/exchain/libs/tendermint/p2p"
+	pvm "github.com/okex/exchain/libs/tendermint/privval"
+	"github.com/okex/exchain/libs/tendermint/proxy"
+	dbm "github.com/okex/exchain/libs/tm-db"
+)
+
+const (
+	FlagReplica                = "replica"
+	FlagReplicaDir             = "replica.dir"
+	FlagReplicaCatchUpInterval = "replica.catch-up-interval"
+)
+
+// replicaApp is the app of a read-only replica
+type replicaApp interface {
+	abci.Application
+	LastBlockHeight() int64
+	ReloadLatestVersion() error
+}
+
+// startReplica starts a read-only replica of the node running in the same home, which serves the queries of the
+// rest, grpc and json-rpc servers from the DBs of the node without joining the network. The DBs are opened as
+// replicas following the writes of the node, so the db backend must be rocksdb, and the replica catches up with the
+// state persisted by the node every interval. The txs must be sent to the node.
+func startReplica(ctx *Context, cdc *codec.CodecProxy, registry jsonpb.AnyResolver, appCreator AppCreator,
+	registerRoutesFn func(restServer *lcd.RestServer)) error {
+
+	cfg := ctx.Config
+	if backend := viper.GetString(sdk.FlagDBBackend); backend != string(dbm.RocksDBBackend) {
+		return fmt.Errorf("the replica mode requires the %s db backend, got %s", dbm.RocksDBBackend, backend)
+	}
+	replicaDir := viper.GetString(FlagReplicaDir)
+	if replicaDir == "" {
+		replicaDir = filepath.Join(cfg.RootDir, "replica")
+	}
+	interval := viper.GetDuration(FlagReplicaCatchUpInterval)
+	if interval <= 0 {
+		return fmt.Errorf("invalid %s: %s", FlagReplicaCatchUpInterval, interval)
+	}
+	dbm.EnableReplicaMode(replicaDir)
+
+	db, err := openDB(cfg.RootDir)
+	if err != nil {
+		return err
+	}
+	app, ok := appCreator(ctx.Logger, db, nil).(replicaApp)
+	if !ok {
+		return fmt.Errorf("the app doesn't support the replica mode")
+	}
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
+	if err != nil {
+		return err
+	}
+	tmNode, err := node.NewLRPNode(
+		cfg,
+		pvm.LoadFilePVEmptyState(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
+		nodeKey,
+		proxy.NewLocalClientCreator(app),
+		node.DefaultGenesisDocProviderFunc(cfg),
+		node.DefaultDBProvider,
+		cfg.DBDir(),
+		ctx.Logger.With("module", "node"),
+	)
+	if err != nil {
+		return err
+	}
+	tmNode.ReloadReplica(app.LastBlockHeight())
+
+	app.SetOption(abci.RequestSetOption{
+		Key:   "CheckChainID",
+		Value: tmNode.ConsensusState().GetState().ChainID,
+	})
+
+	go catchUpReplicaRoutine(ctx, app, tmNode, interval)
+
+	TrapSignal(func() {
+		ctx.Logger.Info("exiting...")
+	})
+
+	if registerRoutesFn != nil {
+		go lcd.StartRestServer(cdc, registry, registerRoutesFn, tmNode, viper.GetString(FlagListenAddr))
+	}
+	if cfg.GRPC.Enable {
+		go grpc.StartGRPCServer(cdc, registry, app.(app2.ApplicationAdapter), cfg.GRPC, tmNode)
+	}
+
+	// run forever (the node will not be returned)
+	select {}
+}
+
+func catchUpReplicaRoutine(ctx *Context, app replicaApp, tmNode *node.Node, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := catchUpReplica(app, tmNode); err != nil {
+			// the state of the latest block may not be persisted yet by the node, it's retried on the next tick
+			ctx.Logger.Error("failed to catch up with the node", "err", err)
+		}
+	}
+}
+
+func catchUpReplica(app replicaApp, tmNode *node.Node) error {
+	if err := dbm.CatchUpReplicas(); err != nil {
+		return err
+	}
+	if err := app.ReloadLatestVersion(); err != nil {
+		return err
+	}
+	tmNode.ReloadReplica(app.LastBlockHeight())
+	return nil
+}
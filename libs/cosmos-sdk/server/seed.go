@@ -0,0 +1,41 @@
+package server
+
+import (
+	"github.com/okex/exchain/libs/tendermint/node"
+	"github.com/okex/exchain/libs/tendermint/p2p"
+)
+
+const FlagSeedNode = "seed-node"
+
+// startSeedNode starts a lightweight seed node crawling the network and serving the addresses of the peers it found,
+// it doesn't open the DBs nor run the app. Its address book can be exported with the tendermint export-addrbook
+// command for the p2p.seeds_file of the other nodes.
+func startSeedNode(ctx *Context) error {
+	cfg := ctx.Config
+	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
+	if err != nil {
+		return err
+	}
+	seedNode, err := node.NewSeedNode(
+		cfg,
+		nodeKey,
+		node.DefaultGenesisDocProviderFunc(cfg),
+		ctx.Logger.With("module", "node"),
+	)
+	if err != nil {
+		return err
+	}
+	if err := seedNode.Start(); err != nil {
+		return err
+	}
+
+	TrapSignal(func() {
+		if seedNode.IsRunning() {
+			_ = seedNode.Stop()
+		}
+		ctx.Logger.Info("exiting...")
+	})
+
+	// run forever (the node will not be returned)
+	select {}
+}
@@ -3,6 +3,7 @@ package server
 // DONTCOVER
 
 import (
+	gocontext "context"
 	"os"
 	"runtime/pprof"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/okex/exchain/libs/cosmos-sdk/server/grpc"
 	"github.com/okex/exchain/libs/tendermint/consensus"
+	"github.com/okex/exchain/libs/tendermint/halt"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/store/mpt"
 	"github.com/okex/exchain/libs/tendermint/rpc/client"
@@ -20,8 +22,11 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/client/lcd"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	"github.com/okex/exchain/libs/cosmos-sdk/store/iavl"
+	"github.com/okex/exchain/libs/cosmos-sdk/store/rootmulti"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
 	"github.com/okex/exchain/libs/system"
+	sysmetrics "github.com/okex/exchain/libs/system/metrics"
+	"github.com/okex/exchain/libs/system/telemetry"
 	"github.com/okex/exchain/libs/tendermint/libs/cli"
 	"github.com/okex/exchain/libs/tendermint/libs/log"
 	"github.com/okex/exchain/libs/tendermint/mempool"
@@ -37,6 +42,7 @@ import (
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 	bcv0 "github.com/okex/exchain/libs/tendermint/blockchain/v0"
 	tcmd "github.com/okex/exchain/libs/tendermint/cmd/tendermint/commands"
+	tmcfg "github.com/okex/exchain/libs/tendermint/config"
 	tmos "github.com/okex/exchain/libs/tendermint/libs/os"
 	pvm "github.com/okex/exchain/libs/tendermint/privval"
 	tmtypes "github.com/okex/exchain/libs/tendermint/types"
@@ -54,6 +60,10 @@ const (
 	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
 	FlagTrace              = "trace"
 
+	FlagQueryGasLimit        = "query-gas-limit"
+	FlagQueryGasBudget       = "query-gas-budget"
+	FlagQueryGasBudgetWindow = "query-gas-budget-window"
+
 	FlagPruning           = "pruning"
 	FlagPruningKeepRecent = "pruning-keep-recent"
 	FlagPruningKeepEvery  = "pruning-keep-every"
@@ -129,6 +139,19 @@ which accepts a path for the resulting pprof file.
 			sub := subFunc(ctx.Logger)
 			log.SetSubscriber(sub)
 
+			if viper.GetBool(FlagReplica) {
+				if err := startReplica(ctx, cdc, registry, appCreator, registerRoutesFn); err != nil {
+					tmos.Exit(err.Error())
+				}
+				return nil
+			}
+			if viper.GetBool(FlagSeedNode) {
+				if err := startSeedNode(ctx); err != nil {
+					tmos.Exit(err.Error())
+				}
+				return nil
+			}
+
 			setPID(ctx)
 			_, err := startInProcess(ctx, cdc, registry, appCreator, appStop, registerRoutesFn)
 			if err != nil {
@@ -164,6 +187,14 @@ func startInProcess(ctx *Context, cdc *codec.CodecProxy, registry jsonpb.AnyReso
 		return nil, err
 	}
 
+	// export the spans of the tx execution pipeline over OTLP
+	shutdownTelemetry, err := telemetry.Init("exchaind")
+	if err != nil {
+		return nil, err
+	}
+
+	initAppMetrics(cfg)
+
 	app := appCreator(ctx.Logger, db, traceWriter)
 
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
@@ -171,6 +202,13 @@ func startInProcess(ctx *Context, cdc *codec.CodecProxy, registry jsonpb.AnyReso
 		return nil, err
 	}
 
+	var nodeOptions []node.Option
+	if scheduler, ok := app.(interface {
+		HaltSchedule() halt.Schedule
+	}); ok {
+		nodeOptions = append(nodeOptions, node.HaltScheduleSource(scheduler.HaltSchedule))
+	}
+
 	// create & start tendermint node
 	tmNode, err := node.NewNode(
 		cfg,
@@ -181,6 +219,7 @@ func startInProcess(ctx *Context, cdc *codec.CodecProxy, registry jsonpb.AnyReso
 		node.DefaultDBProvider,
 		node.DefaultMetricsProvider(cfg.Instrumentation),
 		ctx.Logger.With("module", "node"),
+		nodeOptions...,
 	)
 	if err != nil {
 		return nil, err
@@ -225,11 +264,15 @@ func startInProcess(ctx *Context, cdc *codec.CodecProxy, registry jsonpb.AnyReso
 		}
 	}
 
+	TrapReloadSignal(home, ctx.Logger)
 	TrapSignal(func() {
 		if tmNode.IsRunning() {
 			_ = tmNode.Stop()
 		}
 		appStop(app)
+		if err := shutdownTelemetry(gocontext.Background()); err != nil {
+			ctx.Logger.Error("failed to shutdown telemetry", "err", err)
+		}
 
 		if cpuProfileCleanup != nil {
 			cpuProfileCleanup()
@@ -260,10 +303,23 @@ func startInProcess(ctx *Context, cdc *codec.CodecProxy, registry jsonpb.AnyReso
 		tmNode.Mempool().SetTxInfoParser(parser)
 	}
 
+	// the txs of the wal are rechecked once the application is fully set up
+	if err := tmNode.Mempool().InitWAL(); err != nil {
+		return nil, err
+	}
+
 	// run forever (the node will not be returned)
 	select {}
 }
 
+// initAppMetrics reports the metrics of the app under the instrumentation namespace of the node if enabled
+func initAppMetrics(conf *tmcfg.Config) {
+	sysmetrics.Init(conf.Instrumentation.Prometheus && conf.Instrumentation.AppMetrics, conf.Instrumentation.Namespace)
+	if sysmetrics.Enabled() {
+		rootmulti.SetMetrics(rootmulti.PrometheusMetrics(sysmetrics.Namespace()))
+	}
+}
+
 func StartRestWithNode(ctx *Context, cdc *codec.CodecProxy, blockStoreDir string,
 	registry jsonpb.AnyResolver, appCreator AppCreator,
 	registerRoutesFn func(restServer *lcd.RestServer)) (*node.Node, error) {
@@ -284,6 +340,8 @@ func StartRestWithNode(ctx *Context, cdc *codec.CodecProxy, blockStoreDir string
 		return nil, err
 	}
 
+	initAppMetrics(cfg)
+
 	app := appCreator(ctx.Logger, db, traceWriter)
 
 	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
@@ -356,4 +414,6 @@ func SetExternalPackageValue(cmd *cobra.Command) {
 	consensus.SetActiveVC(viper.GetBool(FlagActiveViewChange))
 
 	tmtypes.EnableEventBlockTime = viper.GetBool(FlagEventBlockTime)
+
+	baseapp.TxResourcesCacheSize = viper.GetInt(baseapp.FlagTxResourcesCacheSize)
 }
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -169,7 +170,14 @@ func RegisterServerFlags(cmd *cobra.Command) *cobra.Command {
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "Gas limit of each query, the queries running out of it fail with an out of gas error (0 means unlimited)")
+	cmd.Flags().Uint64(FlagQueryGasBudget, 0, "Gas the queries of each gRPC connection may consume within the query-gas-budget-window (0 means unlimited)")
+	cmd.Flags().Duration(FlagQueryGasBudgetWindow, time.Minute, "Window of the query gas budget of the gRPC connections")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
+	cmd.Flags().Bool(FlagReplica, false, "Run as a read-only replica serving the queries from the rocksdb of the node running in the same home, the txs must be sent to the node")
+	cmd.Flags().String(FlagReplicaDir, "", "Directory of the files private to the replica (default \"$HOME/replica\")")
+	cmd.Flags().Duration(FlagReplicaCatchUpInterval, time.Second, "Interval at which the replica catches up with the state persisted by the node")
+	cmd.Flags().Bool(FlagSeedNode, false, "Run as a lightweight seed node crawling the network and serving the addresses of the peers, without storing blocks nor running the app")
 
 	cmd.Flags().String(FlagPruning, storetypes.PruningOptionEverything, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
@@ -225,6 +233,7 @@ func RegisterServerFlags(cmd *cobra.Command) *cobra.Command {
 	cmd.Flags().Int(state.FlagApplyBlockPprofTime, -1, "time(ms) of executing ApplyBlock, if it is higher than this value, save pprof")
 
 	cmd.Flags().Float64Var(&baseapp.GasUsedFactor, baseapp.FlagGasUsedFactor, 0.4, "factor to calculate history gas used")
+	cmd.Flags().Int(baseapp.FlagTxResourcesCacheSize, 0, "Number of the latest delivered txs whose resource consumption is kept for debug_txResources, 0 disables the resource accounting")
 
 	cmd.Flags().Bool(sdk.FlagMultiCache, false, "Enable multi cache")
 	cmd.Flags().MarkHidden(sdk.FlagMultiCache)
@@ -269,6 +278,8 @@ func RegisterServerFlags(cmd *cobra.Command) *cobra.Command {
 	cmd.Flags().UintVar(&mpt.TrieCacheSize, mpt.FlagTrieCacheSize, 2048, "Size (MB) to cache trie nodes")
 	cmd.Flags().UintVar(&mpt.TrieNodesLimit, mpt.FlagTrieNodesLimit, 256, "Max node size (MB) cached in triedb")
 	cmd.Flags().UintVar(&mpt.TrieImgsLimit, mpt.FlagTrieImgsLimit, 4, "Max img size (MB) cached in triedb")
+	cmd.Flags().BoolVar(&mpt.TrieAsyncCommit, mpt.FlagTrieAsyncCommit, false, "Write the trie data (acc & evm) to a write-ahead log and flush it to db in the background")
+	cmd.Flags().BoolVar(&mpt.TrieBlockPrefetch, mpt.FlagTrieBlockPrefetch, false, "Prefetch the accounts and the contract storage roots of the txs of a block before delivering them")
 	cmd.Flags().UintVar(&mpt.TrieAccStoreCache, mpt.FlagTrieAccStoreCache, 32, "Size (MB) to cache account")
 	cmd.Flags().BoolVar(&evmtypes.TrieUseCompositeKey, evmtypes.FlagTrieUseCompositeKey, false, "Use composite key to store contract state in mpt")
 	cmd.Flags().Int64(FlagCommitGapHeight, 100, "Block interval to commit cached data into db, affects iavl & mpt")
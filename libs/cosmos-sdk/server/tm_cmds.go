@@ -3,6 +3,7 @@ package server
 // DONTCOVER
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -12,6 +13,7 @@ import (
 	tcmd "github.com/okex/exchain/libs/tendermint/cmd/tendermint/commands"
 	"github.com/okex/exchain/libs/tendermint/libs/cli"
 	"github.com/okex/exchain/libs/tendermint/p2p"
+	"github.com/okex/exchain/libs/tendermint/p2p/pex"
 	pvm "github.com/okex/exchain/libs/tendermint/privval"
 	tversion "github.com/okex/exchain/libs/tendermint/version"
 
@@ -123,6 +125,34 @@ against which this app has been compiled.
 	return cmd
 }
 
+const flagMaxSeeds = "max-seeds"
+
+// ExportAddrBookCmd exports the address book of the node in the json format of the p2p.seeds_file of the other nodes
+func ExportAddrBookCmd(ctx *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-addrbook",
+		Short: "Export the address book of this node for the seeds of the other nodes",
+		Long: `Export the address book of this node, typically a seed node, in the json format of the p2p.seeds_file
+of the other nodes. Its seeds field can also be set as their p2p.seeds. The address book is saved every 2 minutes
+by a running node.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			export, err := pex.ExportAddrBook(ctx.Config.P2P.AddrBookFile(), viper.GetInt(flagMaxSeeds))
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(export, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(bz))
+			return nil
+		},
+	}
+	cmd.Flags().Int(flagMaxSeeds, 50, "Maximum number of the seeds exported, 0 means all of them")
+	return cmd
+}
+
 func printlnJSON(v interface{}) error {
 	cdc := codec.New()
 	codec.RegisterCrypto(cdc)
@@ -75,7 +75,11 @@ func PersistentPreRunEFn(context *Context) func(*cobra.Command, []string) error
 		}
 
 		logger := log.NewTMLogger(log.NewSyncWriter(output))
-		logger, err = tmflags.ParseLogLevel(config.LogLevel, logger, cfg.DefaultLogLevel())
+		if config.LogFormat == cfg.LogFormatJSON {
+			logger = log.NewTMJSONLogger(log.NewSyncWriter(output))
+		}
+		// the log levels can be changed at runtime by the unsafe_set_log_level rpc or SIGHUP
+		logger, err = tmflags.ParseDynamicLogLevel(config.LogLevel, logger, cfg.DefaultLogLevel())
 		if err != nil {
 			return err
 		}
@@ -166,6 +170,7 @@ func AddCommands(
 		ShowValidatorCmd(ctx),
 		ShowAddressCmd(ctx),
 		VersionCmd(ctx),
+		ExportAddrBookCmd(ctx),
 	)
 
 	rootCmd.AddCommand(
@@ -231,6 +236,29 @@ func ExternalIP() (string, error) {
 }
 
 // TrapSignal traps SIGINT and SIGTERM and terminates the server correctly.
+// TrapReloadSignal reloads the log level from config.toml on SIGHUP, so that the operators can change the log levels
+// of a running node
+func TrapReloadSignal(rootDir string, logger log.Logger) {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	go func() {
+		for range sighup {
+			v := viper.New()
+			v.SetConfigFile(filepath.Join(rootDir, "config", "config.toml"))
+			if err := v.ReadInConfig(); err != nil {
+				logger.Error("failed to reload config", "err", err)
+				continue
+			}
+			lvl := v.GetString("log_level")
+			if err := tmflags.SetDynamicLogLevel(lvl); err != nil {
+				logger.Error("failed to reload log level", "log_level", lvl, "err", err)
+				continue
+			}
+			logger.Info("log level reloaded", "log_level", tmflags.GetDynamicLogLevel())
+		}
+	}()
+}
+
 func TrapSignal(cleanupFunc func()) {
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
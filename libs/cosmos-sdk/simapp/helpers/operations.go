@@ -0,0 +1,54 @@
+package helpers
+
+import (
+	"math/rand"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/baseapp"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	authexported "github.com/okex/exchain/libs/cosmos-sdk/x/auth/exported"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
+)
+
+// AccountKeeper defines the account getter the simulation operations need to sign their txs
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authexported.Account
+}
+
+// GenAndDeliverTxWithRandFees generates a tx of the msg signed by the simulation account, paying random fees out of
+// the coins left after the ones spent in the msg, and delivers it. The operation is skipped if the account doesn't
+// exist or can't afford the coins spent.
+func GenAndDeliverTxWithRandFees(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak AccountKeeper,
+	simAccount simulation.Account, msg sdk.Msg, coinsSpent sdk.Coins, gas uint64, chainID string,
+) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	if account == nil {
+		return simulation.NoOpMsg(msg.Route()), nil, nil
+	}
+
+	coins, hasNeg := account.SpendableCoins(ctx.BlockTime()).SafeSub(coinsSpent)
+	if hasNeg {
+		return simulation.NoOpMsg(msg.Route()), nil, nil
+	}
+
+	fees, err := simulation.RandomFees(r, ctx, coins)
+	if err != nil {
+		return simulation.NoOpMsg(msg.Route()), nil, err
+	}
+
+	tx := GenTx(
+		[]sdk.Msg{msg},
+		fees,
+		gas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+
+	if _, _, err = app.Deliver(tx); err != nil {
+		return simulation.NoOpMsg(msg.Route()), nil, err
+	}
+
+	return simulation.NewOperationMsg(msg, true, ""), nil, nil
+}
@@ -16,8 +16,13 @@ const (
 	DefaultWeightMsgDelegate                    int = 100
 	DefaultWeightMsgUndelegate                  int = 100
 	DefaultWeightMsgBeginRedelegate             int = 100
+	DefaultWeightMsgAddShares                   int = 100
+	DefaultWeightMsgStoreCode                   int = 50
+	DefaultWeightMsgInstantiateContract         int = 100
+	DefaultWeightMsgExecuteContract             int = 100
 
 	DefaultWeightCommunitySpendProposal int = 5
 	DefaultWeightTextProposal           int = 5
 	DefaultWeightParamChangeProposal    int = 5
+	DefaultWeightTokenMappingProposal   int = 5
 )
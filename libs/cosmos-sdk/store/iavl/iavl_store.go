@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/store/cachekv"
@@ -43,6 +44,10 @@ type Store struct {
 	upgradeVersion int64
 	//for time statistics
 	beginTime time.Time
+	//for metrics, the number of the reads, writes and deletes since the last ResetCount
+	readCount   int64
+	writeCount  int64
+	deleteCount int64
 }
 
 func (st *Store) CurrentVersion() int64 {
@@ -229,12 +234,14 @@ func (st *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.Ca
 // Implements types.KVStore.
 func (st *Store) Set(key, value []byte) {
 	types.AssertValidValue(value)
+	atomic.AddInt64(&st.writeCount, 1)
 	st.tree.Set(key, value)
 	st.setFlatKV(key, value)
 }
 
 // Implements types.KVStore.
 func (st *Store) Get(key []byte) []byte {
+	atomic.AddInt64(&st.readCount, 1)
 	value := st.getFlatKV(key)
 	if value != nil {
 		return value
@@ -257,6 +264,7 @@ func (st *Store) Has(key []byte) (exists bool) {
 
 // Implements types.KVStore.
 func (st *Store) Delete(key []byte) {
+	atomic.AddInt64(&st.deleteCount, 1)
 	st.tree.Remove(key)
 	st.deleteFlatKV(key)
 }
@@ -405,6 +413,14 @@ func (st *Store) GetNodeReadCount() int {
 func (st *Store) ResetCount() {
 	st.tree.ResetCount()
 	st.resetFlatKVCount()
+	atomic.StoreInt64(&st.readCount, 0)
+	atomic.StoreInt64(&st.writeCount, 0)
+	atomic.StoreInt64(&st.deleteCount, 0)
+}
+
+// GetOpCount returns the number of the reads, writes and deletes since the last ResetCount
+func (st *Store) GetOpCount() (reads, writes, deletes int) {
+	return int(atomic.LoadInt64(&st.readCount)), int(atomic.LoadInt64(&st.writeCount)), int(atomic.LoadInt64(&st.deleteCount))
 }
 
 func (st *Store) StartTiming() {
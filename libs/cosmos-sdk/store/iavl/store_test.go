@@ -191,6 +191,26 @@ func TestIAVLStoreGetSetHasDelete(t *testing.T) {
 	require.False(t, exists)
 }
 
+func TestIAVLStoreOpCount(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree, _ := newAlohaTree(t, db)
+	iavlStore := UnsafeNewStore(tree)
+
+	iavlStore.Get([]byte("hello"))
+	iavlStore.Get([]byte("aloha"))
+	iavlStore.Set([]byte("hello"), []byte("goodbye"))
+	iavlStore.Delete([]byte("aloha"))
+
+	reads, writes, deletes := iavlStore.GetOpCount()
+	require.Equal(t, 2, reads)
+	require.Equal(t, 1, writes)
+	require.Equal(t, 1, deletes)
+
+	iavlStore.ResetCount()
+	reads, writes, deletes = iavlStore.GetOpCount()
+	require.Zero(t, reads+writes+deletes)
+}
+
 func TestIAVLStoreNoNilSet(t *testing.T) {
 	db := dbm.NewMemDB()
 	tree, _ := newAlohaTree(t, db)
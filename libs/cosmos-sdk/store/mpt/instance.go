@@ -18,10 +18,13 @@ import (
 const (
 	mptDataDir = "data"
 	mptSpace   = "mpt"
+
+	asyncCommitQueueSize = 1024
 )
 
 var (
 	gMptDatabase ethstate.Database = nil
+	gAsyncKvDB   *types.AsyncKeyValueStore
 	initMptOnce  sync.Once
 )
 
@@ -39,6 +42,13 @@ func InstanceOfMptStore() ethstate.Database {
 		if e != nil {
 			panic("fail to open database: " + e.Error())
 		}
+		if TrieAsyncCommit {
+			gAsyncKvDB, e = types.NewAsyncKeyValueStore(kvstore, filepath.Join(path, mptSpace+".wal"), asyncCommitQueueSize)
+			if e != nil {
+				panic("fail to open the write-ahead log of database: " + e.Error())
+			}
+			kvstore = gAsyncKvDB
+		}
 		db := rawdb.NewDatabase(kvstore)
 		gMptDatabase = ethstate.NewDatabaseWithConfig(db, &trie.Config{
 			Cache:     int(TrieCacheSize),
@@ -50,6 +60,14 @@ func InstanceOfMptStore() ethstate.Database {
 	return gMptDatabase
 }
 
+// FlushAsyncCommit blocks until the writes of the async commit are applied to the database
+func FlushAsyncCommit() error {
+	if gAsyncKvDB == nil {
+		return nil
+	}
+	return gAsyncKvDB.Flush()
+}
+
 // GetLatestStoredBlockHeight get latest mpt storage height
 func (ms *MptStore) GetLatestStoredBlockHeight() uint64 {
 	rst, err := ms.db.TrieDB().DiskDB().Get(KeyPrefixAccLatestStoredHeight)
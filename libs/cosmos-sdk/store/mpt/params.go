@@ -22,6 +22,8 @@ const (
 	FlagTrieCacheSize     = "trie.cache-size"
 	FlagTrieNodesLimit    = "trie.nodes-limit"
 	FlagTrieImgsLimit     = "trie.imgs-limit"
+	FlagTrieAsyncCommit   = "trie.async-commit"
+	FlagTrieBlockPrefetch = "trie.block-prefetch"
 )
 
 var (
@@ -31,6 +33,8 @@ var (
 	TrieNodesLimit    uint  = 256  // MB
 	TrieImgsLimit     uint  = 4    // MB
 	TrieCommitGap     int64 = 100
+	TrieAsyncCommit         = false
+	TrieBlockPrefetch       = false
 )
 
 var (
@@ -38,6 +42,7 @@ var (
 	KeyPrefixAccLatestStoredHeight = []byte{0x12}
 	KeyPrefixEvmRootMptHash        = []byte{0x13}
 	KeyPrefixEvmLatestStoredHeight = []byte{0x14}
+	KeyPrefixEvmDestructedStorage  = []byte{0x15}
 
 	GAccToPrefetchChannel    = make(chan [][]byte, 2000)
 	GAccTryUpdateTrieChannel = make(chan struct{})
@@ -1,6 +1,15 @@
 package mpt
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/trie"
+
 	"github.com/okex/exchain/libs/tendermint/crypto/merkle"
 )
 
@@ -19,19 +28,142 @@ const ProofOpMptValue = "mpt:v"
 const ProofOpMptAbsence = "mpt:a"
 
 func newProofOpMptValue(key []byte, proof ProofList) merkle.ProofOp {
-	bz := cdc.MustMarshalBinaryLengthPrefixed(proof)
+	return ValueOp{key: key, Proof: proof}.ProofOp()
+}
+
+func newProofOpMptAbsence(key []byte, proof ProofList) merkle.ProofOp {
+	return AbsenceOp{key: key, Proof: proof}.ProofOp()
+}
+
+// verify computes the root hash of the proof, the hash of its first node, and returns it with the value of the key
+// proven by the proof, nil if the proof proves the absence of the key
+func (n ProofList) verify(key []byte) ([]byte, []byte, error) {
+	// the proof of an empty trie is empty
+	if len(n) == 0 {
+		return ethtypes.EmptyRootHash.Bytes(), nil, nil
+	}
+
+	proofDB := memorydb.New()
+	for _, node := range n {
+		if err := proofDB.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, nil, err
+		}
+	}
+	root := crypto.Keccak256Hash(n[0])
+	// the keys of the trie are hashed
+	value, err := trie.VerifyProof(root, crypto.Keccak256(key), proofDB)
+	if err != nil {
+		return nil, nil, err
+	}
+	return root.Bytes(), value, nil
+}
+
+//----------------------------------------
+
+var _ merkle.ProofOperator = ValueOp{}
+
+// ValueOp proves the value of a key of a MPT store, the root hash it computes is the one of the MPT store.
+type ValueOp struct {
+	// Encoded in ProofOp.Key.
+	key []byte
+
+	// To encode in ProofOp.Data.
+	Proof ProofList `json:"proof"`
+}
+
+// ValueOpDecoder decodes a ValueOp from a ProofOp of type ProofOpMptValue.
+func ValueOpDecoder(pop merkle.ProofOp) (merkle.ProofOperator, error) {
+	if pop.Type != ProofOpMptValue {
+		return nil, fmt.Errorf("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpMptValue)
+	}
+	var proof ProofList
+	if err := cdc.UnmarshalBinaryLengthPrefixed(pop.Data, &proof); err != nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into MptValueOp: %w", err)
+	}
+	return ValueOp{key: pop.Key, Proof: proof}, nil
+}
+
+func (op ValueOp) ProofOp() merkle.ProofOp {
 	return merkle.ProofOp{
 		Type: ProofOpMptValue,
-		Key:  key,
-		Data: bz,
+		Key:  op.key,
+		Data: cdc.MustMarshalBinaryLengthPrefixed(op.Proof),
 	}
 }
 
-func newProofOpMptAbsence(key []byte, proof ProofList) merkle.ProofOp {
-	bz := cdc.MustMarshalBinaryLengthPrefixed(proof)
+func (op ValueOp) String() string {
+	return fmt.Sprintf("MptValueOp{%v}", op.GetKey())
+}
+
+func (op ValueOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 1 {
+		return nil, errors.New("value size is not 1")
+	}
+	root, value, err := op.Proof.verify(op.key)
+	if err != nil {
+		return nil, fmt.Errorf("verifying the proof: %w", err)
+	}
+	if value == nil || !bytes.Equal(value, args[0]) {
+		return nil, fmt.Errorf("the value of the key %X doesn't match the proof", op.key)
+	}
+	return [][]byte{root}, nil
+}
+
+func (op ValueOp) GetKey() []byte {
+	return op.key
+}
+
+//----------------------------------------
+
+var _ merkle.ProofOperator = AbsenceOp{}
+
+// AbsenceOp proves the absence of a key from a MPT store, the root hash it computes is the one of the MPT store.
+type AbsenceOp struct {
+	// Encoded in ProofOp.Key.
+	key []byte
+
+	// To encode in ProofOp.Data.
+	Proof ProofList `json:"proof"`
+}
+
+// AbsenceOpDecoder decodes an AbsenceOp from a ProofOp of type ProofOpMptAbsence.
+func AbsenceOpDecoder(pop merkle.ProofOp) (merkle.ProofOperator, error) {
+	if pop.Type != ProofOpMptAbsence {
+		return nil, fmt.Errorf("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpMptAbsence)
+	}
+	var proof ProofList
+	if err := cdc.UnmarshalBinaryLengthPrefixed(pop.Data, &proof); err != nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into MptAbsenceOp: %w", err)
+	}
+	return AbsenceOp{key: pop.Key, Proof: proof}, nil
+}
+
+func (op AbsenceOp) ProofOp() merkle.ProofOp {
 	return merkle.ProofOp{
 		Type: ProofOpMptAbsence,
-		Key:  key,
-		Data: bz,
+		Key:  op.key,
+		Data: cdc.MustMarshalBinaryLengthPrefixed(op.Proof),
+	}
+}
+
+func (op AbsenceOp) String() string {
+	return fmt.Sprintf("MptAbsenceOp{%v}", op.GetKey())
+}
+
+func (op AbsenceOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf("expected 0 args, got %v", len(args))
+	}
+	root, value, err := op.Proof.verify(op.key)
+	if err != nil {
+		return nil, fmt.Errorf("verifying the proof: %w", err)
 	}
+	if value != nil {
+		return nil, fmt.Errorf("the proof proves the key %X exists", op.key)
+	}
+	return [][]byte{root}, nil
+}
+
+func (op AbsenceOp) GetKey() []byte {
+	return op.key
 }
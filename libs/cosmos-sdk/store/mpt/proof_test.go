@@ -0,0 +1,40 @@
+package mpt
+
+import (
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/crypto/merkle"
+)
+
+func (suite *StoreTestSuite) TestMPTStoreProof() {
+	store := suite.mptStore
+	key, value := []byte(commonKeys[0]), []byte(commonValues[1])
+	store.Set(key, value)
+	cid, _ := store.CommitterCommit(nil)
+
+	prt := merkle.NewProofRuntime()
+	prt.RegisterOpDecoder(ProofOpMptValue, ValueOpDecoder)
+	prt.RegisterOpDecoder(ProofOpMptAbsence, AbsenceOpDecoder)
+	keyPath := func(key []byte) string {
+		return merkle.KeyPath{}.AppendKey(key, merkle.KeyEncodingHex).String()
+	}
+
+	// the value is proven against the root hash of the store
+	res := store.Query(abci.RequestQuery{Path: "/key", Data: key, Height: cid.Version, Prove: true})
+	suite.Require().Equal(value, res.Value)
+	suite.Require().NoError(prt.VerifyValue(res.Proof, cid.Hash, keyPath(key), value))
+	suite.Require().Error(prt.VerifyValue(res.Proof, cid.Hash, keyPath(key), []byte(commonValues[2])))
+	suite.Require().Error(prt.VerifyAbsence(res.Proof, cid.Hash, keyPath(key)))
+
+	// and so is the absence of a key
+	missing := []byte("missing-key")
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: missing, Height: cid.Version, Prove: true})
+	suite.Require().Nil(res.Value)
+	suite.Require().NoError(prt.VerifyAbsence(res.Proof, cid.Hash, keyPath(missing)))
+
+	// the proofs of the previous versions don't match the new root hash
+	store.Set(key, []byte(commonValues[2]))
+	newCid, _ := store.CommitterCommit(nil)
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: key, Height: cid.Version, Prove: true})
+	suite.Require().NoError(prt.VerifyValue(res.Proof, cid.Hash, keyPath(key), value))
+	suite.Require().Error(prt.VerifyValue(res.Proof, newCid.Hash, keyPath(key), value))
+}
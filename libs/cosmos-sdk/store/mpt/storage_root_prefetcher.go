@@ -0,0 +1,88 @@
+package mpt
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethstate "github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// storageRootPrefetchQueueSize is the number of contracts waiting to be prefetched, the contracts beyond it are dropped
+const storageRootPrefetchQueueSize = 2000
+
+// StorageRootPrefetcher loads in the background the storage roots of the contracts and the root nodes of their
+// storage tries, so that the txs of the block don't stall on the cold reads of them. It reads through a copy of the
+// trie at the root of the block which is never written, so the nodes are only warmed in the caches of the database.
+type StorageRootPrefetcher struct {
+	db   ethstate.Database
+	trie ethstate.Trie
+
+	tasks chan common.Address
+	stop  chan struct{}
+	term  chan struct{}
+	once  sync.Once
+	seen  map[common.Address]struct{}
+}
+
+// NewStorageRootPrefetcher opens the trie of the storage roots at root and starts prefetching
+func NewStorageRootPrefetcher(db ethstate.Database, root common.Hash) (*StorageRootPrefetcher, error) {
+	trie, err := db.OpenTrie(root)
+	if err != nil {
+		return nil, err
+	}
+	p := &StorageRootPrefetcher{
+		db:    db,
+		trie:  trie,
+		tasks: make(chan common.Address, storageRootPrefetchQueueSize),
+		stop:  make(chan struct{}),
+		term:  make(chan struct{}),
+		seen:  make(map[common.Address]struct{}),
+	}
+	go p.loop()
+	return p, nil
+}
+
+// Prefetch queues the contracts to prefetch, it never blocks and the contracts are dropped once the queue is full
+func (p *StorageRootPrefetcher) Prefetch(addrs ...common.Address) {
+	for _, addr := range addrs {
+		select {
+		case <-p.stop:
+			return
+		case p.tasks <- addr:
+		default:
+			return
+		}
+	}
+}
+
+// Close stops the prefetcher and waits for it to exit, the contracts left in the queue are dropped
+func (p *StorageRootPrefetcher) Close() {
+	p.once.Do(func() {
+		close(p.stop)
+	})
+	<-p.term
+}
+
+func (p *StorageRootPrefetcher) loop() {
+	defer close(p.term)
+	for {
+		select {
+		case <-p.stop:
+			return
+		case addr := <-p.tasks:
+			if _, ok := p.seen[addr]; ok {
+				continue
+			}
+			p.seen[addr] = struct{}{}
+
+			enc, err := p.trie.TryGet(addr.Bytes())
+			if err != nil || len(enc) == 0 {
+				// not a contract, or the node is missing and the tx reports it
+				continue
+			}
+			// opening the storage trie resolves its root node
+			_, _ = p.db.OpenStorageTrie(crypto.Keccak256Hash(addr.Bytes()), common.BytesToHash(enc))
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package mpt
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethstate "github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/stretchr/testify/require"
+)
+
+type readRecordingDB struct {
+	ethdb.Database
+
+	mtx   sync.Mutex
+	reads map[string]int
+}
+
+func (db *readRecordingDB) Get(key []byte) ([]byte, error) {
+	db.mtx.Lock()
+	db.reads[string(key)]++
+	db.mtx.Unlock()
+	return db.Database.Get(key)
+}
+
+func (db *readRecordingDB) readCount(key []byte) int {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+	return db.reads[string(key)]
+}
+
+func TestStorageRootPrefetcher(t *testing.T) {
+	diskdb := &readRecordingDB{Database: rawdb.NewMemoryDatabase(), reads: make(map[string]int)}
+	db := ethstate.NewDatabase(diskdb)
+
+	// the storage trie of the contract and the trie of the storage roots
+	contract := common.HexToAddress("0xaffeaffeaffeaffeaffeaffeaffeaffeaffeaffe")
+	storage, err := db.OpenStorageTrie(crypto.Keccak256Hash(contract.Bytes()), common.Hash{})
+	require.NoError(t, err)
+	require.NoError(t, storage.TryUpdate(common.HexToHash("aaa").Bytes(), []byte{0x1}))
+	storageRoot, err := storage.Commit(nil)
+	require.NoError(t, err)
+	roots, err := db.OpenTrie(common.Hash{})
+	require.NoError(t, err)
+	require.NoError(t, roots.TryUpdate(contract.Bytes(), storageRoot.Bytes()))
+	root, err := roots.Commit(nil)
+	require.NoError(t, err)
+	require.NoError(t, db.TrieDB().Commit(root, false, nil))
+	require.NoError(t, db.TrieDB().Commit(storageRoot, false, nil))
+
+	p, err := NewStorageRootPrefetcher(db, root)
+	require.NoError(t, err)
+	// the normal accounts have no storage root, the contracts are loaded once
+	p.Prefetch(common.HexToAddress("0x01"), contract, contract)
+	require.Eventually(t, func() bool {
+		return diskdb.readCount(storageRoot.Bytes()) > 0
+	}, time.Second, 10*time.Millisecond)
+	p.Close()
+	require.Equal(t, 1, diskdb.readCount(storageRoot.Bytes()))
+
+	// closing is idempotent and the prefetch after the close doesn't block
+	p.Close()
+	for i := 0; i < 2*storageRootPrefetchQueueSize; i++ {
+		p.Prefetch(contract)
+	}
+}
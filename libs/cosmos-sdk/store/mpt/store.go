@@ -402,6 +402,12 @@ func (ms *MptStore) StopWithVersion(targetVersion int64) error {
 
 	ms.cmLock.Lock()
 	defer ms.cmLock.Unlock()
+	// the writes of the async commit are applied to the database before exiting
+	defer func() {
+		if err := FlushAsyncCommit(); err != nil && ms.logger != nil {
+			ms.logger.Error("Failed to flush the async commit", "err", err)
+		}
+	}()
 
 	if !tmtypes.HigherThanMars(ms.version) && !TrieWriteAhead {
 		return nil
@@ -577,4 +583,13 @@ func (ms *MptStore) prefetchData() {
 	}()
 }
 
+// PrefetchAccounts queues the store keys of the accounts to the prefetcher of the account trie, it never blocks and
+// the keys are dropped if the prefetcher is busy
+func PrefetchAccounts(keys [][]byte) {
+	select {
+	case GAccToPrefetchChannel <- keys:
+	default:
+	}
+}
+
 func (ms *MptStore) SetUpgradeVersion(i int64) {}
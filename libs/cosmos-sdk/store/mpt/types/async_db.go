@@ -0,0 +1,381 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+const (
+	walHeaderSize = 8 // length and crc32 of the record
+
+	opPut    byte = 0
+	opDelete byte = 1
+)
+
+var errAsyncDBNotFound = errors.New("not found")
+
+var _ ethdb.KeyValueStore = (*AsyncKeyValueStore)(nil)
+
+// AsyncKeyValueStore is a write-behind ethdb.KeyValueStore. The writes are appended to a write-ahead log synced to the
+// disk, then applied to the underlying store in the background, so that the commit of the block isn't stalled by the
+// latency spikes of the underlying store, e.g. the compactions of leveldb. The writes not yet applied are read from
+// memory, and they are replayed from the log on the next open if the node crashes before applying them.
+type AsyncKeyValueStore struct {
+	ethdb.KeyValueStore
+
+	writeLock sync.Mutex // serializes the writes, so that they are applied in the order of the log
+
+	lock     sync.Mutex
+	drained  *sync.Cond
+	wal      *os.File
+	pending  map[string]pendingWrite
+	seq      uint64
+	inflight int
+
+	queue chan *walRecord
+	done  chan struct{}
+}
+
+type pendingWrite struct {
+	value   []byte
+	deleted bool
+	seq     uint64
+}
+
+type walOp struct {
+	kind  byte
+	key   []byte
+	value []byte
+}
+
+type walRecord struct {
+	seq uint64
+	ops []walOp
+}
+
+// NewAsyncKeyValueStore replays the write-ahead log at walPath to the store and returns the write-behind store
+// wrapping it, at most queueSize writes are waiting to be applied before a write blocks
+func NewAsyncKeyValueStore(db ethdb.KeyValueStore, walPath string, queueSize int) (*AsyncKeyValueStore, error) {
+	if _, err := ReplayWAL(db, walPath); err != nil {
+		return nil, err
+	}
+	wal, err := os.OpenFile(walPath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	adb := &AsyncKeyValueStore{
+		KeyValueStore: db,
+		wal:           wal,
+		pending:       make(map[string]pendingWrite),
+		queue:         make(chan *walRecord, queueSize),
+		done:          make(chan struct{}),
+	}
+	adb.drained = sync.NewCond(&adb.lock)
+	go adb.applyRoutine()
+	return adb, nil
+}
+
+// ReplayWAL applies the complete records of the write-ahead log at walPath to the store and returns their number, a
+// torn record at the end of the log is dropped
+func ReplayWAL(db ethdb.KeyValueStore, walPath string) (int, error) {
+	bz, err := ioutil.ReadFile(walPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	count := 0
+	for len(bz) >= walHeaderSize {
+		size := binary.BigEndian.Uint32(bz[:4])
+		if uint64(len(bz)-walHeaderSize) < uint64(size) {
+			break
+		}
+		payload := bz[walHeaderSize : walHeaderSize+int(size)]
+		if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(bz[4:walHeaderSize]) {
+			break
+		}
+		ops, err := decodeWALOps(payload)
+		if err != nil {
+			return count, err
+		}
+		if err := applyWALOps(db, ops); err != nil {
+			return count, err
+		}
+		bz = bz[walHeaderSize+int(size):]
+		count++
+	}
+	return count, nil
+}
+
+func encodeWALRecord(ops []walOp) []byte {
+	payload := encodeWALOps(ops)
+	record := make([]byte, walHeaderSize, walHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(record[:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:], crc32.ChecksumIEEE(payload))
+	return append(record, payload...)
+}
+
+func encodeWALOps(ops []walOp) []byte {
+	var bz []byte
+	for _, op := range ops {
+		bz = append(bz, op.kind)
+		bz = appendUvarintBytes(bz, op.key)
+		if op.kind == opPut {
+			bz = appendUvarintBytes(bz, op.value)
+		}
+	}
+	return bz
+}
+
+func appendUvarintBytes(bz, data []byte) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], uint64(len(data)))
+	return append(append(bz, buf[:n]...), data...)
+}
+
+func decodeWALOps(bz []byte) ([]walOp, error) {
+	var ops []walOp
+	readBytes := func() ([]byte, error) {
+		size, n := binary.Uvarint(bz)
+		if n <= 0 || uint64(len(bz)-n) < size {
+			return nil, fmt.Errorf("invalid wal record")
+		}
+		data := bz[n : n+int(size)]
+		bz = bz[n+int(size):]
+		return data, nil
+	}
+
+	for len(bz) > 0 {
+		op := walOp{kind: bz[0]}
+		bz = bz[1:]
+		var err error
+		if op.key, err = readBytes(); err != nil {
+			return nil, err
+		}
+		switch op.kind {
+		case opPut:
+			if op.value, err = readBytes(); err != nil {
+				return nil, err
+			}
+		case opDelete:
+		default:
+			return nil, fmt.Errorf("invalid wal op: %d", op.kind)
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+func applyWALOps(db ethdb.KeyValueStore, ops []walOp) error {
+	batch := db.NewBatch()
+	for _, op := range ops {
+		var err error
+		if op.kind == opPut {
+			err = batch.Put(op.key, op.value)
+		} else {
+			err = batch.Delete(op.key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// write appends the ops to the write-ahead log and queues them to be applied to the underlying store
+func (db *AsyncKeyValueStore) write(ops []walOp) error {
+	if len(ops) == 0 {
+		return nil
+	}
+	db.writeLock.Lock()
+	defer db.writeLock.Unlock()
+
+	record := encodeWALRecord(ops)
+
+	db.lock.Lock()
+	if _, err := db.wal.Write(record); err != nil {
+		db.lock.Unlock()
+		return err
+	}
+	if err := db.wal.Sync(); err != nil {
+		db.lock.Unlock()
+		return err
+	}
+	db.seq++
+	rec := &walRecord{seq: db.seq, ops: ops}
+	for _, op := range ops {
+		db.pending[string(op.key)] = pendingWrite{value: op.value, deleted: op.kind == opDelete, seq: rec.seq}
+	}
+	db.inflight++
+	db.lock.Unlock()
+
+	db.queue <- rec
+	return nil
+}
+
+func (db *AsyncKeyValueStore) applyRoutine() {
+	defer close(db.done)
+	for rec := range db.queue {
+		// the store can't go on with the writes lost, they are replayed from the log on the next open
+		if err := applyWALOps(db.KeyValueStore, rec.ops); err != nil {
+			panic(fmt.Sprintf("failed to apply the writes of the async db: %s", err))
+		}
+
+		db.lock.Lock()
+		for _, op := range rec.ops {
+			if p, ok := db.pending[string(op.key)]; ok && p.seq == rec.seq {
+				delete(db.pending, string(op.key))
+			}
+		}
+		db.inflight--
+		if db.inflight == 0 {
+			// all the records of the log are applied
+			if err := db.wal.Truncate(0); err == nil {
+				_, _ = db.wal.Seek(0, 0)
+			}
+			db.drained.Broadcast()
+		}
+		db.lock.Unlock()
+	}
+}
+
+// Flush blocks until all the writes are applied to the underlying store
+func (db *AsyncKeyValueStore) Flush() error {
+	db.writeLock.Lock()
+	defer db.writeLock.Unlock()
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	for db.inflight > 0 {
+		db.drained.Wait()
+	}
+	return db.wal.Sync()
+}
+
+// Has retrieves if a key is present in the key-value data store.
+func (db *AsyncKeyValueStore) Has(key []byte) (bool, error) {
+	db.lock.Lock()
+	p, ok := db.pending[string(key)]
+	db.lock.Unlock()
+	if ok {
+		return !p.deleted, nil
+	}
+	return db.KeyValueStore.Has(key)
+}
+
+// Get retrieves the given key if it's present in the key-value data store.
+func (db *AsyncKeyValueStore) Get(key []byte) ([]byte, error) {
+	db.lock.Lock()
+	p, ok := db.pending[string(key)]
+	db.lock.Unlock()
+	if ok {
+		if p.deleted {
+			return nil, errAsyncDBNotFound
+		}
+		return ethcmn.CopyBytes(p.value), nil
+	}
+	return db.KeyValueStore.Get(key)
+}
+
+// Put inserts the given value into the key-value data store.
+func (db *AsyncKeyValueStore) Put(key []byte, value []byte) error {
+	return db.write([]walOp{{opPut, ethcmn.CopyBytes(key), ethcmn.CopyBytes(value)}})
+}
+
+// Delete removes the key from the key-value data store.
+func (db *AsyncKeyValueStore) Delete(key []byte) error {
+	return db.write([]walOp{{opDelete, ethcmn.CopyBytes(key), nil}})
+}
+
+// NewBatch creates a write-only database that buffers changes to its host db
+// until a final write is called.
+func (db *AsyncKeyValueStore) NewBatch() ethdb.Batch {
+	return &asyncBatch{db: db}
+}
+
+// NewIterator creates a binary-alphabetical iterator over a subset of database content with a particular key prefix,
+// starting at a particular initial key. The pending writes are applied first, so that the iterator sees them.
+func (db *AsyncKeyValueStore) NewIterator(prefix []byte, start []byte) ethdb.Iterator {
+	if err := db.Flush(); err != nil {
+		panic(fmt.Sprintf("failed to flush the async db: %s", err))
+	}
+	return db.KeyValueStore.NewIterator(prefix, start)
+}
+
+// Close applies the pending writes and closes the underlying store
+func (db *AsyncKeyValueStore) Close() error {
+	if err := db.Flush(); err != nil {
+		return err
+	}
+	close(db.queue)
+	<-db.done
+	if err := db.wal.Close(); err != nil {
+		return err
+	}
+	return db.KeyValueStore.Close()
+}
+
+var _ ethdb.Batch = (*asyncBatch)(nil)
+
+type asyncBatch struct {
+	db   *AsyncKeyValueStore
+	ops  []walOp
+	size int
+}
+
+// Put inserts the given value into the batch for later committing.
+func (b *asyncBatch) Put(key []byte, value []byte) error {
+	b.ops = append(b.ops, walOp{opPut, ethcmn.CopyBytes(key), ethcmn.CopyBytes(value)})
+	b.size += len(value)
+	return nil
+}
+
+// Delete inserts the a key removal into the batch for later committing.
+func (b *asyncBatch) Delete(key []byte) error {
+	b.ops = append(b.ops, walOp{opDelete, ethcmn.CopyBytes(key), nil})
+	b.size += len(key)
+	return nil
+}
+
+// ValueSize retrieves the amount of data queued up for writing.
+func (b *asyncBatch) ValueSize() int {
+	return b.size
+}
+
+// Write flushes any accumulated data to the write-ahead log.
+func (b *asyncBatch) Write() error {
+	return b.db.write(b.ops)
+}
+
+// Reset resets the batch for reuse.
+func (b *asyncBatch) Reset() {
+	b.ops = nil
+	b.size = 0
+}
+
+// Replay replays the batch contents.
+func (b *asyncBatch) Replay(w ethdb.KeyValueWriter) error {
+	for _, op := range b.ops {
+		var err error
+		if op.kind == opPut {
+			err = w.Put(op.key, op.value)
+		} else {
+			err = w.Delete(op.key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsyncKeyValueStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "async_db")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	walPath := filepath.Join(dir, "mpt.wal")
+
+	mem := memorydb.New()
+	require.NoError(t, mem.Put([]byte("a"), []byte("0")))
+	db, err := NewAsyncKeyValueStore(mem, walPath, 16)
+	require.NoError(t, err)
+
+	batch := db.NewBatch()
+	require.NoError(t, batch.Put([]byte("a"), []byte("1")))
+	require.NoError(t, batch.Put([]byte("b"), []byte("2")))
+	require.NoError(t, batch.Write())
+	require.NoError(t, db.Delete([]byte("b")))
+	require.NoError(t, db.Put([]byte("c"), []byte("3")))
+
+	// the pending writes are read before being applied
+	value, err := db.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+	has, err := db.Has([]byte("b"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the iterators see the pending writes
+	it := db.NewIterator(nil, nil)
+	var keys []string
+	for it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	it.Release()
+	require.Equal(t, []string{"a", "c"}, keys)
+
+	// the write-ahead log is truncated once applied
+	require.NoError(t, db.Flush())
+	info, err := os.Stat(walPath)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), info.Size())
+	value, err = mem.Get([]byte("c"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("3"), value)
+	require.NoError(t, db.Close())
+}
+
+func TestReplayWAL(t *testing.T) {
+	dir, err := ioutil.TempDir("", "async_db")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	walPath := filepath.Join(dir, "mpt.wal")
+
+	// the node crashed before applying the writes, the last record is torn
+	var wal []byte
+	wal = append(wal, encodeWALRecord([]walOp{{opPut, []byte("a"), []byte("1")}, {opPut, []byte("b"), []byte("2")}})...)
+	wal = append(wal, encodeWALRecord([]walOp{{opDelete, []byte("a"), nil}})...)
+	torn := encodeWALRecord([]walOp{{opPut, []byte("c"), []byte("3")}})
+	wal = append(wal, torn[:len(torn)-1]...)
+	require.NoError(t, ioutil.WriteFile(walPath, wal, 0644))
+
+	mem := memorydb.New()
+	db, err := NewAsyncKeyValueStore(mem, walPath, 16)
+	require.NoError(t, err)
+	has, err := mem.Has([]byte("a"))
+	require.NoError(t, err)
+	require.False(t, has)
+	value, err := mem.Get([]byte("b"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), value)
+	has, err = mem.Has([]byte("c"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the replayed log is truncated
+	info, err := os.Stat(walPath)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), info.Size())
+	require.NoError(t, db.Close())
+}
@@ -25,6 +25,13 @@ type WrapRocksDB struct {
 }
 
 func NewWrapRocksDB(name string, dir string) (*WrapRocksDB, error) {
+	if tmdb.IsReplicaMode() {
+		rdb, err := tmdb.NewReplicaDB(name, tmdb.RocksDBBackend, dir)
+		if err != nil {
+			return nil, err
+		}
+		return &WrapRocksDB{rdb.(*tmdb.RocksDB)}, nil
+	}
 	rdb, err := tmdb.NewRocksDB(name, dir)
 	return &WrapRocksDB{rdb}, err
 }
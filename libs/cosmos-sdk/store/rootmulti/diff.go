@@ -0,0 +1,112 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/store/iavl"
+	"github.com/okex/exchain/libs/cosmos-sdk/store/types"
+)
+
+// DiffVersions returns the keys with prefix of the stores changed between the versions from and to, all the stores
+// are diffed if storeNames is empty. At most limit changes are returned if limit is positive. The iavl stores are
+// diffed by merging the iterators of both versions, the other stores are skipped.
+func (rs *Store) DiffVersions(from, to int64, storeNames []string, prefix []byte, limit int) (*types.StateDiff, error) {
+	if from <= 0 || to <= 0 {
+		return nil, fmt.Errorf("invalid versions %d and %d", from, to)
+	}
+	names := storeNames
+	if len(names) == 0 {
+		for name := range rs.keysByName {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	diff := &types.StateDiff{From: from, To: to}
+	for _, name := range names {
+		key := rs.keysByName[name]
+		if key == nil {
+			return nil, fmt.Errorf("store %s isn't mounted", name)
+		}
+		store := rs.GetCommitKVStore(key)
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			diff.Skipped = append(diff.Skipped, name)
+			continue
+		}
+
+		before, err := rs.getImmutableIAVL(name, store.(*iavl.Store), from)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load version %d of store %s: %w", from, name, err)
+		}
+		after, err := rs.getImmutableIAVL(name, store.(*iavl.Store), to)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load version %d of store %s: %w", to, name, err)
+		}
+		diffKVStores(before, after, prefix, func(k, a, b []byte) bool {
+			if limit > 0 && len(diff.Changes) >= limit {
+				diff.Truncated = true
+				return false
+			}
+			diff.Changes = append(diff.Changes, types.KVChange{Store: name, Key: k, Before: a, After: b})
+			return true
+		})
+		if diff.Truncated {
+			break
+		}
+	}
+	return diff, nil
+}
+
+// getImmutableIAVL returns the store at version, which is empty if the store isn't committed at the version
+func (rs *Store) getImmutableIAVL(name string, store *iavl.Store, version int64) (*iavl.Store, error) {
+	if evmAccStoreFilter(name, version) || filter(name, version, nil, rs.commitFilters) {
+		return store.GetEmptyImmutable(), nil
+	}
+	return store.GetImmutable(version)
+}
+
+// diffKVStores calls fn with the keys with prefix whose values differ in a and b, in the order of the keys, until fn
+// returns false. The value is nil in the store which doesn't have the key.
+func diffKVStores(a, b types.KVStore, prefix []byte, fn func(key, valueA, valueB []byte) bool) {
+	iterA := types.KVStorePrefixIterator(a, prefix)
+	defer iterA.Close()
+	iterB := types.KVStorePrefixIterator(b, prefix)
+	defer iterB.Close()
+
+	for iterA.Valid() || iterB.Valid() {
+		var c int
+		switch {
+		case !iterA.Valid():
+			c = 1
+		case !iterB.Valid():
+			c = -1
+		default:
+			c = bytes.Compare(iterA.Key(), iterB.Key())
+		}
+
+		next := true
+		switch {
+		case c < 0:
+			next = fn(copyBytes(iterA.Key()), copyBytes(iterA.Value()), nil)
+			iterA.Next()
+		case c > 0:
+			next = fn(copyBytes(iterB.Key()), nil, copyBytes(iterB.Value()))
+			iterB.Next()
+		default:
+			if !bytes.Equal(iterA.Value(), iterB.Value()) {
+				next = fn(copyBytes(iterA.Key()), copyBytes(iterA.Value()), copyBytes(iterB.Value()))
+			}
+			iterA.Next()
+			iterB.Next()
+		}
+		if !next {
+			return
+		}
+	}
+}
+
+func copyBytes(bz []byte) []byte {
+	return append([]byte{}, bz...)
+}
@@ -0,0 +1,60 @@
+package rootmulti
+
+import (
+	"testing"
+
+	dbm "github.com/okex/exchain/libs/tm-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/store/types"
+)
+
+func TestDiffVersions(t *testing.T) {
+	ms := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	store1 := ms.getStoreByName("store1").(types.KVStore)
+	store2 := ms.getStoreByName("store2").(types.KVStore)
+	store1.Set([]byte("a"), []byte("1"))
+	store1.Set([]byte("b"), []byte("2"))
+	store1.Set([]byte("c"), []byte("3"))
+	store2.Set([]byte("x"), []byte("1"))
+	ms.CommitterCommitMap(nil)
+
+	store1.Set([]byte("a"), []byte("10"))
+	store1.Delete([]byte("b"))
+	store1.Set([]byte("d"), []byte("4"))
+	store2.Set([]byte("x"), []byte("1"))
+	ms.CommitterCommitMap(nil)
+
+	diff, err := ms.DiffVersions(1, 2, nil, nil, 0)
+	require.NoError(t, err)
+	require.False(t, diff.Truncated)
+	require.Equal(t, []types.KVChange{
+		{Store: "store1", Key: []byte("a"), Before: []byte("1"), After: []byte("10")},
+		{Store: "store1", Key: []byte("b"), Before: []byte("2")},
+		{Store: "store1", Key: []byte("d"), After: []byte("4")},
+	}, diff.Changes)
+
+	// the reversed diff
+	diff, err = ms.DiffVersions(2, 1, []string{"store1"}, nil, 0)
+	require.NoError(t, err)
+	require.Len(t, diff.Changes, 3)
+	require.Equal(t, []byte("10"), diff.Changes[0].Before)
+	require.Equal(t, []byte("2"), diff.Changes[1].After)
+
+	diff, err = ms.DiffVersions(1, 2, []string{"store1"}, []byte("b"), 0)
+	require.NoError(t, err)
+	require.Len(t, diff.Changes, 1)
+	require.Equal(t, []byte("b"), diff.Changes[0].Key)
+
+	diff, err = ms.DiffVersions(1, 2, nil, nil, 2)
+	require.NoError(t, err)
+	require.True(t, diff.Truncated)
+	require.Len(t, diff.Changes, 2)
+
+	_, err = ms.DiffVersions(1, 2, []string{"unknown"}, nil, 0)
+	require.Error(t, err)
+	_, err = ms.DiffVersions(1, 3, nil, nil, 0)
+	require.Error(t, err)
+}
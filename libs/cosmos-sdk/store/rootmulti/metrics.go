@@ -0,0 +1,144 @@
+package rootmulti
+
+import (
+	"sync/atomic"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/store/types"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "store"
+
+	metricsStoreLabel = "store"
+	metricsStageLabel = "stage"
+)
+
+// Metrics contains the metrics of the committed stores, which are reported on each commit.
+type Metrics struct {
+	// Number of the reads of each store.
+	Reads metrics.Counter
+	// Number of the writes of each store.
+	Writes metrics.Counter
+	// Number of the deletes of each store.
+	Deletes metrics.Counter
+	// Ratio of the IAVL nodes read from the node cache of each store in the last block.
+	NodeCacheHitRate metrics.Gauge
+	// Histogram of the time spent on each stage of the commit, in seconds.
+	CommitStageDuration metrics.Histogram
+	// Histogram of the time spent on the commit, in seconds.
+	CommitDuration metrics.Histogram
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	storeLabels := append(append([]string{}, labels...), metricsStoreLabel)
+	stageLabels := append(append([]string{}, labels...), metricsStageLabel)
+	return &Metrics{
+		Reads: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "reads",
+			Help:      "Number of the reads of the store.",
+		}, storeLabels).With(labelsAndValues...),
+		Writes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "writes",
+			Help:      "Number of the writes of the store.",
+		}, storeLabels).With(labelsAndValues...),
+		Deletes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "deletes",
+			Help:      "Number of the deletes of the store.",
+		}, storeLabels).With(labelsAndValues...),
+		NodeCacheHitRate: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "node_cache_hit_rate",
+			Help:      "Ratio of the IAVL nodes of the store read from the node cache in the last block.",
+		}, storeLabels).With(labelsAndValues...),
+		CommitStageDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "commit_stage_duration_seconds",
+			Help:      "Time spent on the stage of the commit (pre-change, cache flush, commit of the stores, metadata flush).",
+			Buckets:   stdprometheus.ExponentialBuckets(0.001, 2, 14),
+		}, stageLabels).With(labelsAndValues...),
+		CommitDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "commit_duration_seconds",
+			Help:      "Time spent on the commit of the block.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.001, 2, 14),
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Reads:               discard.NewCounter(),
+		Writes:              discard.NewCounter(),
+		Deletes:             discard.NewCounter(),
+		NodeCacheHitRate:    discard.NewGauge(),
+		CommitStageDuration: discard.NewHistogram(),
+		CommitDuration:      discard.NewHistogram(),
+	}
+}
+
+var storeMetrics atomic.Value
+
+func init() {
+	storeMetrics.Store(NopMetrics())
+}
+
+// SetMetrics sets the metrics reported by the stores, which are no-op by default
+func SetMetrics(m *Metrics) {
+	storeMetrics.Store(m)
+}
+
+// GetMetrics returns the metrics reported by the stores
+func GetMetrics() *Metrics {
+	return storeMetrics.Load().(*Metrics)
+}
+
+// opCounter is implemented by the stores counting their reads, writes and deletes since the last ResetCount
+type opCounter interface {
+	GetOpCount() (reads, writes, deletes int)
+}
+
+// reportMetrics reports the metrics of the stores collected since the last ResetCount
+func (rs *Store) reportMetrics() {
+	m := GetMetrics()
+	for key, store := range rs.stores {
+		reportStoreMetrics(m, key, store)
+	}
+}
+
+func reportStoreMetrics(m *Metrics, key types.StoreKey, store types.CommitKVStore) {
+	name := key.Name()
+	if counter, ok := store.(opCounter); ok {
+		reads, writes, deletes := counter.GetOpCount()
+		m.Reads.With(metricsStoreLabel, name).Add(float64(reads))
+		m.Writes.With(metricsStoreLabel, name).Add(float64(writes))
+		m.Deletes.With(metricsStoreLabel, name).Add(float64(deletes))
+	}
+	if nodeReads := store.GetNodeReadCount(); nodeReads > 0 {
+		hits := nodeReads - store.GetDBReadCount()
+		m.NodeCacheHitRate.With(metricsStoreLabel, name).Set(float64(hits) / float64(nodeReads))
+	}
+}
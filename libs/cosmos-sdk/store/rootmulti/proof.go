@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/okex/exchain/libs/cosmos-sdk/store/mpt"
 	storetypes "github.com/okex/exchain/libs/cosmos-sdk/store/types"
 
 	"github.com/okex/exchain/libs/iavl"
@@ -134,6 +135,8 @@ func DefaultProofRuntime() (prt *merkle.ProofRuntime) {
 	prt.RegisterOpDecoder(iavl.ProofOpIAVLValue, iavl.ValueOpDecoder)
 	prt.RegisterOpDecoder(iavl.ProofOpIAVLAbsence, iavl.AbsenceOpDecoder)
 	prt.RegisterOpDecoder(ProofOpMultiStore, MultiStoreProofOpDecoder)
+	prt.RegisterOpDecoder(mpt.ProofOpMptValue, mpt.ValueOpDecoder)
+	prt.RegisterOpDecoder(mpt.ProofOpMptAbsence, mpt.AbsenceOpDecoder)
 
 	prt.RegisterOpDecoder(storetypes.ProofOpIAVLCommitment, storetypes.CommitmentOpDecoder)
 	prt.RegisterOpDecoder(storetypes.ProofOpSimpleMerkleCommitment, storetypes.CommitmentOpDecoder)
@@ -606,6 +606,7 @@ func (rs *Store) CommitterCommitMap(inputDeltaMap iavltree.TreeDeltaMap) (types.
 	tsCommitStores := time.Now()
 	var outputDeltaMap iavltree.TreeDeltaMap
 	rs.lastCommitInfo, outputDeltaMap = commitStores(version, rs.stores, inputDeltaMap, rs.commitFilters)
+	rs.reportMetrics()
 
 	if !iavltree.EnableAsyncCommit {
 		// Determine if pruneHeight height needs to be added to the list of heights to
@@ -849,6 +850,12 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 		return res
 	}
 
+	// the evm and acc stores are replaced by the mpt store in the app hash after the mars height
+	if evmAccStoreFilter(storeName, res.Height, true) {
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest,
+			"store %s isn't committed to the app hash at height %d, query the %s store with proof", storeName, res.Height, MptStore))
+	}
+
 	if res.Proof == nil || len(res.Proof.Ops) == 0 {
 		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("proof is unexpectedly empty; ensure height has not been pruned. Query log: %s", res.Log)))
 	}
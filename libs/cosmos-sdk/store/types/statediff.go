@@ -0,0 +1,21 @@
+package types
+
+// KVChange is a key of a store changed between two versions. Before is nil if the key is added, and After is nil if
+// the key is deleted.
+type KVChange struct {
+	Store  string `json:"store"`
+	Key    []byte `json:"key"`
+	Before []byte `json:"before,omitempty"`
+	After  []byte `json:"after,omitempty"`
+}
+
+// StateDiff is the keys of the stores changed between the versions From and To.
+type StateDiff struct {
+	From    int64      `json:"from"`
+	To      int64      `json:"to"`
+	Changes []KVChange `json:"changes"`
+	// the stores which can't be diffed, e.g. the mpt stores whose keys aren't ordered
+	Skipped []string `json:"skipped,omitempty"`
+	// whether more changes than the limit are found
+	Truncated bool `json:"truncated"`
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	ethcmn "github.com/ethereum/go-ethereum/common"
 	"github.com/okex/exchain/libs/tendermint/crypto"
 	tmamino "github.com/okex/exchain/libs/tendermint/crypto/encoding/amino"
 	yaml "gopkg.in/yaml.v2"
@@ -283,6 +284,15 @@ func (aa AccAddress) String() string {
 	return aa.Bech32StringOptimized(GetConfig().GetBech32AccountAddrPrefix())
 }
 
+// HexString returns the EIP-55 checksummed hex string of the address, which is the sender of the evm txs. The mempool
+// keys the cosmos and evm txs of an account by it, so they share the pending nonce of the account.
+func (aa AccAddress) HexString() string {
+	if aa.Empty() {
+		return ""
+	}
+	return ethcmn.BytesToAddress(aa).Hex()
+}
+
 // Bech32String convert account address to bech32 address.
 func (aa AccAddress) Bech32String(bech32PrefixAccAddr string) string {
 	bech32Addr, err := bech32.ConvertAndEncode(bech32PrefixAccAddr, aa.Bytes())
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/okex/exchain/libs/tendermint/libs/bech32"
+)
+
+// the formats an address can be converted from
+const (
+	AddressFormatHex        = "hex"
+	AddressFormatBech32Acc  = "bech32_acc"
+	AddressFormatBech32Val  = "bech32_val"
+	AddressFormatBech32Cons = "bech32_cons"
+)
+
+// ConvertedAddress is an address in all the formats of the chain
+type ConvertedAddress struct {
+	// Format is the format the address is converted from
+	Format     string `json:"format"`
+	Hex        string `json:"hex"`
+	Bech32Acc  string `json:"bech32_acc"`
+	Bech32Val  string `json:"bech32_val"`
+	Bech32Cons string `json:"bech32_cons"`
+}
+
+// ConvertAddress converts an address in the 0x hex format or one of the bech32 formats to all the formats. The
+// checksum of a bech32 address is always validated, while the EIP-55 checksum of a hex address is validated only if
+// it's in mixed case, as the lower and the upper case hex addresses carry no checksum.
+func ConvertAddress(address string) (ConvertedAddress, error) {
+	address = strings.TrimSpace(address)
+	if len(address) == 0 {
+		return ConvertedAddress{}, fmt.Errorf("empty address string is not allowed")
+	}
+
+	var (
+		format string
+		bz     []byte
+		err    error
+	)
+	if IsETHAddress(address) || IsETHAddress(strings.ToLower(address)) {
+		format = AddressFormatHex
+		bz, err = addressFromHex(address)
+	} else {
+		format, bz, err = addressFromBech32(address)
+	}
+	if err != nil {
+		return ConvertedAddress{}, err
+	}
+	if err := VerifyAddressFormat(bz); err != nil {
+		return ConvertedAddress{}, err
+	}
+
+	return ConvertedAddress{
+		Format:     format,
+		Hex:        addressToHex(bz),
+		Bech32Acc:  AccAddress(bz).String(),
+		Bech32Val:  ValAddress(bz).String(),
+		Bech32Cons: ConsAddress(bz).String(),
+	}, nil
+}
+
+func addressFromHex(address string) ([]byte, error) {
+	bz, err := hex.DecodeString(address[2:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex address %s: %s", address, err)
+	}
+	if len(bz) == common.AddressLength && address[2:] != strings.ToLower(address[2:]) &&
+		address[2:] != strings.ToUpper(address[2:]) {
+		if checksummed := common.BytesToAddress(bz).Hex(); address != checksummed {
+			return nil, fmt.Errorf("invalid EIP-55 checksum of address %s, expected %s", address, checksummed)
+		}
+	}
+	return bz, nil
+}
+
+func addressFromBech32(address string) (string, []byte, error) {
+	hrp, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid bech32 address %s: %s", address, err)
+	}
+
+	config := GetConfig()
+	switch hrp {
+	case config.GetBech32AccountAddrPrefix():
+		return AddressFormatBech32Acc, bz, nil
+	case config.GetBech32ValidatorAddrPrefix():
+		return AddressFormatBech32Val, bz, nil
+	case config.GetBech32ConsensusAddrPrefix():
+		return AddressFormatBech32Cons, bz, nil
+	default:
+		return "", nil, fmt.Errorf("invalid bech32 prefix %s of address %s", hrp, address)
+	}
+}
+
+// addressToHex returns the EIP-55 checksummed hex of an account address, or the plain hex of a wasm contract address
+func addressToHex(bz []byte) string {
+	if len(bz) == common.AddressLength {
+		return common.BytesToAddress(bz).Hex()
+	}
+	return "0x" + hex.EncodeToString(bz)
+}
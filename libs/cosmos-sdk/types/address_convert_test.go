@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+func TestConvertAddress(t *testing.T) {
+	ethAddr := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	bz := ethAddr.Bytes()
+	expected := types.ConvertedAddress{
+		Hex:        ethAddr.Hex(),
+		Bech32Acc:  types.AccAddress(bz).String(),
+		Bech32Val:  types.ValAddress(bz).String(),
+		Bech32Cons: types.ConsAddress(bz).String(),
+	}
+
+	testCases := []struct {
+		address string
+		format  string
+		expPass bool
+	}{
+		{ethAddr.Hex(), types.AddressFormatHex, true},
+		{strings.ToLower(ethAddr.Hex()), types.AddressFormatHex, true},
+		{"0x" + strings.ToUpper(ethAddr.Hex()[2:]), types.AddressFormatHex, true},
+		{expected.Bech32Acc, types.AddressFormatBech32Acc, true},
+		{expected.Bech32Val, types.AddressFormatBech32Val, true},
+		{expected.Bech32Cons, types.AddressFormatBech32Cons, true},
+		// the checksum of the mixed case hex address is wrong
+		{"0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "", false},
+		// the bech32 checksum is wrong
+		{expected.Bech32Acc[:len(expected.Bech32Acc)-1] + "q", "", false},
+		{types.Bech32PrefixAccPub + "1234", "", false},
+		{"0x1234", "", false},
+		{"", "", false},
+	}
+
+	for _, tc := range testCases {
+		res, err := types.ConvertAddress(tc.address)
+		if !tc.expPass {
+			require.Error(t, err, tc.address)
+			continue
+		}
+		require.NoError(t, err, tc.address)
+		expected.Format = tc.format
+		require.Equal(t, expected, res, tc.address)
+	}
+}
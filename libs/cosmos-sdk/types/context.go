@@ -6,9 +6,12 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/okex/exchain/libs/system/telemetry"
 	"github.com/okex/exchain/libs/system/trace"
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/store/gaskv"
 	stypes "github.com/okex/exchain/libs/cosmos-sdk/store/types"
@@ -52,6 +55,7 @@ type Context struct {
 	overridesBytes []byte // overridesBytes is used to save overrides info, passed from ethCall to x/evm
 	watcher        *TxWatcher
 	feesplitInfo   *FeeSplitInfo
+	txResources    *TxResources
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -104,6 +108,7 @@ func (c *Context) Cache() *Cache {
 func (c Context) ParaMsg() *ParaMsg {
 	return c.paraMsg
 }
+func (c *Context) TxResources() *TxResources { return c.txResources }
 
 func (c Context) GetFeeSplitInfo() *FeeSplitInfo {
 	if c.feesplitInfo == nil {
@@ -281,6 +286,14 @@ func (c *Context) SetContext(ctx context.Context) *Context {
 	return c
 }
 
+// StartSpan starts a tracing span as the child of the span in the context of c, and sets the context of c to the one
+// holding the new span, so that the spans started with c later are its children. The caller must end the span.
+func (c *Context) StartSpan(name string, attrs ...attribute.KeyValue) oteltrace.Span {
+	ctx, span := telemetry.StartSpan(c.ctx, name, attrs...)
+	c.ctx = ctx
+	return span
+}
+
 func (c *Context) SetChainID(chainID string) *Context {
 	c.chainID = chainID
 	return c
@@ -369,6 +382,11 @@ func (c *Context) SetParaMsg(m *ParaMsg) *Context {
 	return c
 }
 
+func (c *Context) SetTxResources(r *TxResources) *Context {
+	c.txResources = r
+	return c
+}
+
 func (c *Context) SetFeeSplitInfo(f *FeeSplitInfo) *Context {
 	c.feesplitInfo = f
 	return c
@@ -416,7 +434,16 @@ func (c *Context) GetWatcher() IWatcher {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c *Context) KVStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.KVGasConfig())
+	return gaskv.NewStore(c.getKVStore(key), c.GasMeter(), stypes.KVGasConfig())
+}
+
+// getKVStore returns the KVStore of the MultiStore, whose accesses are counted if the resources of the tx are recorded.
+func (c *Context) getKVStore(key StoreKey) KVStore {
+	store := c.MultiStore().GetKVStore(key)
+	if c.txResources != nil {
+		return c.txResources.KVStore(store)
+	}
+	return store
 }
 
 var gasKvPool = &sync.Pool{
@@ -429,7 +456,7 @@ var gasKvPool = &sync.Pool{
 // you must call ReturnKVStore() after you are done with the KVStore.
 func (c *Context) GetReusableKVStore(key StoreKey) KVStore {
 	gaskvs := gasKvPool.Get().(*gaskv.Store)
-	return gaskv.ResetStore(gaskvs, c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.KVGasConfig())
+	return gaskv.ResetStore(gaskvs, c.getKVStore(key), c.GasMeter(), stypes.KVGasConfig())
 }
 
 // ReturnKVStore returns a KVStore than from GetReusableKVStore.
@@ -0,0 +1,180 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// value types of the event attributes
+const (
+	EventValueTypeString  = "string"
+	EventValueTypeUint    = "uint"
+	EventValueTypeInt     = "int"
+	EventValueTypeDec     = "dec"
+	EventValueTypeCoins   = "coins"
+	EventValueTypeAddress = "address"
+	EventValueTypeBool    = "bool"
+)
+
+// EventAttributeSchema describes an attribute of a typed event
+type EventAttributeSchema struct {
+	Key         string `json:"key"`
+	ValueType   string `json:"value_type"`
+	Description string `json:"description"`
+	// Optional attributes may be absent from the event
+	Optional bool `json:"optional"`
+	// Repeated attributes may appear more than once in the event
+	Repeated bool `json:"repeated"`
+}
+
+// NewEventAttributeSchema creates a schema of a required attribute appearing once in the event
+func NewEventAttributeSchema(key, valueType, description string) EventAttributeSchema {
+	return EventAttributeSchema{
+		Key:         key,
+		ValueType:   valueType,
+		Description: description,
+	}
+}
+
+// AsOptional returns the schema of the attribute which may be absent from the event
+func (eas EventAttributeSchema) AsOptional() EventAttributeSchema {
+	eas.Optional = true
+	return eas
+}
+
+// AsRepeated returns the schema of the attribute which may appear more than once in the event
+func (eas EventAttributeSchema) AsRepeated() EventAttributeSchema {
+	eas.Repeated = true
+	return eas
+}
+
+// EventSchema describes the type and the attributes of an event emitted by a module, so that the indexers can parse
+// the event without reverse-engineering the attribute strings
+type EventSchema struct {
+	Module      string                 `json:"module"`
+	Type        string                 `json:"type"`
+	Description string                 `json:"description"`
+	Attributes  []EventAttributeSchema `json:"attributes"`
+}
+
+// NewEventSchema creates a new instance of EventSchema
+func NewEventSchema(module, eventType, description string, attributes ...EventAttributeSchema) EventSchema {
+	return EventSchema{
+		Module:      module,
+		Type:        eventType,
+		Description: description,
+		Attributes:  attributes,
+	}
+}
+
+// Validate checks the schema is well formed
+func (es EventSchema) Validate() error {
+	if len(es.Module) == 0 || len(es.Type) == 0 {
+		return fmt.Errorf("module and type of the event schema should not be empty")
+	}
+	keys := make(map[string]bool, len(es.Attributes))
+	for _, attr := range es.Attributes {
+		if len(attr.Key) == 0 || len(attr.ValueType) == 0 {
+			return fmt.Errorf("key and value type of the attribute of event %s should not be empty", es.Type)
+		}
+		if keys[attr.Key] {
+			return fmt.Errorf("duplicated attribute %s of event %s", attr.Key, es.Type)
+		}
+		keys[attr.Key] = true
+	}
+	return nil
+}
+
+// ValidateEvent checks the event has the type of the schema and only the attributes described by the schema
+func (es EventSchema) ValidateEvent(event Event) error {
+	if event.Type != es.Type {
+		return fmt.Errorf("event type %s doesn't match the schema %s", event.Type, es.Type)
+	}
+
+	counts := make(map[string]int, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		counts[string(attr.Key)]++
+	}
+	described := make(map[string]bool, len(es.Attributes))
+	for _, attrSchema := range es.Attributes {
+		count := counts[attrSchema.Key]
+		if count == 0 && !attrSchema.Optional {
+			return fmt.Errorf("attribute %s is missing from event %s", attrSchema.Key, es.Type)
+		}
+		if count > 1 && !attrSchema.Repeated {
+			return fmt.Errorf("attribute %s appears %d times in event %s", attrSchema.Key, count, es.Type)
+		}
+		described[attrSchema.Key] = true
+	}
+	for _, attr := range event.Attributes {
+		if !described[string(attr.Key)] {
+			return fmt.Errorf("attribute %s isn't described by the schema of event %s", attr.Key, es.Type)
+		}
+	}
+	return nil
+}
+
+// NewEvent creates an event of the schema. It panics if the attributes don't match the schema, which is a bug of
+// the typed event helper calling it
+func (es EventSchema) NewEvent(attrs ...Attribute) Event {
+	event := NewEvent(es.Type, attrs...)
+	if err := es.ValidateEvent(event); err != nil {
+		panic(err)
+	}
+	return event
+}
+
+var eventSchemaRegistry = struct {
+	mtx     sync.RWMutex
+	schemas map[string]EventSchema
+}{schemas: make(map[string]EventSchema)}
+
+func eventSchemaKey(module, eventType string) string {
+	return module + "/" + eventType
+}
+
+// RegisterEventSchemas registers the schemas of the typed events of the modules. It panics if a schema is invalid or
+// registered twice
+func RegisterEventSchemas(schemas ...EventSchema) {
+	eventSchemaRegistry.mtx.Lock()
+	defer eventSchemaRegistry.mtx.Unlock()
+
+	for _, schema := range schemas {
+		if err := schema.Validate(); err != nil {
+			panic(err)
+		}
+		key := eventSchemaKey(schema.Module, schema.Type)
+		if _, found := eventSchemaRegistry.schemas[key]; found {
+			panic(fmt.Sprintf("event schema %s has already been registered", key))
+		}
+		eventSchemaRegistry.schemas[key] = schema
+	}
+}
+
+// GetEventSchema returns the registered schema of an event type of a module
+func GetEventSchema(module, eventType string) (EventSchema, bool) {
+	eventSchemaRegistry.mtx.RLock()
+	defer eventSchemaRegistry.mtx.RUnlock()
+
+	schema, found := eventSchemaRegistry.schemas[eventSchemaKey(module, eventType)]
+	return schema, found
+}
+
+// GetEventSchemas returns all the registered event schemas sorted by module and type
+func GetEventSchemas() []EventSchema {
+	eventSchemaRegistry.mtx.RLock()
+	defer eventSchemaRegistry.mtx.RUnlock()
+
+	schemas := make([]EventSchema, 0, len(eventSchemaRegistry.schemas))
+	for _, schema := range eventSchemaRegistry.schemas {
+		schemas = append(schemas, schema)
+	}
+	sort.Slice(schemas, func(i, j int) bool {
+		if schemas[i].Module != schemas[j].Module {
+			return schemas[i].Module < schemas[j].Module
+		}
+		return schemas[i].Type < schemas[j].Type
+	})
+	return schemas
+}
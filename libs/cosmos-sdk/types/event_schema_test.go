@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSchemaValidateEvent(t *testing.T) {
+	schema := NewEventSchema("test", "store_code", "a code is stored",
+		NewEventAttributeSchema("code_id", EventValueTypeUint, "id of the code"),
+		NewEventAttributeSchema("feature", EventValueTypeString, "feature required by the code").AsOptional().AsRepeated(),
+		NewEventAttributeSchema("sender", EventValueTypeAddress, "sender of the code").AsOptional(),
+	)
+	require.NoError(t, schema.Validate())
+
+	require.NoError(t, schema.ValidateEvent(NewEvent("store_code", NewAttribute("code_id", "1"))))
+	require.NoError(t, schema.ValidateEvent(NewEvent("store_code", NewAttribute("code_id", "1"),
+		NewAttribute("feature", "staking"), NewAttribute("feature", "iterator"), NewAttribute("sender", "ex1"))))
+	// wrong type
+	require.Error(t, schema.ValidateEvent(NewEvent("pin_code", NewAttribute("code_id", "1"))))
+	// missing required attribute
+	require.Error(t, schema.ValidateEvent(NewEvent("store_code", NewAttribute("sender", "ex1"))))
+	// repeated attribute
+	require.Error(t, schema.ValidateEvent(NewEvent("store_code", NewAttribute("code_id", "1"), NewAttribute("code_id", "2"))))
+	// unknown attribute
+	require.Error(t, schema.ValidateEvent(NewEvent("store_code", NewAttribute("code_id", "1"), NewAttribute("label", "x"))))
+
+	require.Equal(t, NewEvent("store_code", NewAttribute("code_id", "1")), schema.NewEvent(NewAttribute("code_id", "1")))
+	require.Panics(t, func() { schema.NewEvent(NewAttribute("label", "x")) })
+
+	require.Error(t, NewEventSchema("", "store_code", "").Validate())
+	require.Error(t, NewEventSchema("test", "store_code", "",
+		NewEventAttributeSchema("code_id", EventValueTypeUint, ""),
+		NewEventAttributeSchema("code_id", EventValueTypeString, "")).Validate())
+	require.Error(t, NewEventSchema("test", "store_code", "", NewEventAttributeSchema("code_id", "", "")).Validate())
+}
+
+func TestRegisterEventSchemas(t *testing.T) {
+	schemaB := NewEventSchema("test_registry", "b", "")
+	schemaA := NewEventSchema("test_registry", "a", "", NewEventAttributeSchema("id", EventValueTypeUint, ""))
+	RegisterEventSchemas(schemaB, schemaA)
+
+	schema, found := GetEventSchema("test_registry", "a")
+	require.True(t, found)
+	require.Equal(t, schemaA, schema)
+	_, found = GetEventSchema("test_registry", "c")
+	require.False(t, found)
+
+	var registered []EventSchema
+	for _, schema := range GetEventSchemas() {
+		if schema.Module == "test_registry" {
+			registered = append(registered, schema)
+		}
+	}
+	require.Equal(t, []EventSchema{schemaA, schemaB}, registered)
+
+	require.Panics(t, func() { RegisterEventSchemas(schemaA) })
+	require.Panics(t, func() { RegisterEventSchemas(NewEventSchema("test_registry", "", "")) })
+}
@@ -21,6 +21,8 @@ type AccNonceHandler func(ctx Context, address AccAddress) (nonce uint64)
 
 type EvmSysContractAddressHandler func(ctx Context, addr AccAddress) bool
 
+// UpdateFeeCollectorAccHandler sets the balance of the fee collector, or credits it the fees collected since the last
+// settlement once the fee collection is deferred to the end of the block, and pays the fee splits
 type UpdateFeeCollectorAccHandler func(ctx Context, balance Coins, txFeesplit []*FeeSplitInfo) error
 
 type LogFix func(tx []Tx, logIndex []int, hasEnterEvmTx []bool, errs []error, resp []abci.ResponseDeliverTx) (logs [][]byte)
@@ -32,6 +34,13 @@ type CustomizeOnStop func(ctx Context) error
 
 type MptCommitHandler func(ctx Context)
 
+// PreCommitHandler runs the app logic on the deliver state right before the block is committed
+type PreCommitHandler func(ctx Context)
+
+// CosmosGasFactorHandler returns the factor converting the gas of the cosmos txs to the gas of the block, which is
+// shared with the evm txs
+type CosmosGasFactorHandler func(ctx Context) Dec
+
 type EvmWatcherCollector func(...IWatcher)
 
 // AnteDecorator wraps the next AnteHandler to perform custom pre- and post-processing.
@@ -13,3 +13,13 @@ type SimulateData struct {
 	TxBytes        []byte `json:"tx"`
 	OverridesBytes []byte `json:"overrides"`
 }
+
+// QueryStateDiff is the params of the query of the keys changed between two heights, all the stores are diffed if
+// Stores is empty.
+type QueryStateDiff struct {
+	From   int64    `json:"from"`
+	To     int64    `json:"to"`
+	Stores []string `json:"stores"`
+	Prefix []byte   `json:"prefix"`
+	Limit  int      `json:"limit"`
+}
@@ -25,6 +25,8 @@ type (
 	MultiStorePersistentCache = types.MultiStorePersistentCache
 	KVStore                   = types.KVStore
 	Iterator                  = types.Iterator
+	KVChange                  = types.KVChange
+	StateDiff                 = types.StateDiff
 )
 
 // StoreDecoderRegistry defines each of the modules store decoders. Used for ImportExport
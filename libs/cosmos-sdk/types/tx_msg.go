@@ -90,6 +90,7 @@ func (tx *BaseTx) GetRaw() []byte                      { return tx.Raw }
 func (tx *BaseTx) TxHash() []byte                      { return tx.Hash }
 func (tx *BaseTx) SetRaw(raw []byte)                   { tx.Raw = raw }
 func (tx *BaseTx) SetTxHash(hash []byte)               { tx.Hash = hash }
+func (tx *BaseTx) SetNonce(nonce uint64)               { tx.Nonce = nonce }
 func (tx *BaseTx) GetSender(_ Context) string          { return tx.From }
 
 //__________________________________________________________
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"time"
+)
+
+// TxResources is the resource consumption of a delivered tx, which is recorded for debugging if the resource
+// accounting is enabled. The store accesses are the ones of the KVStores got from the context of the tx.
+type TxResources struct {
+	Hash      string        `json:"hash"`
+	Height    int64         `json:"height"`
+	CPUTime   time.Duration `json:"cpu_time"`
+	GasWanted uint64        `json:"gas_wanted"`
+	GasUsed   uint64        `json:"gas_used"`
+	// gas consumed by the ante handler
+	AnteGas uint64 `json:"ante_gas"`
+	// gas consumed by the msgs by the descriptor of the consumption
+	MsgGas map[string]uint64 `json:"msg_gas"`
+	Refund DecCoins          `json:"refund,omitempty"`
+
+	Reads         uint64 `json:"reads"`
+	ReadBytes     uint64 `json:"read_bytes"`
+	Writes        uint64 `json:"writes"`
+	WriteBytes    uint64 `json:"write_bytes"`
+	Deletes       uint64 `json:"deletes"`
+	IteratorSteps uint64 `json:"iterator_steps"`
+}
+
+func NewTxResources() *TxResources {
+	return &TxResources{MsgGas: make(map[string]uint64)}
+}
+
+// MsgGasMeter returns a gas meter consuming the gas from meter, which records the gas consumed by the msgs.
+func (r *TxResources) MsgGasMeter(meter GasMeter) GasMeter {
+	r.AnteGas = meter.GasConsumed()
+	return &resourceGasMeter{GasMeter: meter, resources: r}
+}
+
+// KVStore returns the store counting the accesses of store.
+func (r *TxResources) KVStore(store KVStore) KVStore {
+	return &resourceStore{KVStore: store, resources: r}
+}
+
+type resourceGasMeter struct {
+	GasMeter
+	resources *TxResources
+}
+
+func (m *resourceGasMeter) ConsumeGas(amount Gas, descriptor string) {
+	m.GasMeter.ConsumeGas(amount, descriptor)
+	m.resources.MsgGas[descriptor] += amount
+}
+
+type resourceStore struct {
+	KVStore
+	resources *TxResources
+}
+
+func (s *resourceStore) Get(key []byte) []byte {
+	value := s.KVStore.Get(key)
+	s.resources.Reads++
+	s.resources.ReadBytes += uint64(len(key) + len(value))
+	return value
+}
+
+func (s *resourceStore) Has(key []byte) bool {
+	s.resources.Reads++
+	s.resources.ReadBytes += uint64(len(key))
+	return s.KVStore.Has(key)
+}
+
+func (s *resourceStore) Set(key, value []byte) {
+	s.KVStore.Set(key, value)
+	s.resources.Writes++
+	s.resources.WriteBytes += uint64(len(key) + len(value))
+}
+
+func (s *resourceStore) Delete(key []byte) {
+	s.KVStore.Delete(key)
+	s.resources.Deletes++
+}
+
+func (s *resourceStore) Iterator(start, end []byte) Iterator {
+	return &resourceIterator{Iterator: s.KVStore.Iterator(start, end), resources: s.resources}
+}
+
+func (s *resourceStore) ReverseIterator(start, end []byte) Iterator {
+	return &resourceIterator{Iterator: s.KVStore.ReverseIterator(start, end), resources: s.resources}
+}
+
+type resourceIterator struct {
+	Iterator
+	resources *TxResources
+}
+
+func (it *resourceIterator) Next() {
+	it.Iterator.Next()
+	it.resources.IteratorSteps++
+}
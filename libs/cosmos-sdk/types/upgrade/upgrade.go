@@ -17,6 +17,19 @@ type UpgradeModule interface {
 	VersionFilter() *store.VersionFilter
 }
 
+// PreUpgradeVerifier is implemented by the upgrade modules verifying the state their upgrade task assumes. The
+// verification runs on a cache of the state one block before the task is executed, and a failed verification is only
+// reported, so the operators learn about the inconsistent state before the task halts the chain on it.
+type PreUpgradeVerifier interface {
+	PreUpgradeVerify(ctx sdk.Context) error
+}
+
+// ModuleVerifier is the pre-upgrade verification of a module
+type ModuleVerifier struct {
+	Module string
+	Verify func(ctx sdk.Context) error
+}
+
 type HeightTasks []HeightTask
 
 func (h HeightTasks) Len() int {
@@ -82,6 +82,34 @@ func (vmd ValidateMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	return next(ctx, tx, simulate)
 }
 
+// ValidateTxSizeDecorator will reject the tx larger than the max tx bytes param,
+// otherwise call next AnteHandler. The param isn't set by default, and no tx is
+// rejected then.
+type ValidateTxSizeDecorator struct {
+	ak keeper.AccountKeeper
+}
+
+func NewValidateTxSizeDecorator(ak keeper.AccountKeeper) ValidateTxSizeDecorator {
+	return ValidateTxSizeDecorator{
+		ak: ak,
+	}
+}
+
+func (vtsd ValidateTxSizeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// the param is read without gas, so that the gas of the txs is the same as before it's introduced
+	gasMeter := ctx.GasMeter()
+	ctx.SetGasMeter(sdk.NewInfiniteGasMeter())
+	maxTxBytes := vtsd.ak.GetMaxTxBytes(ctx)
+	ctx.SetGasMeter(gasMeter)
+	if txSize := len(ctx.TxBytes()); maxTxBytes > 0 && uint64(txSize) > maxTxBytes {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrTxTooLarge,
+			"maximum size of the tx is %d bytes but received %d bytes", maxTxBytes, txSize,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
 // ValidateMsgDecorator will validate msg with special requirement
 //type ValidateMsgDecorator struct {
 //	validateMsgHandler ValidateMsgHandler
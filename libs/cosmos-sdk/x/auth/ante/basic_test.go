@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/ante"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
 )
@@ -80,6 +81,41 @@ func TestValidateMemo(t *testing.T) {
 	require.Nil(t, err, "ValidateBasicDecorator returned error on valid tx. err: %v", err)
 }
 
+func TestValidateTxSize(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msg1 := types.NewTestMsg(addr1)
+	fee := types.NewTestStdFee()
+
+	msgs := []sdk.Msg{msg1}
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTxWithMemo(ctx, msgs, privs, accNums, seqs, fee, strings.Repeat("01234567890", 10))
+	txBytes, err := json.Marshal(tx)
+	require.Nil(t, err, "Cannot marshal tx: %v", err)
+	ctx.SetTxBytes(txBytes)
+
+	vtsd := ante.NewValidateTxSizeDecorator(app.AccountKeeper)
+	antehandler := sdk.ChainAnteDecorators(vtsd)
+
+	// no limit until the param is set
+	_, err = antehandler(ctx, tx, false)
+	require.Nil(t, err, "ValidateTxSizeDecorator returned error without the limit. err: %v", err)
+
+	app.AccountKeeper.SetMaxTxBytes(ctx, uint64(len(txBytes)))
+	_, err = antehandler(ctx, tx, false)
+	require.Nil(t, err, "ValidateTxSizeDecorator returned error on tx at the limit. err: %v", err)
+
+	app.AccountKeeper.SetMaxTxBytes(ctx, uint64(len(txBytes)-1))
+	_, err = antehandler(ctx, tx, false)
+	require.True(t, sdkerrors.ErrTxTooLarge.Is(err), "Did not error on tx larger than the limit")
+}
+
 func TestConsumeGasForTxSize(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
@@ -7,6 +7,7 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/exported"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/keeper"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
 
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 )
@@ -103,7 +104,11 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 
 	// deduct the fees
 	if !feeTx.GetFee().IsZero() {
-		err = DeductFees(dfd.supplyKeeper, ctx, feePayerAcc, feeTx.GetFee())
+		if IsFeeCollectionDeferred(ctx.BlockHeight()) {
+			err = DeductFeesDeferred(dfd.ak, ctx, feePayerAcc, feeTx.GetFee())
+		} else {
+			err = DeductFees(dfd.supplyKeeper, ctx, feePayerAcc, feeTx.GetFee())
+		}
 		if err != nil {
 			return ctx, err
 		}
@@ -112,11 +117,47 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	return next(ctx, tx, simulate)
 }
 
+// IsFeeCollectionDeferred returns whether the fees of the txs at the height are only deducted from the payers by the
+// ante handlers. The fees are accumulated in memory by the BaseApp and credited to the fee collector once at the end
+// of the block, which saves the writes of the fee collector account of every tx.
+func IsFeeCollectionDeferred(height int64) bool {
+	return tmtypes.HigherThanVenus5(height)
+}
+
 // DeductFees deducts fees from the given account.
 //
 // NOTE: We could use the BankKeeper (in addition to the AccountKeeper, because
 // the BankKeeper doesn't give us accounts), but it seems easier to do this.
 func DeductFees(supplyKeeper types.SupplyKeeper, ctx sdk.Context, acc exported.Account, fees sdk.Coins) error {
+	if err := checkFees(ctx, acc, fees); err != nil {
+		return err
+	}
+
+	err := supplyKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.FeeCollectorName, fees)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	return nil
+}
+
+// DeductFeesDeferred deducts fees from the given account without sending them to the fee collector, the fees are
+// credited to the fee collector at the end of the block. See IsFeeCollectionDeferred.
+func DeductFeesDeferred(ak keeper.AccountKeeper, ctx sdk.Context, acc exported.Account, fees sdk.Coins) error {
+	if err := checkFees(ctx, acc, fees); err != nil {
+		return err
+	}
+
+	if err := acc.SetCoins(acc.GetCoins().Sub(fees)); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+	ak.SetAccount(ctx, acc)
+
+	return nil
+}
+
+// checkFees verifies the account has enough spendable funds to pay for fees
+func checkFees(ctx sdk.Context, acc exported.Account, fees sdk.Coins) error {
 	blockTime := ctx.BlockTime()
 	coins := acc.GetCoins()
 
@@ -139,10 +180,5 @@ func DeductFees(supplyKeeper types.SupplyKeeper, ctx sdk.Context, acc exported.A
 			"insufficient funds to pay for fees; %s < %s", spendableCoins, fees)
 	}
 
-	err := supplyKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), types.FeeCollectorName, fees)
-	if err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
-	}
-
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/ante"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
 )
 
 func TestEnsureMempoolFees(t *testing.T) {
@@ -96,3 +97,45 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestDeductFeesDeferred(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+	ctx.SetBlockHeight(2)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msg1 := types.NewTestMsg(addr1)
+	fee := types.NewTestStdFee()
+
+	msgs := []sdk.Msg{msg1}
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	// Set account with insufficient funds
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	acc.SetCoins([]sdk.Coin{sdk.NewCoin("atom", sdk.NewInt(10))})
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.SupplyKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	_, err := antehandler(ctx, tx, false)
+	require.NotNil(t, err, "Tx did not error when fee payer had insufficient funds")
+
+	// Set account with sufficient funds
+	acc.SetCoins([]sdk.Coin{sdk.NewCoin("atom", sdk.NewInt(200))})
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	_, err = antehandler(ctx, tx, false)
+	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
+
+	// the fees are deducted from the payer, but left for the settlement at the end of the block
+	require.True(sdk.DecEq(t, app.AccountKeeper.GetAccount(ctx, addr1).GetCoins().AmountOf("atom"), sdk.NewDec(50)))
+	require.True(t, app.SupplyKeeper.GetModuleAccount(ctx, types.FeeCollectorName).GetCoins().Empty())
+}
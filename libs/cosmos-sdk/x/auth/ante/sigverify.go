@@ -2,6 +2,7 @@ package ante
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
 	"github.com/okex/exchain/libs/tendermint/crypto"
 	"github.com/okex/exchain/libs/tendermint/crypto/ed25519"
@@ -14,6 +15,7 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/exported"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/keeper"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
 )
 
 var (
@@ -213,15 +215,51 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
+		if simulate {
+			continue
+		}
+
+		// the signatures verified by CheckTx on this node are not verified again by DeliverTx
+		cacheKey := verifiedSigCacheKey(tx.TxHash(), i)
+		digest := verifiedSigDigest(pubKey, signBytes, sig)
+		if cached, ok := tmtypes.SignatureCache().Get(cacheKey); ok && cached == digest {
+			if !ctx.IsCheckTx() {
+				tmtypes.SignatureCache().Remove(cacheKey)
+			}
+			continue
+		}
+
 		// verify signature
-		if !simulate && (len(signBytes) == 0 || !pubKey.VerifyBytes(signBytes, sig)) {
+		if len(signBytes) == 0 || !pubKey.VerifyBytes(signBytes, sig) {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed; verify correct account sequence and chain-id, sign msg:"+string(signBytes))
 		}
+		if ctx.IsCheckTx() {
+			tmtypes.SignatureCache().Add(cacheKey, digest)
+		}
 	}
 
 	return next(ctx, tx, simulate)
 }
 
+// verifiedSigCacheKey returns the key of the i-th signature of the tx in the signature cache
+func verifiedSigCacheKey(txHash []byte, i int) []byte {
+	if len(txHash) == 0 {
+		return nil
+	}
+	return append(append([]byte{}, txHash...), sdk.Uint64ToBigEndian(uint64(i))...)
+}
+
+// verifiedSigDigest returns the digest of the verified signature, a cached signature is only trusted if the pubkey
+// and the sign bytes, which commit to the chain-id, the account number and the sequence, are unchanged
+func verifiedSigDigest(pubKey crypto.PubKey, signBytes, sig []byte) string {
+	h := sha256.New()
+	for _, bz := range [][]byte{pubKey.Bytes(), signBytes, sig} {
+		h.Write(sdk.Uint64ToBigEndian(uint64(len(bz))))
+		h.Write(bz)
+	}
+	return string(h.Sum(nil))
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is no need to execute IncrementSequenceDecorator on RecheckTX since
@@ -250,8 +288,11 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 	// increment sequence of all signers
 	for index, addr := range sigTx.GetSigners() {
 		acc := isd.ak.GetAccount(ctx, addr)
-		if ctx.IsCheckTx() && index == 0 { // context with the nonce of fee payer
+		if ctx.IsCheckTx() && index == 0 { // context and tx with the nonce of fee payer
 			ctx.SetAccountNonce(acc.GetSequence())
+			if nonceTx, ok := tx.(interface{ SetNonce(uint64) }); ok {
+				nonceTx.SetNonce(acc.GetSequence())
+			}
 		}
 		if err := acc.SetSequence(acc.GetSequence() + 1); err != nil {
 			panic(err)
@@ -8,6 +8,8 @@ import (
 	"github.com/okex/exchain/libs/tendermint/crypto/ed25519"
 	"github.com/okex/exchain/libs/tendermint/crypto/multisig"
 	"github.com/okex/exchain/libs/tendermint/crypto/secp256k1"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
@@ -160,6 +162,48 @@ func TestSigVerification(t *testing.T) {
 	}
 }
 
+func TestSigVerificationCache(t *testing.T) {
+	viper.Set(tmtypes.FlagSigCacheSize, 1000)
+	tmtypes.InitSignatureCache()
+
+	// setup
+	app, ctx := createTestApp(true)
+	ctx.SetBlockHeight(1)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	fee := types.NewTestStdFee()
+	antehandler := sdk.ChainAnteDecorators(ante.NewSetPubKeyDecorator(app.AccountKeeper), ante.NewSigVerificationDecorator(app.AccountKeeper))
+
+	txHash := []byte("01234567890123456789012345678901")
+	tx := types.NewTestTxWithMemo(ctx, msgs, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}, fee, "").(*types.StdTx)
+	tx.SetTxHash(txHash)
+	// the signatures of the tx are replayed with another memo under the same hash
+	forged := types.NewTestTxWithMemo(ctx, msgs, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}, fee, "forged").(*types.StdTx)
+	forged.Signatures = tx.Signatures
+	forged.SetTxHash(txHash)
+
+	// CheckTx verifies and caches the signatures
+	_, err := antehandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// DeliverTx only trusts the cached signatures of the same pubkey and sign bytes
+	ctx.SetIsCheckTx(false)
+	_, err = antehandler(ctx, forged, false)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+
+	hits := tmtypes.SignatureCache().HitCount()
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, hits+1, tmtypes.SignatureCache().HitCount())
+
+	// the cached signatures are dropped once delivered
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, hits+1, tmtypes.SignatureCache().HitCount())
+}
+
 func TestIbcSignModeSigVerify(t *testing.T) {
 	app, ctx := createTestApp(true)
 	ctx.SetBlockHeight(1)
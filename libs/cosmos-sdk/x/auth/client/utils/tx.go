@@ -38,13 +38,18 @@ func (gr GasEstimateResponse) String() string {
 
 // GenerateOrBroadcastMsgs creates a StdTx given a series of messages. If
 // the provided context has generate-only enabled, the tx will only be printed
-// to STDOUT in a fully offline manner. Otherwise, the tx will be signed and
-// broadcasted.
+// to STDOUT in a fully offline manner. If it has offline enabled, the tx will
+// be signed and printed to STDOUT without querying a node. Otherwise, the tx
+// will be signed and broadcasted.
 func GenerateOrBroadcastMsgs(cliCtx context.CLIContext, txBldr authtypes.TxBuilder, msgs []sdk.Msg) error {
 	if cliCtx.GenerateOnly {
 		return PrintUnsignedStdTx(txBldr, cliCtx, msgs)
 	}
 
+	if cliCtx.Offline {
+		return PrintSignedStdTxOffline(txBldr, cliCtx, msgs)
+	}
+
 	return CompleteAndBroadcastTxCLI(txBldr, cliCtx, msgs)
 }
 
@@ -77,44 +82,8 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 	}
 	txBytes := []byte{}
 	pbtxMsgs, isPbTxMsg := convertIfPbTx(msgs)
-	if !cliCtx.SkipConfirm {
-		var signData interface{}
-		var json []byte
-		if isPbTxMsg {
-
-			tx, err := buildUnsignedPbTx(txBldr, txConfig, pbtxMsgs...)
-			if err != nil {
-				return err
-			}
-			json, err = txConfig.TxJSONEncoder()(tx.GetTx())
-			if err != nil {
-				panic(err)
-			}
-		} else {
-			signData, err = txBldr.BuildSignMsg(msgs)
-			if err != nil {
-				return err
-			}
-
-			if viper.GetBool(flags.FlagIndentResponse) {
-				json, err = cliCtx.Codec.MarshalJSONIndent(signData, "", "  ")
-				if err != nil {
-					panic(err)
-				}
-			} else {
-				json = cliCtx.Codec.MustMarshalJSON(signData)
-			}
-		}
-
-		_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", json)
-
-		buf := bufio.NewReader(os.Stdin)
-		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf)
-		if err != nil || !ok {
-			_, _ = fmt.Fprintf(os.Stderr, "%s\n", "cancelled transaction")
-			return err
-		}
-
+	if ok, err := confirmTx(txBldr, cliCtx, msgs, "confirm transaction before signing and broadcasting"); !ok {
+		return err
 	}
 
 	if isPbTxMsg {
@@ -138,6 +107,124 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 	return cliCtx.PrintOutput(res)
 }
 
+// PrintSignedStdTxOffline builds and signs a tx with the local key without
+// querying a node, and prints it to STDOUT instead of broadcasting it. The
+// account number and the sequence must be set with the flags. The signed tx
+// can be broadcasted later with the broadcast command.
+func PrintSignedStdTxOffline(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) error {
+	if !viper.IsSet(flags.FlagAccountNumber) || !viper.IsSet(flags.FlagSequence) {
+		return fmt.Errorf("--%s and --%s must be set in offline mode", flags.FlagAccountNumber, flags.FlagSequence)
+	}
+	if txBldr.SimulateAndExecute() || cliCtx.Simulate {
+		return errors.New("cannot estimate gas in offline mode")
+	}
+
+	if ok, err := confirmTx(txBldr, cliCtx, msgs, "confirm transaction before signing"); !ok {
+		return err
+	}
+
+	var json []byte
+	pbTxMsgs, isPbTxMsg := convertIfPbTx(msgs)
+	if isPbTxMsg {
+		txConfig := NewPbTxConfig(cliCtx.InterfaceRegistry)
+		tx, err := buildUnsignedPbTx(txBldr, txConfig, pbTxMsgs...)
+		if err != nil {
+			return err
+		}
+		if err := signPbTx(txConfig, txBldr, cliCtx.GetFromName(), keys.DefaultKeyPass, &tx, true); err != nil {
+			return err
+		}
+		json, err = txConfig.TxJSONEncoder()(tx.GetTx())
+		if err != nil {
+			return err
+		}
+	} else {
+		stdSignMsg, err := txBldr.BuildSignMsg(msgs)
+		if err != nil {
+			return err
+		}
+		stdTx, err := txBldr.SignStdTx(cliCtx.GetFromName(), keys.DefaultKeyPass,
+			authtypes.NewStdTx(stdSignMsg.Msgs, stdSignMsg.Fee, nil, stdSignMsg.Memo), false)
+		if err != nil {
+			return err
+		}
+		if viper.GetBool(flags.FlagIndentResponse) {
+			json, err = cliCtx.Codec.MarshalJSONIndent(stdTx, "", "  ")
+		} else {
+			json, err = cliCtx.Codec.MarshalJSON(stdTx)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, _ = fmt.Fprintf(cliCtx.Output, "%s\n", json)
+	return nil
+}
+
+// confirmTx prints what is being signed and asks for the confirmation, unless
+// it's skipped.
+func confirmTx(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg, prompt string) (bool, error) {
+	if cliCtx.SkipConfirm {
+		return true, nil
+	}
+
+	if err := printSignDoc(txBldr, cliCtx, msgs); err != nil {
+		return false, err
+	}
+
+	buf := bufio.NewReader(os.Stdin)
+	ok, err := input.GetConfirmation(prompt, buf)
+	if err != nil || !ok {
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", "cancelled transaction")
+		return false, err
+	}
+	return true, nil
+}
+
+// printSignDoc prints what is being signed to STDERR, as human-readable lines
+// in the textual sign mode, otherwise as JSON.
+func printSignDoc(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) error {
+	if cliCtx.SignMode == flags.SignModeTextual {
+		stdSignMsg, err := txBldr.BuildSignMsg(msgs)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", stdSignMsg.Textual())
+		return nil
+	}
+
+	var json []byte
+	if pbtxMsgs, isPbTxMsg := convertIfPbTx(msgs); isPbTxMsg {
+		txConfig := NewPbTxConfig(cliCtx.InterfaceRegistry)
+		tx, err := buildUnsignedPbTx(txBldr, txConfig, pbtxMsgs...)
+		if err != nil {
+			return err
+		}
+		json, err = txConfig.TxJSONEncoder()(tx.GetTx())
+		if err != nil {
+			return err
+		}
+	} else {
+		signData, err := txBldr.BuildSignMsg(msgs)
+		if err != nil {
+			return err
+		}
+
+		if viper.GetBool(flags.FlagIndentResponse) {
+			json, err = cliCtx.Codec.MarshalJSONIndent(signData, "", "  ")
+		} else {
+			json, err = cliCtx.Codec.MarshalJSON(signData)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", json)
+	return nil
+}
+
 func buildUnsignedPbTx(txf authtypes.TxBuilder, txConfig client.TxConfig, msgs ...txmsg.Msg) (client.TxBuilder, error) {
 	if txf.ChainID() == "" {
 		return nil, fmt.Errorf("chain ID required but not specified")
@@ -358,6 +445,12 @@ func PrintUnsignedStdTx(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, m
 		return err
 	}
 
+	if cliCtx.SignMode == flags.SignModeTextual {
+		if err := printSignDoc(txBldr, cliCtx, msgs); err != nil {
+			return err
+		}
+	}
+
 	var json []byte
 	pbTxMsgs, isPbTxMsg := convertIfPbTx(msgs)
 
@@ -413,6 +506,17 @@ func SignStdTx(
 		}
 	}
 
+	if cliCtx.SignMode == flags.SignModeTextual {
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", authtypes.StdSignMsg{
+			ChainID:       txBldr.ChainID(),
+			AccountNumber: txBldr.AccountNumber(),
+			Sequence:      txBldr.Sequence(),
+			Fee:           stdTx.Fee,
+			Msgs:          stdTx.GetMsgs(),
+			Memo:          stdTx.GetMemo(),
+		}.Textual())
+	}
+
 	return txBldr.SignStdTx(name, keys.DefaultKeyPass, stdTx, appendSig)
 }
 
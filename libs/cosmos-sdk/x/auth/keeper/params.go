@@ -15,3 +15,16 @@ func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	ak.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// GetMaxTxBytes gets the max size of the txs in bytes, 0 means no limit.
+func (ak AccountKeeper) GetMaxTxBytes(ctx sdk.Context) (maxTxBytes uint64) {
+	if ak.paramSubspace.Has(ctx, types.KeyMaxTxBytes) {
+		ak.paramSubspace.Get(ctx, types.KeyMaxTxBytes, &maxTxBytes)
+	}
+	return
+}
+
+// SetMaxTxBytes sets the max size of the txs in bytes.
+func (ak AccountKeeper) SetMaxTxBytes(ctx sdk.Context, maxTxBytes uint64) {
+	ak.paramSubspace.Set(ctx, types.KeyMaxTxBytes, &maxTxBytes)
+}
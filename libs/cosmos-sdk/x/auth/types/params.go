@@ -7,6 +7,7 @@ import (
 
 	"github.com/okex/exchain/libs/cosmos-sdk/x/params"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/params/subspace"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
 )
 
 // DefaultParamspace defines the default auth module parameter subspace
@@ -19,6 +20,9 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+
+	// MaxTxBytesCeiling is the ceiling of the max tx bytes param, a tx can't be larger than a block
+	MaxTxBytesCeiling uint64 = tmtypes.MaxBlockSizeBytes
 )
 
 // Parameter keys
@@ -28,6 +32,7 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyMaxTxBytes             = []byte("MaxTxBytes")
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -54,9 +59,11 @@ func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte,
 	}
 }
 
-// ParamKeyTable for auth module
+// ParamKeyTable for auth module. The max tx bytes isn't part of Params, it's absent on the existing chains until it's
+// set by governance.
 func ParamKeyTable() subspace.KeyTable {
-	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+	return subspace.NewKeyTable().RegisterParamSet(&Params{}).
+		RegisterType(params.NewParamSetPair(KeyMaxTxBytes, new(uint64), validateMaxTxBytes))
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -167,6 +174,19 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validateMaxTxBytes(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxTxBytesCeiling {
+		return fmt.Errorf("max tx bytes must not be larger than %d: %d", MaxTxBytesCeiling, v)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
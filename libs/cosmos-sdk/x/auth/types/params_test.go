@@ -14,3 +14,10 @@ func TestParamsEqual(t *testing.T) {
 	p1.TxSigLimit += 10
 	require.NotEqual(t, p1, p2)
 }
+
+func TestValidateMaxTxBytes(t *testing.T) {
+	require.NoError(t, validateMaxTxBytes(uint64(0)))
+	require.NoError(t, validateMaxTxBytes(MaxTxBytesCeiling))
+	require.Error(t, validateMaxTxBytes(MaxTxBytesCeiling+1))
+	require.Error(t, validateMaxTxBytes(int64(1)))
+}
@@ -1,6 +1,12 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 )
 
@@ -20,3 +26,112 @@ type StdSignMsg struct {
 func (msg StdSignMsg) Bytes() []byte {
 	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
 }
+
+// Textual renders the sign doc as human-readable lines, so that the signer can review what is being signed, e.g. on
+// an air-gapped machine. Each msg is rendered from its sign bytes with the nested fields indented.
+func (msg StdSignMsg) Textual() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Chain id: %s\n", msg.ChainID)
+	fmt.Fprintf(&b, "Account number: %d\n", msg.AccountNumber)
+	fmt.Fprintf(&b, "Sequence: %d\n", msg.Sequence)
+	fmt.Fprintf(&b, "Fees: %s\n", textualCoins(msg.Fee.Amount.String()))
+	fmt.Fprintf(&b, "Gas limit: %d\n", msg.Fee.Gas)
+	if msg.Memo != "" {
+		fmt.Fprintf(&b, "Memo: %s\n", msg.Memo)
+	}
+
+	for i, m := range msg.Msgs {
+		var value interface{}
+		decoder := json.NewDecoder(bytes.NewReader(m.GetSignBytes()))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err != nil {
+			fmt.Fprintf(&b, "Message (%d/%d): %s\n", i+1, len(msg.Msgs), m.Type())
+			fmt.Fprintf(&b, "  Sign bytes: %X\n", m.GetSignBytes())
+			continue
+		}
+
+		// the amino registered msgs are signed with their names
+		msgType := m.Type()
+		if obj, ok := value.(map[string]interface{}); ok && len(obj) == 2 && obj["type"] != nil && obj["value"] != nil {
+			msgType, value = fmt.Sprint(obj["type"]), obj["value"]
+		}
+		fmt.Fprintf(&b, "Message (%d/%d): %s\n", i+1, len(msg.Msgs), msgType)
+		writeTextualFields(&b, 1, value)
+	}
+	b.WriteString("End of transaction\n")
+	return b.String()
+}
+
+func writeTextualFields(b *strings.Builder, indent int, value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			writeTextualField(b, indent, textualKey(k), v[k])
+		}
+	default:
+		writeTextualField(b, indent, "Value", v)
+	}
+}
+
+func writeTextualField(b *strings.Builder, indent int, key string, value interface{}) {
+	prefix := strings.Repeat("  ", indent)
+	if coins, ok := textualCoinList(value); ok {
+		fmt.Fprintf(b, "%s%s: %s\n", prefix, key, coins)
+		return
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		fmt.Fprintf(b, "%s%s:\n", prefix, key)
+		writeTextualFields(b, indent+1, v)
+	case []interface{}:
+		if len(v) == 0 {
+			fmt.Fprintf(b, "%s%s: (empty)\n", prefix, key)
+		}
+		for i, elem := range v {
+			writeTextualField(b, indent, fmt.Sprintf("%s (%d/%d)", key, i+1, len(v)), elem)
+		}
+	case nil:
+		fmt.Fprintf(b, "%s%s: (none)\n", prefix, key)
+	default:
+		fmt.Fprintf(b, "%s%s: %v\n", prefix, key, v)
+	}
+}
+
+// textualKey turns a json key like from_address into From address
+func textualKey(key string) string {
+	key = strings.ReplaceAll(key, "_", " ")
+	if key == "" {
+		return key
+	}
+	return strings.ToUpper(key[:1]) + key[1:]
+}
+
+// textualCoinList renders a list of coins like 1.5okt,2usdt if the value is one
+func textualCoinList(value interface{}) (string, bool) {
+	list, ok := value.([]interface{})
+	if !ok || len(list) == 0 {
+		return "", false
+	}
+	coins := make([]string, len(list))
+	for i, elem := range list {
+		coin, ok := elem.(map[string]interface{})
+		if !ok || len(coin) != 2 || coin["denom"] == nil || coin["amount"] == nil {
+			return "", false
+		}
+		coins[i] = fmt.Sprintf("%v%v", coin["amount"], coin["denom"])
+	}
+	return strings.Join(coins, ","), true
+}
+
+func textualCoins(coins string) string {
+	if coins == "" {
+		return "(none)"
+	}
+	return coins
+}
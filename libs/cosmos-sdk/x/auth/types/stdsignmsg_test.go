@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// textualTestMsg signs the amino json of a transfer
+type textualTestMsg struct {
+	*sdk.TestMsg
+}
+
+func (msg textualTestMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON([]byte(`{"type":"test/MsgTransfer","value":{"from_address":"ex1from","to_address":"ex1to",
+		"amount":[{"denom":"okt","amount":"1.500000000000000000"}],"labels":[],"extra":{"note":"hi"}}}`))
+}
+
+func TestStdSignMsgTextual(t *testing.T) {
+	signMsg := StdSignMsg{
+		ChainID:       "exchain-65",
+		AccountNumber: 3,
+		Sequence:      7,
+		Fee:           NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("okt", 1))),
+		Msgs:          []sdk.Msg{textualTestMsg{sdk.NewTestMsg(addr)}, sdk.NewTestMsg(addr)},
+		Memo:          "memo",
+	}
+
+	expected := `Chain id: exchain-65
+Account number: 3
+Sequence: 7
+Fees: 1.000000000000000000okt
+Gas limit: 200000
+Memo: memo
+Message (1/2): test/MsgTransfer
+  Amount: 1.500000000000000000okt
+  Extra:
+    Note: hi
+  From address: ex1from
+  Labels: (empty)
+  To address: ex1to
+Message (2/2): Test message
+  Value (1/1): ` + addr.String() + `
+End of transaction
+`
+	require.Equal(t, expected, signMsg.Textual())
+
+	// the fee is rendered even if it's empty
+	signMsg.Fee = NewStdFee(0, nil)
+	signMsg.Msgs, signMsg.Memo = nil, ""
+	require.Equal(t, "Chain id: exchain-65\nAccount number: 3\nSequence: 7\nFees: (none)\nGas limit: 0\nEnd of transaction\n",
+		signMsg.Textual())
+}
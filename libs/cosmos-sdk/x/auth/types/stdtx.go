@@ -272,20 +272,24 @@ func (tx *StdTx) GetTxFnSignatureInfo() ([]byte, int) {
 	return nil, 0
 }
 
+// GetFrom returns the checksummed hex address of the fee payer, the same form as the sender of the evm txs, so the
+// mempool keys the cosmos and evm txs of an account by the same address
 func (tx *StdTx) GetFrom() string {
 	signers := tx.GetSigners()
 	if len(signers) == 0 {
 		return ""
 	}
-	return signers[0].String()
+	return signers[0].HexString()
 }
 
 func (tx *StdTx) GetSender(_ sdk.Context) string {
 	return tx.GetFrom()
 }
 
+// GetNonce returns the sequence of the fee payer, which is set in checkTx only as the tx doesn't carry it. The mempool
+// orders the cosmos and evm txs of an account by it.
 func (tx *StdTx) GetNonce() uint64 {
-	return 0
+	return tx.Nonce
 }
 
 //__________________________________________________________
@@ -36,6 +36,7 @@ var (
 	ErrNoOutputs                = types.ErrNoOutputs
 	ErrInputOutputMismatch      = types.ErrInputOutputMismatch
 	ErrSendDisabled             = types.ErrSendDisabled
+	ErrMultipleSenders          = types.ErrMultipleSenders
 	NewGenesisState             = types.NewGenesisState
 	DefaultGenesisState         = types.DefaultGenesisState
 	ValidateGenesis             = types.ValidateGenesis
@@ -54,6 +55,7 @@ var (
 	NewBankKeeperAdapter        = keeperadapter.NewBankKeeperAdapter
 	NewBankQueryServer          = keeperadapter.NewBankQueryServer
 	RegisterInterface           = typesadapter.RegisterInterface
+	ComposeSendRestrictions     = types.ComposeSendRestrictions
 )
 
 type (
@@ -72,6 +74,7 @@ type (
 	QueryBalanceParams = types.QueryBalanceParams
 	BankKeeperAdapter  = keeperadapter.BankKeeperAdapter
 	SupplyKeeper       = keeperadapter.SupplyKeeper
+	SendRestrictionFn  = types.SendRestrictionFn
 )
 
 //adapter
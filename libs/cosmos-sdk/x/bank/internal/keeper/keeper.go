@@ -173,6 +173,11 @@ type SendKeeper interface {
 	SetSendEnabled(ctx sdk.Context, enabled bool)
 
 	BlacklistedAddr(addr sdk.AccAddress) bool
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
+	ApplySendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error)
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -189,9 +194,16 @@ type BaseSendKeeper struct {
 	// list of addresses that are restricted from receiving transactions
 	blacklistedAddrs map[string]bool
 
+	// the restriction is shared by the copies of the keeper held by the other modules
+	sendRestriction *sendRestriction
+
 	ik innertx.InnerTxKeeper
 }
 
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
 // NewBaseSendKeeper returns a new BaseSendKeeper.
 func NewBaseSendKeeper(
 	ak types.AccountKeeper, paramSpace params.Subspace, blacklistedAddrs map[string]bool,
@@ -202,6 +214,7 @@ func NewBaseSendKeeper(
 		ak:               ak,
 		paramSpace:       paramSpace,
 		blacklistedAddrs: blacklistedAddrs,
+		sendRestriction:  &sendRestriction{},
 	}
 	bsk.ask, _ = bsk.ak.(authexported.SizerAccountKeeper)
 	return bsk
@@ -241,6 +254,11 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 	}
 
 	for _, out := range outputs {
+		out.Address, err = keeper.applyOutputSendRestriction(ctx, inputs, out)
+		if err != nil {
+			return err
+		}
+
 		_, err := keeper.AddCoins(ctx, out.Address, out.Coins)
 		if err != nil {
 			return err
@@ -274,6 +292,11 @@ func (keeper BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress,
 			keeper.ik.UpdateInnerTx(ctx.TxBytes(), ctx.BlockHeight(), innertx.CosmosDepth, fromAddr, toAddr, innertx.CosmosCallType, innertx.SendCallName, amt, err)
 		}
 	}()
+	toAddr, err = keeper.ApplySendRestriction(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
 	fromAddrStr := fromAddr.String()
 	ctx.EventManager().EmitEvents(sdk.Events{
 		// This event should have all info (to, from, amount) without looking at other events
@@ -316,6 +339,59 @@ func (keeper BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress,
 	return nil
 }
 
+// AppendSendRestriction adds the restriction to be run after the registered ones
+func (keeper BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	keeper.sendRestriction.fn = keeper.sendRestriction.fn.Then(restriction)
+}
+
+// PrependSendRestriction adds the restriction to be run before the registered ones
+func (keeper BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	keeper.sendRestriction.fn = restriction.Then(keeper.sendRestriction.fn)
+}
+
+// ClearSendRestriction removes all the registered restrictions
+func (keeper BaseSendKeeper) ClearSendRestriction() {
+	keeper.sendRestriction.fn = nil
+}
+
+func (keeper BaseSendKeeper) hasSendRestriction() bool {
+	return keeper.sendRestriction != nil && keeper.sendRestriction.fn != nil
+}
+
+// applyOutputSendRestriction runs the registered restrictions on an output of a multi send. The sender of an output
+// isn't known with several inputs, so the restrictions run for every input and can't redirect the output.
+func (keeper BaseSendKeeper) applyOutputSendRestriction(ctx sdk.Context, inputs []types.Input, out types.Output) (sdk.AccAddress, error) {
+	if len(inputs) == 1 {
+		return keeper.ApplySendRestriction(ctx, inputs[0].Address, out.Address, out.Coins)
+	}
+	for _, in := range inputs {
+		toAddr, err := keeper.ApplySendRestriction(ctx, in.Address, out.Address, out.Coins)
+		if err != nil {
+			return nil, err
+		}
+		if !toAddr.Equals(out.Address) {
+			return nil, types.ErrMultipleSenders
+		}
+	}
+	return out.Address, nil
+}
+
+// ApplySendRestriction runs the registered restrictions on a transfer and returns the receiver address the coins
+// should go to.
+func (keeper BaseSendKeeper) ApplySendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if !keeper.hasSendRestriction() {
+		return toAddr, nil
+	}
+	newToAddr, err := keeper.sendRestriction.fn(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+	if newToAddr.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "send restriction returned an empty recipient")
+	}
+	return newToAddr, nil
+}
+
 // SubtractCoins subtracts amt from the coins at the addr.
 //
 // CONTRACT: If the account is a vesting account, the amount has to be spendable.
@@ -11,6 +11,7 @@ import (
 
 	"github.com/okex/exchain/libs/cosmos-sdk/simapp"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/vesting"
 	keep "github.com/okex/exchain/libs/cosmos-sdk/x/bank/internal/keeper"
@@ -186,6 +187,79 @@ func TestInputOutputNewAccount(t *testing.T) {
 	require.NotNil(t, app.AccountKeeper.GetAccount(ctx, addr2))
 }
 
+func TestSendRestriction(t *testing.T) {
+	app, ctx := createTestApp(false)
+	sendKeeper := keep.NewBaseSendKeeper(app.AccountKeeper, app.ParamsKeeper.Subspace("newspace"), make(map[string]bool))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	require.NoError(t, app.BankKeeper.SetCoins(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 100))))
+
+	// the restriction registered on a copy of the keeper applies to the keeper
+	copied := sendKeeper
+	copied.AppendSendRestriction(func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(addr2) {
+			return addr3, nil
+		}
+		return toAddr, nil
+	})
+	require.NoError(t, sendKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 10))))
+	require.True(t, sendKeeper.GetCoins(ctx, addr2).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 10)), sendKeeper.GetCoins(ctx, addr3))
+
+	// the vetoing restriction runs before the redirecting one
+	var seen sdk.AccAddress
+	sendKeeper.PrependSendRestriction(func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		seen = fromAddr
+		if amt.AmountOf(fooDenom).GT(sdk.NewDec(50)) {
+			return nil, sdkerrors.ErrUnauthorized
+		}
+		return toAddr, nil
+	})
+	err := sendKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 60)))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 90)), sendKeeper.GetCoins(ctx, addr1))
+
+	// the restrictions apply to the outputs of a multi send
+	inputs := []types.Input{{Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 20))}}
+	outputs := []types.Output{{Address: addr2, Coins: sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 20))}}
+	require.NoError(t, sendKeeper.InputOutputCoins(ctx, inputs, outputs))
+	require.Equal(t, addr1, seen)
+	require.True(t, sendKeeper.GetCoins(ctx, addr2).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 30)), sendKeeper.GetCoins(ctx, addr3))
+
+	// the outputs of a multi send with several inputs can't be redirected, as their sender isn't known
+	inputs = []types.Input{
+		{Address: addr1, Coins: sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 5))},
+		{Address: addr3, Coins: sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 5))},
+	}
+	outputs = []types.Output{{Address: addr2, Coins: sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 10))}}
+	cacheCtx, _ := ctx.CacheContext()
+	err = sendKeeper.InputOutputCoins(cacheCtx, inputs, outputs)
+	require.True(t, types.ErrMultipleSenders.Is(err))
+
+	// the restrictions run for every input of a multi send
+	sendKeeper.ClearSendRestriction()
+	sendKeeper.AppendSendRestriction(func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if fromAddr.Equals(addr3) {
+			return nil, sdkerrors.ErrUnauthorized
+		}
+		return toAddr, nil
+	})
+	cacheCtx, _ = ctx.CacheContext()
+	err = sendKeeper.InputOutputCoins(cacheCtx, inputs, outputs)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err))
+	inputs[1].Address = addr1
+	require.NoError(t, sendKeeper.InputOutputCoins(ctx, inputs, outputs))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 10)), sendKeeper.GetCoins(ctx, addr2))
+
+	sendKeeper.ClearSendRestriction()
+	require.NoError(t, sendKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 60))))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 70)), sendKeeper.GetCoins(ctx, addr2))
+}
+
 func TestMsgSendEvents(t *testing.T) {
 	app, ctx := createTestApp(false)
 
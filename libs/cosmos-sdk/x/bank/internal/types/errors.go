@@ -11,6 +11,7 @@ var (
 	ErrNoOutputs           = sdkerrors.Register(ModuleName, 2, "no outputs to send transaction")
 	ErrInputOutputMismatch = sdkerrors.Register(ModuleName, 3, "sum inputs != sum outputs")
 	ErrSendDisabled        = sdkerrors.Register(ModuleName, 4, "send transactions are disabled")
+	ErrMultipleSenders     = sdkerrors.Register(ModuleName, 6, "the outputs of a multi send with multiple senders can't be redirected")
 )
 
 func ErrUnSupportQueryType(data string) *sdkerrors.Error {
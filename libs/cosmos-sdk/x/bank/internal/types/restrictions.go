@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// SendRestrictionFn can restrict sends and/or provide a new receiver address. A module registers it on the bank
+// keeper to veto the transfers (e.g. a blocklist, a paused token) by returning an error, or to redirect them by
+// returning another receiver address.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
+// Then creates a composite restriction that runs this one then the provided one, the receiver address returned by
+// this one is passed to the provided one. A nil restriction is skipped.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	if r == nil {
+		return second
+	}
+	if second == nil {
+		return r
+	}
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		newToAddr, err := r(ctx, fromAddr, toAddr, amt)
+		if err != nil {
+			return newToAddr, err
+		}
+		return second(ctx, fromAddr, newToAddr, amt)
+	}
+}
+
+// ComposeSendRestrictions combines the restrictions into one running them in order
+func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
+	var composite SendRestrictionFn
+	for _, r := range restrictions {
+		composite = composite.Then(r)
+	}
+	return composite
+}
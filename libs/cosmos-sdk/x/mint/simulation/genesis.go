@@ -47,6 +47,21 @@ func GenGoalBonded(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(67, 2)
 }
 
+// GenDeflationRate randomized DeflationRate
+func GenDeflationRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(99)), 2)
+}
+
+// GenDeflationEpoch randomized DeflationEpoch
+func GenDeflationEpoch(r *rand.Rand) uint64 {
+	return uint64(r.Intn(10) + 1)
+}
+
+// GenFarmProportion randomized FarmProportion
+func GenFarmProportion(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -10,35 +10,23 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
 )
 
-const (
-	keyInflationRateChange = "InflationRateChange"
-	keyInflationMax        = "InflationMax"
-	keyInflationMin        = "InflationMin"
-	keyGoalBonded          = "GoalBonded"
-)
-
 // ParamChanges defines the parameters that can be modified by param change proposals
 // on the simulation
 func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 	return []simulation.ParamChange{
-		simulation.NewSimParamChange(types.ModuleName, keyInflationRateChange,
-			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenInflationRateChange(r))
-			},
-		),
-		simulation.NewSimParamChange(types.ModuleName, keyInflationMax,
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDeflationRate),
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenInflationMax(r))
+				return fmt.Sprintf("\"%s\"", GenDeflationRate(r))
 			},
 		),
-		simulation.NewSimParamChange(types.ModuleName, keyInflationMin,
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDeflationEpoch),
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenInflationMin(r))
+				return fmt.Sprintf("\"%d\"", GenDeflationEpoch(r))
 			},
 		),
-		simulation.NewSimParamChange(types.ModuleName, keyGoalBonded,
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyFarmProportion),
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("\"%s\"", GenGoalBonded(r))
+				return fmt.Sprintf("\"%s\"", GenFarmProportion(r))
 			},
 		),
 	}
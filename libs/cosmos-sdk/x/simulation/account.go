@@ -73,13 +73,13 @@ func RandomFees(r *rand.Rand, ctx sdk.Context, spendableCoins sdk.Coins) (sdk.Co
 		return nil, nil
 	}
 
-	amt, err := RandPositiveInt(r, randCoin.Amount)
-	if err != nil {
-		return nil, err
+	// the coin amount is a decimal, so the random fee keeps all its precision bits
+	// to stay within the account's spendable balance
+	amt := RandomDecAmount(r, randCoin.Amount)
+	if amt.IsZero() {
+		return nil, nil
 	}
 
-	// Create a random fee and verify the fees are within the account's spendable
-	// balance.
-	fees := sdk.NewCoins(sdk.NewCoin(randCoin.Denom, amt))
+	fees := sdk.NewCoins(sdk.NewDecCoinFromDec(randCoin.Denom, amt))
 	return fees, nil
 }
@@ -7,15 +7,12 @@ import (
 	"testing"
 )
 
+// getTestingMode tells whether the simulation runs in testing mode, that is unless it's benchmarked. Besides the tests,
+// it's the case of the testing.TB implementations running the simulation out of the go tests.
 func getTestingMode(tb testing.TB) (testingMode bool, t *testing.T, b *testing.B) {
-	testingMode = false
-	if _t, ok := tb.(*testing.T); ok {
-		t = _t
-		testingMode = true
-	} else {
-		b = tb.(*testing.B)
-	}
-	return testingMode, t, b
+	t, _ = tb.(*testing.T)
+	b, isBenchmark := tb.(*testing.B)
+	return !isBenchmark, t, b
 }
 
 // getBlockSize returns a block size as determined from the transition matrix.
@@ -28,6 +28,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	supplyQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryTotalSupply(cdc),
+		GetCmdQueryModuleAccounts(cdc),
+		GetCmdQueryCirculating(cdc),
 	)...)
 
 	return supplyQueryCmd
@@ -104,3 +106,83 @@ func querySupplyOf(cliCtx context.CLIContext, cdc *codec.Codec, denom string) er
 
 	return cliCtx.PrintOutput(supply)
 }
+
+// GetCmdQueryModuleAccounts implements the query module accounts command.
+func GetCmdQueryModuleAccounts(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "module-accounts",
+		Args:  cobra.NoArgs,
+		Short: "Query all the module accounts with their permissions and balances",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the module accounts in the account store with their permissions and
+balances. A module account that no module of the chain is registered for is shown as unregistered.
+
+Example:
+$ %s query %s module-accounts
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryModuleAccounts), nil)
+			if err != nil {
+				return err
+			}
+
+			var infos []types.ModuleAccountInfo
+			if err := cdc.UnmarshalJSON(res, &infos); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(infos)
+		},
+	}
+}
+
+// GetCmdQueryCirculating implements the query circulating supply command.
+func GetCmdQueryCirculating(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "circulating [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the total, module accounts, locked vesting and circulating supply of coins",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total supply of coins with the amounts held by the module accounts and locked
+in the vesting accounts, from which the circulating supply is derived.
+
+Example:
+$ %s query %s circulating
+
+To query for the supply of a specific coin denomination use:
+$ %s query %s circulating stake
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var denom string
+			if len(args) > 0 {
+				denom = args[0]
+			}
+			bz, err := cdc.MarshalJSON(types.NewQuerySupplyOfParams(denom))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCirculating), bz)
+			if err != nil {
+				return err
+			}
+
+			var breakdowns []types.SupplyBreakdown
+			if err := cdc.UnmarshalJSON(res, &breakdowns); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(breakdowns)
+		},
+	}
+}
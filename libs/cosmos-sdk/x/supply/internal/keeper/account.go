@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	"sort"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	authexported "github.com/okex/exchain/libs/cosmos-sdk/x/auth/exported"
+	vestexported "github.com/okex/exchain/libs/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/supply/exported"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/supply/internal/types"
 )
@@ -64,3 +68,59 @@ func (k Keeper) SetModuleAccount(ctx sdk.Context, macc exported.ModuleAccountI)
 func (k Keeper) GetAccount(ctx sdk.Context, acc sdk.AccAddress) authtypes.Account {
 	return k.ak.GetAccount(ctx, acc)
 }
+
+// GetModuleAccountsInfo iterates the account store for the module accounts, including the ones no module of the
+// chain is registered for, sorted by name
+func (k Keeper) GetModuleAccountsInfo(ctx sdk.Context) []types.ModuleAccountInfo {
+	infos := []types.ModuleAccountInfo{}
+	k.ak.IterateAccounts(ctx, func(acc authexported.Account) bool {
+		macc, ok := acc.(exported.ModuleAccountI)
+		if !ok {
+			return false
+		}
+		permAddr, registered := k.permAddrs[macc.GetName()]
+		registered = registered && permAddr.GetAddress().Equals(macc.GetAddress())
+		infos = append(infos, types.NewModuleAccountInfo(
+			macc.GetName(), macc.GetAddress(), macc.GetPermissions(), macc.GetCoins(), registered,
+		))
+		return false
+	})
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+	return infos
+}
+
+// GetSupplyBreakdown returns the breakdown of the total supply of the denom, or of every denom of the total supply if
+// the denom is empty. The amounts held by the module accounts and locked in the vesting accounts are summed over the
+// account store, so it's meant for the queries only.
+// NOTE: the vesting coins delegated by a vesting account are held by the bonded pool and counted with the module
+// accounts.
+func (k Keeper) GetSupplyBreakdown(ctx sdk.Context, denom string) []types.SupplyBreakdown {
+	var moduleCoins, lockedCoins sdk.Coins
+	blockTime := ctx.BlockTime()
+	k.ak.IterateAccounts(ctx, func(acc authexported.Account) bool {
+		switch acc := acc.(type) {
+		case exported.ModuleAccountI:
+			moduleCoins = moduleCoins.Add(acc.GetCoins()...)
+		case vestexported.VestingAccount:
+			if locked, hasNeg := acc.GetCoins().SafeSub(acc.SpendableCoins(blockTime)); !hasNeg {
+				lockedCoins = lockedCoins.Add(locked...)
+			}
+		}
+		return false
+	})
+
+	total := k.GetSupply(ctx).GetTotal()
+	if denom != "" {
+		total = sdk.Coins{sdk.DecCoin{Denom: denom, Amount: total.AmountOf(denom)}}
+	}
+	breakdowns := []types.SupplyBreakdown{}
+	for _, coin := range total {
+		breakdowns = append(breakdowns, types.NewSupplyBreakdown(
+			coin.Denom, coin.Amount, moduleCoins.AmountOf(coin.Denom), lockedCoins.AmountOf(coin.Denom),
+		))
+	}
+	return breakdowns
+}
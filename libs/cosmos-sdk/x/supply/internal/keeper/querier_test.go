@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/vesting"
 	keep "github.com/okex/exchain/libs/cosmos-sdk/x/supply/internal/keeper"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/supply/internal/types"
 )
@@ -110,3 +112,64 @@ func TestQuerySupply(t *testing.T) {
 	require.True(sdk.DecEq(t, sdk.NewDec(100), supply))
 
 }
+
+func TestQueryModuleAccounts(t *testing.T) {
+	app, ctx := createTestApp(false)
+	keeper := app.SupplyKeeper
+	cdc := app.Codec()
+
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+	burner := types.NewEmptyModuleAccount(types.Burner, types.Burner)
+	require.NoError(t, burner.SetCoins(coins))
+	keeper.SetModuleAccount(ctx, burner)
+	// a module account no module is registered for
+	rogue := types.NewEmptyModuleAccount("rogue", types.Minter)
+	require.NoError(t, rogue.SetCoins(coins))
+	keeper.SetModuleAccount(ctx, rogue)
+
+	querier := keep.NewQuerier(keeper)
+	bz, err := querier(ctx, []string{types.QueryModuleAccounts}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var infos []types.ModuleAccountInfo
+	require.NoError(t, cdc.UnmarshalJSON(bz, &infos))
+	found := make(map[string]types.ModuleAccountInfo)
+	for i, info := range infos {
+		if i > 0 {
+			require.True(t, infos[i-1].Name < info.Name)
+		}
+		found[info.Name] = info
+	}
+	require.Equal(t, types.NewModuleAccountInfo(types.Burner, burner.GetAddress(), []string{types.Burner}, coins, true), found[types.Burner])
+	require.Equal(t, types.NewModuleAccountInfo("rogue", rogue.GetAddress(), []string{types.Minter}, coins, false), found["rogue"])
+}
+
+func TestQueryCirculating(t *testing.T) {
+	app, ctx := createTestApp(false)
+	keeper := app.SupplyKeeper
+	cdc := app.Codec()
+
+	keeper.SetSupply(ctx, types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("foo", 50))))
+	burner := types.NewEmptyModuleAccount(types.Burner, types.Burner)
+	require.NoError(t, burner.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))))
+	keeper.SetModuleAccount(ctx, burner)
+	// the coins of the vesting account are locked until its end time
+	baseAcc := authtypes.NewBaseAccount(sdk.AccAddress([]byte("vesting")), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30)), nil, 0, 0)
+	app.AccountKeeper.SetAccount(ctx, vesting.NewDelayedVestingAccount(baseAcc, ctx.BlockTime().Unix()+1000))
+
+	querier := keep.NewQuerier(keeper)
+	bz, err := querier(ctx, []string{types.QueryCirculating}, abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQuerySupplyOfParams(""))})
+	require.NoError(t, err)
+	var breakdowns []types.SupplyBreakdown
+	require.NoError(t, cdc.UnmarshalJSON(bz, &breakdowns))
+	require.Len(t, breakdowns, 2)
+	require.Equal(t, types.NewSupplyBreakdown("foo", sdk.NewDec(50), sdk.ZeroDec(), sdk.ZeroDec()).String(), breakdowns[0].String())
+	require.Equal(t, types.NewSupplyBreakdown(sdk.DefaultBondDenom, sdk.NewDec(100), sdk.NewDec(10), sdk.NewDec(30)).String(), breakdowns[1].String())
+	require.True(t, breakdowns[1].Circulating.Equal(sdk.NewDec(60)))
+
+	bz, err = querier(ctx, []string{types.QueryCirculating}, abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQuerySupplyOfParams("bar"))})
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalJSON(bz, &breakdowns))
+	require.Len(t, breakdowns, 1)
+	require.Equal(t, types.NewSupplyBreakdown("bar", sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()).String(), breakdowns[0].String())
+}
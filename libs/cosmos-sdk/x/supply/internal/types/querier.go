@@ -1,9 +1,18 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
 // query endpoints supported by the supply Querier
 const (
-	QueryTotalSupply = "total_supply"
-	QuerySupplyOf    = "supply_of"
+	QueryTotalSupply    = "total_supply"
+	QuerySupplyOf       = "supply_of"
+	QueryModuleAccounts = "module_accounts"
+	QueryCirculating    = "circulating_supply"
 )
 
 // QueryTotalSupply defines the params for the following queries:
@@ -30,3 +39,57 @@ type QuerySupplyOfParams struct {
 func NewQuerySupplyOfParams(denom string) QuerySupplyOfParams {
 	return QuerySupplyOfParams{denom}
 }
+
+// ModuleAccountInfo is the result of the 'custom/supply/module_accounts' query, describing a module account in the
+// account store. Registered is false if no module of the chain is registered under its name and address.
+type ModuleAccountInfo struct {
+	Name        string         `json:"name"`
+	Address     sdk.AccAddress `json:"address"`
+	Permissions []string       `json:"permissions"`
+	Coins       sdk.Coins      `json:"coins"`
+	Registered  bool           `json:"registered"`
+}
+
+// NewModuleAccountInfo creates a new instance of ModuleAccountInfo
+func NewModuleAccountInfo(name string, address sdk.AccAddress, permissions []string, coins sdk.Coins, registered bool,
+) ModuleAccountInfo {
+	return ModuleAccountInfo{name, address, permissions, coins, registered}
+}
+
+func (mai ModuleAccountInfo) String() string {
+	return fmt.Sprintf(`Module Account:
+  Name:        %s
+  Address:     %s
+  Permissions: %s
+  Coins:       %s
+  Registered:  %t`, mai.Name, mai.Address, strings.Join(mai.Permissions, ", "), mai.Coins, mai.Registered)
+}
+
+// SupplyBreakdown is the result of the 'custom/supply/circulating_supply' query, splitting the total supply of a
+// denom into the amounts held by the module accounts, the amounts locked in the vesting accounts and the circulating
+// supply derived from them
+type SupplyBreakdown struct {
+	Denom          string  `json:"denom"`
+	Total          sdk.Dec `json:"total"`
+	ModuleAccounts sdk.Dec `json:"module_accounts"`
+	LockedVesting  sdk.Dec `json:"locked_vesting"`
+	Circulating    sdk.Dec `json:"circulating"`
+}
+
+// NewSupplyBreakdown creates a new instance of SupplyBreakdown deriving the circulating supply, which is zero if the
+// amounts held by the module accounts and the vesting accounts exceed the total
+func NewSupplyBreakdown(denom string, total, moduleAccounts, lockedVesting sdk.Dec) SupplyBreakdown {
+	circulating := total.Sub(moduleAccounts).Sub(lockedVesting)
+	if circulating.IsNegative() {
+		circulating = sdk.ZeroDec()
+	}
+	return SupplyBreakdown{denom, total, moduleAccounts, lockedVesting, circulating}
+}
+
+func (sb SupplyBreakdown) String() string {
+	return fmt.Sprintf(`Supply of %s:
+  Total:           %s
+  Module Accounts: %s
+  Locked Vesting:  %s
+  Circulating:     %s`, sb.Denom, sb.Total, sb.ModuleAccounts, sb.LockedVesting, sb.Circulating)
+}
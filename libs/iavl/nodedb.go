@@ -803,6 +803,15 @@ func (ndb *nodeDB) Commit(batch dbm.Batch) error {
 	return nil
 }
 
+// commitSync writes the batch and syncs it to the disk regardless of the sync option
+func (ndb *nodeDB) commitSync(batch dbm.Batch) error {
+	defer batch.Close()
+	if err := batch.WriteSync(); err != nil {
+		return errors.Wrap(err, "failed to write batch")
+	}
+	return nil
+}
+
 func (ndb *nodeDB) getRoot(version int64) ([]byte, error) {
 	return ndb.dbGet(ndb.rootKey(version))
 }
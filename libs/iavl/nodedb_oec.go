@@ -120,7 +120,12 @@ func (ndb *nodeDB) persistTpp(event *commitEvent, writeToDB bool, trc *trace.Tra
 	}
 
 	trc.Pin("batchCommit")
-	if err := ndb.Commit(batch); err != nil {
+	commit := ndb.Commit
+	if event.isStop {
+		// the last batch of the async commit is synced to the disk on stop
+		commit = ndb.commitSync
+	}
+	if err := commit(batch); err != nil {
 		panic(err)
 	}
 
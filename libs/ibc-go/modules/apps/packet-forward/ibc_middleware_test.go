@@ -0,0 +1,160 @@
+package packetforward_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/store"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	capabilitytypes "github.com/okex/exchain/libs/cosmos-sdk/x/capability/types"
+	packetforward "github.com/okex/exchain/libs/ibc-go/modules/apps/packet-forward"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/packet-forward/keeper"
+	transfertypes "github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/okex/exchain/libs/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/okex/exchain/libs/ibc-go/modules/core/04-channel/types"
+	porttypes "github.com/okex/exchain/libs/ibc-go/modules/core/05-port/types"
+	"github.com/okex/exchain/libs/ibc-go/modules/core/exported"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	dbm "github.com/okex/exchain/libs/tm-db"
+	"github.com/stretchr/testify/require"
+)
+
+const forwardMemo = `{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"channel-7"}}`
+
+var receiver = sdk.AccAddress([]byte("receiver____________"))
+
+// mockTransferApp stands in for the transfer application below the middleware
+type mockTransferApp struct {
+	porttypes.Middleware
+
+	ack      exported.Acknowledgement
+	received []channeltypes.Packet
+}
+
+func (m *mockTransferApp) OnRecvPacket(_ sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	m.received = append(m.received, packet)
+	return m.ack
+}
+
+type mockTransferKeeper struct {
+	err      error
+	receiver string
+	memo     string
+}
+
+func (m *mockTransferKeeper) SendTransfer(_ sdk.Context, _, _ string, _ sdk.CoinAdapter, _ sdk.AccAddress,
+	receiver string, _ clienttypes.Height, _ uint64, memo string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.receiver, m.memo = receiver, memo
+	return nil
+}
+
+type mockChannelKeeper struct{}
+
+func (mockChannelKeeper) GetNextSequenceSend(_ sdk.Context, _, _ string) (uint64, bool) {
+	return 1, true
+}
+
+func (mockChannelKeeper) LookupModuleByChannel(_ sdk.Context, _, _ string) (string, *capabilitytypes.Capability, error) {
+	return transfertypes.ModuleName, capabilitytypes.NewCapability(1), nil
+}
+
+func setupMiddleware(t *testing.T) (sdk.Context, packetforward.IBCMiddleware, *mockTransferApp, *mockTransferKeeper, keeper.Keeper) {
+	key := sdk.NewKVStoreKey("transfer")
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{Height: 1, Time: time.Unix(1000, 0).UTC()}, false, log.NewNopLogger())
+
+	app := &mockTransferApp{ack: channeltypes.NewResultAcknowledgement([]byte{byte(1)})}
+	transferKeeper := &mockTransferKeeper{}
+	k := keeper.NewKeeper(key, transferKeeper, mockChannelKeeper{}, nil, nil)
+	return ctx, packetforward.NewIBCMiddleware(app, k), app, transferKeeper, k
+}
+
+func transferPacket(memo string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "1000", "cosmos1sender", receiver.String())
+	data.Memo = memo
+	return channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.ZeroHeight(), 0)
+}
+
+func TestOnRecvPacketWithoutForward(t *testing.T) {
+	for _, memo := range []string{"", "hello", `{"wasm":{"contract":"ex1contract"}}`} {
+		ctx, middleware, app, transferKeeper, _ := setupMiddleware(t)
+
+		ack := middleware.OnRecvPacket(ctx, transferPacket(memo), nil)
+		require.NotNil(t, ack, memo)
+		require.True(t, ack.Success(), memo)
+		require.Len(t, app.received, 1, memo)
+		require.Empty(t, transferKeeper.receiver, memo)
+	}
+}
+
+func TestOnRecvPacketForward(t *testing.T) {
+	ctx, middleware, app, transferKeeper, k := setupMiddleware(t)
+	packet := transferPacket(forwardMemo)
+
+	// the acknowledgement is written asynchronously
+	ack := middleware.OnRecvPacket(ctx, packet, nil)
+	require.Nil(t, ack)
+
+	// the packet is received by the underlying application as is
+	require.Equal(t, []channeltypes.Packet{packet}, app.received)
+	require.Equal(t, "cosmos1final", transferKeeper.receiver)
+
+	inFlight, found := k.GetInFlightPacket(ctx, "transfer", "channel-7", 1)
+	require.True(t, found)
+	require.Equal(t, packet, inFlight.OriginalPacket)
+	require.Equal(t, receiver.String(), inFlight.IntermediateReceiver)
+}
+
+func TestOnRecvPacketForwardFailure(t *testing.T) {
+	testCases := []struct {
+		name     string
+		memo     string
+		malleate func(app *mockTransferApp, transferKeeper *mockTransferKeeper)
+		expRecv  bool
+	}{
+		{
+			"invalid forward memo",
+			`{"forward":{"receiver":"cosmos1final","port":"transfer"}}`,
+			func(*mockTransferApp, *mockTransferKeeper) {},
+			false,
+		},
+		{
+			"receive failed",
+			forwardMemo,
+			func(app *mockTransferApp, _ *mockTransferKeeper) {
+				app.ack = channeltypes.NewErrorAcknowledgement("receive failed")
+			},
+			true,
+		},
+		{
+			"forward failed",
+			forwardMemo,
+			func(_ *mockTransferApp, transferKeeper *mockTransferKeeper) {
+				transferKeeper.err = errors.New("channel closed")
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx, middleware, app, transferKeeper, k := setupMiddleware(t)
+		tc.malleate(app, transferKeeper)
+
+		// the error is acknowledged right away, which reverts the receive
+		ack := middleware.OnRecvPacket(ctx, transferPacket(tc.memo), nil)
+		require.NotNil(t, ack, tc.name)
+		require.False(t, ack.Success(), tc.name)
+		require.Equal(t, tc.expRecv, len(app.received) == 1, tc.name)
+
+		_, found := k.GetInFlightPacket(ctx, "transfer", "channel-7", 1)
+		require.False(t, found, tc.name)
+	}
+}
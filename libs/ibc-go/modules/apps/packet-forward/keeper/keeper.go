@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/packet-forward/types"
+	transfertypes "github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/okex/exchain/libs/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/okex/exchain/libs/ibc-go/modules/core/04-channel/types"
+	host "github.com/okex/exchain/libs/ibc-go/modules/core/24-host"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+)
+
+// Keeper defines the packet forward middleware keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+
+	transferKeeper types.TransferKeeper
+	channelKeeper  types.ChannelKeeper
+	ics4Wrapper    types.ICS4Wrapper
+	bankKeeper     types.BankKeeper
+}
+
+// NewKeeper creates a new packet forward Keeper instance. The acknowledgements
+// of the forwarded packets are written with the ics4Wrapper, which is the
+// middleware wrapping the packet forward middleware or core IBC.
+func NewKeeper(
+	key sdk.StoreKey, transferKeeper types.TransferKeeper, channelKeeper types.ChannelKeeper,
+	ics4Wrapper types.ICS4Wrapper, bankKeeper types.BankKeeper,
+) Keeper {
+	return Keeper{
+		storeKey:       key,
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+		ics4Wrapper:    ics4Wrapper,
+		bankKeeper:     bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// ForwardTransferPacket sends the token received in srcPacket to the next hop
+// described by metadata and records the forwarded packet as in flight. The
+// acknowledgement of srcPacket is written once the forwarded packet is
+// acknowledged or has finally timed out.
+func (k Keeper) ForwardTransferPacket(
+	ctx sdk.Context,
+	srcPacket channeltypes.Packet,
+	srcData transfertypes.FungibleTokenPacketData,
+	metadata types.ForwardMetadata,
+	token sdk.CoinAdapter,
+) error {
+	timeout, err := metadata.GetTimeout()
+	if err != nil {
+		return err
+	}
+
+	inFlight := types.InFlightPacket{
+		OriginalPacket:       srcPacket,
+		OriginalSender:       srcData.Sender,
+		IntermediateReceiver: srcData.Receiver,
+		ForwardPort:          metadata.Port,
+		ForwardChannel:       metadata.Channel,
+		FinalReceiver:        metadata.Receiver,
+		Memo:                 metadata.GetNextMemo(),
+		Denom:                token.Denom,
+		Amount:               token.Amount.String(),
+		RetriesRemaining:     metadata.GetRetries(),
+		Timeout:              uint64(timeout.Nanoseconds()),
+	}
+	return k.sendInFlightPacket(ctx, inFlight)
+}
+
+// HandleAcknowledgement acknowledges the original packet of an acknowledged
+// forwarded packet. On error acknowledgements the transfer module has already
+// refunded the intermediate receiver, so the received funds are taken back
+// from it before the error is acknowledged to refund the original sender.
+func (k Keeper) HandleAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	inFlight, found := k.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.DeleteInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if errAck, ok := ack.Response.(*channeltypes.Acknowledgement_Error); ok {
+		return k.refundForward(ctx, inFlight, errAck.Error)
+	}
+	return k.writeAcknowledgement(ctx, inFlight, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+}
+
+// HandleTimeout resends a timed out forwarded packet as long as retries
+// remain. The transfer module has already refunded the intermediate receiver,
+// so the resend is funded from that account again. Once no retries remain or
+// the resend fails, the original sender is refunded.
+func (k Keeper) HandleTimeout(ctx sdk.Context, packet channeltypes.Packet) error {
+	inFlight, found := k.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.DeleteInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if inFlight.RetriesRemaining == 0 {
+		return k.refundForward(ctx, inFlight, "packet timed out and no retries remain")
+	}
+	inFlight.RetriesRemaining--
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacketForwardRetry,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyFinalReceiver, inFlight.FinalReceiver),
+			sdk.NewAttribute(types.AttributeKeyRetriesRemaining, fmt.Sprintf("%d", inFlight.RetriesRemaining)),
+		),
+	)
+
+	// a failing resend must not revert the refund of the timeout
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.sendInFlightPacket(cacheCtx, inFlight); err != nil {
+		k.Logger(ctx).Error("failed to resend forwarded packet", "error", err)
+		return k.refundForward(ctx, inFlight, err.Error())
+	}
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeFn()
+	return nil
+}
+
+func (k Keeper) sendInFlightPacket(ctx sdk.Context, inFlight types.InFlightPacket) error {
+	sender, err := sdk.AccAddressFromBech32(inFlight.IntermediateReceiver)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidForwardMetadata, "invalid intermediate receiver: %s", err)
+	}
+	amount, ok := sdk.NewIntFromString(inFlight.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse forward amount (%s) into sdk.Int", inFlight.Amount)
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, inFlight.ForwardPort, inFlight.ForwardChannel)
+	if !found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", inFlight.ForwardPort, inFlight.ForwardChannel,
+		)
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + inFlight.Timeout
+	token := sdk.CoinAdapter{Denom: inFlight.Denom, Amount: amount}
+	if err := k.transferKeeper.SendTransfer(
+		ctx, inFlight.ForwardPort, inFlight.ForwardChannel, token,
+		sender, inFlight.FinalReceiver, clienttypes.ZeroHeight(), timeoutTimestamp, inFlight.Memo,
+	); err != nil {
+		return sdkerrors.Wrap(types.ErrForwardTransfer, err.Error())
+	}
+
+	k.SetInFlightPacket(ctx, inFlight.ForwardPort, inFlight.ForwardChannel, sequence, inFlight)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacketForward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyIntermediateReceiver, inFlight.IntermediateReceiver),
+			sdk.NewAttribute(types.AttributeKeyFinalReceiver, inFlight.FinalReceiver),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, inFlight.ForwardPort),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, inFlight.ForwardChannel),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", sequence)),
+		),
+	)
+	return nil
+}
+
+// refundForward reverts the receive of the original packet, which credited
+// the intermediate receiver, and acknowledges it with an error so that the
+// previous chain refunds the original sender.
+func (k Keeper) refundForward(ctx sdk.Context, inFlight types.InFlightPacket, reason string) error {
+	intermediate, err := sdk.AccAddressFromBech32(inFlight.IntermediateReceiver)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidForwardMetadata, "invalid intermediate receiver: %s", err)
+	}
+	amount, ok := sdk.NewIntFromString(inFlight.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse forward amount (%s) into sdk.Int", inFlight.Amount)
+	}
+	denom := inFlight.Denom
+	if denom == sdk.DefaultIbcWei {
+		denom = sdk.DefaultBondDenom
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewDecFromIntWithPrec(amount, sdk.Precision)))
+
+	original := inFlight.OriginalPacket
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(original.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err)
+	}
+	if transfertypes.ReceiverChainIsSource(original.GetSourcePort(), original.GetSourceChannel(), data.Denom) {
+		// the tokens were unescrowed on receive, so they are escrowed again
+		escrowAddress := transfertypes.GetEscrowAddress(original.GetDestPort(), original.GetDestChannel())
+		if err := k.bankKeeper.SendCoins(ctx, intermediate, escrowAddress, coins); err != nil {
+			return sdkerrors.Wrap(err, "unable to escrow the refunded tokens")
+		}
+	} else {
+		// the vouchers were minted on receive, so they are burnt again
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, intermediate, transfertypes.ModuleName, coins); err != nil {
+			return sdkerrors.Wrap(err, "unable to take back the refunded vouchers")
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins); err != nil {
+			return sdkerrors.Wrap(err, "unable to burn the refunded vouchers")
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacketForwardFailure,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyIntermediateReceiver, inFlight.IntermediateReceiver),
+			sdk.NewAttribute(types.AttributeKeyFinalReceiver, inFlight.FinalReceiver),
+			sdk.NewAttribute(types.AttributeKeyError, reason),
+		),
+	)
+	return k.writeAcknowledgement(ctx, inFlight,
+		channeltypes.NewErrorAcknowledgementV4(sdkerrors.Wrap(types.ErrForwardTransfer, reason)))
+}
+
+func (k Keeper) writeAcknowledgement(ctx sdk.Context, inFlight types.InFlightPacket, ack channeltypes.Acknowledgement) error {
+	original := inFlight.OriginalPacket
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, original.GetDestPort(), original.GetDestChannel())
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not retrieve the channel capability of the original packet")
+	}
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, original, ack)
+}
+
+// GetInFlightPacket returns the in flight packet sent on the given port, channel and sequence
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RefundPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.InFlightPacket{}, false
+	}
+	var inFlight types.InFlightPacket
+	types.ModuleCdc.MustUnmarshalBinaryBare(bz, &inFlight)
+	return inFlight, true
+}
+
+// SetInFlightPacket stores the in flight packet sent on the given port, channel and sequence
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64, inFlight types.InFlightPacket) {
+	bz := types.ModuleCdc.MustMarshalBinaryBare(inFlight)
+	ctx.KVStore(k.storeKey).Set(types.RefundPacketKey(portID, channelID, sequence), bz)
+}
+
+// DeleteInFlightPacket removes the in flight packet sent on the given port, channel and sequence
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.RefundPacketKey(portID, channelID, sequence))
+}
@@ -0,0 +1,276 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/store"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	capabilitytypes "github.com/okex/exchain/libs/cosmos-sdk/x/capability/types"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/packet-forward/keeper"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/packet-forward/types"
+	transfertypes "github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/okex/exchain/libs/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/okex/exchain/libs/ibc-go/modules/core/04-channel/types"
+	"github.com/okex/exchain/libs/ibc-go/modules/core/exported"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	dbm "github.com/okex/exchain/libs/tm-db"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	forwardChannel = "channel-7"
+	nextSequence   = uint64(5)
+)
+
+var (
+	sender       = sdk.AccAddress([]byte("sender______________"))
+	intermediate = sdk.AccAddress([]byte("intermediate________"))
+)
+
+type sentTransfer struct {
+	channel  string
+	token    sdk.CoinAdapter
+	sender   sdk.AccAddress
+	receiver string
+	timeout  uint64
+	memo     string
+}
+
+type mockTransferKeeper struct {
+	err  error
+	sent []sentTransfer
+}
+
+func (m *mockTransferKeeper) SendTransfer(_ sdk.Context, _, sourceChannel string, token sdk.CoinAdapter, sender sdk.AccAddress,
+	receiver string, _ clienttypes.Height, timeoutTimestamp uint64, memo string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, sentTransfer{sourceChannel, token, sender, receiver, timeoutTimestamp, memo})
+	return nil
+}
+
+type mockChannelKeeper struct{}
+
+func (mockChannelKeeper) GetNextSequenceSend(_ sdk.Context, _, _ string) (uint64, bool) {
+	return nextSequence, true
+}
+
+func (mockChannelKeeper) LookupModuleByChannel(_ sdk.Context, _, _ string) (string, *capabilitytypes.Capability, error) {
+	return transfertypes.ModuleName, capabilitytypes.NewCapability(1), nil
+}
+
+type mockICS4Wrapper struct {
+	packets []exported.PacketI
+	acks    []exported.Acknowledgement
+}
+
+func (m *mockICS4Wrapper) WriteAcknowledgement(_ sdk.Context, _ *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	m.packets = append(m.packets, packet)
+	m.acks = append(m.acks, ack)
+	return nil
+}
+
+type mockBankKeeper struct {
+	sent   map[string]sdk.Coins
+	burnt  sdk.Coins
+	module sdk.Coins
+}
+
+func (m *mockBankKeeper) SendCoins(_ sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	m.sent[fromAddr.String()+">"+toAddr.String()] = amt
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(_ sdk.Context, _ string, amt sdk.Coins) error {
+	m.burnt = amt
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ sdk.Context, _ sdk.AccAddress, _ string, amt sdk.Coins) error {
+	m.module = amt
+	return nil
+}
+
+type testKeeper struct {
+	keeper.Keeper
+	transfer *mockTransferKeeper
+	ics4     *mockICS4Wrapper
+	bank     *mockBankKeeper
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, testKeeper) {
+	key := sdk.NewKVStoreKey("transfer")
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{Height: 1, Time: time.Unix(1000, 0).UTC()}, false, log.NewNopLogger())
+	tk := testKeeper{
+		transfer: &mockTransferKeeper{},
+		ics4:     &mockICS4Wrapper{},
+		bank:     &mockBankKeeper{sent: map[string]sdk.Coins{}},
+	}
+	tk.Keeper = keeper.NewKeeper(key, tk.transfer, mockChannelKeeper{}, tk.ics4, tk.bank)
+	return ctx, tk
+}
+
+// receivedPacket returns a packet received on transfer/channel-1 from
+// transfer/channel-0 carrying one token of the given denom
+func receivedPacket(denom string) (channeltypes.Packet, transfertypes.FungibleTokenPacketData) {
+	data := transfertypes.NewFungibleTokenPacketData(denom, "1000000000000000000", sender.String(), intermediate.String())
+	packet := channeltypes.NewPacket(data.GetBytes(), 3, "transfer", "channel-0", "transfer", "channel-1",
+		clienttypes.NewHeight(0, 100), 0)
+	return packet, data
+}
+
+func forward(t *testing.T, ctx sdk.Context, k testKeeper, denom, memo string) channeltypes.Packet {
+	packet, data := receivedPacket(denom)
+	metadata, ok, err := types.ParseForwardMemo(memo)
+	require.True(t, ok)
+	require.NoError(t, err)
+
+	received := transfertypes.ReceivedDenom(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+	amount, _ := sdk.NewIntFromString(data.Amount)
+	require.NoError(t, k.ForwardTransferPacket(ctx, packet, data, metadata, sdk.CoinAdapter{Denom: received, Amount: amount}))
+	return packet
+}
+
+func forwardedPacket() channeltypes.Packet {
+	return channeltypes.NewPacket(nil, nextSequence, "transfer", forwardChannel, "transfer", "channel-9", clienttypes.ZeroHeight(), 0)
+}
+
+func TestForwardTransferPacket(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	original := forward(t, ctx, k, "uatom",
+		`{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"channel-7","timeout":"1m","retries":1,"next":{"forward":{"receiver":"osmo1final","port":"transfer","channel":"channel-2"}}}}`)
+
+	require.Len(t, k.transfer.sent, 1)
+	sent := k.transfer.sent[0]
+	require.Equal(t, forwardChannel, sent.channel)
+	require.Equal(t, intermediate, sent.sender)
+	require.Equal(t, "cosmos1final", sent.receiver)
+	require.Equal(t, uint64(ctx.BlockTime().UnixNano())+uint64(time.Minute), sent.timeout)
+	require.Equal(t, `{"forward":{"receiver":"osmo1final","port":"transfer","channel":"channel-2"}}`, sent.memo)
+	require.Equal(t, transfertypes.ParseDenomTrace("transfer/channel-1/uatom").IBCDenom(), sent.token.Denom)
+
+	inFlight, found := k.GetInFlightPacket(ctx, "transfer", forwardChannel, nextSequence)
+	require.True(t, found)
+	require.Equal(t, original, inFlight.OriginalPacket)
+	require.Equal(t, uint8(1), inFlight.RetriesRemaining)
+
+	// nothing is acknowledged before the forwarded packet is
+	require.Empty(t, k.ics4.acks)
+}
+
+func TestForwardTransferPacketDefaults(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	forward(t, ctx, k, "uatom", `{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"channel-7"}}`)
+
+	require.Len(t, k.transfer.sent, 1)
+	require.Equal(t, uint64(ctx.BlockTime().UnixNano())+uint64(types.DefaultForwardTransferPacketTimeoutTimestamp), k.transfer.sent[0].timeout)
+	require.Equal(t, "", k.transfer.sent[0].memo)
+	inFlight, found := k.GetInFlightPacket(ctx, "transfer", forwardChannel, nextSequence)
+	require.True(t, found)
+	require.Equal(t, types.DefaultRetriesOnTimeout, inFlight.RetriesRemaining)
+}
+
+func TestForwardTransferPacketFailure(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	k.transfer.err = errors.New("channel closed")
+
+	packet, data := receivedPacket("uatom")
+	metadata, _, err := types.ParseForwardMemo(`{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"channel-7"}}`)
+	require.NoError(t, err)
+	err = k.ForwardTransferPacket(ctx, packet, data, metadata, sdk.CoinAdapter{Denom: "uatom", Amount: sdk.NewInt(1)})
+	require.ErrorIs(t, err, types.ErrForwardTransfer)
+
+	_, found := k.GetInFlightPacket(ctx, "transfer", forwardChannel, nextSequence)
+	require.False(t, found)
+}
+
+func TestHandleAcknowledgementSuccess(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	original := forward(t, ctx, k, "uatom", `{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"channel-7"}}`)
+
+	require.NoError(t, k.HandleAcknowledgement(ctx, forwardedPacket(), channeltypes.NewResultAcknowledgement([]byte{byte(1)})))
+
+	require.Len(t, k.ics4.acks, 1)
+	require.Equal(t, original, k.ics4.packets[0])
+	require.True(t, k.ics4.acks[0].Success())
+	require.Nil(t, k.bank.burnt)
+	_, found := k.GetInFlightPacket(ctx, "transfer", forwardChannel, nextSequence)
+	require.False(t, found)
+
+	// acknowledgements of packets which were not forwarded are ignored
+	require.NoError(t, k.HandleAcknowledgement(ctx, forwardedPacket(), channeltypes.NewResultAcknowledgement([]byte{byte(1)})))
+	require.Len(t, k.ics4.acks, 1)
+}
+
+func TestHandleAcknowledgementError(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	original := forward(t, ctx, k, "uatom", `{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"channel-7"}}`)
+
+	require.NoError(t, k.HandleAcknowledgement(ctx, forwardedPacket(), channeltypes.NewErrorAcknowledgement("receiver denied")))
+
+	// the vouchers minted on receive are burnt again
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-1/uatom").IBCDenom()
+	expCoins := sdk.NewCoins(sdk.NewCoin(voucher, sdk.NewDec(1)))
+	require.Equal(t, expCoins, k.bank.module)
+	require.Equal(t, expCoins, k.bank.burnt)
+
+	// the error is acknowledged so that the original sender is refunded
+	require.Len(t, k.ics4.acks, 1)
+	require.Equal(t, original, k.ics4.packets[0])
+	require.False(t, k.ics4.acks[0].Success())
+}
+
+func TestHandleTimeout(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	original := forward(t, ctx, k, "transfer/channel-0/okt",
+		`{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"channel-7","retries":1}}`)
+
+	// the first timeout resends the packet
+	require.NoError(t, k.HandleTimeout(ctx, forwardedPacket()))
+	require.Len(t, k.transfer.sent, 2)
+	require.Equal(t, k.transfer.sent[0].receiver, k.transfer.sent[1].receiver)
+	inFlight, found := k.GetInFlightPacket(ctx, "transfer", forwardChannel, nextSequence)
+	require.True(t, found)
+	require.Equal(t, uint8(0), inFlight.RetriesRemaining)
+	require.Empty(t, k.ics4.acks)
+
+	// the second one refunds the original sender
+	require.NoError(t, k.HandleTimeout(ctx, forwardedPacket()))
+	require.Len(t, k.transfer.sent, 2)
+	_, found = k.GetInFlightPacket(ctx, "transfer", forwardChannel, nextSequence)
+	require.False(t, found)
+
+	// the native tokens unescrowed on receive are escrowed again
+	escrow := transfertypes.GetEscrowAddress("transfer", "channel-1")
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("okt", sdk.NewDec(1))), k.bank.sent[intermediate.String()+">"+escrow.String()])
+	require.Nil(t, k.bank.burnt)
+
+	require.Len(t, k.ics4.acks, 1)
+	require.Equal(t, original, k.ics4.packets[0])
+	require.False(t, k.ics4.acks[0].Success())
+}
+
+func TestHandleTimeoutResendFailure(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	forward(t, ctx, k, "uatom", `{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"channel-7"}}`)
+
+	k.transfer.err = errors.New("channel closed")
+	require.NoError(t, k.HandleTimeout(ctx, forwardedPacket()))
+
+	_, found := k.GetInFlightPacket(ctx, "transfer", forwardChannel, nextSequence)
+	require.False(t, found)
+	require.NotNil(t, k.bank.burnt)
+	require.Len(t, k.ics4.acks, 1)
+	require.False(t, k.ics4.acks[0].Success())
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+)
+
+// ModuleCdc is the codec used to persist in flight packets of the middleware
+var ModuleCdc = codec.New()
+
+func init() {
+	ModuleCdc.Seal()
+}
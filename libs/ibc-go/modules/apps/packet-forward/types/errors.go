@@ -0,0 +1,9 @@
+package types
+
+import sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+
+// packet forward middleware sentinel errors
+var (
+	ErrInvalidForwardMetadata = sdkerrors.Register(ModuleName, 2, "invalid forward metadata")
+	ErrForwardTransfer        = sdkerrors.Register(ModuleName, 3, "failed to forward transfer packet")
+)
@@ -0,0 +1,16 @@
+package types
+
+// packet forward middleware events
+const (
+	EventTypePacketForward        = "packet_forward"
+	EventTypePacketForwardRetry   = "packet_forward_retry"
+	EventTypePacketForwardFailure = "packet_forward_failure"
+
+	AttributeKeyIntermediateReceiver = "intermediate_receiver"
+	AttributeKeyFinalReceiver        = "final_receiver"
+	AttributeKeyForwardPort          = "forward_port"
+	AttributeKeyForwardChannel       = "forward_channel"
+	AttributeKeyForwardSequence      = "forward_sequence"
+	AttributeKeyRetriesRemaining     = "retries_remaining"
+	AttributeKeyError                = "error"
+)
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	host "github.com/okex/exchain/libs/ibc-go/modules/core/24-host"
+)
+
+// PacketMetadata is the memo of an ICS-20 packet. Only the forward key is
+// interpreted by the middleware, any other keys are left to other applications.
+type PacketMetadata struct {
+	Forward *ForwardMetadata `json:"forward,omitempty"`
+}
+
+// ForwardMetadata describes the next hop of a transfer that has to be
+// forwarded by this chain. The receiver of the packet on this chain receives
+// the funds before they are forwarded, and it is also the account the funds
+// are taken back from when the forward eventually fails.
+type ForwardMetadata struct {
+	// the receiver on the next chain
+	Receiver string `json:"receiver"`
+	Port     string `json:"port"`
+	Channel  string `json:"channel"`
+	// the relative timeout of the forwarded packet, e.g. "10m"
+	Timeout string `json:"timeout,omitempty"`
+	// the number of times the forwarded packet is resent after timing out
+	Retries *uint8 `json:"retries,omitempty"`
+	// the memo of the forwarded packet, which may request further forward hops
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// ParseForwardMemo parses the memo of an ICS-20 packet. Memos of the form
+//
+//	{"forward":{"receiver":"...","port":"transfer","channel":"channel-1","timeout":"10m","retries":2,"next":{...}}}
+//
+// are requested to be forwarded. The next memo is passed as is to the next
+// chain, so multi-hop transfers are expressed by nesting forward memos.
+// The boolean result is false if the memo does not request forwarding.
+func ParseForwardMemo(memo string) (ForwardMetadata, bool, error) {
+	if !strings.HasPrefix(strings.TrimSpace(memo), "{") {
+		return ForwardMetadata{}, false, nil
+	}
+
+	var metadata PacketMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil || metadata.Forward == nil {
+		// the memo is addressed to another application
+		return ForwardMetadata{}, false, nil
+	}
+	return *metadata.Forward, true, metadata.Forward.Validate()
+}
+
+// Validate performs a stateless check of the forward metadata
+func (m ForwardMetadata) Validate() error {
+	if strings.TrimSpace(m.Receiver) == "" {
+		return sdkerrors.Wrap(ErrInvalidForwardMetadata, "receiver cannot be blank")
+	}
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid forward port: %s", err)
+	}
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid forward channel: %s", err)
+	}
+	if _, err := m.GetTimeout(); err != nil {
+		return err
+	}
+	if len(m.Next) != 0 && !json.Valid(m.Next) {
+		return sdkerrors.Wrap(ErrInvalidForwardMetadata, "next memo is not valid json")
+	}
+	return nil
+}
+
+// GetTimeout returns the relative timeout of the forwarded packet, which
+// defaults to DefaultForwardTransferPacketTimeoutTimestamp.
+func (m ForwardMetadata) GetTimeout() (time.Duration, error) {
+	if m.Timeout == "" {
+		return DefaultForwardTransferPacketTimeoutTimestamp, nil
+	}
+	timeout, err := time.ParseDuration(m.Timeout)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid timeout: %s", err)
+	}
+	if timeout <= 0 {
+		return 0, sdkerrors.Wrapf(ErrInvalidForwardMetadata, "timeout must be positive, got %s", m.Timeout)
+	}
+	return timeout, nil
+}
+
+// GetRetries returns the number of times the forwarded packet is resent after
+// timing out, which defaults to DefaultRetriesOnTimeout.
+func (m ForwardMetadata) GetRetries() uint8 {
+	if m.Retries == nil {
+		return DefaultRetriesOnTimeout
+	}
+	return *m.Retries
+}
+
+// GetNextMemo returns the memo of the forwarded packet
+func (m ForwardMetadata) GetNextMemo() string {
+	if len(m.Next) == 0 {
+		return ""
+	}
+	return string(m.Next)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseForwardMemo tests parsing of forward requests from the ICS-20 memo
+func TestParseForwardMemo(t *testing.T) {
+	two := uint8(2)
+	testCases := []struct {
+		name       string
+		memo       string
+		expForward bool
+		expPass    bool
+		expResult  ForwardMetadata
+	}{
+		{"empty memo", "", false, true, ForwardMetadata{}},
+		{"plain memo", "hello", false, true, ForwardMetadata{}},
+		{"memo of another application", `{"wasm":{"contract":"ex1contract"}}`, false, true, ForwardMetadata{}},
+		{"single hop", `{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"channel-1"}}`, true, true,
+			ForwardMetadata{Receiver: "cosmos1final", Port: "transfer", Channel: "channel-1"}},
+		{"timeout and retries", `{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"channel-1","timeout":"1h","retries":2}}`, true, true,
+			ForwardMetadata{Receiver: "cosmos1final", Port: "transfer", Channel: "channel-1", Timeout: "1h", Retries: &two}},
+		{"multi hop", `{"forward":{"receiver":"cosmos1next","port":"transfer","channel":"channel-1","next":{"forward":{"receiver":"osmo1final","port":"transfer","channel":"channel-7"}}}}`, true, true,
+			ForwardMetadata{Receiver: "cosmos1next", Port: "transfer", Channel: "channel-1",
+				Next: json.RawMessage(`{"forward":{"receiver":"osmo1final","port":"transfer","channel":"channel-7"}}`)}},
+		{"missing receiver", `{"forward":{"port":"transfer","channel":"channel-1"}}`, true, false, ForwardMetadata{}},
+		{"missing channel", `{"forward":{"receiver":"cosmos1final","port":"transfer"}}`, true, false, ForwardMetadata{}},
+		{"invalid channel", `{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"c"}}`, true, false, ForwardMetadata{}},
+		{"invalid timeout", `{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"channel-1","timeout":"soon"}}`, true, false, ForwardMetadata{}},
+		{"negative timeout", `{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"channel-1","timeout":"-1m"}}`, true, false, ForwardMetadata{}},
+	}
+
+	for _, tc := range testCases {
+		metadata, forward, err := ParseForwardMemo(tc.memo)
+		require.Equal(t, tc.expForward, forward, tc.name)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expResult, metadata, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+// TestForwardMetadataDefaults tests the defaults of the per packet forward settings
+func TestForwardMetadataDefaults(t *testing.T) {
+	metadata := ForwardMetadata{Receiver: "cosmos1final", Port: "transfer", Channel: "channel-1"}
+	timeout, err := metadata.GetTimeout()
+	require.NoError(t, err)
+	require.Equal(t, DefaultForwardTransferPacketTimeoutTimestamp, timeout)
+	require.Equal(t, DefaultRetriesOnTimeout, metadata.GetRetries())
+	require.Equal(t, "", metadata.GetNextMemo())
+
+	zero := uint8(0)
+	metadata.Timeout, metadata.Retries = "90s", &zero
+	timeout, err = metadata.GetTimeout()
+	require.NoError(t, err)
+	require.Equal(t, 90*time.Second, timeout)
+	require.Equal(t, uint8(0), metadata.GetRetries())
+}
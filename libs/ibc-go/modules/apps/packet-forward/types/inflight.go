@@ -0,0 +1,29 @@
+package types
+
+import (
+	channeltypes "github.com/okex/exchain/libs/ibc-go/modules/core/04-channel/types"
+)
+
+// InFlightPacket records a packet forwarded to the next hop which has not been
+// acknowledged yet, together with everything required to resend it and to
+// acknowledge the packet it was received with.
+type InFlightPacket struct {
+	// the packet received by this chain, whose acknowledgement is written
+	// once the forwarded packet is acknowledged
+	OriginalPacket channeltypes.Packet `json:"original_packet"`
+	// the original sender on the previous chain
+	OriginalSender string `json:"original_sender"`
+
+	IntermediateReceiver string `json:"intermediate_receiver"`
+	ForwardPort          string `json:"forward_port"`
+	ForwardChannel       string `json:"forward_channel"`
+	FinalReceiver        string `json:"final_receiver"`
+	Memo                 string `json:"memo"`
+
+	// the denom and amount as received by this chain and sent to the next hop
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+
+	RetriesRemaining uint8  `json:"retries_remaining"`
+	Timeout          uint64 `json:"timeout"`
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// ModuleName defines the packet forward middleware name
+	ModuleName = "packetforward"
+
+	// DefaultForwardTransferPacketTimeoutTimestamp is the timeout applied to
+	// forwarded packets whose forward memo doesn't set one.
+	DefaultForwardTransferPacketTimeoutTimestamp = time.Duration(10) * time.Minute
+
+	// DefaultRetriesOnTimeout is the number of times a forwarded packet is
+	// resent after it has timed out on the next hop, unless the forward memo
+	// sets it.
+	DefaultRetriesOnTimeout uint8 = 3
+)
+
+var (
+	// InFlightPacketKey defines the key prefix for forwarded packets that have not
+	// been acknowledged yet. The middleware shares the transfer module store, so the
+	// prefix is chosen to stay clear of the transfer keys (0x01, 0x02).
+	InFlightPacketKey = []byte{0x10}
+)
+
+// RefundPacketKey returns the store key under which the in flight packet
+// sent on the given port, channel and sequence is kept.
+func RefundPacketKey(portID, channelID string, sequence uint64) []byte {
+	return append(InFlightPacketKey, []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))...)
+}
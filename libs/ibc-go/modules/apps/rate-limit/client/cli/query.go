@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit/types"
+	"github.com/spf13/cobra"
+)
+
+// RateLimitWithFlow is a rate limit together with the flow tracked in its window
+type RateLimitWithFlow struct {
+	RateLimit types.RateLimit `json:"rate_limit"`
+	Flow      *types.Flow     `json:"flow,omitempty"`
+}
+
+// GetCmdQueryRateLimit defines the command to query the rate limit of a denom on a channel
+// together with the flow tracked in its rolling window.
+func GetCmdQueryRateLimit(m *codec.CodecProxy) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limit [channel-id] [denom]",
+		Short:   "Query the transfer rate limit of a denom on a channel",
+		Long:    "Query the governance configured transfer rate limit of a denom on a channel together with the flow tracked in its rolling window",
+		Example: fmt.Sprintf("%s query ibc-transfer rate-limit channel-0 okt", version.ServerName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := context.NewCLIContext().WithCodec(m.GetCdc())
+
+			bz, _, err := clientCtx.QueryStore(types.RateLimitStoreKey(args[0], args[1]), types.QuerierRoute)
+			if err != nil {
+				return err
+			}
+			if len(bz) == 0 {
+				return fmt.Errorf("no rate limit of %s on %s", args[1], args[0])
+			}
+
+			var res RateLimitWithFlow
+			types.ModuleCdc.MustUnmarshalBinaryBare(bz, &res.RateLimit)
+
+			bz, _, err = clientCtx.QueryStore(types.FlowStoreKey(args[0], args[1]), types.QuerierRoute)
+			if err != nil {
+				return err
+			}
+			if len(bz) != 0 {
+				var flow types.Flow
+				types.ModuleCdc.MustUnmarshalBinaryBare(bz, &flow)
+				res.Flow = &flow
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRateLimits defines the command to query all the transfer rate limits.
+func GetCmdQueryRateLimits(m *codec.CodecProxy) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits",
+		Short:   "Query all the transfer rate limits",
+		Long:    "Query all the governance configured transfer rate limits",
+		Example: fmt.Sprintf("%s query ibc-transfer rate-limits", version.ServerName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := context.NewCLIContext().WithCodec(m.GetCdc())
+
+			kvs, _, err := clientCtx.QuerySubspace(types.RateLimitKey, types.QuerierRoute)
+			if err != nil {
+				return err
+			}
+
+			rateLimits := make([]types.RateLimit, len(kvs))
+			for i, kv := range kvs {
+				types.ModuleCdc.MustUnmarshalBinaryBare(kv.Value, &rateLimits[i])
+			}
+
+			return clientCtx.PrintOutput(rateLimits)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	interfacetypes "github.com/okex/exchain/libs/cosmos-sdk/codec/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	rlutils "github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit/client/utils"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
+	"github.com/spf13/cobra"
+)
+
+// GetCmdManageRateLimitProposal implements a command handler for submitting a manage rate limit
+// proposal transaction
+func GetCmdManageRateLimitProposal(cdcP *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-ibc-rate-limit [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal setting or removing the ibc transfer rate limit of a denom on a channel",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal setting or removing the ibc transfer rate limit of a denom on a channel
+along with an initial deposit. The proposal details must be supplied via a JSON file.
+The quotas are the amounts allowed to leave (max_outflow) and enter (max_inflow) the chain
+through the channel within the window, given in nanoseconds. A zero quota leaves the direction
+unlimited. Removing a rate limit only requires the channel and denom.
+
+Example:
+$ %s tx gov submit-proposal update-ibc-rate-limit <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "limit okt transfers on channel-0",
+  "description": "cap the okt leaving and entering the chain through channel-0 per day",
+  "rate_limit": {
+    "channel_id": "channel-0",
+    "denom": "%s",
+    "max_outflow": "100000.000000000000000000",
+    "max_inflow": "100000.000000000000000000",
+    "window": "86400000000000"
+  },
+  "is_added": true,
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "100.000000000000000000"
+    }
+  ]
+}
+`, version.ClientName, sdk.DefaultBondDenom, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cdc := cdcP.GetCdc()
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := rlutils.ParseManageRateLimitProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewManageRateLimitProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.RateLimit,
+				proposal.IsAdded,
+			)
+
+			err = content.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
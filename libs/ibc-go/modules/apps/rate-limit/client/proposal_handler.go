@@ -0,0 +1,24 @@
+package client
+
+import (
+	"net/http"
+
+	cliContext "github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/rest"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit/client/cli"
+	govclient "github.com/okex/exchain/x/gov/client"
+	govrest "github.com/okex/exchain/x/gov/client/rest"
+)
+
+var (
+	ManageRateLimitProposalHandler = govclient.NewProposalHandler(cli.GetCmdManageRateLimitProposal, emptyRestHandler)
+)
+
+func emptyRestHandler(ctx cliContext.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-ibc-rate-limit",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for IBC proposals")
+		},
+	}
+}
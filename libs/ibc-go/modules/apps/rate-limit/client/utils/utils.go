@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit/types"
+)
+
+// ManageRateLimitProposalJSON defines a ManageRateLimitProposal with a deposit used to parse
+// manage rate limit proposals from a JSON file.
+type ManageRateLimitProposalJSON struct {
+	Title       string          `json:"title" yaml:"title"`
+	Description string          `json:"description" yaml:"description"`
+	RateLimit   types.RateLimit `json:"rate_limit" yaml:"rate_limit"`
+	IsAdded     bool            `json:"is_added" yaml:"is_added"`
+	Deposit     sdk.SysCoins    `json:"deposit" yaml:"deposit"`
+}
+
+// ParseManageRateLimitProposalJSON parses json from proposal file to ManageRateLimitProposalJSON struct
+func ParseManageRateLimitProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal ManageRateLimitProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	err = cdc.UnmarshalJSON(contents, &proposal)
+	return
+}
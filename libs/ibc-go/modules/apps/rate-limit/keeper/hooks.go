@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	transfertypes "github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
+)
+
+var _ transfertypes.TransferHooks = Hooks{}
+
+// Hooks enforces the rate limits on the transfers of the transfer module.
+// Failing a send reverts the transfer, failing a receive writes an error
+// acknowledgement, so the counterparty refunds the sender.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the transfer hooks enforcing the rate limits
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterSendTransfer implements transfertypes.TransferHooks
+func (h Hooks) AfterSendTransfer(
+	ctx sdk.Context,
+	sourcePort, sourceChannel string,
+	token sdk.SysCoin,
+	sender sdk.AccAddress,
+	receiver string,
+	isSource bool) error {
+	return h.k.CheckAndUpdateOutflow(ctx, sourceChannel, token.Denom, token.Amount)
+}
+
+// AfterRecvTransfer implements transfertypes.TransferHooks
+func (h Hooks) AfterRecvTransfer(
+	ctx sdk.Context,
+	destPort, destChannel string,
+	token sdk.SysCoin,
+	receiver string,
+	isSource bool) error {
+	return h.k.CheckAndUpdateInflow(ctx, destChannel, token.Denom, token.Amount)
+}
+
+// AfterRefundTransfer implements transfertypes.TransferHooks
+func (h Hooks) AfterRefundTransfer(
+	ctx sdk.Context,
+	sourcePort, sourceChannel string,
+	token sdk.SysCoin,
+	sender string,
+	isSource bool) error {
+	h.k.UndoOutflow(ctx, sourceChannel, token.Denom, token.Amount)
+	return nil
+}
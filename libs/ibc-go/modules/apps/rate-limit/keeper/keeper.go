@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit/types"
+	host "github.com/okex/exchain/libs/ibc-go/modules/core/24-host"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+)
+
+// Keeper defines the IBC transfer rate limit keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+}
+
+// NewKeeper creates a new rate limit Keeper instance
+func NewKeeper(key sdk.StoreKey) Keeper {
+	return Keeper{
+		storeKey: key,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetRateLimit returns the rate limit of a denom on a channel
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RateLimitStoreKey(channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	types.ModuleCdc.MustUnmarshalBinaryBare(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// SetRateLimit stores the rate limit of a denom on a channel
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	ctx.KVStore(k.storeKey).Set(
+		types.RateLimitStoreKey(rateLimit.ChannelID, rateLimit.Denom),
+		types.ModuleCdc.MustMarshalBinaryBare(rateLimit),
+	)
+}
+
+// DeleteRateLimit removes the rate limit of a denom on a channel together
+// with the flow tracked for it
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RateLimitStoreKey(channelID, denom))
+	store.Delete(types.FlowStoreKey(channelID, denom))
+}
+
+// IterateRateLimits iterates over all the rate limits and performs a callback
+// function. The iteration stops when the callback returns true.
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RateLimitKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		types.ModuleCdc.MustUnmarshalBinaryBare(iterator.Value(), &rateLimit)
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// GetAllRateLimits returns all the rate limits
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	var rateLimits []types.RateLimit
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+	return rateLimits
+}
+
+// GetFlow returns the flow of a denom on a channel tracked in the window
+func (k Keeper) GetFlow(ctx sdk.Context, channelID, denom string) (types.Flow, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FlowStoreKey(channelID, denom))
+	if bz == nil {
+		return types.Flow{}, false
+	}
+
+	var flow types.Flow
+	types.ModuleCdc.MustUnmarshalBinaryBare(bz, &flow)
+	return flow, true
+}
+
+// SetFlow stores the flow of a denom on a channel. A flow without buckets is
+// removed.
+func (k Keeper) SetFlow(ctx sdk.Context, channelID, denom string, flow types.Flow) {
+	if len(flow.Buckets) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.FlowStoreKey(channelID, denom))
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.FlowStoreKey(channelID, denom), types.ModuleCdc.MustMarshalBinaryBare(flow))
+}
+
+// currentFlow returns the flow within the window rolling up to the block time
+func (k Keeper) currentFlow(ctx sdk.Context, rateLimit types.RateLimit) types.Flow {
+	flow, _ := k.GetFlow(ctx, rateLimit.ChannelID, rateLimit.Denom)
+	return flow.Prune(ctx.BlockTime(), rateLimit)
+}
+
+// CheckAndUpdateOutflow adds amount to the outflow of a denom on a channel,
+// failing if the quota of the window would be exceeded
+func (k Keeper) CheckAndUpdateOutflow(ctx sdk.Context, channelID, denom string, amount sdk.Dec) error {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return nil
+	}
+
+	flow := k.currentFlow(ctx, rateLimit)
+	flow.AddOutflow(ctx.BlockTime(), rateLimit, amount)
+	if outflow := flow.Outflow(); !rateLimit.MaxOutflow.IsZero() && outflow.GT(rateLimit.MaxOutflow) {
+		k.emitRateLimitExceeded(ctx, rateLimit, types.DirectionOutflow, amount)
+		return sdkerrors.Wrapf(
+			types.ErrRateLimitExceeded, "outflow of %s on %s would reach %s, quota is %s per %s",
+			denom, channelID, outflow, rateLimit.MaxOutflow, rateLimit.Window,
+		)
+	}
+
+	k.SetFlow(ctx, channelID, denom, flow)
+	return nil
+}
+
+// CheckAndUpdateInflow adds amount to the inflow of a denom on a channel,
+// failing if the quota of the window would be exceeded
+func (k Keeper) CheckAndUpdateInflow(ctx sdk.Context, channelID, denom string, amount sdk.Dec) error {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return nil
+	}
+
+	flow := k.currentFlow(ctx, rateLimit)
+	flow.AddInflow(ctx.BlockTime(), rateLimit, amount)
+	if inflow := flow.Inflow(); !rateLimit.MaxInflow.IsZero() && inflow.GT(rateLimit.MaxInflow) {
+		k.emitRateLimitExceeded(ctx, rateLimit, types.DirectionInflow, amount)
+		return sdkerrors.Wrapf(
+			types.ErrRateLimitExceeded, "inflow of %s on %s would reach %s, quota is %s per %s",
+			denom, channelID, inflow, rateLimit.MaxInflow, rateLimit.Window,
+		)
+	}
+
+	k.SetFlow(ctx, channelID, denom, flow)
+	return nil
+}
+
+// UndoOutflow subtracts a refunded amount from the outflow of a denom on a
+// channel. The part of a refund exceeding the outflow left in the window is
+// ignored, as it was sent in buckets that have already rolled out.
+func (k Keeper) UndoOutflow(ctx sdk.Context, channelID, denom string, amount sdk.Dec) {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return
+	}
+
+	flow := k.currentFlow(ctx, rateLimit)
+	flow.SubOutflow(amount)
+	k.SetFlow(ctx, channelID, denom, flow)
+}
+
+func (k Keeper) emitRateLimitExceeded(ctx sdk.Context, rateLimit types.RateLimit, direction string, amount sdk.Dec) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRateLimitExceeded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChannel, rateLimit.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Denom),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
+
+// HandleManageRateLimitProposal sets or removes the rate limit of a denom on a
+// channel. Updating a rate limit clears the flow tracked in the window.
+func (k Keeper) HandleManageRateLimitProposal(ctx sdk.Context, p types.ManageRateLimitProposal) sdk.Error {
+	rateLimit := p.RateLimit
+	if !p.IsAdded {
+		if _, found := k.GetRateLimit(ctx, rateLimit.ChannelID, rateLimit.Denom); !found {
+			return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "channel: %s, denom: %s", rateLimit.ChannelID, rateLimit.Denom)
+		}
+		k.DeleteRateLimit(ctx, rateLimit.ChannelID, rateLimit.Denom)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRateLimitRemoved,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyChannel, rateLimit.ChannelID),
+				sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Denom),
+			),
+		)
+		return nil
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.SetFlow(ctx, rateLimit.ChannelID, rateLimit.Denom, types.Flow{})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRateLimitUpdated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyChannel, rateLimit.ChannelID),
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Denom),
+			sdk.NewAttribute(types.AttributeKeyMaxOutflow, rateLimit.MaxOutflow.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxInflow, rateLimit.MaxInflow.String()),
+			sdk.NewAttribute(types.AttributeKeyWindow, rateLimit.Window.String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/store"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit/keeper"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	dbm "github.com/okex/exchain/libs/tm-db"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	channelID = "channel-0"
+	denom     = "okt"
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	key := sdk.NewKVStoreKey("transfer")
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{Height: 1, Time: time.Unix(1000, 0).UTC()}, false, log.NewNopLogger())
+	return ctx, keeper.NewKeeper(key)
+}
+
+func setRateLimit(t *testing.T, ctx sdk.Context, k keeper.Keeper, maxOutflow, maxInflow int64) {
+	rateLimit := types.NewRateLimit(channelID, denom, sdk.NewDec(maxOutflow), sdk.NewDec(maxInflow), time.Hour)
+	proposal := types.NewManageRateLimitProposal("title", "description", rateLimit, true)
+	require.NoError(t, k.HandleManageRateLimitProposal(ctx, proposal))
+}
+
+func TestOutflowQuota(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	// transfers without a rate limit are not tracked
+	require.NoError(t, k.CheckAndUpdateOutflow(ctx, channelID, denom, sdk.NewDec(1000)))
+	_, found := k.GetFlow(ctx, channelID, denom)
+	require.False(t, found)
+
+	setRateLimit(t, ctx, k, 100, 0)
+	require.NoError(t, k.CheckAndUpdateOutflow(ctx, channelID, denom, sdk.NewDec(60)))
+	require.NoError(t, k.CheckAndUpdateOutflow(ctx, channelID, denom, sdk.NewDec(40)))
+	err := k.CheckAndUpdateOutflow(ctx, channelID, denom, sdk.NewDec(1))
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+	// the failed transfer is not accounted for
+	flow, found := k.GetFlow(ctx, channelID, denom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), flow.Outflow())
+
+	// a refund frees up the quota again
+	k.UndoOutflow(ctx, channelID, denom, sdk.NewDec(30))
+	require.NoError(t, k.CheckAndUpdateOutflow(ctx, channelID, denom, sdk.NewDec(30)))
+
+	// the inflow is unlimited
+	require.NoError(t, k.CheckAndUpdateInflow(ctx, channelID, denom, sdk.NewDec(1000)))
+
+	// other denoms and channels are not limited
+	require.NoError(t, k.CheckAndUpdateOutflow(ctx, channelID, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", sdk.NewDec(1000)))
+	require.NoError(t, k.CheckAndUpdateOutflow(ctx, "channel-1", denom, sdk.NewDec(1000)))
+
+	// the quota is available again once the window has rolled past the transfers
+	ctx.SetBlockTime(ctx.BlockTime().Add(time.Hour + 6*time.Minute))
+	require.NoError(t, k.CheckAndUpdateOutflow(ctx, channelID, denom, sdk.NewDec(100)))
+	flow, _ = k.GetFlow(ctx, channelID, denom)
+	require.Len(t, flow.Buckets, 1)
+	require.Equal(t, sdk.NewDec(100), flow.Outflow())
+	require.Equal(t, sdk.ZeroDec(), flow.Inflow())
+}
+
+func TestOutflowRollingWindow(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	setRateLimit(t, ctx, k, 100, 0)
+
+	// the quota spent at the end of a window still counts right after it
+	ctx.SetBlockTime(ctx.BlockTime().Add(59 * time.Minute))
+	require.NoError(t, k.CheckAndUpdateOutflow(ctx, channelID, denom, sdk.NewDec(100)))
+	ctx.SetBlockTime(ctx.BlockTime().Add(2 * time.Minute))
+	err := k.CheckAndUpdateOutflow(ctx, channelID, denom, sdk.NewDec(1))
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+	// and is released once its bucket has rolled out of the window
+	ctx.SetBlockTime(ctx.BlockTime().Add(time.Hour - time.Minute))
+	err = k.CheckAndUpdateOutflow(ctx, channelID, denom, sdk.NewDec(1))
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+	ctx.SetBlockTime(ctx.BlockTime().Add(6 * time.Minute))
+	require.NoError(t, k.CheckAndUpdateOutflow(ctx, channelID, denom, sdk.NewDec(100)))
+}
+
+func TestInflowQuota(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	setRateLimit(t, ctx, k, 0, 100)
+
+	hooks := k.Hooks()
+	token := sdk.NewDecCoinFromDec(denom, sdk.NewDec(100))
+	require.NoError(t, hooks.AfterRecvTransfer(ctx, "transfer", channelID, token, "receiver", false))
+	err := hooks.AfterRecvTransfer(ctx, "transfer", channelID, sdk.NewDecCoinFromDec(denom, sdk.NewDecWithPrec(1, 18)), "receiver", false)
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+	// refunds only release outflow quota
+	require.NoError(t, hooks.AfterRefundTransfer(ctx, "transfer", channelID, token, "sender", true))
+	err = hooks.AfterRecvTransfer(ctx, "transfer", channelID, token, "receiver", false)
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+}
+
+func TestManageRateLimitProposal(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	removal := types.NewManageRateLimitProposal("title", "description", types.RateLimit{ChannelID: channelID, Denom: denom}, false)
+	err := k.HandleManageRateLimitProposal(ctx, removal)
+	require.ErrorIs(t, err, types.ErrRateLimitNotFound)
+
+	setRateLimit(t, ctx, k, 100, 100)
+	require.NoError(t, k.CheckAndUpdateOutflow(ctx, channelID, denom, sdk.NewDec(100)))
+
+	// updating the rate limit clears the flow
+	setRateLimit(t, ctx, k, 200, 100)
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(200), rateLimit.MaxOutflow)
+	flow, _ := k.GetFlow(ctx, channelID, denom)
+	require.True(t, flow.Outflow().IsZero())
+	require.Len(t, k.GetAllRateLimits(ctx), 1)
+
+	require.NoError(t, k.HandleManageRateLimitProposal(ctx, removal))
+	_, found = k.GetRateLimit(ctx, channelID, denom)
+	require.False(t, found)
+	_, found = k.GetFlow(ctx, channelID, denom)
+	require.False(t, found)
+	require.Empty(t, k.GetAllRateLimits(ctx))
+}
@@ -0,0 +1,21 @@
+package ratelimit
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit/keeper"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
+)
+
+// NewManageRateLimitProposalHandler defines the rate limit proposal handler
+func NewManageRateLimitProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content *govtypes.Proposal) sdk.Error {
+		switch c := content.Content.(type) {
+		case types.ManageRateLimitProposal:
+			return k.HandleManageRateLimitProposal(ctx, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized rate limit proposal content type: %T", c)
+		}
+	}
+}
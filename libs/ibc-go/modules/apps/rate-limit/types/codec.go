@@ -0,0 +1,12 @@
+package types
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+)
+
+// ModuleCdc is the codec used to persist the rate limits and flows
+var ModuleCdc = codec.New()
+
+func init() {
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,10 @@
+package types
+
+import sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+
+// rate limit sentinel errors
+var (
+	ErrRateLimitExceeded = sdkerrors.Register(ModuleName, 2, "transfer rate limit exceeded")
+	ErrInvalidRateLimit  = sdkerrors.Register(ModuleName, 3, "invalid rate limit")
+	ErrRateLimitNotFound = sdkerrors.Register(ModuleName, 4, "rate limit not found")
+)
@@ -0,0 +1,19 @@
+package types
+
+// rate limit events
+const (
+	EventTypeRateLimitExceeded = "rate_limit_exceeded"
+	EventTypeRateLimitUpdated  = "rate_limit_updated"
+	EventTypeRateLimitRemoved  = "rate_limit_removed"
+
+	AttributeKeyChannel    = "channel"
+	AttributeKeyDenom      = "denom"
+	AttributeKeyDirection  = "direction"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyMaxOutflow = "max_outflow"
+	AttributeKeyMaxInflow  = "max_inflow"
+	AttributeKeyWindow     = "window"
+
+	DirectionOutflow = "outflow"
+	DirectionInflow  = "inflow"
+)
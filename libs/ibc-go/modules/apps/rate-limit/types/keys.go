@@ -0,0 +1,34 @@
+package types
+
+import "fmt"
+
+const (
+	// ModuleName defines the IBC transfer rate limit name
+	ModuleName = "ratelimit"
+
+	// RouterKey is the message route for the rate limit proposals
+	RouterKey = ModuleName
+
+	// QuerierRoute is the store the rate limits are kept in. The rate limits
+	// share the transfer module store.
+	QuerierRoute = "transfer"
+)
+
+var (
+	// RateLimitKey defines the key prefix for the governance configured quotas.
+	// The rate limits share the transfer module store, so the prefixes are chosen
+	// to stay clear of the transfer (0x01-0x04) and packet forward (0x10) keys.
+	RateLimitKey = []byte{0x11}
+	// FlowKey defines the key prefix for the flow tracked in the current window
+	FlowKey = []byte{0x12}
+)
+
+// RateLimitStoreKey returns the store key of the rate limit of a denom on a channel
+func RateLimitStoreKey(channelID, denom string) []byte {
+	return append(RateLimitKey, []byte(fmt.Sprintf("%s/%s", channelID, denom))...)
+}
+
+// FlowStoreKey returns the store key of the flow of a denom on a channel
+func FlowStoreKey(channelID, denom string) []byte {
+	return append(FlowKey, []byte(fmt.Sprintf("%s/%s", channelID, denom))...)
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
+)
+
+const (
+	// proposalTypeManageRateLimit defines the type for a ManageRateLimitProposal
+	proposalTypeManageRateLimit = "ManageRateLimit"
+)
+
+func init() {
+	govtypes.RegisterProposalType(proposalTypeManageRateLimit)
+	govtypes.RegisterProposalTypeCodec(ManageRateLimitProposal{}, "okexchain/ratelimit/ManageRateLimitProposal")
+}
+
+var _ govtypes.Content = (*ManageRateLimitProposal)(nil)
+
+// ManageRateLimitProposal - structure for the proposal to set or remove the rate limit of a denom on a channel
+type ManageRateLimitProposal struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	RateLimit   RateLimit `json:"rate_limit" yaml:"rate_limit"`
+	IsAdded     bool      `json:"is_added" yaml:"is_added"`
+}
+
+// NewManageRateLimitProposal creates a new instance of ManageRateLimitProposal
+func NewManageRateLimitProposal(title, description string, rateLimit RateLimit, isAdded bool) ManageRateLimitProposal {
+	return ManageRateLimitProposal{
+		Title:       title,
+		Description: description,
+		RateLimit:   rateLimit,
+		IsAdded:     isAdded,
+	}
+}
+
+// GetTitle returns title of a manage rate limit proposal object
+func (mp ManageRateLimitProposal) GetTitle() string {
+	return mp.Title
+}
+
+// GetDescription returns description of a manage rate limit proposal object
+func (mp ManageRateLimitProposal) GetDescription() string {
+	return mp.Description
+}
+
+// ProposalRoute returns route key of a manage rate limit proposal object
+func (mp ManageRateLimitProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a manage rate limit proposal object
+func (mp ManageRateLimitProposal) ProposalType() string {
+	return proposalTypeManageRateLimit
+}
+
+// ValidateBasic validates a manage rate limit proposal
+func (mp ManageRateLimitProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(mp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(mp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(mp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(mp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if mp.ProposalType() != proposalTypeManageRateLimit {
+		return govtypes.ErrInvalidProposalType(mp.ProposalType())
+	}
+
+	// removing a rate limit only requires the channel and denom
+	if !mp.IsAdded {
+		return mp.RateLimit.ValidateIdentifiers()
+	}
+	return mp.RateLimit.Validate()
+}
+
+// String returns a human readable string representation of a ManageRateLimitProposal
+func (mp ManageRateLimitProposal) String() string {
+	return fmt.Sprintf(`ManageRateLimitProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ IsAdded:				%t
+ %s`, mp.Title, mp.Description, mp.ProposalType(), mp.IsAdded, mp.RateLimit)
+}
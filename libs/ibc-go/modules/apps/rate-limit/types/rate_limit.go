@@ -0,0 +1,168 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	host "github.com/okex/exchain/libs/ibc-go/modules/core/24-host"
+)
+
+// RateLimit caps the amount of a denom flowing through a channel within a
+// rolling window. A zero quota leaves the direction unlimited.
+type RateLimit struct {
+	ChannelID string `json:"channel_id"`
+	// the denom as known to the bank module of this chain (okt, ibc/{hash}, ...)
+	Denom      string        `json:"denom"`
+	MaxOutflow sdk.Dec       `json:"max_outflow"`
+	MaxInflow  sdk.Dec       `json:"max_inflow"`
+	Window     time.Duration `json:"window"`
+}
+
+// NewRateLimit creates a new RateLimit instance
+func NewRateLimit(channelID, denom string, maxOutflow, maxInflow sdk.Dec, window time.Duration) RateLimit {
+	return RateLimit{
+		ChannelID:  channelID,
+		Denom:      denom,
+		MaxOutflow: maxOutflow,
+		MaxInflow:  maxInflow,
+		Window:     window,
+	}
+}
+
+// ValidateIdentifiers checks the channel and denom the rate limit applies to
+func (rl RateLimit) ValidateIdentifiers() error {
+	if err := host.ChannelIdentifierValidator(rl.ChannelID); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRateLimit, "invalid channel: %s", err)
+	}
+	if strings.TrimSpace(rl.Denom) == "" {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "denom cannot be blank")
+	}
+	if err := sdk.ValidateDenom(rl.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+	return nil
+}
+
+// Validate performs a stateless check of the rate limit
+func (rl RateLimit) Validate() error {
+	if err := rl.ValidateIdentifiers(); err != nil {
+		return err
+	}
+	if rl.MaxOutflow.IsNil() || rl.MaxOutflow.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "max outflow cannot be negative")
+	}
+	if rl.MaxInflow.IsNil() || rl.MaxInflow.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "max inflow cannot be negative")
+	}
+	if rl.MaxOutflow.IsZero() && rl.MaxInflow.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "at least one of max outflow and max inflow must be set")
+	}
+	if rl.Window <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidRateLimit, "window must be positive, got %s", rl.Window)
+	}
+	return nil
+}
+
+// String returns a human readable string representation of a RateLimit
+func (rl RateLimit) String() string {
+	return fmt.Sprintf(`RateLimit:
+  Channel:     %s
+  Denom:       %s
+  Max Outflow: %s
+  Max Inflow:  %s
+  Window:      %s`, rl.ChannelID, rl.Denom, rl.MaxOutflow, rl.MaxInflow, rl.Window)
+}
+
+// FlowBucketsPerWindow is the number of buckets the window of a rate limit is
+// split into. The flow is summed over the buckets overlapping the window, so
+// it is tracked over a window rolling by one bucket at a time.
+const FlowBucketsPerWindow = 10
+
+// BucketDuration returns the span of the buckets the flow is tracked in
+func (rl RateLimit) BucketDuration() time.Duration {
+	if d := rl.Window / FlowBucketsPerWindow; d > 0 {
+		return d
+	}
+	return time.Nanosecond
+}
+
+// FlowBucket is the amount of a denom that moved through a channel within
+// one bucket of the window
+type FlowBucket struct {
+	Start   time.Time `json:"start"`
+	Outflow sdk.Dec   `json:"outflow"`
+	Inflow  sdk.Dec   `json:"inflow"`
+}
+
+// Flow is the amount of a denom that moved through a channel within the
+// rolling window, kept in buckets from the oldest to the latest.
+type Flow struct {
+	Buckets []FlowBucket `json:"buckets"`
+}
+
+// Prune drops the buckets ended before the window ending at blockTime. The
+// buckets partly overlapping the window are kept, so the flow never covers
+// less than the window.
+func (f Flow) Prune(blockTime time.Time, rl RateLimit) Flow {
+	windowStart := blockTime.Add(-rl.Window)
+	var buckets []FlowBucket
+	for _, bucket := range f.Buckets {
+		if bucket.Start.Add(rl.BucketDuration()).After(windowStart) {
+			buckets = append(buckets, bucket)
+		}
+	}
+	return Flow{Buckets: buckets}
+}
+
+// Outflow returns the outflow summed over the buckets
+func (f Flow) Outflow() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, bucket := range f.Buckets {
+		total = total.Add(bucket.Outflow)
+	}
+	return total
+}
+
+// Inflow returns the inflow summed over the buckets
+func (f Flow) Inflow() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, bucket := range f.Buckets {
+		total = total.Add(bucket.Inflow)
+	}
+	return total
+}
+
+// latestBucket returns the bucket blockTime falls into, appending it if the
+// latest bucket has elapsed
+func (f *Flow) latestBucket(blockTime time.Time, rl RateLimit) *FlowBucket {
+	start := blockTime.Truncate(rl.BucketDuration())
+	if n := len(f.Buckets); n == 0 || f.Buckets[n-1].Start.Before(start) {
+		f.Buckets = append(f.Buckets, FlowBucket{Start: start, Outflow: sdk.ZeroDec(), Inflow: sdk.ZeroDec()})
+	}
+	return &f.Buckets[len(f.Buckets)-1]
+}
+
+// AddOutflow adds amount to the outflow of the bucket blockTime falls into
+func (f *Flow) AddOutflow(blockTime time.Time, rl RateLimit, amount sdk.Dec) {
+	bucket := f.latestBucket(blockTime, rl)
+	bucket.Outflow = bucket.Outflow.Add(amount)
+}
+
+// AddInflow adds amount to the inflow of the bucket blockTime falls into
+func (f *Flow) AddInflow(blockTime time.Time, rl RateLimit, amount sdk.Dec) {
+	bucket := f.latestBucket(blockTime, rl)
+	bucket.Inflow = bucket.Inflow.Add(amount)
+}
+
+// SubOutflow subtracts amount from the outflow of the buckets, starting from
+// the latest one. The outflow doesn't go below zero.
+func (f *Flow) SubOutflow(amount sdk.Dec) {
+	for i := len(f.Buckets) - 1; i >= 0 && amount.IsPositive(); i-- {
+		sub := sdk.MinDec(amount, f.Buckets[i].Outflow)
+		f.Buckets[i].Outflow = f.Buckets[i].Outflow.Sub(sub)
+		amount = amount.Sub(sub)
+	}
+}
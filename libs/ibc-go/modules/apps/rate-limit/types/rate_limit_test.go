@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitValidate(t *testing.T) {
+	valid := NewRateLimit("channel-0", "okt", sdk.NewDec(100), sdk.ZeroDec(), time.Hour)
+
+	testCases := []struct {
+		name     string
+		malleate func(rl *RateLimit)
+		expPass  bool
+	}{
+		{"valid", func(rl *RateLimit) {}, true},
+		{"valid ibc denom", func(rl *RateLimit) {
+			rl.Denom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+		}, true},
+		{"invalid channel", func(rl *RateLimit) { rl.ChannelID = "" }, false},
+		{"blank denom", func(rl *RateLimit) { rl.Denom = " " }, false},
+		{"invalid denom", func(rl *RateLimit) { rl.Denom = "1okt" }, false},
+		{"nil quota", func(rl *RateLimit) { rl.MaxInflow = sdk.Dec{} }, false},
+		{"negative quota", func(rl *RateLimit) { rl.MaxOutflow = sdk.NewDec(-1) }, false},
+		{"no quota", func(rl *RateLimit) { rl.MaxOutflow = sdk.ZeroDec() }, false},
+		{"zero window", func(rl *RateLimit) { rl.Window = 0 }, false},
+	}
+
+	for _, tc := range testCases {
+		rl := valid
+		tc.malleate(&rl)
+		err := rl.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestManageRateLimitProposalValidateBasic(t *testing.T) {
+	removal := NewManageRateLimitProposal("title", "description", RateLimit{ChannelID: "channel-0", Denom: "okt"}, false)
+	require.NoError(t, removal.ValidateBasic())
+
+	// adding requires a complete rate limit
+	removal.IsAdded = true
+	require.Error(t, removal.ValidateBasic())
+
+	removal.Title = ""
+	require.Error(t, removal.ValidateBasic())
+}
+
+func TestFlowRollingWindow(t *testing.T) {
+	rl := NewRateLimit("channel-0", "okt", sdk.NewDec(100), sdk.ZeroDec(), time.Hour)
+	require.Equal(t, 6*time.Minute, rl.BucketDuration())
+
+	start := time.Unix(3600, 0)
+	var flow Flow
+	flow.AddOutflow(start, rl, sdk.NewDec(10))
+	flow.AddOutflow(start.Add(time.Minute), rl, sdk.NewDec(20))
+	flow.AddInflow(start.Add(30*time.Minute), rl, sdk.NewDec(5))
+	require.Len(t, flow.Buckets, 2)
+	require.Equal(t, sdk.NewDec(30), flow.Outflow())
+	require.Equal(t, sdk.NewDec(5), flow.Inflow())
+
+	// the first bucket is kept while it overlaps the window
+	require.Len(t, flow.Prune(start.Add(time.Hour+6*time.Minute-time.Nanosecond), rl).Buckets, 2)
+	pruned := flow.Prune(start.Add(time.Hour+6*time.Minute), rl)
+	require.Len(t, pruned.Buckets, 1)
+	require.True(t, pruned.Outflow().IsZero())
+
+	// refunds are taken from the latest buckets first
+	flow.SubOutflow(sdk.NewDec(25))
+	require.Equal(t, sdk.NewDec(5), flow.Outflow())
+	flow.SubOutflow(sdk.NewDec(25))
+	require.True(t, flow.Outflow().IsZero())
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/client"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	interfacetypes "github.com/okex/exchain/libs/cosmos-sdk/codec/types"
+	ratelimitcli "github.com/okex/exchain/libs/ibc-go/modules/apps/rate-limit/client/cli"
 	"github.com/spf13/cobra"
 )
 
@@ -21,6 +22,11 @@ func GetQueryCmd(cdc *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *c
 		GetCmdQueryDenomTraces(cdc, reg),
 		GetCmdParams(cdc, reg),
 		GetCmdQueryEscrowAddress(cdc, reg),
+		GetCmdQueryDenomMetadata(cdc, reg),
+		GetCmdQueryResolveDenoms(cdc, reg),
+		GetCmdQueryReceiverDenylist(cdc, reg),
+		ratelimitcli.GetCmdQueryRateLimit(cdc),
+		ratelimitcli.GetCmdQueryRateLimits(cdc),
 	)
 
 	return queryCmd
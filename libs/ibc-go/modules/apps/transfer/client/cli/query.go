@@ -120,3 +120,89 @@ func GetCmdQueryEscrowAddress(m *codec.CodecProxy, reg interfacetypes.InterfaceR
 
 	return cmd
 }
+
+// GetCmdQueryDenomMetadata defines the command to query the registered display metadata of a
+// denomination from a given trace hash.
+func GetCmdQueryDenomMetadata(m *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-metadata [hash]",
+		Short:   "Query the registered display metadata from a given trace hash",
+		Long:    "Query the governance registered display metadata (symbol, decimals) from a given trace hash",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-metadata [hash]", version.ServerName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := context.NewCLIContext().WithProxy(m).WithInterfaceRegistry(reg)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomMetadataRequest{
+				Hash: args[0],
+			}
+
+			res, err := queryClient.DenomMetadata(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryResolveDenoms defines the command to resolve a list of ibc denominations to their
+// traces and registered display metadata.
+func GetCmdQueryResolveDenoms(m *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "resolve-denoms [denom]...",
+		Short:   "Resolve ibc denominations to their traces and display metadata",
+		Long:    "Resolve a list of ibc denominations (ibc/{hash}) to their traces and governance registered display metadata",
+		Example: fmt.Sprintf("%s query ibc-transfer resolve-denoms ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", version.ServerName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := context.NewCLIContext().WithProxy(m).WithInterfaceRegistry(reg)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryResolveDenomsRequest{
+				Denoms: args,
+			}
+
+			res, err := queryClient.ResolveDenoms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryReceiverDenylist defines the command to query the receiver addresses transfers
+// are denied to.
+func GetCmdQueryReceiverDenylist(m *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "receiver-denylist",
+		Short:   "Query the receiver addresses transfers are denied to",
+		Long:    "Query the governance managed receiver addresses incoming transfers are denied to",
+		Example: fmt.Sprintf("%s query ibc-transfer receiver-denylist", version.ServerName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := context.NewCLIContext().WithProxy(m).WithInterfaceRegistry(reg)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReceiverDenylist(cmd.Context(), &types.QueryReceiverDenylistRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
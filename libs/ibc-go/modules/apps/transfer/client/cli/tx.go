@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,9 +17,11 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/version"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	govcli "github.com/okex/exchain/libs/cosmos-sdk/x/gov/client/cli"
 	"github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
 	clienttypes "github.com/okex/exchain/libs/ibc-go/modules/core/02-client/types"
 	channelutils "github.com/okex/exchain/libs/ibc-go/modules/core/04-channel/client/utils"
+	govtypes "github.com/okex/exchain/x/gov/types"
 	"github.com/spf13/cobra"
 )
 
@@ -26,6 +29,10 @@ const (
 	flagPacketTimeoutHeight    = "packet-timeout-height"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagPacketMemo             = "packet-memo"
+	flagMetadataDescription    = "metadata-description"
+	flagMetadataDisplay        = "display"
+	flagRemove                 = "remove"
 )
 
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
@@ -86,6 +93,11 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				return err
 			}
 
+			memo, err := cmd.Flags().GetString(flagPacketMemo)
+			if err != nil {
+				return err
+			}
+
 			// if the timeouts are not absolute, retrieve latest block height and block timestamp
 			// for the consensus state connected to the destination port/channel
 			if !absoluteTimeouts {
@@ -123,6 +135,7 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 			msg := types.NewMsgTransfer(
 				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp,
 			)
+			msg.Memo = memo
 			return utils.GenerateOrBroadcastMsgs(clientCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
@@ -130,7 +143,144 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 	cmd.Flags().String(flagPacketTimeoutHeight, types.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagPacketMemo, "", "Memo to be sent along with the packet, e.g. instructions for middleware on the receiving chain.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// NewCmdSubmitDenomMetadataProposal implements a command handler for submitting a denomination
+// metadata proposal transaction.
+func NewCmdSubmitDenomMetadataProposal(m *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-metadata [denom] [symbol] [decimals]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal registering the display metadata of an ibc denomination",
+		Long: "Submit a proposal registering the display metadata of an ibc denomination along with an initial deposit.\n" +
+			"Please specify the ibc voucher denomination (ibc/{hash}) the metadata refers to.\n" +
+			"Please specify the display symbol and the number of decimals of the token.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal denom-metadata ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 ATOM 6 --display=atom --title=\"ATOM metadata\" --description=\"register ATOM\" --deposit=\"100%s\"", version.ServerName, sdk.DefaultBondDenom),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(m.GetCdc()))
+			clientCtx := context.NewCLIContext().WithCodec(m.GetCdc())
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			decimals, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid decimals %s: %w", args[2], err)
+			}
+
+			metadataDescription, err := cmd.Flags().GetString(flagMetadataDescription)
+			if err != nil {
+				return err
+			}
+
+			display, err := cmd.Flags().GetString(flagMetadataDisplay)
+			if err != nil {
+				return err
+			}
+
+			metadata := types.NewDenomMetadata(args[0], args[1], display, uint32(decimals), metadataDescription)
+			content := types.NewDenomMetadataProposal(title, description, metadata)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, from)
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(clientCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(flagMetadataDescription, "", "human readable description of the token")
+	cmd.Flags().String(flagMetadataDisplay, "", "denomination the token is displayed in, such as atom")
+
+	return cmd
+}
+
+// NewCmdSubmitReceiverDenylistProposal implements a command handler for submitting a proposal
+// adding receivers to or removing them from the transfer denylist.
+func NewCmdSubmitReceiverDenylistProposal(m *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "receiver-denylist [receiver]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal adding receivers to or removing them from the ibc transfer denylist",
+		Long: "Submit a proposal adding receivers to or removing them from the ibc transfer denylist along with an initial deposit.\n" +
+			"Incoming transfers to denied receivers are rejected with an error acknowledgement, refunding the sender.\n" +
+			"Please specify the --remove flag to remove the receivers from the denylist.",
+		Example: fmt.Sprintf("%s tx gov submit-proposal receiver-denylist ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02 --title=\"deny receiver\" --description=\"incident response\" --deposit=\"100%s\"", version.ServerName, sdk.DefaultBondDenom),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(m.GetCdc()))
+			clientCtx := context.NewCLIContext().WithCodec(m.GetCdc())
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			remove, err := cmd.Flags().GetBool(flagRemove)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewReceiverDenylistProposal(title, description, args, !remove)
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, from)
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(clientCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(flagRemove, false, "remove the receivers from the denylist instead of adding them")
+
+	return cmd
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"net/http"
+
+	cliContext "github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/rest"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/client/cli"
+	govclient "github.com/okex/exchain/x/gov/client"
+	govrest "github.com/okex/exchain/x/gov/client/rest"
+)
+
+var (
+	DenomMetadataProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitDenomMetadataProposal, emptyRestHandler)
+	ReceiverDenylistProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitReceiverDenylistProposal, emptyRestHandler)
+)
+
+func emptyRestHandler(ctx cliContext.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-ibc-transfer",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for IBC proposals")
+		},
+	}
+}
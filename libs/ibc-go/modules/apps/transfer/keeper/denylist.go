@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
+)
+
+// IsReceiverDenied returns true if transfers to the receiver are denied.
+func (k Keeper) IsReceiverDenied(ctx sdk.Context, receiver sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DeniedReceiverKey(receiver))
+}
+
+// SetReceiverDenied adds the receiver to the denylist.
+func (k Keeper) SetReceiverDenied(ctx sdk.Context, receiver sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.DeniedReceiverKey(receiver), []byte{0x01})
+}
+
+// DeleteReceiverDenied removes the receiver from the denylist.
+func (k Keeper) DeleteReceiverDenied(ctx sdk.Context, receiver sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.DeniedReceiverKey(receiver))
+}
+
+// GetDeniedReceivers returns all the denied receivers.
+func (k Keeper) GetDeniedReceivers(ctx sdk.Context) []string {
+	receivers := []string{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ReceiverDenylistKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		receiver := sdk.AccAddress(iterator.Key()[len(types.ReceiverDenylistKey):])
+		receivers = append(receivers, receiver.String())
+	}
+
+	return receivers
+}
+
+// HandleReceiverDenylistProposal adds the receivers to or removes them from the
+// denylist after the governance proposal passed.
+func (k Keeper) HandleReceiverDenylistProposal(ctx sdk.Context, p *types.ReceiverDenylistProposal) error {
+	eventType := types.EventTypeAllowReceiver
+	if p.IsAdded {
+		eventType = types.EventTypeDenyReceiver
+	}
+
+	for _, receiver := range p.Receivers {
+		addr, err := sdk.AccAddressFromBech32(receiver)
+		if err != nil {
+			return err
+		}
+
+		if p.IsAdded {
+			k.SetReceiverDenied(ctx, addr)
+		} else {
+			k.DeleteReceiverDenied(ctx, addr)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute(types.AttributeKeyReceiver, addr.String()),
+			),
+		)
+	}
+
+	k.Logger(ctx).Info("receiver denylist updated after governance proposal passed", "receivers", p.Receivers, "added", p.IsAdded)
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
+	ibctesting "github.com/okex/exchain/libs/ibc-go/testing"
+)
+
+func (suite *KeeperTestSuite) TestReceiverDenylistProposal() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	receiver := suite.chainA.SenderAccount().GetAddress()
+
+	proposal := types.NewReceiverDenylistProposal(ibctesting.Title, ibctesting.Description, []string{receiver.String()}, true).(*types.ReceiverDenylistProposal)
+	suite.Require().NoError(transferKeeper.HandleReceiverDenylistProposal(ctx, proposal))
+	suite.Require().True(transferKeeper.IsReceiverDenied(ctx, receiver))
+
+	res, err := transferKeeper.ReceiverDenylist(sdk.WrapSDKContext(ctx), &types.QueryReceiverDenylistRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{receiver.String()}, res.Receivers)
+
+	genesis := transferKeeper.ExportGenesis(ctx)
+	suite.Require().Equal([]string{receiver.String()}, genesis.DeniedReceivers)
+
+	proposal = types.NewReceiverDenylistProposal(ibctesting.Title, ibctesting.Description, []string{receiver.String()}, false).(*types.ReceiverDenylistProposal)
+	suite.Require().NoError(transferKeeper.HandleReceiverDenylistProposal(ctx, proposal))
+	suite.Require().False(transferKeeper.IsReceiverDenied(ctx, receiver))
+	suite.Require().Empty(transferKeeper.GetDeniedReceivers(ctx))
+}
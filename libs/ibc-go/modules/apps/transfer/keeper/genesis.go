@@ -15,6 +15,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 		k.SetDenomTrace(ctx, trace)
 	}
 
+	for _, metadata := range state.DenomMetadata {
+		k.SetDenomMetadata(ctx, metadata)
+		if err := k.CallAfterDenomMetadataSetHooks(ctx, metadata); err != nil {
+			panic(fmt.Sprintf("denom metadata hooks of %s failed: %v", metadata.Denom, err))
+		}
+	}
+
+	for _, receiver := range state.DeniedReceivers {
+		addr, err := sdk.AccAddressFromBech32(receiver)
+		if err != nil {
+			panic(err)
+		}
+		k.SetReceiverDenied(ctx, addr)
+	}
+
 	// Only try to bind to port if it is not already bound, since we may already own
 	// port capability from capability InitGenesis
 	if !k.IsBound(ctx, state.PortId) {
@@ -38,8 +53,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 // ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:      k.GetPort(ctx),
-		DenomTraces: k.GetAllDenomTraces(ctx),
-		Params:      k.GetParams(ctx),
+		PortId:          k.GetPort(ctx),
+		DenomTraces:     k.GetAllDenomTraces(ctx),
+		Params:          k.GetParams(ctx),
+		DenomMetadata:   k.GetAllDenomMetadata(ctx),
+		DeniedReceivers: k.GetDeniedReceivers(ctx),
 	}
 }
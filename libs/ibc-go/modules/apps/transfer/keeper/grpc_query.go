@@ -71,6 +71,64 @@ func (q Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesReques
 	}, nil
 }
 
+// DenomMetadata implements the Query/DenomMetadata gRPC method
+func (q Keeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := types.ParseHexHash(req.Hash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom trace hash %s, %s", req.Hash, err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	metadata, found := q.GetDenomMetadata(ctx, hash)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrInvalidDenomMetadata, "no metadata registered for %s", req.Hash).Error(),
+		)
+	}
+
+	return &types.QueryDenomMetadataResponse{
+		Metadata: &metadata,
+	}, nil
+}
+
+// ResolveDenoms implements the Query/ResolveDenoms gRPC method
+func (q Keeper) ResolveDenoms(c context.Context, req *types.QueryResolveDenomsRequest) (*types.QueryResolveDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Denoms) > types.MaxResolveDenoms {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("too many denominations %d, maximum is %d", len(req.Denoms), types.MaxResolveDenoms))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	resolved := make([]types.ResolvedDenom, 0, len(req.Denoms))
+	for _, denom := range req.Denoms {
+		hash, err := types.NewDenomMetadata(denom, "", "", 0, "").Hash()
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		res := types.ResolvedDenom{Denom: denom}
+		if denomTrace, found := q.GetDenomTrace(ctx, hash); found {
+			res.DenomTrace = &denomTrace
+		}
+		if metadata, found := q.GetDenomMetadata(ctx, hash); found {
+			res.Metadata = &metadata
+		}
+		resolved = append(resolved, res)
+	}
+
+	return &types.QueryResolveDenomsResponse{
+		Denoms: resolved,
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -80,3 +138,12 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// ReceiverDenylist implements the Query/ReceiverDenylist gRPC method
+func (q Keeper) ReceiverDenylist(c context.Context, _ *types.QueryReceiverDenylistRequest) (*types.QueryReceiverDenylistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryReceiverDenylistResponse{
+		Receivers: q.GetDeniedReceivers(ctx),
+	}, nil
+}
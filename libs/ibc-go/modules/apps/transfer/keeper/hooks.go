@@ -17,6 +17,25 @@ func (k *Keeper) SetHooks(hooks types.TransferHooks) *Keeper {
 	return k
 }
 
+// SetDenomMetadataHooks sets the hooks called after the denomination metadata is registered
+// It should be called only once during initialization, it panics if called more than once.
+func (k *Keeper) SetDenomMetadataHooks(hooks types.DenomMetadataHooks) *Keeper {
+	if k.metadataHooks != nil {
+		panic("cannot set denom metadata hooks twice")
+	}
+
+	k.metadataHooks = hooks
+
+	return k
+}
+
+func (k Keeper) CallAfterDenomMetadataSetHooks(ctx sdk.Context, metadata types.DenomMetadata) error {
+	if k.metadataHooks != nil {
+		return k.metadataHooks.AfterDenomMetadataSet(ctx, metadata)
+	}
+	return nil
+}
+
 func (k Keeper) CallAfterSendTransferHooks(
 	ctx sdk.Context,
 	sourcePort, sourceChannel string,
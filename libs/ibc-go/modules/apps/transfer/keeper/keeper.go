@@ -29,8 +29,9 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	scopedKeeper  capabilitykeeper.ScopedKeeper
 
-	hooks     types.TransferHooks
-	ibcFactor int64
+	hooks         types.TransferHooks
+	metadataHooks types.DenomMetadataHooks
+	ibcFactor     int64
 }
 
 // NewKeeper creates a new IBC transfer Keeper instance
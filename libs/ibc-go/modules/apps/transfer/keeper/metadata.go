@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/store/prefix"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
+	tmbytes "github.com/okex/exchain/libs/tendermint/libs/bytes"
+)
+
+// GetDenomMetadata retrieves the registered display metadata of the voucher denomination
+// with the given trace hash from the store.
+func (k Keeper) GetDenomMetadata(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (types.DenomMetadata, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomMetadataKey)
+	bz := store.Get(denomTraceHash)
+	if bz == nil {
+		return types.DenomMetadata{}, false
+	}
+	var metadata types.DenomMetadata
+	k.cdc.GetProtocMarshal().MustUnmarshalBinaryBare(bz, &metadata)
+	return metadata, true
+}
+
+// SetDenomMetadata sets the display metadata of a voucher denomination to the store. The
+// metadata is expected to be valid.
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, metadata types.DenomMetadata) {
+	hash, err := metadata.Hash()
+	if err != nil {
+		panic(err)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomMetadataKey)
+	store.Set(hash, k.cdc.GetProtocMarshal().MustMarshalBinaryBare(&metadata))
+}
+
+// GetAllDenomMetadata returns the registered display metadata of all the denominations.
+func (k Keeper) GetAllDenomMetadata(ctx sdk.Context) []types.DenomMetadata {
+	metadata := []types.DenomMetadata{}
+	k.IterateDenomMetadata(ctx, func(m types.DenomMetadata) bool {
+		metadata = append(metadata, m)
+		return false
+	})
+
+	return metadata
+}
+
+// IterateDenomMetadata iterates over the registered denomination metadata in the store
+// and performs a callback function.
+func (k Keeper) IterateDenomMetadata(ctx sdk.Context, cb func(metadata types.DenomMetadata) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomMetadataKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.DenomMetadata
+		k.cdc.GetProtocMarshal().MustUnmarshalBinaryBare(iterator.Value(), &metadata)
+		if cb(metadata) {
+			break
+		}
+	}
+}
+
+// HandleDenomMetadataProposal registers the display metadata of a voucher denomination
+// after the governance proposal passed. The denomination trace has to be known already.
+func (k Keeper) HandleDenomMetadataProposal(ctx sdk.Context, p *types.DenomMetadataProposal) error {
+	hash, err := p.Metadata.Hash()
+	if err != nil {
+		return err
+	}
+
+	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	if !found {
+		return sdkerrors.Wrap(types.ErrTraceNotFound, p.Metadata.Denom)
+	}
+
+	metadata := p.Metadata
+	metadata.Denom = denomTrace.IBCDenom()
+	k.SetDenomMetadata(ctx, metadata)
+	if err := k.CallAfterDenomMetadataSetHooks(ctx, metadata); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("denomination metadata registered after governance proposal passed", "denom", metadata.Denom, "symbol", metadata.Symbol)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, metadata.Denom),
+			sdk.NewAttribute(types.AttributeKeyDenomSymbol, metadata.Symbol),
+		),
+	)
+
+	return nil
+}
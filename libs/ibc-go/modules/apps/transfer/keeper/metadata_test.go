@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
+	ibctesting "github.com/okex/exchain/libs/ibc-go/testing"
+)
+
+func (suite *KeeperTestSuite) TestDenomMetadataProposal() {
+	denomTrace := types.DenomTrace{BaseDenom: "uatom", Path: "transfer/channelToA"}
+	metadata := types.NewDenomMetadata(denomTrace.IBCDenom(), "ATOM", "atom", 6, "cosmos hub staking token")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+			}, true,
+		},
+		{
+			"denomination trace not found", func() {}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			proposal := types.NewDenomMetadataProposal(ibctesting.Title, ibctesting.Description, metadata).(*types.DenomMetadataProposal)
+			err := transferKeeper.HandleDenomMetadataProposal(suite.chainA.GetContext(), proposal)
+
+			stored, found := transferKeeper.GetDenomMetadata(suite.chainA.GetContext(), denomTrace.Hash())
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(metadata, stored)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestResolveDenoms() {
+	denomTrace := types.DenomTrace{BaseDenom: "uatom", Path: "transfer/channelToA"}
+	unknownTrace := types.DenomTrace{BaseDenom: "uosmo", Path: "transfer/channelToB"}
+	metadata := types.NewDenomMetadata(denomTrace.IBCDenom(), "ATOM", "atom", 6, "")
+
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	transferKeeper.SetDenomTrace(ctx, denomTrace)
+	transferKeeper.SetDenomMetadata(ctx, metadata)
+
+	res, err := transferKeeper.ResolveDenoms(sdk.WrapSDKContext(ctx), &types.QueryResolveDenomsRequest{
+		Denoms: []string{denomTrace.IBCDenom(), unknownTrace.IBCDenom()},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Denoms, 2)
+	suite.Require().Equal(denomTrace, *res.Denoms[0].DenomTrace)
+	suite.Require().Equal(metadata, *res.Denoms[0].Metadata)
+	suite.Require().Nil(res.Denoms[1].DenomTrace)
+	suite.Require().Nil(res.Denoms[1].Metadata)
+
+	_, err = transferKeeper.ResolveDenoms(sdk.WrapSDKContext(ctx), &types.QueryResolveDenomsRequest{
+		Denoms: []string{"uatom"},
+	})
+	suite.Require().Error(err)
+
+	genesis := transferKeeper.ExportGenesis(ctx)
+	suite.Require().Equal([]types.DenomMetadata{metadata}, genesis.DenomMetadata)
+}
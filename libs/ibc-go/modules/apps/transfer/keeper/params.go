@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 	"github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
 )
 
@@ -19,9 +20,26 @@ func (k Keeper) GetReceiveEnabled(ctx sdk.Context) bool {
 	return res
 }
 
+// GetMaxMemoLength retrieves the maximum memo length from the paramstore.
+// Chains upgraded from a version without the parameter use the default.
+func (k Keeper) GetMaxMemoLength(ctx sdk.Context) uint64 {
+	res := types.DefaultMaxMemoLength
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxMemoLength, &res)
+	return res
+}
+
 // GetParams returns the total set of ibc-transfer parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx))
+	return types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx), k.GetMaxMemoLength(ctx))
+}
+
+// ValidateMemo checks the memo against the maximum memo length parameter
+func (k Keeper) ValidateMemo(ctx sdk.Context, memo string) error {
+	maxMemoLength := k.GetMaxMemoLength(ctx)
+	if maxMemoLength > 0 && uint64(len(memo)) > maxMemoLength {
+		return sdkerrors.Wrapf(types.ErrInvalidMemo, "memo length %d exceeds the maximum of %d bytes", len(memo), maxMemoLength)
+	}
+	return nil
 }
 
 // SetParams sets the total set of ibc-transfer parameters.
@@ -0,0 +1,25 @@
+package keeper_test
+
+import (
+	"strings"
+
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestValidateMemo() {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+	params := types.DefaultParams()
+	params.MaxMemoLength = 10
+	transferKeeper.SetParams(ctx, params)
+
+	suite.Require().NoError(transferKeeper.ValidateMemo(ctx, ""))
+	suite.Require().NoError(transferKeeper.ValidateMemo(ctx, strings.Repeat("m", 10)))
+	suite.Require().Error(transferKeeper.ValidateMemo(ctx, strings.Repeat("m", 11)))
+
+	// a zero maximum disables the limit
+	params.MaxMemoLength = 0
+	transferKeeper.SetParams(ctx, params)
+	suite.Require().NoError(transferKeeper.ValidateMemo(ctx, strings.Repeat("m", 100000)))
+}
@@ -53,11 +53,16 @@ func (k Keeper) SendTransfer(
 	receiver string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
+	memo string,
 ) error {
 	if !k.GetSendEnabled(ctx) {
 		return types.ErrSendDisabled
 	}
 
+	if err := k.ValidateMemo(ctx, memo); err != nil {
+		return err
+	}
+
 	transferAmountDec := sdk.NewDecFromIntWithPrec(adapterToken.Amount, sdk.Precision)
 	token := sdk.NewCoin(adapterToken.Denom, transferAmountDec)
 	k.Logger(ctx).Info("sendTransfer",
@@ -139,6 +144,7 @@ func (k Keeper) SendTransfer(
 	packetData := types.NewFungibleTokenPacketData(
 		fullDenomPath, adapterToken.Amount.String(), sender.String(), receiver,
 	)
+	packetData.Memo = memo
 
 	packet := channeltypes.NewPacket(
 		packetData.GetBytes(),
@@ -173,12 +179,29 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return types.ErrReceiveDisabled
 	}
 
+	if err := k.ValidateMemo(ctx, data.Memo); err != nil {
+		return err
+	}
+
 	// decode the receiver address
 	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
 	if err != nil {
 		return err
 	}
 
+	if k.IsReceiverDenied(ctx, receiver) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDeniedPacket,
+				sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+				sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+				sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
+				sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
+			),
+		)
+		return sdkerrors.Wrap(types.ErrReceiverDenied, data.Receiver)
+	}
+
 	// parse the transfer amount
 	transferAmount, ok := sdk.NewIntFromString(data.Amount)
 	if !ok {
@@ -126,7 +126,7 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 
 			err = suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
 				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoinAdapter(amount.Denom, sdk.NewIntFromBigInt(amount.Amount.BigInt())),
-				suite.chainA.SenderAccount().GetAddress(), suite.chainB.SenderAccount().GetAddress().String(), clienttypes.NewHeight(0, 110), 0,
+				suite.chainA.SenderAccount().GetAddress(), suite.chainB.SenderAccount().GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "",
 			)
 
 			if tc.expPass {
@@ -167,6 +167,9 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{"invalid receiver address", func() {
 			receiver = "gaia1scqhwpgsmr6vmztaa7suurfl52my6nd2kmrudl"
 		}, true, false},
+		{"failure: receiver denied", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetReceiverDenied(suite.chainB.GetContext(), suite.chainB.SenderAccount().GetAddress())
+		}, false, false},
 
 		// onRecvPacket
 		// - coin from chain chainA
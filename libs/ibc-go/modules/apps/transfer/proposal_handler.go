@@ -0,0 +1,25 @@
+package transfer
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/keeper"
+	"github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
+)
+
+// NewProposalHandler defines the ibc transfer proposal handler for the denomination
+// metadata and receiver denylist proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content *govtypes.Proposal) sdk.Error {
+		cont := content.Content
+		switch c := cont.(type) {
+		case *types.DenomMetadataProposal:
+			return k.HandleDenomMetadataProposal(ctx, c)
+		case *types.ReceiverDenylistProposal:
+			return k.HandleReceiverDenylistProposal(ctx, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ibc transfer proposal content type: %T", c)
+		}
+	}
+}
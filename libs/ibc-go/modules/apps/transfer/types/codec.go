@@ -7,12 +7,15 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/types/msgservice"
 
 	txmsg "github.com/okex/exchain/libs/cosmos-sdk/types/ibc-adapter"
+	govtypes "github.com/okex/exchain/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/ibc transfer interfaces and concrete types
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&MsgTransfer{}, "cosmos-sdk/MsgTransfer", nil)
+	cdc.RegisterConcrete(&DenomMetadataProposal{}, "ibc.applications.transfer.v1.DenomMetadataProposal", nil)
+	cdc.RegisterConcrete(&ReceiverDenylistProposal{}, "ibc.applications.transfer.v1.ReceiverDenylistProposal", nil)
 }
 
 // RegisterInterfaces register the ibc transfer module interfaces to protobuf
@@ -43,6 +46,9 @@ var (
 )
 
 func init() {
+	govtypes.RegisterProposalTypeCodec(&DenomMetadataProposal{}, "ibc.applications.transfer.v1.DenomMetadataProposal")
+	govtypes.RegisterProposalTypeCodec(&ReceiverDenylistProposal{}, "ibc.applications.transfer.v1.ReceiverDenylistProposal")
+
 	RegisterLegacyAminoCodec(ModuleCdc)
 	ModuleCdc.Seal()
 }
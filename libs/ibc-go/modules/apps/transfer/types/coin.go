@@ -30,6 +30,24 @@ func ReceiverChainIsSource(sourcePort, sourceChannel, denom string) bool {
 
 }
 
+// ReceivedDenom returns the local denomination of the tokens credited when
+// receiving a packet with the given denomination. Native tokens are returned
+// with their unprefixed denomination (e.g. "wei" for okt), vouchers in their
+// "ibc/{hash}" form.
+func ReceivedDenom(sourcePort, sourceChannel, destPort, destChannel, denom string) string {
+	if ReceiverChainIsSource(sourcePort, sourceChannel, denom) {
+		unprefixedDenom := denom[len(GetDenomPrefix(sourcePort, sourceChannel)):]
+
+		denomTrace := ParseDenomTrace(unprefixedDenom)
+		if denomTrace.Path != "" {
+			return denomTrace.IBCDenom()
+		}
+		return unprefixedDenom
+	}
+
+	return ParseDenomTrace(GetPrefixedDenom(destPort, destChannel, denom)).IBCDenom()
+}
+
 // GetDenomPrefix returns the receiving denomination prefix
 func GetDenomPrefix(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/", portID, channelID)
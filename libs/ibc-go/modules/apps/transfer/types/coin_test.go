@@ -0,0 +1,24 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceivedDenom(t *testing.T) {
+	testCases := []struct {
+		name     string
+		denom    string
+		expDenom string
+	}{
+		{"native token returning", "transfer/channelToA/wei", "wei"},
+		{"voucher returning", "transfer/channelToA/transfer/channelToC/uatom", DenomTrace{BaseDenom: "uatom", Path: "transfer/channelToC"}.IBCDenom()},
+		{"counterparty native token", "uatom", DenomTrace{BaseDenom: "uatom", Path: "transfer/channelToB"}.IBCDenom()},
+	}
+
+	for _, tc := range testCases {
+		denom := ReceivedDenom("transfer", "channelToA", "transfer", "channelToB", tc.denom)
+		require.Equal(t, tc.expDenom, denom, tc.name)
+	}
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+// MaxDenylistReceivers is the maximum number of receivers managed by a single proposal
+const MaxDenylistReceivers = 100
+
+// ValidateDeniedReceivers checks that the receivers are valid addresses on this
+// chain with no duplicates.
+func ValidateDeniedReceivers(receivers []string) error {
+	seen := make(map[string]bool, len(receivers))
+	for _, receiver := range receivers {
+		addr, err := sdk.AccAddressFromBech32(receiver)
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidReceiverDenylist, "invalid receiver %s: %s", receiver, err)
+		}
+		if seen[string(addr)] {
+			return sdkerrors.Wrapf(ErrInvalidReceiverDenylist, "duplicated receiver %s", receiver)
+		}
+		seen[string(addr)] = true
+	}
+	return nil
+}
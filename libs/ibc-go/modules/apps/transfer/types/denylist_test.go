@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDeniedReceivers(t *testing.T) {
+	receiver := addr1.String()
+
+	require.NoError(t, ValidateDeniedReceivers(nil))
+	require.NoError(t, ValidateDeniedReceivers([]string{receiver}))
+	require.Error(t, ValidateDeniedReceivers([]string{receiver, receiver}))
+	require.Error(t, ValidateDeniedReceivers([]string{"cosmos1invalid"}))
+}
+
+func TestReceiverDenylistProposal_ValidateBasic(t *testing.T) {
+	receiver := addr1.String()
+
+	testCases := []struct {
+		name     string
+		proposal *ReceiverDenylistProposal
+		expError bool
+	}{
+		{"valid proposal", NewReceiverDenylistProposal("title", "description", []string{receiver}, true).(*ReceiverDenylistProposal), false},
+		{"empty title", NewReceiverDenylistProposal("", "description", []string{receiver}, true).(*ReceiverDenylistProposal), true},
+		{"no receivers", NewReceiverDenylistProposal("title", "description", nil, false).(*ReceiverDenylistProposal), true},
+		{"invalid receiver", NewReceiverDenylistProposal("title", "description", []string{"receiver"}, true).(*ReceiverDenylistProposal), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+	}
+}
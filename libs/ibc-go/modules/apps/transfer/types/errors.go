@@ -12,4 +12,8 @@ var (
 	ErrSendDisabled            = sdkerrors.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
 	ErrReceiveDisabled         = sdkerrors.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
 	ErrMaxTransferChannels     = sdkerrors.Register(ModuleName, 9, "max transfer channels")
+	ErrInvalidMemo             = sdkerrors.Register(ModuleName, 10, "invalid memo")
+	ErrInvalidDenomMetadata    = sdkerrors.Register(ModuleName, 11, "invalid denomination metadata")
+	ErrReceiverDenied          = sdkerrors.Register(ModuleName, 12, "fungible token transfers to the receiver are denied")
+	ErrInvalidReceiverDenylist = sdkerrors.Register(ModuleName, 13, "invalid receiver denylist")
 )
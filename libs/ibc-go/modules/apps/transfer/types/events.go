@@ -2,15 +2,20 @@ package types
 
 // IBC transfer events
 const (
-	EventTypeTimeout      = "timeout"
-	EventTypePacket       = "fungible_token_packet"
-	EventTypeTransfer     = "ibc_transfer"
-	EventTypeChannelClose = "channel_closed"
-	EventTypeDenomTrace   = "denomination_trace"
+	EventTypeTimeout       = "timeout"
+	EventTypePacket        = "fungible_token_packet"
+	EventTypeTransfer      = "ibc_transfer"
+	EventTypeChannelClose  = "channel_closed"
+	EventTypeDenomTrace    = "denomination_trace"
+	EventTypeDenomMetadata = "denomination_metadata"
+	EventTypeDenyReceiver  = "deny_receiver"
+	EventTypeAllowReceiver = "allow_receiver"
+	EventTypeDeniedPacket  = "denied_receiver_packet"
 
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyDenom          = "denom"
 	AttributeKeyAmount         = "amount"
+	AttributeKeyMemo           = "memo"
 	AttributeKeyRefundReceiver = "refund_receiver"
 	AttributeKeyRefundDenom    = "refund_denom"
 	AttributeKeyRefundAmount   = "refund_amount"
@@ -18,4 +23,5 @@ const (
 	AttributeKeyAck            = "acknowledgement"
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
+	AttributeKeyDenomSymbol    = "symbol"
 )
@@ -3,20 +3,26 @@ package types
 import host "github.com/okex/exchain/libs/ibc-go/modules/core/24-host"
 
 // NewGenesisState creates a new ibc-transfer GenesisState instance.
-func NewGenesisState(portID string, denomTraces Traces, params Params) *GenesisState {
+func NewGenesisState(
+	portID string, denomTraces Traces, params Params, denomMetadata []DenomMetadata, deniedReceivers []string,
+) *GenesisState {
 	return &GenesisState{
-		PortId:      portID,
-		DenomTraces: denomTraces,
-		Params:      params,
+		PortId:          portID,
+		DenomTraces:     denomTraces,
+		Params:          params,
+		DenomMetadata:   denomMetadata,
+		DeniedReceivers: deniedReceivers,
 	}
 }
 
 // DefaultGenesisState returns a GenesisState with "transfer" as the default PortID.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		PortId:      PortID,
-		DenomTraces: Traces{},
-		Params:      DefaultParams(),
+		PortId:          PortID,
+		DenomTraces:     Traces{},
+		Params:          DefaultParams(),
+		DenomMetadata:   []DenomMetadata{},
+		DeniedReceivers: []string{},
 	}
 }
 
@@ -29,5 +35,11 @@ func (gs GenesisState) Validate() error {
 	if err := gs.DenomTraces.Validate(); err != nil {
 		return err
 	}
+	if err := ValidateDenomMetadata(gs.DenomMetadata); err != nil {
+		return err
+	}
+	if err := ValidateDeniedReceivers(gs.DeniedReceivers); err != nil {
+		return err
+	}
 	return gs.Params.Validate()
 }
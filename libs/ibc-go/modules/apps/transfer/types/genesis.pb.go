@@ -25,9 +25,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the ibc-transfer genesis state
 type GenesisState struct {
-	PortId      string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
-	DenomTraces Traces `protobuf:"bytes,2,rep,name=denom_traces,json=denomTraces,proto3,castrepeated=Traces" json:"denom_traces" yaml:"denom_traces"`
-	Params      Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	PortId          string          `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	DenomTraces     Traces          `protobuf:"bytes,2,rep,name=denom_traces,json=denomTraces,proto3,castrepeated=Traces" json:"denom_traces" yaml:"denom_traces"`
+	Params          Params          `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	DenomMetadata   []DenomMetadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata" yaml:"denom_metadata"`
+	DeniedReceivers []string        `protobuf:"bytes,5,rep,name=denied_receivers,json=deniedReceivers,proto3" json:"denied_receivers,omitempty" yaml:"denied_receivers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +86,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetDenomMetadata() []DenomMetadata {
+	if m != nil {
+		return m.DenomMetadata
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeniedReceivers() []string {
+	if m != nil {
+		return m.DeniedReceivers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v1.GenesisState")
 }
@@ -93,27 +109,32 @@ func init() {
 }
 
 var fileDescriptor_a4f788affd5bea89 = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0x2f, 0x29, 0x4a,
-	0xcc, 0x2b, 0x4e, 0x4b, 0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xc9, 0x4c, 0x4a, 0xd6, 0x43, 0x56, 0xab, 0x07,
-	0x53, 0xab, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41,
-	0xf4, 0x48, 0x69, 0xe3, 0x35, 0x1f, 0xae, 0x1f, 0xac, 0x58, 0xe9, 0x33, 0x23, 0x17, 0x8f, 0x3b,
-	0xc4, 0xca, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x6d, 0x2e, 0xf6, 0x82, 0xfc, 0xa2, 0x92, 0xf8,
-	0xcc, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0xa1, 0x4f, 0xf7, 0xe4, 0xf9, 0x2a, 0x13,
-	0x73, 0x73, 0xac, 0x94, 0xa0, 0x12, 0x4a, 0x41, 0x6c, 0x20, 0x96, 0x67, 0x8a, 0x50, 0x11, 0x17,
-	0x4f, 0x4a, 0x6a, 0x5e, 0x7e, 0x6e, 0x7c, 0x49, 0x51, 0x62, 0x72, 0x6a, 0xb1, 0x04, 0x93, 0x02,
-	0xb3, 0x06, 0xb7, 0x91, 0x86, 0x1e, 0x3e, 0x57, 0xeb, 0xb9, 0x80, 0x74, 0x84, 0x80, 0x34, 0x38,
-	0xa9, 0x9e, 0xb8, 0x27, 0xcf, 0xf0, 0xe9, 0x9e, 0xbc, 0x30, 0xc4, 0x7c, 0x64, 0xb3, 0x94, 0x56,
-	0xdd, 0x97, 0x67, 0x03, 0xab, 0x2a, 0x0e, 0xe2, 0x4e, 0x81, 0x6b, 0x29, 0x16, 0x72, 0xe2, 0x62,
-	0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x96, 0x60, 0x56, 0x60, 0xd4, 0xe0, 0x36, 0x52, 0xc1, 0x6f,
-	0x5b, 0x00, 0x58, 0xad, 0x13, 0x0b, 0xc8, 0xa6, 0x20, 0xa8, 0x4e, 0xa7, 0x88, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xb2, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0x86, 0x52, 0xba, 0xc5, 0x29, 0xd9, 0xfa,
-	0x15, 0xfa, 0xb8, 0xc3, 0xb6, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xac, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xda, 0xbb, 0x81, 0x1e, 0xe5, 0x01, 0x00, 0x00,
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x6a, 0xdb, 0x40,
+	0x1c, 0xc7, 0xa5, 0xca, 0x55, 0xb1, 0xec, 0xba, 0x45, 0x6d, 0xa9, 0x70, 0x5b, 0x49, 0x88, 0x16,
+	0x44, 0x4d, 0x75, 0xd8, 0xdd, 0x3a, 0x74, 0x10, 0xa5, 0xa5, 0x43, 0x20, 0x28, 0x19, 0x42, 0x16,
+	0x73, 0xd2, 0x5d, 0x94, 0x23, 0x96, 0x4e, 0xb9, 0xbb, 0x98, 0xf8, 0x2d, 0xf2, 0x1c, 0x19, 0xf2,
+	0x1c, 0x1e, 0x3d, 0x66, 0x52, 0x82, 0xfd, 0x06, 0x7e, 0x82, 0xa0, 0x3f, 0x36, 0xca, 0x10, 0x93,
+	0xe9, 0x7e, 0xfc, 0xee, 0xf3, 0xfd, 0x33, 0xfc, 0xb4, 0xef, 0x24, 0x8c, 0x00, 0xcc, 0xb2, 0x09,
+	0x89, 0xa0, 0x20, 0x34, 0xe5, 0x40, 0x30, 0x98, 0xf2, 0x13, 0xcc, 0xc0, 0x74, 0x08, 0x62, 0x9c,
+	0x62, 0x4e, 0xb8, 0x97, 0x31, 0x2a, 0xa8, 0xfe, 0x99, 0x84, 0x91, 0xd7, 0x64, 0xbd, 0x0d, 0xeb,
+	0x4d, 0x87, 0xfd, 0xf7, 0x31, 0x8d, 0x69, 0x09, 0x82, 0x62, 0xaa, 0x34, 0xfd, 0xc1, 0x4e, 0xff,
+	0xad, 0xbe, 0x84, 0x9d, 0x1b, 0x45, 0xeb, 0xfe, 0xab, 0x22, 0x0f, 0x04, 0x14, 0x58, 0x1f, 0x68,
+	0xaf, 0x32, 0xca, 0xc4, 0x98, 0x20, 0x43, 0xb6, 0x65, 0xb7, 0xed, 0xeb, 0xeb, 0xdc, 0xea, 0xcd,
+	0x60, 0x32, 0xf9, 0xe5, 0xd4, 0x1f, 0x4e, 0xa0, 0x16, 0xd3, 0x7f, 0xa4, 0x33, 0xad, 0x8b, 0x70,
+	0x4a, 0x93, 0xb1, 0x60, 0x30, 0xc2, 0xdc, 0x78, 0x61, 0x2b, 0x6e, 0x67, 0xe4, 0x7a, 0xbb, 0x5a,
+	0x7b, 0x7f, 0x0a, 0xc5, 0x61, 0x21, 0xf0, 0xbf, 0xcd, 0x73, 0x4b, 0x5a, 0xe7, 0xd6, 0xbb, 0xca,
+	0xbf, 0xe9, 0xe5, 0x5c, 0xdf, 0x59, 0x6a, 0x49, 0xf1, 0xa0, 0x83, 0xb6, 0x12, 0xae, 0xfb, 0x9a,
+	0x9a, 0x41, 0x06, 0x13, 0x6e, 0x28, 0xb6, 0xec, 0x76, 0x46, 0x5f, 0x77, 0xa7, 0xed, 0x97, 0xac,
+	0xdf, 0x2a, 0x92, 0x82, 0x5a, 0xa9, 0x9f, 0x6b, 0xbd, 0x2a, 0x2b, 0xc1, 0x02, 0x22, 0x28, 0xa0,
+	0xd1, 0x2a, 0x9b, 0x0f, 0x9e, 0xd1, 0x7c, 0xaf, 0x96, 0xf8, 0x5f, 0xea, 0xf2, 0x1f, 0x9a, 0xe5,
+	0x37, 0x86, 0x4e, 0xf0, 0x1a, 0x35, 0x69, 0xfd, 0xaf, 0xf6, 0x16, 0xe1, 0x94, 0x60, 0x34, 0x66,
+	0x38, 0xc2, 0x64, 0x8a, 0x19, 0x37, 0x5e, 0xda, 0x8a, 0xdb, 0xf6, 0x3f, 0xad, 0x73, 0xeb, 0xe3,
+	0xd6, 0xe3, 0x11, 0xe1, 0x04, 0x6f, 0xaa, 0x55, 0xb0, 0xd9, 0xf8, 0x47, 0xf3, 0xa5, 0x29, 0x2f,
+	0x96, 0xa6, 0x7c, 0xbf, 0x34, 0xe5, 0xab, 0x95, 0x29, 0x2d, 0x56, 0xa6, 0x74, 0xbb, 0x32, 0xa5,
+	0xe3, 0xdf, 0x31, 0x11, 0xa7, 0x17, 0xa1, 0x17, 0xd1, 0x04, 0x44, 0x94, 0x27, 0x94, 0xd7, 0xcf,
+	0x0f, 0x8e, 0xce, 0xc0, 0x25, 0x78, 0xfa, 0x2c, 0xc4, 0x2c, 0xc3, 0x3c, 0x54, 0xcb, 0x8b, 0xf8,
+	0xf9, 0x30, 0x00, 0x0a, 0xed, 0x5a, 0x5a, 0xa0, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +157,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DeniedReceivers) > 0 {
+		for iNdEx := len(m.DeniedReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedReceivers[iNdEx])
+			copy(dAtA[i:], m.DeniedReceivers[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeniedReceivers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DenomMetadata) > 0 {
+		for iNdEx := len(m.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -199,6 +243,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DenomMetadata) > 0 {
+		for _, e := range m.DenomMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeniedReceivers) > 0 {
+		for _, s := range m.DeniedReceivers {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -336,6 +392,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMetadata = append(m.DenomMetadata, DenomMetadata{})
+			if err := m.DenomMetadata[len(m.DenomMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedReceivers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedReceivers = append(m.DeniedReceivers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
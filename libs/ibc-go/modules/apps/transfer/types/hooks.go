@@ -77,3 +77,9 @@ func (mths MultiTransferHooks) AfterRefundTransfer(
 	}
 	return nil
 }
+
+// DenomMetadataHooks is called after the display metadata of a voucher denomination is registered.
+// An error fails the registration.
+type DenomMetadataHooks interface {
+	AfterDenomMetadataSet(ctx sdk.Context, metadata DenomMetadata) error
+}
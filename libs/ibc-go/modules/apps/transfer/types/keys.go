@@ -36,8 +36,17 @@ var (
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
 	DenomTraceKey = []byte{0x02}
+	// DenomMetadataKey defines the key to store the governance registered denomination metadata in store
+	DenomMetadataKey = []byte{0x03}
+	// ReceiverDenylistKey defines the key prefix to store the receivers transfers are denied to
+	ReceiverDenylistKey = []byte{0x04}
 )
 
+// DeniedReceiverKey returns the store key under which a denied receiver is kept
+func DeniedReceiverKey(receiver sdk.AccAddress) []byte {
+	return append(ReceiverDenylistKey, receiver.Bytes()...)
+}
+
 // GetEscrowAddress returns the escrow address for the specified channel.
 // The escrow address follows the format as outlined in ADR 028:
 // https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	tmbytes "github.com/okex/exchain/libs/tendermint/libs/bytes"
+)
+
+const (
+	// MaxSymbolLength is the maximum length of a denomination display symbol
+	MaxSymbolLength = 32
+	// MaxDisplayLength is the maximum length of a display denomination
+	MaxDisplayLength = 32
+	// MaxDecimals is the maximum number of decimals of a display denomination
+	MaxDecimals = 18
+	// MaxMetadataDescriptionLength is the maximum length of a denomination description
+	MaxMetadataDescriptionLength = 256
+	// MaxResolveDenoms is the maximum number of denominations resolved in a single query
+	MaxResolveDenoms = 100
+)
+
+// NewDenomMetadata creates a new DenomMetadata instance
+func NewDenomMetadata(denom, symbol, display string, decimals uint32, description string) DenomMetadata {
+	return DenomMetadata{
+		Denom:       denom,
+		Symbol:      symbol,
+		Display:     display,
+		Decimals:    decimals,
+		Description: description,
+	}
+}
+
+// Hash returns the denomination trace hash of the voucher denomination the
+// metadata refers to.
+func (m DenomMetadata) Hash() (tmbytes.HexBytes, error) {
+	denomSplit := strings.SplitN(m.Denom, "/", 2)
+	if len(denomSplit) != 2 || denomSplit[0] != DenomPrefix {
+		return nil, sdkerrors.Wrapf(ErrInvalidDenomMetadata, "denomination %s is not an ibc voucher, expected format 'ibc/{hash}'", m.Denom)
+	}
+
+	hash, err := ParseHexHash(denomSplit[1])
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidDenomMetadata, "invalid denom trace hash %s: %s", denomSplit[1], err)
+	}
+	return hash, nil
+}
+
+// Validate performs a basic validation of the DenomMetadata fields.
+func (m DenomMetadata) Validate() error {
+	if _, err := m.Hash(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(m.Symbol) == "" {
+		return sdkerrors.Wrap(ErrInvalidDenomMetadata, "symbol cannot be blank")
+	}
+	if len(m.Symbol) > MaxSymbolLength {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "symbol length %d exceeds the maximum %d", len(m.Symbol), MaxSymbolLength)
+	}
+	if len(m.Display) > MaxDisplayLength {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "display length %d exceeds the maximum %d", len(m.Display), MaxDisplayLength)
+	}
+	if m.Decimals > MaxDecimals {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "decimals %d exceeds the maximum %d", m.Decimals, MaxDecimals)
+	}
+	if len(m.Description) > MaxMetadataDescriptionLength {
+		return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "description length %d exceeds the maximum %d", len(m.Description), MaxMetadataDescriptionLength)
+	}
+	return nil
+}
+
+// ValidateDenomMetadata validates a list of denomination metadata, checking
+// that no voucher denomination is registered twice.
+func ValidateDenomMetadata(metadata []DenomMetadata) error {
+	seen := make(map[string]bool)
+	for i, m := range metadata {
+		if err := m.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "failed denom metadata %d validation", i)
+		}
+		hash, _ := m.Hash()
+		if seen[hash.String()] {
+			return sdkerrors.Wrap(ErrInvalidDenomMetadata, fmt.Sprintf("duplicated metadata for denomination %s", m.Denom))
+		}
+		seen[hash.String()] = true
+	}
+	return nil
+}
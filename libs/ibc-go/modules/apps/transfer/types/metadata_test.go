@@ -0,0 +1,47 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenomMetadata_Validate(t *testing.T) {
+	denom := DenomTrace{BaseDenom: "uatom", Path: "transfer/channelToA"}.IBCDenom()
+
+	testCases := []struct {
+		name     string
+		metadata DenomMetadata
+		expError bool
+	}{
+		{"valid metadata", NewDenomMetadata(denom, "ATOM", "atom", 6, "cosmos hub staking token"), false},
+		{"valid metadata with upper case hash", NewDenomMetadata("ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", "ATOM", "atom", 6, ""), false},
+		{"base denomination", NewDenomMetadata("uatom", "ATOM", "atom", 6, ""), true},
+		{"invalid hash", NewDenomMetadata("ibc/7f1d3f", "ATOM", "atom", 6, ""), true},
+		{"blank symbol", NewDenomMetadata(denom, " ", "atom", 6, ""), true},
+		{"symbol too long", NewDenomMetadata(denom, strings.Repeat("A", MaxSymbolLength+1), "atom", 6, ""), true},
+		{"valid metadata without display", NewDenomMetadata(denom, "ATOM", "", 6, ""), false},
+		{"display too long", NewDenomMetadata(denom, "ATOM", strings.Repeat("a", MaxDisplayLength+1), 6, ""), true},
+		{"too many decimals", NewDenomMetadata(denom, "ATOM", "atom", MaxDecimals+1, ""), true},
+		{"description too long", NewDenomMetadata(denom, "ATOM", "atom", 6, strings.Repeat("a", MaxMetadataDescriptionLength+1)), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.metadata.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+	}
+}
+
+func TestValidateDenomMetadata(t *testing.T) {
+	denom := DenomTrace{BaseDenom: "uatom", Path: "transfer/channelToA"}.IBCDenom()
+	metadata := NewDenomMetadata(denom, "ATOM", "atom", 6, "")
+
+	require.NoError(t, ValidateDenomMetadata(nil))
+	require.NoError(t, ValidateDenomMetadata([]DenomMetadata{metadata}))
+	require.Error(t, ValidateDenomMetadata([]DenomMetadata{metadata, NewDenomMetadata(strings.ToUpper(denom[:4])+denom[4:], "ATOM2", "atom", 6, "")}))
+}
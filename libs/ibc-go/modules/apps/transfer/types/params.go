@@ -11,6 +11,8 @@ const (
 	DefaultSendEnabled = true
 	// DefaultReceiveEnabled enabled
 	DefaultReceiveEnabled = true
+	// DefaultMaxMemoLength is the default maximum memo length in bytes
+	DefaultMaxMemoLength uint64 = 32768
 )
 
 var (
@@ -18,6 +20,8 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyReceiveEnabled is store's key for ReceiveEnabled Params
 	KeyReceiveEnabled = []byte("ReceiveEnabled")
+	// KeyMaxMemoLength is store's key for MaxMemoLength Params
+	KeyMaxMemoLength = []byte("MaxMemoLength")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -26,16 +30,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the ibc transfer module
-func NewParams(enableSend, enableReceive bool) Params {
+func NewParams(enableSend, enableReceive bool, maxMemoLength uint64) Params {
 	return Params{
 		SendEnabled:    enableSend,
 		ReceiveEnabled: enableReceive,
+		MaxMemoLength:  maxMemoLength,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the ibc-transfer module
 func DefaultParams() Params {
-	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
+	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled, DefaultMaxMemoLength)
 }
 
 // Validate all ibc-transfer module parameters
@@ -44,7 +49,11 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateEnabled(p.ReceiveEnabled)
+	if err := validateEnabled(p.ReceiveEnabled); err != nil {
+		return err
+	}
+
+	return validateMaxMemoLength(p.MaxMemoLength)
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -52,6 +61,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, p.SendEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyReceiveEnabled, p.ReceiveEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyMaxMemoLength, p.MaxMemoLength, validateMaxMemoLength),
 	}
 }
 
@@ -63,3 +73,12 @@ func validateEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxMemoLength(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	govtypes "github.com/okex/exchain/libs/cosmos-sdk/x/gov/types"
+	exchaingov "github.com/okex/exchain/x/gov/types"
+)
+
+const (
+	// ProposalTypeDenomMetadata defines the type for a DenomMetadataProposal
+	ProposalTypeDenomMetadata = "DenomMetadata"
+	// ProposalTypeReceiverDenylist defines the type for a ReceiverDenylistProposal
+	ProposalTypeReceiverDenylist = "ReceiverDenylist"
+)
+
+var (
+	_ govtypes.Content = &DenomMetadataProposal{}
+	_ govtypes.Content = &ReceiverDenylistProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeDenomMetadata)
+	govtypes.RegisterProposalType(ProposalTypeReceiverDenylist)
+
+	exchaingov.RegisterProposalType(ProposalTypeDenomMetadata)
+	exchaingov.RegisterProposalType(ProposalTypeReceiverDenylist)
+}
+
+// NewDenomMetadataProposal creates a new denomination metadata proposal.
+func NewDenomMetadataProposal(title, description string, metadata DenomMetadata) govtypes.Content {
+	return &DenomMetadataProposal{
+		Title:       title,
+		Description: description,
+		Metadata:    metadata,
+	}
+}
+
+// GetTitle returns the title of a denomination metadata proposal.
+func (dmp *DenomMetadataProposal) GetTitle() string { return dmp.Title }
+
+// GetDescription returns the description of a denomination metadata proposal.
+func (dmp *DenomMetadataProposal) GetDescription() string { return dmp.Description }
+
+// ProposalRoute returns the routing key of a denomination metadata proposal.
+func (dmp *DenomMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a denomination metadata proposal.
+func (dmp *DenomMetadataProposal) ProposalType() string { return ProposalTypeDenomMetadata }
+
+// ValidateBasic runs basic stateless validity checks
+func (dmp *DenomMetadataProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(dmp); err != nil {
+		return err
+	}
+
+	return dmp.Metadata.Validate()
+}
+
+// NewReceiverDenylistProposal creates a new receiver denylist proposal.
+func NewReceiverDenylistProposal(title, description string, receivers []string, isAdded bool) govtypes.Content {
+	return &ReceiverDenylistProposal{
+		Title:       title,
+		Description: description,
+		Receivers:   receivers,
+		IsAdded:     isAdded,
+	}
+}
+
+// GetTitle returns the title of a receiver denylist proposal.
+func (rdp *ReceiverDenylistProposal) GetTitle() string { return rdp.Title }
+
+// GetDescription returns the description of a receiver denylist proposal.
+func (rdp *ReceiverDenylistProposal) GetDescription() string { return rdp.Description }
+
+// ProposalRoute returns the routing key of a receiver denylist proposal.
+func (rdp *ReceiverDenylistProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a receiver denylist proposal.
+func (rdp *ReceiverDenylistProposal) ProposalType() string { return ProposalTypeReceiverDenylist }
+
+// ValidateBasic runs basic stateless validity checks
+func (rdp *ReceiverDenylistProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(rdp); err != nil {
+		return err
+	}
+
+	if len(rdp.Receivers) == 0 {
+		return sdkerrors.Wrap(ErrInvalidReceiverDenylist, "receivers cannot be empty")
+	}
+	if len(rdp.Receivers) > MaxDenylistReceivers {
+		return sdkerrors.Wrapf(ErrInvalidReceiverDenylist, "too many receivers, got %d, max %d", len(rdp.Receivers), MaxDenylistReceivers)
+	}
+	return ValidateDeniedReceivers(rdp.Receivers)
+}
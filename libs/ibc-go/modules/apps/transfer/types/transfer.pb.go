@@ -91,6 +91,9 @@ type Params struct {
 	// receive_enabled enables or disables all cross-chain token transfers to this
 	// chain.
 	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty" yaml:"receive_enabled"`
+	// max_memo_length is the maximum length in bytes of the memo of a cross-chain
+	// token transfer, a value of zero disables the limit.
+	MaxMemoLength uint64 `protobuf:"varint,3,opt,name=max_memo_length,json=maxMemoLength,proto3" json:"max_memo_length,omitempty" yaml:"max_memo_length"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -140,9 +143,194 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxMemoLength() uint64 {
+	if m != nil {
+		return m.MaxMemoLength
+	}
+	return 0
+}
+
+// DenomMetadata defines the governance registered display information of an
+// ICS20 voucher denomination.
+type DenomMetadata struct {
+	// denom is the ibc voucher denomination (ibc/{hash}) the metadata refers to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// symbol is the display symbol of the token, such as ATOM.
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// decimals is the number of decimals of the display denomination.
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// description is an optional human readable description of the token.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// display is the denomination the token is displayed in, such as atom,
+	// with decimals decimals.
+	Display string `protobuf:"bytes,5,opt,name=display,proto3" json:"display,omitempty"`
+}
+
+func (m *DenomMetadata) Reset()         { *m = DenomMetadata{} }
+func (m *DenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*DenomMetadata) ProtoMessage()    {}
+func (*DenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{2}
+}
+func (m *DenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMetadata.Merge(m, src)
+}
+func (m *DenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMetadata proto.InternalMessageInfo
+
+func (m *DenomMetadata) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomMetadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *DenomMetadata) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *DenomMetadata) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DenomMetadata) GetDisplay() string {
+	if m != nil {
+		return m.Display
+	}
+	return ""
+}
+
+// DenomMetadataProposal is a gov Content type for registering or updating the
+// display metadata of an ICS20 voucher denomination.
+type DenomMetadataProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the metadata to be registered if the proposal passes
+	Metadata DenomMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *DenomMetadataProposal) Reset()         { *m = DenomMetadataProposal{} }
+func (m *DenomMetadataProposal) String() string { return proto.CompactTextString(m) }
+func (*DenomMetadataProposal) ProtoMessage()    {}
+func (*DenomMetadataProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{3}
+}
+func (m *DenomMetadataProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMetadataProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMetadataProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMetadataProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMetadataProposal.Merge(m, src)
+}
+func (m *DenomMetadataProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMetadataProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMetadataProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMetadataProposal proto.InternalMessageInfo
+
+// ReceiverDenylistProposal is a gov Content type for adding receiver addresses
+// to or removing them from the denylist of ICS20 transfers. Transfers to denied
+// receivers are rejected with an error acknowledgement.
+type ReceiverDenylistProposal struct {
+	// the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the receiver addresses on this chain
+	Receivers []string `protobuf:"bytes,3,rep,name=receivers,proto3" json:"receivers,omitempty"`
+	// add the receivers to the denylist if true, remove them otherwise
+	IsAdded bool `protobuf:"varint,4,opt,name=is_added,json=isAdded,proto3" json:"is_added,omitempty" yaml:"is_added"`
+}
+
+func (m *ReceiverDenylistProposal) Reset()         { *m = ReceiverDenylistProposal{} }
+func (m *ReceiverDenylistProposal) String() string { return proto.CompactTextString(m) }
+func (*ReceiverDenylistProposal) ProtoMessage()    {}
+func (*ReceiverDenylistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{4}
+}
+func (m *ReceiverDenylistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverDenylistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiverDenylistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiverDenylistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverDenylistProposal.Merge(m, src)
+}
+func (m *ReceiverDenylistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverDenylistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverDenylistProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiverDenylistProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*DenomTrace)(nil), "ibc.applications.transfer.v1.DenomTrace")
 	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+	proto.RegisterType((*DenomMetadata)(nil), "ibc.applications.transfer.v1.DenomMetadata")
+	proto.RegisterType((*DenomMetadataProposal)(nil), "ibc.applications.transfer.v1.DenomMetadataProposal")
+	proto.RegisterType((*ReceiverDenylistProposal)(nil), "ibc.applications.transfer.v1.ReceiverDenylistProposal")
 }
 
 func init() {
@@ -150,26 +338,41 @@ func init() {
 }
 
 var fileDescriptor_5041673e96e97901 = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0x2b, 0x31,
-	0x14, 0x86, 0x9b, 0x72, 0x29, 0xb7, 0x51, 0x14, 0xa2, 0x68, 0x29, 0x9a, 0xca, 0xac, 0x04, 0x71,
-	0x42, 0x75, 0x21, 0x74, 0x23, 0x54, 0xdd, 0x6b, 0x71, 0xe5, 0xa6, 0x24, 0x99, 0xe3, 0x34, 0x30,
-	0x99, 0x0c, 0x49, 0x3a, 0xd0, 0x47, 0x70, 0xe7, 0x63, 0xb9, 0xec, 0xd2, 0x55, 0x91, 0xf6, 0x0d,
-	0xfa, 0x04, 0x32, 0x69, 0x29, 0x83, 0xbb, 0xff, 0x9c, 0xf3, 0x7d, 0x67, 0xf1, 0xe3, 0x2b, 0x25,
-	0x24, 0xe3, 0x45, 0x91, 0x29, 0xc9, 0xbd, 0x32, 0xb9, 0x63, 0xde, 0xf2, 0xdc, 0xbd, 0x83, 0x65,
-	0x65, 0x7f, 0x97, 0xe3, 0xc2, 0x1a, 0x6f, 0xc8, 0x99, 0x12, 0x32, 0xae, 0xc3, 0xf1, 0x0e, 0x28,
-	0xfb, 0xdd, 0xe3, 0xd4, 0xa4, 0x26, 0x80, 0xac, 0x4a, 0x1b, 0x27, 0xba, 0xc7, 0xf8, 0x11, 0x72,
-	0xa3, 0x5f, 0x2d, 0x97, 0x40, 0x08, 0xfe, 0x57, 0x70, 0x3f, 0xe9, 0xa0, 0x0b, 0x74, 0xd9, 0x1e,
-	0x85, 0x4c, 0xce, 0x31, 0x16, 0xdc, 0xc1, 0x38, 0xa9, 0xb0, 0x4e, 0x33, 0x5c, 0xda, 0xd5, 0x26,
-	0x78, 0xd1, 0x07, 0xc2, 0xad, 0x67, 0x6e, 0xb9, 0x76, 0x64, 0x80, 0xf7, 0x1d, 0xe4, 0xc9, 0x18,
-	0x72, 0x2e, 0x32, 0x48, 0xc2, 0x97, 0xff, 0xc3, 0xd3, 0xf5, 0xa2, 0x77, 0x34, 0xe3, 0x3a, 0x1b,
-	0x44, 0xf5, 0x6b, 0x34, 0xda, 0xab, 0xc6, 0xa7, 0xcd, 0x44, 0x1e, 0xf0, 0xa1, 0x05, 0x09, 0xaa,
-	0x84, 0x9d, 0xde, 0x0c, 0x7a, 0x77, 0xbd, 0xe8, 0x9d, 0x6c, 0xf4, 0x3f, 0x40, 0x34, 0x3a, 0xd8,
-	0x6e, 0xb6, 0x4f, 0x86, 0x2f, 0x5f, 0x4b, 0x8a, 0xe6, 0x4b, 0x8a, 0x7e, 0x96, 0x14, 0x7d, 0xae,
-	0x68, 0x63, 0xbe, 0xa2, 0x8d, 0xef, 0x15, 0x6d, 0xbc, 0xdd, 0xa5, 0xca, 0x4f, 0xa6, 0x22, 0x96,
-	0x46, 0x33, 0x69, 0x9c, 0x36, 0x8e, 0x29, 0x21, 0xaf, 0x53, 0xc3, 0xca, 0x1b, 0xa6, 0x4d, 0x32,
-	0xcd, 0xc0, 0x55, 0x3d, 0xd7, 0xfa, 0xf5, 0xb3, 0x02, 0x9c, 0x68, 0x85, 0x9a, 0x6e, 0x7f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0xb1, 0xc8, 0x63, 0x0e, 0x89, 0x01, 0x00, 0x00,
+	// 534 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0xdb, 0x34, 0x4d, 0x26, 0x84, 0x48, 0xdb, 0x52, 0xac, 0xa8, 0x38, 0x91, 0x4f, 0x95,
+	0x2a, 0x6c, 0xb5, 0x1c, 0x90, 0x72, 0x41, 0x84, 0x72, 0x23, 0x52, 0xb1, 0x38, 0x71, 0x89, 0xd6,
+	0xf6, 0x90, 0xac, 0xb4, 0xeb, 0xb5, 0xbc, 0xdb, 0xa8, 0xf9, 0x03, 0x8e, 0x5c, 0xb8, 0x73, 0x40,
+	0x7c, 0x4b, 0x2f, 0x48, 0x3d, 0x72, 0x8a, 0x50, 0xf2, 0x07, 0xf9, 0x02, 0xe4, 0x75, 0x62, 0xa5,
+	0x41, 0xe2, 0xc2, 0x6d, 0xde, 0xcc, 0xbc, 0x99, 0xb7, 0x33, 0x3b, 0x70, 0xce, 0xc2, 0xc8, 0xa7,
+	0x69, 0xca, 0x59, 0x44, 0x35, 0x93, 0x89, 0xf2, 0x75, 0x46, 0x13, 0xf5, 0x09, 0x33, 0x7f, 0x7a,
+	0x51, 0xda, 0x5e, 0x9a, 0x49, 0x2d, 0xc9, 0x29, 0x0b, 0x23, 0x6f, 0x3b, 0xd9, 0x2b, 0x13, 0xa6,
+	0x17, 0x9d, 0xe3, 0xb1, 0x1c, 0x4b, 0x93, 0xe8, 0xe7, 0x56, 0xc1, 0x71, 0x5f, 0x01, 0x5c, 0x61,
+	0x22, 0xc5, 0x87, 0x8c, 0x46, 0x48, 0x08, 0x54, 0x53, 0xaa, 0x27, 0xb6, 0xd5, 0xb3, 0xce, 0x1a,
+	0x81, 0xb1, 0xc9, 0x33, 0x80, 0x90, 0x2a, 0x1c, 0xc5, 0x79, 0x9a, 0xbd, 0x67, 0x22, 0x8d, 0xdc,
+	0x63, 0x78, 0xee, 0x4f, 0x0b, 0x6a, 0xd7, 0x34, 0xa3, 0x42, 0x91, 0x3e, 0x3c, 0x52, 0x98, 0xc4,
+	0x23, 0x4c, 0x68, 0xc8, 0x31, 0x36, 0x55, 0xea, 0x83, 0xa7, 0xab, 0x79, 0xf7, 0x68, 0x46, 0x05,
+	0xef, 0xbb, 0xdb, 0x51, 0x37, 0x68, 0xe6, 0xf0, 0x6d, 0x81, 0xc8, 0x1b, 0x68, 0x67, 0x18, 0x21,
+	0x9b, 0x62, 0x49, 0xdf, 0x33, 0xf4, 0xce, 0x6a, 0xde, 0x3d, 0x29, 0xe8, 0x3b, 0x09, 0x6e, 0xf0,
+	0x78, 0xed, 0xd9, 0x14, 0x19, 0x40, 0x5b, 0xd0, 0xdb, 0x91, 0x40, 0x21, 0x47, 0x1c, 0x93, 0xb1,
+	0x9e, 0xd8, 0xfb, 0x3d, 0xeb, 0xac, 0xba, 0x5d, 0x64, 0x27, 0xc1, 0x0d, 0x5a, 0x82, 0xde, 0x0e,
+	0x51, 0xc8, 0x77, 0x05, 0xfe, 0x6a, 0x41, 0xcb, 0xbc, 0x6c, 0x88, 0x9a, 0xc6, 0x54, 0x53, 0x72,
+	0x0c, 0x07, 0xc5, 0xdb, 0x8b, 0xa9, 0x14, 0x80, 0x9c, 0x40, 0x4d, 0xcd, 0x44, 0x28, 0xf9, 0x7a,
+	0x24, 0x6b, 0x44, 0x3a, 0x50, 0x8f, 0x31, 0x62, 0x82, 0x72, 0x65, 0x9a, 0xb7, 0x82, 0x12, 0x93,
+	0x1e, 0x34, 0x63, 0x54, 0x51, 0xc6, 0xd2, 0x7c, 0x3d, 0x76, 0xd5, 0x10, 0xb7, 0x5d, 0xc4, 0x86,
+	0xc3, 0x98, 0xa9, 0x94, 0xd3, 0x99, 0x7d, 0x60, 0xa2, 0x1b, 0xe8, 0x7e, 0xb7, 0xe0, 0xc9, 0x03,
+	0x5d, 0xd7, 0x99, 0x4c, 0xa5, 0xa2, 0x3c, 0xd7, 0xa7, 0x99, 0xe6, 0xb8, 0xd1, 0x67, 0xc0, 0x6e,
+	0xaf, 0xbd, 0xbf, 0x7b, 0x0d, 0xa1, 0x2e, 0xd6, 0xb5, 0x8c, 0xd2, 0xe6, 0xe5, 0xb9, 0xf7, 0xaf,
+	0x1f, 0xe4, 0x3d, 0x68, 0x3f, 0xa8, 0xde, 0xcd, 0xbb, 0x95, 0xa0, 0x2c, 0xd1, 0xaf, 0x7e, 0xfe,
+	0xd6, 0xad, 0xb8, 0x3f, 0x2c, 0xb0, 0x83, 0x62, 0x2b, 0xd9, 0x15, 0x26, 0x33, 0xce, 0x94, 0xfe,
+	0x6f, 0xa5, 0xa7, 0xd0, 0x58, 0x6f, 0x3a, 0xcb, 0x87, 0xba, 0x9f, 0xff, 0xc0, 0xd2, 0x41, 0x3c,
+	0xa8, 0x33, 0x35, 0xa2, 0x71, 0x8c, 0xb1, 0x19, 0x69, 0x7d, 0x70, 0xb4, 0x9a, 0x77, 0xdb, 0xc5,
+	0xba, 0x37, 0x11, 0x37, 0x38, 0x64, 0xea, 0x75, 0x6e, 0x15, 0x42, 0x07, 0xef, 0xef, 0x16, 0x8e,
+	0x75, 0xbf, 0x70, 0xac, 0xdf, 0x0b, 0xc7, 0xfa, 0xb2, 0x74, 0x2a, 0xf7, 0x4b, 0xa7, 0xf2, 0x6b,
+	0xe9, 0x54, 0x3e, 0xbe, 0x1c, 0x33, 0x3d, 0xb9, 0x09, 0xbd, 0x48, 0x0a, 0x3f, 0x92, 0x4a, 0x48,
+	0xe5, 0xb3, 0x30, 0x7a, 0x3e, 0x96, 0xfe, 0xf4, 0xd2, 0x17, 0x32, 0xbe, 0xe1, 0xa8, 0xf2, 0x9b,
+	0xdc, 0xba, 0x45, 0x3d, 0x4b, 0x51, 0x85, 0x35, 0x73, 0x52, 0x2f, 0xfe, 0x0c, 0x00, 0x08, 0xca,
+	0xa3, 0x08, 0xb5, 0x03, 0x00, 0x00,
 }
 
 func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
@@ -229,6 +432,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxMemoLength != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.MaxMemoLength))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ReceiveEnabled {
 		i--
 		if m.ReceiveEnabled {
@@ -252,6 +460,165 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Display)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintTransfer(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomMetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTransfer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverDenylistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverDenylistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverDenylistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsAdded {
+		i--
+		if m.IsAdded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Receivers) > 0 {
+		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Receivers[iNdEx])
+			copy(dAtA[i:], m.Receivers[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.Receivers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTransfer(v)
 	base := offset
@@ -292,6 +659,82 @@ func (m *Params) Size() (n int) {
 	if m.ReceiveEnabled {
 		n += 2
 	}
+	if m.MaxMemoLength != 0 {
+		n += 1 + sovTransfer(uint64(m.MaxMemoLength))
+	}
+	return n
+}
+
+func (m *DenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTransfer(uint64(m.Decimals))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTransfer(uint64(l))
+	return n
+}
+
+func (m *ReceiverDenylistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if len(m.Receivers) > 0 {
+		for _, s := range m.Receivers {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if m.IsAdded {
+		n += 2
+	}
 	return n
 }
 
@@ -484,6 +927,535 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoLength", wireType)
+			}
+			m.MaxMemoLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomMetadataProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMetadataProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiverDenylistProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiverDenylistProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiverDenylistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receivers = append(m.Receivers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsAdded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsAdded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])
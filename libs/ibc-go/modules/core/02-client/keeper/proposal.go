@@ -11,36 +11,14 @@ import (
 // ClientUpdateProposal will try to update the client with the new header if and only if
 // the proposal passes. The localhost client is not allowed to be modified with a proposal.
 func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdateProposal) error {
-	if p.SubjectClientId == exported.Localhost || p.SubstituteClientId == exported.Localhost {
-		return sdkerrors.Wrap(types.ErrInvalidUpdateClientProposal, "cannot update localhost client with proposal")
-	}
-
-	subjectClientState, found := k.GetClientState(ctx, p.SubjectClientId)
-	if !found {
-		return sdkerrors.Wrapf(types.ErrClientNotFound, "subject client with ID %s", p.SubjectClientId)
+	subjectClientState, substituteClientState, err := k.checkClientUpdateProposal(ctx, p)
+	if err != nil {
+		return err
 	}
 
 	subjectClientStore := k.ClientStore(ctx, p.SubjectClientId)
-
-	if status := subjectClientState.Status(ctx, subjectClientStore, k.cdc); status == exported.Active {
-		return sdkerrors.Wrap(types.ErrInvalidUpdateClientProposal, "cannot update Active subject client")
-	}
-
-	substituteClientState, found := k.GetClientState(ctx, p.SubstituteClientId)
-	if !found {
-		return sdkerrors.Wrapf(types.ErrClientNotFound, "substitute client with ID %s", p.SubstituteClientId)
-	}
-
-	if subjectClientState.GetLatestHeight().GTE(substituteClientState.GetLatestHeight()) {
-		return sdkerrors.Wrapf(types.ErrInvalidHeight, "subject client state latest height is greater or equal to substitute client state latest height (%s >= %s)", subjectClientState.GetLatestHeight(), substituteClientState.GetLatestHeight())
-	}
-
 	substituteClientStore := k.ClientStore(ctx, p.SubstituteClientId)
 
-	if status := substituteClientState.Status(ctx, substituteClientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(types.ErrClientNotActive, "substitute client is not Active, status is %s", status)
-	}
-
 	clientState, err := subjectClientState.CheckSubstituteAndUpdateState(ctx, k.cdc, subjectClientStore, substituteClientStore, substituteClientState)
 	if err != nil {
 		return err
@@ -63,6 +41,50 @@ func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdatePropo
 	return nil
 }
 
+// ValidateClientUpdateProposal checks against the current state whether a client update proposal
+// could be applied, so that proposals targeting an Active subject or an unusable substitute are
+// rejected at submission instead of failing once the voting period is over.
+func (k Keeper) ValidateClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdateProposal) error {
+	_, _, err := k.checkClientUpdateProposal(ctx, p)
+	return err
+}
+
+// checkClientUpdateProposal returns the subject and substitute client states if the subject is
+// no longer Active (expired or frozen) and the substitute is an Active client ahead of it.
+func (k Keeper) checkClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdateProposal) (exported.ClientState, exported.ClientState, error) {
+	if p.SubjectClientId == exported.Localhost || p.SubstituteClientId == exported.Localhost {
+		return nil, nil, sdkerrors.Wrap(types.ErrInvalidUpdateClientProposal, "cannot update localhost client with proposal")
+	}
+
+	subjectClientState, found := k.GetClientState(ctx, p.SubjectClientId)
+	if !found {
+		return nil, nil, sdkerrors.Wrapf(types.ErrClientNotFound, "subject client with ID %s", p.SubjectClientId)
+	}
+
+	subjectClientStore := k.ClientStore(ctx, p.SubjectClientId)
+
+	if status := subjectClientState.Status(ctx, subjectClientStore, k.cdc); status == exported.Active {
+		return nil, nil, sdkerrors.Wrap(types.ErrInvalidUpdateClientProposal, "cannot update Active subject client")
+	}
+
+	substituteClientState, found := k.GetClientState(ctx, p.SubstituteClientId)
+	if !found {
+		return nil, nil, sdkerrors.Wrapf(types.ErrClientNotFound, "substitute client with ID %s", p.SubstituteClientId)
+	}
+
+	if subjectClientState.GetLatestHeight().GTE(substituteClientState.GetLatestHeight()) {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidHeight, "subject client state latest height is greater or equal to substitute client state latest height (%s >= %s)", subjectClientState.GetLatestHeight(), substituteClientState.GetLatestHeight())
+	}
+
+	substituteClientStore := k.ClientStore(ctx, p.SubstituteClientId)
+
+	if status := substituteClientState.Status(ctx, substituteClientStore, k.cdc); status != exported.Active {
+		return nil, nil, sdkerrors.Wrapf(types.ErrClientNotActive, "substitute client is not Active, status is %s", status)
+	}
+
+	return subjectClientState, substituteClientState, nil
+}
+
 func (k Keeper) HandleUpgradeProposal(ctx sdk.Context, p *types.UpgradeProposal) error {
 	clientState, err := types.UnpackClientState(p.UpgradedClientState)
 	if err != nil {
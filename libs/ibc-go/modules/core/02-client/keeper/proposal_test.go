@@ -157,3 +157,67 @@ func (suite *KeeperTestSuite) TestClientUpdateProposal() {
 	}
 
 }
+
+func (suite *KeeperTestSuite) TestValidateClientUpdateProposal() {
+	var (
+		subject, substitute string
+		freezeSubject       bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"frozen subject and active substitute", func() {}, true,
+		},
+		{
+			"active subject cannot be replaced", func() {
+				freezeSubject = false
+			}, false,
+		},
+		{
+			"substitute client does not exist", func() {
+				substitute = ibctesting.InvalidID
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			freezeSubject = true
+
+			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(subjectPath)
+			subject = subjectPath.EndpointA.ClientID
+
+			substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(substitutePath)
+			substitute = substitutePath.EndpointA.ClientID
+			substitutePath.EndpointA.UpdateClient()
+
+			tc.malleate()
+
+			if freezeSubject {
+				tmClientState, ok := suite.chainA.GetClientState(subject).(*ibctmtypes.ClientState)
+				suite.Require().True(ok)
+				tmClientState.FrozenHeight = tmClientState.LatestHeight
+				suite.chainA.App().GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), subject, tmClientState)
+			}
+
+			updateProp, ok := types.NewClientUpdateProposal(ibctesting.Title, ibctesting.Description, subject, substitute).(*types.ClientUpdateProposal)
+			suite.Require().True(ok)
+			err := suite.chainA.App().GetIBCKeeper().ClientKeeper.ValidateClientUpdateProposal(suite.chainA.GetContext(), updateProp)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
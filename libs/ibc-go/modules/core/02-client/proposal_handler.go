@@ -1,6 +1,8 @@
 package client
 
 import (
+	"time"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 	"github.com/okex/exchain/libs/ibc-go/modules/core/02-client/keeper"
@@ -20,3 +22,65 @@ func NewClientUpdateProposalHandler(k keeper.Keeper) govtypes.Handler {
 		}
 	}
 }
+
+// GovKeeper defines the expected gov keeper providing the default proposal parameters and checks
+type GovKeeper interface {
+	GetDepositParams(ctx sdk.Context) govtypes.DepositParams
+	GetVotingParams(ctx sdk.Context) govtypes.VotingParams
+	CheckMsgSubmitProposal(ctx sdk.Context, msg govtypes.MsgSubmitProposal) sdk.Error
+}
+
+// ProposalHandler checks client update proposals against the current client states when they are
+// submitted. Deposit and voting parameters are the default ones of the gov keeper.
+type ProposalHandler struct {
+	k         keeper.Keeper
+	govKeeper GovKeeper
+}
+
+// NewProposalHandler creates a new client proposal handler. The gov keeper has to be set
+// with SetGovKeeper before any proposal is submitted.
+func NewProposalHandler(k keeper.Keeper) *ProposalHandler {
+	return &ProposalHandler{k: k}
+}
+
+// SetGovKeeper sets the gov keeper providing the default proposal parameters
+func (h *ProposalHandler) SetGovKeeper(gk GovKeeper) {
+	h.govKeeper = gk
+}
+
+// GetMinDeposit returns min deposit
+func (h *ProposalHandler) GetMinDeposit(ctx sdk.Context, _ govtypes.Content) sdk.SysCoins {
+	return h.govKeeper.GetDepositParams(ctx).MinDeposit
+}
+
+// GetMaxDepositPeriod returns max deposit period
+func (h *ProposalHandler) GetMaxDepositPeriod(ctx sdk.Context, _ govtypes.Content) time.Duration {
+	return h.govKeeper.GetDepositParams(ctx).MaxDepositPeriod
+}
+
+// GetVotingPeriod returns voting period
+func (h *ProposalHandler) GetVotingPeriod(ctx sdk.Context, _ govtypes.Content) time.Duration {
+	return h.govKeeper.GetVotingParams(ctx).VotingPeriod
+}
+
+// CheckMsgSubmitProposal validates MsgSubmitProposal
+func (h *ProposalHandler) CheckMsgSubmitProposal(ctx sdk.Context, msg govtypes.MsgSubmitProposal) sdk.Error {
+	if err := h.govKeeper.CheckMsgSubmitProposal(ctx, msg); err != nil {
+		return err
+	}
+
+	switch content := msg.Content.(type) {
+	case *types.ClientUpdateProposal:
+		return h.k.ValidateClientUpdateProposal(ctx, content)
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ibc proposal content type: %T", content)
+	}
+}
+
+// nolint
+func (h *ProposalHandler) AfterSubmitProposalHandler(_ sdk.Context, _ govtypes.Proposal) {}
+func (h *ProposalHandler) AfterDepositPeriodPassed(_ sdk.Context, _ govtypes.Proposal)   {}
+func (h *ProposalHandler) RejectedHandler(_ sdk.Context, _ govtypes.Content)             {}
+func (h *ProposalHandler) VoteHandler(_ sdk.Context, _ govtypes.Proposal, _ govtypes.Vote) (string, sdk.Error) {
+	return "", nil
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"sync"
+)
+
+const defaultNamespace = "tendermint"
+
+var (
+	mtx       sync.RWMutex
+	enabled   bool
+	namespace = defaultNamespace
+)
+
+// Init sets the namespace shared by all the metrics of the node, and whether the metrics of the app are reported. It's
+// called on start with the instrumentation config of the node, before the metrics are created.
+func Init(enable bool, ns string) {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	enabled = enable
+	if ns != "" {
+		namespace = ns
+	}
+}
+
+// Enabled returns whether the metrics of the app are reported
+func Enabled() bool {
+	mtx.RLock()
+	defer mtx.RUnlock()
+	return enabled
+}
+
+// Namespace returns the namespace shared by all the metrics of the node
+func Namespace() string {
+	mtx.RLock()
+	defer mtx.RUnlock()
+	return namespace
+}
@@ -0,0 +1,90 @@
+package telemetry
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	FlagEnable      = "otel.enable"
+	FlagEndpoint    = "otel.endpoint"
+	FlagInsecure    = "otel.insecure"
+	FlagSampleRatio = "otel.sample-ratio"
+
+	DefaultEndpoint = "localhost:4317"
+
+	tracerName = "github.com/okex/exchain"
+)
+
+var (
+	enabled  int32
+	tracer   trace.Tracer = trace.NewNoopTracerProvider().Tracer(tracerName)
+	noopSpan              = trace.SpanFromContext(context.Background())
+)
+
+// Init starts exporting the spans to the OTLP collector over gRPC if enabled by the flags. The returned func flushes
+// the spans not exported yet and stops the exporter.
+func Init(serviceName string) (func(context.Context) error, error) {
+	if !viper.GetBool(FlagEnable) {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(viper.GetString(FlagEndpoint))}
+	if viper.GetBool(FlagInsecure) {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(context.Background(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(viper.GetFloat64(FlagSampleRatio)))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	tracer = provider.Tracer(tracerName)
+	atomic.StoreInt32(&enabled, 1)
+
+	return func(ctx context.Context) error {
+		atomic.StoreInt32(&enabled, 0)
+		return provider.Shutdown(ctx)
+	}, nil
+}
+
+// Enabled returns whether the spans are exported
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
+// StartSpan starts a span as the child of the span in parent, and returns the context holding the new span. Nothing
+// is allocated if the spans aren't exported.
+func StartSpan(parent context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !Enabled() {
+		return parent, noopSpan
+	}
+	if parent == nil {
+		parent = context.Background()
+	}
+	return tracer.Start(parent, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends the span, marking it failed if err isn't nil
+func EndSpan(span trace.Span, err error) {
+	if err != nil && span.IsRecording() {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,41 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestStartSpanDisabled(t *testing.T) {
+	parent := context.Background()
+	ctx, span := StartSpan(parent, "disabled")
+	require.Equal(t, parent, ctx)
+	require.False(t, span.IsRecording())
+	EndSpan(span, errors.New("failed"))
+}
+
+func TestStartSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer(tracerName)
+	atomic.StoreInt32(&enabled, 1)
+	defer atomic.StoreInt32(&enabled, 0)
+
+	ctx, parent := StartSpan(nil, "parent")
+	_, child := StartSpan(ctx, "child")
+	EndSpan(child, errors.New("failed"))
+	EndSpan(parent, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, "child", spans[0].Name())
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+	require.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	require.Equal(t, "parent", spans[1].Name())
+	require.Equal(t, codes.Unset, spans[1].Status().Code)
+}
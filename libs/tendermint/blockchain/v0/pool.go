@@ -65,8 +65,9 @@ type BlockPool struct {
 
 	mtx sync.Mutex
 	// block requests
-	requesters map[int64]*bpRequester
-	height     int64 // the lowest key in requesters.
+	requesters  map[int64]*bpRequester
+	height      int64 // the lowest key in requesters.
+	startHeight int64 // the height the sync started from.
 	// peers
 	peers         map[p2p.ID]*bpPeer
 	maxPeerHeight int64 // the biggest reported height
@@ -84,9 +85,10 @@ func NewBlockPool(start int64, requestsCh chan<- BlockRequest, errorsCh chan<- p
 	bp := &BlockPool{
 		peers: make(map[p2p.ID]*bpPeer),
 
-		requesters: make(map[int64]*bpRequester),
-		height:     start,
-		numPending: 0,
+		requesters:  make(map[int64]*bpRequester),
+		height:      start,
+		startHeight: start,
+		numPending:  0,
 
 		requestsCh: requestsCh,
 		errorsCh:   errorsCh,
@@ -100,6 +102,7 @@ func (pool *BlockPool) SetHeight(height int64) {
 	defer pool.mtx.Unlock()
 
 	pool.height = height
+	pool.startHeight = height
 }
 
 // OnStart implements service.Service by spawning requesters routine and recording
@@ -183,6 +186,15 @@ func (pool *BlockPool) GetStatus() (height int64, numPending int32, lenRequester
 	return pool.height, atomic.LoadInt32(&pool.numPending), len(pool.requesters)
 }
 
+// GetSyncProgress returns the height the sync started from and the biggest
+// height reported by the peers.
+func (pool *BlockPool) GetSyncProgress() (startHeight, maxPeerHeight int64) {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+
+	return pool.startHeight, pool.maxPeerHeight
+}
+
 // IsCaughtUp returns true if this node is caught up, false - otherwise.
 // TODO: relax conditions, prevent abuse.
 func (pool *BlockPool) IsCaughtUp() bool {
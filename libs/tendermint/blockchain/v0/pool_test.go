@@ -228,3 +228,21 @@ func TestBlockPoolRemovePeer(t *testing.T) {
 
 	assert.EqualValues(t, 0, pool.MaxPeerHeight())
 }
+
+func TestBlockPoolSyncProgress(t *testing.T) {
+	pool := NewBlockPool(7, make(chan BlockRequest), make(chan peerError))
+	startHeight, maxPeerHeight := pool.GetSyncProgress()
+	assert.EqualValues(t, 7, startHeight)
+	assert.EqualValues(t, 0, maxPeerHeight)
+
+	pool.SetPeerRange(p2p.ID("1"), 0, 20, 0)
+	pool.SetPeerRange(p2p.ID("2"), 0, 30, 0)
+	startHeight, maxPeerHeight = pool.GetSyncProgress()
+	assert.EqualValues(t, 7, startHeight)
+	assert.EqualValues(t, 30, maxPeerHeight)
+
+	// the sync restarts from the new height
+	pool.SetHeight(12)
+	startHeight, _ = pool.GetSyncProgress()
+	assert.EqualValues(t, 12, startHeight)
+}
@@ -440,6 +440,12 @@ func (bcR *BlockchainReactor) SwitchToConsensus(state sm.State) bool {
 	return false
 }
 
+// SyncProgress returns the height the fast sync started from and the highest
+// height reported by the peers.
+func (bcR *BlockchainReactor) SyncProgress() (startHeight, highestHeight int64) {
+	return bcR.pool.GetSyncProgress()
+}
+
 // BroadcastStatusRequest broadcasts `BlockStore` base and height.
 func (bcR *BlockchainReactor) BroadcastStatusRequest() error {
 	msgBytes := cdc.MustMarshalBinaryBare(&bcStatusRequestMessage{
@@ -66,6 +66,7 @@ func AddNodeFlags(cmd *cobra.Command) {
 		config.P2P.ListenAddress,
 		"Node listen address. (0.0.0.0:0 means any interface, any port)")
 	cmd.Flags().String("p2p.seeds", config.P2P.Seeds, "Comma-delimited ID@host:port seed nodes")
+	cmd.Flags().String("p2p.seeds_file", config.P2P.SeedsPath, "Address book exported by a seed node whose seeds are used along with p2p.seeds")
 	cmd.Flags().String("p2p.persistent_peers", config.P2P.PersistentPeers, "Comma-delimited ID@host:port persistent peers")
 	cmd.Flags().String("p2p.unconditional_peer_ids",
 		config.P2P.UnconditionalPeerIDs, "Comma-delimited IDs of unconditional peers")
@@ -176,6 +177,41 @@ func AddNodeFlags(cmd *cobra.Command) {
 		config.Mempool.PendingRemoveEvent,
 		"Push event when remove a pending tx",
 	)
+	cmd.Flags().String(
+		"mempool.wal_dir",
+		config.Mempool.WalPath,
+		"Directory of the wal of the evm txs submitted through the rpc, which are rechecked on restart, empty disables it",
+	)
+	cmd.Flags().Int64(
+		"mempool.wal_rotate_blocks",
+		config.Mempool.WalRotateBlocks,
+		"Rewrite the mempool wal with the txs still in the mempool every this many blocks",
+	)
+	cmd.Flags().Int64(
+		"mempool.peer_tx_send_rate",
+		config.Mempool.PeerTxSendRate,
+		"Maximum rate at which the txs are relayed to a peer, in bytes/second, 0 means unlimited",
+	)
+	cmd.Flags().Int64(
+		"mempool.peer_tx_recv_rate",
+		config.Mempool.PeerTxRecvRate,
+		"Maximum rate at which the txs are accepted from a peer, in bytes/second, 0 means unlimited",
+	)
+	cmd.Flags().Int(
+		"mempool.peer_min_score",
+		config.Mempool.PeerMinScore,
+		"The txs of the peers scored under this from 0 to 100 are dropped, 0 disables it",
+	)
+	cmd.Flags().Duration(
+		"mempool.peer_max_latency",
+		config.Mempool.PeerMaxLatency,
+		"The ping round trip time over which the latency of a peer lowers its score, 0 disables it",
+	)
+	cmd.Flags().String(
+		"mempool.ordering_policy",
+		config.Mempool.OrderingPolicy,
+		"Name of the policy ordering the txs of the block proposals, default keeps the order of the mempool",
+	)
 
 	cmd.Flags().String(
 		"mempool.node_key_whitelist",
@@ -199,6 +235,21 @@ func AddNodeFlags(cmd *cobra.Command) {
 		config.TxIndex.Indexer,
 		"indexer to use for transactions, options: null, kv",
 	)
+	cmd.Flags().Int64(
+		"tx_index.retain_blocks",
+		config.TxIndex.RetainBlocks,
+		"number of the latest blocks whose txs and block events are kept, 0 keeps all",
+	)
+	cmd.Flags().Duration(
+		"tx_index.retain_time",
+		config.TxIndex.RetainTime,
+		"period of time during which the txs and block events are kept, 0 keeps all",
+	)
+	cmd.Flags().Duration(
+		"tx_index.prune_interval",
+		config.TxIndex.PruneInterval,
+		"how often the indexed txs and block events are pruned",
+	)
 	cmd.Flags().String(
 		"local_perf",
 		"",
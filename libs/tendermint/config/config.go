@@ -143,6 +143,9 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "Error in [consensus] section")
 	}
+	if err := cfg.TxIndex.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "Error in [tx_index] section")
+	}
 	return errors.Wrap(
 		cfg.Instrumentation.ValidateBasic(),
 		"Error in [instrumentation] section",
@@ -214,9 +217,28 @@ type BaseConfig struct { //nolint: maligned
 	PrivValidatorState string `mapstructure:"priv_validator_state_file"`
 
 	// TCP or UNIX socket address for Tendermint to listen on for
-	// connections from an external PrivValidator process
+	// connections from an external PrivValidator process, or grpc://host:port of
+	// the external PrivValidator gRPC service to connect to
 	PrivValidatorListenAddr string `mapstructure:"priv_validator_laddr"`
 
+	// Timeout of the requests to the external PrivValidator
+	PrivValidatorRequestTimeout time.Duration `mapstructure:"priv_validator_request_timeout"`
+
+	// Number of the attempts of each request to the external PrivValidator before giving up
+	// 0 - retry indefinitely
+	PrivValidatorRetries int `mapstructure:"priv_validator_retries"`
+
+	// Path to the lease file shared by the validator nodes of the same key on a shared file system.
+	// If set, the node runs as a hot standby and only signs while holding the lease
+	PrivValidatorLease string `mapstructure:"priv_validator_lease_file"`
+
+	// The lease expires if the holder doesn't renew it within this duration, then a standby node takes it over
+	PrivValidatorLeaseTTL time.Duration `mapstructure:"priv_validator_lease_ttl"`
+
+	// URL of the redis keeping the last height/round/step signed with the validator key, shared by all the hosts
+	// of the key so that they never sign the same or a previous height/round/step, e.g. redis://localhost:6379/0
+	PrivValidatorSignStateRedis string `mapstructure:"priv_validator_sign_state_redis"`
+
 	// A JSON file containing the private key to use for p2p authenticated encryption
 	NodeKey string `mapstructure:"node_key_file"`
 
@@ -257,6 +279,10 @@ func DefaultBaseConfig() BaseConfig {
 		LogFile:            defaultLogFile,
 		LogStdout:          true,
 		ProfListenAddress:  "localhost:6060",
+
+		PrivValidatorRequestTimeout: 5 * time.Second,
+		PrivValidatorRetries:        50,
+		PrivValidatorLeaseTTL:       15 * time.Second,
 	}
 }
 
@@ -285,6 +311,14 @@ func (cfg BaseConfig) PrivValidatorKeyFile() string {
 	return rootify(cfg.PrivValidatorKey, cfg.RootDir)
 }
 
+// PrivValidatorLeaseFile returns the full path to the lease file of the hot standby, or empty if not set
+func (cfg BaseConfig) PrivValidatorLeaseFile() string {
+	if cfg.PrivValidatorLease == "" {
+		return ""
+	}
+	return rootify(cfg.PrivValidatorLease, cfg.RootDir)
+}
+
 // PrivValidatorFile returns the full path to the priv_validator_state.json file
 func (cfg BaseConfig) PrivValidatorStateFile() string {
 	return rootify(cfg.PrivValidatorState, cfg.RootDir)
@@ -308,6 +342,15 @@ func (cfg BaseConfig) ValidateBasic() error {
 	default:
 		return errors.New("unknown log_format (must be 'plain' or 'json')")
 	}
+	if cfg.PrivValidatorRequestTimeout <= 0 {
+		return errors.New("priv_validator_request_timeout must be positive")
+	}
+	if cfg.PrivValidatorRetries < 0 {
+		return errors.New("priv_validator_retries can't be negative")
+	}
+	if cfg.PrivValidatorLease != "" && cfg.PrivValidatorLeaseTTL <= 0 {
+		return errors.New("priv_validator_lease_ttl must be positive")
+	}
 	return nil
 }
 
@@ -345,7 +388,7 @@ type RPCConfig struct {
 	CORSAllowedHeaders []string `mapstructure:"cors_allowed_headers"`
 
 	// TCP or UNIX socket address for the gRPC server to listen on
-	// NOTE: This server only supports /broadcast_tx_commit
+	// NOTE: This server only supports /broadcast_tx_commit and the block stream
 	GRPCListenAddress string `mapstructure:"grpc_laddr"`
 
 	// Maximum number of simultaneous connections.
@@ -406,6 +449,13 @@ type RPCConfig struct {
 	// NOTE: both tls_cert_file and tls_key_file must be present for Tendermint to create HTTPS server.
 	// Otherwise, HTTP server is run.
 	TLSKeyFile string `mapstructure:"tls_key_file"`
+
+	// The node is not reported ready by /ready if the latest block is older than it.
+	// 0 - the age of the latest block is not checked.
+	ReadyMaxBlockAge time.Duration `mapstructure:"ready_max_block_age"`
+
+	// The node is not reported ready by /ready if it has less peers than it.
+	ReadyMinPeers int `mapstructure:"ready_min_peers"`
 }
 
 // DefaultRPCConfig returns a default configuration for the RPC server
@@ -430,6 +480,9 @@ func DefaultRPCConfig() *RPCConfig {
 
 		TLSCertFile: "",
 		TLSKeyFile:  "",
+
+		ReadyMaxBlockAge: 30 * time.Second,
+		ReadyMinPeers:    1,
 	}
 }
 
@@ -466,6 +519,12 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.MaxHeaderBytes < 0 {
 		return errors.New("max_header_bytes can't be negative")
 	}
+	if cfg.ReadyMaxBlockAge < 0 {
+		return errors.New("ready_max_block_age can't be negative")
+	}
+	if cfg.ReadyMinPeers < 0 {
+		return errors.New("ready_min_peers can't be negative")
+	}
 	return nil
 }
 
@@ -511,6 +570,10 @@ type P2PConfig struct { //nolint: maligned
 	// We only use these if we can’t connect to peers in the addrbook
 	Seeds string `mapstructure:"seeds"`
 
+	// Path to an address book exported by a seed node, its seeds are used
+	// along with the ones above
+	SeedsPath string `mapstructure:"seeds_file"`
+
 	// Comma separated list of nodes to keep persistent connections to
 	PersistentPeers string `mapstructure:"persistent_peers"`
 
@@ -616,6 +679,15 @@ func (cfg *P2PConfig) AddrBookFile() string {
 	return rootify(cfg.AddrBook, cfg.RootDir)
 }
 
+// SeedsFile returns the full path to the exported address book of the seeds,
+// empty if there is none.
+func (cfg *P2PConfig) SeedsFile() string {
+	if cfg.SeedsPath == "" {
+		return ""
+	}
+	return rootify(cfg.SeedsPath, cfg.RootDir)
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *P2PConfig) ValidateBasic() error {
@@ -689,6 +761,17 @@ type MempoolConfig struct {
 	PendingPoolMaxTxPerAddress int      `mapstructure:"pending_pool_max_tx_per_address"`
 	NodeKeyWhitelist           []string `mapstructure:"node_key_whitelist"`
 	PendingRemoveEvent         bool     `mapstructure:"pending_remove_event"`
+	WalPath                    string   `mapstructure:"wal_dir"`
+	WalRotateBlocks            int64    `mapstructure:"wal_rotate_blocks"`
+
+	// relay of the txs with the peers
+	PeerTxSendRate int64         `mapstructure:"peer_tx_send_rate"`
+	PeerTxRecvRate int64         `mapstructure:"peer_tx_recv_rate"`
+	PeerMinScore   int           `mapstructure:"peer_min_score"`
+	PeerMaxLatency time.Duration `mapstructure:"peer_max_latency"`
+
+	// name of the policy ordering the txs of the block proposals
+	OrderingPolicy string `mapstructure:"ordering_policy"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the Tendermint mempool
@@ -715,6 +798,13 @@ func DefaultMempoolConfig() *MempoolConfig {
 		PendingPoolMaxTxPerAddress: 100,
 		NodeKeyWhitelist:           []string{},
 		PendingRemoveEvent:         false,
+		WalPath:                    "",
+		WalRotateBlocks:            100,
+		PeerTxSendRate:             0,
+		PeerTxRecvRate:             0,
+		PeerMinScore:               20,
+		PeerMaxLatency:             3 * time.Second,
+		OrderingPolicy:             "default",
 	}
 }
 
@@ -735,6 +825,16 @@ func (cfg *MempoolConfig) GetNodeKeyWhitelist() []string {
 	return cfg.NodeKeyWhitelist
 }
 
+// WalDir returns the full path to the mempool's write-ahead log
+func (cfg *MempoolConfig) WalDir() string {
+	return rootify(cfg.WalPath, cfg.RootDir)
+}
+
+// WalEnabled returns true if the WAL is enabled.
+func (cfg *MempoolConfig) WalEnabled() bool {
+	return cfg.WalPath != ""
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *MempoolConfig) ValidateBasic() error {
@@ -756,6 +856,21 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.ForceRecheckGap <= 0 {
 		return errors.New("force_recheck_gap can't be negative or zero")
 	}
+	if cfg.WalRotateBlocks <= 0 {
+		return errors.New("wal_rotate_blocks can't be negative or zero")
+	}
+	if cfg.PeerTxSendRate < 0 {
+		return errors.New("peer_tx_send_rate can't be negative")
+	}
+	if cfg.PeerTxRecvRate < 0 {
+		return errors.New("peer_tx_recv_rate can't be negative")
+	}
+	if cfg.PeerMinScore < 0 || cfg.PeerMinScore > 100 {
+		return errors.New("peer_min_score must be within [0, 100]")
+	}
+	if cfg.PeerMaxLatency < 0 {
+		return errors.New("peer_max_latency can't be negative")
+	}
 	return nil
 }
 
@@ -989,6 +1104,20 @@ type TxIndexConfig struct {
 	// precedence over IndexAllKeys (i.e. when given both, IndexKeys will be
 	// indexed).
 	IndexAllKeys bool `mapstructure:"index_all_keys"`
+
+	// Number of the latest blocks whose txs and block events are kept, the
+	// older ones are pruned. 0 keeps all the blocks.
+	RetainBlocks int64 `mapstructure:"retain_blocks"`
+
+	// Period of time during which the txs and block events are kept, the
+	// older ones are pruned. 0 keeps all the blocks.
+	//
+	// When both RetainBlocks and RetainTime are set, the blocks kept by either
+	// of them are kept.
+	RetainTime time.Duration `mapstructure:"retain_time"`
+
+	// How often the indexed txs and block events are pruned.
+	PruneInterval time.Duration `mapstructure:"prune_interval"`
 }
 
 // DefaultTxIndexConfig returns a default configuration for the transaction indexer.
@@ -997,6 +1126,10 @@ func DefaultTxIndexConfig() *TxIndexConfig {
 		Indexer:      "kv",
 		IndexKeys:    "",
 		IndexAllKeys: false,
+
+		RetainBlocks:  0,
+		RetainTime:    0,
+		PruneInterval: 10 * time.Minute,
 	}
 }
 
@@ -1005,6 +1138,26 @@ func TestTxIndexConfig() *TxIndexConfig {
 	return DefaultTxIndexConfig()
 }
 
+// PruningEnabled returns true if the indexed txs and block events are pruned.
+func (cfg *TxIndexConfig) PruningEnabled() bool {
+	return cfg.RetainBlocks > 0 || cfg.RetainTime > 0
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *TxIndexConfig) ValidateBasic() error {
+	if cfg.RetainBlocks < 0 {
+		return errors.New("retain_blocks can't be negative")
+	}
+	if cfg.RetainTime < 0 {
+		return errors.New("retain_time can't be negative")
+	}
+	if cfg.PruningEnabled() && cfg.PruneInterval <= 0 {
+		return errors.New("prune_interval must be positive when pruning")
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // InstrumentationConfig
 
@@ -1026,6 +1179,11 @@ type InstrumentationConfig struct {
 
 	// Instrumentation namespace.
 	Namespace string `mapstructure:"namespace"`
+
+	// When true, the metrics of the app (store operations, IAVL node cache
+	// hit rates, commit latencies and RPC latencies) are also reported under
+	// Namespace.
+	AppMetrics bool `mapstructure:"app_metrics"`
 }
 
 // DefaultInstrumentationConfig returns a default configuration for metrics
@@ -112,6 +112,7 @@ db_backend = "{{ .BaseConfig.DBBackend }}"
 db_dir = "{{ js .BaseConfig.DBPath }}"
 
 # Output level for logging, including package level options
+# It can be changed at runtime by the unsafe_set_log_level rpc, or reloaded from this file on SIGHUP
 log_level = "{{ .BaseConfig.LogLevel }}"
 
 # Output format: 'plain' (colored text) or 'json'
@@ -129,9 +130,28 @@ priv_validator_key_file = "{{ js .BaseConfig.PrivValidatorKey }}"
 priv_validator_state_file = "{{ js .BaseConfig.PrivValidatorState }}"
 
 # TCP or UNIX socket address for Tendermint to listen on for
-# connections from an external PrivValidator process
+# connections from an external PrivValidator process, or grpc://host:port of
+# the external PrivValidator gRPC service to connect to
 priv_validator_laddr = "{{ .BaseConfig.PrivValidatorListenAddr }}"
 
+# Timeout of the requests to the external PrivValidator
+priv_validator_request_timeout = "{{ .BaseConfig.PrivValidatorRequestTimeout }}"
+
+# Number of the attempts of each request to the external PrivValidator before giving up
+# 0 - retry indefinitely
+priv_validator_retries = {{ .BaseConfig.PrivValidatorRetries }}
+
+# Path to the lease file shared by the validator nodes of the same key on a shared file system.
+# If set, the node runs as a hot standby and only signs while holding the lease
+priv_validator_lease_file = "{{ js .BaseConfig.PrivValidatorLease }}"
+
+# The lease expires if the holder doesn't renew it within this duration, then a standby node takes it over
+priv_validator_lease_ttl = "{{ .BaseConfig.PrivValidatorLeaseTTL }}"
+
+# URL of the redis keeping the last height/round/step signed with the validator key, shared by all the hosts
+# of the key so that they never sign the same or a previous height/round/step, e.g. redis://localhost:6379/0
+priv_validator_sign_state_redis = "{{ js .BaseConfig.PrivValidatorSignStateRedis }}"
+
 # Path to the JSON file containing the private key to use for node authentication in the p2p protocol
 node_key_file = "{{ js .BaseConfig.NodeKey }}"
 
@@ -165,7 +185,8 @@ cors_allowed_methods = [{{ range .RPC.CORSAllowedMethods }}{{ printf "%q, " . }}
 cors_allowed_headers = [{{ range .RPC.CORSAllowedHeaders }}{{ printf "%q, " . }}{{end}}]
 
 # TCP or UNIX socket address for the gRPC server to listen on
-# NOTE: This server only supports /broadcast_tx_commit
+# NOTE: This server only supports /broadcast_tx_commit and the block stream
+# (tendermint.rpc.grpc.BlockStream/SubscribeBlocks)
 grpc_laddr = "{{ .RPC.GRPCListenAddress }}"
 
 # Maximum number of simultaneous connections.
@@ -226,6 +247,13 @@ tls_cert_file = "{{ .RPC.TLSCertFile }}"
 # Otherwise, HTTP server is run.
 tls_key_file = "{{ .RPC.TLSKeyFile }}"
 
+# The node is not reported ready by /ready if the latest block is older than it
+# 0 - the age of the latest block is not checked
+ready_max_block_age = "{{ .RPC.ReadyMaxBlockAge }}"
+
+# The node is not reported ready by /ready if it has less peers than it
+ready_min_peers = {{ .RPC.ReadyMinPeers }}
+
 ##### peer to peer configuration options #####
 [p2p]
 
@@ -241,6 +269,10 @@ external_address = "{{ .P2P.ExternalAddress }}"
 # Comma separated list of seed nodes to connect to
 seeds = "{{ .P2P.Seeds }}"
 
+# Path to an address book exported by a seed node, in the json format of the
+# "tendermint export-addrbook" command. Its seeds are used along with the ones above.
+seeds_file = "{{ js .P2P.SeedsPath }}"
+
 # Comma separated list of nodes to keep persistent connections to
 persistent_peers = "{{ .P2P.PersistentPeers }}"
 
@@ -304,6 +336,34 @@ recheck = {{ .Mempool.Recheck }}
 force_recheck_gap = {{ .Mempool.ForceRecheckGap }}
 broadcast = {{ .Mempool.Broadcast }}
 
+# Directory of the write-ahead log of the evm txs submitted through the rpc of
+# the node, they're rechecked and added back to the mempool on restart. Leave
+# empty to disable it.
+wal_dir = "{{ js .Mempool.WalPath }}"
+
+# Rewrite the wal with the txs still in the mempool every this many blocks
+wal_rotate_blocks = {{ .Mempool.WalRotateBlocks }}
+
+# Maximum rate at which the txs are relayed to a peer and accepted from a peer,
+# in bytes/second. The txs received over the limit are dropped. 0 means
+# unlimited.
+peer_tx_send_rate = {{ .Mempool.PeerTxSendRate }}
+peer_tx_recv_rate = {{ .Mempool.PeerTxRecvRate }}
+
+# The peers are scored from 0 to 100 on the rate of their invalid txs, their
+# useless messages and their latency. The txs of the peers scored under
+# peer_min_score are dropped until their score recovers. 0 disables it.
+peer_min_score = {{ .Mempool.PeerMinScore }}
+
+# The ping round trip time over which the latency of a peer lowers its score.
+# 0 disables it.
+peer_max_latency = "{{ .Mempool.PeerMaxLatency }}"
+
+# Name of the policy ordering the txs reaped from the mempool for the block
+# proposals. "default" keeps the order of the mempool, by gas price unless
+# sort_tx_by_gp is disabled, the other policies are registered by the binary.
+ordering_policy = "{{ .Mempool.OrderingPolicy }}"
+
 # Maximum number of transactions in the mempool
 size = {{ .Mempool.Size }}
 
@@ -397,6 +457,21 @@ index_keys = "{{ .TxIndex.IndexKeys }}"
 # indexed).
 index_all_keys = {{ .TxIndex.IndexAllKeys }}
 
+# Number of the latest blocks whose txs and block events are kept, the older
+# ones are pruned online. 0 keeps all the blocks.
+#
+# Pruning needs the txs to be indexed by height, so "tx.height" is indexed
+# when pruning. The txs indexed before without it aren't pruned.
+retain_blocks = {{ .TxIndex.RetainBlocks }}
+
+# Period of time during which the txs and block events are kept, e.g. "720h"
+# for 30 days. 0 keeps all the blocks. When both retain_blocks and retain_time
+# are set, the blocks kept by either of them are kept.
+retain_time = "{{ .TxIndex.RetainTime }}"
+
+# How often the indexed txs and block events are pruned.
+prune_interval = "{{ .TxIndex.PruneInterval }}"
+
 ##### instrumentation configuration options #####
 [instrumentation]
 
@@ -417,6 +492,11 @@ max_open_connections = {{ .Instrumentation.MaxOpenConnections }}
 # Instrumentation namespace
 namespace = "{{ .Instrumentation.Namespace }}"
 
+# When true, the metrics of the app (store operations, IAVL node cache hit
+# rates, commit latencies and RPC latencies) are also reported under the
+# instrumentation namespace
+app_metrics = {{ .Instrumentation.AppMetrics }}
+
 # Log file
 log_file = "{{ js .BaseConfig.LogFile }}"
 
@@ -0,0 +1,209 @@
+package halt
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+	"time"
+
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/okex/exchain/libs/tendermint/p2p"
+)
+
+const (
+	HaltChannel = byte(0x50)
+
+	maxMsgSize = 1024
+
+	checkScheduleIntervalS = 10 // check the schedule of the chain for changes to announce this often
+)
+
+// Schedule is the planned halt of the chain. The chain halts after the commit of the first block at or above Height,
+// or with a block time at or after Time in unix seconds, 0 means not set for both.
+type Schedule struct {
+	Height uint64 `json:"height"`
+	Time   uint64 `json:"time"`
+}
+
+// IsZero returns true if no halt is scheduled
+func (s Schedule) IsZero() bool {
+	return s.Height == 0 && s.Time == 0
+}
+
+// ScheduleSource returns the halt schedule of the chain as of the latest block
+type ScheduleSource func() Schedule
+
+// Reactor announces the halt schedule of the chain to the peers, and keeps the latest ones announced by them, so
+// that the nodes that are catching up or don't serve the state learn the maintenance windows too.
+type Reactor struct {
+	p2p.BaseReactor
+
+	mtx           sync.RWMutex
+	source        ScheduleSource
+	peerSchedules map[p2p.ID]Schedule
+}
+
+// NewReactor returns a new Reactor, it only listens to the peers until a schedule source is set.
+func NewReactor() *Reactor {
+	r := &Reactor{
+		peerSchedules: make(map[p2p.ID]Schedule),
+	}
+	r.BaseReactor = *p2p.NewBaseReactor("Halt", r)
+	return r
+}
+
+// SetScheduleSource sets the source of the schedule announced to the peers.
+func (r *Reactor) SetScheduleSource(source ScheduleSource) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.source = source
+}
+
+// Schedule returns the halt schedule of the chain known to this node.
+func (r *Reactor) Schedule() Schedule {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	if r.source == nil {
+		return Schedule{}
+	}
+	return r.source()
+}
+
+// PeerSchedules returns the latest schedules announced by the peers.
+func (r *Reactor) PeerSchedules() map[p2p.ID]Schedule {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	schedules := make(map[p2p.ID]Schedule, len(r.peerSchedules))
+	for id, schedule := range r.peerSchedules {
+		schedules[id] = schedule
+	}
+	return schedules
+}
+
+// OnStart implements service.Service.
+func (r *Reactor) OnStart() error {
+	go r.announceRoutine()
+	return nil
+}
+
+// GetChannels implements Reactor.
+func (r *Reactor) GetChannels() []*p2p.ChannelDescriptor {
+	return []*p2p.ChannelDescriptor{
+		{
+			ID:       HaltChannel,
+			Priority: 1,
+		},
+	}
+}
+
+// AddPeer implements Reactor.
+// It sends the schedule to the new peer if a halt is planned.
+func (r *Reactor) AddPeer(peer p2p.Peer) {
+	if schedule := r.Schedule(); !schedule.IsZero() {
+		peer.TrySend(HaltChannel, cdc.MustMarshalBinaryBare(&ScheduleMessage{Schedule: schedule}))
+	}
+}
+
+// RemovePeer implements Reactor.
+func (r *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	delete(r.peerSchedules, peer.ID())
+}
+
+// Receive implements Reactor.
+// It records the schedule announced by the peer.
+func (r *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
+	msg, err := decodeMsg(msgBytes)
+	if err != nil {
+		r.Logger.Error("Error decoding message", "src", src, "chId", chID, "msg", msg, "err", err, "bytes", msgBytes)
+		r.Switch.StopPeerForError(src, err)
+		return
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		r.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
+		r.Switch.StopPeerForError(src, err)
+		return
+	}
+
+	switch msg := msg.(type) {
+	case *ScheduleMessage:
+		r.mtx.Lock()
+		previous, ok := r.peerSchedules[src.ID()]
+		r.peerSchedules[src.ID()] = msg.Schedule
+		r.mtx.Unlock()
+
+		if !ok || previous != msg.Schedule {
+			r.Logger.Info("Peer announced the halt schedule", "peer", src.ID(),
+				"height", msg.Schedule.Height, "time", msg.Schedule.Time)
+		}
+	default:
+		r.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+	}
+}
+
+// announceRoutine broadcasts the schedule whenever it changes, including its cancellation.
+func (r *Reactor) announceRoutine() {
+	ticker := time.NewTicker(time.Second * checkScheduleIntervalS)
+	defer ticker.Stop()
+
+	var announced Schedule
+	for {
+		select {
+		case <-ticker.C:
+			if schedule := r.Schedule(); schedule != announced {
+				r.Switch.Broadcast(HaltChannel, cdc.MustMarshalBinaryBare(&ScheduleMessage{Schedule: schedule}))
+				announced = schedule
+			}
+		case <-r.Quit():
+			return
+		}
+	}
+}
+
+//-----------------------------------------------------------------------------
+// Messages
+
+// Message is a message sent or received by the Reactor.
+type Message interface {
+	ValidateBasic() error
+}
+
+func RegisterMessages(cdc *amino.Codec) {
+	cdc.RegisterInterface((*Message)(nil), nil)
+	cdc.RegisterConcrete(&ScheduleMessage{}, "tendermint/halt/ScheduleMessage", nil)
+}
+
+func decodeMsg(bz []byte) (msg Message, err error) {
+	if len(bz) > maxMsgSize {
+		return msg, fmt.Errorf("msg exceeds max size (%d > %d)", len(bz), maxMsgSize)
+	}
+	err = cdc.UnmarshalBinaryBare(bz, &msg)
+	return
+}
+
+//-------------------------------------
+
+// ScheduleMessage announces the halt schedule of the chain, a zero schedule announces its cancellation.
+type ScheduleMessage struct {
+	Schedule Schedule
+}
+
+// ValidateBasic performs basic validation.
+func (m *ScheduleMessage) ValidateBasic() error {
+	if m.Schedule.Height > math.MaxInt64 {
+		return fmt.Errorf("halt height %d overflows int64", m.Schedule.Height)
+	}
+	if m.Schedule.Time > math.MaxInt64 {
+		return fmt.Errorf("halt time %d overflows int64", m.Schedule.Time)
+	}
+	return nil
+}
+
+// String returns a string representation of the ScheduleMessage.
+func (m *ScheduleMessage) String() string {
+	return fmt.Sprintf("[ScheduleMessage height:%d time:%d]", m.Schedule.Height, m.Schedule.Time)
+}
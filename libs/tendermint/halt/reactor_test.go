@@ -0,0 +1,83 @@
+package halt
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/okex/exchain/libs/tendermint/config"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/libs/tendermint/p2p"
+)
+
+// connect a halt reactor for each of the sources through a switch, a nil source leaves the reactor listening only
+func makeAndConnectReactors(config *cfg.Config, sources ...ScheduleSource) ([]*Reactor, []*p2p.Switch) {
+	reactors := make([]*Reactor, len(sources))
+	for i, source := range sources {
+		reactors[i] = NewReactor()
+		reactors[i].SetLogger(log.TestingLogger().With("validator", i))
+		if source != nil {
+			reactors[i].SetScheduleSource(source)
+		}
+	}
+
+	switches := p2p.MakeConnectedSwitches(config.P2P, len(sources), func(i int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("HALT", reactors[i])
+		return s
+	}, p2p.Connect2Switches)
+	return reactors, switches
+}
+
+func waitForPeerSchedule(t *testing.T, reactor *Reactor, id p2p.ID, expected Schedule) {
+	require.Eventually(t, func() bool {
+		schedule, ok := reactor.PeerSchedules()[id]
+		return ok && schedule == expected
+	}, time.Second*(checkScheduleIntervalS+5), time.Millisecond*100)
+}
+
+func TestReactorAnnounceSchedule(t *testing.T) {
+	// the schedule is sent to the peers once they're connected
+	reactors, switches := makeAndConnectReactors(cfg.TestConfig(), func() Schedule { return Schedule{Height: 100} }, nil)
+	defer func() {
+		for _, s := range switches {
+			s.Stop()
+		}
+	}()
+
+	waitForPeerSchedule(t, reactors[1], switches[0].NodeInfo().ID(), Schedule{Height: 100})
+	assert.Empty(t, reactors[0].PeerSchedules())
+}
+
+func TestReactorAnnounceScheduleChange(t *testing.T) {
+	reactors, switches := makeAndConnectReactors(cfg.TestConfig(), nil, nil)
+	defer func() {
+		for _, s := range switches {
+			s.Stop()
+		}
+	}()
+	require.True(t, reactors[0].Schedule().IsZero())
+
+	// the schedule set after the peers are connected is broadcast, and so is its cancellation
+	reactors[0].SetScheduleSource(func() Schedule { return Schedule{Time: 1700000000} })
+	waitForPeerSchedule(t, reactors[1], switches[0].NodeInfo().ID(), Schedule{Time: 1700000000})
+
+	reactors[0].SetScheduleSource(func() Schedule { return Schedule{} })
+	waitForPeerSchedule(t, reactors[1], switches[0].NodeInfo().ID(), Schedule{})
+}
+
+func TestScheduleMessageValidateBasic(t *testing.T) {
+	require.NoError(t, (&ScheduleMessage{Schedule{Height: 100, Time: 1700000000}}).ValidateBasic())
+	require.Error(t, (&ScheduleMessage{Schedule{Height: math.MaxUint64}}).ValidateBasic())
+	require.Error(t, (&ScheduleMessage{Schedule{Time: math.MaxUint64}}).ValidateBasic())
+
+	msg := &ScheduleMessage{Schedule{Height: 100}}
+	decoded, err := decodeMsg(cdc.MustMarshalBinaryBare(msg))
+	require.NoError(t, err)
+	require.Equal(t, msg, decoded)
+
+	_, err = decodeMsg(make([]byte, maxMsgSize+1))
+	require.Error(t, err)
+}
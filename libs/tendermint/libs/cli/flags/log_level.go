@@ -3,6 +3,7 @@ package flags
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 
@@ -88,3 +89,97 @@ func ParseLogLevel(lvl string, logger log.Logger, defaultLogLevelValue string) (
 
 	return log.NewFilter(logger, options...), nil
 }
+
+// dynamicLogLevel holds the levels of the logger created by ParseDynamicLogLevel, so that they can be changed at
+// runtime, e.g. by the privileged rpc or a reload of the config
+var dynamicLogLevel struct {
+	mtx                  sync.Mutex
+	levels               *log.ModuleLevels
+	defaultLogLevelValue string
+}
+
+// ParseModuleLevels parses complex log level like ParseLogLevel into the default level and the levels of the modules
+func ParseModuleLevels(lvl string, defaultLogLevelValue string) (string, map[string]string, error) {
+	if lvl == "" {
+		return "", nil, errors.New("empty log level")
+	}
+
+	l := lvl
+
+	// prefix simple one word levels (e.g. "info") with "*"
+	if !strings.Contains(l, ":") {
+		l = defaultLogLevelKey + ":" + l
+	}
+
+	defaultLevel := defaultLogLevelValue
+	modules := make(map[string]string)
+	list := strings.Split(l, ",")
+	for _, item := range list {
+		moduleAndLevel := strings.Split(item, ":")
+
+		if len(moduleAndLevel) != 2 {
+			return "", nil, fmt.Errorf("expected list in a form of \"module:level\" pairs, given pair %s, list %s", item, list)
+		}
+
+		module := moduleAndLevel[0]
+		level := moduleAndLevel[1]
+		if _, err := log.AllowLevel(level); err != nil {
+			return "", nil, errors.Wrap(err, fmt.Sprintf("Failed to parse log level (pair %s, list %s)", item, l))
+		}
+
+		if module == defaultLogLevelKey {
+			defaultLevel = level
+		} else {
+			modules[module] = level
+		}
+	}
+
+	return defaultLevel, modules, nil
+}
+
+// ParseDynamicLogLevel parses complex log level like ParseLogLevel, but the levels of the returned logger can be
+// changed at runtime by SetDynamicLogLevel
+func ParseDynamicLogLevel(lvl string, logger log.Logger, defaultLogLevelValue string) (log.Logger, error) {
+	defaultLevel, modules, err := ParseModuleLevels(lvl, defaultLogLevelValue)
+	if err != nil {
+		return nil, err
+	}
+	levels, err := log.NewModuleLevels(defaultLevel, modules)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicLogLevel.mtx.Lock()
+	defer dynamicLogLevel.mtx.Unlock()
+	dynamicLogLevel.levels = levels
+	dynamicLogLevel.defaultLogLevelValue = defaultLogLevelValue
+
+	return log.NewDynamicFilter(logger, levels), nil
+}
+
+// SetDynamicLogLevel replaces the levels of the logger created by ParseDynamicLogLevel with the complex log level,
+// e.g. "state:debug,*:info". The modules absent from lvl fall back to the default level.
+func SetDynamicLogLevel(lvl string) error {
+	dynamicLogLevel.mtx.Lock()
+	defer dynamicLogLevel.mtx.Unlock()
+
+	if dynamicLogLevel.levels == nil {
+		return errors.New("log level can't be changed at runtime")
+	}
+	defaultLevel, modules, err := ParseModuleLevels(lvl, dynamicLogLevel.defaultLogLevelValue)
+	if err != nil {
+		return err
+	}
+	return dynamicLogLevel.levels.Set(defaultLevel, modules)
+}
+
+// GetDynamicLogLevel returns the levels of the logger created by ParseDynamicLogLevel
+func GetDynamicLogLevel() string {
+	dynamicLogLevel.mtx.Lock()
+	defer dynamicLogLevel.mtx.Unlock()
+
+	if dynamicLogLevel.levels == nil {
+		return ""
+	}
+	return dynamicLogLevel.levels.String()
+}
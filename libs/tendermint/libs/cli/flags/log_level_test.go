@@ -92,3 +92,32 @@ func TestParseLogLevel(t *testing.T) {
 		}
 	}
 }
+
+func TestSetDynamicLogLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := tmflags.ParseDynamicLogLevel("mempool:error", log.NewTMJSONLogger(&buf), defaultLogLevelValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger = logger.With("module", "mempool")
+
+	logger.Info("Kitty Pryde")
+	if have := strings.TrimSpace(buf.String()); have != "" {
+		t.Errorf("\nwant ''\nhave '%s'", have)
+	}
+
+	if err := tmflags.SetDynamicLogLevel("mempool:info,*:error"); err != nil {
+		t.Fatal(err)
+	}
+	logger.Info("Kitty Pryde")
+	if want, have := `{"_msg":"Kitty Pryde","level":"info","module":"mempool"}`, strings.TrimSpace(buf.String()); want != have {
+		t.Errorf("\nwant '%s'\nhave '%s'", want, have)
+	}
+	if want, have := "mempool:info,*:error", tmflags.GetDynamicLogLevel(); want != have {
+		t.Errorf("\nwant '%s'\nhave '%s'", want, have)
+	}
+
+	if err := tmflags.SetDynamicLogLevel("mempool:verbose"); err == nil {
+		t.Error("expected an error of the unknown level")
+	}
+}
@@ -0,0 +1,149 @@
+package log
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	kitlog "github.com/go-kit/kit/log"
+)
+
+const defaultLevelKey = "*"
+
+// ModuleLevels holds the default log level and the log levels of the modules. Unlike the levels of the filter, they
+// can be changed at runtime, and the loggers created by NewDynamicFilter pick up the new levels immediately.
+type ModuleLevels struct {
+	levels atomic.Value // moduleLevels
+}
+
+type moduleLevels struct {
+	defaultLevel string
+	modules      map[string]string
+}
+
+// NewModuleLevels creates a new ModuleLevels with the default level and the levels of the modules
+func NewModuleLevels(defaultLevel string, modules map[string]string) (*ModuleLevels, error) {
+	ml := &ModuleLevels{}
+	if err := ml.Set(defaultLevel, modules); err != nil {
+		return nil, err
+	}
+	return ml, nil
+}
+
+// Set replaces the default level and the levels of the modules at once
+func (ml *ModuleLevels) Set(defaultLevel string, modules map[string]string) error {
+	if _, err := parseLevel(defaultLevel); err != nil {
+		return err
+	}
+	levels := moduleLevels{defaultLevel: defaultLevel, modules: make(map[string]string, len(modules))}
+	for module, lvl := range modules {
+		if _, err := parseLevel(lvl); err != nil {
+			return fmt.Errorf("invalid log level of module %s: %s", module, err)
+		}
+		levels.modules[module] = lvl
+	}
+	ml.levels.Store(levels)
+	return nil
+}
+
+// String returns the levels in the form of the log_level config, e.g. "consensus:debug,*:info"
+func (ml *ModuleLevels) String() string {
+	levels := ml.load()
+	items := make([]string, 0, len(levels.modules)+1)
+	for module, lvl := range levels.modules {
+		items = append(items, module+":"+lvl)
+	}
+	sort.Strings(items)
+	return strings.Join(append(items, defaultLevelKey+":"+levels.defaultLevel), ",")
+}
+
+func (ml *ModuleLevels) load() moduleLevels {
+	return ml.levels.Load().(moduleLevels)
+}
+
+func (ml *ModuleLevels) allowed(module string) level {
+	levels := ml.load()
+	lvl, found := levels.modules[module]
+	if !found {
+		lvl = levels.defaultLevel
+	}
+	// the levels have been validated by Set
+	allowed, _ := parseLevel(lvl)
+	return allowed
+}
+
+func parseLevel(lvl string) (level, error) {
+	switch lvl {
+	case "debug":
+		return levelError | levelInfo | levelDebug, nil
+	case "info":
+		return levelError | levelInfo, nil
+	case "error":
+		return levelError, nil
+	case "none":
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("expected either \"info\", \"debug\", \"error\" or \"none\" level, given %s", lvl)
+	}
+}
+
+type dynamicFilter struct {
+	next   Logger
+	levels *ModuleLevels
+	module interface{}
+}
+
+// NewDynamicFilter wraps next and filters the log events by the level of the module the logger is created for with
+// With("module", ...), which is looked up in levels every time an event is logged
+func NewDynamicFilter(next Logger, levels *ModuleLevels) Logger {
+	return &dynamicFilter{
+		next:   next,
+		levels: levels,
+	}
+}
+
+func (l *dynamicFilter) allowed(lvl level) bool {
+	module, _ := l.module.(string)
+	return l.levels.allowed(module)&lvl != 0
+}
+
+func (l *dynamicFilter) Info(msg string, keyvals ...interface{}) {
+	if l.allowed(levelInfo) {
+		l.next.Info(msg, keyvals...)
+	}
+}
+
+func (l *dynamicFilter) Debug(msg string, keyvals ...interface{}) {
+	if l.allowed(levelDebug) {
+		l.next.Debug(msg, keyvals...)
+	}
+}
+
+func (l *dynamicFilter) Error(msg string, keyvals ...interface{}) {
+	if l.allowed(levelError) {
+		l.next.Error(msg, keyvals...)
+	}
+}
+
+// With implements Logger by constructing a new dynamicFilter with keyvals appended to the logger. The last module in
+// keyvals decides the level of the new logger.
+func (l *dynamicFilter) With(keyvals ...interface{}) Logger {
+	module := l.module
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		if keyvals[i] == moduleKey {
+			module = keyvals[i+1]
+		}
+	}
+	return &dynamicFilter{
+		next:   l.next.With(keyvals...),
+		levels: l.levels,
+		module: module,
+	}
+}
+
+// Lazy returns a value of keyvals which is evaluated only when an event is logged, so that the values expensive to
+// compute, e.g. the hash of a tx, can be bound to the logger by With at no cost if nothing is logged
+func Lazy(f func() interface{}) interface{} {
+	return kitlog.Valuer(f)
+}
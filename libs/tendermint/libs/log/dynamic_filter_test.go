@@ -0,0 +1,69 @@
+package log_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+)
+
+func TestDynamicFilter(t *testing.T) {
+	var buf bytes.Buffer
+	levels, err := log.NewModuleLevels("info", map[string]string{"mempool": "error"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := log.NewDynamicFilter(log.NewTMJSONLogger(&buf), levels)
+	mempoolLogger := logger.With("module", "mempool")
+	stateLogger := logger.With("module", "state")
+
+	mempoolLogger.Info("Kitty Pryde")
+	stateLogger.Info("Mind")
+	stateLogger.Debug("Gideon")
+	if want, have := `{"_msg":"Mind","level":"info","module":"state"}`, strings.TrimSpace(buf.String()); want != have {
+		t.Errorf("\nwant '%s'\nhave '%s'", want, have)
+	}
+
+	// the loggers created before pick up the new levels
+	if err := levels.Set("error", map[string]string{"state": "debug"}); err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	mempoolLogger.Info("Kitty Pryde")
+	stateLogger.Debug("Gideon")
+	logger.Info("Wolverine")
+	if want, have := `{"_msg":"Gideon","level":"debug","module":"state"}`, strings.TrimSpace(buf.String()); want != have {
+		t.Errorf("\nwant '%s'\nhave '%s'", want, have)
+	}
+
+	if want, have := "state:debug,*:error", levels.String(); want != have {
+		t.Errorf("\nwant '%s'\nhave '%s'", want, have)
+	}
+	if err := levels.Set("verbose", nil); err == nil {
+		t.Error("expected an error of the unknown level")
+	}
+	if want, have := "state:debug,*:error", levels.String(); want != have {
+		t.Errorf("\nwant '%s'\nhave '%s'", want, have)
+	}
+}
+
+func TestLazy(t *testing.T) {
+	var buf bytes.Buffer
+	evaluated := 0
+	logger := log.NewTMJSONLogger(&buf).With("txhash", log.Lazy(func() interface{} {
+		evaluated++
+		return "ABCD"
+	}))
+	if evaluated != 0 {
+		t.Fatalf("lazy value evaluated before logging")
+	}
+
+	logger.Info("here")
+	if want, have := `{"_msg":"here","level":"info","txhash":"ABCD"}`, strings.TrimSpace(buf.String()); want != have {
+		t.Errorf("\nwant '%s'\nhave '%s'", want, have)
+	}
+	if evaluated != 1 {
+		t.Errorf("lazy value evaluated %d times", evaluated)
+	}
+}
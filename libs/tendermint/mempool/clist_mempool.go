@@ -99,6 +99,12 @@ type CListMempool struct {
 	gasCache *lru.Cache
 
 	rmPendingTxChan chan types.EventDataRmPendingTx
+
+	// journal of the txs submitted through the rpc, nil if the wal is disabled or closed
+	journal *txJournal
+
+	// orders the txs reaped for the block proposals, nil for the queue order
+	orderingPolicy OrderingPolicy
 }
 
 var _ Mempool = &CListMempool{}
@@ -393,6 +399,7 @@ func (mem *CListMempool) globalCb(req *abci.Request, res *abci.Response) {
 
 	// update metrics
 	mem.metrics.Size.Set(float64(mem.Size()))
+	mem.metrics.TxsBytes.Set(float64(mem.TxsBytes()))
 }
 
 // Request specific callback that should be set on individual reqRes objects
@@ -419,6 +426,7 @@ func (mem *CListMempool) reqResCb(
 
 		// update metrics
 		mem.metrics.Size.Set(float64(mem.Size()))
+		mem.metrics.TxsBytes.Set(float64(mem.TxsBytes()))
 		if mem.pendingPool != nil {
 			mem.metrics.PendingPoolSize.Set(float64(mem.pendingPool.Size()))
 		}
@@ -618,7 +626,7 @@ func (mem *CListMempool) resCbFirstTime(
 	case *abci.Response_CheckTx:
 		var postCheckErr error
 		if mem.postCheck != nil {
-			postCheckErr = mem.postCheck(tx, r.CheckTx)
+			postCheckErr = mem.postCheck(tx, mem.blockGasResponse(nil, r.CheckTx))
 		}
 		var txHash []byte
 		if r.CheckTx != nil && r.CheckTx.Tx != nil {
@@ -662,7 +670,7 @@ func (mem *CListMempool) resCbFirstTime(
 
 			memTx := &mempoolTx{
 				height:      mem.Height(),
-				gasWanted:   r.CheckTx.GasWanted,
+				gasWanted:   mem.txBlockGas(r.CheckTx.Tx, r.CheckTx.GasWanted),
 				tx:          tx,
 				realTx:      r.CheckTx.Tx,
 				nodeKey:     txInfo.wtx.GetNodeKey(),
@@ -673,6 +681,8 @@ func (mem *CListMempool) resCbFirstTime(
 
 			memTx.senders = make(map[uint16]struct{})
 			memTx.senders[txInfo.SenderID] = struct{}{}
+			memTx.private = txInfo.Private
+			memTx.journaled = mem.shouldJournal(txInfo, r.CheckTx.Tx)
 
 			var err error
 			if mem.pendingPool != nil {
@@ -683,6 +693,7 @@ func (mem *CListMempool) resCbFirstTime(
 
 			if err == nil {
 				mem.logAddTx(memTx, r)
+				mem.journalTx(memTx)
 				mem.notifyTxsAvailable()
 			} else {
 				// ignore bad transaction
@@ -725,7 +736,7 @@ func (mem *CListMempool) resCbRecheck(req *abci.Request, res *abci.Response) {
 		}
 		var postCheckErr error
 		if mem.postCheck != nil {
-			postCheckErr = mem.postCheck(tx, r.CheckTx)
+			postCheckErr = mem.postCheck(tx, mem.blockGasResponse(memTx.realTx, r.CheckTx))
 		}
 		if (r.CheckTx.Code == abci.CodeTypeOK) && postCheckErr == nil {
 			// Good, nothing to do.
@@ -820,19 +831,19 @@ func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) []types.Tx {
 		trace.GetElapsedInfo().AddInfo(trace.SimTx, fmt.Sprintf("%d:%d", mem.Height()+1, simCount))
 		trace.GetElapsedInfo().AddInfo(trace.SimGasUsed, fmt.Sprintf("%d:%d", mem.Height()+1, simGas))
 	}()
-	for e := mem.txs.Front(); e != nil; e = e.Next() {
-		memTx := e.Value.(*mempoolTx)
+	// reapTx appends the tx to the proposal, it returns false once the block is full
+	reapTx := func(memTx *mempoolTx) bool {
 		key := txOrTxHashToKey(memTx.tx, memTx.realTx.TxHash(), mem.Height())
 		if _, ok := txFilter[key]; ok {
 			// Just log error and ignore the dup tx. and it will be packed into the next block and deleted from mempool
 			mem.logger.Error("found duptx in same block", "tx hash", hex.EncodeToString(key[:]))
-			continue
+			return true
 		}
 		txFilter[key] = struct{}{}
 		// Check total size requirement
 		aminoOverhead := types.ComputeAminoOverhead(memTx.tx, 1)
 		if maxBytes > -1 && totalBytes+int64(len(memTx.tx))+aminoOverhead > maxBytes {
-			return txs
+			return false
 		}
 		totalBytes += int64(len(memTx.tx)) + aminoOverhead
 		// Check total gas requirement.
@@ -842,10 +853,10 @@ func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) []types.Tx {
 		gasWanted := atomic.LoadInt64(&memTx.gasWanted)
 		newTotalGas := totalGas + gasWanted
 		if maxGas > -1 && newTotalGas > maxGas {
-			return txs
+			return false
 		}
 		if totalTxNum >= cfg.DynamicConfig.GetMaxTxNumPerBlock() {
-			return txs
+			return false
 		}
 
 		totalTxNum++
@@ -855,6 +866,20 @@ func (mem *CListMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) []types.Tx {
 		if atomic.LoadUint32(&memTx.isSim) > 0 {
 			simCount++
 		}
+		return true
+	}
+	if mem.orderingPolicy != nil {
+		for _, memTx := range mem.orderedProposalTxs() {
+			if !reapTx(memTx) {
+				break
+			}
+		}
+	} else {
+		for e := mem.txs.Front(); e != nil; e = e.Next() {
+			if !reapTx(e.Value.(*mempoolTx)) {
+				break
+			}
+		}
 	}
 
 	return txs
@@ -872,6 +897,9 @@ func (mem *CListMempool) ReapMaxTxs(max int) types.Txs {
 	txs := make([]types.Tx, 0, tmmath.MinInt(mem.txs.Len(), max))
 	for e := mem.txs.Front(); e != nil && len(txs) <= max; e = e.Next() {
 		memTx := e.Value.(*mempoolTx)
+		if memTx.private {
+			continue
+		}
 		txs = append(txs, memTx.tx)
 	}
 	return txs
@@ -1027,6 +1055,7 @@ func (mem *CListMempool) Update(
 
 	// Update metrics
 	mem.metrics.Size.Set(float64(mem.Size()))
+	mem.metrics.TxsBytes.Set(float64(mem.TxsBytes()))
 	if mem.pendingPool != nil {
 		select {
 		case mem.pendingPoolNotify <- addressNonce:
@@ -1050,6 +1079,7 @@ func (mem *CListMempool) Update(
 	if cfg.DynamicConfig.GetEnableDeleteMinGPTx() {
 		mem.deleteMinGPTxOnlyFull()
 	}
+	mem.rotateJournal(height)
 	// WARNING: The txs inserted between [ReapMaxBytesMaxGas, Update) is insert-sorted in the mempool.txs,
 	// but they are not included in the latest block, after remove the latest block txs, these txs may
 	// in unsorted state. We need to resort them again for the the purpose of absolute order, or just let it go for they are
@@ -1190,6 +1220,8 @@ type mempoolTx struct {
 
 	isOutdated uint32
 	isSim      uint32
+	journaled  bool // submitted through the rpc and journaled to the wal
+	private    bool // held locally, not gossiped to the peers
 
 	// ids of peers who've sent us this tx (as a map for quick lookups).
 	// senders: PeerID -> bool
@@ -1324,6 +1356,33 @@ func (mem *CListMempool) SetTxInfoParser(parser TxInfoParser) {
 	mem.txInfoparser = parser
 }
 
+// txBlockGas weighs the gas of the tx against the gas limit of the block with
+// the BlockGasWeigher of the TxInfoParser if any
+func (mem *CListMempool) txBlockGas(tx abci.TxEssentials, gas int64) int64 {
+	if weigher, ok := mem.txInfoparser.(BlockGasWeigher); ok && tx != nil {
+		return weigher.GetTxBlockGas(tx, gas)
+	}
+	return gas
+}
+
+// blockGasResponse returns the response of CheckTx with the gas wanted weighed
+// against the gas limit of the block, for the post check to filter the txs
+// that can't fit in a block. The tx of the response is weighed if tx is nil.
+func (mem *CListMempool) blockGasResponse(tx abci.TxEssentials, res *abci.ResponseCheckTx) *abci.ResponseCheckTx {
+	if res == nil {
+		return res
+	}
+	if tx == nil {
+		tx = res.Tx
+	}
+	if gas := mem.txBlockGas(tx, res.GasWanted); gas != res.GasWanted {
+		weighed := *res
+		weighed.GasWanted = gas
+		return &weighed
+	}
+	return res
+}
+
 func (mem *CListMempool) pendingPoolJob() {
 	for addressNonce := range mem.pendingPoolNotify {
 		timeStart := time.Now()
@@ -1378,6 +1437,7 @@ func (mem *CListMempool) simulationJob(memTx *mempoolTx) {
 		return
 	}
 	gas := int64(simuRes.GasUsed) * int64(cfg.DynamicConfig.GetPGUAdjustment()*100) / 100
+	gas = mem.txBlockGas(memTx.realTx, gas)
 	atomic.StoreInt64(&memTx.gasWanted, gas)
 	atomic.AddUint32(&memTx.isSim, 1)
 	mem.gasCache.Add(hex.EncodeToString(memTx.realTx.TxHash()), gas)
@@ -144,6 +144,40 @@ func TestReapMaxBytesMaxGas(t *testing.T) {
 	}
 }
 
+// blockGasWeigher is a TxInfoParser weighing the gas of all the txs by its factor
+type blockGasWeigher struct {
+	factor int64
+}
+
+func (w blockGasWeigher) GetRawTxInfo(tx types.Tx) ExTxInfo                   { return ExTxInfo{} }
+func (w blockGasWeigher) GetTxHistoryGasUsed(tx types.Tx) int64               { return -1 }
+func (w blockGasWeigher) GetRealTxFromRawTx(rawTx types.Tx) abci.TxEssentials { return nil }
+func (w blockGasWeigher) GetTxBlockGas(tx abci.TxEssentials, gas int64) int64 { return gas * w.factor }
+
+func TestReapMaxBytesMaxGasWeighed(t *testing.T) {
+	app := kvstore.NewApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	mempool, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+	mempool.SetTxInfoParser(blockGasWeigher{factor: 3})
+
+	// each tx wants 1 gas, which takes 3 gas of the block
+	checkTxs(t, mempool, 20, UnknownPeerID)
+	require.Equal(t, int64(3), mempool.TxsFront().Value.(*mempoolTx).gasWanted)
+	require.Equal(t, 10, len(mempool.ReapMaxBytesMaxGas(-1, 30)))
+	require.Equal(t, 3, len(mempool.ReapMaxBytesMaxGas(-1, 11)))
+	require.Equal(t, 20, len(mempool.ReapMaxBytesMaxGas(-1, -1)))
+	mempool.Flush()
+
+	// the txs that can't fit in a block are filtered by the post check
+	mempool.postCheck = PostCheckMaxGas(2)
+	checkTxs(t, mempool, 10, UnknownPeerID)
+	require.Equal(t, 0, mempool.Size())
+	mempool.postCheck = PostCheckMaxGas(3)
+	checkTxs(t, mempool, 10, UnknownPeerID)
+	require.Equal(t, 10, mempool.Size())
+}
+
 func TestMempoolFilters(t *testing.T) {
 	app := kvstore.NewApplication()
 	cc := proxy.NewLocalClientCreator(app)
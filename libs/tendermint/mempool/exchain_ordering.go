@@ -0,0 +1,106 @@
+package mempool
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+// DefaultOrderingPolicy keeps the order of the mempool queue, the txs are
+// prioritized by their gas price unless sort_tx_by_gp is disabled.
+const DefaultOrderingPolicy = "default"
+
+// ProposalTx is a tx of the mempool candidate to a block proposal.
+type ProposalTx struct {
+	Tx        types.Tx
+	From      string
+	Nonce     uint64
+	GasPrice  *big.Int
+	GasWanted int64
+
+	memTx *mempoolTx
+}
+
+// OrderingPolicy orders the txs reaped from the mempool for a block proposal,
+// e.g. to keep the txs of a bundle together. The policy may drop some of the
+// txs, but must keep the nonce order of the txs of a sender. The size, gas and
+// number limits of the block are applied to the returned txs in their order.
+type OrderingPolicy interface {
+	Order(txs []*ProposalTx) []*ProposalTx
+}
+
+// OrderingPolicyFunc is an OrderingPolicy implemented by a function.
+type OrderingPolicyFunc func(txs []*ProposalTx) []*ProposalTx
+
+// Order implements OrderingPolicy.
+func (f OrderingPolicyFunc) Order(txs []*ProposalTx) []*ProposalTx {
+	return f(txs)
+}
+
+var (
+	orderingPoliciesMtx sync.RWMutex
+	orderingPolicies    = map[string]OrderingPolicy{}
+)
+
+// RegisterOrderingPolicy registers a policy selectable by its name with the
+// mempool.ordering_policy of the config. It panics if the name is taken.
+func RegisterOrderingPolicy(name string, policy OrderingPolicy) {
+	orderingPoliciesMtx.Lock()
+	defer orderingPoliciesMtx.Unlock()
+
+	if _, ok := orderingPolicies[name]; ok || name == DefaultOrderingPolicy {
+		panic(fmt.Sprintf("ordering policy %s is already registered", name))
+	}
+	orderingPolicies[name] = policy
+}
+
+// GetOrderingPolicy returns the policy registered under the name, nil for the
+// default policy.
+func GetOrderingPolicy(name string) (OrderingPolicy, error) {
+	if name == "" || name == DefaultOrderingPolicy {
+		return nil, nil
+	}
+	orderingPoliciesMtx.RLock()
+	defer orderingPoliciesMtx.RUnlock()
+
+	policy, ok := orderingPolicies[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown ordering policy %s", name)
+	}
+	return policy, nil
+}
+
+// WithOrderingPolicy sets the policy ordering the txs reaped for the block
+// proposals, nil keeps the default policy.
+func WithOrderingPolicy(policy OrderingPolicy) CListMempoolOption {
+	return func(mem *CListMempool) { mem.orderingPolicy = policy }
+}
+
+// orderedProposalTxs returns the txs of the mempool ordered by the policy,
+// skipping the txs the policy returned which aren't in the mempool.
+func (mem *CListMempool) orderedProposalTxs() []*mempoolTx {
+	candidates := make([]*ProposalTx, 0, mem.txs.Len())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*mempoolTx)
+		candidates = append(candidates, &ProposalTx{
+			Tx:        memTx.tx,
+			From:      memTx.from,
+			Nonce:     memTx.realTx.GetNonce(),
+			GasPrice:  memTx.realTx.GetGasPrice(),
+			GasWanted: atomic.LoadInt64(&memTx.gasWanted),
+			memTx:     memTx,
+		})
+	}
+
+	ordered := mem.orderingPolicy.Order(candidates)
+	memTxs := make([]*mempoolTx, 0, len(ordered))
+	for _, ptx := range ordered {
+		if ptx != nil && ptx.memTx != nil {
+			memTxs = append(memTxs, ptx.memTx)
+		}
+	}
+	return memTxs
+}
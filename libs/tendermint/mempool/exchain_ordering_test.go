@@ -0,0 +1,65 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/tendermint/abci/example/kvstore"
+	"github.com/okex/exchain/libs/tendermint/proxy"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+func TestOrderingPolicy(t *testing.T) {
+	mempool, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(kvstore.NewApplication()))
+	defer cleanup()
+
+	txs := checkTxs(t, mempool, 10, UnknownPeerID)
+	require.Equal(t, txs, types.Txs(mempool.ReapMaxBytesMaxGas(-1, -1)))
+
+	// the txs are reaped in the order of the policy
+	WithOrderingPolicy(OrderingPolicyFunc(func(ptxs []*ProposalTx) []*ProposalTx {
+		for i, j := 0, len(ptxs)-1; i < j; i, j = i+1, j-1 {
+			ptxs[i], ptxs[j] = ptxs[j], ptxs[i]
+		}
+		return ptxs
+	}))(mempool)
+	reversed := make(types.Txs, 0, len(txs))
+	for i := len(txs) - 1; i >= 0; i-- {
+		reversed = append(reversed, txs[i])
+	}
+	require.Equal(t, reversed, types.Txs(mempool.ReapMaxBytesMaxGas(-1, -1)))
+
+	// the limits of the block apply in the order of the policy
+	maxBytes := int64(len(txs[9])) + types.ComputeAminoOverhead(txs[9], 1)
+	require.Equal(t, types.Txs{txs[9]}, types.Txs(mempool.ReapMaxBytesMaxGas(maxBytes, -1)))
+
+	// the policy may drop txs, but can't add any
+	WithOrderingPolicy(OrderingPolicyFunc(func(ptxs []*ProposalTx) []*ProposalTx {
+		return []*ProposalTx{ptxs[3], {Tx: types.Tx("unknown-tx")}, ptxs[1]}
+	}))(mempool)
+	require.Equal(t, types.Txs{txs[3], txs[1]}, types.Txs(mempool.ReapMaxBytesMaxGas(-1, -1)))
+
+	// the default policy keeps the order of the mempool
+	WithOrderingPolicy(nil)(mempool)
+	require.Equal(t, txs, types.Txs(mempool.ReapMaxBytesMaxGas(-1, -1)))
+}
+
+func TestRegisterOrderingPolicy(t *testing.T) {
+	policy, err := GetOrderingPolicy(DefaultOrderingPolicy)
+	require.NoError(t, err)
+	require.Nil(t, policy)
+
+	_, err = GetOrderingPolicy("test-policy")
+	require.Error(t, err)
+
+	RegisterOrderingPolicy("test-policy", OrderingPolicyFunc(func(ptxs []*ProposalTx) []*ProposalTx {
+		return ptxs
+	}))
+	policy, err = GetOrderingPolicy("test-policy")
+	require.NoError(t, err)
+	require.NotNil(t, policy)
+
+	require.Panics(t, func() { RegisterOrderingPolicy("test-policy", policy) })
+	require.Panics(t, func() { RegisterOrderingPolicy(DefaultOrderingPolicy, policy) })
+}
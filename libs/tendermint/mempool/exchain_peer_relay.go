@@ -0,0 +1,187 @@
+package mempool
+
+import (
+	"sync"
+	"time"
+
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	flow "github.com/okex/exchain/libs/tendermint/libs/flowrate"
+	"github.com/okex/exchain/libs/tendermint/p2p"
+	"github.com/okex/exchain/libs/tendermint/p2p/trust"
+)
+
+const peerLatencyCheckInterval = 10 * time.Second
+
+// peerTxRelay tracks the txs relayed with a peer. The peer is scored by its
+// trust metric, the invalid txs, the useless messages and the latency of the
+// peer are the bad events lowering its score.
+type peerTxRelay struct {
+	metric      *trust.Metric
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+	onCheckTx   func(*abci.Response)
+}
+
+func newPeerTxRelay() *peerTxRelay {
+	relay := &peerTxRelay{
+		metric:      trust.NewMetric(),
+		sendMonitor: flow.New(0, 0),
+		recvMonitor: flow.New(0, 0),
+	}
+	relay.onCheckTx = relay.scoreCheckTx
+	return relay
+}
+
+// scoreCheckTx scores the peer on the result of the check of a tx it sent.
+func (relay *peerTxRelay) scoreCheckTx(res *abci.Response) {
+	r, ok := res.Value.(*abci.Response_CheckTx)
+	if !ok {
+		return
+	}
+	if r.CheckTx.Code == abci.CodeTypeOK {
+		relay.metric.GoodEvents(1)
+	} else {
+		relay.metric.BadEvents(1)
+	}
+}
+
+// scoreCheckTxError scores the peer on the error of the check of a tx it sent,
+// the txs already seen or rejected by a full mempool aren't the peer's fault.
+func (relay *peerTxRelay) scoreCheckTxError(err error) {
+	if relay == nil {
+		return
+	}
+	switch err.(type) {
+	case ErrTxTooLarge, ErrPreCheck:
+		relay.metric.BadEvents(1)
+	}
+}
+
+func (relay *peerTxRelay) checkTxCb() func(*abci.Response) {
+	if relay == nil {
+		return nil
+	}
+	return relay.onCheckTx
+}
+
+// uselessMessage lowers the score of the peer for a message it shouldn't have sent.
+func (relay *peerTxRelay) uselessMessage() {
+	if relay != nil {
+		relay.metric.BadEvents(1)
+	}
+}
+
+type peerTxRelays struct {
+	mtx    sync.RWMutex
+	relays map[p2p.ID]*peerTxRelay
+}
+
+func newPeerTxRelays() *peerTxRelays {
+	return &peerTxRelays{
+		relays: make(map[p2p.ID]*peerTxRelay),
+	}
+}
+
+func (relays *peerTxRelays) add(peer p2p.Peer) {
+	relay := newPeerTxRelay()
+	if err := relay.metric.Start(); err != nil {
+		panic(err)
+	}
+
+	relays.mtx.Lock()
+	defer relays.mtx.Unlock()
+	if old, ok := relays.relays[peer.ID()]; ok {
+		old.metric.Stop()
+	}
+	relays.relays[peer.ID()] = relay
+}
+
+func (relays *peerTxRelays) remove(peer p2p.Peer) {
+	relays.mtx.Lock()
+	defer relays.mtx.Unlock()
+
+	if relay, ok := relays.relays[peer.ID()]; ok {
+		relay.metric.Stop()
+		delete(relays.relays, peer.ID())
+	}
+}
+
+// get returns the relay of the peer, nil if the peer wasn't added.
+func (relays *peerTxRelays) get(peer p2p.Peer) *peerTxRelay {
+	if peer == nil {
+		return nil
+	}
+	relays.mtx.RLock()
+	defer relays.mtx.RUnlock()
+
+	return relays.relays[peer.ID()]
+}
+
+// PeerScore returns the score of the peer from 0 to 100, -1 if the peer is unknown.
+func (memR *Reactor) PeerScore(peer p2p.Peer) int {
+	relay := memR.relays.get(peer)
+	if relay == nil {
+		return -1
+	}
+	return relay.metric.TrustScore()
+}
+
+func (memR *Reactor) isDownRanked(relay *peerTxRelay) bool {
+	return memR.config.PeerMinScore > 0 && relay.metric.TrustScore() < memR.config.PeerMinScore
+}
+
+// acceptPeerMsg returns whether a message of size bytes received from the peer
+// is processed. The messages received over the relay bandwidth of the peer are
+// useless, the ones of the down-ranked peers are dropped until their score recovers.
+func (memR *Reactor) acceptPeerMsg(relay *peerTxRelay, size int) bool {
+	if relay == nil {
+		return true
+	}
+	if rate := memR.config.PeerTxRecvRate; rate > 0 && relay.recvMonitor.Status().CurRate >= rate {
+		relay.uselessMessage()
+		memR.mempool.metrics.RateLimitedPeerTxs.Add(1)
+		return false
+	}
+	if memR.isDownRanked(relay) {
+		memR.mempool.metrics.DownRankedPeerTxs.Add(1)
+		return false
+	}
+	relay.recvMonitor.Update(size)
+	return true
+}
+
+// sendRateExceeded returns whether the txs relayed to the peer exceed its relay bandwidth.
+func (memR *Reactor) sendRateExceeded(relay *peerTxRelay) bool {
+	rate := memR.config.PeerTxSendRate
+	return relay != nil && rate > 0 && relay.sendMonitor.Status().CurRate >= rate
+}
+
+// peerLatencyRoutine scores the peers on the round trip time of their pings.
+func (memR *Reactor) peerLatencyRoutine() {
+	ticker := time.NewTicker(peerLatencyCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, peer := range memR.Switch.Peers().List() {
+				memR.scorePeerLatency(peer)
+			}
+		case <-memR.Quit():
+			return
+		}
+	}
+}
+
+func (memR *Reactor) scorePeerLatency(peer p2p.Peer) {
+	relay := memR.relays.get(peer)
+	latency := peer.Status().Latency
+	// no pong has been received yet
+	if relay == nil || latency == 0 {
+		return
+	}
+	if latency > memR.config.PeerMaxLatency {
+		relay.metric.BadEvents(1)
+	} else {
+		relay.metric.GoodEvents(1)
+	}
+}
@@ -0,0 +1,78 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/tendermint/abci/example/kvstore"
+	cfg "github.com/okex/exchain/libs/tendermint/config"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/libs/tendermint/p2p/mock"
+	"github.com/okex/exchain/libs/tendermint/proxy"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+func newPeerRelayTestReactor(config *cfg.Config) (*Reactor, cleanupFunc) {
+	mempool, cleanup := newMempoolWithAppAndConfig(proxy.NewLocalClientCreator(kvstore.NewApplication()), config)
+	memR := NewReactor(config.Mempool, mempool)
+	memR.SetLogger(log.TestingLogger())
+	return memR, cleanup
+}
+
+func TestReactorDownRanksPeer(t *testing.T) {
+	config := cfg.ResetTestRoot("mempool_peer_relay_test")
+	config.Mempool.MaxTxBytes = 16
+	config.Mempool.PeerMinScore = 50
+	memR, cleanup := newPeerRelayTestReactor(config)
+	defer cleanup()
+
+	peer := mock.NewPeer(nil)
+	require.Equal(t, -1, memR.PeerScore(peer))
+	memR.InitPeer(peer)
+	defer memR.RemovePeer(peer, nil)
+	require.Equal(t, 100, memR.PeerScore(peer))
+
+	// the valid txs keep the score of the peer
+	memR.Receive(MempoolChannel, peer, memR.encodeMsg(&TxMessage{Tx: types.Tx("valid-tx-1")}))
+	require.Equal(t, 1, memR.mempool.Size())
+	require.Equal(t, 100, memR.PeerScore(peer))
+
+	// the invalid ones lower it
+	for i := 0; i < 3; i++ {
+		memR.Receive(MempoolChannel, peer, memR.encodeMsg(&TxMessage{Tx: types.Tx("too-large-tx-0001")}))
+	}
+	require.Less(t, memR.PeerScore(peer), config.Mempool.PeerMinScore)
+
+	// until the txs of the down-ranked peer are dropped
+	memR.Receive(MempoolChannel, peer, memR.encodeMsg(&TxMessage{Tx: types.Tx("valid-tx-2")}))
+	require.Equal(t, 1, memR.mempool.Size())
+
+	// the other peers aren't affected
+	other := mock.NewPeer(nil)
+	memR.InitPeer(other)
+	defer memR.RemovePeer(other, nil)
+	memR.Receive(MempoolChannel, other, memR.encodeMsg(&TxMessage{Tx: types.Tx("valid-tx-2")}))
+	require.Equal(t, 2, memR.mempool.Size())
+}
+
+func TestReactorPeerTxRecvRate(t *testing.T) {
+	config := cfg.ResetTestRoot("mempool_peer_relay_test")
+	config.Mempool.PeerTxRecvRate = 1
+	memR, cleanup := newPeerRelayTestReactor(config)
+	defer cleanup()
+
+	peer := mock.NewPeer(nil)
+	memR.InitPeer(peer)
+	defer memR.RemovePeer(peer, nil)
+
+	memR.Receive(MempoolChannel, peer, memR.encodeMsg(&TxMessage{Tx: types.Tx("tx-1")}))
+	require.Equal(t, 1, memR.mempool.Size())
+
+	// the rate of the peer is measured once the sample of the flow monitor is over
+	time.Sleep(200 * time.Millisecond)
+	memR.Receive(MempoolChannel, peer, memR.encodeMsg(&TxMessage{Tx: types.Tx("tx-2")}))
+	require.Equal(t, 1, memR.mempool.Size())
+	require.Less(t, memR.PeerScore(peer), 100)
+}
@@ -0,0 +1,269 @@
+package mempool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync/atomic"
+
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	tmos "github.com/okex/exchain/libs/tendermint/libs/os"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+// journalRecordHeaderSize is the size of the header of a record, the length and the crc32 checksum of the tx
+const journalRecordHeaderSize = 8
+
+// txJournal is an append-only log of the txs admitted to the mempool, so that they're recovered after a restart or a
+// crash. The records aren't synced to the disk one by one, the checksums let the loading drop the torn tail written
+// by a crash, and the journal is rotated atomically through a rename.
+type txJournal struct {
+	path   string
+	writer *os.File
+}
+
+func newTxJournal(path string) *txJournal {
+	return &txJournal{path: path}
+}
+
+// load reads the txs of the journal up to the first truncated or corrupted record, a missing journal has no tx
+func (journal *txJournal) load() (types.Txs, error) {
+	file, err := os.Open(journal.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var (
+		txs    types.Txs
+		reader = bufio.NewReader(file)
+		header = make([]byte, journalRecordHeaderSize)
+	)
+	for {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return txs, nil
+			}
+			return txs, err
+		}
+		tx := make([]byte, binary.BigEndian.Uint32(header[:4]))
+		if _, err := io.ReadFull(reader, tx); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return txs, nil
+			}
+			return txs, err
+		}
+		if crc32.ChecksumIEEE(tx) != binary.BigEndian.Uint32(header[4:]) {
+			return txs, nil
+		}
+		txs = append(txs, tx)
+	}
+}
+
+// insert appends the tx to the journal
+func (journal *txJournal) insert(tx types.Tx) error {
+	if journal.writer == nil {
+		return errors.New("the journal isn't open")
+	}
+	_, err := journal.writer.Write(encodeJournalRecord(tx))
+	return err
+}
+
+// rotate replaces the journal with the txs, and reopens it for appending
+func (journal *txJournal) rotate(txs types.Txs) error {
+	if journal.writer != nil {
+		if err := journal.writer.Close(); err != nil {
+			return err
+		}
+		journal.writer = nil
+	}
+
+	replacement, err := os.OpenFile(journal.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	writer := bufio.NewWriter(replacement)
+	for _, tx := range txs {
+		if _, err := writer.Write(encodeJournalRecord(tx)); err != nil {
+			replacement.Close()
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		replacement.Close()
+		return err
+	}
+	if err := replacement.Sync(); err != nil {
+		replacement.Close()
+		return err
+	}
+	if err := replacement.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(journal.path+".new", journal.path); err != nil {
+		return err
+	}
+
+	journal.writer, err = os.OpenFile(journal.path, os.O_WRONLY|os.O_APPEND, 0600)
+	return err
+}
+
+// close flushes the journal to the disk and closes it
+func (journal *txJournal) close() error {
+	if journal.writer == nil {
+		return nil
+	}
+	defer func() {
+		journal.writer = nil
+	}()
+	if err := journal.writer.Sync(); err != nil {
+		journal.writer.Close()
+		return err
+	}
+	return journal.writer.Close()
+}
+
+func encodeJournalRecord(tx types.Tx) []byte {
+	record := make([]byte, journalRecordHeaderSize+len(tx))
+	binary.BigEndian.PutUint32(record[:4], uint32(len(tx)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.ChecksumIEEE(tx))
+	copy(record[journalRecordHeaderSize:], tx)
+	return record
+}
+
+// InitWAL opens the journal of the txs submitted through the rpc of the node, and rechecks the txs journaled before
+// the last shutdown or crash so that the users don't lose them. The journal is then rewritten with the recovered txs.
+func (mem *CListMempool) InitWAL() error {
+	if !mem.config.WalEnabled() {
+		return nil
+	}
+	walDir := mem.config.WalDir()
+	if err := tmos.EnsureDir(walDir, 0700); err != nil {
+		return err
+	}
+
+	journal := newTxJournal(filepath.Join(walDir, "wal"))
+	txs, err := journal.load()
+	if err != nil {
+		return err
+	}
+	recovered, rejected := mem.recheckJournaledTxs(txs)
+	mem.metrics.WalRecoveredTxs.Add(float64(recovered))
+	mem.metrics.WalRejectedTxs.Add(float64(rejected))
+	mem.logger.Info("Recovered the txs of the mempool wal", "recovered", recovered, "rejected", rejected)
+
+	mem.updateMtx.Lock()
+	defer mem.updateMtx.Unlock()
+	if err := journal.rotate(mem.journaledTxs()); err != nil {
+		return err
+	}
+	mem.journal = journal
+	return nil
+}
+
+// CloseWAL closes the journal, the txs admitted afterwards aren't journaled.
+func (mem *CListMempool) CloseWAL() {
+	mem.updateMtx.Lock()
+	defer mem.updateMtx.Unlock()
+	if mem.journal == nil {
+		return
+	}
+	if err := mem.journal.close(); err != nil {
+		mem.logger.Error("Error closing the mempool wal", "err", err)
+	}
+	mem.journal = nil
+}
+
+// recheckJournaledTxs checks the txs of the journal again as if they were submitted through the rpc
+func (mem *CListMempool) recheckJournaledTxs(txs types.Txs) (recovered, rejected int64) {
+	for _, tx := range txs {
+		err := mem.CheckTx(tx, func(res *abci.Response) {
+			if r := res.GetCheckTx(); r != nil && r.Code == abci.CodeTypeOK {
+				atomic.AddInt64(&recovered, 1)
+			} else {
+				atomic.AddInt64(&rejected, 1)
+			}
+		}, TxInfo{SenderID: UnknownPeerID})
+		if err != nil {
+			atomic.AddInt64(&rejected, 1)
+		}
+	}
+	// wait for the responses of the txs being checked
+	if err := mem.FlushAppConn(); err != nil {
+		mem.logger.Error("Error flushing the mempool connection", "err", err)
+	}
+	return atomic.LoadInt64(&recovered), atomic.LoadInt64(&rejected)
+}
+
+// shouldJournal returns true if the tx admitted to the mempool is journaled, that's the txs submitted through the
+// rpc and selected by the WalFilter of the application if any
+func (mem *CListMempool) shouldJournal(txInfo TxInfo, realTx abci.TxEssentials) bool {
+	// the private txs would be gossiped once rechecked on restart
+	if !mem.config.WalEnabled() || txInfo.SenderID != UnknownPeerID || txInfo.Private {
+		return false
+	}
+	if filter, ok := mem.txInfoparser.(WalFilter); ok {
+		return filter.ShouldLogTx(realTx)
+	}
+	return true
+}
+
+// journalTx appends the tx admitted to the mempool to the journal
+func (mem *CListMempool) journalTx(memTx *mempoolTx) {
+	if mem.journal == nil || !memTx.journaled {
+		return
+	}
+	if err := mem.journal.insert(memTx.tx); err != nil {
+		mem.logger.Error("Error writing the tx to the mempool wal", "tx", txIDStringer{memTx.tx, memTx.height}, "err", err)
+	}
+}
+
+// rotateJournal rewrites the journal with the journaled txs still in the mempool, the lock must be held
+func (mem *CListMempool) rotateJournal(height int64) {
+	if mem.journal == nil || height%mem.config.WalRotateBlocks != 0 {
+		return
+	}
+	if err := mem.journal.rotate(mem.journaledTxs()); err != nil {
+		mem.logger.Error("Error rotating the mempool wal", "height", height, "err", err)
+	}
+}
+
+// journaledTxs returns the journaled txs in the mempool and the pending pool, sorted by sender and nonce so that
+// they're rechecked in order
+func (mem *CListMempool) journaledTxs() types.Txs {
+	var memTxs []*mempoolTx
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		if memTx := e.Value.(*mempoolTx); memTx.journaled {
+			memTxs = append(memTxs, memTx)
+		}
+	}
+	if mem.pendingPool != nil {
+		mem.pendingPool.mtx.RLock()
+		for _, memTx := range mem.pendingPool.txsMap {
+			if memTx.journaled {
+				memTxs = append(memTxs, memTx)
+			}
+		}
+		mem.pendingPool.mtx.RUnlock()
+	}
+
+	sort.SliceStable(memTxs, func(i, j int) bool {
+		if memTxs[i].from != memTxs[j].from {
+			return memTxs[i].from < memTxs[j].from
+		}
+		return memTxs[i].realTx.GetNonce() < memTxs[j].realTx.GetNonce()
+	})
+	txs := make(types.Txs, len(memTxs))
+	for i, memTx := range memTxs {
+		txs[i] = memTx.tx
+	}
+	return txs
+}
@@ -0,0 +1,103 @@
+package mempool
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/tendermint/abci/example/kvstore"
+	cfg "github.com/okex/exchain/libs/tendermint/config"
+	"github.com/okex/exchain/libs/tendermint/proxy"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+type testCounter struct {
+	value float64
+}
+
+func (c *testCounter) With(...string) metrics.Counter { return c }
+func (c *testCounter) Add(delta float64)              { c.value += delta }
+
+func TestTxJournalLoad(t *testing.T) {
+	dir, err := os.MkdirTemp("", "tx_journal")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "wal")
+	journal := newTxJournal(path)
+	txs, err := journal.load()
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	require.NoError(t, journal.rotate(types.Txs{[]byte("tx1"), []byte("tx2")}))
+	require.NoError(t, journal.insert([]byte("tx3")))
+	require.NoError(t, journal.close())
+	require.Error(t, journal.insert([]byte("tx4")))
+
+	txs, err = newTxJournal(path).load()
+	require.NoError(t, err)
+	require.Equal(t, types.Txs{[]byte("tx1"), []byte("tx2"), []byte("tx3")}, txs)
+
+	// the torn tail written by a crash is dropped
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = file.Write(encodeJournalRecord([]byte("tx4"))[:journalRecordHeaderSize+1])
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	txs, err = newTxJournal(path).load()
+	require.NoError(t, err)
+	require.Equal(t, types.Txs{[]byte("tx1"), []byte("tx2"), []byte("tx3")}, txs)
+
+	// and so are the records after a corrupted one
+	record := encodeJournalRecord([]byte("tx2"))
+	record[len(record)-1] = 'x'
+	require.NoError(t, os.WriteFile(path, append(append(encodeJournalRecord([]byte("tx1")), record...),
+		encodeJournalRecord([]byte("tx3"))...), 0600))
+	txs, err = newTxJournal(path).load()
+	require.NoError(t, err)
+	require.Equal(t, types.Txs{[]byte("tx1")}, txs)
+}
+
+func TestMempoolWAL(t *testing.T) {
+	config := cfg.ResetTestRoot("mempool_wal_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Mempool.WalPath = "data/mempool.wal"
+	config.Mempool.WalRotateBlocks = 1
+	cc := proxy.NewLocalClientCreator(kvstore.NewApplication())
+
+	mempool, _ := newMempoolWithAppAndConfig(cc, config)
+	require.NoError(t, mempool.InitWAL())
+	// only the txs submitted through the rpc are journaled
+	rpcTxs := checkTxs(t, mempool, 3, UnknownPeerID)
+	checkTxs(t, mempool, 2, 1)
+	require.Equal(t, 5, mempool.Size())
+
+	// the committed tx is dropped from the journal by the rotation
+	mempool.Lock()
+	require.NoError(t, mempool.Update(1, rpcTxs[:1], abciResponses(1, 0), nil, nil))
+	mempool.Unlock()
+
+	// the node crashes without closing the wal, the tx already in the mempool of the restarted node is rejected
+	metrics := NopMetrics()
+	recovered, rejected := &testCounter{}, &testCounter{}
+	metrics.WalRecoveredTxs, metrics.WalRejectedTxs = recovered, rejected
+	restarted, _ := newMempoolWithAppAndConfig(cc, config)
+	WithMetrics(metrics)(restarted)
+	require.NoError(t, restarted.CheckTx(rpcTxs[2], nil, TxInfo{SenderID: 1}))
+	require.NoError(t, restarted.InitWAL())
+	require.Equal(t, 2, restarted.Size())
+	require.Equal(t, float64(1), recovered.value)
+	require.Equal(t, float64(1), rejected.value)
+
+	_, err := restarted.GetTxByHash(txKey(rpcTxs[1]))
+	require.NoError(t, err)
+	restarted.CloseWAL()
+
+	// the journal is rewritten with the txs recovered, the ones submitted by the peers aren't journaled
+	txs, err := newTxJournal(filepath.Join(config.Mempool.WalDir(), "wal")).load()
+	require.NoError(t, err)
+	require.Equal(t, types.Txs{rpcTxs[1]}, txs)
+}
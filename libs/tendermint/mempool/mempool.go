@@ -93,6 +93,15 @@ type Mempool interface {
 	GetTxSimulateGas(txHash string) int64
 
 	GetEnableDeleteMinGPTx() bool
+
+	// InitWAL opens the write-ahead log of the mempool, and rechecks the txs
+	// logged before the last shutdown or crash. It must be called once the
+	// application is ready to check txs.
+	InitWAL() error
+
+	// CloseWAL closes and discards the underlying WAL file.
+	// Any further writes will not be relayed to disk.
+	CloseWAL()
 }
 
 //--------------------------------------------------------------------------------
@@ -107,6 +116,21 @@ type PreCheckFunc func(types.Tx) error
 // transaction doesn't require more gas than available for the block.
 type PostCheckFunc func(types.Tx, *abci.ResponseCheckTx) error
 
+// WalFilter is optionally implemented by the TxInfoParser to select the txs
+// logged to the WAL, all the txs submitted through the rpc are logged
+// otherwise.
+type WalFilter interface {
+	ShouldLogTx(tx abci.TxEssentials) bool
+}
+
+// BlockGasWeigher is optionally implemented by the TxInfoParser to weigh the
+// gas of the txs against the gas limit of the block, e.g. to convert the gas of
+// the txs of different kinds to a unified block gas. The gas of the txs is
+// taken as it is otherwise.
+type BlockGasWeigher interface {
+	GetTxBlockGas(tx abci.TxEssentials, gas int64) int64
+}
+
 // TxInfo are parameters that get passed when attempting to add a tx to the
 // mempool.
 type TxInfo struct {
@@ -115,6 +139,9 @@ type TxInfo struct {
 	SenderID uint16
 	// SenderP2PID is the actual p2p.ID of the sender, used e.g. for logging.
 	SenderP2PID p2p.ID
+	// Private txs are held locally for the blocks proposed by the node, they
+	// aren't gossiped to the peers nor listed with the unconfirmed txs.
+	Private bool
 
 	from      string
 	wtx       *WrappedTx
@@ -18,6 +18,8 @@ const (
 type Metrics struct {
 	// Size of the mempool.
 	Size metrics.Gauge
+	// Total size of the transactions in the mempool, in bytes.
+	TxsBytes metrics.Gauge
 	// Histogram of transaction sizes, in bytes.
 	TxSizeBytes metrics.Histogram
 	// Number of failed transactions.
@@ -28,6 +30,14 @@ type Metrics struct {
 	PendingPoolSize metrics.Gauge
 	// Size of the pending pool
 	GasUsed metrics.Gauge
+	// Number of txs of the wal added back to the mempool on restart.
+	WalRecoveredTxs metrics.Counter
+	// Number of txs of the wal rejected by the recheck on restart.
+	WalRejectedTxs metrics.Counter
+	// Number of txs of the peers dropped for exceeding their relay bandwidth.
+	RateLimitedPeerTxs metrics.Counter
+	// Number of txs of the down-ranked peers dropped.
+	DownRankedPeerTxs metrics.Counter
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -45,6 +55,12 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "size",
 			Help:      "Size of the mempool (number of uncommitted transactions).",
 		}, labels).With(labelsAndValues...),
+		TxsBytes: fastmetrics.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "txs_bytes",
+			Help:      "Total size of the uncommitted transactions in bytes.",
+		}, labels).With(labelsAndValues...),
 		TxSizeBytes: fastmetrics.NewHistogramFrom(stdprometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -76,6 +92,30 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "gas_used",
 			Help:      "Total amount of gas used in one block",
 		}, labels).With(labelsAndValues...),
+		WalRecoveredTxs: fastmetrics.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "wal_recovered_txs",
+			Help:      "Number of txs of the wal added back to the mempool on restart.",
+		}, labels).With(labelsAndValues...),
+		WalRejectedTxs: fastmetrics.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "wal_rejected_txs",
+			Help:      "Number of txs of the wal rejected by the recheck on restart.",
+		}, labels).With(labelsAndValues...),
+		RateLimitedPeerTxs: fastmetrics.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "rate_limited_peer_txs",
+			Help:      "Number of txs of the peers dropped for exceeding their relay bandwidth.",
+		}, labels).With(labelsAndValues...),
+		DownRankedPeerTxs: fastmetrics.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "down_ranked_peer_txs",
+			Help:      "Number of txs of the down-ranked peers dropped.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -83,10 +123,16 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 func NopMetrics() *Metrics {
 	return &Metrics{
 		Size:            discard.NewGauge(),
+		TxsBytes:        discard.NewGauge(),
 		TxSizeBytes:     discard.NewHistogram(),
 		FailedTxs:       discard.NewCounter(),
 		RecheckTimes:    discard.NewCounter(),
 		PendingPoolSize: discard.NewGauge(),
 		GasUsed:         discard.NewGauge(),
+		WalRecoveredTxs: discard.NewCounter(),
+		WalRejectedTxs:  discard.NewCounter(),
+
+		RateLimitedPeerTxs: discard.NewCounter(),
+		DownRankedPeerTxs:  discard.NewCounter(),
 	}
 }
@@ -41,6 +41,7 @@ type Reactor struct {
 	config           *cfg.MempoolConfig
 	mempool          *CListMempool
 	ids              *mempoolIDs
+	relays           *peerTxRelays
 	nodeKey          *p2p.NodeKey
 	nodeKeyWhitelist map[string]struct{}
 	enableWtx        bool
@@ -119,6 +120,7 @@ func NewReactor(config *cfg.MempoolConfig, mempool *CListMempool) *Reactor {
 		config:           config,
 		mempool:          mempool,
 		ids:              newMempoolIDs(),
+		relays:           newPeerTxRelays(),
 		nodeKeyWhitelist: make(map[string]struct{}),
 		enableWtx:        cfg.DynamicConfig.GetEnableWtx(),
 	}
@@ -133,6 +135,7 @@ func NewReactor(config *cfg.MempoolConfig, mempool *CListMempool) *Reactor {
 // InitPeer implements Reactor by creating a state for the peer.
 func (memR *Reactor) InitPeer(peer p2p.Peer) p2p.Peer {
 	memR.ids.ReserveForPeer(peer)
+	memR.relays.add(peer)
 	return peer
 }
 
@@ -147,6 +150,9 @@ func (memR *Reactor) OnStart() error {
 	if !memR.config.Broadcast {
 		memR.Logger.Info("Tx broadcasting is disabled")
 	}
+	if memR.config.PeerMaxLatency > 0 {
+		go memR.peerLatencyRoutine()
+	}
 	return nil
 }
 
@@ -170,6 +176,7 @@ func (memR *Reactor) AddPeer(peer p2p.Peer) {
 // RemovePeer implements Reactor.
 func (memR *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
 	memR.ids.Reclaim(peer)
+	memR.relays.remove(peer)
 	// broadcast routine checks if peer is gone and returns
 }
 
@@ -231,6 +238,10 @@ func (memR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 	if memR.mempool.config.Sealed {
 		return
 	}
+	relay := memR.relays.get(src)
+	if !memR.acceptPeerMsg(relay, len(msgBytes)) {
+		return
+	}
 	msg, err := memR.decodeMsg(msgBytes)
 	if err != nil {
 		memR.Logger.Error("Error decoding message", "src", src, "chId", chID, "msg", msg, "err", err, "bytes", msgBytes)
@@ -259,6 +270,7 @@ func (memR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 			memR.Logger.Error("wtx.verify", "error", err, "txhash",
 				common.BytesToHash(types.Tx(msg.Wtx.Payload).Hash(memR.mempool.Height())),
 			)
+			relay.uselessMessage()
 		} else {
 			txInfo.wtx = msg.Wtx
 			txInfo.checkType = abci.CheckTxType_WrappedCheck
@@ -266,11 +278,13 @@ func (memR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 		// broadcasting happens from go routines per peer
 	default:
 		memR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+		relay.uselessMessage()
 		return
 	}
 
-	err = memR.mempool.CheckTx(tx, nil, txInfo)
+	err = memR.mempool.CheckTx(tx, relay.checkTxCb(), txInfo)
 	if err != nil {
+		relay.scoreCheckTxError(err)
 		memR.logCheckTxError(tx, memR.mempool.height, err)
 	}
 }
@@ -288,6 +302,7 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 	_, isInWhiteList := memR.nodeKeyWhitelist[string(peer.ID())]
 
 	peerID := memR.ids.GetForPeer(peer)
+	relay := memR.relays.get(peer)
 	var next *clist.CElement
 	for {
 		// In case of both next.NextWaitChan() and peer.Quit() are variable at the same time
@@ -332,7 +347,11 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 		memTx.senderMtx.RLock()
 		_, ok = memTx.senders[peerID]
 		memTx.senderMtx.RUnlock()
-		if !ok {
+		if !ok && !memTx.private {
+			if memR.sendRateExceeded(relay) {
+				time.Sleep(peerCatchupSleepIntervalMS * time.Millisecond)
+				continue
+			}
 			var getFromPool bool
 			// send memTx
 			var msg Message
@@ -374,6 +393,9 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 				time.Sleep(peerCatchupSleepIntervalMS * time.Millisecond)
 				continue
 			}
+			if relay != nil {
+				relay.sendMonitor.Update(len(msgBz))
+			}
 		}
 
 		select {
@@ -155,6 +155,35 @@ func TestReactorNoBroadcastToSender(t *testing.T) {
 	ensureNoTxs(t, reactors[1], 100*time.Millisecond)
 }
 
+func TestReactorNoBroadcastPrivateTxs(t *testing.T) {
+	config := cfg.TestConfig()
+	const N = 2
+	reactors := makeAndConnectReactors(config, N)
+	defer func() {
+		for _, r := range reactors {
+			r.Stop()
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().List() {
+			peer.Set(types.PeerStateKey, peerState{1})
+		}
+	}
+
+	// the private txs are held by the first reactor only, and aren't listed
+	privateTx := types.Tx("private-tx")
+	require.NoError(t, reactors[0].mempool.CheckTx(privateTx, nil, TxInfo{Private: true}))
+	require.Equal(t, 1, reactors[0].mempool.Size())
+	require.Empty(t, reactors[0].mempool.ReapMaxTxs(-1))
+	require.Equal(t, types.Txs{privateTx}, types.Txs(reactors[0].mempool.ReapMaxBytesMaxGas(-1, -1)))
+	ensureNoTxs(t, reactors[1], 100*time.Millisecond)
+
+	// while the other txs are still gossiped
+	txs := checkTxs(t, reactors[0].mempool, 1, UnknownPeerID)
+	waitForTxsOnReactor(t, txs, reactors[1], 1)
+	require.Equal(t, 1, reactors[1].mempool.Size())
+}
+
 func TestBroadcastTxForPeerStopsWhenPeerStops(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping test in short mode.")
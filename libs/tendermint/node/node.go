@@ -34,7 +34,9 @@ import (
 	cs "github.com/okex/exchain/libs/tendermint/consensus"
 	"github.com/okex/exchain/libs/tendermint/crypto"
 	"github.com/okex/exchain/libs/tendermint/evidence"
+	"github.com/okex/exchain/libs/tendermint/halt"
 	"github.com/okex/exchain/libs/tendermint/libs/log"
+	tmnet "github.com/okex/exchain/libs/tendermint/libs/net"
 	tmpubsub "github.com/okex/exchain/libs/tendermint/libs/pubsub"
 	"github.com/okex/exchain/libs/tendermint/libs/service"
 	mempl "github.com/okex/exchain/libs/tendermint/mempool"
@@ -155,6 +157,14 @@ func CustomReactors(reactors map[string]p2p.Reactor) Option {
 	}
 }
 
+// HaltScheduleSource sets the source of the halt schedule of the chain, which
+// is announced to the peers.
+func HaltScheduleSource(source halt.ScheduleSource) Option {
+	return func(n *Node) {
+		n.haltReactor.SetScheduleSource(source)
+	}
+}
+
 //------------------------------------------------------------------------------
 
 // Node is the highest level interface to a full Tendermint node.
@@ -185,6 +195,7 @@ type Node struct {
 	consensusState   *cs.State      // latest consensus state
 	consensusReactor *cs.Reactor    // for participating in the consensus
 	pexReactor       *pex.Reactor   // for exchanging peer addresses
+	haltReactor      *halt.Reactor  // for announcing the halt schedule of the chain
 	evidencePool     *evidence.Pool // tracking evidence
 	proxyApp         proxy.AppConns // connection to the application
 	rpcListeners     []net.Listener // rpc servers
@@ -270,7 +281,7 @@ func createAndStartEventBus(logger log.Logger) (*types.EventBus, error) {
 	return eventBus, nil
 }
 
-func createAndStartIndexerService(config *cfg.Config, dbProvider DBProvider,
+func createAndStartIndexerService(config *cfg.Config, dbProvider DBProvider, blockStore txindex.BlockStore,
 	eventBus *types.EventBus, logger log.Logger) (*txindex.IndexerService, txindex.TxIndexer, error) {
 
 	var txIndexer txindex.TxIndexer
@@ -281,11 +292,19 @@ func createAndStartIndexerService(config *cfg.Config, dbProvider DBProvider,
 		if err != nil {
 			return nil, nil, err
 		}
+		// the txs are pruned by height, so the height is always indexed when pruning
+		var indexKeys []string
+		if config.TxIndex.PruningEnabled() {
+			indexKeys = append(indexKeys, types.TxHeightKey)
+		}
 		switch {
 		case config.TxIndex.IndexKeys != "":
-			txIndexer = kv.NewTxIndex(store, kv.IndexEvents(splitAndTrimEmpty(config.TxIndex.IndexKeys, ",", " ")))
+			indexKeys = append(indexKeys, splitAndTrimEmpty(config.TxIndex.IndexKeys, ",", " ")...)
+			txIndexer = kv.NewTxIndex(store, kv.IndexEvents(indexKeys))
 		case config.TxIndex.IndexAllKeys:
 			txIndexer = kv.NewTxIndex(store, kv.IndexAllEvents())
+		case len(indexKeys) > 0:
+			txIndexer = kv.NewTxIndex(store, kv.IndexEvents(indexKeys))
 		default:
 			txIndexer = kv.NewTxIndex(store)
 		}
@@ -301,6 +320,12 @@ func createAndStartIndexerService(config *cfg.Config, dbProvider DBProvider,
 
 	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus)
 	indexerService.SetLogger(logger.With("module", "txindex"))
+	if config.TxIndex.PruningEnabled() {
+		pruner := txindex.NewPruner(txIndexer, blockIndexer, blockStore,
+			config.TxIndex.RetainBlocks, config.TxIndex.RetainTime, config.TxIndex.PruneInterval)
+		pruner.SetLogger(logger.With("module", "txindex"))
+		indexerService.SetPruner(pruner)
+	}
 	if err := indexerService.Start(); err != nil {
 		return nil, nil, err
 	}
@@ -359,8 +384,12 @@ func onlyValidatorIsUs(state sm.State, pubKey crypto.PubKey) bool {
 }
 
 func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns,
-	state sm.State, memplMetrics *mempl.Metrics, logger log.Logger) (*mempl.Reactor, *mempl.CListMempool) {
+	state sm.State, memplMetrics *mempl.Metrics, logger log.Logger) (*mempl.Reactor, *mempl.CListMempool, error) {
 
+	orderingPolicy, err := mempl.GetOrderingPolicy(config.Mempool.OrderingPolicy)
+	if err != nil {
+		return nil, nil, err
+	}
 	mempool := mempl.NewCListMempool(
 		config.Mempool,
 		proxyApp.Mempool(),
@@ -368,6 +397,7 @@ func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns,
 		mempl.WithMetrics(memplMetrics),
 		mempl.WithPreCheck(sm.TxPreCheck(state)),
 		mempl.WithPostCheck(sm.TxPostCheck(state)),
+		mempl.WithOrderingPolicy(orderingPolicy),
 	)
 	mempoolLogger := logger.With("module", "mempool")
 	mempoolReactor := mempl.NewReactor(config.Mempool, mempool)
@@ -376,7 +406,7 @@ func createMempoolAndMempoolReactor(config *cfg.Config, proxyApp proxy.AppConns,
 	if config.Consensus.WaitForTxs() {
 		mempool.EnableTxsAvailable()
 	}
-	return mempoolReactor, mempool
+	return mempoolReactor, mempool, nil
 }
 
 func createEvidenceReactor(config *cfg.Config, dbProvider DBProvider,
@@ -529,6 +559,7 @@ func createSwitch(config *cfg.Config,
 	bcReactor p2p.Reactor,
 	consensusReactor *consensus.Reactor,
 	evidenceReactor *evidence.Reactor,
+	haltReactor *halt.Reactor,
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
 	p2pLogger log.Logger) *p2p.Switch {
@@ -544,6 +575,7 @@ func createSwitch(config *cfg.Config,
 	sw.AddReactor("BLOCKCHAIN", bcReactor)
 	sw.AddReactor("CONSENSUS", consensusReactor)
 	sw.AddReactor("EVIDENCE", evidenceReactor)
+	sw.AddReactor("HALT", haltReactor)
 
 	sw.SetNodeInfo(nodeInfo)
 	sw.SetNodeKey(nodeKey)
@@ -580,12 +612,21 @@ func createAddrBookAndSetOnSwitch(config *cfg.Config, sw *p2p.Switch,
 }
 
 func createPEXReactorAndAddToSwitch(addrBook pex.AddrBook, config *cfg.Config,
-	sw *p2p.Switch, logger log.Logger) *pex.Reactor {
+	sw *p2p.Switch, logger log.Logger) (*pex.Reactor, error) {
+
+	seeds := splitAndTrimEmpty(setDefaultSeeds(config.P2P.Seeds), ",", " ")
+	if seedsFile := config.P2P.SeedsFile(); seedsFile != "" {
+		fileSeeds, err := pex.LoadSeedsFile(seedsFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "p2p.seeds_file is incorrect")
+		}
+		seeds = append(seeds, fileSeeds...)
+	}
 
 	// TODO persistent peers ? so we can have their DNS addrs saved
 	pexReactor := pex.NewReactor(addrBook,
 		&pex.ReactorConfig{
-			Seeds:    splitAndTrimEmpty(setDefaultSeeds(config.P2P.Seeds), ",", " "),
+			Seeds:    seeds,
 			SeedMode: config.P2P.SeedMode,
 			// See consensus/reactor.go: blocksToContributeToBecomeGoodPeer 10000
 			// blocks assuming 10s blocks ~ 28 hours.
@@ -597,7 +638,7 @@ func createPEXReactorAndAddToSwitch(addrBook pex.AddrBook, config *cfg.Config,
 		})
 	pexReactor.SetLogger(logger.With("module", "pex"))
 	sw.AddReactor("PEX", pexReactor)
-	return pexReactor
+	return pexReactor, nil
 }
 
 // NewNode returns a new, ready to go, Tendermint Node.
@@ -639,7 +680,7 @@ func NewNode(config *cfg.Config,
 	}
 
 	// Transaction indexing
-	indexerService, txIndexer, err := createAndStartIndexerService(config, dbProvider, eventBus, logger)
+	indexerService, txIndexer, err := createAndStartIndexerService(config, dbProvider, blockStore, eventBus, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -660,10 +701,41 @@ func NewNode(config *cfg.Config,
 	// external signing process.
 	if config.PrivValidatorListenAddr != "" {
 		// FIXME: we should start services inside OnStart
-		privValidator, err = createAndStartPrivValidatorSocketClient(config.PrivValidatorListenAddr, logger)
+		privValidator, err = createAndStartPrivValidatorSocketClient(
+			config.PrivValidatorListenAddr,
+			config.PrivValidatorRequestTimeout,
+			config.PrivValidatorRetries,
+			logger,
+		)
 		if err != nil {
 			return nil, errors.Wrap(err, "error with private validator socket client")
 		}
+		// keep the last sign state on the validator host, so that it never double signs whatever the external
+		// PrivValidator does
+		privValidator, err = privval.NewSignStateGuard(privValidator, config.PrivValidatorStateFile())
+		if err != nil {
+			return nil, errors.Wrap(err, "error loading private validator state")
+		}
+	}
+
+	// Sign only the heights/rounds/steps reserved in the sign state shared with the other hosts of the key.
+	if config.PrivValidatorSignStateRedis != "" {
+		pubKey, err := privValidator.GetPubKey()
+		if err != nil {
+			return nil, errors.Wrap(err, "can't get pubkey")
+		}
+		store, err := privval.NewRedisSignStateStore(config.PrivValidatorSignStateRedis, pubKey.Address())
+		if err != nil {
+			return nil, errors.Wrap(err, "error with the shared sign state")
+		}
+		privValidator = privval.NewSharedSignStatePV(privValidator, store, string(nodeKey.ID()))
+	}
+
+	// Sign only while holding the lease shared with the standby nodes of the same key.
+	if leaseFile := config.PrivValidatorLeaseFile(); leaseFile != "" {
+		holder := string(nodeKey.ID())
+		lease := privval.NewFileLease(leaseFile, holder, config.PrivValidatorLeaseTTL)
+		privValidator = privval.NewStandbyPV(privValidator, lease, holder, logger.With("module", "privval"))
 	}
 
 	pubKey, err := privValidator.GetPubKey()
@@ -681,7 +753,10 @@ func NewNode(config *cfg.Config,
 	csMetrics, p2pMetrics, memplMetrics, smMetrics := metricsProvider(genDoc.ChainID)
 
 	// Make MempoolReactor
-	mempoolReactor, mempool := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger)
+	mempoolReactor, mempool, err := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger)
+	if err != nil {
+		return nil, err
+	}
 	mempoolReactor.SetNodeKey(nodeKey)
 	// Make Evidence Reactor
 	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateDB, logger)
@@ -715,6 +790,10 @@ func NewNode(config *cfg.Config,
 		privValidator, csMetrics, fastSync, autoFastSync, eventBus, consensusLogger,
 	)
 
+	// Make HaltReactor, it announces the halt schedule once the application sets its source
+	haltReactor := halt.NewReactor()
+	haltReactor.SetLogger(logger.With("module", "halt"))
+
 	nodeInfo, err := makeNodeInfo(config, nodeKey, txIndexer, genDoc, state)
 	if err != nil {
 		return nil, err
@@ -727,7 +806,7 @@ func NewNode(config *cfg.Config,
 	p2pLogger := logger.With("module", "p2p")
 	sw := createSwitch(
 		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
-		consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger,
+		consensusReactor, evidenceReactor, haltReactor, nodeInfo, nodeKey, p2pLogger,
 	)
 
 	err = sw.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
@@ -759,7 +838,9 @@ func NewNode(config *cfg.Config,
 	// Note we currently use the addrBook regardless at least for AddOurAddress
 	var pexReactor *pex.Reactor
 	if config.P2P.PexReactor {
-		pexReactor = createPEXReactorAndAddToSwitch(addrBook, config, sw, logger)
+		if pexReactor, err = createPEXReactorAndAddToSwitch(addrBook, config, sw, logger); err != nil {
+			return nil, err
+		}
 	}
 
 	if config.ProfListenAddress != "" {
@@ -787,6 +868,7 @@ func NewNode(config *cfg.Config,
 		consensusState:   consensusState,
 		consensusReactor: consensusReactor,
 		pexReactor:       pexReactor,
+		haltReactor:      haltReactor,
 		evidencePool:     evidencePool,
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
@@ -864,7 +946,10 @@ func NewLRPNode(config *cfg.Config,
 	consensusLogger := logger.With("module", "consensus")
 
 	state = sm.LoadState(stateDB)
-	mempoolReactor, mempool := createMempoolAndMempoolReactor(config, proxyApp, state, nil, logger)
+	mempoolReactor, mempool, err := createMempoolAndMempoolReactor(config, proxyApp, state, nil, logger)
+	if err != nil {
+		return nil, err
+	}
 	mempoolReactor.SetNodeKey(nodeKey)
 
 	// Make ConsensusReactor
@@ -968,6 +1053,9 @@ func (n *Node) OnStop() {
 	// now stop the reactors
 	n.sw.Stop()
 
+	// stop mempool WAL
+	n.mempool.CloseWAL()
+
 	n.blockExec.Stop()
 
 	if err := n.transport.Close(); err != nil {
@@ -1002,6 +1090,10 @@ func (n *Node) ConfigureRPC() error {
 	if err != nil {
 		return fmt.Errorf("can't get pubkey: %w", err)
 	}
+	// only the v0 blockchain reactor reports the progress of the fast sync
+	bcReactor, _ := n.bcReactor.(interface {
+		SyncProgress() (startHeight, highestHeight int64)
+	})
 	rpccore.SetEnvironment(&rpccore.Environment{
 		ProxyAppQuery: n.proxyApp.Query(),
 
@@ -1012,11 +1104,14 @@ func (n *Node) ConfigureRPC() error {
 		P2PPeers:       n.sw,
 		P2PTransport:   n,
 
+		BlockchainReactor: bcReactor,
+
 		PubKey:           pubKey,
 		GenDoc:           n.genesisDoc,
 		TxIndexer:        n.txIndexer,
 		BlockIndexer:     n.indexerService.GetBlockIndexer(),
 		ConsensusReactor: n.consensusReactor,
+		HaltReactor:      n.haltReactor,
 		EventBus:         n.eventBus,
 		Mempool:          n.mempool,
 
@@ -1078,14 +1173,14 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 			return nil, err
 		}
 
-		var rootHandler http.Handler = mux
+		var rootHandler http.Handler = rpccore.HealthCheckHandler(mux)
 		if n.config.RPC.IsCorsEnabled() {
 			corsMiddleware := cors.New(cors.Options{
 				AllowedOrigins: n.config.RPC.CORSAllowedOrigins,
 				AllowedMethods: n.config.RPC.CORSAllowedMethods,
 				AllowedHeaders: n.config.RPC.CORSAllowedHeaders,
 			})
-			rootHandler = corsMiddleware.Handler(mux)
+			rootHandler = corsMiddleware.Handler(rootHandler)
 		}
 		if n.config.RPC.IsTLSEnabled() {
 			go rpcserver.ServeTLS(
@@ -1164,6 +1259,13 @@ func (n *Node) BlockStore() *store.BlockStore {
 	return n.blockStore
 }
 
+// ReloadReplica refreshes the blocks served by a read-only replica node once its DBs caught up with the primary,
+// the blocks above the height of the state of the app are left out.
+func (n *Node) ReloadReplica(appHeight int64) {
+	n.blockStore.Reload(appHeight)
+	global.SetGlobalHeight(n.blockStore.Height())
+}
+
 // ConsensusState returns the Node's ConsensusState.
 func (n *Node) ConsensusState() *cs.State {
 	return n.consensusState
@@ -1279,6 +1381,7 @@ func makeNodeInfo(
 			cs.StateChannel, cs.DataChannel, cs.VoteChannel, cs.VoteSetBitsChannel, cs.ViewChangeChannel,
 			mempl.MempoolChannel,
 			evidence.EvidenceChannel,
+			halt.HaltChannel,
 		},
 		Moniker: config.Moniker,
 		Other: p2p.DefaultNodeInfoOther{
@@ -1363,9 +1466,15 @@ func saveGenesisDoc(db dbm.DB, genDoc *types.GenesisDoc) {
 
 func createAndStartPrivValidatorSocketClient(
 	listenAddr string,
+	timeout time.Duration,
+	retries int,
 	logger log.Logger,
 ) (types.PrivValidator, error) {
-	pve, err := privval.NewSignerListener(listenAddr, logger)
+	if protocol, address := tmnet.ProtocolAndAddress(listenAddr); protocol == "grpc" {
+		return createPrivValidatorGRPCClient(address, timeout)
+	}
+
+	pve, err := privval.NewSignerListener(listenAddr, logger, privval.SignerListenerEndpointTimeoutReadWrite(timeout))
 	if err != nil {
 		return nil, fmt.Errorf("failed to start private validator: %w", err)
 	}
@@ -1381,15 +1490,25 @@ func createAndStartPrivValidatorSocketClient(
 		return nil, fmt.Errorf("can't get pubkey: %w", err)
 	}
 
-	const (
-		retries = 50 // 50 * 100ms = 5s total
-		timeout = 100 * time.Millisecond
-	)
-	pvscWithRetries := privval.NewRetrySignerClient(pvsc, retries, timeout)
+	const retryWait = 100 * time.Millisecond
+	pvscWithRetries := privval.NewRetrySignerClient(pvsc, retries, retryWait)
 
 	return pvscWithRetries, nil
 }
 
+func createPrivValidatorGRPCClient(address string, timeout time.Duration) (types.PrivValidator, error) {
+	pvsc, err := privval.NewSignerGRPCClient(address, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect private validator: %w", err)
+	}
+
+	// try to get a pubkey from private validate first time
+	if _, err = pvsc.GetPubKey(); err != nil {
+		return nil, fmt.Errorf("can't get pubkey: %w", err)
+	}
+	return pvsc, nil
+}
+
 // splitAndTrimEmpty slices s into all subslices separated by sep and returns a
 // slice of the string s with all leading and trailing Unicode code points
 // contained in cutset removed. If sep is empty, SplitAndTrim splits after each
@@ -162,7 +162,8 @@ func TestNodeSetPrivValTCP(t *testing.T) {
 	config.Instrumentation.Prometheus = false
 	n, err := DefaultNewNode(config, log.TestingLogger())
 	require.NoError(t, err)
-	assert.IsType(t, &privval.RetrySignerClient{}, n.PrivValidator())
+	require.IsType(t, &privval.SignStateGuard{}, n.PrivValidator())
+	assert.IsType(t, &privval.RetrySignerClient{}, n.PrivValidator().(*privval.SignStateGuard).Next())
 }
 
 // address without a protocol must result in error
@@ -207,7 +208,8 @@ func TestNodeSetPrivValIPC(t *testing.T) {
 	config.Instrumentation.Prometheus = false
 	n, err := DefaultNewNode(config, log.TestingLogger())
 	require.NoError(t, err)
-	assert.IsType(t, &privval.RetrySignerClient{}, n.PrivValidator())
+	require.IsType(t, &privval.SignStateGuard{}, n.PrivValidator())
+	assert.IsType(t, &privval.RetrySignerClient{}, n.PrivValidator().(*privval.SignStateGuard).Next())
 }
 
 // testFreeAddr claims a free port so we don't block on listener being ready.
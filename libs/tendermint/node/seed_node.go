@@ -0,0 +1,165 @@
+package node
+
+import (
+	"github.com/pkg/errors"
+
+	cfg "github.com/okex/exchain/libs/tendermint/config"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/libs/tendermint/libs/service"
+	"github.com/okex/exchain/libs/tendermint/p2p"
+	"github.com/okex/exchain/libs/tendermint/p2p/pex"
+	sm "github.com/okex/exchain/libs/tendermint/state"
+	"github.com/okex/exchain/libs/tendermint/version"
+)
+
+// SeedNode is a lightweight node which only runs the pex reactor in seed mode:
+// it crawls the network and serves the addresses of the peers it found, without
+// storing blocks nor running an app. Its address book can be exported with
+// pex.ExportAddrBook for the seeds of the other nodes.
+type SeedNode struct {
+	service.BaseService
+
+	config    *cfg.Config
+	nodeKey   *p2p.NodeKey
+	nodeInfo  p2p.NodeInfo
+	transport *p2p.MultiplexTransport
+	sw        *p2p.Switch
+	addrBook  pex.AddrBook
+}
+
+// NewSeedNode returns a new seed node of the network of the genesis doc, the
+// pex reactor and the seed mode are always enabled.
+func NewSeedNode(config *cfg.Config,
+	nodeKey *p2p.NodeKey,
+	genesisDocProvider GenesisDocProvider,
+	logger log.Logger) (*SeedNode, error) {
+
+	if config.FilterPeers {
+		return nil, errors.New("the seed node can't filter the peers through the abci app")
+	}
+	config.P2P.PexReactor = true
+	config.P2P.SeedMode = true
+
+	genDoc, err := genesisDocProvider()
+	if err != nil {
+		return nil, err
+	}
+	// the peers are only compatible with the block protocol of the genesis state
+	state, err := sm.MakeGenesisState(genDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeInfo := p2p.DefaultNodeInfo{
+		ProtocolVersion: p2p.NewProtocolVersion(
+			version.P2PProtocol,
+			state.Version.Consensus.Block,
+			state.Version.Consensus.App,
+		),
+		DefaultNodeID: nodeKey.ID(),
+		Network:       genDoc.ChainID,
+		Version:       version.TMCoreSemVer,
+		Channels:      []byte{pex.PexChannel},
+		Moniker:       config.Moniker,
+		Other: p2p.DefaultNodeInfoOther{
+			TxIndex: "off",
+		},
+		ListenAddr: config.P2P.ExternalAddress,
+	}
+	if nodeInfo.ListenAddr == "" {
+		nodeInfo.ListenAddr = config.P2P.ListenAddress
+	}
+	if err := nodeInfo.Validate(); err != nil {
+		return nil, err
+	}
+
+	transport, peerFilters := createTransport(config, nodeInfo, nodeKey, nil)
+
+	p2pLogger := logger.With("module", "p2p")
+	sw := p2p.NewSwitch(config.P2P, transport, p2p.SwitchPeerFilters(peerFilters...))
+	sw.SetLogger(p2pLogger)
+	sw.SetNodeInfo(nodeInfo)
+	sw.SetNodeKey(nodeKey)
+
+	err = sw.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not add peers from persistent_peers field")
+	}
+	err = sw.AddUnconditionalPeerIDs(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not add peer ids from unconditional_peer_ids field")
+	}
+
+	addrBook, err := createAddrBookAndSetOnSwitch(config, sw, p2pLogger, nodeKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create addrbook")
+	}
+	if _, err := createPEXReactorAndAddToSwitch(addrBook, config, sw, logger); err != nil {
+		return nil, err
+	}
+
+	p2pLogger.Info("P2P Node ID", "ID", nodeKey.ID(), "file", config.NodeKeyFile())
+
+	node := &SeedNode{
+		config:    config,
+		nodeKey:   nodeKey,
+		nodeInfo:  nodeInfo,
+		transport: transport,
+		sw:        sw,
+		addrBook:  addrBook,
+	}
+	node.BaseService = *service.NewBaseService(logger, "SeedNode", node)
+	return node, nil
+}
+
+// OnStart starts the seed node. It implements service.Service.
+func (n *SeedNode) OnStart() error {
+	n.addrBook.AddPrivateIDs(splitAndTrimEmpty(n.config.P2P.PrivatePeerIDs, ",", " "))
+
+	addr, err := p2p.NewNetAddressString(p2p.IDAddressString(n.nodeKey.ID(), n.config.P2P.ListenAddress))
+	if err != nil {
+		return err
+	}
+	if err := n.transport.Listen(*addr); err != nil {
+		return err
+	}
+
+	if err := n.sw.Start(); err != nil {
+		return err
+	}
+
+	err = n.sw.DialPeersAsync(splitAndTrimEmpty(n.config.P2P.PersistentPeers, ",", " "))
+	if err != nil {
+		return errors.Wrap(err, "could not dial peers from persistent_peers field")
+	}
+	return nil
+}
+
+// OnStop stops the seed node. It implements service.Service.
+func (n *SeedNode) OnStop() {
+	n.BaseService.OnStop()
+
+	n.Logger.Info("Stopping SeedNode")
+	n.sw.Stop()
+	// the address book is saved in the background when stopped with the pex
+	// reactor, save it right away so it can be exported once the node stopped
+	n.addrBook.Save()
+	if err := n.transport.Close(); err != nil {
+		n.Logger.Error("Error closing transport", "err", err)
+	}
+}
+
+// Switch returns the seed node's Switch.
+func (n *SeedNode) Switch() *p2p.Switch {
+	return n.sw
+}
+
+// AddrBook returns the seed node's address book.
+func (n *SeedNode) AddrBook() pex.AddrBook {
+	return n.addrBook
+}
+
+// NodeInfo returns the seed node's NodeInfo.
+func (n *SeedNode) NodeInfo() p2p.NodeInfo {
+	return n.nodeInfo
+}
@@ -0,0 +1,50 @@
+package node
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/okex/exchain/libs/tendermint/config"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/libs/tendermint/p2p"
+	"github.com/okex/exchain/libs/tendermint/p2p/pex"
+)
+
+func TestSeedNode(t *testing.T) {
+	config := cfg.ResetTestRoot("node_seed_node_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Instrumentation.Prometheus = false
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	defer n.Stop()
+
+	seedConfig := cfg.ResetTestRoot("node_seed_node_test")
+	defer os.RemoveAll(seedConfig.RootDir)
+	seedConfig.P2P.ListenAddress = "tcp://127.0.0.1:36756"
+	seedConfig.P2P.AddrBookStrict = false
+	seedConfig.P2P.PersistentPeers = fmt.Sprintf("%s@127.0.0.1:36656", n.NodeInfo().ID())
+	nodeKey, err := p2p.LoadOrGenNodeKey(seedConfig.NodeKeyFile())
+	require.NoError(t, err)
+	seedNode, err := NewSeedNode(seedConfig, nodeKey, DefaultGenesisDocProviderFunc(seedConfig), log.TestingLogger())
+	require.NoError(t, err)
+	assert.True(t, seedConfig.P2P.SeedMode)
+	assert.Equal(t, n.NodeInfo().(p2p.DefaultNodeInfo).Network, seedNode.NodeInfo().(p2p.DefaultNodeInfo).Network)
+	require.NoError(t, seedNode.Start())
+
+	// the full node accepts the seed node as a peer
+	require.Eventually(t, func() bool {
+		return seedNode.Switch().Peers().Size() == 1
+	}, 10*time.Second, 100*time.Millisecond)
+	require.NoError(t, seedNode.Stop())
+
+	export, err := pex.ExportAddrBook(seedConfig.P2P.AddrBookFile(), 0)
+	require.NoError(t, err)
+	require.Len(t, export.Addrs, 1)
+	assert.Equal(t, seedConfig.P2P.PersistentPeers, export.Seeds)
+}
@@ -129,6 +129,8 @@ type MConnection struct {
 	// close conn if pong is not received in pongTimeout
 	pongTimer     *time.Timer
 	pongTimeoutCh chan bool // true - timeout, false - peer sent pong
+	pingSentAt    time.Time // when the last ping was sent
+	latency       int64     // round trip time of the last ping, accessed atomically
 
 	chStatsTimer *time.Ticker // update channel stats periodically
 
@@ -478,6 +480,7 @@ FOR_LOOP:
 				break SELECTION
 			}
 			c.sendMonitor.Update(int(_n))
+			c.pingSentAt = time.Now()
 			c.Logger.Debug("Starting pong timer", "dur", c.config.PongTimeout)
 			c.pongTimer = time.AfterFunc(c.config.PongTimeout, func() {
 				select {
@@ -492,6 +495,9 @@ FOR_LOOP:
 				err = errors.New("pong timeout")
 			} else {
 				c.stopPongTimer()
+				if !c.pingSentAt.IsZero() {
+					atomic.StoreInt64(&c.latency, int64(time.Since(c.pingSentAt)))
+				}
 			}
 		case <-c.pong:
 			c.Logger.Debug("Send Pong")
@@ -729,6 +735,7 @@ func (c *MConnection) maxPacketMsgSize() int {
 
 type ConnectionStatus struct {
 	Duration    time.Duration
+	Latency     time.Duration
 	SendMonitor flow.Status
 	RecvMonitor flow.Status
 	Channels    []ChannelStatus
@@ -745,6 +752,7 @@ type ChannelStatus struct {
 func (c *MConnection) Status() ConnectionStatus {
 	var status ConnectionStatus
 	status.Duration = time.Since(c.created)
+	status.Latency = time.Duration(atomic.LoadInt64(&c.latency))
 	status.SendMonitor = c.sendMonitor.Status()
 	status.RecvMonitor = c.recvMonitor.Status()
 	status.Channels = make([]ChannelStatus, len(c.channels))
@@ -0,0 +1,90 @@
+package pex
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AddrBookExport is an address book exported in a format consumable by the
+// other nodes: Seeds can be set as the p2p.seeds of their config, or the whole
+// export be loaded through their p2p.seeds_file.
+type AddrBookExport struct {
+	Seeds string         `json:"seeds"`
+	Addrs []ExportedAddr `json:"addrs"`
+}
+
+// ExportedAddr is an address of an exported address book.
+type ExportedAddr struct {
+	Addr        string    `json:"addr"`
+	Good        bool      `json:"good"`
+	LastSuccess time.Time `json:"last_success"`
+}
+
+// ExportAddrBook exports the address book saved at filePath. The good
+// addresses come first, the most recently reached first, and the banned ones
+// are skipped. The seeds are the first maxSeeds good addresses, or the first
+// maxSeeds ones if none is good yet. maxSeeds <= 0 means all of them.
+func ExportAddrBook(filePath string, maxSeeds int) (*AddrBookExport, error) {
+	bz, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	aJSON := &addrBookJSON{}
+	if err := json.Unmarshal(bz, aJSON); err != nil {
+		return nil, errors.Wrapf(err, "failed to read the address book %s", filePath)
+	}
+
+	now := time.Now()
+	kas := make([]*knownAddress, 0, len(aJSON.Addrs))
+	for _, ka := range aJSON.Addrs {
+		if ka.Addr != nil && !ka.LastBanTime.After(now) {
+			kas = append(kas, ka)
+		}
+	}
+	sort.SliceStable(kas, func(i, j int) bool {
+		if kas[i].isOld() != kas[j].isOld() {
+			return kas[i].isOld()
+		}
+		return kas[i].LastSuccess.After(kas[j].LastSuccess)
+	})
+
+	export := &AddrBookExport{Addrs: make([]ExportedAddr, 0, len(kas))}
+	seeds := make([]string, 0, len(kas))
+	for _, ka := range kas {
+		export.Addrs = append(export.Addrs, ExportedAddr{
+			Addr:        ka.Addr.String(),
+			Good:        ka.isOld(),
+			LastSuccess: ka.LastSuccess,
+		})
+		if (ka.isOld() || !kas[0].isOld()) && (maxSeeds <= 0 || len(seeds) < maxSeeds) {
+			seeds = append(seeds, ka.Addr.String())
+		}
+	}
+	export.Seeds = strings.Join(seeds, ",")
+	return export, nil
+}
+
+// LoadSeedsFile returns the seeds of the address book export saved at filePath.
+func LoadSeedsFile(filePath string) ([]string, error) {
+	bz, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	export := &AddrBookExport{}
+	if err := json.Unmarshal(bz, export); err != nil {
+		return nil, errors.Wrapf(err, "failed to read the seeds file %s", filePath)
+	}
+
+	seeds := make([]string, 0)
+	for _, seed := range strings.Split(export.Seeds, ",") {
+		if seed = strings.TrimSpace(seed); seed != "" {
+			seeds = append(seeds, seed)
+		}
+	}
+	return seeds, nil
+}
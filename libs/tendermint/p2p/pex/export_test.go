@@ -0,0 +1,60 @@
+package pex
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportAddrBook(t *testing.T) {
+	book, fname := createAddrBookWithMOldAndNNewAddrs(t, 2, 3)
+	defer deleteTempFile(fname)
+	book.Save()
+
+	export, err := ExportAddrBook(fname, 0)
+	require.NoError(t, err)
+	require.Len(t, export.Addrs, 5)
+	seeds := strings.Split(export.Seeds, ",")
+	require.Len(t, seeds, 2)
+	for i, addr := range export.Addrs {
+		// the good addresses come first and are the seeds
+		assert.Equal(t, i < 2, addr.Good)
+		if addr.Good {
+			assert.Equal(t, seeds[i], addr.Addr)
+			assert.False(t, addr.LastSuccess.IsZero())
+		}
+	}
+
+	export, err = ExportAddrBook(fname, 1)
+	require.NoError(t, err)
+	assert.Len(t, export.Addrs, 5)
+	assert.Equal(t, export.Addrs[0].Addr, export.Seeds)
+
+	// the seeds file of the other nodes is the export
+	bz, err := json.Marshal(export)
+	require.NoError(t, err)
+	seedsFile := createTempFileName("seeds_test")
+	defer deleteTempFile(seedsFile)
+	require.NoError(t, ioutil.WriteFile(seedsFile, bz, 0644))
+	loaded, err := LoadSeedsFile(seedsFile)
+	require.NoError(t, err)
+	assert.Equal(t, []string{export.Seeds}, loaded)
+
+	_, err = ExportAddrBook(fname+".missing", 0)
+	require.Error(t, err)
+}
+
+func TestExportAddrBookWithoutGoodAddrs(t *testing.T) {
+	book, fname := createAddrBookWithMOldAndNNewAddrs(t, 0, 3)
+	defer deleteTempFile(fname)
+	book.Save()
+
+	export, err := ExportAddrBook(fname, 0)
+	require.NoError(t, err)
+	require.Len(t, export.Addrs, 3)
+	assert.Len(t, strings.Split(export.Seeds, ","), 3)
+}
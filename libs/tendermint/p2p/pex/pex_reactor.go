@@ -713,6 +713,8 @@ func (r *Reactor) crawlPeers(addrs []*p2p.NetAddress) {
 			continue
 		}
 
+		// the peer is reachable, its address is worth being served and exported
+		r.book.MarkGood(addr.ID)
 		peer := r.Switch.Peers().Get(addr.ID)
 		if peer != nil {
 			r.RequestAddrs(peer)
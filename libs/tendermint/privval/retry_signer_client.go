@@ -95,11 +95,14 @@ func (sc *RetrySignerClient) SignProposal(chainID string, proposal *types.Propos
 }
 
 func (sc *RetrySignerClient) SignBytes(signBytes []byte) ([]byte, error) {
-	var err error
+	var (
+		sig []byte
+		err error
+	)
 	for i := 0; i < sc.retries || sc.retries == 0; i++ {
-		_, err = sc.next.SignBytes(signBytes)
+		sig, err = sc.next.SignBytes(signBytes)
 		if err == nil {
-			return nil, err
+			return sig, nil
 		}
 		// If remote signer errors, we don't retry.
 		if _, ok := err.(*RemoteSignerError); ok {
@@ -0,0 +1,155 @@
+package privval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/okex/exchain/libs/tendermint/crypto"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+// Shared sign state errors.
+var (
+	ErrSignStateRegression = errors.New("the height/round/step regresses from the shared sign state")
+	ErrSignStateTaken      = errors.New("the height/round/step was signed by another host of the key")
+)
+
+// SignStateStore is an external store of the last height/round/step signed with a validator key, shared by all the
+// hosts of the key, e.g. during a key migration or in a HA setup.
+type SignStateStore interface {
+	// Reserve records that holder is about to sign the height/round/step. It returns ErrSignStateRegression if a
+	// later height/round/step was recorded, and ErrSignStateTaken if the same one was recorded by another holder.
+	Reserve(height int64, round int, step int8, holder string) error
+}
+
+//-------------------------------------------------------------------------------
+
+// reserveScript checks and records the sign state atomically, it returns 1 if reserved, 0 on a regression and -1 if
+// the same height/round/step was reserved by another holder
+var reserveScript = redis.NewScript(`
+	local cur = redis.call("hmget", KEYS[1], "height", "round", "step", "holder")
+	local h, r, s = tonumber(ARGV[1]), tonumber(ARGV[2]), tonumber(ARGV[3])
+	if cur[1] then
+		local ch, cr, cs = tonumber(cur[1]), tonumber(cur[2]), tonumber(cur[3])
+		if ch > h or (ch == h and (cr > r or (cr == r and cs > s))) then
+			return 0
+		end
+		if ch == h and cr == r and cs == s and cur[4] ~= ARGV[4] then
+			return -1
+		end
+	end
+	redis.call("hset", KEYS[1], "height", ARGV[1], "round", ARGV[2], "step", ARGV[3], "holder", ARGV[4])
+	return 1
+`)
+
+// RedisSignStateStore implements SignStateStore with a redis hash, updated atomically by a script.
+type RedisSignStateStore struct {
+	client *redis.Client
+	key    string
+}
+
+var _ SignStateStore = (*RedisSignStateStore)(nil)
+
+// NewRedisSignStateStore returns a RedisSignStateStore connected to the redis url, e.g.
+// redis://:password@localhost:6379/0, keeping the sign state of the validator address.
+func NewRedisSignStateStore(url string, address crypto.Address) (*RedisSignStateStore, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis url: %w", err)
+	}
+	return &RedisSignStateStore{
+		client: redis.NewClient(opts),
+		key:    fmt.Sprintf("privval:sign_state:%X", address),
+	}, nil
+}
+
+// Reserve implements SignStateStore.
+func (rs *RedisSignStateStore) Reserve(height int64, round int, step int8, holder string) error {
+	code, err := reserveScript.Run(context.Background(), rs.client, []string{rs.key}, height, round, step, holder).Int()
+	if err != nil {
+		return err
+	}
+	switch code {
+	case 0:
+		return ErrSignStateRegression
+	case -1:
+		return ErrSignStateTaken
+	}
+	return nil
+}
+
+// Close closes the connection to redis.
+func (rs *RedisSignStateStore) Close() error {
+	return rs.client.Close()
+}
+
+//-------------------------------------------------------------------------------
+
+// SharedSignStatePV wraps a PrivValidator so that it only signs the heights/rounds/steps it reserved in the store
+// shared with the other hosts of the key, which enforces the monotonic signing across the hosts.
+type SharedSignStatePV struct {
+	next   types.PrivValidator
+	store  SignStateStore
+	holder string
+
+	mtx sync.Mutex
+}
+
+var _ types.PrivValidator = (*SharedSignStatePV)(nil)
+
+// NewSharedSignStatePV returns a SharedSignStatePV signing with next as holder.
+func NewSharedSignStatePV(next types.PrivValidator, store SignStateStore, holder string) *SharedSignStatePV {
+	return &SharedSignStatePV{
+		next:   next,
+		store:  store,
+		holder: holder,
+	}
+}
+
+// Next returns the wrapped PrivValidator.
+func (pv *SharedSignStatePV) Next() types.PrivValidator {
+	return pv.next
+}
+
+// GetPubKey implements PrivValidator.
+func (pv *SharedSignStatePV) GetPubKey() (crypto.PubKey, error) {
+	return pv.next.GetPubKey()
+}
+
+// SignVote implements PrivValidator.
+func (pv *SharedSignStatePV) SignVote(chainID string, vote *types.Vote) error {
+	return pv.sign(vote.Height, vote.Round, voteToStep(vote), func() error { return pv.next.SignVote(chainID, vote) })
+}
+
+// SignProposal implements PrivValidator.
+func (pv *SharedSignStatePV) SignProposal(chainID string, proposal *types.Proposal) error {
+	return pv.sign(proposal.Height, proposal.Round, stepPropose, func() error {
+		return pv.next.SignProposal(chainID, proposal)
+	})
+}
+
+// SignBytes implements PrivValidator. The arbitrary bytes aren't bound to a height/round/step, so they aren't
+// recorded in the shared sign state.
+func (pv *SharedSignStatePV) SignBytes(bz []byte) ([]byte, error) {
+	return pv.next.SignBytes(bz)
+}
+
+// sign reserves the height/round/step before signing, so that it's never signed by the others even if the host
+// crashes right after
+func (pv *SharedSignStatePV) sign(height int64, round int, step int8, signFn func() error) error {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	if err := pv.store.Reserve(height, round, step, pv.holder); err != nil {
+		return fmt.Errorf("can't sign height %d round %d step %d: %w", height, round, step, err)
+	}
+	return signFn()
+}
+
+func (pv *SharedSignStatePV) String() string {
+	return fmt.Sprintf("SharedSignStatePV{%v holder:%v}", pv.next, pv.holder)
+}
@@ -0,0 +1,78 @@
+package privval
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+func TestSharedSignStatePV(t *testing.T) {
+	s := miniredis.RunT(t)
+
+	key := types.NewMockPV()
+	pubKey, err := key.GetPubKey()
+	require.NoError(t, err)
+	newSharedPV := func(holder string) *SharedSignStatePV {
+		store, err := NewRedisSignStateStore("redis://"+s.Addr(), pubKey.Address())
+		require.NoError(t, err)
+		t.Cleanup(func() { store.Close() })
+		return NewSharedSignStatePV(key, store, holder)
+	}
+	old, migrated := newSharedPV("old-host"), newSharedPV("new-host")
+
+	blockID := types.BlockID{}
+	newTestVote := func(height int64, round int, typ types.SignedMsgType) *types.Vote {
+		return newVote(nil, 0, height, round, byte(typ), blockID)
+	}
+
+	// the old host signs, and may sign the same height/round/step again
+	require.NoError(t, old.SignProposal("mychainid", newProposal(1, 0, blockID)))
+	require.NoError(t, old.SignVote("mychainid", newTestVote(1, 0, types.PrevoteType)))
+	require.NoError(t, old.SignVote("mychainid", newTestVote(1, 0, types.PrevoteType)))
+
+	// the key migrated to the new host, which never signs what the old one may have signed
+	err = migrated.SignVote("mychainid", newTestVote(1, 0, types.PrevoteType))
+	assert.True(t, errors.Is(err, ErrSignStateTaken))
+	err = migrated.SignProposal("mychainid", newProposal(1, 0, blockID))
+	assert.True(t, errors.Is(err, ErrSignStateRegression))
+	require.NoError(t, migrated.SignVote("mychainid", newTestVote(1, 0, types.PrecommitType)))
+	require.NoError(t, migrated.SignVote("mychainid", newTestVote(1, 1, types.PrevoteType)))
+	require.NoError(t, migrated.SignVote("mychainid", newTestVote(2, 0, types.PrevoteType)))
+
+	// and the old host can't sign anymore
+	err = old.SignVote("mychainid", newTestVote(1, 0, types.PrecommitType))
+	assert.True(t, errors.Is(err, ErrSignStateRegression))
+	err = old.SignVote("mychainid", newTestVote(2, 0, types.PrevoteType))
+	assert.True(t, errors.Is(err, ErrSignStateTaken))
+
+	// the sign state is kept per validator key
+	otherKey := types.NewMockPV()
+	otherPubKey, err := otherKey.GetPubKey()
+	require.NoError(t, err)
+	store, err := NewRedisSignStateStore("redis://"+s.Addr(), otherPubKey.Address())
+	require.NoError(t, err)
+	defer store.Close()
+	require.NoError(t, NewSharedSignStatePV(otherKey, store, "old-host").SignVote("mychainid", newTestVote(1, 0, types.PrevoteType)))
+}
+
+func TestRedisSignStateStoreUnavailable(t *testing.T) {
+	s := miniredis.RunT(t)
+	key := types.NewMockPV()
+	pubKey, err := key.GetPubKey()
+	require.NoError(t, err)
+	store, err := NewRedisSignStateStore("redis://"+s.Addr(), pubKey.Address())
+	require.NoError(t, err)
+	defer store.Close()
+
+	// nothing is signed without the shared sign state
+	s.Close()
+	assert.Error(t, NewSharedSignStatePV(key, store, "host").SignVote("mychainid", newVote(nil, 0, 1, 0, byte(types.PrevoteType), types.BlockID{})))
+
+	_, err = NewRedisSignStateStore("localhost:6379", pubKey.Address())
+	assert.Error(t, err)
+}
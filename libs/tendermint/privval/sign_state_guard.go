@@ -0,0 +1,146 @@
+package privval
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"github.com/okex/exchain/libs/tendermint/crypto"
+	tmos "github.com/okex/exchain/libs/tendermint/libs/os"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+// SignStateGuard wraps a remote signer with the last sign state kept on the validator host, so that the validator
+// never double signs even if the remote signer lost its state or a different signer is switched to. The state is
+// kept in the same file as the one of FilePV, so it's shared when switching between a local key and a remote signer.
+type SignStateGuard struct {
+	next   types.PrivValidator
+	pubKey crypto.PubKey
+
+	mtx           sync.Mutex
+	LastSignState FilePVLastSignState
+}
+
+var _ types.PrivValidator = (*SignStateGuard)(nil)
+
+// NewSignStateGuard returns a SignStateGuard for next, loading the last sign state from stateFilePath if it exists.
+func NewSignStateGuard(next types.PrivValidator, stateFilePath string) (*SignStateGuard, error) {
+	pubKey, err := next.GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("can't get pubkey: %w", err)
+	}
+
+	state := FilePVLastSignState{}
+	if tmos.FileExists(stateFilePath) {
+		bz, err := ioutil.ReadFile(stateFilePath)
+		if err != nil {
+			return nil, err
+		}
+		if err := cdc.UnmarshalJSON(bz, &state); err != nil {
+			return nil, fmt.Errorf("error reading PrivValidator state from %v: %w", stateFilePath, err)
+		}
+	}
+	state.filePath = stateFilePath
+
+	return &SignStateGuard{next: next, pubKey: pubKey, LastSignState: state}, nil
+}
+
+// Next returns the guarded PrivValidator.
+func (sg *SignStateGuard) Next() types.PrivValidator {
+	return sg.next
+}
+
+// GetPubKey implements PrivValidator.
+func (sg *SignStateGuard) GetPubKey() (crypto.PubKey, error) {
+	return sg.pubKey, nil
+}
+
+// SignVote implements PrivValidator.
+func (sg *SignStateGuard) SignVote(chainID string, vote *types.Vote) error {
+	sg.mtx.Lock()
+	defer sg.mtx.Unlock()
+
+	height, round, step := vote.Height, vote.Round, voteToStep(vote)
+	lss := sg.LastSignState
+	sameHRS, err := lss.CheckHRS(height, round, step, vote.HasVC)
+	if err != nil {
+		return err
+	}
+
+	signBytes := vote.SignBytes(chainID)
+	if sameHRS {
+		if bytes.Equal(signBytes, lss.SignBytes) {
+			vote.Signature = lss.Signature
+		} else if timestamp, ok := checkVotesOnlyDifferByTimestamp(lss.Height, lss.SignBytes, signBytes); ok {
+			vote.Timestamp = timestamp
+			vote.Signature = lss.Signature
+		} else {
+			err = fmt.Errorf("conflicting data")
+		}
+		return err
+	}
+
+	if err := sg.next.SignVote(chainID, vote); err != nil {
+		return err
+	}
+	// the remote signer may have changed the timestamp of the vote
+	return sg.saveSigned(height, round, step, vote.SignBytes(chainID), vote.Signature)
+}
+
+// SignProposal implements PrivValidator.
+func (sg *SignStateGuard) SignProposal(chainID string, proposal *types.Proposal) error {
+	sg.mtx.Lock()
+	defer sg.mtx.Unlock()
+
+	height, round, step := proposal.Height, proposal.Round, stepPropose
+	lss := sg.LastSignState
+	sameHRS, err := lss.CheckHRS(height, round, step, proposal.HasVC)
+	if err != nil {
+		return err
+	}
+
+	signBytes := proposal.SignBytes(chainID)
+	if sameHRS {
+		if bytes.Equal(signBytes, lss.SignBytes) {
+			proposal.Signature = lss.Signature
+		} else if timestamp, ok := checkProposalsOnlyDifferByTimestamp(lss.SignBytes, signBytes); ok {
+			proposal.Timestamp = timestamp
+			proposal.Signature = lss.Signature
+		} else {
+			err = fmt.Errorf("conflicting data")
+		}
+		return err
+	}
+
+	if err := sg.next.SignProposal(chainID, proposal); err != nil {
+		return err
+	}
+	return sg.saveSigned(height, round, step, proposal.SignBytes(chainID), proposal.Signature)
+}
+
+// SignBytes implements PrivValidator.
+func (sg *SignStateGuard) SignBytes(bz []byte) ([]byte, error) {
+	return sg.next.SignBytes(bz)
+}
+
+// saveSigned verifies the signature returned by the remote signer, and persists it with the height/round/step
+func (sg *SignStateGuard) saveSigned(height int64, round int, step int8, signBytes []byte, sig []byte) error {
+	if !sg.pubKey.VerifyBytes(signBytes, sig) {
+		return errors.New("invalid signature returned by the remote signer")
+	}
+
+	sg.LastSignState.Height = height
+	sg.LastSignState.Round = round
+	sg.LastSignState.Step = step
+	sg.LastSignState.Signature = sig
+	sg.LastSignState.SignBytes = signBytes
+	sg.LastSignState.Save()
+	return nil
+}
+
+func (sg *SignStateGuard) String() string {
+	return fmt.Sprintf("SignStateGuard{%v LH:%v, LR:%v, LS:%v}", sg.next, sg.LastSignState.Height,
+		sg.LastSignState.Round, sg.LastSignState.Step)
+}
@@ -0,0 +1,48 @@
+package privval
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+func TestSignStateGuard(t *testing.T) {
+	tempStateFile, err := ioutil.TempFile("", "priv_validator_state_")
+	require.NoError(t, err)
+	os.Remove(tempStateFile.Name())
+	defer os.Remove(tempStateFile.Name())
+
+	chainID := "test-chain"
+	mockPV := types.NewMockPV()
+	guard, err := NewSignStateGuard(mockPV, tempStateFile.Name())
+	require.NoError(t, err)
+
+	vote := newVote(nil, 0, 2, 0, byte(types.PrevoteType), types.BlockID{})
+	require.NoError(t, guard.SignVote(chainID, vote))
+	assert.Equal(t, int64(2), guard.LastSignState.Height)
+
+	// the state is shared with the guards of the same state file
+	guard, err = NewSignStateGuard(mockPV, tempStateFile.Name())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), guard.LastSignState.Height)
+
+	// the signature of the same vote is reused
+	sameVote := newVote(nil, 0, 2, 0, byte(types.PrevoteType), types.BlockID{})
+	sameVote.Timestamp = vote.Timestamp
+	require.NoError(t, guard.SignVote(chainID, sameVote))
+	assert.Equal(t, vote.Signature, sameVote.Signature)
+
+	// the regressions are refused even if the remote signer would sign them
+	assert.Error(t, guard.SignVote(chainID, newVote(nil, 0, 1, 0, byte(types.PrevoteType), types.BlockID{})))
+	assert.Error(t, guard.SignProposal(chainID, newProposal(1, 0, types.BlockID{})))
+
+	// the signatures of the other keys are refused
+	guard.pubKey = types.NewMockPV().PrivKey.PubKey()
+	assert.Error(t, guard.SignVote(chainID, newVote(nil, 0, 3, 0, byte(types.PrevoteType), types.BlockID{})))
+	assert.Equal(t, int64(2), guard.LastSignState.Height)
+}
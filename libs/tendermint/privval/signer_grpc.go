@@ -0,0 +1,183 @@
+package privval
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+
+	"github.com/okex/exchain/libs/tendermint/crypto"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+// SignerGRPCServiceName is the name of the gRPC service of the remote signer.
+const SignerGRPCServiceName = "tendermint.privval.RemoteSigner"
+
+// aminoGRPCCodec encodes the signer messages of the gRPC service with amino, the same encoding as the raw socket
+// signer, so that the messages needn't be defined twice.
+type aminoGRPCCodec struct{}
+
+func (aminoGRPCCodec) Marshal(v interface{}) ([]byte, error) {
+	return cdc.MarshalBinaryBare(v)
+}
+
+func (aminoGRPCCodec) Unmarshal(data []byte, v interface{}) error {
+	return cdc.UnmarshalBinaryBare(data, v)
+}
+
+func (aminoGRPCCodec) Name() string {
+	return "amino"
+}
+
+//-------------------------------------------------------------------------------
+
+// SignerGRPCServer serves the requests of the validators signing with privVal over gRPC, which lets the key live
+// on a separate signing host.
+type SignerGRPCServer struct {
+	chainID string
+	privVal types.PrivValidator
+	server  *grpc.Server
+}
+
+// NewSignerGRPCServer returns a SignerGRPCServer signing the messages of chainID with privVal.
+func NewSignerGRPCServer(chainID string, privVal types.PrivValidator) *SignerGRPCServer {
+	ss := &SignerGRPCServer{
+		chainID: chainID,
+		privVal: privVal,
+		server:  grpc.NewServer(grpc.ForceServerCodec(aminoGRPCCodec{})),
+	}
+	ss.server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: SignerGRPCServiceName,
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{
+			{MethodName: "GetPubKey", Handler: ss.handler(func() SignerMessage { return &PubKeyRequest{} })},
+			{MethodName: "SignVote", Handler: ss.handler(func() SignerMessage { return &SignVoteRequest{} })},
+			{MethodName: "SignProposal", Handler: ss.handler(func() SignerMessage { return &SignProposalRequest{} })},
+			{MethodName: "Ping", Handler: ss.handler(func() SignerMessage { return &PingRequest{} })},
+		},
+	}, ss)
+	return ss
+}
+
+// Serve serves the requests accepted on the listener, it returns when the server is stopped.
+func (ss *SignerGRPCServer) Serve(listener net.Listener) error {
+	return ss.server.Serve(listener)
+}
+
+// Stop stops the server.
+func (ss *SignerGRPCServer) Stop() {
+	ss.server.GracefulStop()
+}
+
+func (ss *SignerGRPCServer) handler(newRequest func() SignerMessage) func(interface{}, context.Context,
+	func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	return func(_ interface{}, _ context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+		req := newRequest()
+		if err := dec(req); err != nil {
+			return nil, err
+		}
+		res, err := DefaultValidationRequestHandler(ss.privVal, req, ss.chainID)
+		if res != nil {
+			// the error is replied in res, the same as SignerServer
+			return res, nil
+		}
+		return nil, err
+	}
+}
+
+//-------------------------------------------------------------------------------
+
+// SignerGRPCClient implements PrivValidator by requesting a remote signer over gRPC. The connection is
+// re-established with backoff if lost, and each request waits for the connection until it times out.
+type SignerGRPCClient struct {
+	conn    *grpc.ClientConn
+	timeout time.Duration
+}
+
+var _ types.PrivValidator = (*SignerGRPCClient)(nil)
+
+// NewSignerGRPCClient returns a SignerGRPCClient connecting to the remote signer at address.
+func NewSignerGRPCClient(address string, timeout time.Duration) (*SignerGRPCClient, error) {
+	conn, err := grpc.Dial(address,
+		grpc.WithInsecure(),
+		grpc.WithConnectParams(grpc.ConnectParams{Backoff: backoff.DefaultConfig, MinConnectTimeout: timeout}),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(aminoGRPCCodec{}), grpc.WaitForReady(true)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &SignerGRPCClient{conn: conn, timeout: timeout}, nil
+}
+
+// Close closes the connection.
+func (sc *SignerGRPCClient) Close() error {
+	return sc.conn.Close()
+}
+
+// Ping checks the remote signer is reachable.
+func (sc *SignerGRPCClient) Ping() error {
+	return sc.invoke("Ping", &PingRequest{}, &PingResponse{})
+}
+
+// GetPubKey implements PrivValidator.
+func (sc *SignerGRPCClient) GetPubKey() (crypto.PubKey, error) {
+	resp := &PubKeyResponse{}
+	if err := sc.invoke("GetPubKey", &PubKeyRequest{}, resp); err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+	return resp.PubKey, nil
+}
+
+// SignVote implements PrivValidator.
+func (sc *SignerGRPCClient) SignVote(chainID string, vote *types.Vote) error {
+	resp := &SignedVoteResponse{}
+	if err := sc.invoke("SignVote", &SignVoteRequest{Vote: vote}, resp); err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return resp.Error
+	}
+	if resp.Vote == nil {
+		return ErrUnexpectedResponse
+	}
+	*vote = *resp.Vote
+	return nil
+}
+
+// SignProposal implements PrivValidator.
+func (sc *SignerGRPCClient) SignProposal(chainID string, proposal *types.Proposal) error {
+	resp := &SignedProposalResponse{}
+	if err := sc.invoke("SignProposal", &SignProposalRequest{Proposal: proposal}, resp); err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return resp.Error
+	}
+	if resp.Proposal == nil {
+		return ErrUnexpectedResponse
+	}
+	*proposal = *resp.Proposal
+	return nil
+}
+
+// SignBytes implements PrivValidator. Signing arbitrary bytes isn't supported by the remote signer.
+func (sc *SignerGRPCClient) SignBytes(_ []byte) ([]byte, error) {
+	return nil, &RemoteSignerError{Description: "signing bytes is not supported by the remote signer"}
+}
+
+func (sc *SignerGRPCClient) invoke(method string, req, resp interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sc.timeout)
+	defer cancel()
+
+	err := sc.conn.Invoke(ctx, fmt.Sprintf("/%s/%s", SignerGRPCServiceName, method), req, resp)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		return ErrReadTimeout
+	}
+	return err
+}
@@ -0,0 +1,57 @@
+package privval
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+func TestSignerGRPCClient(t *testing.T) {
+	chainID := "test-chain"
+	mockPV := types.NewMockPV()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := NewSignerGRPCServer(chainID, mockPV)
+	go server.Serve(listener) // nolint: errcheck
+	defer server.Stop()
+
+	client, err := NewSignerGRPCClient(listener.Addr().String(), 3*time.Second)
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.Ping())
+
+	pubKey, err := client.GetPubKey()
+	require.NoError(t, err)
+	expectedPubKey, _ := mockPV.GetPubKey()
+	assert.Equal(t, expectedPubKey, pubKey)
+
+	vote := newVote(pubKey.Address(), 0, 1, 0, byte(types.PrevoteType), types.BlockID{})
+	require.NoError(t, client.SignVote(chainID, vote))
+	assert.True(t, pubKey.VerifyBytes(vote.SignBytes(chainID), vote.Signature))
+
+	proposal := newProposal(1, 0, types.BlockID{})
+	require.NoError(t, client.SignProposal(chainID, proposal))
+	assert.True(t, pubKey.VerifyBytes(proposal.SignBytes(chainID), proposal.Signature))
+
+	// the remote signer errors are returned
+	erroringServer := NewSignerGRPCServer(chainID, types.NewErroringMockPV())
+	erroringListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go erroringServer.Serve(erroringListener) // nolint: errcheck
+	defer erroringServer.Stop()
+
+	erroringClient, err := NewSignerGRPCClient(erroringListener.Addr().String(), 3*time.Second)
+	require.NoError(t, err)
+	defer erroringClient.Close()
+	err = erroringClient.SignVote(chainID, vote)
+	require.Error(t, err)
+	_, ok := err.(*RemoteSignerError)
+	assert.True(t, ok)
+}
@@ -0,0 +1,237 @@
+package privval
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/okex/exchain/libs/tendermint/crypto"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/libs/tendermint/libs/tempfile"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+// Standby errors.
+var (
+	ErrLeaseNotHeld = errors.New("the signing lease is held by another node")
+	ErrLeaseLocked  = errors.New("the signing lease is locked by another node")
+)
+
+// Lease is shared by the validator nodes of the same key, and only the holder of it signs. It also records the last
+// height signed by its holders, so that a new holder never signs a height the previous one may have signed.
+type Lease interface {
+	// Acquire acquires or renews the lease, and returns the last signed height recorded and who signed it. It returns
+	// ErrLeaseNotHeld if the lease is held by another node and hasn't expired.
+	Acquire() (lastSignedHeight int64, lastSigner string, err error)
+	// RecordSigned records the height signed by the holder.
+	RecordSigned(height int64) error
+}
+
+//-------------------------------------------------------------------------------
+
+// FileLeaseState is the state of a FileLease persisted to disk.
+type FileLeaseState struct {
+	Holder           string    `json:"holder"`
+	Expires          time.Time `json:"expires"`
+	LastSignedHeight int64     `json:"last_signed_height"`
+	LastSigner       string    `json:"last_signer"`
+}
+
+// FileLease implements Lease using a file on a file system shared by the validator nodes. The updates of the file
+// are serialized by a lock file next to it, created exclusively.
+type FileLease struct {
+	filePath string
+	holder   string
+	ttl      time.Duration
+}
+
+var _ Lease = (*FileLease)(nil)
+
+// NewFileLease returns a FileLease held as holder, which expires ttl after its last renewal.
+func NewFileLease(filePath, holder string, ttl time.Duration) *FileLease {
+	return &FileLease{filePath: filePath, holder: holder, ttl: ttl}
+}
+
+// Acquire implements Lease.
+func (fl *FileLease) Acquire() (int64, string, error) {
+	var lastSignedHeight int64
+	var lastSigner string
+	err := fl.update(func(state *FileLeaseState, now time.Time) error {
+		if state.Holder != fl.holder && now.Before(state.Expires) {
+			return ErrLeaseNotHeld
+		}
+		state.Holder = fl.holder
+		state.Expires = now.Add(fl.ttl)
+		lastSignedHeight, lastSigner = state.LastSignedHeight, state.LastSigner
+		return nil
+	})
+	return lastSignedHeight, lastSigner, err
+}
+
+// RecordSigned implements Lease.
+func (fl *FileLease) RecordSigned(height int64) error {
+	return fl.update(func(state *FileLeaseState, now time.Time) error {
+		if state.Holder != fl.holder {
+			return ErrLeaseNotHeld
+		}
+		if height > state.LastSignedHeight {
+			state.LastSignedHeight = height
+		}
+		state.LastSigner = fl.holder
+		return nil
+	})
+}
+
+// update applies fn to the state of the lease under the lock file, and saves the state if fn succeeds
+func (fl *FileLease) update(fn func(state *FileLeaseState, now time.Time) error) error {
+	unlock, err := fl.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	state := FileLeaseState{}
+	bz, err := ioutil.ReadFile(fl.filePath)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return err
+	default:
+		if err := cdc.UnmarshalJSON(bz, &state); err != nil {
+			return fmt.Errorf("error reading the lease from %v: %w", fl.filePath, err)
+		}
+	}
+
+	if err := fn(&state, time.Now()); err != nil {
+		return err
+	}
+
+	bz, err = cdc.MarshalJSONIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(fl.filePath, bz, 0600)
+}
+
+// lock creates the lock file exclusively, a lock file older than the ttl is left by a crashed node and removed
+func (fl *FileLease) lock() (func(), error) {
+	lockPath := fl.filePath + ".lock"
+	f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if os.IsExist(err) {
+		if info, statErr := os.Stat(lockPath); statErr == nil && time.Since(info.ModTime()) > fl.ttl {
+			os.Remove(lockPath)
+			f, err = os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		}
+	}
+	if os.IsExist(err) {
+		return nil, ErrLeaseLocked
+	}
+	if err != nil {
+		return nil, err
+	}
+	f.Close()
+	return func() { os.Remove(lockPath) }, nil
+}
+
+//-------------------------------------------------------------------------------
+
+// StandbyPV wraps a PrivValidator so that it only signs while holding the lease, which lets a hot standby node
+// follow the consensus with the same key and take over the signing once the lease of the active node expires.
+// The heights signed by the previous holder are never signed again, which prevents double signing on failover.
+type StandbyPV struct {
+	next   types.PrivValidator
+	lease  Lease
+	holder string
+	logger log.Logger
+
+	mtx    sync.Mutex
+	active bool
+}
+
+var _ types.PrivValidator = (*StandbyPV)(nil)
+
+// NewStandbyPV returns a StandbyPV signing with next while holder holds the lease.
+func NewStandbyPV(next types.PrivValidator, lease Lease, holder string, logger log.Logger) *StandbyPV {
+	return &StandbyPV{
+		next:   next,
+		lease:  lease,
+		holder: holder,
+		logger: logger,
+	}
+}
+
+// IsActive returns whether the node held the lease when it signed last time.
+func (pv *StandbyPV) IsActive() bool {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+	return pv.active
+}
+
+// GetPubKey implements PrivValidator.
+func (pv *StandbyPV) GetPubKey() (crypto.PubKey, error) {
+	return pv.next.GetPubKey()
+}
+
+// SignVote implements PrivValidator.
+func (pv *StandbyPV) SignVote(chainID string, vote *types.Vote) error {
+	return pv.sign(vote.Height, func() error { return pv.next.SignVote(chainID, vote) })
+}
+
+// SignProposal implements PrivValidator.
+func (pv *StandbyPV) SignProposal(chainID string, proposal *types.Proposal) error {
+	return pv.sign(proposal.Height, func() error { return pv.next.SignProposal(chainID, proposal) })
+}
+
+// SignBytes implements PrivValidator. The arbitrary bytes aren't bound to a height, so they are signed only while
+// holding the lease.
+func (pv *StandbyPV) SignBytes(bz []byte) ([]byte, error) {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	if _, _, err := pv.lease.Acquire(); err != nil {
+		pv.deactivate(err)
+		return nil, err
+	}
+	return pv.next.SignBytes(bz)
+}
+
+func (pv *StandbyPV) sign(height int64, signFn func() error) error {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	lastSignedHeight, lastSigner, err := pv.lease.Acquire()
+	if err != nil {
+		pv.deactivate(err)
+		return err
+	}
+	if lastSigner != pv.holder && height <= lastSignedHeight {
+		pv.deactivate(nil)
+		return fmt.Errorf("height %d may have been signed by the previous holder %s of the lease, which signed height %d",
+			height, lastSigner, lastSignedHeight)
+	}
+	if !pv.active {
+		pv.active = true
+		pv.logger.Info("Acquired the signing lease, start signing", "height", height, "lastSignedHeight", lastSignedHeight)
+	}
+
+	// record the height before signing, so that it's never signed by the others even if the node crashes right after
+	if err := pv.lease.RecordSigned(height); err != nil {
+		pv.deactivate(err)
+		return err
+	}
+	return signFn()
+}
+
+func (pv *StandbyPV) deactivate(err error) {
+	if pv.active {
+		pv.active = false
+		pv.logger.Error("Lost the signing lease, stop signing", "err", err)
+	}
+}
+
+func (pv *StandbyPV) String() string {
+	return fmt.Sprintf("StandbyPV{%v holder:%v}", pv.next, pv.holder)
+}
@@ -0,0 +1,51 @@
+package privval
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+func TestStandbyPVFailover(t *testing.T) {
+	dir, err := ioutil.TempDir("", "standby_pv")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	leaseFile := filepath.Join(dir, "lease.json")
+	ttl := 100 * time.Millisecond
+	newStandbyPV := func(holder string) *StandbyPV {
+		return NewStandbyPV(types.NewMockPV(), NewFileLease(leaseFile, holder, ttl), holder, log.TestingLogger())
+	}
+	active, standby := newStandbyPV("active"), newStandbyPV("standby")
+
+	blockID := types.BlockID{}
+	newTestVote := func(height int64) *types.Vote {
+		return newVote(nil, 0, height, 0, byte(types.PrevoteType), blockID)
+	}
+
+	// the active node signs while the standby one follows
+	require.NoError(t, active.SignVote("mychainid", newTestVote(1)))
+	require.NoError(t, active.SignProposal("mychainid", newProposal(2, 0, blockID)))
+	assert.Equal(t, ErrLeaseNotHeld, standby.SignVote("mychainid", newTestVote(2)))
+	assert.True(t, active.IsActive())
+	assert.False(t, standby.IsActive())
+
+	// the standby node takes over once the lease expires, but never signs the heights signed by the active one
+	time.Sleep(2 * ttl)
+	assert.Error(t, standby.SignVote("mychainid", newTestVote(2)))
+	require.NoError(t, standby.SignVote("mychainid", newTestVote(3)))
+	require.NoError(t, standby.SignVote("mychainid", newTestVote(3)))
+	assert.True(t, standby.IsActive())
+
+	// the previous active node stops signing
+	assert.Equal(t, ErrLeaseNotHeld, active.SignVote("mychainid", newTestVote(4)))
+	assert.False(t, active.IsActive())
+}
@@ -264,6 +264,12 @@ func (c *baseRPCClient) BroadcastTxSync(tx types.Tx) (*ctypes.ResultBroadcastTx,
 	return c.broadcastTX("broadcast_tx_sync", tx)
 }
 
+// BroadcastPrivateTxSync submits a tx which isn't gossiped to the peers of the
+// node, the unsafe routes of the node must be enabled.
+func (c *baseRPCClient) BroadcastPrivateTxSync(tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return c.broadcastTX("broadcast_private_tx_sync", tx)
+}
+
 func (c *baseRPCClient) broadcastTX(route string, tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
 	result := new(ctypes.ResultBroadcastTx)
 	_, err := c.caller.Call(route, map[string]interface{}{"tx": tx}, result)
@@ -102,6 +102,11 @@ func (c *Local) BroadcastTxSync(tx types.Tx) (*ctypes.ResultBroadcastTx, error)
 	return core.BroadcastTxSync(c.ctx, tx)
 }
 
+// BroadcastPrivateTxSync submits a tx which isn't gossiped to the peers of the node.
+func (c *Local) BroadcastPrivateTxSync(tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return core.BroadcastPrivateTxSync(c.ctx, tx)
+}
+
 func (c *Local) UnconfirmedTxs(limit int) (*ctypes.ResultUnconfirmedTxs, error) {
 	return core.UnconfirmedTxs(c.ctx, limit)
 }
@@ -4,6 +4,7 @@ import (
 	"os"
 	"runtime/pprof"
 
+	tmflags "github.com/okex/exchain/libs/tendermint/libs/cli/flags"
 	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
 	rpctypes "github.com/okex/exchain/libs/tendermint/rpc/jsonrpc/types"
 )
@@ -14,6 +15,15 @@ func UnsafeFlushMempool(ctx *rpctypes.Context) (*ctypes.ResultUnsafeFlushMempool
 	return &ctypes.ResultUnsafeFlushMempool{}, nil
 }
 
+// UnsafeSetLogLevel changes the log levels of the node at runtime, e.g. "state:debug,*:info".
+func UnsafeSetLogLevel(ctx *rpctypes.Context, level string) (*ctypes.ResultLogLevel, error) {
+	if err := tmflags.SetDynamicLogLevel(level); err != nil {
+		return nil, err
+	}
+	env.Logger.Info("log level changed", "log_level", level)
+	return &ctypes.ResultLogLevel{LogLevel: tmflags.GetDynamicLogLevel()}, nil
+}
+
 var profFile *os.File
 
 // UnsafeStartCPUProfiler starts a pprof profiler using the given filename.
@@ -11,6 +11,7 @@ import (
 	cfg "github.com/okex/exchain/libs/tendermint/config"
 	"github.com/okex/exchain/libs/tendermint/consensus"
 	"github.com/okex/exchain/libs/tendermint/crypto"
+	"github.com/okex/exchain/libs/tendermint/halt"
 	"github.com/okex/exchain/libs/tendermint/libs/log"
 	mempl "github.com/okex/exchain/libs/tendermint/mempool"
 	"github.com/okex/exchain/libs/tendermint/p2p"
@@ -58,6 +59,10 @@ type transport interface {
 	NodeInfo() p2p.NodeInfo
 }
 
+type blockchain interface {
+	SyncProgress() (startHeight, highestHeight int64)
+}
+
 type peers interface {
 	AddPersistentPeers([]string) error
 	DialPeersAsync([]string) error
@@ -79,12 +84,16 @@ type Environment struct {
 	P2PPeers       peers
 	P2PTransport   transport
 
+	// nil if the fast sync of the blockchain reactor doesn't report its progress
+	BlockchainReactor blockchain
+
 	// objects
 	PubKey           crypto.PubKey
 	GenDoc           *types.GenesisDoc // cache the genesis structure
 	TxIndexer        txindex.TxIndexer
 	BlockIndexer     blockindex.BlockIndexer
 	ConsensusReactor *consensus.Reactor
+	HaltReactor      *halt.Reactor
 	EventBus         *types.EventBus // thread safe
 	Mempool          mempl.Mempool
 
@@ -0,0 +1,26 @@
+package core
+
+import (
+	"sort"
+
+	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
+	rpctypes "github.com/okex/exchain/libs/tendermint/rpc/jsonrpc/types"
+)
+
+// HaltSchedule returns the halt schedule of the chain known to the node, and
+// the ones announced by its peers, sorted by node ID.
+func HaltSchedule(ctx *rpctypes.Context) (*ctypes.ResultHaltSchedule, error) {
+	peerSchedules := env.HaltReactor.PeerSchedules()
+	peers := make([]ctypes.PeerHaltSchedule, 0, len(peerSchedules))
+	for id, schedule := range peerSchedules {
+		peers = append(peers, ctypes.PeerHaltSchedule{NodeID: id, Schedule: schedule})
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].NodeID < peers[j].NodeID
+	})
+
+	return &ctypes.ResultHaltSchedule{
+		Schedule: env.HaltReactor.Schedule(),
+		Peers:    peers,
+	}, nil
+}
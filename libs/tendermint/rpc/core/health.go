@@ -1,6 +1,12 @@
 package core
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	cfg "github.com/okex/exchain/libs/tendermint/config"
 	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
 	rpctypes "github.com/okex/exchain/libs/tendermint/rpc/jsonrpc/types"
 )
@@ -11,3 +17,78 @@ import (
 func Health(ctx *rpctypes.Context) (*ctypes.ResultHealth, error) {
 	return &ctypes.ResultHealth{}, nil
 }
+
+// key of the state in the state db, which is read to check the db
+var dbHealthCheckKey = []byte("stateKey")
+
+// HealthCheckHandler serves /health and /ready for the load balancers, and passes the other requests to next.
+// /health responds 503 if the node can't read its databases, and /ready also responds 503 if the node is catching up,
+// its latest block is too old or it has too few peers.
+func HealthCheckHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/health":
+			writeNodeHealth(w, checkNodeHealth(false, time.Now()))
+		case "/ready":
+			writeNodeHealth(w, checkNodeHealth(true, time.Now()))
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+func checkNodeHealth(ready bool, now time.Time) *ctypes.ResultNodeHealth {
+	res := &ctypes.ResultNodeHealth{
+		CatchingUp:        env.ConsensusReactor.FastSync(),
+		LatestBlockHeight: env.BlockStore.Height(),
+		Peers:             env.P2PPeers.Peers().Size(),
+		DBHealthy:         true,
+	}
+
+	if _, err := env.StateDB.Has(dbHealthCheckKey); err != nil {
+		res.DBHealthy = false
+		res.Errors = append(res.Errors, fmt.Sprintf("failed to read the state db: %s", err))
+	}
+	if res.LatestBlockHeight > 0 {
+		meta := env.BlockStore.LoadBlockMeta(res.LatestBlockHeight)
+		if meta == nil {
+			res.DBHealthy = false
+			res.Errors = append(res.Errors, fmt.Sprintf("failed to load the latest block %d", res.LatestBlockHeight))
+		} else {
+			res.LatestBlockTime = meta.Header.Time
+			res.LastBlockAge = now.Sub(meta.Header.Time).Seconds()
+		}
+	}
+	if ready {
+		checkReadiness(res, env.Config, now)
+	}
+	return res
+}
+
+// checkReadiness adds the reasons why the node isn't ready to serve the requests to the errors of res
+func checkReadiness(res *ctypes.ResultNodeHealth, conf cfg.RPCConfig, now time.Time) {
+	if res.CatchingUp {
+		res.Errors = append(res.Errors, "catching up")
+	}
+	if maxAge := conf.ReadyMaxBlockAge; maxAge > 0 && !res.LatestBlockTime.IsZero() &&
+		now.Sub(res.LatestBlockTime) > maxAge {
+		res.Errors = append(res.Errors, fmt.Sprintf("the latest block is older than %s", maxAge))
+	}
+	if res.Peers < conf.ReadyMinPeers {
+		res.Errors = append(res.Errors, fmt.Sprintf("less than %d peers", conf.ReadyMinPeers))
+	}
+}
+
+func writeNodeHealth(w http.ResponseWriter, res *ctypes.ResultNodeHealth) {
+	jsonBytes, err := json.MarshalIndent(res, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if len(res.Errors) == 0 {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	w.Write(jsonBytes) // nolint: errcheck
+}
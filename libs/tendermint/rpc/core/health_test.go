@@ -0,0 +1,34 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/okex/exchain/libs/tendermint/config"
+	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
+)
+
+func TestCheckReadiness(t *testing.T) {
+	now := time.Now()
+	conf := *cfg.DefaultRPCConfig()
+
+	testCases := []struct {
+		name    string
+		res     ctypes.ResultNodeHealth
+		wantErr bool
+	}{
+		{"ready", ctypes.ResultNodeHealth{LatestBlockTime: now.Add(-time.Second), Peers: 1}, false},
+		{"catching up", ctypes.ResultNodeHealth{CatchingUp: true, LatestBlockTime: now, Peers: 1}, true},
+		{"old block", ctypes.ResultNodeHealth{LatestBlockTime: now.Add(-time.Hour), Peers: 1}, true},
+		{"no peers", ctypes.ResultNodeHealth{LatestBlockTime: now}, true},
+		{"no blocks", ctypes.ResultNodeHealth{Peers: 1}, false},
+	}
+
+	for _, tc := range testCases {
+		res := tc.res
+		checkReadiness(&res, conf, now)
+		require.Equal(t, tc.wantErr, len(res.Errors) > 0, tc.name)
+	}
+}
@@ -54,6 +54,27 @@ func BroadcastTxSync(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcas
 	}, nil
 }
 
+// BroadcastPrivateTxSync returns with the response from CheckTx, like
+// BroadcastTxSync, but the tx is held in the local mempool for the blocks
+// proposed by the node instead of being gossiped to its peers.
+func BroadcastPrivateTxSync(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+	resCh := make(chan *abci.Response, 1)
+	err := env.Mempool.CheckTx(tx, func(res *abci.Response) {
+		resCh <- res
+	}, mempl.TxInfo{Private: true})
+	if err != nil {
+		return nil, err
+	}
+	res := <-resCh
+	r := res.GetCheckTx()
+	return &ctypes.ResultBroadcastTx{
+		Code:      r.Code,
+		Log:       r.Log,
+		Codespace: r.Codespace,
+		Hash:      tx.Hash(env.BlockStore.Height()),
+	}, nil
+}
+
 // BroadcastTxCommit returns with the responses from CheckTx and DeliverTx.
 // More: https://docs.tendermint.com/master/rpc/#/Tx/broadcast_tx_commit
 func BroadcastTxCommit(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
@@ -37,6 +37,7 @@ var Routes = map[string]*rpc.RPCFunc{
 	"user_num_unconfirmed_txs": rpc.NewRPCFunc(UserNumUnconfirmedTxs, "address"),
 	"get_address_list":         rpc.NewRPCFunc(GetAddressList, ""),
 	"block_search":             rpc.NewRPCFunc(BlockSearch, "query,page,per_page,order_by"),
+	"halt_schedule":            rpc.NewRPCFunc(HaltSchedule, ""),
 
 	// tx broadcast API
 	"broadcast_tx_commit": rpc.NewRPCFunc(BroadcastTxCommit, "tx"),
@@ -60,6 +61,10 @@ func AddUnsafeRoutes() {
 	Routes["dial_seeds"] = rpc.NewRPCFunc(UnsafeDialSeeds, "seeds")
 	Routes["dial_peers"] = rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent")
 	Routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(UnsafeFlushMempool, "")
+	Routes["unsafe_set_log_level"] = rpc.NewRPCFunc(UnsafeSetLogLevel, "level")
+
+	// private txs API, the txs aren't gossiped to the peers
+	Routes["broadcast_private_tx_sync"] = rpc.NewRPCFunc(BroadcastPrivateTxSync, "tx")
 
 	// profiler API
 	Routes["unsafe_start_cpu_profiler"] = rpc.NewRPCFunc(UnsafeStartCPUProfiler, "filename")
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	tmbytes "github.com/okex/exchain/libs/tendermint/libs/bytes"
+	tmmath "github.com/okex/exchain/libs/tendermint/libs/math"
 	"github.com/okex/exchain/libs/tendermint/p2p"
 	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
 	rpctypes "github.com/okex/exchain/libs/tendermint/rpc/jsonrpc/types"
@@ -72,6 +73,11 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 			VotingPower: votingPower,
 		},
 	}
+	if result.SyncInfo.CatchingUp && env.BlockchainReactor != nil {
+		startHeight, highestHeight := env.BlockchainReactor.SyncProgress()
+		result.SyncInfo.StartingBlockHeight = startHeight
+		result.SyncInfo.HighestBlockHeight = tmmath.MaxInt64(highestHeight, latestHeight)
+	}
 	// update Network to the ChainID in state
 	result.NodeInfo.Network = env.ConsensusState.GetState().ChainID
 
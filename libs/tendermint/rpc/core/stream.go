@@ -0,0 +1,127 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
+	sm "github.com/okex/exchain/libs/tendermint/state"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+// streamBlocksPollInterval is the interval the block stream checks the block store at, in case the results of the
+// latest block are saved after its event
+const streamBlocksPollInterval = time.Second
+
+// StreamBlocks sends the finalized blocks from fromHeight on with the results of their txs, until ctx is done or
+// send fails. If fromHeight is 0, it starts from the next block.
+//
+// Unlike the websocket subscriptions, the blocks are always loaded from the stores, and the new block events only
+// wake the stream up. So no block is skipped when the subscription is cancelled for a slow subscriber, the stream
+// just subscribes again and catches up from the last block sent.
+func StreamBlocks(ctx context.Context, subscriber string, fromHeight int64,
+	send func(*ctypes.ResultStreamedBlock) error) error {
+	if fromHeight < 0 {
+		return fmt.Errorf("height must not be negative, but got %d", fromHeight)
+	}
+	if fromHeight == 0 {
+		fromHeight = env.BlockStore.Height() + 1
+	}
+	if base := env.BlockStore.Base(); fromHeight < base {
+		return fmt.Errorf("height %v is not available, blocks pruned at height %v", fromHeight, base)
+	}
+
+	if env.EventBus.NumClients() >= env.Config.MaxSubscriptionClients {
+		return fmt.Errorf("max_subscription_clients %d reached", env.Config.MaxSubscriptionClients)
+	}
+	sub, err := subscribeNewBlockHeader(ctx, subscriber)
+	if err != nil {
+		return err
+	}
+	defer env.EventBus.Unsubscribe(context.Background(), subscriber, types.EventQueryNewBlockHeader)
+
+	ticker := time.NewTicker(streamBlocksPollInterval)
+	defer ticker.Stop()
+
+	next := fromHeight
+	for {
+		for latest := env.BlockStore.Height(); next <= latest; next++ {
+			block, err := loadStreamedBlock(next)
+			if _, ok := err.(sm.ErrNoABCIResponsesForHeight); ok && next == latest {
+				// the results of the latest block may be saved asynchronously, they are loaded on the next tick
+				break
+			}
+			if err != nil {
+				return err
+			}
+			if err := send(block); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-sub.Out():
+		case <-sub.Cancelled():
+			if sub.Err() == nil {
+				return fmt.Errorf("subscription of %s was cancelled (reason: Tendermint exited)", subscriber)
+			}
+			// the subscriber fell behind, the blocks it missed are loaded from the stores. The cancelled subscription
+			// is still recorded by the event bus until it's unsubscribed.
+			env.Logger.Info("Resubscribing the block stream", "subscriber", subscriber, "err", sub.Err())
+			env.EventBus.Unsubscribe(ctx, subscriber, types.EventQueryNewBlockHeader)
+			if sub, err = subscribeNewBlockHeader(ctx, subscriber); err != nil {
+				return err
+			}
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func subscribeNewBlockHeader(ctx context.Context, subscriber string) (types.Subscription, error) {
+	subCtx, cancel := context.WithTimeout(ctx, SubscribeTimeout)
+	defer cancel()
+	sub, err := env.EventBus.Subscribe(subCtx, subscriber, types.EventQueryNewBlockHeader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to new block headers: %w", err)
+	}
+	return sub, nil
+}
+
+func loadStreamedBlock(height int64) (*ctypes.ResultStreamedBlock, error) {
+	block := env.BlockStore.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+	results, err := sm.LoadABCIResponses(env.StateDB, height)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.DeliverTxs) != len(block.Txs) {
+		return nil, fmt.Errorf("block at height %d has %d txs, but %d tx results",
+			height, len(block.Txs), len(results.DeliverTxs))
+	}
+
+	txResults := make([]ctypes.StreamedTxResult, len(block.Txs))
+	for i, tx := range block.Txs {
+		res := results.DeliverTxs[i]
+		txResults[i] = ctypes.StreamedTxResult{
+			Hash:      tx.Hash(height),
+			Index:     uint32(i),
+			Code:      res.Code,
+			Codespace: res.Codespace,
+			Log:       res.Log,
+			GasWanted: res.GasWanted,
+			GasUsed:   res.GasUsed,
+			Events:    ctypes.NewStreamedEvents(res.Events),
+		}
+	}
+	return &ctypes.ResultStreamedBlock{
+		Header:           block.Header,
+		TxResults:        txResults,
+		BeginBlockEvents: ctypes.NewStreamedEvents(results.BeginBlock.Events),
+		EndBlockEvents:   ctypes.NewStreamedEvents(results.EndBlock.Events),
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 	"github.com/okex/exchain/libs/tendermint/crypto"
+	"github.com/okex/exchain/libs/tendermint/halt"
 	"github.com/okex/exchain/libs/tendermint/libs/bytes"
 
 	"github.com/okex/exchain/libs/tendermint/p2p"
@@ -45,6 +46,50 @@ type ResultBlockResults struct {
 	ConsensusParamUpdates *abci.ConsensusParams     `json:"consensus_param_updates"`
 }
 
+// Finalized block pushed by the block stream, with the results of its txs
+type ResultStreamedBlock struct {
+	Header           types.Header       `json:"header"`
+	TxResults        []StreamedTxResult `json:"tx_results"`
+	BeginBlockEvents []StreamedEvent    `json:"begin_block_events"`
+	EndBlockEvents   []StreamedEvent    `json:"end_block_events"`
+}
+
+// Result of a tx in a streamed block
+type StreamedTxResult struct {
+	Hash      bytes.HexBytes  `json:"hash"`
+	Index     uint32          `json:"index"`
+	Code      uint32          `json:"code"`
+	Codespace string          `json:"codespace"`
+	Log       string          `json:"log"`
+	GasWanted int64           `json:"gas_wanted"`
+	GasUsed   int64           `json:"gas_used"`
+	Events    []StreamedEvent `json:"events"`
+}
+
+// Event with the attributes decoded as strings
+type StreamedEvent struct {
+	Type       string                   `json:"type"`
+	Attributes []StreamedEventAttribute `json:"attributes"`
+}
+
+type StreamedEventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// NewStreamedEvents decodes the attributes of the abci events
+func NewStreamedEvents(events []abci.Event) []StreamedEvent {
+	streamed := make([]StreamedEvent, len(events))
+	for i, event := range events {
+		streamed[i].Type = event.Type
+		streamed[i].Attributes = make([]StreamedEventAttribute, len(event.Attributes))
+		for j, attr := range event.Attributes {
+			streamed[i].Attributes[j] = StreamedEventAttribute{Key: string(attr.Key), Value: string(attr.Value)}
+		}
+	}
+	return streamed
+}
+
 // NewResultCommit is a helper to initialize the ResultCommit with
 // the embedded struct
 func NewResultCommit(header *types.Header, commit *types.Commit,
@@ -72,6 +117,10 @@ type SyncInfo struct {
 	EarliestBlockTime   time.Time      `json:"earliest_block_time"`
 
 	CatchingUp bool `json:"catching_up"`
+
+	// progress of the fast sync, set while catching up
+	StartingBlockHeight int64 `json:"starting_block_height,omitempty"`
+	HighestBlockHeight  int64 `json:"highest_block_height,omitempty"`
 }
 
 // Info about the node's validator
@@ -104,6 +153,19 @@ type ResultNetInfo struct {
 	Peers     []Peer   `json:"peers"`
 }
 
+// Halt schedule of the chain known to the node, and the ones announced by its
+// peers
+type ResultHaltSchedule struct {
+	Schedule halt.Schedule      `json:"schedule"`
+	Peers    []PeerHaltSchedule `json:"peers"`
+}
+
+// Halt schedule announced by a peer
+type PeerHaltSchedule struct {
+	NodeID   p2p.ID        `json:"node_id"`
+	Schedule halt.Schedule `json:"schedule"`
+}
+
 // Log from dialing seeds
 type ResultDialSeeds struct {
 	Log string `json:"log"`
@@ -237,6 +299,24 @@ type ResultEnableDeleteMinGPTx struct {
 	Enable bool `json:"enable"`
 }
 
+// log levels of the node
+type ResultLogLevel struct {
+	LogLevel string `json:"log_level"`
+}
+
+// Health of the node reported by /health and /ready
+type ResultNodeHealth struct {
+	CatchingUp        bool      `json:"catching_up"`
+	LatestBlockHeight int64     `json:"latest_block_height"`
+	LatestBlockTime   time.Time `json:"latest_block_time"`
+	// age of the latest block in seconds
+	LastBlockAge float64 `json:"last_block_age"`
+	Peers        int     `json:"peers"`
+	DBHealthy    bool    `json:"db_healthy"`
+	// the reasons why the node isn't healthy or ready
+	Errors []string `json:"errors,omitempty"`
+}
+
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
@@ -0,0 +1,147 @@
+package coregrpc
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	amino "github.com/tendermint/go-amino"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/peer"
+
+	core "github.com/okex/exchain/libs/tendermint/rpc/core"
+	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
+)
+
+const (
+	// BlockStreamServiceName is the name of the gRPC service streaming the finalized blocks.
+	BlockStreamServiceName = "tendermint.rpc.grpc.BlockStream"
+
+	subscribeBlocksMethod = "SubscribeBlocks"
+)
+
+var (
+	cdc = amino.NewCodec()
+
+	// streamCount numbers the block streams, so that each of them subscribes to the events as a different subscriber
+	streamCount uint64
+)
+
+func init() {
+	ctypes.RegisterAmino(cdc)
+	encoding.RegisterCodec(aminoJSONGRPCCodec{})
+}
+
+// aminoJSONGRPCCodec encodes the messages of the block stream with amino JSON, the same encoding as the JSON-RPC
+// results, so that the blocks needn't be defined in protobuf again.
+type aminoJSONGRPCCodec struct{}
+
+func (aminoJSONGRPCCodec) Marshal(v interface{}) ([]byte, error) {
+	return cdc.MarshalJSON(v)
+}
+
+func (aminoJSONGRPCCodec) Unmarshal(data []byte, v interface{}) error {
+	return cdc.UnmarshalJSON(data, v)
+}
+
+func (aminoJSONGRPCCodec) Name() string {
+	return "amino-json"
+}
+
+// RequestSubscribeBlocks subscribes to the finalized blocks from FromHeight on, 0 means from the next block.
+type RequestSubscribeBlocks struct {
+	FromHeight int64 `json:"from_height"`
+}
+
+//-------------------------------------------------------------------------------
+
+type blockStreamAPI struct {
+}
+
+// RegisterBlockStreamServer registers the block stream service on the gRPC server.
+func RegisterBlockStreamServer(s *grpc.Server) {
+	s.RegisterService(&grpc.ServiceDesc{
+		ServiceName: BlockStreamServiceName,
+		HandlerType: (*interface{})(nil),
+		Streams: []grpc.StreamDesc{
+			{StreamName: subscribeBlocksMethod, Handler: subscribeBlocksHandler, ServerStreams: true},
+		},
+	}, &blockStreamAPI{})
+}
+
+func subscribeBlocksHandler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(*blockStreamAPI).SubscribeBlocks(stream)
+}
+
+func (bsapi *blockStreamAPI) SubscribeBlocks(stream grpc.ServerStream) error {
+	req := &RequestSubscribeBlocks{}
+	if err := stream.RecvMsg(req); err != nil {
+		return err
+	}
+
+	subscriber := fmt.Sprintf("grpc-block-stream-%d", atomic.AddUint64(&streamCount, 1))
+	if p, ok := peer.FromContext(stream.Context()); ok {
+		subscriber = fmt.Sprintf("%s@%s", subscriber, p.Addr)
+	}
+	return core.StreamBlocks(stream.Context(), subscriber, req.FromHeight, func(block *ctypes.ResultStreamedBlock) error {
+		return stream.SendMsg(block)
+	})
+}
+
+//-------------------------------------------------------------------------------
+
+// BlockStreamClient subscribes to the finalized blocks of a node over gRPC.
+type BlockStreamClient struct {
+	conn *grpc.ClientConn
+}
+
+// StartBlockStreamClient dials the gRPC server using protoAddr and returns a new BlockStreamClient.
+func StartBlockStreamClient(protoAddr string) (*BlockStreamClient, error) {
+	conn, err := grpc.Dial(protoAddr,
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(dialerFunc),
+		grpc.WithDefaultCallOptions(grpc.CallContentSubtype(aminoJSONGRPCCodec{}.Name())),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &BlockStreamClient{conn: conn}, nil
+}
+
+// Close closes the connection.
+func (bsc *BlockStreamClient) Close() error {
+	return bsc.conn.Close()
+}
+
+// SubscribeBlocks subscribes to the finalized blocks from fromHeight on, 0 means from the next block. The
+// subscription ends when ctx is done. To resume a broken subscription, subscribe again from the height after the
+// last block received.
+func (bsc *BlockStreamClient) SubscribeBlocks(ctx context.Context, fromHeight int64) (*BlockSubscription, error) {
+	stream, err := bsc.conn.NewStream(ctx, &grpc.StreamDesc{StreamName: subscribeBlocksMethod, ServerStreams: true},
+		fmt.Sprintf("/%s/%s", BlockStreamServiceName, subscribeBlocksMethod))
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.SendMsg(&RequestSubscribeBlocks{FromHeight: fromHeight}); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return &BlockSubscription{stream: stream}, nil
+}
+
+// BlockSubscription receives the blocks of a subscription in order of height.
+type BlockSubscription struct {
+	stream grpc.ClientStream
+}
+
+// Recv blocks until the next block is received.
+func (bs *BlockSubscription) Recv() (*ctypes.ResultStreamedBlock, error) {
+	block := &ctypes.ResultStreamedBlock{}
+	if err := bs.stream.RecvMsg(block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
@@ -1,13 +1,17 @@
 package coregrpc_test
 
 import (
+	"bytes"
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/okex/exchain/libs/tendermint/abci/example/kvstore"
+	ctypes "github.com/okex/exchain/libs/tendermint/rpc/core/types"
 	core_grpc "github.com/okex/exchain/libs/tendermint/rpc/grpc"
 	rpctest "github.com/okex/exchain/libs/tendermint/rpc/test"
+	"github.com/okex/exchain/libs/tendermint/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,3 +36,46 @@ func TestBroadcastTx(t *testing.T) {
 	require.EqualValues(t, 0, res.CheckTx.Code)
 	require.EqualValues(t, 0, res.DeliverTx.Code)
 }
+
+func TestSubscribeBlocks(t *testing.T) {
+	client, err := core_grpc.StartBlockStreamClient(rpctest.GetConfig().RPC.GRPCListenAddress)
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// the stream resumes from a past height, and goes on with the new blocks
+	sub, err := client.SubscribeBlocks(ctx, 1)
+	require.NoError(t, err)
+	for height := int64(1); height <= 3; height++ {
+		block, err := sub.Recv()
+		require.NoError(t, err)
+		require.Equal(t, height, block.Header.Height)
+	}
+
+	tx := []byte("stream=tx")
+	res, err := rpctest.GetGRPCClient().BroadcastTx(context.Background(), &core_grpc.RequestBroadcastTx{Tx: tx})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, res.DeliverTx.Code)
+
+	var txResult *ctypes.StreamedTxResult
+	for txResult == nil {
+		block, err := sub.Recv()
+		require.NoError(t, err)
+		for i, res := range block.TxResults {
+			if bytes.Equal(types.Tx(tx).Hash(block.Header.Height), res.Hash) {
+				txResult = &block.TxResults[i]
+			}
+		}
+	}
+	require.NotEmpty(t, txResult.Events)
+	require.Equal(t, "app", txResult.Events[0].Type)
+	require.Contains(t, txResult.Events[0].Attributes, ctypes.StreamedEventAttribute{Key: "key", Value: "stream"})
+
+	// the error of the subscription is received by the stream
+	sub, err = client.SubscribeBlocks(ctx, -1)
+	require.NoError(t, err)
+	_, err = sub.Recv()
+	require.Error(t, err)
+}
@@ -174,8 +174,10 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 
 	// Fetch a limited amount of valid txs
 	maxDataBytes := types.MaxDataBytes(maxBytes, state.Validators.Size(), len(evidence))
-	if cfg.DynamicConfig.GetMaxGasUsedPerBlock() > -1 {
-		maxGas = cfg.DynamicConfig.GetMaxGasUsedPerBlock()
+	// the local limit of the node may only lower the consensus limit, the txs over the
+	// consensus limit would fail once the block is executed
+	if localMaxGas := cfg.DynamicConfig.GetMaxGasUsedPerBlock(); localMaxGas > -1 && (maxGas == -1 || localMaxGas < maxGas) {
+		maxGas = localMaxGas
 	}
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxDataBytes, maxGas)
 
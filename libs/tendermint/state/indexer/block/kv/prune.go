@@ -0,0 +1,71 @@
+package kv
+
+import (
+	"fmt"
+
+	"github.com/google/orderedcode"
+
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+// key of the height below which the block events have been pruned
+var prunedHeightKey = []byte("block_index.pruned_height")
+
+// Prune deletes the block events indexed below retainHeight, and returns the number of the blocks deleted. The
+// event keys aren't ordered by height, so the whole index is scanned each time the retain height moves.
+func (idx *BlockerIndexer) Prune(retainHeight int64) (int64, error) {
+	prunedHeight, err := idx.prunedHeight()
+	if err != nil {
+		return 0, err
+	}
+	if retainHeight <= prunedHeight {
+		return 0, nil
+	}
+
+	var pruned int64
+	keys := make([][]byte, 0)
+	it, err := idx.store.Iterator(nil, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create iterator: %w", err)
+	}
+	for ; it.Valid(); it.Next() {
+		height, primary, ok := parseHeightFromKey(it.Key())
+		if !ok || height >= retainHeight {
+			continue
+		}
+		if primary {
+			pruned++
+		}
+		keys = append(keys, append([]byte{}, it.Key()...))
+	}
+	it.Close()
+
+	batch := idx.store.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		batch.Delete(key)
+	}
+	batch.Set(prunedHeightKey, int64ToBytes(retainHeight))
+	return pruned, batch.WriteSync()
+}
+
+func (idx *BlockerIndexer) prunedHeight() (int64, error) {
+	bz, err := idx.store.Get(prunedHeightKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return int64FromBytes(bz), nil
+}
+
+// parseHeightFromKey returns the height of a primary key or an event key, and whether it's a primary key
+func parseHeightFromKey(key []byte) (height int64, primary bool, ok bool) {
+	var compositeKey, typ, eventValue string
+	if remaining, err := orderedcode.Parse(string(key), &compositeKey, &height); err == nil && len(remaining) == 0 {
+		return height, compositeKey == types.BlockHeightKey, compositeKey == types.BlockHeightKey
+	}
+	remaining, err := orderedcode.Parse(string(key), &compositeKey, &eventValue, &height, &typ)
+	if err != nil || len(remaining) != 0 {
+		return 0, false, false
+	}
+	return height, false, true
+}
@@ -305,6 +305,17 @@ func ExecCommitBlock(
 	logger log.Logger,
 	stateDB dbm.DB,
 ) ([]byte, error) {
+	_, appHash, err := ExecCommitBlockWithResponses(appConnConsensus, block, logger, stateDB)
+	return appHash, err
+}
+
+// ExecCommitBlockWithResponses is ExecCommitBlock which also returns the ABCI responses of the block.
+func ExecCommitBlockWithResponses(
+	appConnConsensus proxy.AppConnConsensus,
+	block *types.Block,
+	logger log.Logger,
+	stateDB dbm.DB,
+) (*ABCIResponses, []byte, error) {
 
 	ctx := &executionTask{
 		logger:   logger,
@@ -313,19 +324,19 @@ func ExecCommitBlock(
 		proxyApp: appConnConsensus,
 	}
 
-	_, err := execBlockOnProxyApp(ctx)
+	abciResponses, err := execBlockOnProxyApp(ctx)
 	if err != nil {
 		logger.Error("Error executing block on proxy app", "height", block.Height, "err", err)
-		return nil, err
+		return nil, nil, err
 	}
 	// Commit block, get hash back
 	res, err := appConnConsensus.CommitSync(abci.RequestCommit{})
 	if err != nil {
 		logger.Error("Client error during proxyAppConn.CommitSync", "err", res)
-		return nil, err
+		return nil, nil, err
 	}
 	// ResponseCommit has no error or log, just data
-	return res.Data, nil
+	return abciResponses, res.Data, nil
 }
 
 func execCommitBlockDelta(
@@ -21,6 +21,7 @@ type IndexerService struct {
 	idr       TxIndexer
 	blockIdxr indexer.BlockIndexer
 	eventBus  *types.EventBus
+	pruner    *Pruner
 	quit      chan struct{}
 }
 
@@ -33,6 +34,12 @@ func NewIndexerService(idr TxIndexer, bidr indexer.BlockIndexer, eventBus *types
 	return is
 }
 
+// SetPruner sets the pruner of the indexers, which is started and stopped with
+// the service.
+func (is *IndexerService) SetPruner(p *Pruner) {
+	is.pruner = p
+}
+
 // OnStart implements service.Service by subscribing for all transactions
 // and indexing them by events.
 func (is *IndexerService) OnStart() error {
@@ -89,11 +96,18 @@ func (is *IndexerService) OnStart() error {
 
 		}
 	}()
+
+	if is.pruner != nil {
+		return is.pruner.Start()
+	}
 	return nil
 }
 
 // OnStop implements service.Service by unsubscribing from all transactions.
 func (is *IndexerService) OnStop() {
+	if is.pruner != nil {
+		_ = is.pruner.Stop()
+	}
 	if is.eventBus.IsRunning() {
 		_ = is.eventBus.UnsubscribeAll(context.Background(), subscriber)
 	}
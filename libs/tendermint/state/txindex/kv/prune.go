@@ -0,0 +1,143 @@
+package kv
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	dbm "github.com/okex/exchain/libs/tm-db"
+
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+// key of the height below which the txs have been pruned
+var prunedHeightKey = []byte("txindex.pruned_height")
+
+// number of the heights pruned in a batch
+const pruneBatchHeights = 100
+
+// Prune deletes the txs indexed below retainHeight with all their events, and returns the number of the txs
+// deleted. The txs are found by their height, so the txs indexed without "tx.height" aren't pruned.
+func (txi *TxIndex) Prune(retainHeight int64) (int64, error) {
+	prunedHeight, err := txi.prunedHeight()
+	if err != nil {
+		return 0, err
+	}
+	if retainHeight <= prunedHeight {
+		return 0, nil
+	}
+
+	var pruned int64
+	if prunedHeight == 0 {
+		// the heights are ordered as strings, so all of them are scanned the first time
+		pruned, err = txi.pruneHeights(startKey(types.TxHeightKey), retainHeight)
+		if err != nil {
+			return pruned, err
+		}
+		return pruned, txi.setPrunedHeight(retainHeight)
+	}
+
+	for from := prunedHeight; from < retainHeight; from += pruneBatchHeights {
+		to := from + pruneBatchHeights
+		if to > retainHeight {
+			to = retainHeight
+		}
+		for height := from; height < to; height++ {
+			n, err := txi.pruneHeights(startKey(types.TxHeightKey, height), retainHeight)
+			pruned += n
+			if err != nil {
+				return pruned, err
+			}
+		}
+		if err := txi.setPrunedHeight(to); err != nil {
+			return pruned, err
+		}
+	}
+	return pruned, nil
+}
+
+// pruneHeights deletes the txs whose height keys start with prefix and are below retainHeight
+func (txi *TxIndex) pruneHeights(prefix []byte, retainHeight int64) (int64, error) {
+	heightKeys := make([][]byte, 0)
+	hashes := make([][]byte, 0)
+
+	it, err := dbm.IteratePrefix(txi.store, prefix)
+	if err != nil {
+		return 0, err
+	}
+	for ; it.Valid(); it.Next() {
+		height, err := parseHeightFromHeightKey(it.Key())
+		if err != nil {
+			it.Close()
+			return 0, err
+		}
+		if height < retainHeight {
+			heightKeys = append(heightKeys, append([]byte{}, it.Key()...))
+			hashes = append(hashes, append([]byte{}, it.Value()...))
+		}
+	}
+	it.Close()
+	if len(heightKeys) == 0 {
+		return 0, nil
+	}
+
+	batch := txi.store.NewBatch()
+	defer batch.Close()
+	for i, hash := range hashes {
+		batch.Delete(heightKeys[i])
+
+		rawBytes, err := txi.store.Get(hash)
+		if err != nil {
+			return 0, err
+		}
+		result, err := getTxResultFromBytes(rawBytes)
+		if err != nil {
+			return 0, err
+		}
+		// the same tx may have been indexed again at a retained height
+		if result == nil || result.Height >= retainHeight {
+			continue
+		}
+		txi.deleteEvents(result, batch)
+		batch.Delete(hash)
+	}
+	return int64(len(heightKeys)), batch.WriteSync()
+}
+
+// deleteEvents deletes all the event keys of the tx, whether they have been indexed or not
+func (txi *TxIndex) deleteEvents(result *types.TxResult, batch dbm.SetDeleter) {
+	for _, event := range result.Result.Events {
+		if len(event.Type) == 0 {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 {
+				continue
+			}
+
+			compositeTag := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
+			batch.Delete(keyForEvent(compositeTag, attr.Value, result))
+		}
+	}
+}
+
+func (txi *TxIndex) prunedHeight() (int64, error) {
+	bz, err := txi.store.Get(prunedHeightKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(bz), 10, 64)
+}
+
+func (txi *TxIndex) setPrunedHeight(height int64) error {
+	return txi.store.SetSync(prunedHeightKey, []byte(strconv.FormatInt(height, 10)))
+}
+
+func parseHeightFromHeightKey(key []byte) (int64, error) {
+	parts := strings.Split(string(key), tagKeySeparator)
+	if len(parts) != 4 {
+		return 0, fmt.Errorf("invalid height key: %s", key)
+	}
+	return strconv.ParseInt(parts[1], 10, 64)
+}
@@ -0,0 +1,139 @@
+package txindex
+
+import (
+	"sort"
+	"time"
+
+	"github.com/okex/exchain/libs/tendermint/libs/service"
+	"github.com/okex/exchain/libs/tendermint/state/indexer"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+// Prunable is implemented by the indexers which can delete the data indexed below a height.
+type Prunable interface {
+	// Prune deletes the data indexed below retainHeight, and returns the number of the txs or blocks deleted.
+	Prune(retainHeight int64) (int64, error)
+}
+
+// BlockStore is the block store used to find the heights to retain.
+type BlockStore interface {
+	Base() int64
+	Height() int64
+	LoadBlockMeta(height int64) *types.BlockMeta
+}
+
+// Pruner periodically prunes the txs and the block events indexed before the retained blocks, which are the last
+// retainBlocks blocks and the blocks of the last retainTime.
+type Pruner struct {
+	service.BaseService
+
+	indexers     []Prunable
+	blockStore   BlockStore
+	retainBlocks int64
+	retainTime   time.Duration
+	interval     time.Duration
+	quit         chan struct{}
+}
+
+// NewPruner returns a Pruner of the indexers, the ones which aren't Prunable are ignored.
+func NewPruner(idr TxIndexer, bidr indexer.BlockIndexer, blockStore BlockStore,
+	retainBlocks int64, retainTime, interval time.Duration) *Pruner {
+	p := &Pruner{
+		blockStore:   blockStore,
+		retainBlocks: retainBlocks,
+		retainTime:   retainTime,
+		interval:     interval,
+		quit:         make(chan struct{}),
+	}
+	for _, i := range []interface{}{idr, bidr} {
+		if prunable, ok := i.(Prunable); ok {
+			p.indexers = append(p.indexers, prunable)
+		}
+	}
+	p.BaseService = *service.NewBaseService(nil, "IndexPruner", p)
+	return p
+}
+
+// OnStart implements service.Service by starting the pruning routine.
+func (p *Pruner) OnStart() error {
+	go p.pruneRoutine()
+	return nil
+}
+
+// OnStop implements service.Service.
+func (p *Pruner) OnStop() {
+	close(p.quit)
+}
+
+func (p *Pruner) pruneRoutine() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.Prune(time.Now())
+		select {
+		case <-ticker.C:
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// Prune prunes the indexers to the retain height at now.
+func (p *Pruner) Prune(now time.Time) {
+	retainHeight := p.RetainHeight(now)
+	if retainHeight <= 1 {
+		return
+	}
+	for _, idr := range p.indexers {
+		start := time.Now()
+		pruned, err := idr.Prune(retainHeight)
+		if err != nil {
+			p.Logger.Error("Failed to prune the index", "retainHeight", retainHeight, "err", err)
+			continue
+		}
+		if pruned > 0 {
+			p.Logger.Info("Pruned the index", "retainHeight", retainHeight, "pruned", pruned,
+				"duration", time.Since(start))
+		}
+	}
+}
+
+// RetainHeight returns the lowest height retained at now, the indexed data below it is pruned.
+func (p *Pruner) RetainHeight(now time.Time) int64 {
+	height := p.blockStore.Height()
+	if height <= 0 {
+		return 0
+	}
+
+	retainHeight := height + 1
+	if p.retainBlocks > 0 && height-p.retainBlocks+1 < retainHeight {
+		retainHeight = height - p.retainBlocks + 1
+	}
+	if p.retainTime > 0 {
+		if h := p.firstHeightSince(now.Add(-p.retainTime)); h < retainHeight {
+			retainHeight = h
+		}
+	}
+	switch {
+	case retainHeight > height:
+		// nothing is retained by the settings, but the latest block is always retained
+		retainHeight = height
+	case retainHeight < 1:
+		retainHeight = 1
+	}
+	return retainHeight
+}
+
+// firstHeightSince returns the first height of the blocks stored whose time isn't before t, or the height after the
+// latest one if all of them are before t.
+func (p *Pruner) firstHeightSince(t time.Time) int64 {
+	base, height := p.blockStore.Base(), p.blockStore.Height()
+	if base <= 0 {
+		base = 1
+	}
+	i := sort.Search(int(height-base+1), func(i int) bool {
+		meta := p.blockStore.LoadBlockMeta(base + int64(i))
+		return meta != nil && !meta.Header.Time.Before(t)
+	})
+	return base + int64(i)
+}
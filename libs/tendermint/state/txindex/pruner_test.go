@@ -0,0 +1,122 @@
+package txindex_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	db "github.com/okex/exchain/libs/tm-db"
+
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/kv"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/libs/tendermint/libs/pubsub/query"
+	blockindexer "github.com/okex/exchain/libs/tendermint/state/indexer/block/kv"
+	"github.com/okex/exchain/libs/tendermint/state/txindex"
+	txkv "github.com/okex/exchain/libs/tendermint/state/txindex/kv"
+	"github.com/okex/exchain/libs/tendermint/types"
+)
+
+type mockBlockStore struct {
+	base  int64
+	times []time.Time
+}
+
+func (bs *mockBlockStore) Base() int64   { return bs.base }
+func (bs *mockBlockStore) Height() int64 { return int64(len(bs.times)) }
+func (bs *mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
+	if height < bs.base || height > bs.Height() {
+		return nil
+	}
+	return &types.BlockMeta{Header: types.Header{Height: height, Time: bs.times[height-1]}}
+}
+
+func TestPrunerRetainHeight(t *testing.T) {
+	start := time.Now()
+	bs := &mockBlockStore{base: 1}
+	for i := 0; i < 10; i++ {
+		bs.times = append(bs.times, start.Add(time.Duration(i)*time.Minute))
+	}
+	now := start.Add(10 * time.Minute)
+
+	testCases := []struct {
+		retainBlocks int64
+		retainTime   time.Duration
+		base         int64
+		expected     int64
+	}{
+		{3, 0, 1, 8},
+		{20, 0, 1, 1},
+		{0, 3 * time.Minute, 1, 8},
+		{0, time.Second, 1, 10},
+		{2, 5 * time.Minute, 1, 6},
+		{5, 2 * time.Minute, 1, 6},
+		{0, 3 * time.Minute, 9, 9},
+	}
+	for _, tc := range testCases {
+		bs.base = tc.base
+		pruner := txindex.NewPruner(nil, nil, bs, tc.retainBlocks, tc.retainTime, time.Minute)
+		assert.Equal(t, tc.expected, pruner.RetainHeight(now), "%+v", tc)
+	}
+}
+
+func TestPrunerPrunesIndexers(t *testing.T) {
+	txIndexer := txkv.NewTxIndex(db.NewMemDB(), txkv.IndexEvents([]string{types.TxHeightKey, "account.number"}))
+	blockIndexer := blockindexer.New(db.NewMemDB())
+
+	bs := &mockBlockStore{base: 1}
+	var txs []*types.TxResult
+	for height := int64(1); height <= 6; height++ {
+		bs.times = append(bs.times, time.Now())
+		events := []abci.Event{{Type: "account", Attributes: []kv.Pair{{Key: []byte("number"), Value: []byte("1")}}}}
+		tx := &types.TxResult{
+			Height: height,
+			Tx:     types.Tx("tx" + string(rune('0'+height))),
+			Result: abci.ResponseDeliverTx{Code: abci.CodeTypeOK, Events: events},
+		}
+		require.NoError(t, txIndexer.Index(tx))
+		txs = append(txs, tx)
+		require.NoError(t, blockIndexer.Index(types.EventDataNewBlockHeader{
+			Header:           types.Header{Height: height},
+			ResultBeginBlock: abci.ResponseBeginBlock{Events: events},
+		}))
+	}
+
+	pruner := txindex.NewPruner(txIndexer, blockIndexer, bs, 4, 0, time.Minute)
+	pruner.SetLogger(log.TestingLogger())
+	pruner.Prune(time.Now())
+	assertRetained(t, txIndexer, blockIndexer, txs, 3)
+
+	// the retain height moves with the new blocks
+	bs.times = append(bs.times, time.Now(), time.Now())
+	pruner.Prune(time.Now())
+	assertRetained(t, txIndexer, blockIndexer, txs, 5)
+}
+
+func assertRetained(t *testing.T, txIndexer *txkv.TxIndex, blockIndexer *blockindexer.BlockerIndexer,
+	txs []*types.TxResult, retainHeight int64) {
+	for _, tx := range txs {
+		res, err := txIndexer.Get(tx.Tx.Hash(tx.Height))
+		require.NoError(t, err)
+		has, err := blockIndexer.Has(tx.Height)
+		require.NoError(t, err)
+		if tx.Height < retainHeight {
+			assert.Nil(t, res, "height %d", tx.Height)
+			assert.False(t, has, "height %d", tx.Height)
+		} else {
+			assert.NotNil(t, res, "height %d", tx.Height)
+			assert.True(t, has, "height %d", tx.Height)
+		}
+	}
+
+	results, err := txIndexer.Search(context.Background(), query.MustParse("account.number = 1"))
+	require.NoError(t, err)
+	assert.Len(t, results, len(txs)-int(retainHeight)+1)
+
+	heights, err := blockIndexer.Search(context.Background(), query.MustParse("account.number = 1"))
+	require.NoError(t, err)
+	assert.Len(t, heights, len(txs)-int(retainHeight)+1)
+}
@@ -50,6 +50,8 @@ func validateBlock(evidencePool EvidencePool, stateDB dbm.DB, state State, block
 
 	// Validate app info
 	if !bytes.Equal(block.AppHash, state.AppHash) {
+		// the state of the node diverged, so the signatures verified against it are not trusted anymore
+		types.SignatureCache().Reset()
 		return fmt.Errorf("wrong Block.Header.AppHash.  Expected %X, got %v",
 			state.AppHash,
 			block.AppHash,
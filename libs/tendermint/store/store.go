@@ -53,6 +53,19 @@ func NewBlockStore(db dbm.DB) *BlockStore {
 	}
 }
 
+// Reload reloads the heights of the store from the DB, e.g. once a replica DB caught up with its primary. The blocks
+// above maxHeight are left out.
+func (bs *BlockStore) Reload(maxHeight int64) {
+	bsjson := LoadBlockStoreStateJSON(bs.db)
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	bs.base = bsjson.Base
+	bs.height = bsjson.Height
+	if bs.height > maxHeight {
+		bs.height = maxHeight
+	}
+}
+
 // Base returns the first known contiguous block height, or 0 for empty block stores.
 func (bs *BlockStore) Base() int64 {
 	bs.mtx.RLock()
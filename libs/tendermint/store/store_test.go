@@ -129,6 +129,22 @@ func TestNewBlockStore(t *testing.T) {
 	assert.Equal(t, bs.Height(), int64(0), "expecting nil bytes to be unmarshaled alright")
 }
 
+func TestBlockStoreReload(t *testing.T) {
+	db := db.NewMemDB()
+	bs := NewBlockStore(db)
+	require.Equal(t, int64(0), bs.Height())
+
+	// the blocks saved by another block store on the same db
+	BlockStoreStateJSON{Base: 10, Height: 20}.Save(db)
+	bs.Reload(30)
+	assert.Equal(t, int64(10), bs.Base())
+	assert.Equal(t, int64(20), bs.Height())
+	// the blocks above the max height are left out
+	BlockStoreStateJSON{Base: 10, Height: 40}.Save(db)
+	bs.Reload(30)
+	assert.Equal(t, int64(30), bs.Height())
+}
+
 func freshBlockStore() (*BlockStore, db.DB) {
 	db := db.NewMemDB()
 	return NewBlockStore(db), db
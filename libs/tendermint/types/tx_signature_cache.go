@@ -78,6 +78,14 @@ func (c *Cache) Remove(key []byte) {
 	c.data.Del(key)
 }
 
+// Reset drops all the cached signatures
+func (c *Cache) Reset() {
+	if c.data == nil {
+		return
+	}
+	c.data.Reset()
+}
+
 func (c *Cache) ReadCount() int64 {
 	return atomic.LoadInt64(&c.readCount)
 }
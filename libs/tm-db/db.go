@@ -68,6 +68,14 @@ func NewDB(name string, backend BackendType, dir string) DB {
 			backend))
 	}
 
+	if IsReplicaMode() {
+		db, err := NewReplicaDB(name, backend, dir)
+		if err != nil {
+			panic(fmt.Sprintf("Error initializing replica DB: %v", err))
+		}
+		return db
+	}
+
 	dbCreator, ok := backends[backend]
 	if !ok {
 		keys := make([]string, len(backends))
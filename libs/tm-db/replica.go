@@ -0,0 +1,82 @@
+package db
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+)
+
+// ReplicaDB is a read-only DB opened on the files of a DB written by another process, the primary. It reads a
+// consistent snapshot of the primary, which is advanced to the latest writes of the primary on TryCatchUpWithPrimary.
+type ReplicaDB interface {
+	DB
+
+	// TryCatchUpWithPrimary applies the writes done by the primary since the last catch up
+	TryCatchUpWithPrimary() error
+}
+
+type replicaDBCreator func(name string, dir string, replicaDir string) (ReplicaDB, error)
+
+var replicaBackends = map[BackendType]replicaDBCreator{}
+
+func registerReplicaDBCreator(backend BackendType, creator replicaDBCreator, force bool) {
+	_, ok := replicaBackends[backend]
+	if !force && ok {
+		return
+	}
+	replicaBackends[backend] = creator
+}
+
+var replicas = struct {
+	mtx sync.Mutex
+	dir string
+	dbs []ReplicaDB
+}{}
+
+// EnableReplicaMode makes NewDB open the DBs as replicas of the DBs written by the primary process, the files private
+// to the replicas, e.g. their logs, are kept in dir
+func EnableReplicaMode(dir string) {
+	replicas.mtx.Lock()
+	defer replicas.mtx.Unlock()
+	replicas.dir = dir
+}
+
+// IsReplicaMode returns whether the DBs are opened as replicas
+func IsReplicaMode() bool {
+	replicas.mtx.Lock()
+	defer replicas.mtx.Unlock()
+	return replicas.dir != ""
+}
+
+// NewReplicaDB opens the DB of type backend with the given name as a replica, the replica mode must be enabled
+func NewReplicaDB(name string, backend BackendType, dir string) (ReplicaDB, error) {
+	replicas.mtx.Lock()
+	defer replicas.mtx.Unlock()
+	if replicas.dir == "" {
+		return nil, fmt.Errorf("replica mode is not enabled")
+	}
+	creator, ok := replicaBackends[backend]
+	if !ok {
+		return nil, fmt.Errorf("db_backend %s doesn't support the replica mode", backend)
+	}
+
+	// the dbs of different dirs may have the same name
+	db, err := creator(name, dir, filepath.Join(replicas.dir, filepath.Base(dir), name+".db"))
+	if err != nil {
+		return nil, err
+	}
+	replicas.dbs = append(replicas.dbs, db)
+	return db, nil
+}
+
+// CatchUpReplicas catches all the replica DBs opened up with their primaries
+func CatchUpReplicas() error {
+	replicas.mtx.Lock()
+	defer replicas.mtx.Unlock()
+	for _, db := range replicas.dbs {
+		if err := db.TryCatchUpWithPrimary(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
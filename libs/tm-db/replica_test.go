@@ -0,0 +1,46 @@
+package db
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeReplicaDB struct {
+	*MemDB
+	replicaDir string
+	catchUps   int
+}
+
+func (db *fakeReplicaDB) TryCatchUpWithPrimary() error {
+	db.catchUps++
+	return nil
+}
+
+func TestReplicaMode(t *testing.T) {
+	registerReplicaDBCreator(MemDBBackend, func(name string, dir string, replicaDir string) (ReplicaDB, error) {
+		return &fakeReplicaDB{MemDB: NewMemDB(), replicaDir: replicaDir}, nil
+	}, true)
+	defer func() {
+		delete(replicaBackends, MemDBBackend)
+		EnableReplicaMode("")
+		replicas.dbs = nil
+	}()
+
+	_, err := NewReplicaDB("state", MemDBBackend, "data")
+	require.Error(t, err)
+	require.False(t, IsReplicaMode())
+
+	EnableReplicaMode("replica")
+	require.True(t, IsReplicaMode())
+	db := NewDB("state", MemDBBackend, "data").(*fakeReplicaDB)
+	assert.Equal(t, filepath.Join("replica", "data", "state.db"), db.replicaDir)
+
+	require.NoError(t, CatchUpReplicas())
+	assert.Equal(t, 1, db.catchUps)
+
+	// the backends without replicas can't be opened
+	assert.Panics(t, func() { NewDB("state", GoLevelDBBackend, "data") })
+}
@@ -5,6 +5,7 @@ package db
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 	"runtime"
 	"strconv"
@@ -18,6 +19,11 @@ func init() {
 		return NewRocksDB(name, dir)
 	}
 	registerDBCreator(RocksDBBackend, dbCreator, false)
+
+	replicaDBCreator := func(name string, dir string, replicaDir string) (ReplicaDB, error) {
+		return NewRocksDBReplica(name, dir, replicaDir)
+	}
+	registerReplicaDBCreator(RocksDBBackend, replicaDBCreator, false)
 }
 
 // RocksDB is a RocksDB backend.
@@ -42,6 +48,10 @@ const (
 )
 
 func NewRocksDB(name string, dir string) (*RocksDB, error) {
+	return NewRocksDBWithOptions(name, dir, newRocksDBOptions())
+}
+
+func newRocksDBOptions() *gorocksdb.Options {
 	// default rocksdb option, good enough for most cases, including heavy workloads.
 	// 1GB table cache, 512MB write buffer(may use 50% more on heavy workloads).
 	// compression: snappy as default, need to -lsnappy to enable.
@@ -130,7 +140,7 @@ func NewRocksDB(name string, dir string) (*RocksDB, error) {
 
 	// 1.5GB maximum memory use for writebuffer.
 	opts.OptimizeLevelStyleCompaction(512 * 1024 * 1024)
-	return NewRocksDBWithOptions(name, dir, opts)
+	return opts
 }
 
 func NewRocksDBWithOptions(name string, dir string, opts *gorocksdb.Options) (*RocksDB, error) {
@@ -139,6 +149,26 @@ func NewRocksDBWithOptions(name string, dir string, opts *gorocksdb.Options) (*R
 	if err != nil {
 		return nil, err
 	}
+	return newRocksDB(db), nil
+}
+
+// NewRocksDBReplica opens the rocksdb as a secondary instance of the process writing it, which keeps its own logs in
+// replicaDir. The writes on it fail.
+func NewRocksDBReplica(name string, dir string, replicaDir string) (*RocksDB, error) {
+	if err := os.MkdirAll(replicaDir, 0755); err != nil {
+		return nil, err
+	}
+	opts := newRocksDBOptions()
+	// the secondary instances must keep all the files open
+	opts.SetMaxOpenFiles(-1)
+	db, err := gorocksdb.OpenDbAsSecondary(opts, filepath.Join(dir, name+".db"), replicaDir)
+	if err != nil {
+		return nil, err
+	}
+	return newRocksDB(db), nil
+}
+
+func newRocksDB(db *gorocksdb.DB) *RocksDB {
 	ro := gorocksdb.NewDefaultReadOptions()
 	wo := gorocksdb.NewDefaultWriteOptions()
 	woSync := gorocksdb.NewDefaultWriteOptions()
@@ -149,7 +179,12 @@ func NewRocksDBWithOptions(name string, dir string, opts *gorocksdb.Options) (*R
 		wo:     wo,
 		woSync: woSync,
 	}
-	return database, nil
+	return database
+}
+
+// TryCatchUpWithPrimary implements ReplicaDB.
+func (db *RocksDB) TryCatchUpWithPrimary() error {
+	return db.db.TryCatchUpWithPrimary()
 }
 
 // Get implements DB.
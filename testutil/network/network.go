@@ -0,0 +1,258 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/okex/exchain/app"
+	okexchaincodec "github.com/okex/exchain/app/codec"
+	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+	"github.com/okex/exchain/app/rpc"
+	clientcontext "github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/crypto/keys"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmcfg "github.com/okex/exchain/libs/tendermint/config"
+	"github.com/okex/exchain/libs/tendermint/crypto"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/libs/tendermint/node"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+// lock ensures a single network runs at a time, as the nodes share the global state of the process
+var lock = new(sync.Mutex)
+
+// Config defines the parameters of the in-process network
+type Config struct {
+	ChainID       string                     // chain id of the network, its epoch is the chain id of the evm txs
+	NumValidators int                        // number of validators, each running its own node
+	BondDenom     string                     // denom the validators are created and the accounts funded with
+	AccountTokens sdk.Dec                    // tokens every validator account is funded with in the genesis
+	MinGasPrices  string                     // minimum gas prices of the nodes
+	TimeoutCommit time.Duration              // time the nodes wait after a block is committed, the block time
+	GenesisState  map[string]json.RawMessage // genesis of the modules, the accounts and gentxs are added by New
+	EnableLogging bool                       // log the nodes to stdout
+	CleanupDir    bool                       // remove the directories of the nodes when the network is cleaned up
+	Codec         *codec.CodecProxy          // codec of the app
+}
+
+// DefaultConfig returns a sane default configuration of a network of 4 validators producing a block per second
+func DefaultConfig() Config {
+	cdc, _ := okexchaincodec.MakeCodecSuit(app.ModuleBasics)
+
+	// the evm contracts are enabled for the dapps to be tested against the network
+	genesisState := app.ModuleBasics.DefaultGenesis()
+	var evmGenState evmtypes.GenesisState
+	cdc.GetCdc().MustUnmarshalJSON(genesisState[evmtypes.ModuleName], &evmGenState)
+	evmGenState.Params.EnableCreate = true
+	evmGenState.Params.EnableCall = true
+	genesisState[evmtypes.ModuleName] = cdc.GetCdc().MustMarshalJSON(evmGenState)
+
+	return Config{
+		ChainID:       "exchain-67",
+		NumValidators: 4,
+		BondDenom:     sdk.DefaultBondDenom,
+		AccountTokens: sdk.NewDec(1000000),
+		MinGasPrices:  fmt.Sprintf("0.0000000001%s", sdk.DefaultBondDenom),
+		TimeoutCommit: time.Second,
+		GenesisState:  genesisState,
+		CleanupDir:    true,
+		Codec:         cdc,
+	}
+}
+
+// Validator is a validator of the network, running its own node and app in process. The EVM JSON-RPC is only
+// served by the first validator, as the backend of the RPC is global to the process.
+type Validator struct {
+	Moniker        string
+	Dir            string
+	NodeID         string
+	PubKey         crypto.PubKey        // consensus pubkey
+	Address        sdk.AccAddress       // address of the account the validator is created with
+	PrivKey        ethsecp256k1.PrivKey // key of the account, unlocked on the EVM JSON-RPC
+	Mnemonic       string
+	P2PAddress     string
+	RPCAddress     string // tendermint RPC address
+	JSONRPCAddress string // EVM JSON-RPC url, empty but on the first validator
+	ClientCtx      clientcontext.CLIContext
+
+	tmCfg   *tmcfg.Config
+	app     *app.OKExChainApp
+	tmNode  *node.Node
+	jsonRPC *http.Server
+}
+
+// Network is an in-process network of validators reaching consensus over localhost, for the integration tests
+// against real nodes. Only a single network can run at a time in a process, it must be cleaned up for the next one
+// to start.
+type Network struct {
+	T          *testing.T
+	BaseDir    string
+	Config     Config
+	Validators []*Validator
+
+	logger        log.Logger
+	kb            keys.Keybase
+	dynamicConfig tmcfg.IDynamicConfig
+}
+
+// New creates and starts a network of the configured number of validators, waiting for its first block
+func New(t *testing.T, cfg Config) *Network {
+	// only one network can run at a time
+	lock.Lock()
+
+	baseDir, err := ioutil.TempDir("", "exchain-network")
+	require.NoError(t, err)
+	t.Logf("created temporary directory: %s", baseDir)
+
+	network := &Network{
+		T:          t,
+		BaseDir:    baseDir,
+		Config:     cfg,
+		Validators: make([]*Validator, cfg.NumValidators),
+		logger:     log.NewNopLogger(),
+	}
+	if cfg.EnableLogging {
+		network.logger = log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	}
+
+	if err := network.start(); err != nil {
+		network.Cleanup()
+		require.NoError(t, err)
+	}
+	return network
+}
+
+// start initializes the validators and the genesis, and starts the network
+func (n *Network) start() error {
+	if err := setSharedHome(); err != nil {
+		return err
+	}
+	registerKeyTypes()
+	setModuleCodecs(n.Config.Codec.GetCdc())
+	n.dynamicConfig = tmcfg.DynamicConfig
+	tmcfg.SetDynamicConfig(dynamicConfig{timeoutCommit: n.Config.TimeoutCommit})
+
+	n.T.Log("preparing test network...")
+	if err := n.initValidators(); err != nil {
+		return err
+	}
+	if err := n.initGenFiles(); err != nil {
+		return err
+	}
+
+	n.T.Log("starting test network...")
+	for _, val := range n.Validators {
+		if err := n.startInProcess(val); err != nil {
+			return err
+		}
+	}
+	if err := n.startJSONRPC(n.Validators[0]); err != nil {
+		return err
+	}
+
+	n.T.Log("started test network")
+	return n.WaitForNextBlock()
+}
+
+// LatestHeight returns the latest height of the network, known by the first validator
+func (n *Network) LatestHeight() (int64, error) {
+	if len(n.Validators) == 0 {
+		return 0, errors.New("no validators available")
+	}
+
+	status, err := n.Validators[0].ClientCtx.Client.Status()
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// WaitForHeight waits for the network to reach the height, timing out after 10 blocks. It returns the latest height.
+func (n *Network) WaitForHeight(h int64) (int64, error) {
+	return n.WaitForHeightWithTimeout(h, 10*n.Config.TimeoutCommit+10*time.Second)
+}
+
+// WaitForHeightWithTimeout waits for the network to reach the height, timing out after the timeout. It returns the
+// latest height.
+func (n *Network) WaitForHeightWithTimeout(h int64, t time.Duration) (int64, error) {
+	ticker := time.NewTicker(n.Config.TimeoutCommit / 4)
+	defer ticker.Stop()
+	timeout := time.After(t)
+
+	var latestHeight int64
+	for {
+		select {
+		case <-timeout:
+			return latestHeight, fmt.Errorf("timeout exceeded waiting for block %d, latest %d", h, latestHeight)
+		case <-ticker.C:
+			height, err := n.LatestHeight()
+			if err == nil {
+				latestHeight = height
+			}
+			if latestHeight >= h {
+				return latestHeight, nil
+			}
+		}
+	}
+}
+
+// WaitForNextBlock waits for the network to commit the next block
+func (n *Network) WaitForNextBlock() error {
+	lastBlock, err := n.LatestHeight()
+	if err != nil {
+		return err
+	}
+
+	_, err = n.WaitForHeight(lastBlock + 1)
+	return err
+}
+
+// Cleanup stops the nodes of the network and removes their directories if configured, releasing the network for
+// the next one to start
+func (n *Network) Cleanup() {
+	defer lock.Unlock()
+
+	n.T.Log("cleaning up test network...")
+
+	for _, val := range n.Validators {
+		if val == nil {
+			continue
+		}
+		if val.jsonRPC != nil {
+			_ = val.jsonRPC.Shutdown(context.Background())
+		}
+		if val.tmNode != nil && val.tmNode.IsRunning() {
+			_ = val.tmNode.Stop()
+			val.tmNode.Wait()
+		}
+		if val.app != nil {
+			val.app.StopBaseApp()
+		}
+	}
+	rpc.CloseEthBackend()
+	if n.dynamicConfig != nil {
+		tmcfg.SetDynamicConfig(n.dynamicConfig)
+	}
+
+	if n.Config.CleanupDir {
+		_ = os.RemoveAll(n.BaseDir)
+	}
+
+	n.T.Log("finished cleaning up test network")
+}
+
+// nodeDir returns the home directory of the node of the validator i
+func (n *Network) nodeDir(i int) string {
+	return filepath.Join(n.BaseDir, fmt.Sprintf("node%d", i))
+}
@@ -0,0 +1,59 @@
+package network_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gorpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/testutil/network"
+)
+
+func TestNetwork(t *testing.T) {
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 2
+	n := network.New(t, cfg)
+	defer n.Cleanup()
+
+	height, err := n.WaitForHeightWithTimeout(3, time.Minute)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, height, int64(3))
+
+	client, err := gorpc.Dial(n.Validators[0].JSONRPCAddress)
+	require.NoError(t, err)
+	defer client.Close()
+
+	var chainID hexutil.Big
+	require.NoError(t, client.Call(&chainID, "eth_chainId"))
+	require.Equal(t, big.NewInt(67), chainID.ToInt())
+
+	// transfer from the first validator to the second one, through the EVM JSON-RPC of the first validator
+	from := ethcmn.BytesToAddress(n.Validators[0].Address)
+	to := ethcmn.BytesToAddress(n.Validators[1].Address)
+	var balanceBefore hexutil.Big
+	require.NoError(t, client.Call(&balanceBefore, "eth_getBalance", to, "latest"))
+
+	value := big.NewInt(1000)
+	var hash ethcmn.Hash
+	require.NoError(t, client.Call(&hash, "eth_sendTransaction", map[string]interface{}{
+		"from":  from,
+		"to":    to,
+		"value": (*hexutil.Big)(value),
+	}))
+
+	var receipt map[string]interface{}
+	for i := 0; i < 20 && receipt == nil; i++ {
+		require.NoError(t, n.WaitForNextBlock())
+		require.NoError(t, client.Call(&receipt, "eth_getTransactionReceipt", hash))
+	}
+	require.NotNil(t, receipt)
+	require.Equal(t, "0x1", receipt["status"])
+
+	var balanceAfter hexutil.Big
+	require.NoError(t, client.Call(&balanceAfter, "eth_getBalance", to, "latest"))
+	require.Equal(t, new(big.Int).Add(balanceBefore.ToInt(), value), balanceAfter.ToInt())
+}
@@ -0,0 +1,318 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	gorpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/spf13/viper"
+
+	"github.com/okex/exchain/app"
+	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+	"github.com/okex/exchain/app/crypto/hd"
+	"github.com/okex/exchain/app/rpc"
+	"github.com/okex/exchain/app/rpc/backend"
+	"github.com/okex/exchain/app/rpc/namespaces/eth"
+	ethermint "github.com/okex/exchain/app/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/baseapp"
+	clientcontext "github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	clientkeys "github.com/okex/exchain/libs/cosmos-sdk/client/keys"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/crypto/keys"
+	"github.com/okex/exchain/libs/cosmos-sdk/server"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	authexported "github.com/okex/exchain/libs/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	tmcfg "github.com/okex/exchain/libs/tendermint/config"
+	tmamino "github.com/okex/exchain/libs/tendermint/crypto/encoding/amino"
+	"github.com/okex/exchain/libs/tendermint/node"
+	"github.com/okex/exchain/libs/tendermint/p2p"
+	pvm "github.com/okex/exchain/libs/tendermint/privval"
+	"github.com/okex/exchain/libs/tendermint/proxy"
+	"github.com/okex/exchain/libs/tendermint/rpc/client/local"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	tmtime "github.com/okex/exchain/libs/tendermint/types/time"
+	dbm "github.com/okex/exchain/libs/tm-db"
+	"github.com/okex/exchain/x/evm/watcher"
+	"github.com/okex/exchain/x/genutil"
+	genutiltypes "github.com/okex/exchain/x/genutil/types"
+	stakingtypes "github.com/okex/exchain/x/staking/types"
+)
+
+var (
+	sharedDir     string
+	sharedDirOnce sync.Once
+)
+
+// setSharedHome sets the home of the process to a directory shared by all the networks, unless already set. The wasm
+// code and the mpt store are opened once in a process under its home, so they must outlive the network which opened
+// them.
+func setSharedHome() error {
+	var err error
+	sharedDirOnce.Do(func() {
+		if viper.GetString(flags.FlagHome) != "" {
+			return
+		}
+		if sharedDir, err = ioutil.TempDir("", "exchain-network-shared"); err == nil {
+			viper.Set(flags.FlagHome, sharedDir)
+		}
+	})
+	return err
+}
+
+// registerKeyTypes registers the eth keys of the validator accounts into the tendermint crypto codec, unless the
+// process already did
+func registerKeyTypes() {
+	if _, found := tmamino.PubkeyAminoName(nil, ethsecp256k1.PubKey{}); found {
+		return
+	}
+	tmamino.RegisterKeyType(ethsecp256k1.PubKey{}, ethsecp256k1.PubKeyName)
+	tmamino.RegisterKeyType(ethsecp256k1.PrivKey{}, ethsecp256k1.PrivKeyName)
+}
+
+// setModuleCodecs sets the codecs of the modules to the codec of the app, as the binary does, for the gentxs and the
+// keys of the validators to be decoded
+func setModuleCodecs(cdc *codec.Codec) {
+	keys.CryptoCdc = cdc
+	genutil.ModuleCdc = cdc
+	genutiltypes.ModuleCdc = cdc
+	clientkeys.KeysCdc = cdc
+}
+
+// dynamicConfig overrides the commit timeout of the dynamic config the consensus reads its timeouts from
+type dynamicConfig struct {
+	tmcfg.MockDynamicConfig
+	timeoutCommit time.Duration
+}
+
+func (c dynamicConfig) GetCsTimeoutCommit() time.Duration {
+	return c.timeoutCommit
+}
+
+// initValidators generates the node files and the account key of every validator
+func (n *Network) initValidators() error {
+	kb := keys.NewInMemory(hd.EthSecp256k1Options()...)
+
+	for i := range n.Validators {
+		moniker := fmt.Sprintf("node%d", i)
+		dir := n.nodeDir(i)
+		if err := os.MkdirAll(filepath.Join(dir, "config"), 0755); err != nil {
+			return err
+		}
+
+		_, p2pPort, err := server.FreeTCPAddr()
+		if err != nil {
+			return err
+		}
+		_, rpcPort, err := server.FreeTCPAddr()
+		if err != nil {
+			return err
+		}
+
+		tmCfg := tmcfg.DefaultConfig()
+		tmCfg.SetRoot(dir)
+		tmCfg.Moniker = moniker
+		tmCfg.P2P.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%s", p2pPort)
+		tmCfg.P2P.AddrBookStrict = false
+		tmCfg.P2P.AllowDuplicateIP = true
+		tmCfg.RPC.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%s", rpcPort)
+		// the metrics are registered once in a process
+		tmCfg.Instrumentation.Prometheus = false
+
+		nodeID, pubKey, err := genutil.InitializeNodeValidatorFiles(tmCfg)
+		if err != nil {
+			return err
+		}
+
+		info, mnemonic, err := kb.CreateMnemonic(moniker, keys.English, clientkeys.DefaultKeyPass, hd.EthSecp256k1, "")
+		if err != nil {
+			return err
+		}
+		privKey, err := kb.ExportPrivateKeyObject(moniker, clientkeys.DefaultKeyPass)
+		if err != nil {
+			return err
+		}
+
+		n.Validators[i] = &Validator{
+			Moniker:    moniker,
+			Dir:        dir,
+			NodeID:     nodeID,
+			PubKey:     pubKey,
+			Address:    info.GetAddress(),
+			PrivKey:    privKey.(ethsecp256k1.PrivKey),
+			Mnemonic:   mnemonic,
+			P2PAddress: fmt.Sprintf("127.0.0.1:%s", p2pPort),
+			RPCAddress: tmCfg.RPC.ListenAddress,
+			tmCfg:      tmCfg,
+		}
+	}
+
+	n.kb = kb
+	return nil
+}
+
+// initGenFiles writes the genesis of the network into the config of every node. The validator accounts are funded in
+// the genesis, and the validators are created by the gentxs the genesis is initialized with.
+func (n *Network) initGenFiles() error {
+	cdc := n.Config.Codec.GetCdc()
+
+	var (
+		genAccounts []authexported.GenesisAccount
+		genTxs      []json.RawMessage
+	)
+	for _, val := range n.Validators {
+		coins := sdk.NewCoins(sdk.NewDecCoinFromDec(n.Config.BondDenom, n.Config.AccountTokens))
+		genAccounts = append(genAccounts, ethermint.EthAccount{
+			BaseAccount: authtypes.NewBaseAccount(val.Address, coins, nil, 0, 0),
+			CodeHash:    ethcrypto.Keccak256(nil),
+		})
+
+		msg := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(val.Address), val.PubKey,
+			stakingtypes.NewDescription(val.Moniker, "", "", ""),
+			sdk.NewDecCoinFromDec(n.Config.BondDenom, stakingtypes.DefaultMinSelfDelegation),
+		)
+		memo := fmt.Sprintf("%s@%s", val.NodeID, val.P2PAddress)
+		tx := authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.StdFee{}, []authtypes.StdSignature{}, memo)
+		txBldr := authtypes.NewTxBuilder(nil, 0, 0, 0, 0, false, n.Config.ChainID, memo, nil, nil).WithKeybase(n.kb)
+
+		signedTx, err := txBldr.SignStdTx(val.Moniker, clientkeys.DefaultKeyPass, tx, false)
+		if err != nil {
+			return err
+		}
+		txBytes, err := cdc.MarshalJSON(signedTx)
+		if err != nil {
+			return err
+		}
+		genTxs = append(genTxs, txBytes)
+	}
+
+	appGenState := make(map[string]json.RawMessage, len(n.Config.GenesisState))
+	for module, state := range n.Config.GenesisState {
+		appGenState[module] = state
+	}
+
+	var authGenState authtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appGenState[authtypes.ModuleName], &authGenState); err != nil {
+		return err
+	}
+	authGenState.Accounts = append(authGenState.Accounts, genAccounts...)
+	appGenState[authtypes.ModuleName] = cdc.MustMarshalJSON(authGenState)
+	appGenState[genutil.ModuleName] = cdc.MustMarshalJSON(genutil.NewGenesisState(genTxs))
+
+	appState, err := codec.MarshalJSONIndent(cdc, appGenState)
+	if err != nil {
+		return err
+	}
+
+	genDoc := tmtypes.GenesisDoc{
+		ChainID:     n.Config.ChainID,
+		GenesisTime: tmtime.Now(),
+		AppState:    appState,
+	}
+	for _, val := range n.Validators {
+		if err := genDoc.SaveAs(val.tmCfg.GenesisFile()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startInProcess starts the node of the validator, peering with the other validators
+func (n *Network) startInProcess(val *Validator) error {
+	var peers []string
+	for _, other := range n.Validators {
+		if other != val {
+			peers = append(peers, fmt.Sprintf("%s@%s", other.NodeID, other.P2PAddress))
+		}
+	}
+	val.tmCfg.P2P.PersistentPeers = strings.Join(peers, ",")
+
+	logger := n.logger.With("validator", val.Moniker)
+	val.app = app.NewOKExChainApp(logger, dbm.NewMemDB(), nil, true, map[int64]bool{}, 0,
+		baseapp.SetMinGasPrices(n.Config.MinGasPrices))
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(val.tmCfg.NodeKeyFile())
+	if err != nil {
+		return err
+	}
+
+	tmNode, err := node.NewNode(
+		val.tmCfg,
+		pvm.LoadOrGenFilePV(val.tmCfg.PrivValidatorKeyFile(), val.tmCfg.PrivValidatorStateFile()),
+		nodeKey,
+		proxy.NewLocalClientCreator(val.app),
+		node.DefaultGenesisDocProviderFunc(val.tmCfg),
+		node.DefaultDBProvider,
+		node.DefaultMetricsProvider(val.tmCfg.Instrumentation),
+		logger.With("module", "node"),
+	)
+	if err != nil {
+		return err
+	}
+
+	val.app.SetOption(abci.RequestSetOption{
+		Key:   "CheckChainID",
+		Value: tmNode.ConsensusState().GetState().ChainID,
+	})
+	tmNode.Mempool().SetTxInfoParser(val.app)
+
+	if err := tmNode.Start(); err != nil {
+		return err
+	}
+	val.tmNode = tmNode
+
+	val.ClientCtx = clientcontext.NewCLIContext().
+		WithProxy(n.Config.Codec).
+		WithClient(local.New(tmNode)).
+		WithTrustNode(true).
+		WithChainID(n.Config.ChainID).
+		WithBroadcastMode(flags.BroadcastSync)
+	val.ClientCtx.Keybase = n.kb
+	return nil
+}
+
+// startJSONRPC serves the EVM JSON-RPC of the validator on a random local port, with the keys of all the validators
+// unlocked
+func (n *Network) startJSONRPC(val *Validator) error {
+	viper.SetDefault(backend.FlagApiBackendBlockLruCache, 100)
+	viper.SetDefault(backend.FlagApiBackendTxLruCache, 100)
+	viper.SetDefault(watcher.FlagFastQueryLru, 100)
+
+	privKeys := make([]ethsecp256k1.PrivKey, len(n.Validators))
+	for i, v := range n.Validators {
+		privKeys[i] = v.PrivKey
+	}
+
+	server := gorpc.NewServer()
+	for _, api := range rpc.GetAPIs(val.ClientCtx, n.logger.With("module", "json-rpc"), privKeys...) {
+		if err := server.RegisterName(api.Namespace, api.Service); err != nil {
+			return err
+		}
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	val.JSONRPCAddress = fmt.Sprintf("http://%s", listener.Addr())
+	val.jsonRPC = &http.Server{Handler: eth.WithAuthToken(server)}
+
+	go func() {
+		if err := val.jsonRPC.Serve(listener); err != nil && err != http.ErrServerClosed {
+			n.logger.Error("json-rpc server stopped", "err", err)
+		}
+	}()
+	return nil
+}
@@ -21,6 +21,7 @@ var (
 	NewQuerier           = keeper.NewQuerier
 	RegisterCodec        = types.RegisterCodec
 	NewMsgAddLiquidity   = types.NewMsgAddLiquidity
+	NewMsgMultiSwap      = types.NewMsgMultiSwap
 	GetSwapTokenPairName = types.GetSwapTokenPairName
 
 	// variable aliases
@@ -35,4 +36,5 @@ type (
 
 	// nolint
 	SwapTokenPair = types.SwapTokenPair
+	MsgMultiSwap  = types.MsgMultiSwap
 )
@@ -14,6 +14,8 @@ import (
 	"strings"
 )
 
+const flagMaxHops = "max-hops"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	// Group swap queries under a subcommand
@@ -32,6 +34,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdAllSwapTokenPairs(queryRoute, cdc),
 			GetCmdRedeemableAssets(queryRoute, cdc),
 			GetCmdQueryBuyAmount(queryRoute, cdc),
+			GetCmdQueryBestSwapRoute(queryRoute, cdc),
 		)...,
 	)
 
@@ -197,3 +200,41 @@ $ exchaincli query swap redeemable-assets eth xxb 1
 		},
 	}
 }
+
+// GetCmdQueryBestSwapRoute queries the route buying the most tokens by the given amount of token to sell
+func GetCmdQueryBestSwapRoute(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "route [token-to-sell] [token-name-to-buy]",
+		Short: "Query the best swap route and its quote by the given amount of token to sell",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the route through at most max-hops pools which returns the most token to buy.
+
+Example:
+$ %s query swap route 100eth-245 xxb --max-hops 3`, version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			maxHops, err := cmd.Flags().GetInt(flagMaxHops)
+			if err != nil {
+				return err
+			}
+			params := types.NewQueryBestSwapRouteParams(args[0], args[1], maxHops)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryBestSwapRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().Int(flagMaxHops, types.MaxSwapHops, "The max number of pools the route goes through")
+	return cmd
+}
@@ -30,6 +30,7 @@ const (
 	flagRecipient        = "recipient"
 	flagToken0           = "token0"
 	flagToken1           = "token1"
+	flagRouteTokens      = "route-tokens"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -44,6 +45,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		getCmdRemoveLiquidity(cdc),
 		getCmdCreateExchange(cdc),
 		getCmdTokenSwap(cdc),
+		getCmdMultiSwap(cdc),
 	)...)
 
 	return txCmd
@@ -257,3 +259,76 @@ $ exchaincli tx swap token --sell-amount 1eth-355 --min-buy-amount 60btc-366
 
 	return cmd
 }
+
+func getCmdMultiSwap(cdc *codec.Codec) *cobra.Command {
+	// flags
+	var soldTokenAmount string
+	var routeTokens string
+	var minBoughtTokenAmount string
+	var deadline string
+	var recipient string
+	cmd := &cobra.Command{
+		Use:   "multi-swap",
+		Short: "swap token through multiple pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`swap token through the pools between every two adjacent tokens of the route.
+
+Example:
+$ exchaincli tx swap multi-swap --sell-amount 1eth-355 --route-tokens okt,usdk-017 --min-buy-amount 60btc-366
+
+`),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			soldTokenAmount, err := sdk.ParseDecCoin(soldTokenAmount)
+			if err != nil {
+				return err
+			}
+			minBoughtTokenAmount, err := sdk.ParseDecCoin(minBoughtTokenAmount)
+			if err != nil {
+				return err
+			}
+			var route []string
+			if routeTokens != "" {
+				route = strings.Split(routeTokens, ",")
+			}
+			dur, err := time.ParseDuration(deadline)
+			if err != nil {
+				return err
+			}
+			deadline := time.Now().Add(dur).Unix()
+			var recip sdk.AccAddress
+			if recipient == "" {
+				recip = cliCtx.FromAddress
+			} else {
+				recip, err = sdk.AccAddressFromBech32(recipient)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgMultiSwap(soldTokenAmount, route, minBoughtTokenAmount,
+				deadline, recip, cliCtx.FromAddress)
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringVarP(&soldTokenAmount, flagSellAmount, "", "",
+		"Amount expected to sell")
+	cmd.Flags().StringVarP(&routeTokens, flagRouteTokens, "", "",
+		"Comma separated intermediate tokens the swap goes through, in order")
+	cmd.Flags().StringVarP(&minBoughtTokenAmount, flagMinBuyAmount, "", "",
+		"Minimum amount expected to buy")
+	cmd.Flags().StringVarP(&recipient, flagRecipient, "", "",
+		"The address to receive the amount bought")
+	cmd.Flags().StringVarP(&deadline, flagDeadlineDuration, "", "100s",
+		"Duration after which this transaction can no longer be executed. such as \"300ms\", \"1.5h\" or \"2h45m\". Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".")
+	cmd.MarkFlagRequired(flagSellAmount)
+	cmd.MarkFlagRequired(flagMinBuyAmount)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gorilla/mux"
@@ -20,6 +21,7 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/liquidity/add_quote/{token}", swapAddQuoteHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/liquidity/remove_quote/{token_pair}", queryRedeemableAssetsHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/quote/{token}", swapQuoteHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/route/{token}", swapRouteHandler(cliCtx)).Methods("GET")
 }
 
 func querySwapTokenPairHandler(cliContext context.CLIContext) func(http.ResponseWriter, *http.Request) {
@@ -105,6 +107,38 @@ func swapQuoteHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func swapRouteHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		buyToken := vars["token"]
+		sellTokenAmount := r.URL.Query().Get("sell_token_amount")
+		var maxHops int
+		if maxHopsStr := r.URL.Query().Get("max_hops"); maxHopsStr != "" {
+			var err error
+			if maxHops, err = strconv.Atoi(maxHopsStr); err != nil {
+				common.HandleErrorMsg(w, cliCtx, common.CodeStrconvFailed, err.Error())
+				return
+			}
+		}
+
+		params := types.NewQueryBestSwapRouteParams(sellTokenAmount, buyToken, maxHops)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeMarshalJSONFailed, err.Error())
+			return
+		}
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBestSwapRoute), bz)
+		if err != nil {
+			sdkErr := common.ParseSDKError(err.Error())
+			common.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func swapAddQuoteHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -1,6 +1,8 @@
 package ammswap
 
 import (
+	"strings"
+
 	"github.com/okex/exchain/x/ammswap/keeper"
 	"github.com/okex/exchain/x/ammswap/types"
 	"github.com/okex/exchain/x/common"
@@ -36,6 +38,11 @@ func NewHandler(k Keeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgTokenToToken(ctx, k, msg)
 			}
+		case types.MsgMultiSwap:
+			name = "handleMsgMultiSwap"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgMultiSwap(ctx, k, msg)
+			}
 		default:
 			return nil, types.ErrSwapUnknownMsgType()
 		}
@@ -328,6 +335,65 @@ func swapTokenByRouter(ctx sdk.Context, k Keeper, msg types.MsgTokenToToken) (*s
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgMultiSwap(ctx sdk.Context, k Keeper, msg types.MsgMultiSwap) (*sdk.Result, error) {
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+
+	if msg.Deadline < ctx.BlockTime().Unix() {
+		return types.ErrBlockTimeBigThanDeadline().Result()
+	}
+	if err := common.HasSufficientCoins(msg.Sender, k.GetTokenKeeper().GetCoins(ctx, msg.Sender),
+		sdk.SysCoins{msg.SoldTokenAmount}); err != nil {
+		return common.ErrInsufficientCoins(DefaultParamspace, err.Error()).Result()
+	}
+	path := msg.GetSwapPath()
+	swapTokenPairs, err := k.GetSwapTokenPairsOnPath(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	hopAmounts := keeper.CalculateTokenToBuyOnPath(swapTokenPairs, msg.SoldTokenAmount, path, params)
+	tokenBuy := hopAmounts[len(hopAmounts)-1]
+	if tokenBuy.IsZero() {
+		return types.ErrIsZeroValue("token to buy amount").Result()
+	}
+	if tokenBuy.Amount.LT(msg.MinBoughtTokenAmount.Amount) {
+		return types.ErrLessThan("token buy amount", "min bought token amount").Result()
+	}
+
+	// all the pools share the module account, so only the sold token and the token finally bought are transferred
+	err = k.SendCoinsToPool(ctx, sdk.SysCoins{msg.SoldTokenAmount}, msg.Sender)
+	if err != nil {
+		return types.ErrSendCoinsToPoolFailed(err.Error()).Result()
+	}
+	err = k.SendCoinsFromPoolToAccount(ctx, sdk.SysCoins{tokenBuy}, msg.Recipient)
+	if err != nil {
+		return types.ErrSendCoinsFromPoolToAccountFailed(err.Error()).Result()
+	}
+
+	// update swapTokenPairs hop by hop
+	sellToken := msg.SoldTokenAmount
+	for i, swapTokenPair := range swapTokenPairs {
+		hopTokenBuy := hopAmounts[i]
+		if hopTokenBuy.Denom < sellToken.Denom {
+			swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Add(sellToken)
+			swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Sub(hopTokenBuy)
+		} else {
+			swapTokenPair.QuotePooledCoin = swapTokenPair.QuotePooledCoin.Sub(hopTokenBuy)
+			swapTokenPair.BasePooledCoin = swapTokenPair.BasePooledCoin.Add(sellToken)
+		}
+		k.SetSwapTokenPair(ctx, swapTokenPair.TokenPairName(), swapTokenPair)
+		k.OnSwapToken(ctx, msg.Recipient, swapTokenPair, sellToken, hopTokenBuy)
+		sellToken = hopTokenBuy
+	}
+
+	event = event.AppendAttributes(sdk.NewAttribute("bought_token_amount", tokenBuy.String()))
+	event = event.AppendAttributes(sdk.NewAttribute("recipient", msg.Recipient.String()))
+	event = event.AppendAttributes(sdk.NewAttribute("route", strings.Join(path, ",")))
+	ctx.EventManager().EmitEvent(event)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func swapTokenNativeToken(
 	ctx sdk.Context, k Keeper, swapTokenPair SwapTokenPair, tokenBuy sdk.SysCoin,
 	msg types.MsgTokenToToken,
@@ -28,6 +28,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 			res, err = querySwapQuoteInfo(ctx, req, k)
 		case types.QuerySwapAddLiquidityQuote:
 			res, err = querySwapAddLiquidityQuote(ctx, req, k)
+		case types.QueryBestSwapRoute:
+			res, err = queryBestSwapRoute(ctx, req, k)
 
 		default:
 			return nil, types.ErrSwapUnknownQueryType()
@@ -328,3 +330,53 @@ func querySwapAddLiquidityQuote(ctx sdk.Context, req abci.RequestQuery, keeper K
 	return bz, nil
 
 }
+
+// queryBestSwapRoute returns the route buying the most tokens and the quote of it
+func queryBestSwapRoute(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var queryParams types.QueryBestSwapRouteParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &queryParams)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+	if queryParams.SellTokenAmount == "" || queryParams.BuyToken == "" {
+		return nil, types.ErrSellAmountOrBuyTokenIsEmpty()
+	}
+
+	sellAmount, err := sdk.ParseDecCoin(queryParams.SellTokenAmount)
+	if err != nil {
+		return nil, types.ErrConvertSellTokenAmount(queryParams.SellTokenAmount, err)
+	}
+	if sellAmount.Denom == queryParams.BuyToken {
+		return nil, types.ErrSellAmountEqualBuyToken()
+	}
+
+	maxHops := queryParams.MaxHops
+	if maxHops <= 0 || maxHops > types.MaxSwapHops {
+		maxHops = types.MaxSwapHops
+	}
+
+	path, hopAmounts, err := keeper.GetBestSwapRoute(ctx, sellAmount, queryParams.BuyToken, maxHops)
+	if err != nil {
+		return nil, err
+	}
+
+	buyAmount := hopAmounts[len(hopAmounts)-1].Amount
+	price := sdk.ZeroDec()
+	if sellAmount.Amount.IsPositive() {
+		price = buyAmount.Quo(sellAmount.Amount)
+	}
+
+	routeInfo := types.SwapRouteInfo{
+		Route:      path[1 : len(path)-1],
+		BuyAmount:  buyAmount,
+		Price:      price,
+		HopAmounts: hopAmounts,
+	}
+
+	response := common.GetBaseResponse(routeInfo)
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/ammswap/types"
+)
+
+// GetSwapTokenPairsOnPath returns the swap token pairs between every two adjacent tokens of the path
+func (k Keeper) GetSwapTokenPairsOnPath(ctx sdk.Context, path []string) ([]types.SwapTokenPair, error) {
+	swapTokenPairs := make([]types.SwapTokenPair, 0, len(path)-1)
+	for i := 0; i < len(path)-1; i++ {
+		tokenPairName := types.GetSwapTokenPairName(path[i], path[i+1])
+		swapTokenPair, err := k.GetSwapTokenPair(ctx, tokenPairName)
+		if err != nil {
+			return nil, err
+		}
+		if swapTokenPair.BasePooledCoin.IsZero() || swapTokenPair.QuotePooledCoin.IsZero() {
+			return nil, types.ErrIsZeroValue("base pooled coin or quote pooled coin")
+		}
+		swapTokenPairs = append(swapTokenPairs, swapTokenPair)
+	}
+	return swapTokenPairs, nil
+}
+
+// CalculateTokenToBuyOnPath returns the amount bought on every hop of the path, the last one is the amount finally bought
+func CalculateTokenToBuyOnPath(swapTokenPairs []types.SwapTokenPair, sellToken sdk.SysCoin, path []string,
+	params types.Params) sdk.SysCoins {
+	hopAmounts := make(sdk.SysCoins, 0, len(swapTokenPairs))
+	for i, swapTokenPair := range swapTokenPairs {
+		sellToken = CalculateTokenToBuy(swapTokenPair, sellToken, path[i+1], params)
+		hopAmounts = append(hopAmounts, sellToken)
+	}
+	return hopAmounts
+}
+
+// GetBestSwapRoute searches all the routes through at most maxHops pools and returns the one buying the most tokens
+func (k Keeper) GetBestSwapRoute(ctx sdk.Context, sellToken sdk.SysCoin, buyTokenDenom string,
+	maxHops int) (path []string, hopAmounts sdk.SysCoins, err error) {
+	// build the adjacency list of tokens by the pools with liquidity
+	pools := make(map[string][]types.SwapTokenPair)
+	for _, swapTokenPair := range k.GetSwapTokenPairs(ctx) {
+		if swapTokenPair.BasePooledCoin.IsZero() || swapTokenPair.QuotePooledCoin.IsZero() {
+			continue
+		}
+		baseDenom, quoteDenom := swapTokenPair.BasePooledCoin.Denom, swapTokenPair.QuotePooledCoin.Denom
+		pools[baseDenom] = append(pools[baseDenom], swapTokenPair)
+		pools[quoteDenom] = append(pools[quoteDenom], swapTokenPair)
+	}
+
+	params := k.GetParams(ctx)
+	bestAmount := sdk.ZeroDec()
+	visited := map[string]bool{sellToken.Denom: true}
+	curPath := []string{sellToken.Denom}
+	var curAmounts sdk.SysCoins
+
+	var search func(curToken sdk.SysCoin)
+	search = func(curToken sdk.SysCoin) {
+		if curToken.Denom == buyTokenDenom {
+			if curToken.Amount.GT(bestAmount) {
+				bestAmount = curToken.Amount
+				path = append([]string{}, curPath...)
+				hopAmounts = append(sdk.SysCoins{}, curAmounts...)
+			}
+			return
+		}
+		if len(curPath)-1 >= maxHops {
+			return
+		}
+		for _, swapTokenPair := range pools[curToken.Denom] {
+			nextDenom := swapTokenPair.BasePooledCoin.Denom
+			if nextDenom == curToken.Denom {
+				nextDenom = swapTokenPair.QuotePooledCoin.Denom
+			}
+			if visited[nextDenom] {
+				continue
+			}
+			nextToken := CalculateTokenToBuy(swapTokenPair, curToken, nextDenom, params)
+			if !nextToken.IsPositive() {
+				continue
+			}
+			visited[nextDenom] = true
+			curPath = append(curPath, nextDenom)
+			curAmounts = append(curAmounts, nextToken)
+			search(nextToken)
+			curPath = curPath[:len(curPath)-1]
+			curAmounts = curAmounts[:len(curAmounts)-1]
+			visited[nextDenom] = false
+		}
+	}
+	search(sellToken)
+
+	if path == nil {
+		return nil, nil, types.ErrNoSwapRoute(sellToken.Denom, buyTokenDenom)
+	}
+	return path, hopAmounts, nil
+}
@@ -21,6 +21,7 @@ const (
 	QueryBuyAmount             = "buy"
 	QuerySwapQuoteInfo         = "swapQuoteInfo"
 	QuerySwapAddLiquidityQuote = "swapAddLiquidityQuote"
+	QueryBestSwapRoute         = "bestSwapRoute"
 )
 
 var (
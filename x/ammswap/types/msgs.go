@@ -8,8 +8,12 @@ import (
 const (
 	TypeMsgAddLiquidity = "add_liquidity"
 	TypeMsgTokenSwap    = "token_swap"
+	TypeMsgMultiSwap    = "multi_swap"
 )
 
+// MaxSwapHops defines the max number of pools a multi-hop swap can go through
+const MaxSwapHops = 4
+
 // MsgAddLiquidity Deposit quote_amount and base_amount at current ratio to mint pool tokens.
 type MsgAddLiquidity struct {
 	MinLiquidity  sdk.Dec        `json:"min_liquidity"`   // Minimum number of sender will mint if total pool token supply is greater than 0.
@@ -265,3 +269,75 @@ func (msg MsgTokenToToken) GetSigners() []sdk.AccAddress {
 func (msg MsgTokenToToken) GetSwapTokenPairName() string {
 	return GetSwapTokenPairName(msg.MinBoughtTokenAmount.Denom, msg.SoldTokenAmount.Denom)
 }
+
+// MsgMultiSwap define the message for swap between tokens through the pools on the route
+type MsgMultiSwap struct {
+	SoldTokenAmount      sdk.SysCoin    `json:"sold_token_amount"`       // Amount of Tokens sold.
+	RouteTokens          []string       `json:"route_tokens"`            // Intermediate tokens the swap goes through, in order.
+	MinBoughtTokenAmount sdk.SysCoin    `json:"min_bought_token_amount"` // Minimum token purchased.
+	Deadline             int64          `json:"deadline"`                // Time after which this transaction can no longer be executed.
+	Recipient            sdk.AccAddress `json:"recipient"`               // Recipient address,transfer Tokens to recipient.default recipient is sender.
+	Sender               sdk.AccAddress `json:"sender"`                  // Sender
+}
+
+// NewMsgMultiSwap is a constructor function for MsgMultiSwap
+func NewMsgMultiSwap(
+	soldTokenAmount sdk.SysCoin, routeTokens []string, minBoughtTokenAmount sdk.SysCoin, deadline int64, recipient, sender sdk.AccAddress,
+) MsgMultiSwap {
+	return MsgMultiSwap{
+		SoldTokenAmount:      soldTokenAmount,
+		RouteTokens:          routeTokens,
+		MinBoughtTokenAmount: minBoughtTokenAmount,
+		Deadline:             deadline,
+		Recipient:            recipient,
+		Sender:               sender,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgMultiSwap) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgMultiSwap) Type() string { return TypeMsgMultiSwap }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgMultiSwap) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrAddressIsRequire("sender")
+	}
+
+	if msg.Recipient.Empty() {
+		return ErrAddressIsRequire("recipient")
+	}
+
+	if !(msg.SoldTokenAmount.IsPositive()) {
+		return ErrSoldTokenAmountIsNegative()
+	}
+	if !msg.SoldTokenAmount.IsValid() {
+		return ErrSoldTokenAmount()
+	}
+
+	if !msg.MinBoughtTokenAmount.IsValid() {
+		return ErrMinBoughtTokenAmount()
+	}
+
+	return ValidateSwapPath(msg.GetSwapPath())
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMultiSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMultiSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// GetSwapPath returns all the tokens the swap goes through, from the sold token to the bought token
+func (msg MsgMultiSwap) GetSwapPath() []string {
+	path := make([]string, 0, len(msg.RouteTokens)+2)
+	path = append(path, msg.SoldTokenAmount.Denom)
+	path = append(path, msg.RouteTokens...)
+	return append(path, msg.MinBoughtTokenAmount.Denom)
+}
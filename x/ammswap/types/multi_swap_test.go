@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgMultiSwapValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("testMultiSwapAddress"))
+	soldTokenAmount := sdk.NewDecCoinFromDec("xxb", sdk.NewDec(10))
+	minBoughtTokenAmount := sdk.NewDecCoinFromDec("yyb", sdk.NewDec(1))
+
+	tests := []struct {
+		name  string
+		msg   MsgMultiSwap
+		valid bool
+	}{
+		{"direct", NewMsgMultiSwap(soldTokenAmount, nil, minBoughtTokenAmount, 0, addr, addr), true},
+		{"two hops", NewMsgMultiSwap(soldTokenAmount, []string{"okt"}, minBoughtTokenAmount, 0, addr, addr), true},
+		{"max hops", NewMsgMultiSwap(soldTokenAmount, []string{"aab", "bbb", "ccb"}, minBoughtTokenAmount, 0, addr, addr), true},
+		{"too many hops", NewMsgMultiSwap(soldTokenAmount, []string{"aab", "bbb", "ccb", "ddb"}, minBoughtTokenAmount, 0, addr, addr), false},
+		{"repeated token", NewMsgMultiSwap(soldTokenAmount, []string{"okt", "xxb"}, minBoughtTokenAmount, 0, addr, addr), false},
+		{"same sold and bought token", NewMsgMultiSwap(soldTokenAmount, []string{"okt"}, sdk.NewDecCoinFromDec("xxb", sdk.NewDec(1)), 0, addr, addr), false},
+		{"pool token on route", NewMsgMultiSwap(soldTokenAmount, []string{GetPoolTokenName("okt", "xxb")}, minBoughtTokenAmount, 0, addr, addr), false},
+		{"zero sold amount", NewMsgMultiSwap(sdk.NewDecCoinFromDec("xxb", sdk.ZeroDec()), nil, minBoughtTokenAmount, 0, addr, addr), false},
+		{"empty sender", NewMsgMultiSwap(soldTokenAmount, nil, minBoughtTokenAmount, 0, addr, nil), false},
+		{"empty recipient", NewMsgMultiSwap(soldTokenAmount, nil, minBoughtTokenAmount, 0, nil, addr), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, tc.name)
+		} else {
+			require.NotNil(t, err, tc.name)
+		}
+	}
+
+	msg := NewMsgMultiSwap(soldTokenAmount, []string{"okt"}, minBoughtTokenAmount, 0, addr, addr)
+	require.Equal(t, []string{"xxb", "okt", "yyb"}, msg.GetSwapPath())
+	require.Equal(t, TypeMsgMultiSwap, msg.Type())
+	require.Equal(t, RouterKey, msg.Route())
+}
@@ -40,6 +40,30 @@ type SwapBuyInfo struct {
 	Route       string  `json:"route"`
 }
 
+// nolint
+type QueryBestSwapRouteParams struct {
+	SellTokenAmount string `json:"sell_token_amount"`
+	BuyToken        string `json:"buy_token"`
+	MaxHops         int    `json:"max_hops"`
+}
+
+// NewQueryBestSwapRouteParams creates a new instance of QueryBestSwapRouteParams
+func NewQueryBestSwapRouteParams(sellTokenAmount string, buyToken string, maxHops int) QueryBestSwapRouteParams {
+	return QueryBestSwapRouteParams{
+		SellTokenAmount: sellTokenAmount,
+		BuyToken:        buyToken,
+		MaxHops:         maxHops,
+	}
+}
+
+// SwapRouteInfo defines the best route and the quote of a multi-hop swap
+type SwapRouteInfo struct {
+	Route      []string     `json:"route"`
+	BuyAmount  sdk.Dec      `json:"buy_amount"`
+	Price      sdk.Dec      `json:"price"`
+	HopAmounts sdk.SysCoins `json:"hop_amounts"`
+}
+
 type SwapAddInfo struct {
 	BaseTokenAmount sdk.Dec `json:"base_token_amount"`
 	PoolShare       sdk.Dec `json:"pool_share"`
@@ -104,6 +104,25 @@ func ValidateSwapAmountName(amountName string) error {
 	return nil
 }
 
+// ValidateSwapPath checks the swap path goes through at least one and at most MaxSwapHops pools without visiting
+// a token twice
+func ValidateSwapPath(path []string) error {
+	if len(path) < 2 || len(path)-1 > MaxSwapHops {
+		return ErrInvalidSwapRoute(fmt.Sprintf("the number of pools on the route should be in [1, %d]", MaxSwapHops))
+	}
+	visited := make(map[string]bool, len(path))
+	for _, tokenName := range path {
+		if err := ValidateSwapAmountName(tokenName); err != nil {
+			return err
+		}
+		if visited[tokenName] {
+			return ErrInvalidSwapRoute(fmt.Sprintf("token %s appears more than once on the route", tokenName))
+		}
+		visited[tokenName] = true
+	}
+	return nil
+}
+
 func GetPoolTokenName(token1, token2 string) string {
 	return PoolTokenPrefix + GetSwapTokenPairName(token1, token2)
 }
@@ -0,0 +1,21 @@
+package atomicswap
+
+import (
+	"github.com/okex/exchain/x/atomicswap/keeper"
+	"github.com/okex/exchain/x/atomicswap/types"
+)
+
+const (
+	ModuleName   = types.ModuleName
+	StoreKey     = types.StoreKey
+	RouterKey    = types.RouterKey
+	QuerierRoute = types.QuerierRoute
+)
+
+var (
+	NewKeeper = keeper.NewKeeper
+)
+
+type (
+	Keeper = keeper.Keeper
+)
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/x/atomicswap/types"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	// Group atomicswap queries under a subcommand
+	swapQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	swapQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQuerySwap(queryRoute, cdc),
+			GetCmdQuerySwaps(queryRoute, cdc),
+		)...,
+	)
+
+	return swapQueryCmd
+}
+
+// GetCmdQuerySwap gets the swap query command.
+func GetCmdQuerySwap(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap [swap-id]",
+		Short: "query an open swap",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the maker, the offer, the ask and the expire height of an open swap.
+
+Example:
+$ %s query atomicswap swap 1
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			swapID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("swap id %s not a valid uint, please input a valid swap id", args[0])
+			}
+
+			bytes, err := cdc.MarshalJSON(types.NewQuerySwapParams(swapID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySwap)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var swap types.Swap
+			cdc.MustUnmarshalJSON(resp, &swap)
+			return cliCtx.PrintOutput(swap)
+		},
+	}
+}
+
+// GetCmdQuerySwaps gets the open swaps query command.
+func GetCmdQuerySwaps(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "swaps",
+		Short: "query all the open swaps",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the swaps which are neither taken nor canceled.
+
+Example:
+$ %s query atomicswap swaps
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySwaps)
+			resp, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var swaps types.Swaps
+			cdc.MustUnmarshalJSON(resp, &swaps)
+			return cliCtx.PrintOutput(swaps)
+		},
+	}
+}
@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	"github.com/okex/exchain/x/atomicswap/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagTaker        = "taker"
+	flagExpireHeight = "expire-height"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	swapTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		SuggestionsMinimumDistance: 2,
+	}
+
+	swapTxCmd.AddCommand(client.PostCommands(
+		GetCmdCreateSwap(cdc),
+		GetCmdTakeSwap(cdc),
+		GetCmdCancelSwap(cdc),
+	)...)
+	return swapTxCmd
+}
+
+// GetCmdCreateSwap gets the create swap command
+func GetCmdCreateSwap(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [offer] [ask]",
+		Short: "escrow an offer and open a swap for an ask",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Escrow the offer in the atomicswap module and open a swap paying it to whoever pays the ask.
+The assets are given as type:token:amount, where the type is native, erc20 or cw20, the token is the native denom,
+the hex address of the erc20 contract or the bech32 address of the cw20 contract, and the amount is the integer
+amount of the token. The amount of a native denom is in its smallest unit of 10^-18. The erc20 and cw20 offers must
+be approved to the atomicswap module account first.
+
+Example:
+$ %s tx atomicswap create erc20:0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed:1000 native:okt:1000000000000000000 --expire-height 100000 --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			offer, err := parseAsset(args[0])
+			if err != nil {
+				return err
+			}
+			ask, err := parseAsset(args[1])
+			if err != nil {
+				return err
+			}
+
+			var taker sdk.AccAddress
+			if s := viper.GetString(flagTaker); s != "" {
+				if taker, err = sdk.AccAddressFromBech32(s); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgCreateSwap(cliCtx.GetFromAddress(), taker, offer, ask, viper.GetInt64(flagExpireHeight))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagTaker, "", "the only account allowed to take the swap, anyone can take it if it's empty")
+	cmd.Flags().Int64(flagExpireHeight, 0, "the last height the swap can be taken at")
+	return cmd
+}
+
+// GetCmdTakeSwap gets the take swap command
+func GetCmdTakeSwap(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "take [swap-id]",
+		Short: "pay the ask of a swap and receive its offer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pay the ask of a swap to its maker and receive its escrowed offer in the same tx. An erc20 or
+cw20 ask must be approved to the atomicswap module account first.
+
+Example:
+$ %s tx atomicswap take 1 --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			swapID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("swap id %s not a valid uint, please input a valid swap id", args[0])
+			}
+
+			msg := types.NewMsgTakeSwap(cliCtx.GetFromAddress(), swapID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdCancelSwap gets the cancel swap command
+func GetCmdCancelSwap(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [swap-id]",
+		Short: "cancel a swap and refund its offer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a swap made by the sender and refund its escrowed offer.
+
+Example:
+$ %s tx atomicswap cancel 1 --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			swapID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("swap id %s not a valid uint, please input a valid swap id", args[0])
+			}
+
+			msg := types.NewMsgCancelSwap(cliCtx.GetFromAddress(), swapID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// parseAsset parses an asset given as type:token:amount
+func parseAsset(s string) (types.Asset, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return types.Asset{}, fmt.Errorf("asset %s should be type:token:amount", s)
+	}
+	amount, ok := sdk.NewIntFromString(parts[2])
+	if !ok {
+		return types.Asset{}, fmt.Errorf("invalid amount %s", parts[2])
+	}
+	asset := types.NewAsset(parts[0], parts[1], amount)
+	return asset, asset.ValidateBasic()
+}
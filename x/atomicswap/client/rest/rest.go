@@ -0,0 +1,71 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/rest"
+	"github.com/okex/exchain/x/atomicswap/types"
+	"github.com/okex/exchain/x/common"
+)
+
+// RegisterRoutes registers atomicswap-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// get an open swap
+	r.HandleFunc(
+		"/atomicswap/swaps/{swapID}",
+		querySwapHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get all the open swaps
+	r.HandleFunc(
+		"/atomicswap/swaps",
+		querySwapsHandlerFn(cliCtx),
+	).Methods("GET")
+}
+
+func querySwapHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		swapID, err := strconv.ParseUint(mux.Vars(r)["swapID"], 10, 64)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeStrconvFailed, err.Error())
+			return
+		}
+		queryWithParams(w, r, cliCtx, types.QuerySwap, types.NewQuerySwapParams(swapID))
+	}
+}
+
+func querySwapsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		queryWithParams(w, r, cliCtx, types.QuerySwaps, nil)
+	}
+}
+
+func queryWithParams(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, path string, params interface{}) {
+	cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+	if !ok {
+		return
+	}
+
+	var jsonBytes []byte
+	if params != nil {
+		var err error
+		if jsonBytes, err = cliCtx.Codec.MarshalJSON(params); err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorCodecFails)
+			return
+		}
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+	res, height, err := cliCtx.QueryWithData(route, jsonBytes)
+	if err != nil {
+		common.HandleErrorResponseV2(w, http.StatusInternalServerError, common.ErrorABCIQueryFails)
+		return
+	}
+
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, res)
+}
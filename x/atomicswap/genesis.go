@@ -0,0 +1,28 @@
+package atomicswap
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/atomicswap/keeper"
+	"github.com/okex/exchain/x/atomicswap/types"
+)
+
+// InitGenesis initializes the atomicswap module's state from a given genesis state
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	// if module account doesn't exist, it will create automatically
+	moduleAcc := k.SupplyKeeper().GetModuleAccount(ctx, types.ModuleName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	k.SetNextSwapID(ctx, data.NextSwapID)
+	for _, swap := range data.Swaps {
+		k.SetSwap(ctx, swap)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetNextSwapID(ctx), k.GetSwaps(ctx))
+}
@@ -0,0 +1,102 @@
+package atomicswap
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/atomicswap/keeper"
+	"github.com/okex/exchain/x/atomicswap/types"
+)
+
+// NewHandler creates an sdk.Handler for all the atomicswap type messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx.SetEventManager(sdk.NewEventManager())
+
+		if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+			return nil, types.ErrAtomicSwapNotSupported(ctx.BlockHeight())
+		}
+
+		switch msg := msg.(type) {
+		case types.MsgCreateSwap:
+			return handleMsgCreateSwap(ctx, k, msg)
+		case types.MsgTakeSwap:
+			return handleMsgTakeSwap(ctx, k, msg)
+		case types.MsgCancelSwap:
+			return handleMsgCancelSwap(ctx, k, msg)
+		default:
+			return nil, types.ErrUnknownAtomicSwapMsgType(fmt.Sprintf("%T", msg))
+		}
+	}
+}
+
+func handleMsgCreateSwap(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateSwap) (*sdk.Result, error) {
+	swap, err := k.CreateSwap(ctx, msg.Maker, msg.Taker, msg.Offer, msg.Ask, msg.ExpireHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateSwap,
+			sdk.NewAttribute(types.AttributeKeySwapID, strconv.FormatUint(swap.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyMaker, swap.Maker.String()),
+			sdk.NewAttribute(types.AttributeKeyOffer, swap.Offer.String()),
+			sdk.NewAttribute(types.AttributeKeyAsk, swap.Ask.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Maker.String()),
+		),
+	})
+	return &sdk.Result{Data: sdk.Uint64ToBigEndian(swap.ID), Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgTakeSwap(ctx sdk.Context, k keeper.Keeper, msg types.MsgTakeSwap) (*sdk.Result, error) {
+	swap, err := k.TakeSwap(ctx, msg.Taker, msg.SwapID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTakeSwap,
+			sdk.NewAttribute(types.AttributeKeySwapID, strconv.FormatUint(swap.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyMaker, swap.Maker.String()),
+			sdk.NewAttribute(types.AttributeKeyTaker, msg.Taker.String()),
+			sdk.NewAttribute(types.AttributeKeyOffer, swap.Offer.String()),
+			sdk.NewAttribute(types.AttributeKeyAsk, swap.Ask.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Taker.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelSwap(ctx sdk.Context, k keeper.Keeper, msg types.MsgCancelSwap) (*sdk.Result, error) {
+	swap, err := k.CancelSwap(ctx, msg.Maker, msg.SwapID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelSwap,
+			sdk.NewAttribute(types.AttributeKeySwapID, strconv.FormatUint(swap.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyMaker, swap.Maker.String()),
+			sdk.NewAttribute(types.AttributeKeyOffer, swap.Offer.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Maker.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
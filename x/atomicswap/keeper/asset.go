@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/atomicswap/types"
+)
+
+const (
+	erc20TransferMethod     = "transfer"
+	erc20TransferFromMethod = "transferFrom"
+	erc20ABIJSON            = `[
+{"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},
+{"inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transferFrom","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}
+]`
+)
+
+var erc20ABI abi.ABI
+
+func init() {
+	var err error
+	if erc20ABI, err = abi.JSON(strings.NewReader(erc20ABIJSON)); err != nil {
+		panic(err)
+	}
+}
+
+// cw20ExecuteMsg is the execute msg of the cw20 transfers
+type cw20ExecuteMsg struct {
+	Transfer     *cw20Transfer     `json:"transfer,omitempty"`
+	TransferFrom *cw20TransferFrom `json:"transfer_from,omitempty"`
+}
+
+type cw20Transfer struct {
+	Recipient string `json:"recipient"`
+	Amount    string `json:"amount"`
+}
+
+type cw20TransferFrom struct {
+	Owner     string `json:"owner"`
+	Recipient string `json:"recipient"`
+	Amount    string `json:"amount"`
+}
+
+// moduleAddress returns the address of the module account escrowing the offers, the account is created if it doesn't
+// exist since the evm and the wasm calls are sent from it
+func (k Keeper) moduleAddress(ctx sdk.Context) sdk.AccAddress {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+}
+
+// escrow moves the asset from the account to the module account. The erc20 and cw20 tokens are moved with the
+// allowance the account approved to the module account.
+func (k Keeper) escrow(ctx sdk.Context, from sdk.AccAddress, asset types.Asset) error {
+	if asset.Type == types.AssetTypeNative {
+		if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, asset.Coins()); err != nil {
+			return types.ErrTransferFailed(asset.String(), err.Error())
+		}
+		return nil
+	}
+	moduleAddr := k.moduleAddress(ctx)
+	return k.transferFrom(ctx, moduleAddr, from, moduleAddr, asset)
+}
+
+// release moves the asset from the module account to the account
+func (k Keeper) release(ctx sdk.Context, to sdk.AccAddress, asset types.Asset) error {
+	switch asset.Type {
+	case types.AssetTypeNative:
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, asset.Coins()); err != nil {
+			return types.ErrTransferFailed(asset.String(), err.Error())
+		}
+		return nil
+	case types.AssetTypeERC20:
+		input, err := erc20ABI.Pack(erc20TransferMethod, common.BytesToAddress(to), asset.Amount.BigInt())
+		if err != nil {
+			return types.ErrTransferFailed(asset.String(), err.Error())
+		}
+		return k.callERC20(ctx, k.moduleAddress(ctx), asset, input)
+	default:
+		msg := cw20ExecuteMsg{Transfer: &cw20Transfer{Recipient: to.String(), Amount: asset.Amount.String()}}
+		return k.callCW20(ctx, k.moduleAddress(ctx), asset, msg)
+	}
+}
+
+// pay moves the asset from an account to another. The erc20 and cw20 tokens are moved with the allowance the payer
+// approved to the module account.
+func (k Keeper) pay(ctx sdk.Context, from, to sdk.AccAddress, asset types.Asset) error {
+	if asset.Type == types.AssetTypeNative {
+		if err := k.bankKeeper.SendCoins(ctx, from, to, asset.Coins()); err != nil {
+			return types.ErrTransferFailed(asset.String(), err.Error())
+		}
+		return nil
+	}
+	return k.transferFrom(ctx, k.moduleAddress(ctx), from, to, asset)
+}
+
+// transferFrom moves the erc20 or cw20 tokens from an account to another with the allowance of the spender
+func (k Keeper) transferFrom(ctx sdk.Context, spender, from, to sdk.AccAddress, asset types.Asset) error {
+	if asset.Type == types.AssetTypeERC20 {
+		input, err := erc20ABI.Pack(erc20TransferFromMethod, common.BytesToAddress(from), common.BytesToAddress(to),
+			asset.Amount.BigInt())
+		if err != nil {
+			return types.ErrTransferFailed(asset.String(), err.Error())
+		}
+		return k.callERC20(ctx, spender, asset, input)
+	}
+	msg := cw20ExecuteMsg{TransferFrom: &cw20TransferFrom{Owner: from.String(), Recipient: to.String(), Amount: asset.Amount.String()}}
+	return k.callCW20(ctx, spender, asset, msg)
+}
+
+// callERC20 calls the erc20 contract of the asset, the call fails if the contract returns false. The tokens returning
+// nothing are accepted as the call doesn't revert.
+func (k Keeper) callERC20(ctx sdk.Context, caller sdk.AccAddress, asset types.Asset, input []byte) error {
+	contract := common.HexToAddress(asset.Token)
+	_, result, err := k.vmbridgeKeeper.CallEvmFrom(ctx, common.BytesToAddress(caller), &contract, big.NewInt(0), input)
+	if err != nil {
+		return types.ErrTransferFailed(asset.String(), err.Error())
+	}
+	if len(result.Ret) == 0 {
+		return nil
+	}
+	var ok bool
+	if err := erc20ABI.UnpackIntoInterface(&ok, erc20TransferMethod, result.Ret); err != nil || !ok {
+		return types.ErrTransferFailed(asset.String(), "the erc20 contract returns false")
+	}
+	return nil
+}
+
+// callCW20 executes the cw20 contract of the asset
+func (k Keeper) callCW20(ctx sdk.Context, caller sdk.AccAddress, asset types.Asset, msg cw20ExecuteMsg) error {
+	contract, err := sdk.AccAddressFromBech32(asset.Token)
+	if err != nil {
+		return types.ErrTransferFailed(asset.String(), err.Error())
+	}
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return types.ErrTransferFailed(asset.String(), err.Error())
+	}
+	if _, err := k.vmbridgeKeeper.CallWasm(ctx, caller, contract, bz); err != nil {
+		return types.ErrTransferFailed(asset.String(), err.Error())
+	}
+	return nil
+}
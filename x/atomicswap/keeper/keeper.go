@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/x/atomicswap/types"
+)
+
+// Keeper of the atomicswap store
+type Keeper struct {
+	storeKey       sdk.StoreKey
+	cdc            *codec.Codec
+	supplyKeeper   types.SupplyKeeper
+	bankKeeper     types.BankKeeper
+	vmbridgeKeeper types.VMBridgeKeeper
+}
+
+// NewKeeper creates an atomicswap keeper
+func NewKeeper(supplyKeeper types.SupplyKeeper, bankKeeper types.BankKeeper, vmbridgeKeeper types.VMBridgeKeeper,
+	key sdk.StoreKey, cdc *codec.Codec) Keeper {
+	return Keeper{
+		storeKey:       key,
+		cdc:            cdc,
+		supplyKeeper:   supplyKeeper,
+		bankKeeper:     bankKeeper,
+		vmbridgeKeeper: vmbridgeKeeper,
+	}
+}
+
+// SupplyKeeper returns the supply keeper
+func (k Keeper) SupplyKeeper() types.SupplyKeeper {
+	return k.supplyKeeper
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetNextSwapID gets the id of the next created swap
+func (k Keeper) GetNextSwapID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextSwapIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextSwapID sets the id of the next created swap
+func (k Keeper) SetNextSwapID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextSwapIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetSwap gets a swap from store
+func (k Keeper) GetSwap(ctx sdk.Context, id uint64) (swap types.Swap, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSwapKey(id))
+	if bz == nil {
+		return swap, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &swap)
+	return swap, true
+}
+
+// SetSwap sets a swap into store
+func (k Keeper) SetSwap(ctx sdk.Context, swap types.Swap) {
+	ctx.KVStore(k.storeKey).Set(types.GetSwapKey(swap.ID), k.cdc.MustMarshalBinaryLengthPrefixed(swap))
+}
+
+// DeleteSwap deletes a swap from store
+func (k Keeper) DeleteSwap(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.GetSwapKey(id))
+}
+
+// IterateSwaps iterates over all the open swaps in the order of their ids
+func (k Keeper) IterateSwaps(ctx sdk.Context, handler func(swap types.Swap) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SwapPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var swap types.Swap
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &swap)
+		if handler(swap) {
+			break
+		}
+	}
+}
+
+// GetSwaps gets all the open swaps
+func (k Keeper) GetSwaps(ctx sdk.Context) (swaps types.Swaps) {
+	k.IterateSwaps(ctx, func(swap types.Swap) bool {
+		swaps = append(swaps, swap)
+		return false
+	})
+	return
+}
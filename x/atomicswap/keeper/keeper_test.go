@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
+	"github.com/okex/exchain/x/atomicswap/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+type contractCall struct {
+	caller   string
+	contract string
+	input    []byte
+}
+
+// mockVMBridgeKeeper records the contract calls, the erc20 calls return the encoded ret
+type mockVMBridgeKeeper struct {
+	calls []contractCall
+	ret   []byte
+}
+
+func (m *mockVMBridgeKeeper) CallEvmFrom(_ sdk.Context, callerAddr common.Address, to *common.Address, _ *big.Int, data []byte) (*evmtypes.ExecutionResult, *evmtypes.ResultData, error) {
+	m.calls = append(m.calls, contractCall{callerAddr.String(), to.String(), data})
+	return &evmtypes.ExecutionResult{}, &evmtypes.ResultData{Ret: m.ret}, nil
+}
+
+func (m *mockVMBridgeKeeper) CallWasm(_ sdk.Context, caller, contractAddr sdk.AccAddress, msg []byte) ([]byte, error) {
+	m.calls = append(m.calls, contractCall{caller.String(), contractAddr.String(), msg})
+	return nil, nil
+}
+
+func createTestInputWithVMBridge(t *testing.T) (sdk.Context, Keeper, *mockSupplyKeeper, *mockVMBridgeKeeper) {
+	vk := &mockVMBridgeKeeper{ret: common.BigToHash(big.NewInt(1)).Bytes()}
+	ctx, k, sk := createTestInput(t, vk)
+	return ctx, k, sk, vk
+}
+
+func TestSwapNativeForERC20(t *testing.T) {
+	ctx, k, sk, vk := createTestInputWithVMBridge(t)
+	maker, taker := sdk.AccAddress(common.HexToAddress("0x1").Bytes()), sdk.AccAddress(common.HexToAddress("0x2").Bytes())
+	moduleAddr := supply.NewModuleAddress(types.ModuleName)
+	offer := types.NewAsset(types.AssetTypeNative, sdk.DefaultBondDenom, sdk.NewInt(1500000000000000000))
+	ask := types.NewAsset(types.AssetTypeERC20, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", sdk.NewInt(100))
+	sk.balances[maker.String()] = sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDec(2))
+
+	_, err := k.CreateSwap(ctx, maker, taker, offer, ask, ctx.BlockHeight())
+	require.Error(t, err)
+	swap, err := k.CreateSwap(ctx, maker, taker, offer, ask, 20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), swap.ID)
+	require.Equal(t, uint64(2), k.GetNextSwapID(ctx))
+	require.Equal(t, offer.Coins(), sk.balances[moduleAddr.String()])
+	require.Len(t, k.GetSwaps(ctx), 1)
+
+	// only the taker of the swap takes it, and only its maker cancels it
+	_, err = k.TakeSwap(ctx, maker, swap.ID)
+	require.Error(t, err)
+	_, err = k.CancelSwap(ctx, taker, swap.ID)
+	require.Error(t, err)
+
+	// the erc20 contract returning false fails the swap
+	vk.ret = common.Hash{}.Bytes()
+	_, err = k.TakeSwap(ctx, taker, swap.ID)
+	require.Error(t, err)
+	vk.ret = nil
+	vk.calls = nil
+
+	_, err = k.TakeSwap(ctx, taker, swap.ID)
+	require.NoError(t, err)
+	require.Len(t, vk.calls, 1)
+	require.Equal(t, common.BytesToAddress(moduleAddr).String(), vk.calls[0].caller)
+	require.Equal(t, ask.Token, vk.calls[0].contract)
+	input, err := erc20ABI.Pack(erc20TransferFromMethod, common.BytesToAddress(taker), common.BytesToAddress(maker), big.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, input, vk.calls[0].input)
+	require.Equal(t, offer.Coins(), sk.balances[taker.String()])
+	require.True(t, sk.balances[moduleAddr.String()].IsZero())
+
+	_, found := k.GetSwap(ctx, swap.ID)
+	require.False(t, found)
+	_, err = k.TakeSwap(ctx, taker, swap.ID)
+	require.Error(t, err)
+}
+
+func TestCancelCW20Swap(t *testing.T) {
+	ctx, k, _, vk := createTestInputWithVMBridge(t)
+	maker := sdk.AccAddress(common.HexToAddress("0x1").Bytes())
+	moduleAddr := supply.NewModuleAddress(types.ModuleName)
+	cw20 := sdk.AccAddress(make([]byte, 32)).String()
+	offer := types.NewAsset(types.AssetTypeCW20, cw20, sdk.NewInt(7))
+	ask := types.NewAsset(types.AssetTypeNative, sdk.DefaultBondDenom, sdk.NewInt(1))
+
+	swap, err := k.CreateSwap(ctx, maker, nil, offer, ask, 20)
+	require.NoError(t, err)
+	_, err = k.CancelSwap(ctx, maker, swap.ID)
+	require.NoError(t, err)
+	require.Empty(t, k.GetSwaps(ctx))
+
+	// the offer is escrowed with the allowance of the module account, and refunded by the module account
+	require.Len(t, vk.calls, 2)
+	for _, call := range vk.calls {
+		require.Equal(t, moduleAddr.String(), call.caller)
+		require.Equal(t, cw20, call.contract)
+	}
+	var escrow, refund cw20ExecuteMsg
+	require.NoError(t, json.Unmarshal(vk.calls[0].input, &escrow))
+	require.Equal(t, &cw20TransferFrom{Owner: maker.String(), Recipient: moduleAddr.String(), Amount: "7"}, escrow.TransferFrom)
+	require.NoError(t, json.Unmarshal(vk.calls[1].input, &refund))
+	require.Equal(t, &cw20Transfer{Recipient: maker.String(), Amount: "7"}, refund.Transfer)
+}
+
+func TestTakeExpiredSwap(t *testing.T) {
+	ctx, k, sk, _ := createTestInputWithVMBridge(t)
+	maker, taker := sdk.AccAddress(common.HexToAddress("0x1").Bytes()), sdk.AccAddress(common.HexToAddress("0x2").Bytes())
+	offer := types.NewAsset(types.AssetTypeNative, sdk.DefaultBondDenom, sdk.NewInt(1))
+	ask := types.NewAsset(types.AssetTypeNative, "usdt", sdk.NewInt(1))
+	sk.balances[maker.String()] = offer.Coins()
+	sk.balances[taker.String()] = ask.Coins()
+
+	swap, err := k.CreateSwap(ctx, maker, nil, offer, ask, 20)
+	require.NoError(t, err)
+	_, err = k.TakeSwap(ctx.WithBlockHeight(21), taker, swap.ID)
+	require.Error(t, err)
+
+	// the expired swap is still canceled by its maker
+	_, err = k.CancelSwap(ctx.WithBlockHeight(21), maker, swap.ID)
+	require.NoError(t, err)
+	require.Equal(t, offer.Coins(), sk.balances[maker.String()])
+	require.Equal(t, ask.Coins(), sk.balances[taker.String()])
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/atomicswap/types"
+	"github.com/okex/exchain/x/common"
+)
+
+// NewQuerier creates a new querier for atomicswap clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QuerySwap:
+			return querySwap(ctx, req, k)
+		case types.QuerySwaps:
+			return querySwaps(ctx, k)
+		default:
+			return nil, types.ErrUnknownAtomicSwapQueryType(path[0])
+		}
+	}
+}
+
+func querySwap(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySwapParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	swap, found := k.GetSwap(ctx, params.SwapID)
+	if !found {
+		return nil, types.ErrNoSwapFound(params.SwapID)
+	}
+	return marshalJSON(swap)
+}
+
+func querySwaps(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	swaps := k.GetSwaps(ctx)
+	if swaps == nil {
+		swaps = types.Swaps{}
+	}
+	return marshalJSON(swaps)
+}
+
+func marshalJSON(o interface{}) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, o)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/atomicswap/types"
+)
+
+// CreateSwap escrows the offer of the maker and opens a swap of the next id
+func (k Keeper) CreateSwap(ctx sdk.Context, maker, taker sdk.AccAddress, offer, ask types.Asset, expireHeight int64) (types.Swap, error) {
+	if expireHeight <= ctx.BlockHeight() {
+		return types.Swap{}, types.ErrInvalidExpireHeight(expireHeight, ctx.BlockHeight())
+	}
+	if err := k.escrow(ctx, maker, offer); err != nil {
+		return types.Swap{}, err
+	}
+
+	id := k.GetNextSwapID(ctx)
+	swap := types.NewSwap(id, maker, taker, offer, ask, expireHeight)
+	k.SetSwap(ctx, swap)
+	k.SetNextSwapID(ctx, id+1)
+	return swap, nil
+}
+
+// TakeSwap pays the ask of the swap from the taker to the maker and releases the escrowed offer to the taker. Both
+// transfers happen in the same tx, so the swap is either fully settled or not at all.
+func (k Keeper) TakeSwap(ctx sdk.Context, taker sdk.AccAddress, id uint64) (types.Swap, error) {
+	swap, found := k.GetSwap(ctx, id)
+	if !found {
+		return swap, types.ErrNoSwapFound(id)
+	}
+	if swap.IsExpired(ctx.BlockHeight()) {
+		return swap, types.ErrSwapExpired(id, swap.ExpireHeight)
+	}
+	if !swap.Taker.Empty() && !swap.Taker.Equals(taker) {
+		return swap, types.ErrUnauthorizedTaker(id, swap.Taker.String())
+	}
+
+	if err := k.pay(ctx, taker, swap.Maker, swap.Ask); err != nil {
+		return swap, err
+	}
+	if err := k.release(ctx, taker, swap.Offer); err != nil {
+		return swap, err
+	}
+	k.DeleteSwap(ctx, id)
+	return swap, nil
+}
+
+// CancelSwap refunds the escrowed offer of the swap to its maker
+func (k Keeper) CancelSwap(ctx sdk.Context, maker sdk.AccAddress, id uint64) (types.Swap, error) {
+	swap, found := k.GetSwap(ctx, id)
+	if !found {
+		return swap, types.ErrNoSwapFound(id)
+	}
+	if !swap.Maker.Equals(maker) {
+		return swap, types.ErrUnauthorizedMaker(id, swap.Maker.String())
+	}
+
+	if err := k.release(ctx, maker, swap.Offer); err != nil {
+		return swap, err
+	}
+	k.DeleteSwap(ctx, id)
+	return swap, nil
+}
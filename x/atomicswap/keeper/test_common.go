@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/store"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
+	supplyexported "github.com/okex/exchain/libs/cosmos-sdk/x/supply/exported"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	dbm "github.com/okex/exchain/libs/tm-db"
+	"github.com/okex/exchain/x/atomicswap/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSupplyKeeper struct {
+	balances map[string]sdk.SysCoins
+}
+
+func (m *mockSupplyKeeper) GetModuleAccount(_ sdk.Context, name string) supplyexported.ModuleAccountI {
+	return supply.NewEmptyModuleAccount(name)
+}
+
+func (m *mockSupplyKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return supply.NewModuleAddress(name)
+}
+
+func (m *mockSupplyKeeper) send(from, to string, amt sdk.Coins) error {
+	if !m.balances[from].IsAllGTE(amt) {
+		return errors.New("insufficient funds")
+	}
+	m.balances[from] = m.balances[from].Sub(amt)
+	m.balances[to] = m.balances[to].Add(amt...)
+	return nil
+}
+
+func (m *mockSupplyKeeper) SendCoinsFromAccountToModule(_ sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.send(senderAddr.String(), supply.NewModuleAddress(recipientModule).String(), amt)
+}
+
+func (m *mockSupplyKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(supply.NewModuleAddress(senderModule).String(), recipientAddr.String(), amt)
+}
+
+func (m *mockSupplyKeeper) SendCoins(_ sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(fromAddr.String(), toAddr.String(), amt)
+}
+
+func createTestInput(t *testing.T, vk types.VMBridgeKeeper) (sdk.Context, Keeper, *mockSupplyKeeper) {
+	keySwap := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keySwap, sdk.StoreTypeIAVL, db)
+	require.Nil(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid", Height: 10}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	sk := &mockSupplyKeeper{balances: make(map[string]sdk.SysCoins)}
+	k := NewKeeper(sk, sk, vk, keySwap, cdc)
+	k.SetNextSwapID(ctx, 1)
+	return ctx, k, sk
+}
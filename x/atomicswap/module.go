@@ -0,0 +1,150 @@
+package atomicswap
+
+import (
+	"encoding/json"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/module"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/upgrade"
+	"github.com/okex/exchain/libs/ibc-go/modules/core/base"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/atomicswap/client/cli"
+	"github.com/okex/exchain/x/atomicswap/client/rest"
+	"github.com/okex/exchain/x/atomicswap/keeper"
+	"github.com/okex/exchain/x/atomicswap/types"
+)
+
+// type check to ensure the interface is properly implemented
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+	_ upgrade.UpgradeModule = AppModule{}
+)
+
+// AppModuleBasic defines the basic application module used by the atomicswap module.
+type AppModuleBasic struct{}
+
+// Name returns the atomicswap module's name.
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterCodec registers the atomicswap module's types for the given codec.
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	types.RegisterCodec(cdc)
+}
+
+// DefaultGenesis returns nil, the state of the atomicswap module is initialized at the upgrade height
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return nil
+}
+
+// ValidateGenesis performs genesis state validation for the atomicswap module.
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	if len(bz) > 0 {
+		var data types.GenesisState
+		if err := types.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+			return err
+		}
+		return types.ValidateGenesis(data)
+	}
+	return nil
+}
+
+// RegisterRESTRoutes registers the REST routes for the atomicswap module.
+func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
+	rest.RegisterRoutes(ctx, rtr)
+}
+
+// GetTxCmd returns the root tx command for the atomicswap module.
+func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetTxCmd(cdc)
+}
+
+// GetQueryCmd returns the root query command for the atomicswap module.
+func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetQueryCmd(types.QuerierRoute, cdc)
+}
+
+//____________________________________________________________________________
+
+// AppModule implements an application module for the atomicswap module.
+type AppModule struct {
+	AppModuleBasic
+	*base.BaseIBCUpgradeModule
+	keeper keeper.Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(k keeper.Keeper) AppModule {
+	m := AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         k,
+	}
+	m.BaseIBCUpgradeModule = base.NewBaseIBCUpgradeModule(m)
+	return m
+}
+
+// Name returns the atomicswap module's name.
+func (AppModule) Name() string {
+	return types.ModuleName
+}
+
+// RegisterInvariants registers the atomicswap module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+
+// Route returns the message routing key for the atomicswap module.
+func (AppModule) Route() string {
+	return types.RouterKey
+}
+
+// NewHandler returns an sdk.Handler for the atomicswap module.
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.keeper)
+}
+
+// QuerierRoute returns the atomicswap module's querier route name.
+func (AppModule) QuerierRoute() string {
+	return types.QuerierRoute
+}
+
+// NewQuerierHandler returns the atomicswap module sdk.Querier.
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return keeper.NewQuerier(am.keeper)
+}
+
+// InitGenesis performs genesis initialization for the atomicswap module with the exported state. The default state
+// is initialized at the upgrade height instead. It returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	if len(data) == 0 {
+		return nil
+	}
+	var genesisState types.GenesisState
+	types.ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+	am.Seal()
+	return nil
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the atomicswap module.
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+		return nil
+	}
+	gs := ExportGenesis(ctx, am.keeper)
+	return types.ModuleCdc.MustMarshalJSON(gs)
+}
+
+// BeginBlock returns the begin blocker for the atomicswap module.
+func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
+// EndBlock returns the end blocker for the atomicswap module. It returns no validator updates.
+func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
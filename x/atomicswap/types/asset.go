@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	AssetTypeNative = "native"
+	AssetTypeERC20  = "erc20"
+	AssetTypeCW20   = "cw20"
+)
+
+// Asset is an amount of a native denom, an erc20 token or a cw20 token. The token of an erc20 asset is the hex address
+// of its contract, the token of a cw20 asset is the bech32 address of its contract. The amount is the integer amount
+// of the token, the amount of a native denom is in its smallest unit of 10^-18 as in the evm.
+type Asset struct {
+	Type   string  `json:"type" yaml:"type"`
+	Token  string  `json:"token" yaml:"token"`
+	Amount sdk.Int `json:"amount" yaml:"amount"`
+}
+
+// NewAsset creates a new instance of Asset
+func NewAsset(typ, token string, amount sdk.Int) Asset {
+	return Asset{
+		Type:   typ,
+		Token:  token,
+		Amount: amount,
+	}
+}
+
+// ValidateBasic validates the type, the token and the amount of the asset
+func (a Asset) ValidateBasic() sdk.Error {
+	switch a.Type {
+	case AssetTypeNative:
+		if err := sdk.ValidateDenom(a.Token); err != nil {
+			return ErrInvalidAsset(err.Error())
+		}
+	case AssetTypeERC20:
+		if !common.IsHexAddress(a.Token) {
+			return ErrInvalidAsset(fmt.Sprintf("erc20 contract %s is not a hex address", a.Token))
+		}
+	case AssetTypeCW20:
+		contract, err := sdk.AccAddressFromBech32(a.Token)
+		if err != nil {
+			return ErrInvalidAsset(fmt.Sprintf("cw20 contract %s is not a bech32 address", a.Token))
+		}
+		if !sdk.IsWasmAddress(contract) {
+			return ErrInvalidAsset(fmt.Sprintf("cw20 contract %s is not a wasm address", a.Token))
+		}
+	default:
+		return ErrInvalidAsset(fmt.Sprintf("unknown asset type %s", a.Type))
+	}
+	if a.Amount.IsNil() || !a.Amount.IsPositive() {
+		return ErrInvalidAsset(fmt.Sprintf("amount of %s should be positive", a.Token))
+	}
+	return nil
+}
+
+// Coins returns the native coins of the asset
+func (a Asset) Coins() sdk.SysCoins {
+	return sdk.SysCoins{sdk.NewDecCoinFromDec(a.Token, sdk.NewDecFromIntWithPrec(a.Amount, sdk.Precision))}
+}
+
+// String implements the fmt.Stringer interface
+func (a Asset) String() string {
+	return fmt.Sprintf("%s%s(%s)", a.Amount, a.Token, a.Type)
+}
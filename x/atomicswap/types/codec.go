@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateSwap{}, "okexchain/atomicswap/MsgCreateSwap", nil)
+	cdc.RegisterConcrete(MsgTakeSwap{}, "okexchain/atomicswap/MsgTakeSwap", nil)
+	cdc.RegisterConcrete(MsgCancelSwap{}, "okexchain/atomicswap/MsgCancelSwap", nil)
+}
+
+// ModuleCdc defines the module codec
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	DefaultCodespace string = ModuleName
+
+	CodeInvalidAddress             uint32 = 75000
+	CodeInvalidAsset               uint32 = 75001
+	CodeInvalidExpireHeight        uint32 = 75002
+	CodeNoSwapFound                uint32 = 75003
+	CodeSwapExpired                uint32 = 75004
+	CodeUnauthorizedTaker          uint32 = 75005
+	CodeUnauthorizedMaker          uint32 = 75006
+	CodeTransferFailed             uint32 = 75007
+	CodeUnknownAtomicSwapMsgType   uint32 = 75008
+	CodeUnknownAtomicSwapQueryType uint32 = 75009
+	CodeAtomicSwapNotSupported     uint32 = 75010
+)
+
+// ErrInvalidAddress returns an error when an address is invalid
+func ErrInvalidAddress(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidAddress, fmt.Sprintf("failed. invalid address: %s", msg))}
+}
+
+// ErrInvalidAsset returns an error when an asset is invalid
+func ErrInvalidAsset(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidAsset, fmt.Sprintf("failed. invalid asset: %s", msg))}
+}
+
+// ErrInvalidExpireHeight returns an error when the expire height of a swap has passed
+func ErrInvalidExpireHeight(expireHeight, height int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidExpireHeight, fmt.Sprintf("failed. expire height %d should be greater than the height %d", expireHeight, height))}
+}
+
+// ErrNoSwapFound returns an error when the swap doesn't exist
+func ErrNoSwapFound(id uint64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNoSwapFound, fmt.Sprintf("failed. swap %d does not exist", id))}
+}
+
+// ErrSwapExpired returns an error when an expired swap is taken
+func ErrSwapExpired(id uint64, expireHeight int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeSwapExpired, fmt.Sprintf("failed. swap %d expired at height %d", id, expireHeight))}
+}
+
+// ErrUnauthorizedTaker returns an error when a swap is taken by an account other than its taker
+func ErrUnauthorizedTaker(id uint64, taker string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnauthorizedTaker, fmt.Sprintf("failed. swap %d can only be taken by %s", id, taker))}
+}
+
+// ErrUnauthorizedMaker returns an error when a swap is canceled by an account other than its maker
+func ErrUnauthorizedMaker(id uint64, maker string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnauthorizedMaker, fmt.Sprintf("failed. swap %d can only be canceled by %s", id, maker))}
+}
+
+// ErrTransferFailed returns an error when the transfer of an asset fails
+func ErrTransferFailed(asset string, msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTransferFailed, fmt.Sprintf("failed. transfer %s failed: %s", asset, msg))}
+}
+
+// ErrUnknownAtomicSwapMsgType returns an error when the msg type is unknown
+func ErrUnknownAtomicSwapMsgType(msgType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownAtomicSwapMsgType, fmt.Sprintf("unrecognized atomicswap message type: %s", msgType))}
+}
+
+// ErrUnknownAtomicSwapQueryType returns an error when the query path is unknown
+func ErrUnknownAtomicSwapQueryType(path string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownAtomicSwapQueryType, fmt.Sprintf("unknown atomicswap query endpoint: %s", path))}
+}
+
+// ErrAtomicSwapNotSupported returns an error when the atomicswap module is not enabled at the height
+func ErrAtomicSwapNotSupported(height int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeAtomicSwapNotSupported, fmt.Sprintf("atomicswap module is not supported at height %d", height))}
+}
@@ -0,0 +1,16 @@
+package types
+
+// atomicswap module event types
+const (
+	EventTypeCreateSwap = "create_swap"
+	EventTypeTakeSwap   = "take_swap"
+	EventTypeCancelSwap = "cancel_swap"
+
+	AttributeKeySwapID = "swap_id"
+	AttributeKeyMaker  = "maker"
+	AttributeKeyTaker  = "taker"
+	AttributeKeyOffer  = "offer"
+	AttributeKeyAsk    = "ask"
+
+	AttributeValueCategory = ModuleName
+)
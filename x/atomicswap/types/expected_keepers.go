@@ -0,0 +1,30 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	supplyexported "github.com/okex/exchain/libs/cosmos-sdk/x/supply/exported"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+// SupplyKeeper defines the expected supply keeper to escrow the native offers in the module account
+type SupplyKeeper interface {
+	GetModuleAccount(ctx sdk.Context, moduleName string) supplyexported.ModuleAccountI
+	GetModuleAddress(moduleName string) sdk.AccAddress
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// BankKeeper defines the expected bank keeper to pay the native asks to the makers
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// VMBridgeKeeper defines the expected vmbridge keeper to transfer the erc20 and cw20 assets as the module account
+type VMBridgeKeeper interface {
+	CallEvmFrom(ctx sdk.Context, callerAddr common.Address, to *common.Address, value *big.Int, data []byte) (*evmtypes.ExecutionResult, *evmtypes.ResultData, error)
+	CallWasm(ctx sdk.Context, caller, contractAddr sdk.AccAddress, msg []byte) ([]byte, error)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState is the state of the atomicswap module that must be provided at genesis
+type GenesisState struct {
+	NextSwapID uint64 `json:"next_swap_id" yaml:"next_swap_id"`
+	Swaps      Swaps  `json:"swaps" yaml:"swaps"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(nextSwapID uint64, swaps Swaps) GenesisState {
+	return GenesisState{
+		NextSwapID: nextSwapID,
+		Swaps:      swaps,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(1, nil)
+}
+
+// ValidateGenesis validates the atomicswap genesis state
+func ValidateGenesis(data GenesisState) error {
+	if data.NextSwapID == 0 {
+		return fmt.Errorf("next swap id should be positive")
+	}
+	ids := make(map[uint64]bool, len(data.Swaps))
+	for _, swap := range data.Swaps {
+		if err := swap.ValidateBasic(); err != nil {
+			return err
+		}
+		if swap.ID == 0 || swap.ID >= data.NextSwapID {
+			return fmt.Errorf("swap id %d should be in [1, %d)", swap.ID, data.NextSwapID)
+		}
+		if ids[swap.ID] {
+			return fmt.Errorf("duplicated swap %d", swap.ID)
+		}
+		ids[swap.ID] = true
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the atomicswap module
+	ModuleName = "atomicswap"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the atomicswap module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the atomicswap module
+	QuerierRoute = ModuleName
+)
+
+var (
+	SwapPrefix    = []byte{0x01}
+	NextSwapIDKey = []byte{0x02}
+)
+
+// GetSwapKey gets the key for the swap of an id
+func GetSwapKey(id uint64) []byte {
+	return append(SwapPrefix, sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,130 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = MsgCreateSwap{}
+	_ sdk.Msg = MsgTakeSwap{}
+	_ sdk.Msg = MsgCancelSwap{}
+)
+
+// MsgCreateSwap escrows the offer of the maker in the module until the swap is taken or canceled. The erc20 and cw20
+// offers are transferred with the allowances the maker approved to the module account.
+type MsgCreateSwap struct {
+	Maker        sdk.AccAddress `json:"maker" yaml:"maker"`
+	Taker        sdk.AccAddress `json:"taker" yaml:"taker"`
+	Offer        Asset          `json:"offer" yaml:"offer"`
+	Ask          Asset          `json:"ask" yaml:"ask"`
+	ExpireHeight int64          `json:"expire_height" yaml:"expire_height"`
+}
+
+// NewMsgCreateSwap creates a new instance of MsgCreateSwap
+func NewMsgCreateSwap(maker, taker sdk.AccAddress, offer, ask Asset, expireHeight int64) MsgCreateSwap {
+	return MsgCreateSwap{
+		Maker:        maker,
+		Taker:        taker,
+		Offer:        offer,
+		Ask:          ask,
+		ExpireHeight: expireHeight,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCreateSwap) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCreateSwap) Type() string { return "create_swap" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateSwap) ValidateBasic() sdk.Error {
+	return NewSwap(0, msg.Maker, msg.Taker, msg.Offer, msg.Ask, msg.ExpireHeight).ValidateBasic()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCreateSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Maker}
+}
+
+// MsgTakeSwap pays the ask of a swap to its maker and releases its offer to the taker. The erc20 and cw20 asks are
+// transferred with the allowances the taker approved to the module account.
+type MsgTakeSwap struct {
+	Taker  sdk.AccAddress `json:"taker" yaml:"taker"`
+	SwapID uint64         `json:"swap_id" yaml:"swap_id"`
+}
+
+// NewMsgTakeSwap creates a new instance of MsgTakeSwap
+func NewMsgTakeSwap(taker sdk.AccAddress, swapID uint64) MsgTakeSwap {
+	return MsgTakeSwap{
+		Taker:  taker,
+		SwapID: swapID,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTakeSwap) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTakeSwap) Type() string { return "take_swap" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTakeSwap) ValidateBasic() sdk.Error {
+	if msg.Taker.Empty() {
+		return ErrInvalidAddress("taker is empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgTakeSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTakeSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Taker}
+}
+
+// MsgCancelSwap refunds the escrowed offer of a swap to its maker, a swap can be canceled whether it's expired or not
+type MsgCancelSwap struct {
+	Maker  sdk.AccAddress `json:"maker" yaml:"maker"`
+	SwapID uint64         `json:"swap_id" yaml:"swap_id"`
+}
+
+// NewMsgCancelSwap creates a new instance of MsgCancelSwap
+func NewMsgCancelSwap(maker sdk.AccAddress, swapID uint64) MsgCancelSwap {
+	return MsgCancelSwap{
+		Maker:  maker,
+		SwapID: swapID,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelSwap) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelSwap) Type() string { return "cancel_swap" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelSwap) ValidateBasic() sdk.Error {
+	if msg.Maker.Empty() {
+		return ErrInvalidAddress("maker is empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Maker}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateSwapValidateBasic(t *testing.T) {
+	maker, taker := sdk.AccAddress("maker"), sdk.AccAddress("taker")
+	cw20 := sdk.AccAddress(make([]byte, 32)).String()
+	native := NewAsset(AssetTypeNative, sdk.DefaultBondDenom, sdk.NewInt(1))
+	erc20 := NewAsset(AssetTypeERC20, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", sdk.NewInt(1))
+
+	tests := []struct {
+		name string
+		msg  MsgCreateSwap
+		ok   bool
+	}{
+		{"valid", NewMsgCreateSwap(maker, nil, erc20, native, 100), true},
+		{"valid cw20 with taker", NewMsgCreateSwap(maker, taker, NewAsset(AssetTypeCW20, cw20, sdk.NewInt(1)), native, 100), true},
+		{"empty maker", NewMsgCreateSwap(nil, taker, erc20, native, 100), false},
+		{"erc20 not hex", NewMsgCreateSwap(maker, nil, NewAsset(AssetTypeERC20, "token", sdk.NewInt(1)), native, 100), false},
+		{"cw20 not wasm address", NewMsgCreateSwap(maker, nil, NewAsset(AssetTypeCW20, maker.String(), sdk.NewInt(1)), native, 100), false},
+		{"invalid denom", NewMsgCreateSwap(maker, nil, erc20, NewAsset(AssetTypeNative, "okt!", sdk.NewInt(1)), 100), false},
+		{"unknown type", NewMsgCreateSwap(maker, nil, erc20, NewAsset("erc721", erc20.Token, sdk.NewInt(1)), 100), false},
+		{"zero amount", NewMsgCreateSwap(maker, nil, erc20, NewAsset(AssetTypeNative, sdk.DefaultBondDenom, sdk.ZeroInt()), 100), false},
+		{"zero expire height", NewMsgCreateSwap(maker, nil, erc20, native, 0), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.ok {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAssetCoins(t *testing.T) {
+	asset := NewAsset(AssetTypeNative, sdk.DefaultBondDenom, sdk.NewInt(1500000000000000000))
+	require.Equal(t, "1.500000000000000000"+sdk.DefaultBondDenom, asset.Coins().String())
+}
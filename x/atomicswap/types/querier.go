@@ -0,0 +1,20 @@
+package types
+
+const (
+	// QuerySwap is the query endpoint of a swap
+	QuerySwap = "swap"
+	// QuerySwaps is the query endpoint of all the open swaps
+	QuerySwaps = "swaps"
+)
+
+// QuerySwapParams is the params of the query of a swap
+type QuerySwapParams struct {
+	SwapID uint64 `json:"swap_id"`
+}
+
+// NewQuerySwapParams creates a new instance of QuerySwapParams
+func NewQuerySwapParams(swapID uint64) QuerySwapParams {
+	return QuerySwapParams{
+		SwapID: swapID,
+	}
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// Swap is an open offer of a maker escrowed in the module. It's taken in a single tx which pays the ask asset to the
+// maker and releases the offer asset to the taker, so neither party can receive without paying. An empty taker lets
+// anyone take the swap.
+type Swap struct {
+	ID           uint64         `json:"id" yaml:"id"`
+	Maker        sdk.AccAddress `json:"maker" yaml:"maker"`
+	Taker        sdk.AccAddress `json:"taker" yaml:"taker"`
+	Offer        Asset          `json:"offer" yaml:"offer"`
+	Ask          Asset          `json:"ask" yaml:"ask"`
+	ExpireHeight int64          `json:"expire_height" yaml:"expire_height"`
+}
+
+// NewSwap creates a new instance of Swap
+func NewSwap(id uint64, maker, taker sdk.AccAddress, offer, ask Asset, expireHeight int64) Swap {
+	return Swap{
+		ID:           id,
+		Maker:        maker,
+		Taker:        taker,
+		Offer:        offer,
+		Ask:          ask,
+		ExpireHeight: expireHeight,
+	}
+}
+
+// IsExpired returns whether the swap can't be taken at the height anymore
+func (s Swap) IsExpired(height int64) bool {
+	return height > s.ExpireHeight
+}
+
+// ValidateBasic validates the swap
+func (s Swap) ValidateBasic() sdk.Error {
+	if s.Maker.Empty() {
+		return ErrInvalidAddress("maker is empty")
+	}
+	if err := s.Offer.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := s.Ask.ValidateBasic(); err != nil {
+		return err
+	}
+	if s.ExpireHeight <= 0 {
+		return ErrInvalidExpireHeight(s.ExpireHeight, 0)
+	}
+	return nil
+}
+
+// String implements the fmt.Stringer interface
+func (s Swap) String() string {
+	return fmt.Sprintf(`Swap:
+  ID:            %d
+  Maker:         %s
+  Taker:         %s
+  Offer:         %s
+  Ask:           %s
+  Expire Height: %d`,
+		s.ID, s.Maker, s.Taker, s.Offer, s.Ask, s.ExpireHeight)
+}
+
+// Swaps is a collection of Swap
+type Swaps []Swap
+
+// String implements the fmt.Stringer interface
+func (s Swaps) String() (out string) {
+	for _, swap := range s {
+		out += swap.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,21 @@
+package circuit
+
+import (
+	"github.com/okex/exchain/x/circuit/keeper"
+	"github.com/okex/exchain/x/circuit/types"
+)
+
+const (
+	ModuleName   = types.ModuleName
+	StoreKey     = types.StoreKey
+	RouterKey    = types.RouterKey
+	QuerierRoute = types.QuerierRoute
+)
+
+var (
+	NewKeeper = keeper.NewKeeper
+)
+
+type (
+	Keeper = keeper.Keeper
+)
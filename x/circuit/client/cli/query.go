@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/x/circuit/types"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	// Group circuit queries under a subcommand
+	circuitQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	circuitQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryDisabledMsgTypes(queryRoute, cdc),
+			GetCmdQueryParams(queryRoute, cdc),
+		)...,
+	)
+
+	return circuitQueryCmd
+}
+
+// GetCmdQueryDisabledMsgTypes gets the disabled msg types query command.
+func GetCmdQueryDisabledMsgTypes(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "disabled-msg-types",
+		Short: "query the msg types whose routing is disabled",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the msg types whose routing is disabled by the circuit breaker.
+
+Example:
+$ %s query circuit disabled-msg-types
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDisabledMsgTypes)
+			resp, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var disabledMsgTypes types.DisabledMsgTypes
+			cdc.MustUnmarshalJSON(resp, &disabledMsgTypes)
+			return cliCtx.PrintOutput(disabledMsgTypes)
+		},
+	}
+}
+
+// GetCmdQueryParams gets the circuit params query command.
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "query the current circuit parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as circuit parameters.
+
+Example:
+$ %s query circuit params
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParameters)
+			resp, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(resp, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
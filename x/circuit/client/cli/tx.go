@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	interfacetypes "github.com/okex/exchain/libs/cosmos-sdk/codec/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	circuitutils "github.com/okex/exchain/x/circuit/client/utils"
+	"github.com/okex/exchain/x/circuit/types"
+	"github.com/okex/exchain/x/gov"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		SuggestionsMinimumDistance: 2,
+	}
+
+	circuitTxCmd.AddCommand(client.PostCommands(
+		GetCmdTripCircuit(cdc),
+		GetCmdResetCircuit(cdc),
+	)...)
+	return circuitTxCmd
+}
+
+// GetCmdTripCircuit gets the trip circuit command
+func GetCmdTripCircuit(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip [msg-types]",
+		Short: "disable the routing of msg types as a member of the emergency committee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Disable the routing of msg types as a member of the emergency committee. A msg type is either
+a route, which disables all the msgs of the route, or route/type. %s disables the ethereum txs creating contracts.
+
+Example:
+$ %s tx circuit trip wasm/store-code,%s --from mykey
+`, types.MsgTypeEvmContractCreation, version.ClientName, types.MsgTypeEvmContractCreation),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgTripCircuit(cliCtx.GetFromAddress(), strings.Split(args[0], ","))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdResetCircuit gets the reset circuit command
+func GetCmdResetCircuit(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [msg-types]",
+		Short: "enable the routing of disabled msg types as a member of the emergency committee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable the routing of msg types disabled before as a member of the emergency committee.
+
+Example:
+$ %s tx circuit reset wasm/store-code --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgResetCircuit(cliCtx.GetFromAddress(), strings.Split(args[0], ","))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdCircuitBreakerProposal implements a command handler for submitting a circuit breaker proposal transaction
+func GetCmdCircuitBreakerProposal(cdcP *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	return &cobra.Command{
+		Use:   "circuit-breaker [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to disable or enable the routing of msg types",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a circuit breaker proposal along with an initial deposit. The action is trip, which
+disables the routing of the msg types, or reset, which enables them again. The proposal details must be supplied
+via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal circuit-breaker <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "disable wasm code uploading",
+ "description": "disable wasm code uploading until the vm is patched",
+ "action": "trip",
+ "msg_types": ["wasm/store-code"],
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cdc := cdcP.GetCdc()
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := circuitutils.ParseCircuitBreakerProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCircuitBreakerProposal(proposal.Title, proposal.Description, proposal.Action,
+				proposal.MsgTypes)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
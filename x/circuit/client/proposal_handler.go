@@ -0,0 +1,12 @@
+package client
+
+import (
+	"github.com/okex/exchain/x/circuit/client/cli"
+	"github.com/okex/exchain/x/circuit/client/rest"
+	"github.com/okex/exchain/x/gov/client"
+)
+
+var (
+	// CircuitBreakerProposalHandler alias gov NewProposalHandler
+	CircuitBreakerProposalHandler = client.NewProposalHandler(cli.GetCmdCircuitBreakerProposal, rest.CircuitBreakerProposalRESTHandler)
+)
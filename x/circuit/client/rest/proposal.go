@@ -0,0 +1,11 @@
+package rest
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	govRest "github.com/okex/exchain/x/gov/client/rest"
+)
+
+// CircuitBreakerProposalRESTHandler defines circuit breaker proposal handler
+func CircuitBreakerProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
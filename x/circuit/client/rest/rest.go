@@ -0,0 +1,46 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/rest"
+	"github.com/okex/exchain/x/circuit/types"
+	"github.com/okex/exchain/x/common"
+)
+
+// RegisterRoutes registers circuit-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// get the msg types whose routing is disabled
+	r.HandleFunc(
+		"/circuit/disabled_msg_types",
+		queryHandlerFn(cliCtx, types.QueryDisabledMsgTypes),
+	).Methods("GET")
+
+	// get the current circuit parameter values
+	r.HandleFunc(
+		"/circuit/parameters",
+		queryHandlerFn(cliCtx, types.QueryParameters),
+	).Methods("GET")
+}
+
+func queryHandlerFn(cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, endpoint)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusInternalServerError, common.ErrorABCIQueryFails)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// CircuitBreakerProposalJSON defines a CircuitBreakerProposal with a deposit used to parse circuit breaker proposals
+// from a JSON file.
+type CircuitBreakerProposalJSON struct {
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Action      string       `json:"action" yaml:"action"`
+	MsgTypes    []string     `json:"msg_types" yaml:"msg_types"`
+	Deposit     sdk.SysCoins `json:"deposit" yaml:"deposit"`
+}
+
+// ParseCircuitBreakerProposalJSON parses json from proposal file to CircuitBreakerProposalJSON struct
+func ParseCircuitBreakerProposalJSON(cdc *codec.Codec, proposalFilePath string) (proposal CircuitBreakerProposalJSON,
+	err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	err = cdc.UnmarshalJSON(contents, &proposal)
+	return
+}
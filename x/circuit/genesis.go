@@ -0,0 +1,20 @@
+package circuit
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/circuit/keeper"
+	"github.com/okex/exchain/x/circuit/types"
+)
+
+// InitGenesis initializes the circuit module's state from a given genesis state
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	for _, disabled := range data.DisabledMsgTypes {
+		k.SetDisabledMsgType(ctx, disabled)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetDisabledMsgTypes(ctx))
+}
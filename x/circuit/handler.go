@@ -0,0 +1,60 @@
+package circuit
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/circuit/keeper"
+	"github.com/okex/exchain/x/circuit/types"
+)
+
+// NewHandler creates an sdk.Handler for all the circuit type messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx.SetEventManager(sdk.NewEventManager())
+
+		if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+			return nil, types.ErrCircuitNotSupported(ctx.BlockHeight())
+		}
+
+		switch msg := msg.(type) {
+		case types.MsgTripCircuit:
+			return handleMsgTripCircuit(ctx, k, msg)
+		case types.MsgResetCircuit:
+			return handleMsgResetCircuit(ctx, k, msg)
+		default:
+			return nil, types.ErrUnknownCircuitMsgType(fmt.Sprintf("%T", msg))
+		}
+	}
+}
+
+func handleMsgTripCircuit(ctx sdk.Context, k keeper.Keeper, msg types.MsgTripCircuit) (*sdk.Result, error) {
+	if !k.GetParams(ctx).IsCommitteeMember(msg.Authority) {
+		return nil, types.ErrNotCommitteeMember(msg.Authority.String())
+	}
+
+	k.TripCircuit(ctx, msg.MsgTypes, msg.Authority.String())
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgResetCircuit(ctx sdk.Context, k keeper.Keeper, msg types.MsgResetCircuit) (*sdk.Result, error) {
+	if !k.GetParams(ctx).IsCommitteeMember(msg.Authority) {
+		return nil, types.ErrNotCommitteeMember(msg.Authority.String())
+	}
+
+	k.ResetCircuit(ctx, msg.MsgTypes, msg.Authority.String())
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/x/circuit/types"
+	"github.com/okex/exchain/x/params"
+)
+
+// Keeper of the circuit module maintains the msg types whose routing is disabled
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	paramSpace types.Subspace
+
+	govKeeper types.GovKeeper
+}
+
+// NewKeeper creates new instances of the circuit Keeper
+func NewKeeper(storeKey sdk.StoreKey, cdc *codec.Codec, ps params.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		paramSpace: ps,
+	}
+}
+
+// SetGovKeeper sets keeper of gov
+func (k *Keeper) SetGovKeeper(gk types.GovKeeper) {
+	k.govKeeper = gk
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of circuit parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
+
+// SetParams sets the circuit parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetDisabledMsgType gets a disabled msg type from store
+func (k Keeper) GetDisabledMsgType(ctx sdk.Context, msgType string) (disabled types.DisabledMsgType, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetDisabledMsgTypeKey(msgType))
+	if bz == nil {
+		return disabled, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &disabled)
+	return disabled, true
+}
+
+// SetDisabledMsgType sets a disabled msg type into store
+func (k Keeper) SetDisabledMsgType(ctx sdk.Context, disabled types.DisabledMsgType) {
+	ctx.KVStore(k.storeKey).Set(types.GetDisabledMsgTypeKey(disabled.MsgType), k.cdc.MustMarshalBinaryLengthPrefixed(disabled))
+}
+
+// DeleteDisabledMsgType deletes a disabled msg type from store
+func (k Keeper) DeleteDisabledMsgType(ctx sdk.Context, msgType string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetDisabledMsgTypeKey(msgType))
+}
+
+// GetDisabledMsgTypes gets all the disabled msg types from store
+func (k Keeper) GetDisabledMsgTypes(ctx sdk.Context) (disabledMsgTypes types.DisabledMsgTypes) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DisabledMsgTypePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var disabled types.DisabledMsgType
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &disabled)
+		disabledMsgTypes = append(disabledMsgTypes, disabled)
+	}
+	return
+}
+
+// IsMsgDisabled checks whether the routing of a msg is disabled, either by its route or by its route/type
+func (k Keeper) IsMsgDisabled(ctx sdk.Context, route, msgType string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDisabledMsgTypeKey(route)) ||
+		store.Has(types.GetDisabledMsgTypeKey(types.GetMsgType(route, msgType)))
+}
+
+// TripCircuit disables the routing of the msg types. The msg types disabled already are kept as they are
+func (k Keeper) TripCircuit(ctx sdk.Context, msgTypes []string, authority string) {
+	for _, msgType := range msgTypes {
+		if _, found := k.GetDisabledMsgType(ctx, msgType); found {
+			continue
+		}
+		k.SetDisabledMsgType(ctx, types.NewDisabledMsgType(msgType, authority, ctx.BlockHeight()))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeTripCircuit,
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+			sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+		))
+		k.Logger(ctx).Info("circuit tripped", "msg_type", msgType, "authority", authority)
+	}
+}
+
+// ResetCircuit enables the routing of the msg types. The msg types not disabled are ignored
+func (k Keeper) ResetCircuit(ctx sdk.Context, msgTypes []string, authority string) {
+	for _, msgType := range msgTypes {
+		if _, found := k.GetDisabledMsgType(ctx, msgType); !found {
+			continue
+		}
+		k.DeleteDisabledMsgType(ctx, msgType)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeResetCircuit,
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+			sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+		))
+		k.Logger(ctx).Info("circuit reset", "msg_type", msgType, "authority", authority)
+	}
+}
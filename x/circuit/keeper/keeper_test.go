@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/okex/exchain/x/circuit/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTripAndResetCircuit(t *testing.T) {
+	ctx, k := createTestInput(t)
+	require.False(t, k.IsMsgDisabled(ctx, "wasm", "store-code"))
+
+	// disable a single msg type and a whole route
+	k.TripCircuit(ctx, []string{"wasm/store-code", "farm"}, types.AttributeValueGovernance)
+	require.True(t, k.IsMsgDisabled(ctx, "wasm", "store-code"))
+	require.False(t, k.IsMsgDisabled(ctx, "wasm", "execute"))
+	require.True(t, k.IsMsgDisabled(ctx, "farm", "lock"))
+	require.True(t, k.IsMsgDisabled(ctx, "farm", "unlock"))
+	require.Len(t, ctx.EventManager().Events(), 2)
+
+	// trip again keeps the original record
+	ctx.SetBlockHeight(20)
+	k.TripCircuit(ctx, []string{"farm"}, "ex1")
+	disabled, found := k.GetDisabledMsgType(ctx, "farm")
+	require.True(t, found)
+	require.Equal(t, types.NewDisabledMsgType("farm", types.AttributeValueGovernance, 10), disabled)
+	require.Len(t, k.GetDisabledMsgTypes(ctx), 2)
+	require.Len(t, ctx.EventManager().Events(), 2)
+
+	// reset ignores the msg types not disabled
+	k.ResetCircuit(ctx, []string{"farm", "wasm/execute"}, "ex1")
+	require.False(t, k.IsMsgDisabled(ctx, "farm", "lock"))
+	require.True(t, k.IsMsgDisabled(ctx, "wasm", "store-code"))
+	require.Len(t, k.GetDisabledMsgTypes(ctx), 1)
+	require.Len(t, ctx.EventManager().Events(), 3)
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/circuit/types"
+	"github.com/okex/exchain/x/common"
+)
+
+// NewQuerier creates a new querier for circuit clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryDisabledMsgTypes:
+			return queryDisabledMsgTypes(ctx, k)
+		case types.QueryParameters:
+			return queryParams(ctx, k)
+		default:
+			return nil, types.ErrUnknownCircuitQueryType(path[0])
+		}
+	}
+}
+
+func queryDisabledMsgTypes(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	disabledMsgTypes := k.GetDisabledMsgTypes(ctx)
+	if disabledMsgTypes == nil {
+		disabledMsgTypes = types.DisabledMsgTypes{}
+	}
+	return marshalJSON(disabledMsgTypes)
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	return marshalJSON(k.GetParams(ctx))
+}
+
+func marshalJSON(o interface{}) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, o)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/store"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	dbm "github.com/okex/exchain/libs/tm-db"
+	"github.com/okex/exchain/x/circuit/types"
+	"github.com/okex/exchain/x/params"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper) {
+	keyCircuit := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyCircuit, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.Nil(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid", Height: 10}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	k := NewKeeper(keyCircuit, cdc, pk.Subspace(types.ModuleName))
+	k.SetParams(ctx, types.DefaultParams())
+	return ctx, k
+}
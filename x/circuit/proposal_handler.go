@@ -0,0 +1,32 @@
+package circuit
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/circuit/types"
+	"github.com/okex/exchain/x/common"
+	govTypes "github.com/okex/exchain/x/gov/types"
+)
+
+// NewCircuitBreakerProposalHandler handles "gov" type message in "circuit"
+func NewCircuitBreakerProposalHandler(k *Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, proposal *govTypes.Proposal) (err sdk.Error) {
+		switch content := proposal.Content.(type) {
+		case types.CircuitBreakerProposal:
+			return handleCircuitBreakerProposal(ctx, k, content)
+		default:
+			return common.ErrUnknownProposalType(types.DefaultCodespace, content.ProposalType())
+		}
+	}
+}
+
+func handleCircuitBreakerProposal(ctx sdk.Context, k *Keeper, p types.CircuitBreakerProposal) sdk.Error {
+	switch p.Action {
+	case types.CircuitActionTrip:
+		k.TripCircuit(ctx, p.MsgTypes, types.AttributeValueGovernance)
+	case types.CircuitActionReset:
+		k.ResetCircuit(ctx, p.MsgTypes, types.AttributeValueGovernance)
+	default:
+		return types.ErrInvalidCircuitAction(p.Action)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgTripCircuit{}, "okexchain/circuit/MsgTripCircuit", nil)
+	cdc.RegisterConcrete(MsgResetCircuit{}, "okexchain/circuit/MsgResetCircuit", nil)
+	cdc.RegisterConcrete(CircuitBreakerProposal{}, "okexchain/circuit/CircuitBreakerProposal", nil)
+}
+
+// ModuleCdc defines the module codec
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	DefaultCodespace string = ModuleName
+
+	CodeInvalidAddress          uint32 = 71000
+	CodeInvalidMsgType          uint32 = 71001
+	CodeMsgTypeProtected        uint32 = 71002
+	CodeNotCommitteeMember      uint32 = 71003
+	CodeMsgTypeDisabled         uint32 = 71004
+	CodeInvalidCircuitAction    uint32 = 71005
+	CodeUnknownCircuitMsgType   uint32 = 71006
+	CodeUnknownCircuitQueryType uint32 = 71007
+	CodeCircuitNotSupported     uint32 = 71008
+)
+
+// ErrInvalidAddress returns an error when an address is invalid
+func ErrInvalidAddress(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidAddress, fmt.Sprintf("failed. invalid address: %s", msg))}
+}
+
+// ErrInvalidMsgType returns an error when a msg type is not in the form of route or route/type
+func ErrInvalidMsgType(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidMsgType, fmt.Sprintf("failed. invalid msg type: %s", msg))}
+}
+
+// ErrMsgTypeProtected returns an error when a msg type which the circuit breaker relies on is going to be disabled
+func ErrMsgTypeProtected(msgType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeMsgTypeProtected, fmt.Sprintf("failed. msg type %s can't be disabled", msgType))}
+}
+
+// ErrNotCommitteeMember returns an error when an address out of the emergency committee trips or resets the circuit breaker
+func ErrNotCommitteeMember(addr string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNotCommitteeMember, fmt.Sprintf("failed. %s is not a member of the emergency committee", addr))}
+}
+
+// ErrMsgTypeDisabled returns an error when a msg whose routing is disabled is sent
+func ErrMsgTypeDisabled(msgType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeMsgTypeDisabled, fmt.Sprintf("failed. msg type %s is disabled by the circuit breaker", msgType))}
+}
+
+// ErrInvalidCircuitAction returns an error when the action of a circuit breaker proposal is unknown
+func ErrInvalidCircuitAction(action string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidCircuitAction,
+		fmt.Sprintf("failed. invalid action %s, it should be %s or %s", action, CircuitActionTrip, CircuitActionReset))}
+}
+
+// ErrUnknownCircuitMsgType returns an error when the msg type is unknown
+func ErrUnknownCircuitMsgType(msgType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownCircuitMsgType, fmt.Sprintf("unrecognized circuit message type: %s", msgType))}
+}
+
+// ErrUnknownCircuitQueryType returns an error when the query endpoint is unknown
+func ErrUnknownCircuitQueryType(path string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownCircuitQueryType, fmt.Sprintf("unknown circuit query endpoint: %s", path))}
+}
+
+// ErrCircuitNotSupported returns an error when the circuit module is used before the upgrade height
+func ErrCircuitNotSupported(height int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeCircuitNotSupported, fmt.Sprintf("failed. circuit module is not supported at height %d", height))}
+}
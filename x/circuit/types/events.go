@@ -0,0 +1,13 @@
+package types
+
+// circuit module event types
+const (
+	EventTypeTripCircuit  = "trip_circuit"
+	EventTypeResetCircuit = "reset_circuit"
+
+	AttributeKeyMsgType   = "msg_type"
+	AttributeKeyAuthority = "authority"
+
+	AttributeValueGovernance = "governance"
+	AttributeValueCategory   = ModuleName
+)
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState is the state of the circuit module that must be provided at genesis
+type GenesisState struct {
+	Params           Params           `json:"params" yaml:"params"`
+	DisabledMsgTypes DisabledMsgTypes `json:"disabled_msg_types" yaml:"disabled_msg_types"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, disabledMsgTypes DisabledMsgTypes) GenesisState {
+	return GenesisState{
+		Params:           params,
+		DisabledMsgTypes: disabledMsgTypes,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// ValidateGenesis validates the circuit genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	msgTypes := make(map[string]bool, len(data.DisabledMsgTypes))
+	for _, disabled := range data.DisabledMsgTypes {
+		if err := ValidateMsgType(disabled.MsgType); err != nil {
+			return err
+		}
+		if msgTypes[disabled.MsgType] {
+			return fmt.Errorf("duplicated disabled msg type %s", disabled.MsgType)
+		}
+		msgTypes[disabled.MsgType] = true
+	}
+	return nil
+}
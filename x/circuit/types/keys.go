@@ -0,0 +1,25 @@
+package types
+
+const (
+	// ModuleName is the name of the circuit module
+	ModuleName = "circuit"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the circuit module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the circuit module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// DisabledMsgTypePrefix is the prefix of the msg types whose routing is disabled, msg type -> DisabledMsgType
+	DisabledMsgTypePrefix = []byte{0x01}
+)
+
+// GetDisabledMsgTypeKey returns the store key of a disabled msg type
+func GetDisabledMsgTypeKey(msgType string) []byte {
+	return append(DisabledMsgTypePrefix, []byte(msgType)...)
+}
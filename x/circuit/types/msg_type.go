@@ -0,0 +1,105 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// MaxMsgTypesPerAction is the max number of msg types tripped or reset at a time
+	MaxMsgTypesPerAction = 32
+
+	// TypeEvmContractCreation is the type matched by the ethereum txs creating contracts besides their own type,
+	// so that the contract creations can be disabled without disabling the txs calling contracts
+	TypeEvmContractCreation = "contract_creation"
+	// MsgTypeEvmContractCreation is the msg type of the ethereum txs creating contracts
+	MsgTypeEvmContractCreation = "evm/" + TypeEvmContractCreation
+)
+
+var (
+	reMsgType = regexp.MustCompile(`^[a-zA-Z0-9_\-]{1,64}(/[a-zA-Z0-9_\-]{1,64})?$`)
+
+	// protectedRoutes are the routes that can't be disabled, otherwise the circuit breaker can't be reset
+	protectedRoutes = []string{ModuleName, "gov"}
+)
+
+// GetMsgType returns the msg type a msg is matched by in the form of route/type
+func GetMsgType(route, msgType string) string {
+	return route + "/" + msgType
+}
+
+// ValidateMsgTypes checks the msg types are in the form of route or route/type without duplicates, and none of
+// them is protected
+func ValidateMsgTypes(msgTypes []string) sdk.Error {
+	if len(msgTypes) == 0 {
+		return ErrInvalidMsgType("msg types are empty")
+	}
+	if len(msgTypes) > MaxMsgTypesPerAction {
+		return ErrInvalidMsgType(fmt.Sprintf("too many msg types: %d > %d", len(msgTypes), MaxMsgTypesPerAction))
+	}
+
+	seen := make(map[string]bool, len(msgTypes))
+	for _, msgType := range msgTypes {
+		if err := ValidateMsgType(msgType); err != nil {
+			return err
+		}
+		if seen[msgType] {
+			return ErrInvalidMsgType(fmt.Sprintf("duplicated msg type %s", msgType))
+		}
+		seen[msgType] = true
+	}
+	return nil
+}
+
+// ValidateMsgType checks the msg type is in the form of route or route/type and it isn't protected
+func ValidateMsgType(msgType string) sdk.Error {
+	if !reMsgType.MatchString(msgType) {
+		return ErrInvalidMsgType(fmt.Sprintf("%s should be in the form of route or route/type", msgType))
+	}
+	route := strings.SplitN(msgType, "/", 2)[0]
+	for _, protected := range protectedRoutes {
+		if route == protected {
+			return ErrMsgTypeProtected(msgType)
+		}
+	}
+	return nil
+}
+
+// DisabledMsgType is a msg type whose routing is disabled. A bare route disables all the msgs of the route
+type DisabledMsgType struct {
+	MsgType   string `json:"msg_type" yaml:"msg_type"`
+	Authority string `json:"authority" yaml:"authority"`
+	Height    int64  `json:"height" yaml:"height"`
+}
+
+// NewDisabledMsgType creates a new instance of DisabledMsgType
+func NewDisabledMsgType(msgType, authority string, height int64) DisabledMsgType {
+	return DisabledMsgType{
+		MsgType:   msgType,
+		Authority: authority,
+		Height:    height,
+	}
+}
+
+// String returns a human readable string representation of DisabledMsgType
+func (dmt DisabledMsgType) String() string {
+	return fmt.Sprintf(`Disabled Msg Type:
+  Msg Type:   %s
+  Authority:  %s
+  Height:     %d`,
+		dmt.MsgType, dmt.Authority, dmt.Height)
+}
+
+// DisabledMsgTypes is a collection of DisabledMsgType
+type DisabledMsgTypes []DisabledMsgType
+
+// String returns a human readable string representation of DisabledMsgTypes
+func (dmts DisabledMsgTypes) String() (out string) {
+	for _, dmt := range dmts {
+		out += dmt.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMsgTypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		msgTypes []string
+		valid    bool
+	}{
+		{"route", []string{"wasm"}, true},
+		{"route and type", []string{"wasm/store-code", MsgTypeEvmContractCreation}, true},
+		{"empty", []string{}, false},
+		{"empty msg type", []string{""}, false},
+		{"too many parts", []string{"wasm/store-code/x"}, false},
+		{"empty type", []string{"wasm/"}, false},
+		{"invalid character", []string{"wasm store-code"}, false},
+		{"duplicated", []string{"wasm", "wasm"}, false},
+		{"protected circuit", []string{"circuit/reset_circuit"}, false},
+		{"protected gov", []string{"gov"}, false},
+	}
+
+	for _, tc := range tests {
+		err := ValidateMsgTypes(tc.msgTypes)
+		if tc.valid {
+			require.Nil(t, err, tc.name)
+		} else {
+			require.NotNil(t, err, tc.name)
+		}
+	}
+
+	tooMany := make([]string, MaxMsgTypesPerAction+1)
+	for i := range tooMany {
+		tooMany[i] = string(rune('a'+i%26)) + string(rune('a'+i/26))
+	}
+	require.NotNil(t, ValidateMsgTypes(tooMany))
+}
+
+func TestCircuitMsgsValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("testCircuitAddr"))
+
+	require.Nil(t, NewMsgTripCircuit(addr, []string{"wasm/store-code"}).ValidateBasic())
+	require.NotNil(t, NewMsgTripCircuit(nil, []string{"wasm/store-code"}).ValidateBasic())
+	require.NotNil(t, NewMsgTripCircuit(addr, []string{"gov"}).ValidateBasic())
+
+	require.Nil(t, NewMsgResetCircuit(addr, []string{"wasm/store-code"}).ValidateBasic())
+	require.NotNil(t, NewMsgResetCircuit(nil, []string{"wasm/store-code"}).ValidateBasic())
+	require.NotNil(t, NewMsgResetCircuit(addr, nil).ValidateBasic())
+}
+
+func TestCircuitBreakerProposalValidateBasic(t *testing.T) {
+	require.Nil(t, NewCircuitBreakerProposal("title", "desc", CircuitActionTrip, []string{"wasm"}).ValidateBasic())
+	require.Nil(t, NewCircuitBreakerProposal("title", "desc", CircuitActionReset, []string{"wasm"}).ValidateBasic())
+	require.NotNil(t, NewCircuitBreakerProposal("", "desc", CircuitActionTrip, []string{"wasm"}).ValidateBasic())
+	require.NotNil(t, NewCircuitBreakerProposal("title", "", CircuitActionTrip, []string{"wasm"}).ValidateBasic())
+	require.NotNil(t, NewCircuitBreakerProposal("title", "desc", "pause", []string{"wasm"}).ValidateBasic())
+	require.NotNil(t, NewCircuitBreakerProposal("title", "desc", CircuitActionTrip, []string{"circuit"}).ValidateBasic())
+}
+
+func TestParamsAndGenesisValidate(t *testing.T) {
+	addr := sdk.AccAddress([]byte("testCircuitAddr"))
+	params := NewParams([]sdk.AccAddress{addr})
+	require.Nil(t, params.Validate())
+	require.True(t, params.IsCommitteeMember(addr))
+	require.False(t, params.IsCommitteeMember(sdk.AccAddress([]byte("otherCircuitAddr"))))
+	require.NotNil(t, NewParams([]sdk.AccAddress{addr, addr}).Validate())
+	require.NotNil(t, NewParams([]sdk.AccAddress{nil}).Validate())
+
+	require.Nil(t, ValidateGenesis(DefaultGenesisState()))
+	require.Nil(t, ValidateGenesis(NewGenesisState(params, DisabledMsgTypes{
+		NewDisabledMsgType("wasm", AttributeValueGovernance, 1),
+		NewDisabledMsgType(MsgTypeEvmContractCreation, addr.String(), 2),
+	})))
+	require.NotNil(t, ValidateGenesis(NewGenesisState(params, DisabledMsgTypes{
+		NewDisabledMsgType("wasm", AttributeValueGovernance, 1),
+		NewDisabledMsgType("wasm", AttributeValueGovernance, 2),
+	})))
+	require.NotNil(t, ValidateGenesis(NewGenesisState(params, DisabledMsgTypes{
+		NewDisabledMsgType("gov", AttributeValueGovernance, 1),
+	})))
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = MsgTripCircuit{}
+	_ sdk.Msg = MsgResetCircuit{}
+)
+
+// MsgTripCircuit disables the routing of the msg types, it can only be sent by a member of the emergency committee
+type MsgTripCircuit struct {
+	Authority sdk.AccAddress `json:"authority" yaml:"authority"`
+	MsgTypes  []string       `json:"msg_types" yaml:"msg_types"`
+}
+
+// NewMsgTripCircuit creates a new instance of MsgTripCircuit
+func NewMsgTripCircuit(authority sdk.AccAddress, msgTypes []string) MsgTripCircuit {
+	return MsgTripCircuit{
+		Authority: authority,
+		MsgTypes:  msgTypes,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTripCircuit) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTripCircuit) Type() string { return "trip_circuit" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTripCircuit) ValidateBasic() sdk.Error {
+	if msg.Authority.Empty() {
+		return ErrInvalidAddress("authority is empty")
+	}
+	if err := ValidateMsgTypes(msg.MsgTypes); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgTripCircuit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTripCircuit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// MsgResetCircuit enables the routing of the msg types disabled before, it can only be sent by a member of the
+// emergency committee
+type MsgResetCircuit struct {
+	Authority sdk.AccAddress `json:"authority" yaml:"authority"`
+	MsgTypes  []string       `json:"msg_types" yaml:"msg_types"`
+}
+
+// NewMsgResetCircuit creates a new instance of MsgResetCircuit
+func NewMsgResetCircuit(authority sdk.AccAddress, msgTypes []string) MsgResetCircuit {
+	return MsgResetCircuit{
+		Authority: authority,
+		MsgTypes:  msgTypes,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgResetCircuit) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgResetCircuit) Type() string { return "reset_circuit" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgResetCircuit) ValidateBasic() sdk.Error {
+	if msg.Authority.Empty() {
+		return ErrInvalidAddress("authority is empty")
+	}
+	if err := ValidateMsgTypes(msg.MsgTypes); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgResetCircuit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgResetCircuit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/params"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Parameter store key
+var (
+	DefaultCommittee = []sdk.AccAddress{}
+
+	ParamStoreKeyCommittee = []byte("Committee")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params defines the circuit module params
+type Params struct {
+	// committee defines the addresses of the emergency committee, which can trip and reset the circuit breaker
+	// without a governance proposal
+	Committee []sdk.AccAddress `json:"committee" yaml:"committee"`
+}
+
+// NewParams creates a new Params object
+func NewParams(committee []sdk.AccAddress) Params {
+	return Params{
+		Committee: committee,
+	}
+}
+
+// DefaultParams returns the default parameters of the circuit module
+func DefaultParams() Params {
+	return NewParams(DefaultCommittee)
+}
+
+// String implements the fmt.Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyCommittee, &p.Committee, validateCommittee),
+	}
+}
+
+// Validate checks all the params
+func (p Params) Validate() error {
+	return validateCommittee(p.Committee)
+}
+
+// IsCommitteeMember checks whether the address is a member of the emergency committee
+func (p Params) IsCommitteeMember(addr sdk.AccAddress) bool {
+	for _, member := range p.Committee {
+		if member.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateCommittee(i interface{}) error {
+	v, ok := i.([]sdk.AccAddress)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	members := make(map[string]bool, len(v))
+	for _, member := range v {
+		if member.Empty() {
+			return fmt.Errorf("empty address in committee")
+		}
+		if members[member.String()] {
+			return fmt.Errorf("duplicated address %s in committee", member)
+		}
+		members[member.String()] = true
+	}
+
+	return nil
+}
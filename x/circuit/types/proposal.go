@@ -0,0 +1,105 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
+)
+
+const (
+	// proposalTypeCircuitBreaker defines the type for a CircuitBreakerProposal
+	proposalTypeCircuitBreaker = "CircuitBreaker"
+
+	// actions of the CircuitBreakerProposal
+	CircuitActionTrip  = "trip"
+	CircuitActionReset = "reset"
+)
+
+func init() {
+	govtypes.RegisterProposalType(proposalTypeCircuitBreaker)
+	govtypes.RegisterProposalTypeCodec(CircuitBreakerProposal{}, "okexchain/circuit/CircuitBreakerProposal")
+}
+
+var _ govtypes.Content = (*CircuitBreakerProposal)(nil)
+
+// CircuitBreakerProposal - structure for the proposal to disable or enable the routing of msg types
+type CircuitBreakerProposal struct {
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description" yaml:"description"`
+	Action      string   `json:"action" yaml:"action"`
+	MsgTypes    []string `json:"msg_types" yaml:"msg_types"`
+}
+
+// NewCircuitBreakerProposal creates a new instance of CircuitBreakerProposal
+func NewCircuitBreakerProposal(title, description, action string, msgTypes []string) CircuitBreakerProposal {
+	return CircuitBreakerProposal{
+		Title:       title,
+		Description: description,
+		Action:      action,
+		MsgTypes:    msgTypes,
+	}
+}
+
+// GetTitle returns title of a circuit breaker proposal object
+func (cp CircuitBreakerProposal) GetTitle() string {
+	return cp.Title
+}
+
+// GetDescription returns description of a circuit breaker proposal object
+func (cp CircuitBreakerProposal) GetDescription() string {
+	return cp.Description
+}
+
+// ProposalRoute returns route key of a circuit breaker proposal object
+func (cp CircuitBreakerProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a circuit breaker proposal object
+func (cp CircuitBreakerProposal) ProposalType() string {
+	return proposalTypeCircuitBreaker
+}
+
+// ValidateBasic validates a circuit breaker proposal
+func (cp CircuitBreakerProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(cp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(cp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(cp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(cp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if cp.ProposalType() != proposalTypeCircuitBreaker {
+		return govtypes.ErrInvalidProposalType(cp.ProposalType())
+	}
+
+	if cp.Action != CircuitActionTrip && cp.Action != CircuitActionReset {
+		return ErrInvalidCircuitAction(cp.Action)
+	}
+
+	if err := ValidateMsgTypes(cp.MsgTypes); err != nil {
+		return err
+	}
+	return nil
+}
+
+// String returns a human readable string representation of a CircuitBreakerProposal
+func (cp CircuitBreakerProposal) String() string {
+	return fmt.Sprintf(`CircuitBreakerProposal:
+ Title:        %s
+ Description:  %s
+ Type:         %s
+ Action:       %s
+ Msg Types:    %s`,
+		cp.Title, cp.Description, cp.ProposalType(), cp.Action, strings.Join(cp.MsgTypes, ", "))
+}
@@ -0,0 +1,8 @@
+package types
+
+const (
+	// QueryDisabledMsgTypes is the query endpoint of the msg types whose routing is disabled
+	QueryDisabledMsgTypes = "disabled_msg_types"
+	// QueryParameters is the query endpoint of the circuit params
+	QueryParameters = "params"
+)
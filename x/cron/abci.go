@@ -0,0 +1,49 @@
+package cron
+
+import (
+	"strconv"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/cron/keeper"
+	"github.com/okex/exchain/x/cron/types"
+)
+
+// EndBlocker executes the due jobs up to the max jobs per block, the rest of the due jobs are executed first in the
+// following blocks. A job whose escrow can't cover the fee of an execution is removed instead
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	for _, job := range k.GetDueJobs(ctx, params.MaxJobsPerBlock) {
+		if job.Escrow.IsLT(params.Fee(job.GasLimit)) {
+			refund, err := k.RemoveJob(ctx, job)
+			if err != nil {
+				k.Logger(ctx).Error("failed to remove the job", "id", job.ID, "err", err)
+				continue
+			}
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeRemoveJob,
+				sdk.NewAttribute(types.AttributeKeyJobID, strconv.FormatUint(job.ID, 10)),
+				sdk.NewAttribute(types.AttributeKeyOwner, job.Owner.String()),
+				sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+			))
+			continue
+		}
+
+		gasUsed, fee, err := k.ExecuteJob(ctx, job, params)
+		if err != nil {
+			k.Logger(ctx).Debug("failed to execute the job", "id", job.ID, "err", err)
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeExecuteJob,
+			sdk.NewAttribute(types.AttributeKeyJobID, strconv.FormatUint(job.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyContract, job.Contract.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+		))
+	}
+}
@@ -0,0 +1,21 @@
+package cron
+
+import (
+	"github.com/okex/exchain/x/cron/keeper"
+	"github.com/okex/exchain/x/cron/types"
+)
+
+const (
+	ModuleName   = types.ModuleName
+	StoreKey     = types.StoreKey
+	RouterKey    = types.RouterKey
+	QuerierRoute = types.QuerierRoute
+)
+
+var (
+	NewKeeper = keeper.NewKeeper
+)
+
+type (
+	Keeper = keeper.Keeper
+)
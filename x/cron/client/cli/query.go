@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/x/cron/types"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	// Group cron queries under a subcommand
+	cronQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	cronQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryJob(queryRoute, cdc),
+			GetCmdQueryJobs(queryRoute, cdc),
+			GetCmdQueryParams(queryRoute, cdc),
+		)...,
+	)
+
+	return cronQueryCmd
+}
+
+// GetCmdQueryJob gets the job query command.
+func GetCmdQueryJob(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "job [job-id]",
+		Short: "query a job",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a job with its escrow and the height of its next execution.
+
+Example:
+$ %s query cron job 1
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			jobID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryJobParams(jobID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryJob)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var job types.Job
+			cdc.MustUnmarshalJSON(resp, &job)
+			return cliCtx.PrintOutput(job)
+		},
+	}
+}
+
+// GetCmdQueryJobs gets the jobs query command.
+func GetCmdQueryJobs(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "jobs [owner]",
+		Short: "query the jobs of an owner, or all the jobs",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the jobs owned by an account or a contract, or all the jobs if the owner is omitted.
+The owner can be given as a bech32 address or a 0x prefixed hex address.
+
+Example:
+$ %s query cron jobs ex1hw4r48aww06ldrfeuq2v438ujnl6alszzzqpph
+$ %s query cron jobs
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var owner sdk.AccAddress
+			if len(args) == 1 {
+				var err error
+				if owner, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+					return err
+				}
+			}
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryJobsParams(owner))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryJobs)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var jobs types.Jobs
+			cdc.MustUnmarshalJSON(resp, &jobs)
+			return cliCtx.PrintOutput(jobs)
+		},
+	}
+}
+
+// GetCmdQueryParams gets the cron params query command.
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "query the current cron parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as cron parameters.
+
+Example:
+$ %s query cron params
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParameters)
+			resp, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(resp, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	"github.com/okex/exchain/x/cron/types"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	cronTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		SuggestionsMinimumDistance: 2,
+	}
+
+	cronTxCmd.AddCommand(client.PostCommands(
+		GetCmdRegisterJob(cdc),
+		GetCmdDepositJob(cdc),
+		GetCmdCancelJob(cdc),
+	)...)
+	return cronTxCmd
+}
+
+// GetCmdRegisterJob gets the register job command
+func GetCmdRegisterJob(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [contract] [msg] [interval] [gas-limit] [deposit]",
+		Short: "register a job executing the msg on the contract every interval blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register a job executing the json msg on the contract at the end of every interval blocks, as
+the sender of the transaction and within the gas limit. The deposit is escrowed to pay the fees of the executions
+and must cover at least one execution using up the gas limit. The job is removed once its escrow runs out.
+
+Example:
+$ %s tx cron register ex14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s6fqu27 '{"liquidate":{}}' 10 200000 1okt --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseDecCoin(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterJob(cliCtx.GetFromAddress(), contract, args[1], interval, gasLimit, deposit)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdDepositJob gets the deposit job command
+func GetCmdDepositJob(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit [job-id] [amount]",
+		Short: "top up the escrow of a job",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Top up the escrow paying the fees of the executions of a job, anyone can deposit to any job.
+
+Example:
+$ %s tx cron deposit 1 10okt --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			jobID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseDecCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositJob(cliCtx.GetFromAddress(), jobID, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdCancelJob gets the cancel job command
+func GetCmdCancelJob(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [job-id]",
+		Short: "cancel a job, refunding the rest of its escrow to the owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a job by its owner, the rest of its escrow is refunded to the owner.
+
+Example:
+$ %s tx cron cancel 1 --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			jobID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelJob(cliCtx.GetFromAddress(), jobID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
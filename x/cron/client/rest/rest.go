@@ -0,0 +1,92 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/rest"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/cron/types"
+)
+
+// RegisterRoutes registers cron-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// get a single job by its id
+	r.HandleFunc(
+		"/cron/jobs/{jobID}",
+		queryJobHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get the jobs of an owner, or all the jobs
+	r.HandleFunc(
+		"/cron/jobs",
+		queryJobsHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get the cron params
+	r.HandleFunc(
+		"/cron/params",
+		queryParamsHandlerFn(cliCtx),
+	).Methods("GET")
+}
+
+func queryJobHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		jobID, err := strconv.ParseUint(mux.Vars(r)["jobID"], 10, 64)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeStrconvFailed, err.Error())
+			return
+		}
+		queryWithParams(w, r, cliCtx, types.QueryJob, types.NewQueryJobParams(jobID))
+	}
+}
+
+func queryJobsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var owner sdk.AccAddress
+		if ownerStr := r.URL.Query().Get("owner"); ownerStr != "" {
+			var err error
+			if owner, err = sdk.AccAddressFromBech32(ownerStr); err != nil {
+				common.HandleErrorMsg(w, cliCtx, common.CodeCreateAddrFromBech32Failed, err.Error())
+				return
+			}
+		}
+		queryWithParams(w, r, cliCtx, types.QueryJobs, types.NewQueryJobsParams(owner))
+	}
+}
+
+func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		queryWithParams(w, r, cliCtx, types.QueryParameters, nil)
+	}
+}
+
+func queryWithParams(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, path string, params interface{}) {
+	cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+	if !ok {
+		return
+	}
+
+	var jsonBytes []byte
+	if params != nil {
+		var err error
+		if jsonBytes, err = cliCtx.Codec.MarshalJSON(params); err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorCodecFails)
+			return
+		}
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+	res, height, err := cliCtx.QueryWithData(route, jsonBytes)
+	if err != nil {
+		common.HandleErrorResponseV2(w, http.StatusInternalServerError, common.ErrorABCIQueryFails)
+		return
+	}
+
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, res)
+}
@@ -0,0 +1,37 @@
+package cron
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/cron/keeper"
+	"github.com/okex/exchain/x/cron/types"
+)
+
+// InitGenesis initializes the cron module's state from a given genesis state
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	// if module account doesn't exist, it will create automatically
+	moduleAcc := k.SupplyKeeper().GetModuleAccount(ctx, types.ModuleName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	k.SetParams(ctx, data.Params)
+
+	// the module account must cover the escrows of all the jobs
+	escrows := sdk.SysCoins{}
+	for _, job := range data.Jobs {
+		k.SetJob(ctx, job)
+		escrows = escrows.Add(job.Escrow)
+	}
+	if !moduleAcc.GetCoins().IsAllGTE(escrows) {
+		panic(fmt.Sprintf("%s module account balance %s is less than the escrows %s",
+			types.ModuleName, moduleAcc.GetCoins(), escrows))
+	}
+	k.SetNextJobID(ctx, data.NextJobID)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetJobs(ctx), k.GetNextJobID(ctx))
+}
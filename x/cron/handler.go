@@ -0,0 +1,113 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/cron/keeper"
+	"github.com/okex/exchain/x/cron/types"
+)
+
+// NewHandler creates an sdk.Handler for all the cron type messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx.SetEventManager(sdk.NewEventManager())
+
+		if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+			return nil, types.ErrCronNotSupported(ctx.BlockHeight())
+		}
+
+		switch msg := msg.(type) {
+		case types.MsgRegisterJob:
+			return handleMsgRegisterJob(ctx, k, msg)
+		case types.MsgDepositJob:
+			return handleMsgDepositJob(ctx, k, msg)
+		case types.MsgCancelJob:
+			return handleMsgCancelJob(ctx, k, msg)
+		default:
+			return nil, types.ErrUnknownCronMsgType(fmt.Sprintf("%T", msg))
+		}
+	}
+}
+
+func handleMsgRegisterJob(ctx sdk.Context, k keeper.Keeper, msg types.MsgRegisterJob) (*sdk.Result, error) {
+	job, err := k.RegisterJob(ctx, msg.Owner, msg.Contract, msg.Msg, msg.Interval, msg.GasLimit, msg.Deposit)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterJob,
+			sdk.NewAttribute(types.AttributeKeyJobID, strconv.FormatUint(job.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, job.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyContract, job.Contract.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, job.Escrow.String()),
+			sdk.NewAttribute(types.AttributeKeyNextHeight, strconv.FormatInt(job.NextHeight, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgDepositJob(ctx sdk.Context, k keeper.Keeper, msg types.MsgDepositJob) (*sdk.Result, error) {
+	job, found := k.GetJob(ctx, msg.JobID)
+	if !found {
+		return nil, types.ErrNoJobFound(msg.JobID)
+	}
+
+	job, err := k.DepositJob(ctx, msg.Sender, job, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDepositJob,
+			sdk.NewAttribute(types.AttributeKeyJobID, strconv.FormatUint(job.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelJob(ctx sdk.Context, k keeper.Keeper, msg types.MsgCancelJob) (*sdk.Result, error) {
+	job, found := k.GetJob(ctx, msg.JobID)
+	if !found {
+		return nil, types.ErrNoJobFound(msg.JobID)
+	}
+	if !job.Owner.Equals(msg.Owner) {
+		return nil, types.ErrNotJobOwner(msg.Owner.String(), msg.JobID)
+	}
+
+	refund, err := k.RemoveJob(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelJob,
+			sdk.NewAttribute(types.AttributeKeyJobID, strconv.FormatUint(job.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, job.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/okex/exchain/x/cron/types"
+	"github.com/okex/exchain/x/wasm/watcher"
+)
+
+// RegisterJob escrows the deposit from the owner into the module account and registers the job, which is executed
+// first at the end of the block interval blocks later
+func (k Keeper) RegisterJob(ctx sdk.Context, owner, contract sdk.AccAddress, msg string, interval int64,
+	gasLimit uint64, deposit sdk.SysCoin) (types.Job, error) {
+	params := k.GetParams(ctx)
+	if gasLimit > params.MaxGasLimit {
+		return types.Job{}, types.ErrInvalidGasLimit(fmt.Sprintf("%d exceeds the max gas limit %d", gasLimit, params.MaxGasLimit))
+	}
+	if maxFee := params.Fee(gasLimit); deposit.IsLT(maxFee) {
+		return types.Job{}, types.ErrInvalidDeposit(fmt.Sprintf("%s is less than the fee %s of an execution", deposit, maxFee))
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, deposit.ToCoins()); err != nil {
+		return types.Job{}, types.ErrSendCoinsFromAccountToModuleFailed(err.Error())
+	}
+
+	job := types.NewJob(k.GetNextJobID(ctx), owner, contract, msg, interval, gasLimit, deposit, ctx.BlockHeight()+interval)
+	k.SetJob(ctx, job)
+	k.SetNextJobID(ctx, job.ID+1)
+	return job, nil
+}
+
+// DepositJob escrows the amount from the sender into the module account and adds it to the escrow of the job
+func (k Keeper) DepositJob(ctx sdk.Context, sender sdk.AccAddress, job types.Job, amount sdk.SysCoin) (types.Job, error) {
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount.ToCoins()); err != nil {
+		return job, types.ErrSendCoinsFromAccountToModuleFailed(err.Error())
+	}
+
+	job.Escrow = job.Escrow.Add(amount)
+	k.SetJob(ctx, job)
+	return job, nil
+}
+
+// RemoveJob refunds the rest of the escrow of the job to its owner and removes the job
+func (k Keeper) RemoveJob(ctx sdk.Context, job types.Job) (sdk.SysCoin, error) {
+	if job.Escrow.IsPositive() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, job.Owner, job.Escrow.ToCoins()); err != nil {
+			return job.Escrow, types.ErrSendCoinsFromModuleToAccountFailed(err.Error())
+		}
+	}
+
+	k.DeleteJob(ctx, job)
+	return job.Escrow, nil
+}
+
+// ExecuteJob executes the msg of the job on its contract within its gas limit and pays the fee of the gas used from
+// its escrow to the fee collector, whether the execution succeeds or not. The state changes of a failed execution are
+// discarded, and the job is rescheduled interval blocks later either way. The escrow must cover the fee of an
+// execution using up the gas limit.
+func (k Keeper) ExecuteJob(ctx sdk.Context, job types.Job, params types.Params) (gasUsed uint64, fee sdk.SysCoin, err error) {
+	gasUsed, err = k.execute(ctx, job)
+
+	fee = params.Fee(gasUsed)
+	if fee.IsPositive() {
+		if sendErr := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auth.FeeCollectorName, fee.ToCoins()); sendErr != nil {
+			panic(fmt.Sprintf("failed to pay the fee %s of job %d: %s", fee, job.ID, sendErr))
+		}
+	}
+
+	k.DeleteJob(ctx, job)
+	job.Escrow = job.Escrow.Sub(fee)
+	job.NextHeight = ctx.BlockHeight() + job.Interval
+	k.SetJob(ctx, job)
+	return gasUsed, fee, err
+}
+
+// execute executes the msg of the job in a cached context limited to the gas limit of the job, an execution running
+// out of gas uses up the gas limit
+func (k Keeper) execute(ctx sdk.Context, job types.Job) (gasUsed uint64, err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx.SetGasMeter(sdk.NewGasMeter(job.GasLimit))
+	snapshot := watcher.Snapshot()
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			gasUsed, err = job.GasLimit, fmt.Errorf("job %d hit gas limit %d", job.ID, job.GasLimit)
+		}
+		if err != nil {
+			watcher.RevertToSnapshot(snapshot)
+		}
+	}()
+
+	if _, err = k.wasmKeeper.Execute(cacheCtx, job.Contract, job.Owner, []byte(job.Msg), nil); err != nil {
+		return cacheCtx.GasMeter().GasConsumed(), err
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return cacheCtx.GasMeter().GasConsumed(), nil
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/x/cron/types"
+	"github.com/okex/exchain/x/params"
+)
+
+// Keeper of the cron store
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	paramSpace   types.Subspace
+	supplyKeeper types.SupplyKeeper
+
+	wasmKeeper types.WasmKeeper
+}
+
+// NewKeeper creates a cron keeper
+func NewKeeper(supplyKeeper types.SupplyKeeper, key sdk.StoreKey, cdc *codec.Codec, ps params.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:     key,
+		cdc:          cdc,
+		paramSpace:   ps,
+		supplyKeeper: supplyKeeper,
+	}
+}
+
+// SetWasmKeeper sets the keeper executing the contracts of the jobs, which is created after the cron keeper as the
+// contracts register the jobs through the wasm messenger
+func (k *Keeper) SetWasmKeeper(wk types.WasmKeeper) {
+	k.wasmKeeper = wk
+}
+
+// SupplyKeeper returns the supply keeper
+func (k Keeper) SupplyKeeper() types.SupplyKeeper {
+	return k.supplyKeeper
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of cron parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
+
+// SetParams sets the cron parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetNextJobID gets the id for the next job
+func (k Keeper) GetNextJobID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.JobIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextJobID sets the id for the next job
+func (k Keeper) SetNextJobID(ctx sdk.Context, jobID uint64) {
+	ctx.KVStore(k.storeKey).Set(types.JobIDKey, sdk.Uint64ToBigEndian(jobID))
+}
+
+// GetJob gets a job from store
+func (k Keeper) GetJob(ctx sdk.Context, jobID uint64) (job types.Job, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetJobKey(jobID))
+	if bz == nil {
+		return job, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &job)
+	return job, true
+}
+
+// SetJob sets a job and its indexes by the owner and the next height into store. The job must be deleted first if
+// its next height changes
+func (k Keeper) SetJob(ctx sdk.Context, job types.Job) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetJobKey(job.ID), k.cdc.MustMarshalBinaryLengthPrefixed(job))
+	store.Set(types.GetOwnerJobKey(job.Owner, job.ID), []byte{})
+	store.Set(types.GetScheduleKey(job.NextHeight, job.ID), []byte{})
+}
+
+// DeleteJob deletes a job and its indexes from store
+func (k Keeper) DeleteJob(ctx sdk.Context, job types.Job) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetJobKey(job.ID))
+	store.Delete(types.GetOwnerJobKey(job.Owner, job.ID))
+	store.Delete(types.GetScheduleKey(job.NextHeight, job.ID))
+}
+
+// IterateJobs iterates over all the jobs
+func (k Keeper) IterateJobs(ctx sdk.Context, handler func(job types.Job) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.JobPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var job types.Job
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &job)
+		if handler(job) {
+			break
+		}
+	}
+}
+
+// GetJobs gets all the jobs
+func (k Keeper) GetJobs(ctx sdk.Context) (jobs types.Jobs) {
+	k.IterateJobs(ctx, func(job types.Job) bool {
+		jobs = append(jobs, job)
+		return false
+	})
+	return
+}
+
+// GetJobsByOwner gets all the jobs of an owner. The addresses of different lengths may share the prefix, so the
+// jobs are filtered by the owner again
+func (k Keeper) GetJobsByOwner(ctx sdk.Context, owner sdk.AccAddress) (jobs types.Jobs) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetOwnerJobsPrefix(owner))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		job, found := k.GetJob(ctx, binary.BigEndian.Uint64(key[len(key)-8:]))
+		if !found {
+			panic("the indexed job can't be found")
+		}
+		if job.Owner.Equals(owner) {
+			jobs = append(jobs, job)
+		}
+	}
+	return
+}
+
+// GetDueJobs gets at most limit jobs whose next height is not after the height of the block, in the order of their
+// next heights and ids
+func (k Keeper) GetDueJobs(ctx sdk.Context, limit uint64) (jobs types.Jobs) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.SchedulePrefix, types.GetScheduleKey(ctx.BlockHeight()+1, 0))
+	defer iterator.Close()
+	for ; iterator.Valid() && uint64(len(jobs)) < limit; iterator.Next() {
+		key := iterator.Key()
+		job, found := k.GetJob(ctx, binary.BigEndian.Uint64(key[len(key)-8:]))
+		if !found {
+			panic("the scheduled job can't be found")
+		}
+		jobs = append(jobs, job)
+	}
+	return
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/okex/exchain/x/cron/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mockWasmKeeper consumes the gas and writes a key to the store of the executed contract, failing if err is set
+type mockWasmKeeper struct {
+	key  sdk.StoreKey
+	gas  uint64
+	err  error
+	msgs []string
+}
+
+func (m *mockWasmKeeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+	m.msgs = append(m.msgs, string(msg))
+	ctx.KVStore(m.key).Set(contractAddress, caller)
+	ctx.GasMeter().ConsumeGas(m.gas, "execute")
+	return nil, m.err
+}
+
+func createTestInputWithWasm(t *testing.T) (sdk.Context, Keeper, *mockSupplyKeeper, *mockWasmKeeper) {
+	keyWasm := sdk.NewKVStoreKey("wasm")
+	ctx, k, sk := createTestInput(t, keyWasm)
+	wk := &mockWasmKeeper{key: keyWasm, gas: 100000}
+	k.SetWasmKeeper(wk)
+	return ctx, k, sk, wk
+}
+
+func TestRegisterAndExecuteJob(t *testing.T) {
+	ctx, k, sk, wk := createTestInputWithWasm(t)
+	owner, contract := sdk.AccAddress("owner"), sdk.AccAddress("contract")
+	sk.balances[owner.String()] = sdk.NewCoins(okt(100))
+	params := k.GetParams(ctx)
+
+	// the gas limit is capped and the deposit must cover an execution using up the gas limit
+	_, err := k.RegisterJob(ctx, owner, contract, `{"ping":{}}`, 5, params.MaxGasLimit+1, okt(100))
+	require.Error(t, err)
+	_, err = k.RegisterJob(ctx, owner, contract, `{"ping":{}}`, 5, 200000, okt(1))
+	require.Error(t, err)
+
+	job, err := k.RegisterJob(ctx, owner, contract, `{"ping":{}}`, 5, 200000, okt(5))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), job.ID)
+	require.Equal(t, int64(15), job.NextHeight)
+	require.Equal(t, sdk.NewCoins(okt(5)), sk.balances[types.ModuleName])
+	require.Equal(t, types.Jobs{job}, k.GetJobsByOwner(ctx, owner))
+
+	// the job isn't due before its next height
+	ctx.SetBlockHeight(14)
+	require.Empty(t, k.GetDueJobs(ctx, params.MaxJobsPerBlock))
+
+	ctx.SetBlockHeight(15)
+	due := k.GetDueJobs(ctx, params.MaxJobsPerBlock)
+	require.Equal(t, types.Jobs{job}, due)
+	gasUsed, fee, err := k.ExecuteJob(ctx, due[0], params)
+	require.NoError(t, err)
+	require.True(t, gasUsed > 100000)
+	require.Equal(t, params.Fee(gasUsed), fee)
+	require.Equal(t, []string{`{"ping":{}}`}, wk.msgs)
+	require.Equal(t, sdk.NewCoins(fee), sk.balances[auth.FeeCollectorName])
+	require.Equal(t, []byte(owner), ctx.KVStore(wk.key).Get(contract))
+
+	// the job is rescheduled with the fee paid from its escrow
+	require.Empty(t, k.GetDueJobs(ctx, params.MaxJobsPerBlock))
+	job, found := k.GetJob(ctx, job.ID)
+	require.True(t, found)
+	require.Equal(t, int64(20), job.NextHeight)
+	require.Equal(t, okt(5).Sub(fee), job.Escrow)
+
+	// a failed execution is charged but its state changes are discarded
+	ctx.SetBlockHeight(20)
+	ctx.KVStore(wk.key).Delete(contract)
+	wk.err = errors.New("failed")
+	gasUsed, fee, err = k.ExecuteJob(ctx, job, params)
+	require.Error(t, err)
+	require.Equal(t, params.Fee(gasUsed), fee)
+	require.Nil(t, ctx.KVStore(wk.key).Get(contract))
+
+	// an execution running out of gas uses up the gas limit
+	job, _ = k.GetJob(ctx, job.ID)
+	ctx.SetBlockHeight(25)
+	wk.err, wk.gas = nil, 300000
+	gasUsed, fee, err = k.ExecuteJob(ctx, job, params)
+	require.Error(t, err)
+	require.Equal(t, uint64(200000), gasUsed)
+	require.Equal(t, okt(2), fee)
+	require.Nil(t, ctx.KVStore(wk.key).Get(contract))
+
+	// the escrow left can't cover another execution
+	job, _ = k.GetJob(ctx, job.ID)
+	require.True(t, job.Escrow.IsLT(params.Fee(job.GasLimit)))
+	require.Equal(t, sdk.NewCoins(job.Escrow), sk.balances[types.ModuleName])
+}
+
+func TestDueJobsAndRemoveJob(t *testing.T) {
+	ctx, k, sk, _ := createTestInputWithWasm(t)
+	owner, contract := sdk.AccAddress("owner"), sdk.AccAddress("contract")
+	sk.balances[owner.String()] = sdk.NewCoins(okt(1000))
+
+	for interval := int64(3); interval > 0; interval-- {
+		_, err := k.RegisterJob(ctx, owner, contract, `{}`, interval, 100000, okt(100))
+		require.NoError(t, err)
+	}
+
+	// the due jobs are capped and ordered by their next heights
+	ctx.SetBlockHeight(20)
+	due := k.GetDueJobs(ctx, 2)
+	require.Len(t, due, 2)
+	require.Equal(t, uint64(3), due[0].ID)
+	require.Equal(t, uint64(2), due[1].ID)
+
+	// anyone can deposit to a job, the rest of the escrow is refunded to the owner on removal
+	other := sdk.AccAddress("other")
+	sk.balances[other.String()] = sdk.NewCoins(okt(50))
+	job, err := k.DepositJob(ctx, other, due[0], okt(50))
+	require.NoError(t, err)
+	require.Equal(t, okt(150), job.Escrow)
+	refund, err := k.RemoveJob(ctx, job)
+	require.NoError(t, err)
+	require.Equal(t, okt(150), refund)
+	require.Equal(t, sdk.NewCoins(okt(850)), sk.balances[owner.String()])
+
+	_, found := k.GetJob(ctx, job.ID)
+	require.False(t, found)
+	require.Len(t, k.GetJobsByOwner(ctx, owner), 2)
+	require.Len(t, k.GetDueJobs(ctx, 10), 2)
+	require.Len(t, k.GetJobs(ctx), 2)
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/cron/types"
+)
+
+// NewQuerier creates a new querier for cron clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryJob:
+			return queryJob(ctx, req, k)
+		case types.QueryJobs:
+			return queryJobs(ctx, req, k)
+		case types.QueryParameters:
+			return queryParams(ctx, k)
+		default:
+			return nil, types.ErrUnknownCronQueryType(path[0])
+		}
+	}
+}
+
+func queryJob(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryJobParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	job, found := k.GetJob(ctx, params.JobID)
+	if !found {
+		return nil, types.ErrNoJobFound(params.JobID)
+	}
+	return marshalJSON(job)
+}
+
+// queryJobs returns the jobs of the owner, or all the jobs if the owner is empty
+func queryJobs(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryJobsParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	var jobs types.Jobs
+	if params.Owner.Empty() {
+		jobs = k.GetJobs(ctx)
+	} else {
+		jobs = k.GetJobsByOwner(ctx, params.Owner)
+	}
+	if jobs == nil {
+		jobs = types.Jobs{}
+	}
+	return marshalJSON(jobs)
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	return marshalJSON(k.GetParams(ctx))
+}
+
+func marshalJSON(o interface{}) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, o)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
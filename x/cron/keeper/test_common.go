@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/store"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	supplyexported "github.com/okex/exchain/libs/cosmos-sdk/x/supply/exported"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	dbm "github.com/okex/exchain/libs/tm-db"
+	"github.com/okex/exchain/x/cron/types"
+	"github.com/okex/exchain/x/params"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSupplyKeeper struct {
+	balances map[string]sdk.SysCoins
+}
+
+func (m *mockSupplyKeeper) GetModuleAccount(sdk.Context, string) supplyexported.ModuleAccountI {
+	return nil
+}
+
+func (m *mockSupplyKeeper) send(from, to string, amt sdk.Coins) error {
+	if !m.balances[from].IsAllGTE(amt) {
+		return errors.New("insufficient funds")
+	}
+	m.balances[from] = m.balances[from].Sub(amt)
+	m.balances[to] = m.balances[to].Add(amt...)
+	return nil
+}
+
+func (m *mockSupplyKeeper) SendCoinsFromAccountToModule(_ sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.send(senderAddr.String(), recipientModule, amt)
+}
+
+func (m *mockSupplyKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(senderModule, recipientAddr.String(), amt)
+}
+
+func (m *mockSupplyKeeper) SendCoinsFromModuleToModule(_ sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return m.send(senderModule, recipientModule, amt)
+}
+
+func createTestInput(t *testing.T, keys ...sdk.StoreKey) (sdk.Context, Keeper, *mockSupplyKeeper) {
+	keyCron := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyCron, sdk.StoreTypeIAVL, db)
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.Nil(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid", Height: 10}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	sk := &mockSupplyKeeper{balances: make(map[string]sdk.SysCoins)}
+	k := NewKeeper(sk, keyCron, cdc, pk.Subspace(types.ModuleName))
+	k.SetParams(ctx, types.DefaultParams())
+	return ctx, k, sk
+}
+
+func okt(amount int64) sdk.SysCoin {
+	return sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(amount, 4))
+}
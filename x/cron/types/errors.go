@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	DefaultCodespace string = ModuleName
+
+	CodeInvalidAddress                     uint32 = 73000
+	CodeInvalidJobMsg                      uint32 = 73001
+	CodeInvalidInterval                    uint32 = 73002
+	CodeInvalidGasLimit                    uint32 = 73003
+	CodeInvalidDeposit                     uint32 = 73004
+	CodeNoJobFound                         uint32 = 73005
+	CodeNotJobOwner                        uint32 = 73006
+	CodeSendCoinsFromAccountToModuleFailed uint32 = 73007
+	CodeSendCoinsFromModuleToAccountFailed uint32 = 73008
+	CodeUnknownCronMsgType                 uint32 = 73009
+	CodeUnknownCronQueryType               uint32 = 73010
+	CodeCronNotSupported                   uint32 = 73011
+)
+
+// ErrInvalidAddress returns an error when an address is invalid
+func ErrInvalidAddress(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidAddress, fmt.Sprintf("failed. invalid address: %s", msg))}
+}
+
+// ErrInvalidJobMsg returns an error when the msg executed by a job is not a json object
+func ErrInvalidJobMsg(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidJobMsg, fmt.Sprintf("failed. invalid job msg: %s", msg))}
+}
+
+// ErrInvalidInterval returns an error when the interval of a job is not positive
+func ErrInvalidInterval(interval int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidInterval, fmt.Sprintf("failed. invalid interval %d, it should be positive", interval))}
+}
+
+// ErrInvalidGasLimit returns an error when the gas limit of a job is zero or above the max gas limit
+func ErrInvalidGasLimit(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidGasLimit, fmt.Sprintf("failed. invalid gas limit: %s", msg))}
+}
+
+// ErrInvalidDeposit returns an error when the deposit of a job is invalid
+func ErrInvalidDeposit(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidDeposit, fmt.Sprintf("failed. invalid deposit: %s", msg))}
+}
+
+// ErrNoJobFound returns an error when a job doesn't exist
+func ErrNoJobFound(jobID uint64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNoJobFound, fmt.Sprintf("failed. job %d does not exist", jobID))}
+}
+
+// ErrNotJobOwner returns an error when an address other than the owner cancels a job
+func ErrNotJobOwner(addr string, jobID uint64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNotJobOwner, fmt.Sprintf("failed. %s is not the owner of job %d", addr, jobID))}
+}
+
+// ErrSendCoinsFromAccountToModuleFailed returns an error when it fails to send coins from an account to the module
+func ErrSendCoinsFromAccountToModuleFailed(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeSendCoinsFromAccountToModuleFailed, fmt.Sprintf("failed. send coins from account to module failed: %s", msg))}
+}
+
+// ErrSendCoinsFromModuleToAccountFailed returns an error when it fails to send coins from the module to an account
+func ErrSendCoinsFromModuleToAccountFailed(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeSendCoinsFromModuleToAccountFailed, fmt.Sprintf("failed. send coins from module to account failed: %s", msg))}
+}
+
+// ErrUnknownCronMsgType returns an error when the msg type is unknown
+func ErrUnknownCronMsgType(msgType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownCronMsgType, fmt.Sprintf("unrecognized cron message type: %s", msgType))}
+}
+
+// ErrUnknownCronQueryType returns an error when the query path is unknown
+func ErrUnknownCronQueryType(path string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownCronQueryType, fmt.Sprintf("unknown cron query endpoint: %s", path))}
+}
+
+// ErrCronNotSupported returns an error when the cron module is not enabled at the height
+func ErrCronNotSupported(height int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeCronNotSupported, fmt.Sprintf("cron module is not supported at height %d", height))}
+}
@@ -0,0 +1,22 @@
+package types
+
+// cron module event types
+const (
+	EventTypeRegisterJob = "register_job"
+	EventTypeDepositJob  = "deposit_job"
+	EventTypeCancelJob   = "cancel_job"
+	EventTypeExecuteJob  = "execute_job"
+	EventTypeRemoveJob   = "remove_job"
+
+	AttributeKeyJobID      = "job_id"
+	AttributeKeyOwner      = "owner"
+	AttributeKeyContract   = "contract"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyNextHeight = "next_height"
+	AttributeKeyGasUsed    = "gas_used"
+	AttributeKeyFee        = "fee"
+	AttributeKeySuccess    = "success"
+	AttributeKeyRefund     = "refund"
+
+	AttributeValueCategory = ModuleName
+)
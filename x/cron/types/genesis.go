@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState is the state of the cron module that must be provided at genesis
+type GenesisState struct {
+	Params    Params `json:"params" yaml:"params"`
+	Jobs      Jobs   `json:"jobs" yaml:"jobs"`
+	NextJobID uint64 `json:"next_job_id" yaml:"next_job_id"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, jobs Jobs, nextJobID uint64) GenesisState {
+	return GenesisState{
+		Params:    params,
+		Jobs:      jobs,
+		NextJobID: nextJobID,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), nil, 1)
+}
+
+// ValidateGenesis validates the cron genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	if data.NextJobID == 0 {
+		return fmt.Errorf("next job id should be positive")
+	}
+	ids := make(map[uint64]bool, len(data.Jobs))
+	for _, job := range data.Jobs {
+		if err := job.ValidateBasic(); err != nil {
+			return err
+		}
+		if ids[job.ID] {
+			return fmt.Errorf("duplicated job id %d", job.ID)
+		}
+		if job.ID == 0 || job.ID >= data.NextJobID {
+			return fmt.Errorf("job id %d should be in [1, %d)", job.ID, data.NextJobID)
+		}
+		if job.NextHeight <= 0 {
+			return fmt.Errorf("next height %d of job %d should be positive", job.NextHeight, job.ID)
+		}
+		ids[job.ID] = true
+	}
+	return nil
+}
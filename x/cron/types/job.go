@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// Job is a recurring execution of Msg on Contract by Owner at the end of every Interval blocks. The fees of the
+// executions are paid from the escrow of the job, which anyone can top up
+type Job struct {
+	ID         uint64         `json:"id" yaml:"id"`
+	Owner      sdk.AccAddress `json:"owner" yaml:"owner"`
+	Contract   sdk.AccAddress `json:"contract" yaml:"contract"`
+	Msg        string         `json:"msg" yaml:"msg"`
+	Interval   int64          `json:"interval" yaml:"interval"`
+	GasLimit   uint64         `json:"gas_limit" yaml:"gas_limit"`
+	Escrow     sdk.SysCoin    `json:"escrow" yaml:"escrow"`
+	NextHeight int64          `json:"next_height" yaml:"next_height"`
+}
+
+// NewJob creates a new instance of Job
+func NewJob(id uint64, owner, contract sdk.AccAddress, msg string, interval int64, gasLimit uint64,
+	escrow sdk.SysCoin, nextHeight int64) Job {
+	return Job{
+		ID:         id,
+		Owner:      owner,
+		Contract:   contract,
+		Msg:        msg,
+		Interval:   interval,
+		GasLimit:   gasLimit,
+		Escrow:     escrow,
+		NextHeight: nextHeight,
+	}
+}
+
+// ValidateBasic checks the addresses, the msg, the schedule and the escrow of the job
+func (j Job) ValidateBasic() sdk.Error {
+	if err := validateJob(j.Owner, j.Contract, j.Msg, j.Interval, j.GasLimit); err != nil {
+		return err
+	}
+	// the escrow may be used up by the last execution
+	if j.Escrow.Amount.IsNil() || !j.Escrow.IsValid() || j.Escrow.Denom != sdk.DefaultBondDenom {
+		return ErrInvalidDeposit(fmt.Sprintf("escrow %s", j.Escrow))
+	}
+	return nil
+}
+
+// String returns a human readable string representation of Job
+func (j Job) String() string {
+	return fmt.Sprintf(`Job:
+  ID:           %d
+  Owner:        %s
+  Contract:     %s
+  Msg:          %s
+  Interval:     %d
+  Gas Limit:    %d
+  Escrow:       %s
+  Next Height:  %d`,
+		j.ID, j.Owner, j.Contract, j.Msg, j.Interval, j.GasLimit, j.Escrow, j.NextHeight)
+}
+
+// Jobs is a collection of Job
+type Jobs []Job
+
+// String returns a human readable string representation of Jobs
+func (js Jobs) String() (out string) {
+	for _, j := range js {
+		out += j.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+func validateJob(owner, contract sdk.AccAddress, msg string, interval int64, gasLimit uint64) sdk.Error {
+	if owner.Empty() {
+		return ErrInvalidAddress("owner is empty")
+	}
+	if contract.Empty() {
+		return ErrInvalidAddress("contract is empty")
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(msg), &obj); err != nil {
+		return ErrInvalidJobMsg(err.Error())
+	}
+	if interval <= 0 {
+		return ErrInvalidInterval(interval)
+	}
+	if gasLimit == 0 {
+		return ErrInvalidGasLimit("gas limit should be positive")
+	}
+	return nil
+}
+
+// validateDeposit checks that the deposit is a positive amount of the native token, in which the fees are paid
+func validateDeposit(deposit sdk.SysCoin) sdk.Error {
+	if deposit.Amount.IsNil() || !deposit.IsValid() || !deposit.IsPositive() {
+		return ErrInvalidDeposit(deposit.String())
+	}
+	if deposit.Denom != sdk.DefaultBondDenom {
+		return ErrInvalidDeposit(fmt.Sprintf("%s, only %s is accepted", deposit, sdk.DefaultBondDenom))
+	}
+	return nil
+}
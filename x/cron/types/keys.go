@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the cron module
+	ModuleName = "cron"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the cron module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the cron module
+	QuerierRoute = ModuleName
+)
+
+var (
+	JobPrefix      = []byte{0x01}
+	OwnerJobPrefix = []byte{0x02}
+	SchedulePrefix = []byte{0x03}
+	JobIDKey       = []byte{0x04}
+)
+
+// GetJobKey gets the key for a job
+func GetJobKey(jobID uint64) []byte {
+	return append(JobPrefix, sdk.Uint64ToBigEndian(jobID)...)
+}
+
+// GetOwnerJobsPrefix gets the prefix key for all the jobs of an owner
+func GetOwnerJobsPrefix(owner sdk.AccAddress) []byte {
+	return append(OwnerJobPrefix, owner.Bytes()...)
+}
+
+// GetOwnerJobKey gets the key for the index of a job by its owner
+func GetOwnerJobKey(owner sdk.AccAddress, jobID uint64) []byte {
+	return append(GetOwnerJobsPrefix(owner), sdk.Uint64ToBigEndian(jobID)...)
+}
+
+// GetScheduleKey gets the key for the index of a job by the height of its next execution, so that the due jobs are
+// iterated in the order of their heights and ids
+func GetScheduleKey(height int64, jobID uint64) []byte {
+	return append(append(SchedulePrefix, sdk.Uint64ToBigEndian(uint64(height))...), sdk.Uint64ToBigEndian(jobID)...)
+}
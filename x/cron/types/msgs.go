@@ -0,0 +1,137 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = MsgRegisterJob{}
+	_ sdk.Msg = MsgDepositJob{}
+	_ sdk.Msg = MsgCancelJob{}
+)
+
+// MsgRegisterJob registers a job executing the msg on the contract every interval blocks, escrowing the deposit to
+// pay the fees of the executions
+type MsgRegisterJob struct {
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+	Msg      string         `json:"msg" yaml:"msg"`
+	Interval int64          `json:"interval" yaml:"interval"`
+	GasLimit uint64         `json:"gas_limit" yaml:"gas_limit"`
+	Deposit  sdk.SysCoin    `json:"deposit" yaml:"deposit"`
+}
+
+// NewMsgRegisterJob creates a new instance of MsgRegisterJob
+func NewMsgRegisterJob(owner, contract sdk.AccAddress, msg string, interval int64, gasLimit uint64,
+	deposit sdk.SysCoin) MsgRegisterJob {
+	return MsgRegisterJob{
+		Owner:    owner,
+		Contract: contract,
+		Msg:      msg,
+		Interval: interval,
+		GasLimit: gasLimit,
+		Deposit:  deposit,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterJob) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterJob) Type() string { return "register_job" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterJob) ValidateBasic() sdk.Error {
+	if err := validateJob(msg.Owner, msg.Contract, msg.Msg, msg.Interval, msg.GasLimit); err != nil {
+		return err
+	}
+	return validateDeposit(msg.Deposit)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRegisterJob) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterJob) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgDepositJob tops up the escrow of a job, anyone can deposit to any job
+type MsgDepositJob struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	JobID  uint64         `json:"job_id" yaml:"job_id"`
+	Amount sdk.SysCoin    `json:"amount" yaml:"amount"`
+}
+
+// NewMsgDepositJob creates a new instance of MsgDepositJob
+func NewMsgDepositJob(sender sdk.AccAddress, jobID uint64, amount sdk.SysCoin) MsgDepositJob {
+	return MsgDepositJob{
+		Sender: sender,
+		JobID:  jobID,
+		Amount: amount,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgDepositJob) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgDepositJob) Type() string { return "deposit_job" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDepositJob) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrInvalidAddress("sender is empty")
+	}
+	return validateDeposit(msg.Amount)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDepositJob) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDepositJob) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgCancelJob cancels a job by its owner, the rest of the escrow is refunded to the owner
+type MsgCancelJob struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	JobID uint64         `json:"job_id" yaml:"job_id"`
+}
+
+// NewMsgCancelJob creates a new instance of MsgCancelJob
+func NewMsgCancelJob(owner sdk.AccAddress, jobID uint64) MsgCancelJob {
+	return MsgCancelJob{
+		Owner: owner,
+		JobID: jobID,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelJob) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelJob) Type() string { return "cancel_job" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelJob) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return ErrInvalidAddress("owner is empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelJob) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelJob) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRegisterJobValidateBasic(t *testing.T) {
+	owner, contract := sdk.AccAddress("owner"), sdk.AccAddress("contract")
+	deposit := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.OneDec())
+
+	tests := []struct {
+		name string
+		msg  MsgRegisterJob
+		ok   bool
+	}{
+		{"valid", NewMsgRegisterJob(owner, contract, `{"ping":{}}`, 10, 100000, deposit), true},
+		{"empty owner", NewMsgRegisterJob(nil, contract, `{"ping":{}}`, 10, 100000, deposit), false},
+		{"empty contract", NewMsgRegisterJob(owner, nil, `{"ping":{}}`, 10, 100000, deposit), false},
+		{"msg not an object", NewMsgRegisterJob(owner, contract, `"ping"`, 10, 100000, deposit), false},
+		{"zero interval", NewMsgRegisterJob(owner, contract, `{"ping":{}}`, 0, 100000, deposit), false},
+		{"zero gas limit", NewMsgRegisterJob(owner, contract, `{"ping":{}}`, 10, 0, deposit), false},
+		{"zero deposit", NewMsgRegisterJob(owner, contract, `{"ping":{}}`, 10, 100000,
+			sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.ZeroDec())), false},
+		{"deposit not okt", NewMsgRegisterJob(owner, contract, `{"ping":{}}`, 10, 100000,
+			sdk.NewDecCoinFromDec("usdt", sdk.OneDec())), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.ok {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
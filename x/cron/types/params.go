@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/params"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Parameter store key
+var (
+	DefaultMaxJobsPerBlock uint64 = 10
+	DefaultMaxGasLimit     uint64 = 1000000
+	DefaultGasPrice               = sdk.NewDecWithPrec(1, 9)
+
+	ParamStoreKeyMaxJobsPerBlock = []byte("MaxJobsPerBlock")
+	ParamStoreKeyMaxGasLimit     = []byte("MaxGasLimit")
+	ParamStoreKeyGasPrice        = []byte("GasPrice")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params defines the cron module params
+type Params struct {
+	// max_jobs_per_block caps the number of the jobs executed at the end of a block, the due jobs above the cap are
+	// executed in the following blocks
+	MaxJobsPerBlock uint64 `json:"max_jobs_per_block" yaml:"max_jobs_per_block"`
+	// max_gas_limit caps the gas limit of an execution of a job
+	MaxGasLimit uint64 `json:"max_gas_limit" yaml:"max_gas_limit"`
+	// gas_price is the price in okt of the gas used by the executions of the jobs
+	GasPrice sdk.Dec `json:"gas_price" yaml:"gas_price"`
+}
+
+// NewParams creates a new Params object
+func NewParams(maxJobsPerBlock, maxGasLimit uint64, gasPrice sdk.Dec) Params {
+	return Params{
+		MaxJobsPerBlock: maxJobsPerBlock,
+		MaxGasLimit:     maxGasLimit,
+		GasPrice:        gasPrice,
+	}
+}
+
+// DefaultParams returns the default parameters of the cron module
+func DefaultParams() Params {
+	return NewParams(DefaultMaxJobsPerBlock, DefaultMaxGasLimit, DefaultGasPrice)
+}
+
+// String implements the fmt.Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyMaxJobsPerBlock, &p.MaxJobsPerBlock, validateUint64("max jobs per block")),
+		params.NewParamSetPair(ParamStoreKeyMaxGasLimit, &p.MaxGasLimit, validateUint64("max gas limit")),
+		params.NewParamSetPair(ParamStoreKeyGasPrice, &p.GasPrice, validateGasPrice),
+	}
+}
+
+// Validate checks all the params
+func (p Params) Validate() error {
+	if err := validateUint64("max jobs per block")(p.MaxJobsPerBlock); err != nil {
+		return err
+	}
+	if err := validateUint64("max gas limit")(p.MaxGasLimit); err != nil {
+		return err
+	}
+	return validateGasPrice(p.GasPrice)
+}
+
+// Fee returns the fee of an execution of a job using the gas
+func (p Params) Fee(gasUsed uint64) sdk.SysCoin {
+	return sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, p.GasPrice.MulInt64(int64(gasUsed)))
+}
+
+func validateUint64(name string) func(interface{}) error {
+	return func(i interface{}) error {
+		v, ok := i.(uint64)
+		if !ok {
+			return fmt.Errorf("invalid parameter type: %T", i)
+		}
+		if v == 0 {
+			return fmt.Errorf("%s must be positive", name)
+		}
+		return nil
+	}
+}
+
+func validateGasPrice(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("gas price must not be negative: %s", v)
+	}
+	return nil
+}
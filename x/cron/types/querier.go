@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// QueryParameters is the query endpoint of the cron params
+	QueryParameters = "params"
+	// QueryJob is the query endpoint of a job
+	QueryJob = "job"
+	// QueryJobs is the query endpoint of the jobs of an owner
+	QueryJobs = "jobs"
+)
+
+// QueryJobParams is the params of the query of a job
+type QueryJobParams struct {
+	JobID uint64 `json:"job_id"`
+}
+
+// NewQueryJobParams creates a new instance of QueryJobParams
+func NewQueryJobParams(jobID uint64) QueryJobParams {
+	return QueryJobParams{
+		JobID: jobID,
+	}
+}
+
+// QueryJobsParams is the params of the query of the jobs owned by an address
+type QueryJobsParams struct {
+	Owner sdk.AccAddress `json:"owner"`
+}
+
+// NewQueryJobsParams creates a new instance of QueryJobsParams
+func NewQueryJobsParams(owner sdk.AccAddress) QueryJobsParams {
+	return QueryJobsParams{
+		Owner: owner,
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+)
+
+// CronMsg is the custom msg of wasm contracts managing their jobs, routed to the cron module under its module name.
+// The contract is the owner of the jobs it registers, e.g.
+//
+//	{"cron":{"register_job":{"contract":"ex1...","msg":{"liquidate":{}},"interval":10,"gas_limit":200000,"deposit":{"denom":"okt","amount":"1"}}}}
+//
+// Only one of the fields is set
+type CronMsg struct {
+	RegisterJob *RegisterJobMsg `json:"register_job,omitempty"`
+	DepositJob  *DepositJobMsg  `json:"deposit_job,omitempty"`
+	CancelJob   *CancelJobMsg   `json:"cancel_job,omitempty"`
+}
+
+// WasmCustomMsg is the custom msg of wasm contracts routed by the module name
+type WasmCustomMsg struct {
+	Cron *CronMsg `json:"cron,omitempty"`
+}
+
+// RegisterJobMsg registers a job owned by the contract
+type RegisterJobMsg struct {
+	Contract string           `json:"contract"`
+	Msg      json.RawMessage  `json:"msg"`
+	Interval int64            `json:"interval"`
+	GasLimit uint64           `json:"gas_limit"`
+	Deposit  wasmvmtypes.Coin `json:"deposit"`
+}
+
+// DepositJobMsg tops up the escrow of a job from the contract
+type DepositJobMsg struct {
+	JobID  uint64           `json:"job_id"`
+	Amount wasmvmtypes.Coin `json:"amount"`
+}
+
+// CancelJobMsg cancels a job owned by the contract
+type CancelJobMsg struct {
+	JobID uint64 `json:"job_id"`
+}
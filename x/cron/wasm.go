@@ -0,0 +1,91 @@
+package cron
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/cron/keeper"
+	"github.com/okex/exchain/x/cron/types"
+	wasmkeeper "github.com/okex/exchain/x/wasm/keeper"
+	wasmtypes "github.com/okex/exchain/x/wasm/types"
+)
+
+// GetWasmOpts returns the option of the wasm keeper dispatching the cron msgs of the contracts to the keeper. The
+// keeper is referenced as its wasm keeper is set after the wasm keeper is created
+func GetWasmOpts(k *keeper.Keeper) wasmkeeper.Option {
+	return wasmkeeper.WithMessageHandlerDecorator(func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return wasmkeeper.NewMessageHandlerChain(NewWasmMessenger(k), old)
+	})
+}
+
+var _ wasmkeeper.Messenger = WasmMessenger{}
+
+// WasmMessenger dispatches the cron custom msgs of wasm contracts, the other msgs are left to the next messengers.
+// The cron msgs are amino msgs, which the custom encoders can't return
+type WasmMessenger struct {
+	keeper *keeper.Keeper
+}
+
+// NewWasmMessenger creates a new instance of WasmMessenger
+func NewWasmMessenger(k *keeper.Keeper) WasmMessenger {
+	return WasmMessenger{keeper: k}
+}
+
+// DispatchMsg implements wasmkeeper.Messenger, the contract is the owner of the jobs it registers
+func (m WasmMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	if msg.Custom == nil {
+		return nil, nil, wasmtypes.ErrUnknownMsg
+	}
+	var custom types.WasmCustomMsg
+	if err := json.Unmarshal(msg.Custom, &custom); err != nil || custom.Cron == nil {
+		return nil, nil, wasmtypes.ErrUnknownMsg
+	}
+
+	sdkMsg, err := m.toSdkMsg(contractAddr, custom.Cron)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, nil, err
+	}
+	res, err := NewHandler(*m.keeper)(ctx, sdkMsg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res.Events, nil, nil
+}
+
+func (m WasmMessenger) toSdkMsg(contractAddr sdk.AccAddress, msg *types.CronMsg) (sdk.Msg, error) {
+	switch {
+	case msg.RegisterJob != nil:
+		contract, err := sdk.AccAddressFromBech32(msg.RegisterJob.Contract)
+		if err != nil {
+			return nil, types.ErrInvalidAddress(err.Error())
+		}
+		deposit, err := convertWasmCoin(msg.RegisterJob.Deposit)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewMsgRegisterJob(contractAddr, contract, string(msg.RegisterJob.Msg), msg.RegisterJob.Interval,
+			msg.RegisterJob.GasLimit, deposit), nil
+	case msg.DepositJob != nil:
+		amount, err := convertWasmCoin(msg.DepositJob.Amount)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewMsgDepositJob(contractAddr, msg.DepositJob.JobID, amount), nil
+	case msg.CancelJob != nil:
+		return types.NewMsgCancelJob(contractAddr, msg.CancelJob.JobID), nil
+	default:
+		return nil, types.ErrUnknownCronMsgType("unknown cron msg variant")
+	}
+}
+
+func convertWasmCoin(coin wasmvmtypes.Coin) (sdk.SysCoin, error) {
+	c, err := wasmkeeper.ConvertWasmCoinToSdkCoin(coin)
+	if err != nil {
+		return sdk.SysCoin{}, err
+	}
+	return sdk.CoinAdapterToCoin(c), nil
+}
@@ -45,6 +45,7 @@ type (
 	MsgConfirmOwnership  = types.MsgConfirmOwnership
 	MsgUpdateOperator    = types.MsgUpdateOperator
 	MsgCreateOperator    = types.MsgCreateOperator
+	MsgSetFeeTiers       = types.MsgSetFeeTiers
 
 	TokenPair     = types.TokenPair
 	Params        = types.Params
@@ -52,6 +53,9 @@ type (
 	WithdrawInfos = types.WithdrawInfos
 	DEXOperator   = types.DEXOperator
 	DEXOperators  = types.DEXOperators
+	FeeTier       = types.FeeTier
+	FeeTiers      = types.FeeTiers
+	FeeStatistics = types.FeeStatistics
 )
 
 var (
@@ -64,9 +68,10 @@ var (
 	GetBuiltInTokenPair = keeper.GetBuiltInTokenPair
 	DefaultParams       = types.DefaultParams
 
-	NewMsgList     = types.NewMsgList
-	NewMsgDeposit  = types.NewMsgDeposit
-	NewMsgWithdraw = types.NewMsgWithdraw
+	NewMsgList        = types.NewMsgList
+	NewMsgDeposit     = types.NewMsgDeposit
+	NewMsgWithdraw    = types.NewMsgWithdraw
+	NewMsgSetFeeTiers = types.NewMsgSetFeeTiers
 
 	ErrTokenPairNotFound = types.ErrTokenPairNotFound
 )
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"strconv"
 	"strings"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
@@ -29,6 +30,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryProductsUnderDelisting(queryRoute, cdc),
 		GetCmdQueryOperator(queryRoute, cdc),
 		GetCmdQueryOperators(queryRoute, cdc),
+		GetCmdQueryFeeTiers(queryRoute, cdc),
+		GetCmdQueryFeeStatistics(queryRoute, cdc),
 	)...)
 
 	return queryCmd
@@ -233,6 +236,75 @@ func GetCmdQueryOperators(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryFeeTiers queries the fee tiers of a product
+func GetCmdQueryFeeTiers(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-tiers [product]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the trade fee tiers of a token pair",
+		RunE: func(_ *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryFeeTiersParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFeeTiers), bz)
+			if err != nil {
+				return err
+			}
+			var feeTiers types.FeeTiers
+			cdc.MustUnmarshalJSON(res, &feeTiers)
+			return cliCtx.PrintOutput(feeTiers)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryFeeStatistics queries the fee statistics in a block range
+func GetCmdQueryFeeStatistics(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-statistics [start-height] [end-height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the trade fees received by operators in a block range",
+		RunE: func(_ *cobra.Command, args []string) error {
+			startHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height: %s", args[0])
+			}
+			endHeight, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end height: %s", args[1])
+			}
+			product := viper.GetString("product")
+			operator := viper.GetString("operator")
+			page := viper.GetUint("page-number")
+			perPage := viper.GetUint("items-per-page")
+			queryParams := types.NewQueryFeeStatisticsParams(startHeight, endHeight, product, operator, int(page), int(perPage))
+
+			bz, err := cdc.MarshalJSON(queryParams)
+			if err != nil {
+				return err
+			}
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFeeStatistics), bz)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().String("product", "", "filter by token pair")
+	cmd.Flags().String("operator", "", "filter by address of the dex operator")
+	cmd.Flags().UintP("page-number", "p", types.DefaultPage, "page num")
+	cmd.Flags().UintP("items-per-page", "i", types.DefaultPerPage, "items per page")
+	return cmd
+}
+
 // Strings is just for the object of []string could be inputted into cliCtx.PrintOutput(...)
 type Strings []string
 
@@ -34,6 +34,7 @@ const (
 	FlagTo                 = "to"
 	FlagWebsite            = "website"
 	FlagHandlingFeeAddress = "handling-fee-address"
+	FlagFeeTiers           = "fee-tiers"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -51,6 +52,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		getCmdConfirmOwnership(cdc),
 		getCmdRegisterOperator(cdc),
 		getCmdEditOperator(cdc),
+		getCmdSetFeeTiers(cdc),
 	)...)
 
 	return txCmd
@@ -378,3 +380,69 @@ $ exchaincli tx dex edit-operator --website http://xxx/operator.json --handling-
 
 	return cmd
 }
+
+func getCmdSetFeeTiers(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-tiers",
+		Short: "set the trade fee tiers of the product",
+		Args:  cobra.ExactArgs(0),
+		Long: strings.TrimSpace(`Set the trade fee tiers of the product. Each tier is given as min-quantity:trade-fee-rate,
+an empty tier list removes the tiers of the product:
+
+$ exchaincli tx dex set-fee-tiers --product btc-000_okt --fee-tiers "0:0.001,100:0.0008,1000:0.0005" --from mykey
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			if err := authTypes.NewAccountRetriever(cliCtx).EnsureExists(cliCtx.FromAddress); err != nil {
+				return err
+			}
+			flags := cmd.Flags()
+
+			product, err := flags.GetString(FlagProduct)
+			if err != nil || product == "" {
+				return fmt.Errorf("invalid product:%s", product)
+			}
+
+			feeTiersStr, err := flags.GetString(FlagFeeTiers)
+			if err != nil {
+				return err
+			}
+			feeTiers, err := parseFeeTiers(feeTiersStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFeeTiers(cliCtx.GetFromAddress(), product, feeTiers)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().StringP(FlagProduct, "p", "", "product to set fee tiers for")
+	cmd.Flags().String(FlagFeeTiers, "", "comma separated fee tiers in the form of min-quantity:trade-fee-rate")
+	return cmd
+}
+
+func parseFeeTiers(feeTiersStr string) (types.FeeTiers, error) {
+	feeTiers := types.FeeTiers{}
+	feeTiersStr = strings.TrimSpace(feeTiersStr)
+	if feeTiersStr == "" {
+		return feeTiers, nil
+	}
+	for _, tierStr := range strings.Split(feeTiersStr, ",") {
+		parts := strings.Split(strings.TrimSpace(tierStr), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid fee tier:%s", tierStr)
+		}
+		minQuantity, err := sdk.NewDecFromStr(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid min quantity:%s", parts[0])
+		}
+		tradeFeeRate, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid trade fee rate:%s", parts[1])
+		}
+		feeTiers = append(feeTiers, types.NewFeeTier(minQuantity, tradeFeeRate))
+	}
+	return feeTiers, nil
+}
@@ -60,6 +60,11 @@ func NewHandler(k IKeeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgUpdateOperator(ctx, k, msg, logger)
 			}
+		case MsgSetFeeTiers:
+			name = "handleMsgSetFeeTiers"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgSetFeeTiers(ctx, k, msg, logger)
+			}
 		default:
 			return types.ErrDexUnknownMsgType(msg.Type()).Result()
 		}
@@ -329,3 +334,35 @@ func handleMsgUpdateOperator(ctx sdk.Context, keeper IKeeper, msg MsgUpdateOpera
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgSetFeeTiers(ctx sdk.Context, keeper IKeeper, msg MsgSetFeeTiers, logger log.Logger) (*sdk.Result, error) {
+
+	logger.Debug(fmt.Sprintf("handleMsgSetFeeTiers msg: %+v", msg))
+
+	tokenPair := keeper.GetTokenPair(ctx, msg.Product)
+	if tokenPair == nil {
+		return types.ErrTokenPairNotFound(msg.Product).Result()
+	}
+	if !tokenPair.Owner.Equals(msg.Owner) {
+		return types.ErrUnauthorized(msg.Owner.String(), msg.Product).Result()
+	}
+
+	if len(msg.FeeTiers) == 0 {
+		keeper.DeleteFeeTiers(ctx, msg.Product)
+	} else {
+		params := keeper.GetParams(ctx)
+		if err := msg.FeeTiers.ValidateBounds(params.MinTradeFeeRate, params.MaxTradeFeeRate); err != nil {
+			return nil, err
+		}
+		keeper.SetFeeTiers(ctx, msg.Product, msg.FeeTiers)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -61,6 +61,10 @@ type IKeeper interface {
 	DeleteConfirmOwnership(ctx sdk.Context, product string)
 	UpdateUserTokenPair(ctx sdk.Context, product string, owner, to sdk.AccAddress)
 	UpdateTokenPair(ctx sdk.Context, product string, tokenPair *types.TokenPair)
+	GetFeeTiers(ctx sdk.Context, product string) types.FeeTiers
+	SetFeeTiers(ctx sdk.Context, product string, feeTiers types.FeeTiers)
+	DeleteFeeTiers(ctx sdk.Context, product string)
+	IterateFeeStatistics(ctx sdk.Context, startHeight, endHeight int64, cb func(statistics types.FeeStatistics) (stop bool))
 }
 
 // StakingKeeper defines the expected staking Keeper (noalias)
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/dex/types"
+)
+
+// GetFeeTiers gets the fee tiers of product, nil if the product uses the default trade fee rate
+func (k Keeper) GetFeeTiers(ctx sdk.Context, product string) (feeTiers types.FeeTiers) {
+	bytes := ctx.KVStore(k.storeKey).Get(types.GetFeeTiersKey(product))
+	if bytes == nil {
+		return nil
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bytes, &feeTiers)
+	return feeTiers
+}
+
+// SetFeeTiers sets the fee tiers of product to db
+func (k Keeper) SetFeeTiers(ctx sdk.Context, product string, feeTiers types.FeeTiers) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFeeTiersKey(product), k.cdc.MustMarshalBinaryBare(feeTiers))
+}
+
+// DeleteFeeTiers deletes the fee tiers of product from db
+func (k Keeper) DeleteFeeTiers(ctx sdk.Context, product string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFeeTiersKey(product))
+}
+
+// GetTradeFeeRate gets the trade fee rate of the fee tier the filled quantity reaches
+// returns false if no fee tier of product matches
+func (k Keeper) GetTradeFeeRate(ctx sdk.Context, product string, quantity sdk.Dec) (sdk.Dec, bool) {
+	return k.GetFeeTiers(ctx, product).TradeFeeRate(quantity)
+}
+
+// AddFeeStatistics accumulates the deal fees collected by the operator of product in the current block
+func (k Keeper) AddFeeStatistics(ctx sdk.Context, product string, operator sdk.AccAddress, fees sdk.SysCoins) {
+	if fees.IsZero() {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetFeeStatisticsKey(ctx.BlockHeight(), product)
+	statistics := types.FeeStatistics{
+		BlockHeight: ctx.BlockHeight(),
+		Product:     product,
+		Operator:    operator,
+	}
+	if bytes := store.Get(key); bytes != nil {
+		k.cdc.MustUnmarshalBinaryBare(bytes, &statistics)
+	}
+	statistics.Operator = operator
+	statistics.Fees = statistics.Fees.Add2(fees)
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(statistics))
+}
+
+// IterateFeeStatistics iterates over the fee statistics in the block range [startHeight, endHeight]
+func (k Keeper) IterateFeeStatistics(ctx sdk.Context, startHeight, endHeight int64,
+	cb func(statistics types.FeeStatistics) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetFeeStatisticsHeightKey(startHeight), types.GetFeeStatisticsHeightKey(endHeight+1))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var statistics types.FeeStatistics
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &statistics)
+
+		if cb(statistics) {
+			break
+		}
+	}
+}
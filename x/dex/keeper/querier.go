@@ -32,6 +32,10 @@ func NewQuerier(keeper IKeeper) sdk.Querier {
 			return queryOperator(ctx, req, keeper)
 		case types.QueryOperators:
 			return queryOperators(ctx, keeper)
+		case types.QueryFeeTiers:
+			return queryFeeTiers(ctx, req, keeper)
+		case types.QueryFeeStatistics:
+			return queryFeeStatistics(ctx, req, keeper)
 		default:
 			return nil, types.ErrDexUnknownQueryType()
 		}
@@ -275,3 +279,81 @@ func queryOperators(ctx sdk.Context, keeper IKeeper) ([]byte, sdk.Error) {
 	}
 	return bz, nil
 }
+
+// nolint
+func queryFeeTiers(ctx sdk.Context, req abci.RequestQuery, keeper IKeeper) ([]byte, sdk.Error) {
+	var params types.QueryFeeTiersParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	if keeper.GetTokenPair(ctx, params.Product) == nil {
+		return nil, types.ErrTokenPairNotFound(params.Product)
+	}
+
+	feeTiers := keeper.GetFeeTiers(ctx, params.Product)
+	if feeTiers == nil {
+		feeTiers = types.FeeTiers{}
+	}
+	res, errMarshal := codec.MarshalJSONIndent(types.ModuleCdc, feeTiers)
+	if errMarshal != nil {
+		return nil, common.ErrMarshalJSONFailed(errMarshal.Error())
+	}
+	return res, nil
+}
+
+// nolint
+func queryFeeStatistics(ctx sdk.Context, req abci.RequestQuery, keeper IKeeper) ([]byte, sdk.Error) {
+	var params types.QueryFeeStatisticsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	if params.StartHeight <= 0 || params.EndHeight < params.StartHeight ||
+		params.EndHeight-params.StartHeight >= types.MaxFeeStatisticsBlockRange {
+		return nil, types.ErrInvalidBlockRange(params.StartHeight, params.EndHeight)
+	}
+
+	offset, limit := common.GetPage(params.Page, params.PerPage)
+	if offset < 0 || limit < 0 {
+		return nil, common.ErrInvalidPaginateParam(params.Page, params.PerPage)
+	}
+
+	var operator sdk.AccAddress
+	if params.Operator != "" {
+		operator, err = sdk.AccAddressFromBech32(params.Operator)
+		if err != nil {
+			return nil, common.ErrCreateAddrFromBech32Failed(params.Operator, err.Error())
+		}
+	}
+
+	total := 0
+	response := types.FeeStatisticsResponse{
+		Total:      sdk.SysCoins{},
+		Statistics: []types.FeeStatistics{},
+	}
+	keeper.IterateFeeStatistics(ctx, params.StartHeight, params.EndHeight, func(statistics types.FeeStatistics) bool {
+		if params.Product != "" && statistics.Product != params.Product {
+			return false
+		}
+		if !operator.Empty() && !statistics.Operator.Equals(operator) {
+			return false
+		}
+
+		response.Total = response.Total.Add2(statistics.Fees)
+		if total >= offset && total < offset+limit {
+			response.Statistics = append(response.Statistics, statistics)
+		}
+		total++
+		return false
+	})
+
+	listResponse := common.GetListResponse(total, params.Page, params.PerPage, response)
+	res, errMarshal := json.MarshalIndent(listResponse, "", "  ")
+	if errMarshal != nil {
+		return nil, common.ErrMarshalJSONFailed(errMarshal.Error())
+	}
+	return res, nil
+}
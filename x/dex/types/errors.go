@@ -43,6 +43,9 @@ const (
 	CodeIsTransferringOwner         uint32 = 64031
 	CodeTransferOwnerExpired        uint32 = 64032
 	CodeUnauthorizedOperator        uint32 = 64033
+	CodeInvalidFeeTiers             uint32 = 64034
+	CodeTradeFeeRateOutOfBounds     uint32 = 64035
+	CodeInvalidBlockRange           uint32 = 64036
 )
 
 // Addr and Product All Required
@@ -186,3 +189,15 @@ func ErrIsTransferOwnerExpired(time string) sdk.EnvelopedErr {
 func ErrUnauthorizedOperator(operator, owner string) sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnauthorizedOperator, fmt.Sprintf("%s is not the owner of operator(%s)", owner, operator))}
 }
+
+func ErrInvalidFeeTiers(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidFeeTiers, fmt.Sprintf("invalid fee tiers: %s", msg))}
+}
+
+func ErrTradeFeeRateOutOfBounds(rate, minRate, maxRate sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTradeFeeRateOutOfBounds, fmt.Sprintf("trade fee rate %s is out of the bounds [%s, %s]", rate, minRate, maxRate))}
+}
+
+func ErrInvalidBlockRange(startHeight, endHeight int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidBlockRange, fmt.Sprintf("invalid block range [%d, %d]", startHeight, endHeight))}
+}
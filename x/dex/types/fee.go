@@ -4,6 +4,8 @@ const (
 	defaultFeeList              = "20000"
 	defaultFeeTransferOwnership = "10"
 	defaultDelistMinDeposit     = "100"
+	defaultMinTradeFeeRate      = "0.0001"
+	defaultMaxTradeFeeRate      = "0.01"
 
 	// DefaultMaxPriceDigitSize defines default max price digit size
 	DefaultMaxPriceDigitSize = 4
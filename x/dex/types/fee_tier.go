@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// MaxFeeTiers defines the max number of fee tiers of a product
+const MaxFeeTiers = 10
+
+// FeeTier defines the trade fee rate of deals whose filled quantity is not less than MinQuantity
+type FeeTier struct {
+	MinQuantity  sdk.Dec `json:"min_quantity"`
+	TradeFeeRate sdk.Dec `json:"trade_fee_rate"`
+}
+
+// NewFeeTier creates a new FeeTier
+func NewFeeTier(minQuantity, tradeFeeRate sdk.Dec) FeeTier {
+	return FeeTier{
+		MinQuantity:  minQuantity,
+		TradeFeeRate: tradeFeeRate,
+	}
+}
+
+// nolint
+func (t FeeTier) String() string {
+	return fmt.Sprintf("MinQuantity: %s, TradeFeeRate: %s", t.MinQuantity, t.TradeFeeRate)
+}
+
+// FeeTiers defines the fee tiers of a product, sorted by MinQuantity asc
+type FeeTiers []FeeTier
+
+// nolint
+func (tiers FeeTiers) String() string {
+	out := make([]string, 0, len(tiers))
+	for _, tier := range tiers {
+		out = append(out, tier.String())
+	}
+	return strings.Join(out, "\n")
+}
+
+// ValidateBasic checks the fee tiers are sorted by MinQuantity asc without the governance bounds
+func (tiers FeeTiers) ValidateBasic() sdk.Error {
+	if len(tiers) > MaxFeeTiers {
+		return ErrInvalidFeeTiers(fmt.Sprintf("too many fee tiers: %d > %d", len(tiers), MaxFeeTiers))
+	}
+	for i, tier := range tiers {
+		if tier.MinQuantity.IsNil() || tier.MinQuantity.IsNegative() {
+			return ErrInvalidFeeTiers(fmt.Sprintf("min quantity of fee tier %d must not be negative", i))
+		}
+		if tier.TradeFeeRate.IsNil() || tier.TradeFeeRate.IsNegative() || tier.TradeFeeRate.GT(sdk.OneDec()) {
+			return ErrInvalidFeeTiers(fmt.Sprintf("trade fee rate of fee tier %d should be in [0, 1]", i))
+		}
+		if i > 0 && !tier.MinQuantity.GT(tiers[i-1].MinQuantity) {
+			return ErrInvalidFeeTiers("fee tiers must be sorted by min quantity asc without duplicates")
+		}
+	}
+	return nil
+}
+
+// ValidateBounds checks the trade fee rates are within the governance bounds
+func (tiers FeeTiers) ValidateBounds(minRate, maxRate sdk.Dec) sdk.Error {
+	for _, tier := range tiers {
+		if tier.TradeFeeRate.LT(minRate) || tier.TradeFeeRate.GT(maxRate) {
+			return ErrTradeFeeRateOutOfBounds(tier.TradeFeeRate, minRate, maxRate)
+		}
+	}
+	return nil
+}
+
+// TradeFeeRate returns the trade fee rate of the highest tier the filled quantity reaches
+func (tiers FeeTiers) TradeFeeRate(quantity sdk.Dec) (rate sdk.Dec, found bool) {
+	for i := len(tiers) - 1; i >= 0; i-- {
+		if quantity.GTE(tiers[i].MinQuantity) {
+			return tiers[i].TradeFeeRate, true
+		}
+	}
+	return sdk.ZeroDec(), false
+}
+
+// FeeStatistics defines the deal fees collected by the operator of a product in a block
+type FeeStatistics struct {
+	BlockHeight int64          `json:"block_height"`
+	Product     string         `json:"product"`
+	Operator    sdk.AccAddress `json:"operator"`
+	Fees        sdk.SysCoins   `json:"fees"`
+}
+
+// nolint
+func (s FeeStatistics) String() string {
+	return fmt.Sprintf(`FeeStatistics:
+  BlockHeight: %d
+  Product:     %s
+  Operator:    %s
+  Fees:        %s`, s.BlockHeight, s.Product, s.Operator, s.Fees)
+}
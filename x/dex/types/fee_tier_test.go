@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeTiersValidateBasic(t *testing.T) {
+	tests := []struct {
+		name     string
+		feeTiers FeeTiers
+		valid    bool
+	}{
+		{"empty", FeeTiers{}, true},
+		{"single", FeeTiers{NewFeeTier(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.001"))}, true},
+		{"ascending", FeeTiers{
+			NewFeeTier(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.001")),
+			NewFeeTier(sdk.NewDec(100), sdk.MustNewDecFromStr("0.0005")),
+		}, true},
+		{"duplicated min quantity", FeeTiers{
+			NewFeeTier(sdk.NewDec(100), sdk.MustNewDecFromStr("0.001")),
+			NewFeeTier(sdk.NewDec(100), sdk.MustNewDecFromStr("0.0005")),
+		}, false},
+		{"descending", FeeTiers{
+			NewFeeTier(sdk.NewDec(100), sdk.MustNewDecFromStr("0.001")),
+			NewFeeTier(sdk.NewDec(10), sdk.MustNewDecFromStr("0.0005")),
+		}, false},
+		{"negative min quantity", FeeTiers{NewFeeTier(sdk.NewDec(-1), sdk.MustNewDecFromStr("0.001"))}, false},
+		{"negative rate", FeeTiers{NewFeeTier(sdk.ZeroDec(), sdk.MustNewDecFromStr("-0.001"))}, false},
+		{"rate greater than one", FeeTiers{NewFeeTier(sdk.ZeroDec(), sdk.MustNewDecFromStr("1.1"))}, false},
+	}
+
+	for _, tc := range tests {
+		err := tc.feeTiers.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, tc.name)
+		} else {
+			require.NotNil(t, err, tc.name)
+		}
+	}
+
+	tooMany := FeeTiers{}
+	for i := 0; i <= MaxFeeTiers; i++ {
+		tooMany = append(tooMany, NewFeeTier(sdk.NewDec(int64(i)), sdk.MustNewDecFromStr("0.001")))
+	}
+	require.NotNil(t, tooMany.ValidateBasic())
+}
+
+func TestFeeTiersTradeFeeRate(t *testing.T) {
+	feeTiers := FeeTiers{
+		NewFeeTier(sdk.NewDec(10), sdk.MustNewDecFromStr("0.001")),
+		NewFeeTier(sdk.NewDec(100), sdk.MustNewDecFromStr("0.0005")),
+	}
+
+	_, found := feeTiers.TradeFeeRate(sdk.NewDec(1))
+	require.False(t, found)
+
+	rate, found := feeTiers.TradeFeeRate(sdk.NewDec(10))
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("0.001"), rate)
+
+	rate, found = feeTiers.TradeFeeRate(sdk.NewDec(1000))
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("0.0005"), rate)
+
+	require.Nil(t, feeTiers.ValidateBounds(sdk.MustNewDecFromStr("0.0001"), sdk.MustNewDecFromStr("0.01")))
+	require.NotNil(t, feeTiers.ValidateBounds(sdk.MustNewDecFromStr("0.0008"), sdk.MustNewDecFromStr("0.01")))
+}
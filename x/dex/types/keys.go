@@ -37,6 +37,10 @@ const (
 	QueryOperator = "operator"
 	// QueryOperators defines operators query route path
 	QueryOperators = "operators"
+	// QueryFeeTiers defines fee tiers query route path
+	QueryFeeTiers = "fee-tiers"
+	// QueryFeeStatistics defines fee statistics query route path
+	QueryFeeStatistics = "fee-statistics"
 )
 
 var (
@@ -63,6 +67,10 @@ var (
 	UserTokenPairKeyPrefix = []byte{0x06}
 	//the prefix of the confirm ownership key
 	PrefixConfirmOwnershipKey = []byte{0x07}
+	// FeeTiersKeyPrefix is the store key prefix for fee tiers of product
+	FeeTiersKeyPrefix = []byte{0x08}
+	// FeeStatisticsKeyPrefix is the store key prefix for fee statistics of product by block height
+	FeeStatisticsKeyPrefix = []byte{0x09}
 )
 
 // GetUserTokenPairAddressPrefix returns token pair address prefix key
@@ -127,3 +135,18 @@ func GetOperatorAddressKey(addr sdk.AccAddress) []byte {
 func GetConfirmOwnershipKey(product string) []byte {
 	return append(PrefixConfirmOwnershipKey, []byte(product)...)
 }
+
+// GetFeeTiersKey returns key of fee tiers of product
+func GetFeeTiersKey(product string) []byte {
+	return append(FeeTiersKeyPrefix, []byte(product)...)
+}
+
+// GetFeeStatisticsHeightKey returns key prefix of fee statistics at block height
+func GetFeeStatisticsHeightKey(blockHeight int64) []byte {
+	return append(FeeStatisticsKeyPrefix, sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+}
+
+// GetFeeStatisticsKey returns key of fee statistics of product at block height
+func GetFeeStatisticsKey(blockHeight int64, product string) []byte {
+	return append(GetFeeStatisticsHeightKey(blockHeight), []byte(product)...)
+}
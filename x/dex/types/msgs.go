@@ -14,6 +14,7 @@ const (
 	typeMsgTransferOwnership = "transferOwnership"
 	typeMsgUpdateOperator    = "updateOperator"
 	typeMsgCreateOperator    = "createOperator"
+	typeMsgSetFeeTiers       = "setFeeTiers"
 )
 
 // MsgList - high level transaction of the dex module
@@ -324,6 +325,51 @@ func (msg MsgUpdateOperator) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
 }
 
+// MsgSetFeeTiers sets the fee tiers of a product by the owner of the product
+// empty fee tiers resets the product to the default trade fee rate
+type MsgSetFeeTiers struct {
+	Owner    sdk.AccAddress `json:"owner"`
+	Product  string         `json:"product"`
+	FeeTiers FeeTiers       `json:"fee_tiers"`
+}
+
+// NewMsgSetFeeTiers creates a new MsgSetFeeTiers
+func NewMsgSetFeeTiers(owner sdk.AccAddress, product string, feeTiers FeeTiers) MsgSetFeeTiers {
+	return MsgSetFeeTiers{
+		Owner:    owner,
+		Product:  product,
+		FeeTiers: feeTiers,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSetFeeTiers) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetFeeTiers) Type() string { return typeMsgSetFeeTiers }
+
+// ValidateBasic Implements Msg
+func (msg MsgSetFeeTiers) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return ErrAddressIsRequired("owner")
+	}
+	if len(msg.Product) == 0 {
+		return ErrTokenPairIsRequired()
+	}
+	return msg.FeeTiers.ValidateBasic()
+}
+
+// GetSignBytes Implements Msg
+func (msg MsgSetFeeTiers) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg
+func (msg MsgSetFeeTiers) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
 func checkWebsite(website string) sdk.Error {
 	if len(website) == 0 {
 		return nil
@@ -18,6 +18,8 @@ var (
 	keyDelistVotingPeriod     = []byte("DelistVotingPeriod")
 	keyWithdrawPeriod         = []byte("WithdrawPeriod")
 	keyOwnershipConfirmWindow = []byte("OwnershipConfirmWindow")
+	keyMinTradeFeeRate        = []byte("MinTradeFeeRate")
+	keyMaxTradeFeeRate        = []byte("MaxTradeFeeRate")
 )
 
 // Params defines param object
@@ -35,6 +37,10 @@ type Params struct {
 
 	WithdrawPeriod         time.Duration `json:"withdraw_period"`
 	OwnershipConfirmWindow time.Duration `json:"ownership_confirm_window"`
+
+	// bounds of the trade fee rates in the fee tiers configured by dex operators
+	MinTradeFeeRate sdk.Dec `json:"min_trade_fee_rate"`
+	MaxTradeFeeRate sdk.Dec `json:"max_trade_fee_rate"`
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -48,6 +54,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: keyDelistVotingPeriod, Value: &p.DelistVotingPeriod, ValidatorFn: common.ValidateDurationPositive("delist voting period")},
 		{Key: keyWithdrawPeriod, Value: &p.WithdrawPeriod, ValidatorFn: common.ValidateDurationPositive("withdraw period")},
 		{Key: keyOwnershipConfirmWindow, Value: &p.OwnershipConfirmWindow, ValidatorFn: common.ValidateDurationPositive("ownership confirm window")},
+		{Key: keyMinTradeFeeRate, Value: &p.MinTradeFeeRate, ValidatorFn: common.ValidateRateNotNeg("min trade fee rate")},
+		{Key: keyMaxTradeFeeRate, Value: &p.MaxTradeFeeRate, ValidatorFn: common.ValidateRateNotNeg("max trade fee rate")},
 	}
 }
 
@@ -70,12 +78,16 @@ func DefaultParams() *Params {
 		DelistVotingPeriod:     time.Hour * 72,
 		WithdrawPeriod:         DefaultWithdrawPeriod,
 		OwnershipConfirmWindow: DefaultOwnershipConfirmWindow,
+		MinTradeFeeRate:        sdk.MustNewDecFromStr(defaultMinTradeFeeRate),
+		MaxTradeFeeRate:        sdk.MustNewDecFromStr(defaultMaxTradeFeeRate),
 	}
 }
 
 // String implements the stringer interface.
 func (p Params) String() string {
 	return fmt.Sprintf("Params: \nDexListFee:%s\nTransferOwnershipFee:%s\nRegisterOperatorFee:%s\nDelistMaxDepositPeriod:%s\n"+
-		"DelistMinDeposit:%s\nDelistVotingPeriod:%s\nWithdrawPeriod:%d\nOwnershipConfirmWindow: %s\n",
-		p.ListFee, p.TransferOwnershipFee, p.RegisterOperatorFee, p.DelistMaxDepositPeriod, p.DelistMinDeposit, p.DelistVotingPeriod, p.WithdrawPeriod, p.OwnershipConfirmWindow)
+		"DelistMinDeposit:%s\nDelistVotingPeriod:%s\nWithdrawPeriod:%d\nOwnershipConfirmWindow: %s\n"+
+		"MinTradeFeeRate:%s\nMaxTradeFeeRate:%s\n",
+		p.ListFee, p.TransferOwnershipFee, p.RegisterOperatorFee, p.DelistMaxDepositPeriod, p.DelistMinDeposit, p.DelistVotingPeriod, p.WithdrawPeriod, p.OwnershipConfirmWindow,
+		p.MinTradeFeeRate, p.MaxTradeFeeRate)
 }
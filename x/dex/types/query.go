@@ -65,3 +65,51 @@ func NewQueryDepositParams(address, baseAsset, quoteAsset string, page, perPage
 		PerPage:    perPage,
 	}
 }
+
+// QueryFeeTiersParams defines query params of fee tiers
+type QueryFeeTiersParams struct {
+	Product string
+}
+
+// NewQueryFeeTiersParams creates a new instance of QueryFeeTiersParams
+func NewQueryFeeTiersParams(product string) QueryFeeTiersParams {
+	return QueryFeeTiersParams{
+		Product: product,
+	}
+}
+
+// QueryFeeStatisticsParams defines query params of fee statistics in the block range [StartHeight, EndHeight]
+// filtered by product and operator if not empty
+type QueryFeeStatisticsParams struct {
+	StartHeight int64
+	EndHeight   int64
+	Product     string
+	Operator    string
+	Page        int
+	PerPage     int
+}
+
+// NewQueryFeeStatisticsParams creates a new instance of QueryFeeStatisticsParams
+func NewQueryFeeStatisticsParams(startHeight, endHeight int64, product, operator string, page, perPage int) QueryFeeStatisticsParams {
+	if page == 0 && perPage == 0 {
+		page = DefaultPage
+		perPage = DefaultPerPage
+	}
+	return QueryFeeStatisticsParams{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Product:     product,
+		Operator:    operator,
+		Page:        page,
+		PerPage:     perPage,
+	}
+}
+
+// MaxFeeStatisticsBlockRange defines the max block range of a fee statistics query
+const MaxFeeStatisticsBlockRange = 100000
+
+// FeeStatisticsResponse defines the response of fee statistics query
+type FeeStatisticsResponse struct {
+	Total      sdk.SysCoins    `json:"total"`
+	Statistics []FeeStatistics `json:"statistics"`
+}
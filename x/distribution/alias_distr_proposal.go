@@ -16,4 +16,6 @@ var (
 	WithdrawRewardEnabledProposalHandler   = client.WithdrawRewardEnabledProposalHandler
 	RewardTruncatePrecisionProposalHandler = client.RewardTruncatePrecisionProposalHandler
 	NewMsgWithdrawDelegatorAllRewards      = types.NewMsgWithdrawDelegatorAllRewards
+	NewMsgGrantWithdrawRewards             = types.NewMsgGrantWithdrawRewards
+	NewMsgRevokeWithdrawRewards            = types.NewMsgRevokeWithdrawRewards
 )
@@ -35,6 +35,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryDelegatorRewards(queryRoute, cdc),
 		GetCmdQueryValidatorOutstandingRewards(queryRoute, cdc),
 		GetCmdQueryWithdrawAddr(queryRoute, cdc),
+		GetCmdQueryWithdrawRewardsGrantees(queryRoute, cdc),
 	)...)
 
 	return distQueryCmd
@@ -172,3 +172,48 @@ $ %s query distr withdraw-addr ex17kn7d20d85yymu45h79dqs5pxq9m3nyx2mdmcs
 		},
 	}
 }
+
+// GetCmdQueryWithdrawRewardsGrantees implements the query the grantees of withdrawing the delegator's rewards
+func GetCmdQueryWithdrawRewardsGrantees(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-rewards-grantees [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the addresses granted to withdraw delegator's rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the addresses granted to withdraw delegator's rewards.
+
+Example:
+$ %s query distr withdraw-rewards-grantees ex17kn7d20d85yymu45h79dqs5pxq9m3nyx2mdmcs
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+			if err != nil {
+				return err
+			}
+
+			resp, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryWithdrawRewardsGrantees),
+				bz,
+			)
+			if err != nil {
+				return err
+			}
+
+			var grantees []sdk.AccAddress
+			if err := cdc.UnmarshalJSON(resp, &grantees); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(grantees)
+		},
+	}
+}
@@ -37,6 +37,8 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdWithdrawRewards(cdc),
 		GetCmdSetWithdrawAddr(cdc),
 		GetCmdWithdrawAllRewards(cdc, storeKey),
+		GetCmdGrantWithdrawRewards(cdc),
+		GetCmdRevokeWithdrawRewards(cdc),
 	)...)
 
 	return distTxCmd
@@ -51,6 +51,69 @@ $ %s tx distr withdraw-all-rewards --from mykey
 	return cmd
 }
 
+// GetCmdGrantWithdrawRewards command to grant an address to withdraw all rewards of a delegator
+func GetCmdGrantWithdrawRewards(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grant-withdraw-rewards [grantee-addr]",
+		Short: "grant an address, e.g. a vault contract, to withdraw all delegations rewards for a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an address, e.g. a vault contract, to withdraw all rewards for a single delegator.
+The rewards are withdrawn to the withdraw address of the delegator.
+
+Example:
+$ %s tx distr grant-withdraw-rewards 0x8D8d6C8a7E9ab6E0b8e6bd8E3D0Bc2b8F9bC7E2a --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid address：%s", args[0])
+			}
+
+			msg := types.NewMsgGrantWithdrawRewards(cliCtx.GetFromAddress(), grantee)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRevokeWithdrawRewards command to revoke the grant of withdrawing all rewards of a delegator
+func GetCmdRevokeWithdrawRewards(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-withdraw-rewards [grantee-addr]",
+		Short: "revoke the grant of withdrawing all delegations rewards for a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the grant of withdrawing all rewards for a single delegator.
+
+Example:
+$ %s tx distr revoke-withdraw-rewards 0x8D8d6C8a7E9ab6E0b8e6bd8E3D0Bc2b8F9bC7E2a --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid address：%s", args[0])
+			}
+
+			msg := types.NewMsgRevokeWithdrawRewards(cliCtx.GetFromAddress(), grantee)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetChangeDistributionTypeProposal implements the command to submit a change-distr-type proposal
 func GetChangeDistributionTypeProposal(cdcP *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
 	cmd := &cobra.Command{
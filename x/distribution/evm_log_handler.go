@@ -0,0 +1,134 @@
+package distribution
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/distribution/keeper"
+	"github.com/okex/exchain/x/distribution/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+var (
+	_ evmtypes.EvmLogHandler = WithdrawRewardsEventHandler{}
+	_ evmtypes.EvmLogHandler = WithdrawRewardsForEventHandler{}
+)
+
+const (
+	WithdrawRewardsEventName    = "__OKCWithdrawRewards"
+	WithdrawRewardsForEventName = "__OKCWithdrawRewardsFor"
+)
+
+// WithdrawRewardsEvent represent the signature of
+// `event __OKCWithdrawRewards()`
+var WithdrawRewardsEvent = abi.NewEvent(
+	WithdrawRewardsEventName,
+	WithdrawRewardsEventName,
+	false,
+	abi.Arguments{},
+)
+
+// WithdrawRewardsForEvent represent the signature of
+// `event __OKCWithdrawRewardsFor(address delegator)`
+var WithdrawRewardsForEvent abi.Event
+
+func init() {
+	addressType, _ := abi.NewType("address", "", nil)
+
+	WithdrawRewardsForEvent = abi.NewEvent(
+		WithdrawRewardsForEventName,
+		WithdrawRewardsForEventName,
+		false,
+		abi.Arguments{abi.Argument{
+			Name:    "delegator",
+			Type:    addressType,
+			Indexed: false,
+		}},
+	)
+}
+
+// WithdrawRewardsEventHandler withdraws all the rewards of the contract emitting the event to its withdraw address
+type WithdrawRewardsEventHandler struct {
+	keeper.Keeper
+}
+
+func NewWithdrawRewardsEventHandler(k keeper.Keeper) *WithdrawRewardsEventHandler {
+	return &WithdrawRewardsEventHandler{k}
+}
+
+// EventID Return the id of the log signature it handles
+func (h WithdrawRewardsEventHandler) EventID() common.Hash {
+	return WithdrawRewardsEvent.ID
+}
+
+// Handle Process the log
+func (h WithdrawRewardsEventHandler) Handle(ctx sdk.Context, contract common.Address, data []byte) error {
+	if err := checkEventHeight(ctx, WithdrawRewardsEventName); err != nil {
+		return err
+	}
+
+	return withdrawAllRewards(ctx, h.Keeper, sdk.AccAddress(contract.Bytes()))
+}
+
+// WithdrawRewardsForEventHandler withdraws all the rewards of the delegator of the event to its withdraw address,
+// if the delegator granted the contract emitting the event to
+type WithdrawRewardsForEventHandler struct {
+	keeper.Keeper
+}
+
+func NewWithdrawRewardsForEventHandler(k keeper.Keeper) *WithdrawRewardsForEventHandler {
+	return &WithdrawRewardsForEventHandler{k}
+}
+
+// EventID Return the id of the log signature it handles
+func (h WithdrawRewardsForEventHandler) EventID() common.Hash {
+	return WithdrawRewardsForEvent.ID
+}
+
+// Handle Process the log
+func (h WithdrawRewardsForEventHandler) Handle(ctx sdk.Context, contract common.Address, data []byte) error {
+	if err := checkEventHeight(ctx, WithdrawRewardsForEventName); err != nil {
+		return err
+	}
+
+	unpacked, err := WithdrawRewardsForEvent.Inputs.Unpack(data)
+	if err != nil {
+		// log and ignore
+		h.Logger(ctx).Error("log signature matches but failed to decode", "error", err)
+		return nil
+	}
+
+	delAddr := sdk.AccAddress(unpacked[0].(common.Address).Bytes())
+	grantee := sdk.AccAddress(contract.Bytes())
+	if !h.HasWithdrawRewardsGrant(ctx, delAddr, grantee) {
+		return types.ErrCodeNoWithdrawRewardsGrant(delAddr.String(), grantee.String())
+	}
+	return withdrawAllRewards(ctx, h.Keeper, delAddr)
+}
+
+func checkEventHeight(ctx sdk.Context, eventName string) error {
+	if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+		errMsg := fmt.Sprintf("%s not support at height %d", eventName, ctx.BlockHeight())
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+	}
+	return nil
+}
+
+// withdrawAllRewards withdraws all the rewards of the delegator as MsgWithdrawDelegatorAllRewards does
+func withdrawAllRewards(ctx sdk.Context, k keeper.Keeper, delAddr sdk.AccAddress) error {
+	msg := types.NewMsgWithdrawDelegatorAllRewards(delAddr)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	res, err := NewHandler(k)(ctx, msg)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvents(res.Events)
+	return nil
+}
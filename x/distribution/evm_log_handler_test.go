@@ -0,0 +1,83 @@
+package distribution
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/distribution/keeper"
+	"github.com/okex/exchain/x/distribution/types"
+)
+
+func TestWithdrawRewardsEventHandler(t *testing.T) {
+	ctx, _, dk, sk, _ := keeper.CreateTestInputDefault(t, false, 10)
+	delAddr := keeper.TestDelAddrs[0]
+	contract := common.BytesToAddress(delAddr)
+	h := NewWithdrawRewardsEventHandler(dk)
+
+	// not supported before venus5
+	require.Error(t, h.Handle(ctx, contract, nil))
+
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+	tmtypes.UnittestOnlySetMilestoneVenus2Height(-1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus2Height(0)
+	ctx.SetBlockHeight(2)
+	keeper.HandleChangeDistributionTypeProposal(ctx, dk,
+		types.NewChangeDistributionTypeProposal("change distri type", "", types.DistributionTypeOnChain))
+
+	// the contract has no delegation
+	require.Equal(t, types.ErrCodeEmptyDelegationDistInfo(), h.Handle(ctx, contract, nil))
+
+	// the contract withdraws the rewards of its delegation
+	keeper.DoDepositWithError(t, ctx, sk, delAddr, sdk.NewCoin(sk.BondDenom(ctx), sdk.NewInt(100)), nil)
+	keeper.DoAddSharesWithError(t, ctx, sk, delAddr, []sdk.ValAddress{keeper.TestValAddrs[0]}, nil)
+	require.NoError(t, h.Handle(ctx, contract, nil))
+}
+
+func TestWithdrawRewardsForEventHandler(t *testing.T) {
+	ctx, _, dk, sk, _ := keeper.CreateTestInputDefault(t, false, 10)
+	handler := NewHandler(dk)
+	delAddr := keeper.TestDelAddrs[0]
+	vault := common.BytesToAddress(keeper.TestDelAddrs[1])
+	grantee := sdk.AccAddress(vault.Bytes())
+	h := NewWithdrawRewardsForEventHandler(dk)
+	data, err := WithdrawRewardsForEvent.Inputs.Pack(common.BytesToAddress(delAddr))
+	require.NoError(t, err)
+
+	// not supported before venus5
+	require.Error(t, h.Handle(ctx, vault, data))
+	_, err = handler(ctx, NewMsgGrantWithdrawRewards(delAddr, grantee))
+	require.Equal(t, types.ErrUnknownDistributionMsgType(), err)
+
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+	tmtypes.UnittestOnlySetMilestoneVenus2Height(-1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus2Height(0)
+	ctx.SetBlockHeight(2)
+	keeper.HandleChangeDistributionTypeProposal(ctx, dk,
+		types.NewChangeDistributionTypeProposal("change distri type", "", types.DistributionTypeOnChain))
+	keeper.DoDepositWithError(t, ctx, sk, delAddr, sdk.NewCoin(sk.BondDenom(ctx), sdk.NewInt(100)), nil)
+	keeper.DoAddSharesWithError(t, ctx, sk, delAddr, []sdk.ValAddress{keeper.TestValAddrs[0]}, nil)
+
+	// the vault isn't granted
+	require.Equal(t, types.ErrCodeNoWithdrawRewardsGrant(delAddr.String(), grantee.String()), h.Handle(ctx, vault, data))
+
+	// the vault withdraws the rewards of the delegator once granted
+	_, err = handler(ctx, NewMsgGrantWithdrawRewards(delAddr, grantee))
+	require.NoError(t, err)
+	require.NoError(t, h.Handle(ctx, vault, data))
+
+	// and can't anymore once revoked
+	_, err = handler(ctx, NewMsgRevokeWithdrawRewards(delAddr, grantee))
+	require.NoError(t, err)
+	require.Error(t, h.Handle(ctx, vault, data))
+	_, err = handler(ctx, NewMsgRevokeWithdrawRewards(delAddr, grantee))
+	require.Equal(t, types.ErrCodeNoWithdrawRewardsGrant(delAddr.String(), grantee.String()), err)
+
+	// the data failing to decode is ignored
+	require.NoError(t, h.Handle(ctx, vault, []byte{0x1}))
+}
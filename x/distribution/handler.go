@@ -35,6 +35,16 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 				return handleMsgWithdrawDelegatorAllRewards(ctx, msg, k)
 			}
 			return nil, types.ErrUnknownDistributionMsgType()
+		case types.MsgGrantWithdrawRewards:
+			if tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+				return handleMsgGrantWithdrawRewards(ctx, msg, k)
+			}
+			return nil, types.ErrUnknownDistributionMsgType()
+		case types.MsgRevokeWithdrawRewards:
+			if tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+				return handleMsgRevokeWithdrawRewards(ctx, msg, k)
+			}
+			return nil, types.ErrUnknownDistributionMsgType()
 
 		default:
 			return nil, types.ErrUnknownDistributionMsgType()
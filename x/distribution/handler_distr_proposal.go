@@ -39,3 +39,34 @@ func handleMsgWithdrawDelegatorAllRewards(ctx sdk.Context, msg types.MsgWithdraw
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgGrantWithdrawRewards(ctx sdk.Context, msg types.MsgGrantWithdrawRewards, k keeper.Keeper) (*sdk.Result, error) {
+	k.SetWithdrawRewardsGrant(ctx, msg.DelegatorAddress, msg.GranteeAddress)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevokeWithdrawRewards(ctx sdk.Context, msg types.MsgRevokeWithdrawRewards, k keeper.Keeper) (*sdk.Result, error) {
+	if !k.HasWithdrawRewardsGrant(ctx, msg.DelegatorAddress, msg.GranteeAddress) {
+		return nil, types.ErrCodeNoWithdrawRewardsGrant(msg.DelegatorAddress.String(), msg.GranteeAddress.String())
+	}
+	k.DeleteWithdrawRewardsGrant(ctx, msg.DelegatorAddress, msg.GranteeAddress)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
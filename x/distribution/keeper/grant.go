@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	comm "github.com/okex/exchain/x/common"
+
+	"github.com/okex/exchain/x/distribution/types"
+)
+
+// HasWithdrawRewardsGrant returns whether the grantee is granted to withdraw the rewards of the delegator
+func (k Keeper) HasWithdrawRewardsGrant(ctx sdk.Context, delAddr, grantee sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetWithdrawRewardsGrantKey(delAddr, grantee))
+}
+
+// SetWithdrawRewardsGrant grants the grantee to withdraw the rewards of the delegator
+func (k Keeper) SetWithdrawRewardsGrant(ctx sdk.Context, delAddr, grantee sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetWithdrawRewardsGrantKey(delAddr, grantee), []byte{})
+}
+
+// DeleteWithdrawRewardsGrant revokes the grant of withdrawing the rewards of the delegator by the grantee
+func (k Keeper) DeleteWithdrawRewardsGrant(ctx sdk.Context, delAddr, grantee sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetWithdrawRewardsGrantKey(delAddr, grantee))
+}
+
+// IterateWithdrawRewardsGrantees iterates over the grantees of withdrawing the rewards of the delegator
+func (k Keeper) IterateWithdrawRewardsGrantees(ctx sdk.Context, delAddr sdk.AccAddress,
+	handler func(grantee sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetWithdrawRewardsGrantPrefix(delAddr))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.GetWithdrawRewardsGrantGrantee(iter.Key())) {
+			break
+		}
+	}
+}
+
+func queryWithdrawRewardsGrantees(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, comm.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	grantees := make([]sdk.AccAddress, 0)
+	k.IterateWithdrawRewardsGrantees(ctx, params.DelegatorAddress, func(grantee sdk.AccAddress) (stop bool) {
+		grantees = append(grantees, grantee)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, grantees)
+	if err != nil {
+		return nil, comm.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return bz, nil
+}
@@ -37,6 +37,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryValidatorOutstandingRewards:
 			return queryValidatorOutstandingRewards(ctx, path[1:], req, k)
 
+		case types.QueryWithdrawRewardsGrantees:
+			return queryWithdrawRewardsGrantees(ctx, path[1:], req, k)
+
 		default:
 			return nil, types.ErrUnknownDistributionQueryType()
 		}
@@ -85,6 +85,28 @@ func TestQueryDelegatorWithdrawAddress(t *testing.T) {
 	require.Equal(t, valAccAddr2, data)
 }
 
+func TestQueryWithdrawRewardsGrantees(t *testing.T) {
+	ctx, _, k, _, _ := CreateTestInputDefault(t, false, 1000)
+	querior := NewQuerier(k)
+	k.SetWithdrawRewardsGrant(ctx, valAccAddr1, valAccAddr2)
+	k.SetWithdrawRewardsGrant(ctx, valAccAddr2, valAccAddr3)
+
+	bz, err := amino.MarshalJSON(types.NewQueryDelegatorParams(valAccAddr1))
+	require.NoError(t, err)
+	grantees, err := querior(ctx, []string{types.QueryWithdrawRewardsGrantees}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var data []sdk.AccAddress
+	require.NoError(t, amino.UnmarshalJSON(grantees, &data))
+	require.Equal(t, []sdk.AccAddress{valAccAddr2}, data)
+
+	k.DeleteWithdrawRewardsGrant(ctx, valAccAddr1, valAccAddr2)
+	grantees, err = querior(ctx, []string{types.QueryWithdrawRewardsGrantees}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+	require.NoError(t, amino.UnmarshalJSON(grantees, &data))
+	require.Empty(t, data)
+}
+
 func TestQueryCommunityPool(t *testing.T) {
 	ctx, _, k, _, _ := CreateTestInputDefault(t, false, 1000)
 	querior := NewQuerier(k)
@@ -22,6 +22,8 @@ func init() {
 func RegisterConvert() {
 	enableHeight := tmtypes.GetVenus3Height()
 	baseapp.RegisterCmHandle("okexchain/distribution/MsgWithdrawDelegatorAllRewards", baseapp.NewCMHandle(ConvertWithdrawDelegatorAllRewardsMsg, enableHeight))
+	baseapp.RegisterCmHandle("okexchain/distribution/MsgGrantWithdrawRewards", baseapp.NewCMHandle(ConvertGrantWithdrawRewardsMsg, tmtypes.GetVenus5Height()))
+	baseapp.RegisterCmHandle("okexchain/distribution/MsgRevokeWithdrawRewards", baseapp.NewCMHandle(ConvertRevokeWithdrawRewardsMsg, tmtypes.GetVenus5Height()))
 }
 
 func ConvertWithdrawDelegatorAllRewardsMsg(data []byte, signers []sdk.AccAddress) (sdk.Msg, error) {
@@ -39,3 +41,35 @@ func ConvertWithdrawDelegatorAllRewardsMsg(data []byte, signers []sdk.AccAddress
 	}
 	return newMsg, nil
 }
+
+func ConvertGrantWithdrawRewardsMsg(data []byte, signers []sdk.AccAddress) (sdk.Msg, error) {
+	newMsg := types.MsgGrantWithdrawRewards{}
+	err := json.Unmarshal(data, &newMsg)
+	if err != nil {
+		return nil, err
+	}
+	err = newMsg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	if ok := common.CheckSignerAddress(signers, newMsg.GetSigners()); !ok {
+		return nil, ErrCheckSignerFail
+	}
+	return newMsg, nil
+}
+
+func ConvertRevokeWithdrawRewardsMsg(data []byte, signers []sdk.AccAddress) (sdk.Msg, error) {
+	newMsg := types.MsgRevokeWithdrawRewards{}
+	err := json.Unmarshal(data, &newMsg)
+	if err != nil {
+		return nil, err
+	}
+	err = newMsg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	if ok := common.CheckSignerAddress(signers, newMsg.GetSigners()); !ok {
+		return nil, ErrCheckSignerFail
+	}
+	return newMsg, nil
+}
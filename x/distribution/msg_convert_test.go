@@ -86,3 +86,59 @@ func TestConvertWithdrawDelegatorAllRewardsMsg(t *testing.T) {
 		ts.fnCheck(msg, err, ts.res)
 	}
 }
+
+func TestConvertGrantWithdrawRewardsMsg(t *testing.T) {
+	addr, err := sdk.AccAddressFromHex("B2910E22Bb23D129C02d122B77B462ceB0E89Db9")
+	require.NoError(t, err)
+	grantee, err := sdk.AccAddressFromHex("889Fb79ac5Ec9C1Ee86Db2D3f3857Dd3D4af0C2E")
+	require.NoError(t, err)
+
+	testcases := []struct {
+		msgstr  string
+		signers []sdk.AccAddress
+		res     sdk.Msg
+		err     bool
+	}{
+		{
+			msgstr:  fmt.Sprintf(`{"delegator_address": "%s", "grantee_address": "%s"}`, addr, grantee),
+			signers: []sdk.AccAddress{addr},
+			res:     NewMsgGrantWithdrawRewards(addr, grantee),
+		},
+		{
+			msgstr:  "123",
+			signers: []sdk.AccAddress{addr},
+			err:     true,
+		},
+		{
+			msgstr:  fmt.Sprintf(`{"delegator_address": "%s", "grantee_address": "%s"}`, addr, addr),
+			signers: []sdk.AccAddress{addr},
+			err:     true,
+		},
+		{
+			msgstr:  fmt.Sprintf(`{"delegator_address": "%s", "grantee_address": "%s"}`, addr, grantee),
+			signers: []sdk.AccAddress{grantee},
+			err:     true,
+		},
+	}
+
+	for _, ts := range testcases {
+		msg, err := ConvertGrantWithdrawRewardsMsg([]byte(ts.msgstr), ts.signers)
+		if ts.err {
+			require.Error(t, err)
+			require.Nil(t, msg)
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, ts.res, msg)
+		}
+
+		// the revoke msg converts alike
+		msg, err = ConvertRevokeWithdrawRewardsMsg([]byte(ts.msgstr), ts.signers)
+		if ts.err {
+			require.Error(t, err)
+			require.Nil(t, msg)
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, NewMsgRevokeWithdrawRewards(addr, grantee), msg)
+		}
+	}
+}
@@ -14,6 +14,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(WithdrawRewardEnabledProposal{}, "okexchain/distribution/WithdrawRewardEnabledProposal", nil)
 	cdc.RegisterConcrete(RewardTruncatePrecisionProposal{}, "okexchain/distribution/RewardTruncatePrecisionProposal", nil)
 	cdc.RegisterConcrete(MsgWithdrawDelegatorAllRewards{}, "okexchain/distribution/MsgWithdrawDelegatorAllRewards", nil)
+	cdc.RegisterConcrete(MsgGrantWithdrawRewards{}, "okexchain/distribution/MsgGrantWithdrawRewards", nil)
+	cdc.RegisterConcrete(MsgRevokeWithdrawRewards{}, "okexchain/distribution/MsgRevokeWithdrawRewards", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
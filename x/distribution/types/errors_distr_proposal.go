@@ -2,6 +2,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 )
@@ -19,6 +21,8 @@ const (
 	CodeProposerMustBeValidator                   uint32 = 67828
 	CodeNotSupportRewardTruncatePrecisionProposal uint32 = 67829
 	CodeOutOfRangeRewardTruncatePrecision         uint32 = 67830
+	CodeNoWithdrawRewardsGrant                    uint32 = 67831
+	CodeInvalidGranteeAddr                        uint32 = 67832
 )
 
 func ErrInvalidDistributionType() sdk.Error {
@@ -68,3 +72,12 @@ func ErrCodeNotSupportRewardTruncateProposal() sdk.Error {
 func ErrCodeRewardTruncatePrecision() sdk.Error {
 	return sdkerrors.New(DefaultCodespace, CodeOutOfRangeRewardTruncatePrecision, "reward truncate precision out of range [0,18]")
 }
+
+func ErrCodeNoWithdrawRewardsGrant(delAddr, grantee string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeNoWithdrawRewardsGrant,
+		fmt.Sprintf("%s isn't granted to withdraw the rewards of %s", grantee, delAddr))
+}
+
+func ErrCodeInvalidGranteeAddr() sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeInvalidGranteeAddr, "grantee address is empty or the delegator address")
+}
@@ -11,6 +11,7 @@ var (
 	ValidatorHistoricalRewardsPrefix        = []byte{0x05} // key for historical validators rewards / stake
 	ValidatorCurrentRewardsPrefix           = []byte{0x06} // key for current validator rewards
 	InitExistedValidatorForDistrProposalKey = []byte{0x09} // key for check init old validator distribution proposal
+	WithdrawRewardsGrantPrefix              = []byte{0x0A} // key for the grants of withdrawing the delegator rewards
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -87,3 +88,22 @@ func GetValidatorHistoricalRewardsKey(v sdk.ValAddress, k uint64) []byte {
 func GetValidatorCurrentRewardsKey(v sdk.ValAddress) []byte {
 	return append(ValidatorCurrentRewardsPrefix, v.Bytes()...)
 }
+
+// gets the prefix key for the grants of withdrawing the rewards of a delegator
+func GetWithdrawRewardsGrantPrefix(d sdk.AccAddress) []byte {
+	return append(WithdrawRewardsGrantPrefix, d.Bytes()...)
+}
+
+// gets the key for the grant of withdrawing the rewards of a delegator by the grantee
+func GetWithdrawRewardsGrantKey(d sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(GetWithdrawRewardsGrantPrefix(d), grantee.Bytes()...)
+}
+
+// gets the grantee from a key of the grants of withdrawing the rewards of a delegator
+func GetWithdrawRewardsGrantGrantee(key []byte) (grantee sdk.AccAddress) {
+	addr := key[1+sdk.AddrLen:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addr)
+}
@@ -0,0 +1,83 @@
+// nolint
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// Verify interface at compile time
+var _, _ sdk.Msg = &MsgGrantWithdrawRewards{}, &MsgRevokeWithdrawRewards{}
+
+// msg struct for granting an address, e.g. a vault contract, to withdraw all the rewards of a delegator
+type MsgGrantWithdrawRewards struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	GranteeAddress   sdk.AccAddress `json:"grantee_address" yaml:"grantee_address"`
+}
+
+func NewMsgGrantWithdrawRewards(delAddr, grantee sdk.AccAddress) MsgGrantWithdrawRewards {
+	return MsgGrantWithdrawRewards{
+		DelegatorAddress: delAddr,
+		GranteeAddress:   grantee,
+	}
+}
+
+func (msg MsgGrantWithdrawRewards) Route() string { return ModuleName }
+func (msg MsgGrantWithdrawRewards) Type() string  { return "grant_withdraw_rewards" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgGrantWithdrawRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgGrantWithdrawRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgGrantWithdrawRewards) ValidateBasic() error {
+	return validateGrant(msg.DelegatorAddress, msg.GranteeAddress)
+}
+
+// msg struct for revoking the grant of withdrawing all the rewards of a delegator
+type MsgRevokeWithdrawRewards struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	GranteeAddress   sdk.AccAddress `json:"grantee_address" yaml:"grantee_address"`
+}
+
+func NewMsgRevokeWithdrawRewards(delAddr, grantee sdk.AccAddress) MsgRevokeWithdrawRewards {
+	return MsgRevokeWithdrawRewards{
+		DelegatorAddress: delAddr,
+		GranteeAddress:   grantee,
+	}
+}
+
+func (msg MsgRevokeWithdrawRewards) Route() string { return ModuleName }
+func (msg MsgRevokeWithdrawRewards) Type() string  { return "revoke_withdraw_rewards" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgRevokeWithdrawRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRevokeWithdrawRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgRevokeWithdrawRewards) ValidateBasic() error {
+	return validateGrant(msg.DelegatorAddress, msg.GranteeAddress)
+}
+
+func validateGrant(delAddr, grantee sdk.AccAddress) error {
+	if delAddr.Empty() {
+		return ErrNilDelegatorAddr()
+	}
+	if grantee.Empty() || grantee.Equals(delAddr) {
+		return ErrCodeInvalidGranteeAddr()
+	}
+	return nil
+}
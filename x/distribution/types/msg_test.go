@@ -85,3 +85,35 @@ func TestMsgWithdrawValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgGrantWithdrawRewards test ValidateBasic for MsgGrantWithdrawRewards and MsgRevokeWithdrawRewards
+func TestMsgGrantWithdrawRewards(t *testing.T) {
+	msg := NewMsgGrantWithdrawRewards(delAddr1, delAddr2)
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	require.Equal(t, ModuleName, msg.Route())
+	require.Equal(t, "grant_withdraw_rewards", msg.Type())
+	require.Equal(t, []sdk.AccAddress{delAddr1}, msg.GetSigners())
+	require.Equal(t, sdk.MustSortJSON(bz), msg.GetSignBytes())
+
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		granteeAddr   sdk.AccAddress
+		expectPass    bool
+	}{
+		{delAddr1, delAddr2, true},
+		{delAddr1, delAddr1, false},
+		{emptyDelAddr, delAddr1, false},
+		{delAddr1, emptyDelAddr, false},
+	}
+	for i, tc := range tests {
+		grant := NewMsgGrantWithdrawRewards(tc.delegatorAddr, tc.granteeAddr)
+		revoke := NewMsgRevokeWithdrawRewards(tc.delegatorAddr, tc.granteeAddr)
+		if tc.expectPass {
+			require.Nil(t, grant.ValidateBasic(), "test index: %v", i)
+			require.Nil(t, revoke.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, grant.ValidateBasic(), "test index: %v", i)
+			require.NotNil(t, revoke.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
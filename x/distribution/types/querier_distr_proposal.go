@@ -8,6 +8,7 @@ const (
 	QueryDelegatorTotalRewards       = "delegator_total_rewards"
 	QueryDelegatorValidators         = "delegator_validators"
 	QueryDelegationRewards           = "delegation_rewards"
+	QueryWithdrawRewardsGrantees     = "withdraw_rewards_grantees"
 
 	ParamDistributionType        = "distribution_type"
 	ParamWithdrawRewardEnabled   = "withdraw_reward_enabled"
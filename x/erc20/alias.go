@@ -17,6 +17,7 @@ const (
 var (
 	NewKeeper                = keeper.NewKeeper
 	NewIBCTransferHooks      = keeper.NewIBCTransferHooks
+	NewDenomMetadataHooks    = keeper.NewDenomMetadataHooks
 	NewSendToIbcEventHandler = keeper.NewSendToIbcEventHandler
 
 	NewSendNative20ToIbcEventHandler = keeper.NewSendNative20ToIbcEventHandler
@@ -8,7 +8,9 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/okex/exchain/libs/cosmos-sdk/client"
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	interfacetypes "github.com/okex/exchain/libs/cosmos-sdk/codec/types"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
@@ -16,11 +18,17 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
 	govcli "github.com/okex/exchain/libs/cosmos-sdk/x/gov/client/cli"
+	ibctransfertypes "github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
 	"github.com/okex/exchain/x/erc20/types"
 	"github.com/okex/exchain/x/gov"
 	"github.com/spf13/cobra"
 )
 
+const (
+	flagSourcePort    = "source-port"
+	flagSourceChannel = "source-channel"
+)
+
 // GetCmdTokenMappingProposal returns a CLI command handler for creating
 // a token mapping proposal governance transaction.
 func GetCmdTokenMappingProposal(cdcP *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
@@ -229,3 +237,70 @@ the template.json should be like :
 
 	return cmd
 }
+
+// GetTxCmd returns the transaction commands for the erc20 module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "erc20 transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(flags.PostCommands(
+		GetCmdTransferERC20(cdc),
+	)...)
+	return cmd
+}
+
+// GetCmdTransferERC20 returns a CLI command handler for sending the evm tokens
+// of a registered contract over ibc.
+func GetCmdTransferERC20(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-to-ibc [contract] [amount] [receiver]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Send the evm tokens of a registered contract to another chain by ibc",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send the evm tokens of a registered contract to another chain by ibc.
+The tokens are converted into their bank representation before the transfer, the amount
+is given in the smallest unit of the contract. The source port and channel may be omitted
+for ibc vouchers, which are then sent back through the channel they came from.
+
+Example:
+$ %s tx erc20 transfer-to-ibc 0x0000...0000 1000000000000000000 cosmos1... --source-channel=channel-0 --from=<key_or_address>
+`, version.ClientName,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			sourcePort, err := cmd.Flags().GetString(flagSourcePort)
+			if err != nil {
+				return err
+			}
+			sourceChannel, err := cmd.Flags().GetString(flagSourceChannel)
+			if err != nil {
+				return err
+			}
+			if sourceChannel == "" {
+				sourcePort = ""
+			}
+
+			msg := types.NewMsgTransferERC20(cliCtx.GetFromAddress(), args[0], amount, args[2], sourcePort, sourceChannel)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagSourcePort, ibctransfertypes.PortID, "source port of the transfer")
+	cmd.Flags().String(flagSourceChannel, "", "source channel of the transfer, required for tokens native to this chain")
+
+	return cmd
+}
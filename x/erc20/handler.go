@@ -1,8 +1,11 @@
 package erc20
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/x/erc20/types"
+	"github.com/okex/exchain/x/evm/watcher"
 )
 
 // NewHandler returns a handler for erc20 type messages.
@@ -11,8 +14,35 @@ func NewHandler(k Keeper) sdk.Handler {
 		ctx.SetEventManager(sdk.NewEventManager())
 
 		switch msg := msg.(type) {
+		case types.MsgTransferERC20:
+			return handleMsgTransferERC20(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
 	}
 }
+
+func handleMsgTransferERC20(ctx sdk.Context, k Keeper, msg types.MsgTransferERC20) (*sdk.Result, error) {
+	// the evm token balances are changed by the module call
+	if watcher.IsWatcherEnabled() {
+		ctx.SetWatcher(watcher.NewTxWatcher())
+	}
+
+	if err := k.IbcTransferERC20(ctx, msg.Sender, common.HexToAddress(msg.Contract), msg.Amount,
+		msg.Receiver, msg.SourcePort, msg.SourceChannel); err != nil {
+		return nil, err
+	}
+
+	if watcher.IsWatcherEnabled() {
+		ctx.GetWatcher().Finalize()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
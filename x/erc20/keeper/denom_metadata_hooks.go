@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	transfertypes "github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
+	"github.com/okex/exchain/x/erc20/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+var (
+	_ transfertypes.DenomMetadataHooks = DenomMetadataHooks{}
+)
+
+// DenomMetadataHooks mirrors the display metadata of the ibc vouchers into the denom metadata contract, so that the
+// evm contracts can read the symbols, the decimals and the display denoms of the vouchers
+type DenomMetadataHooks struct {
+	Keeper
+}
+
+func NewDenomMetadataHooks(k Keeper) DenomMetadataHooks {
+	return DenomMetadataHooks{k}
+}
+
+// AfterDenomMetadataSet writes the symbol, the decimals and the display denom of the denom into the storage of the
+// denom metadata contract
+func (h DenomMetadataHooks) AfterDenomMetadataSet(ctx sdk.Context, metadata transfertypes.DenomMetadata) error {
+	csdb := evmtypes.CreateEmptyCommitStateDB(h.evmKeeper.GenerateCSDBParams(), ctx)
+	if len(csdb.GetCode(types.DenomMetadataContractAddress)) == 0 {
+		csdb.SetCode(types.DenomMetadataContractAddress, types.DenomMetadataContractCode)
+	}
+	csdb.SetState(types.DenomMetadataContractAddress, types.GetDenomSymbolSlot(metadata.Denom), types.GetDenomStringValue(metadata.Symbol))
+	csdb.SetState(types.DenomMetadataContractAddress, types.GetDenomDecimalsSlot(metadata.Denom), common.BigToHash(sdk.NewInt(int64(metadata.Decimals)).BigInt()))
+	csdb.SetState(types.DenomMetadataContractAddress, types.GetDenomDisplaySlot(metadata.Denom), types.GetDenomStringValue(metadata.Display))
+	if _, err := csdb.Commit(false); err != nil {
+		return fmt.Errorf("failed to write the metadata of %s into the denom metadata contract: %w", metadata.Denom, err)
+	}
+	return nil
+}
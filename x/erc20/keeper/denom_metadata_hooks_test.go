@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	transfertypes "github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
+	"github.com/okex/exchain/x/erc20/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestDenomMetadataHooks() {
+	denomTrace := transfertypes.DenomTrace{BaseDenom: "uatom", Path: "transfer/channel-0"}
+	suite.app.TransferKeeper.SetDenomTrace(suite.ctx, denomTrace)
+
+	metadata := transfertypes.NewDenomMetadata(denomTrace.IBCDenom(), "ATOM", "atom", 6, "cosmos hub staking token")
+	proposal := transfertypes.NewDenomMetadataProposal("title", "description", metadata).(*transfertypes.DenomMetadataProposal)
+	suite.Require().NoError(suite.app.TransferKeeper.HandleDenomMetadataProposal(suite.ctx, proposal))
+
+	csdb := evmtypes.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	suite.Require().Equal(types.DenomMetadataContractCode, csdb.GetCode(types.DenomMetadataContractAddress))
+	symbol := csdb.GetState(types.DenomMetadataContractAddress, types.GetDenomSymbolSlot(metadata.Denom))
+	suite.Require().Equal("ATOM", string(common.TrimRightZeroes(symbol.Bytes())))
+	decimals := csdb.GetState(types.DenomMetadataContractAddress, types.GetDenomDecimalsSlot(metadata.Denom))
+	suite.Require().Equal(uint64(6), decimals.Big().Uint64())
+	display := csdb.GetState(types.DenomMetadataContractAddress, types.GetDenomDisplaySlot(metadata.Denom))
+	suite.Require().Equal("atom", string(common.TrimRightZeroes(display.Bytes())))
+
+	// the metadata registered again overrides the mirrored one
+	metadata = transfertypes.NewDenomMetadata(denomTrace.IBCDenom(), "uATOM", "", 0, "")
+	proposal = transfertypes.NewDenomMetadataProposal("title", "description", metadata).(*transfertypes.DenomMetadataProposal)
+	suite.Require().NoError(suite.app.TransferKeeper.HandleDenomMetadataProposal(suite.ctx, proposal))
+
+	csdb = evmtypes.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	symbol = csdb.GetState(types.DenomMetadataContractAddress, types.GetDenomSymbolSlot(metadata.Denom))
+	suite.Require().Equal("uATOM", string(common.TrimRightZeroes(symbol.Bytes())))
+	decimals = csdb.GetState(types.DenomMetadataContractAddress, types.GetDenomDecimalsSlot(metadata.Denom))
+	suite.Require().Equal(common.Hash{}, decimals)
+	display = csdb.GetState(types.DenomMetadataContractAddress, types.GetDenomDisplaySlot(metadata.Denom))
+	suite.Require().Equal(common.Hash{}, display)
+}
+
+func (suite *KeeperTestSuite) TestDenomMetadataHooksInitGenesis() {
+	denomTrace := transfertypes.DenomTrace{BaseDenom: "uatom", Path: "transfer/channel-0"}
+	genesis := transfertypes.DefaultGenesisState()
+	genesis.DenomTraces = transfertypes.Traces{denomTrace}
+	genesis.DenomMetadata = []transfertypes.DenomMetadata{transfertypes.NewDenomMetadata(denomTrace.IBCDenom(), "ATOM", "atom", 6, "")}
+	suite.app.TransferKeeper.InitGenesis(suite.ctx, *genesis)
+
+	// the metadata loaded from the genesis is mirrored as well
+	csdb := evmtypes.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	symbol := csdb.GetState(types.DenomMetadataContractAddress, types.GetDenomSymbolSlot(denomTrace.IBCDenom()))
+	suite.Require().Equal("ATOM", string(common.TrimRightZeroes(symbol.Bytes())))
+	decimals := csdb.GetState(types.DenomMetadataContractAddress, types.GetDenomDecimalsSlot(denomTrace.IBCDenom()))
+	suite.Require().Equal(uint64(6), decimals.Big().Uint64())
+	display := csdb.GetState(types.DenomMetadataContractAddress, types.GetDenomDisplaySlot(denomTrace.IBCDenom()))
+	suite.Require().Equal("atom", string(common.TrimRightZeroes(display.Bytes())))
+}
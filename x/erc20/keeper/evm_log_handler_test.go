@@ -84,7 +84,7 @@ func (suite *KeeperTestSuite) TestSendToIbcHandler() {
 			func() {
 				amount := sdk.NewInt(100)
 				suite.app.TransferKeeper.SetParams(suite.ctx, types2.Params{
-					true, true,
+					SendEnabled: true, ReceiveEnabled: true, MaxMemoLength: types2.DefaultMaxMemoLength,
 				})
 				channelA := "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"
 				suite.app.TransferKeeper.SetDenomTrace(suite.ctx, types2.DenomTrace{
@@ -245,7 +245,7 @@ func (suite *KeeperTestSuite) TestSendNative20ToIbcHandler() {
 				suite.ctx.SetIsTraceTx(false)
 				balance := suite.GetBalance(sdk.AccAddress(contract.Bytes()), validDenom)
 				suite.Require().Equal(coin, balance)
-				suite.app.TransferKeeper.SetParams(suite.ctx, types2.Params{true, true})
+				suite.app.TransferKeeper.SetParams(suite.ctx, types2.Params{SendEnabled: true, ReceiveEnabled: true, MaxMemoLength: types2.DefaultMaxMemoLength})
 				input, err := keeper.SendNative20ToIbcEvent.Inputs.Pack(
 					sender,
 					"recipient",
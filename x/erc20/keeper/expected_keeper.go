@@ -52,6 +52,7 @@ type TransferKeeper interface {
 		receiver string,
 		timeoutHeight clienttypes.Height,
 		timeoutTimestamp uint64,
+		memo string,
 	) error
 	DenomPathFromHash(ctx sdk.Context, denom string) (string, error)
 	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (types.DenomTrace, bool)
@@ -381,6 +381,78 @@ func (k Keeper) IbcTransferNative20(ctx sdk.Context, from, to string, native20s
 	return nil
 }
 
+// IbcTransferERC20 burns evm tokens of a registered contract from the sender and
+// transfers their bank representation to the receiver on the other chain by ibc.
+// If the transfer fails the sender is refunded in bank coins, which are converted
+// back into evm tokens by the transfer hooks.
+func (k Keeper) IbcTransferERC20(ctx sdk.Context, sender sdk.AccAddress, contract common.Address, amount sdk.Int,
+	to, portID, channelID string) error {
+	denom, found := k.GetDenomByContract(ctx, contract)
+	if !found {
+		return fmt.Errorf("contract %s is not connected to native token", contract)
+	}
+	k.Logger(ctx).Info("transfer erc20 to other chain by ibc", "sender", sender, "to", to,
+		"contract", contract.String(), "denom", denom, "amount", amount)
+
+	// 1. burn the evm tokens of the sender in the contract
+	if _, err := k.CallModuleERC20(
+		ctx,
+		contract,
+		types.ContractBurnMethod,
+		common.BytesToAddress(sender.Bytes()),
+		amount.BigInt()); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewDecFromIntWithPrec(amount, sdk.Precision)))
+	events := sdk.Events{
+		sdk.NewEvent(
+			types.EventTypCallModuleERC20,
+			sdk.NewAttribute(types.AttributeKeyContractAddr, contract.String()),
+			sdk.NewAttribute(types.AttributeKeyContractMethod, types.ContractBurnMethod),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(ibctransfertypes.AttributeKeyAmount, amount.String()),
+		),
+	}
+
+	// 2. give the bank representation to the sender, who is refunded if the transfer fails
+	if types.IsValidIBCDenom(denom) {
+		// okc2:erc20/xxb----->okc2:ibc/xxb, the vouchers are locked in the contract address
+		if err := k.bankKeeper.SendCoins(ctx, sdk.AccAddress(contract.Bytes()), sender, coins); err != nil {
+			return err
+		}
+		events = append(events, sdk.NewEvent(
+			types.EventTypUnlock,
+			sdk.NewAttribute(types.AttributeKeyFrom, contract.String()),
+			sdk.NewAttribute(types.AttributeKeyTo, sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		))
+	} else {
+		// okc1:erc20/xxb----->okc1:xxb, the natives are burned when converted into evm tokens
+		if portID == "" || channelID == "" {
+			return fmt.Errorf("source port and channel are required to transfer native token %s", denom)
+		}
+		if err := k.supplyKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins); err != nil {
+			return err
+		}
+		events = append(events, sdk.NewEvent(
+			types.EventTypMint,
+			sdk.NewAttribute(types.AttributeKeyTo, sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	// 3. initiate the ibc transfer from the sender account
+	if channelID == "" {
+		return k.ibcSendTransfer(ctx, sender, to, coins[0])
+	}
+	return k.ibcSendTransferWithChannel(ctx, sender, to, coins[0], portID, channelID)
+}
+
 func (k Keeper) ibcSendTransfer(ctx sdk.Context, sender sdk.AccAddress, to string, coin sdk.Coin) error {
 	// Coin needs to be a voucher so that we can extract the channel id from the denom
 	channelID, err := k.GetSourceChannelID(ctx, coin.Denom)
@@ -402,6 +474,7 @@ func (k Keeper) ibcSendTransfer(ctx sdk.Context, sender sdk.AccAddress, to strin
 		to,
 		timeoutHeight,
 		timeoutTimestamp,
+		"",
 	)
 }
 
@@ -420,5 +493,6 @@ func (k Keeper) ibcSendTransferWithChannel(ctx sdk.Context, sender sdk.AccAddres
 		to,
 		timeoutHeight,
 		timeoutTimestamp,
+		"",
 	)
 }
@@ -441,3 +441,148 @@ func (suite *KeeperTestSuite) TestConvertNatives() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestIbcTransferERC20() {
+	addr1 := common.BigToAddress(big.NewInt(1))
+	addr1Bech := sdk.AccAddress(addr1.Bytes())
+
+	amountDec := sdk.NewDec(123)
+	amount := sdk.NewIntFromBigInt(amountDec.BigInt())
+
+	var contract common.Address
+	setupEvm := func() {
+		evmParams := evmtypes.DefaultParams()
+		evmParams.EnableCreate = true
+		evmParams.EnableCall = true
+		suite.app.EvmKeeper.SetParams(suite.ctx, evmParams)
+		suite.app.Erc20Keeper.InitInternalTemplateContract(suite.ctx)
+	}
+	erc20Balance := func() *big.Int {
+		ret, err := suite.app.Erc20Keeper.CallModuleERC20(suite.ctx, contract, "balanceOf", addr1)
+		suite.Require().NoError(err)
+		return big.NewInt(0).SetBytes(ret)
+	}
+
+	testCases := []struct {
+		msg       string
+		channelID string
+		malleate  func()
+		postcheck func()
+		expError  error
+	}{
+		{
+			"non registered contract",
+			"",
+			func() {
+				contract = common.BigToAddress(big.NewInt(2))
+			},
+			func() {},
+			errors.New("contract 0x0000000000000000000000000000000000000002 is not connected to native token"),
+		},
+		{
+			"not enough evm tokens",
+			"",
+			func() {
+				setupEvm()
+				var err error
+				contract, err = suite.app.Erc20Keeper.DeployModuleERC20(suite.ctx, CorrectIbcDenom)
+				suite.Require().NoError(err)
+				suite.app.Erc20Keeper.SetContractForDenom(suite.ctx, CorrectIbcDenom, contract)
+			},
+			func() {},
+			errors.New("execution reverted"),
+		},
+		{
+			"ibc voucher : Should unlock vouchers to sender",
+			"",
+			func() {
+				setupEvm()
+				params := types.DefaultParams()
+				params.EnableAutoDeployment = true
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+
+				coin := sdk.NewCoin(CorrectIbcDenom, amountDec)
+				suite.Require().NoError(suite.MintCoins(addr1Bech, sdk.NewCoins(coin)))
+				suite.Require().NoError(suite.app.Erc20Keeper.ConvertVouchers(suite.ctx, addr1Bech.String(), sdk.NewCoins(coin)))
+
+				var found bool
+				contract, found = suite.app.Erc20Keeper.GetContractByDenom(suite.ctx, CorrectIbcDenom)
+				suite.Require().True(found)
+				suite.Require().Equal(amountDec.BigInt(), erc20Balance())
+			},
+			func() {
+				suite.Require().Equal(0, erc20Balance().Sign())
+				suite.Require().Equal(amountDec, suite.GetBalance(addr1Bech, CorrectIbcDenom).Amount)
+				suite.Require().True(suite.GetBalance(sdk.AccAddress(contract.Bytes()), CorrectIbcDenom).Amount.IsZero())
+			},
+			nil,
+		},
+		{
+			"native token without channel",
+			"",
+			func() {
+				setupEvm()
+				coin := sdk.NewCoin(NativeDenom, amountDec)
+				suite.Require().NoError(suite.MintCoins(addr1Bech, sdk.NewCoins(coin)))
+				var err error
+				contract, err = suite.app.Erc20Keeper.DeployModuleERC20(suite.ctx, NativeDenom)
+				suite.Require().NoError(err)
+				suite.app.Erc20Keeper.SetContractForDenom(suite.ctx, NativeDenom, contract)
+				suite.Require().NoError(suite.app.Erc20Keeper.ConvertNatives(suite.ctx, addr1Bech.String(), sdk.NewCoins(coin)))
+			},
+			func() {},
+			fmt.Errorf("source port and channel are required to transfer native token %s", NativeDenom),
+		},
+		{
+			"native token : Should mint natives to sender",
+			"channel-0",
+			func() {
+				setupEvm()
+				coin := sdk.NewCoin(NativeDenom, amountDec)
+				suite.Require().NoError(suite.MintCoins(addr1Bech, sdk.NewCoins(coin)))
+				var err error
+				contract, err = suite.app.Erc20Keeper.DeployModuleERC20(suite.ctx, NativeDenom)
+				suite.Require().NoError(err)
+				suite.app.Erc20Keeper.SetContractForDenom(suite.ctx, NativeDenom, contract)
+				suite.Require().NoError(suite.app.Erc20Keeper.ConvertNatives(suite.ctx, addr1Bech.String(), sdk.NewCoins(coin)))
+				suite.Require().True(suite.GetBalance(addr1Bech, NativeDenom).Amount.IsZero())
+			},
+			func() {
+				suite.Require().Equal(0, erc20Balance().Sign())
+				suite.Require().Equal(amountDec, suite.GetBalance(addr1Bech, NativeDenom).Amount)
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			// Create erc20 Keeper with mock transfer keeper
+			suite.app.Erc20Keeper = erc20Keeper.NewKeeper(
+				suite.app.Codec(),
+				suite.app.GetKey(types.StoreKey),
+				suite.app.GetSubspace(types.ModuleName),
+				suite.app.AccountKeeper,
+				suite.app.SupplyKeeper,
+				suite.app.BankKeeper,
+				suite.app.EvmKeeper,
+				IbcKeeperMock{},
+			)
+			tc.malleate()
+
+			portID := ""
+			if tc.channelID != "" {
+				portID = "transfer"
+			}
+			err := suite.app.Erc20Keeper.IbcTransferERC20(suite.ctx, addr1Bech, contract, amount, "to", portID, tc.channelID)
+			if tc.expError != nil {
+				suite.Require().Error(err, tc.msg)
+				suite.Require().Contains(err.Error(), tc.expError.Error(), tc.msg)
+			} else {
+				suite.Require().NoError(err, tc.msg)
+				tc.postcheck()
+			}
+		})
+	}
+}
@@ -73,7 +73,7 @@ func (suite *KeeperTestSuite) GetBalance(address sdk.AccAddress, denom string) s
 type IbcKeeperMock struct{}
 
 func (i IbcKeeperMock) SendTransfer(ctx sdk.Context, sourcePort, sourceChannel string, token sdk.CoinAdapter,
-	sender sdk.AccAddress, receiver string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64) error {
+	sender sdk.AccAddress, receiver string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, memo string) error {
 	return nil
 }
 
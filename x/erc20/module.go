@@ -2,6 +2,7 @@ package erc20
 
 import (
 	"encoding/json"
+	"math/rand"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/types/upgrade"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/params"
@@ -14,15 +15,18 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/types/module"
+	sim "github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
 	"github.com/okex/exchain/x/erc20/client/cli"
 	"github.com/okex/exchain/x/erc20/keeper"
+	"github.com/okex/exchain/x/erc20/simulation"
 	"github.com/okex/exchain/x/erc20/types"
 )
 
 var _ module.AppModuleBasic = AppModuleBasic{}
 var _ module.AppModule = AppModule{}
 var _ upgrade.UpgradeModule = AppModule{}
+var _ module.AppModuleSimulation = AppModule{}
 
 // AppModuleBasic struct
 type AppModuleBasic struct{}
@@ -58,7 +62,7 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 // GetTxCmd Gets the root tx command of this module
 func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
-	return nil
+	return cli.GetTxCmd(cdc)
 }
 
 //____________________________________________________________________________
@@ -147,3 +151,30 @@ func (am AppModule) RegisterTask() upgrade.HeightTask {
 func (am AppModule) RegisterParam() params.ParamSet {
 	return nil
 }
+
+//____________________________________________________________________________
+
+// AppModuleSimulation functions
+
+// GenerateGenesisState doesn't generate any genesis state, as the erc20 genesis is applied by the upgrade task.
+func (AppModule) GenerateGenesisState(_ *module.SimulationState) {}
+
+// ProposalContents returns all the erc20 content functions used to
+// simulate governance proposals.
+func (AppModule) ProposalContents(_ module.SimulationState) []sim.WeightedProposalContent {
+	return simulation.ProposalContents()
+}
+
+// RandomizedParams creates randomized erc20 param changes for the simulator.
+func (AppModule) RandomizedParams(r *rand.Rand) []sim.ParamChange {
+	return simulation.ParamChanges(r)
+}
+
+// RegisterStoreDecoder registers a decoder for erc20 module's types
+func (AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+
+// WeightedOperations doesn't return any erc20 module operation, as the erc20 transfers need the ibc channels that
+// the simulation doesn't open.
+func (AppModule) WeightedOperations(_ module.SimulationState) []sim.WeightedOperation {
+	return nil
+}
@@ -0,0 +1,33 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyEnableAutoDeployment),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%t", r.Intn(2) == 0)
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyIbcTimeout),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenIbcTimeout(r))
+			},
+		),
+	}
+}
+
+// GenIbcTimeout randomized IbcTimeout in nanoseconds
+func GenIbcTimeout(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1, 60*60*24)) * 1e9
+}
@@ -0,0 +1,46 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+
+	ethcmm "github.com/ethereum/go-ethereum/common"
+
+	simappparams "github.com/okex/exchain/libs/cosmos-sdk/simapp/params"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	govtypes "github.com/okex/exchain/libs/cosmos-sdk/x/gov/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
+	"github.com/okex/exchain/x/erc20/types"
+)
+
+// OpWeightSubmitTokenMappingProposal app params key for token mapping proposal
+const OpWeightSubmitTokenMappingProposal = "op_weight_submit_token_mapping_proposal"
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents() []simulation.WeightedProposalContent {
+	return []simulation.WeightedProposalContent{
+		{
+			AppParamsKey:       OpWeightSubmitTokenMappingProposal,
+			DefaultWeight:      simappparams.DefaultWeightTokenMappingProposal,
+			ContentSimulatorFn: SimulateTokenMappingProposalContent,
+		},
+	}
+}
+
+// SimulateTokenMappingProposalContent returns a random token mapping proposal content. Half of the proposals delete the
+// mapping of the denom, the others map it to the address of a random account.
+func SimulateTokenMappingProposalContent(r *rand.Rand, _ sdk.Context, accs []simulation.Account) govtypes.Content {
+	var contract *ethcmm.Address
+	if r.Intn(2) == 0 {
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		addr := ethcmm.BytesToAddress(simAccount.Address)
+		contract = &addr
+	}
+
+	return types.NewTokenMappingProposal(
+		simulation.RandStringOfLength(r, 140),
+		simulation.RandStringOfLength(r, 5000),
+		"sim"+strings.ToLower(simulation.RandStringOfLength(r, 5)),
+		contract,
+	)
+}
@@ -12,6 +12,7 @@ const (
 	ProxyContractRedirectProposalName = "okexchain/erc20/ProxyContractRedirectProposal"
 	ContractTemplateProposalName      = "okexchain/erc20/ContractTemplateProposal"
 	CompiledContractProposalName      = "okexchain/erc20/Contract"
+	MsgTransferERC20Name              = "okexchain/erc20/MsgTransferERC20"
 )
 
 // RegisterCodec registers all the necessary types and interfaces for the
@@ -22,6 +23,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(ProxyContractRedirectProposal{}, ProxyContractRedirectProposalName, nil)
 	cdc.RegisterConcrete(ContractTemplateProposal{}, ContractTemplateProposalName, nil)
 	cdc.RegisterConcrete(CompiledContract{}, CompiledContractProposalName, nil)
+
+	cdc.RegisterConcrete(MsgTransferERC20{}, MsgTransferERC20Name, nil)
 }
 
 func init() {
@@ -35,6 +35,7 @@ const (
 	IbcEvmModuleName = "ibc-evm"
 
 	ContractMintMethod = "mint_by_okc_module"
+	ContractBurnMethod = "burn_by_okc_module"
 
 	ProxyContractUpgradeTo   = "upgradeTo"
 	ProxyContractChangeAdmin = "changeAdmin"
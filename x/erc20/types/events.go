@@ -5,6 +5,8 @@ const (
 	EventTypCallModuleERC20   = "call_erc20_contract"
 	EventTypLock              = "erc20_lock"
 	EventTypBurn              = "erc20_burn"
+	EventTypUnlock            = "erc20_unlock"
+	EventTypMint              = "erc20_mint"
 
 	AttributeKeyContractAddr   = "contract_address"
 	AttributeKeyContractMethod = "contract_method"
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+)
+
+// The precompiled contracts of the EVM can't read the state of the chain, so the display metadata of the ibc vouchers
+// registered by the governance is mirrored into the storage of a system contract at DenomMetadataContractAddress
+// instead. The storage slot of the symbol of a denom is keccak256(denom), its value is the symbol as a left aligned
+// bytes32, 0 if the metadata of the denom is not registered. The decimals are stored in the next slot, the display
+// denom as a left aligned bytes32 in the slot after. The code of the contract returns the slot given as the 32 bytes
+// calldata:
+//
+//	bytes32 slot = keccak256(bytes("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"));
+//	(, bytes memory symbol) = metadata.staticcall(abi.encode(slot));
+//	(, bytes memory decimals) = metadata.staticcall(abi.encode(uint256(slot) + 1));
+//	(, bytes memory display) = metadata.staticcall(abi.encode(uint256(slot) + 2));
+var (
+	// DenomMetadataContractCode is PUSH1 0 CALLDATALOAD SLOAD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	DenomMetadataContractCode = []byte{0x60, 0x00, 0x35, 0x54, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+
+	// DenomMetadataContractAddress is the address of the denom metadata contract derived from the module name
+	DenomMetadataContractAddress common.Address
+)
+
+func init() {
+	DenomMetadataContractAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName + "-denom-metadata").Bytes())
+}
+
+// GetDenomSymbolSlot returns the storage slot of the symbol of a denom in the denom metadata contract
+func GetDenomSymbolSlot(denom string) common.Hash {
+	return ethcrypto.Keccak256Hash([]byte(denom))
+}
+
+// GetDenomDecimalsSlot returns the storage slot of the decimals of a denom in the denom metadata contract
+func GetDenomDecimalsSlot(denom string) common.Hash {
+	slot := GetDenomSymbolSlot(denom).Big()
+	return common.BigToHash(slot.Add(slot, big.NewInt(1)))
+}
+
+// GetDenomDisplaySlot returns the storage slot of the display denom of a denom in the denom metadata contract
+func GetDenomDisplaySlot(denom string) common.Hash {
+	slot := GetDenomSymbolSlot(denom).Big()
+	return common.BigToHash(slot.Add(slot, big.NewInt(2)))
+}
+
+// GetDenomStringValue returns the storage value of a symbol or a display denom of at most 32 bytes in the denom
+// metadata contract
+func GetDenomStringValue(s string) common.Hash {
+	var value common.Hash
+	copy(value[:], s)
+	return value
+}
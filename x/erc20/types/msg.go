@@ -1 +1,85 @@
 package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	host "github.com/okex/exchain/libs/ibc-go/modules/core/24-host"
+)
+
+const (
+	transferERC20MsgType = "transfer_erc20"
+)
+
+// MsgTransferERC20 sends the evm tokens of a registered contract over ibc. The
+// tokens are burned from the sender and their bank representation is sent to
+// the receiver on the counterparty chain.
+type MsgTransferERC20 struct {
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Contract string         `json:"contract" yaml:"contract"`
+	// the amount in the smallest unit of the contract
+	Amount   sdk.Int `json:"amount" yaml:"amount"`
+	Receiver string  `json:"receiver" yaml:"receiver"`
+	// the port and channel the transfer is sent on. They may be left empty for
+	// ibc vouchers, which are then sent back through the channel they came from.
+	SourcePort    string `json:"source_port" yaml:"source_port"`
+	SourceChannel string `json:"source_channel" yaml:"source_channel"`
+}
+
+var _ sdk.Msg = MsgTransferERC20{}
+
+// NewMsgTransferERC20 creates a new MsgTransferERC20 instance
+func NewMsgTransferERC20(sender sdk.AccAddress, contract string, amount sdk.Int, receiver, sourcePort, sourceChannel string) MsgTransferERC20 {
+	return MsgTransferERC20{
+		Sender:        sender,
+		Contract:      contract,
+		Amount:        amount,
+		Receiver:      receiver,
+		SourcePort:    sourcePort,
+		SourceChannel: sourceChannel,
+	}
+}
+
+func (m MsgTransferERC20) Route() string {
+	return RouterKey
+}
+
+func (m MsgTransferERC20) Type() string {
+	return transferERC20MsgType
+}
+
+func (m MsgTransferERC20) ValidateBasic() sdk.Error {
+	if m.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender cannot be empty")
+	}
+	if !common.IsHexAddress(m.Contract) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address %s", m.Contract)
+	}
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount must be positive, got %s", m.Amount)
+	}
+	if len(strings.TrimSpace(m.Receiver)) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver cannot be empty")
+	}
+	if m.SourcePort == "" && m.SourceChannel == "" {
+		return nil
+	}
+	if err := host.PortIdentifierValidator(m.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(m.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	return nil
+}
+
+func (m MsgTransferERC20) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgTransferERC20) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
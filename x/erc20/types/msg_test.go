@@ -1 +1,37 @@
 package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferERC20ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress([]byte("sender______________"))
+	contract := "0x0000000000000000000000000000000000000001"
+
+	testCases := []struct {
+		name    string
+		msg     MsgTransferERC20
+		expPass bool
+	}{
+		{"valid", NewMsgTransferERC20(sender, contract, sdk.NewInt(1), "receiver", "transfer", "channel-0"), true},
+		{"valid without channel", NewMsgTransferERC20(sender, contract, sdk.NewInt(1), "receiver", "", ""), true},
+		{"empty sender", NewMsgTransferERC20(nil, contract, sdk.NewInt(1), "receiver", "", ""), false},
+		{"invalid contract", NewMsgTransferERC20(sender, "contract", sdk.NewInt(1), "receiver", "", ""), false},
+		{"zero amount", NewMsgTransferERC20(sender, contract, sdk.ZeroInt(), "receiver", "", ""), false},
+		{"nil amount", NewMsgTransferERC20(sender, contract, sdk.Int{}, "receiver", "", ""), false},
+		{"empty receiver", NewMsgTransferERC20(sender, contract, sdk.NewInt(1), " ", "", ""), false},
+		{"port without channel", NewMsgTransferERC20(sender, contract, sdk.NewInt(1), "receiver", "transfer", ""), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
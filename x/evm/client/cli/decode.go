@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/evm/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const flagABI = "abi"
+
+// the encodings of the txs detected by decode-any
+const (
+	txEncodingRLP      = "rlp"
+	txEncodingAmino    = "amino"
+	txEncodingProtobuf = "protobuf"
+)
+
+// decodedAnyTx is the annotated tx printed by decode-any
+type decodedAnyTx struct {
+	Encoding string                 `json:"encoding"`
+	Hash     string                 `json:"hash"`
+	Tx       json.RawMessage        `json:"tx"`
+	From     string                 `json:"from,omitempty"`
+	CallData *types.DecodedCallData `json:"call_data,omitempty"`
+}
+
+// GetCmdDecodeAnyTx returns the command decoding a tx in any of the encodings of the chain
+func GetCmdDecodeAnyTx(proxy *codec.CodecProxy) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-any [base64|hex]",
+		Short: "Decode an amino, protobuf or RLP encoded tx into annotated JSON",
+		Long: `Decode a base64 or hex (with or without 0x) encoded tx into JSON annotated with the detected
+encoding, the tx hash and the sender of an evm tx. The calldata of an evm tx is decoded as well if the
+ABI of the contract called is supplied with --abi.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBytes, err := decodeTxString(args[0])
+			if err != nil {
+				return err
+			}
+
+			var contractABI *types.ABI
+			if path := viper.GetString(flagABI); path != "" {
+				bz, err := ioutil.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				if contractABI, err = types.NewABI(string(bz)); err != nil {
+					return fmt.Errorf("invalid abi %s: %s", path, err)
+				}
+			}
+
+			decoded, err := decodeAnyTx(proxy, txBytes, contractABI)
+			if err != nil {
+				return err
+			}
+			out, err := json.MarshalIndent(decoded, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s\n", out)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagABI, "", "Path to the ABI json of the contract called, to decode the calldata of an evm tx")
+	return cmd
+}
+
+// decodeTxString decodes the tx bytes from hex if the string is hex, otherwise from base64
+func decodeTxString(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if has0x := strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X"); has0x {
+		return hex.DecodeString(s[2:])
+	}
+	if bz, err := hex.DecodeString(s); err == nil {
+		return bz, nil
+	}
+	bz, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("the tx is neither hex nor base64 encoded: %s", err)
+	}
+	return bz, nil
+}
+
+func decodeAnyTx(proxy *codec.CodecProxy, txBytes []byte, contractABI *types.ABI) (*decodedAnyTx, error) {
+	tx, err := types.TxDecoder(proxy)(txBytes, types.IGNORE_HEIGHT_CHECKING)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := &decodedAnyTx{
+		Encoding: txEncodingAmino,
+		// the hash is computed as the chain does since the latest upgrade of the hash
+		Hash: hexutil.Encode(tmtypes.Tx(txBytes).Hash(tmtypes.GetMilestoneVenusHeight())),
+	}
+	switch realTx := tx.(type) {
+	case *authtypes.IbcTx:
+		decoded.Encoding = txEncodingProtobuf
+		if tx, err = authtypes.FromProtobufTx(proxy, realTx); err != nil {
+			return nil, err
+		}
+	case *types.MsgEthereumTx:
+		if authtypes.EthereumTxDecode(txBytes, &types.MsgEthereumTx{}) == nil {
+			decoded.Encoding = txEncodingRLP
+		}
+		if err := realTx.VerifySig(realTx.ChainID(), types.IGNORE_HEIGHT_CHECKING); err == nil {
+			decoded.From = realTx.GetFrom()
+		}
+		if contractABI != nil && realTx.Data.Recipient != nil {
+			if decoded.CallData, err = contractABI.DecodeCallData(realTx.Data.Payload); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if decoded.Tx, err = proxy.GetCdc().MarshalJSON(tx); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
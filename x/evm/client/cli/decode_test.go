@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	okexchaincodec "github.com/okex/exchain/app/codec"
+	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/module"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+const erc20TransferABI = `[{"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`
+
+func TestDecodeAnyTx(t *testing.T) {
+	cdc := okexchaincodec.MakeCodec(module.NewBasicManager())
+	types.RegisterCodec(cdc)
+	proxy := codec.NewCodecProxy(codec.NewProtoCodec(okexchaincodec.MakeIBC(module.NewBasicManager())), cdc)
+
+	contractABI, err := types.NewABI(erc20TransferABI)
+	require.NoError(t, err)
+	contract := ethcmn.HexToAddress("0x1000000000000000000000000000000000000001")
+	to := ethcmn.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	payload, err := contractABI.Pack("transfer", to, big.NewInt(100))
+	require.NoError(t, err)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	msg := types.NewMsgEthereumTx(1, &contract, nil, 100000, big.NewInt(1), payload)
+	require.NoError(t, msg.Sign(big.NewInt(66), priv.ToECDSA()))
+	rlpBytes, err := authtypes.EthereumTxEncode(msg)
+	require.NoError(t, err)
+	aminoBytes, err := cdc.MarshalBinaryLengthPrefixed(msg)
+	require.NoError(t, err)
+
+	// the tx string is hex with or without 0x, or base64
+	for _, s := range []string{hexutil.Encode(rlpBytes), hexutil.Encode(rlpBytes)[2:], base64.StdEncoding.EncodeToString(rlpBytes)} {
+		txBytes, err := decodeTxString(s)
+		require.NoError(t, err)
+		require.Equal(t, rlpBytes, txBytes)
+	}
+	_, err = decodeTxString("not a tx")
+	require.Error(t, err)
+
+	decoded, err := decodeAnyTx(proxy, rlpBytes, contractABI)
+	require.NoError(t, err)
+	require.Equal(t, txEncodingRLP, decoded.Encoding)
+	require.Equal(t, ethcmn.BytesToAddress(priv.PubKey().Address()).Hex(), decoded.From)
+	require.Equal(t, &types.DecodedCallData{
+		Method:    "transfer",
+		Signature: "transfer(address,uint256)",
+		Args: []types.DecodedArg{
+			{Name: "to", Type: "address", Value: to.Hex()},
+			{Name: "amount", Type: "uint256", Value: "100"},
+		},
+	}, decoded.CallData)
+	var tx types.MsgEthereumTx
+	require.NoError(t, cdc.UnmarshalJSON(decoded.Tx, &tx))
+	require.Equal(t, msg.Data, tx.Data)
+	_, err = json.Marshal(decoded)
+	require.NoError(t, err)
+
+	// the calldata isn't decoded without the abi
+	decoded, err = decodeAnyTx(proxy, aminoBytes, nil)
+	require.NoError(t, err)
+	require.Equal(t, txEncodingAmino, decoded.Encoding)
+	require.Nil(t, decoded.CallData)
+
+	// the calldata doesn't match the abi
+	msg = types.NewMsgEthereumTx(1, &contract, nil, 100000, big.NewInt(1), []byte{0x1, 0x2, 0x3, 0x4})
+	require.NoError(t, msg.Sign(big.NewInt(66), priv.ToECDSA()))
+	rlpBytes, err = authtypes.EthereumTxEncode(msg)
+	require.NoError(t, err)
+	_, err = decodeAnyTx(proxy, rlpBytes, contractABI)
+	require.Error(t, err)
+}
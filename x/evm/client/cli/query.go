@@ -38,6 +38,8 @@ func GetQueryCmd(moduleName string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryContractBlockedList(moduleName, cdc),
 		GetCmdQueryContractMethodeBlockedList(moduleName, cdc),
 		GetCmdQueryManageSysContractAddress(moduleName, cdc),
+		GetCmdQueryChainConfigUpgrades(moduleName, cdc),
+		GetCmdQueryGasSchedule(moduleName, cdc),
 	)...)
 	return evmQueryCmd
 }
@@ -153,6 +155,67 @@ $ %s query evm contract-blocked-list
 	}
 }
 
+// GetCmdQueryChainConfigUpgrades gets the scheduled chain config upgrades query command.
+func GetCmdQueryChainConfigUpgrades(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "chain-config-upgrades",
+		Short: "Query the scheduled upgrades of the evm chain config",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the forks of the evm chain config scheduled by governance, which aren't activated yet.
+
+Example:
+$ %s query evm chain-config-upgrades
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryChainConfigUpgrades)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var upgrades []types.ChainConfigUpgrade
+			cdc.MustUnmarshalJSON(bz, &upgrades)
+			return cliCtx.PrintOutput(upgrades)
+		},
+	}
+}
+
+// GetCmdQueryGasSchedule gets the gas schedule query command.
+func GetCmdQueryGasSchedule(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "gas-schedule",
+		Short: "Query the gas schedule of the evm execution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the gas schedule of the evm execution, and the gas schedules scheduled by governance which
+aren't applied yet.
+
+Example:
+$ %s query evm gas-schedule
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryGasSchedule)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var gasSchedule types.QueryResGasSchedule
+			cdc.MustUnmarshalJSON(bz, &gasSchedule)
+			return cliCtx.PrintOutput(gasSchedule)
+		},
+	}
+}
+
 // GetCmdQueryContractDeploymentWhitelist gets the contract deployment whitelist query command.
 func GetCmdQueryContractDeploymentWhitelist(storeName string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
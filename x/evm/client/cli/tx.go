@@ -2,22 +2,269 @@ package cli
 
 import (
 	"bufio"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
+	"os"
 	"strings"
 
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	ethparams "github.com/ethereum/go-ethereum/params"
+	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+	ethermint "github.com/okex/exchain/app/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/client"
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/input"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/keys"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	interfacetypes "github.com/okex/exchain/libs/cosmos-sdk/codec/types"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/version"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
 	evmutils "github.com/okex/exchain/x/evm/client/utils"
 	"github.com/okex/exchain/x/evm/types"
 	"github.com/okex/exchain/x/gov"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
+const (
+	flagTo     = "to"
+	flagAmount = "amount"
+	flagData   = "data"
+)
+
+// GetTxCmd defines the evm module txs through the cli
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	evmTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "EVM transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	evmTxCmd.AddCommand(flags.PostCommands(
+		GetCmdEthereumTx(cdc),
+	)...)
+
+	return evmTxCmd
+}
+
+// GetCmdEthereumTx implements a command handler for signing an ethereum tx with a local key. The tx is broadcasted,
+// printed signed with --offline, or printed unsigned with --generate-only.
+func GetCmdEthereumTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ethereum-tx",
+		Args:  cobra.NoArgs,
+		Short: "Sign an ethereum tx with a local key and broadcast it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign an ethereum tx with a local key and broadcast it. The tx creates a contract if --to is omitted.
+The gas price is taken from --gas-prices, and the nonce from --sequence if it's set, otherwise from the node.
+With --gas=auto, the gas is estimated by simulating the tx on the node.
+
+With --offline, the node is not queried, --sequence must be set, and the signed tx is printed instead of being
+broadcasted, so that it can be signed on an air-gapped machine and sent with eth_sendRawTransaction later.
+With --sign-mode=textual, the tx is shown as human-readable lines before it's signed.
+
+Example:
+$ %s tx evm ethereum-tx --to=0x2CF4ea7dF75b513509d95946B43062E26bD88035 --amount=1.5%s --from=<key_or_address>
+$ %s tx evm ethereum-tx --data=0x6080604052... --gas=3000000 --sequence=8 --offline --from=<key_or_address>
+`, version.ClientName, sdk.DefaultBondDenom, version.ClientName,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf)
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			chainID, err := ethermint.ParseChainID(cliCtx.ChainID)
+			if err != nil {
+				return err
+			}
+			msg, err := buildEthereumTx(txBldr, cliCtx)
+			if err != nil {
+				return err
+			}
+
+			if txBldr.SimulateAndExecute() || cliCtx.Simulate {
+				if cliCtx.GenerateOnly || cliCtx.Offline {
+					return errors.New("cannot estimate gas in offline mode")
+				}
+				if msg.Data.GasLimit, err = estimateEthereumTxGas(cliCtx, msg, txBldr.GasAdjustment()); err != nil {
+					return err
+				}
+				gasEst := utils.GasEstimateResponse{GasEstimate: msg.Data.GasLimit}
+				_, _ = fmt.Fprintf(os.Stderr, "%s\n", gasEst.String())
+				if cliCtx.Simulate {
+					return nil
+				}
+			}
+
+			if cliCtx.GenerateOnly {
+				if cliCtx.SignMode == flags.SignModeTextual {
+					_, _ = fmt.Fprintf(os.Stderr, "%s\n", ethereumTxTextual(msg, chainID, cliCtx.GetFromAddress()))
+				}
+				return cliCtx.PrintOutput(msg)
+			}
+
+			if !cliCtx.SkipConfirm {
+				if cliCtx.SignMode == flags.SignModeTextual {
+					_, _ = fmt.Fprintf(os.Stderr, "%s\n", ethereumTxTextual(msg, chainID, cliCtx.GetFromAddress()))
+				} else {
+					_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", cdc.MustMarshalJSON(msg))
+				}
+				ok, err := input.GetConfirmation("confirm transaction before signing", inBuf)
+				if err != nil || !ok {
+					_, _ = fmt.Fprintf(os.Stderr, "%s\n", "cancelled transaction")
+					return err
+				}
+			}
+
+			privKey, err := txBldr.Keybase().ExportPrivateKeyObject(cliCtx.GetFromName(), keys.DefaultKeyPass)
+			if err != nil {
+				return err
+			}
+			ethPrivKey, ok := privKey.(ethsecp256k1.PrivKey)
+			if !ok {
+				return fmt.Errorf("key %s is not an %s key", cliCtx.GetFromName(), ethsecp256k1.KeyType)
+			}
+			if err := msg.Sign(chainID, ethPrivKey.ToECDSA()); err != nil {
+				return err
+			}
+			txBytes, err := authtypes.EthereumTxEncode(msg)
+			if err != nil {
+				return err
+			}
+
+			if cliCtx.Offline {
+				out, err := json.Marshal(signedEthereumTx{Raw: txBytes, Hash: ethcrypto.Keccak256Hash(txBytes)})
+				if err != nil {
+					return err
+				}
+				_, _ = fmt.Fprintf(cliCtx.Output, "%s\n", out)
+				return nil
+			}
+
+			res, err := cliCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(res)
+		},
+	}
+
+	cmd.Flags().String(flagTo, "", "Hex address of the recipient, omitted to create a contract")
+	cmd.Flags().String(flagAmount, "", fmt.Sprintf("Amount of %s to send", sdk.DefaultBondDenom))
+	cmd.Flags().String(flagData, "", "Hex encoded input data of the tx")
+
+	return cmd
+}
+
+// signedEthereumTx is printed by an offline ethereum tx, the raw tx is the parameter of eth_sendRawTransaction
+type signedEthereumTx struct {
+	Raw  hexutil.Bytes `json:"raw"`
+	Hash ethcmn.Hash   `json:"hash"`
+}
+
+// buildEthereumTx builds the unsigned ethereum tx from the flags
+func buildEthereumTx(txBldr authtypes.TxBuilder, cliCtx context.CLIContext) (*types.MsgEthereumTx, error) {
+	amount := big.NewInt(0)
+	if amountStr := viper.GetString(flagAmount); amountStr != "" {
+		coin, err := sdk.ParseDecCoin(amountStr)
+		if err != nil {
+			return nil, err
+		}
+		if coin.Denom != sdk.DefaultBondDenom {
+			return nil, fmt.Errorf("only %s can be sent, got %s", sdk.DefaultBondDenom, coin.Denom)
+		}
+		amount = coin.Amount.BigInt()
+	}
+
+	var data []byte
+	if dataStr := viper.GetString(flagData); dataStr != "" {
+		var err error
+		if data, err = hexutil.Decode(dataStr); err != nil {
+			return nil, fmt.Errorf("invalid data: %w", err)
+		}
+	}
+
+	gasPrice := sdk.NewDecFromBigIntWithPrec(big.NewInt(ethermint.DefaultGasPrice), sdk.Precision/2+1)
+	if gasPrices := txBldr.GasPrices(); !gasPrices.IsZero() {
+		gasPrice = gasPrices.AmountOf(sdk.DefaultBondDenom)
+		if !gasPrice.IsPositive() {
+			return nil, fmt.Errorf("the gas price must be in %s", sdk.DefaultBondDenom)
+		}
+	}
+	nonce := txBldr.Sequence()
+	if !viper.IsSet(flags.FlagSequence) {
+		if cliCtx.GenerateOnly || cliCtx.Offline {
+			return nil, fmt.Errorf("--%s must be set in offline mode", flags.FlagSequence)
+		}
+		_, seq, err := authtypes.NewAccountRetriever(cliCtx).GetAccountNumberSequence(cliCtx.GetFromAddress())
+		if err != nil {
+			return nil, err
+		}
+		nonce = seq
+	}
+
+	if toStr := viper.GetString(flagTo); toStr != "" {
+		if !ethcmn.IsHexAddress(toStr) {
+			return nil, fmt.Errorf("invalid recipient %s", toStr)
+		}
+		to := ethcmn.HexToAddress(toStr)
+		return types.NewMsgEthereumTx(nonce, &to, amount, txBldr.Gas(), gasPrice.BigInt(), data), nil
+	}
+	return types.NewMsgEthereumTxContract(nonce, amount, txBldr.Gas(), gasPrice.BigInt(), data), nil
+}
+
+// estimateEthereumTxGas simulates the unsigned ethereum tx sent from the key and returns the adjusted gas it uses
+func estimateEthereumTxGas(cliCtx context.CLIContext, msg *types.MsgEthereumTx, adjustment float64) (uint64, error) {
+	simMsg := *msg
+	simMsg.Data.GasLimit = ethermint.DefaultRPCGasLimit
+	txBytes, err := authtypes.EthereumTxEncode(&simMsg)
+	if err != nil {
+		return 0, err
+	}
+
+	// the simulation takes the sender from the path, as the tx isn't signed
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("app/simulate/%s", cliCtx.GetFromAddress()), txBytes)
+	if err != nil {
+		return 0, err
+	}
+	var simRes sdk.SimulationResponse
+	if err := cliCtx.Codec.UnmarshalBinaryBare(res, &simRes); err != nil {
+		return 0, err
+	}
+	return uint64(adjustment * float64(simRes.GasUsed)), nil
+}
+
+// ethereumTxTextual renders the ethereum tx to be signed as human-readable lines
+func ethereumTxTextual(msg *types.MsgEthereumTx, chainID *big.Int, from sdk.AccAddress) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Chain id: %s\n", chainID)
+	fmt.Fprintf(&b, "From: %s\n", ethcmn.BytesToAddress(from.Bytes()).Hex())
+	if msg.Data.Recipient != nil {
+		fmt.Fprintf(&b, "To: %s\n", msg.Data.Recipient.Hex())
+	} else {
+		fmt.Fprintf(&b, "To: (contract creation)\n")
+	}
+	fmt.Fprintf(&b, "Nonce: %d\n", msg.Data.AccountNonce)
+	fmt.Fprintf(&b, "Amount: %s%s\n", sdk.NewDecFromBigIntWithPrec(msg.Data.Amount, sdk.Precision), sdk.DefaultBondDenom)
+	fmt.Fprintf(&b, "Gas limit: %d\n", msg.Data.GasLimit)
+	fmt.Fprintf(&b, "Gas price: %s%s\n", sdk.NewDecFromBigIntWithPrec(msg.Data.Price, sdk.Precision), sdk.DefaultBondDenom)
+	if len(msg.Data.Payload) > 0 {
+		fmt.Fprintf(&b, "Data: %s\n", hexutil.Encode(msg.Data.Payload))
+	}
+	b.WriteString("End of transaction")
+	return b.String()
+}
+
 // GetCmdManageContractDeploymentWhitelistProposal implements a command handler for submitting a manage contract deployment
 // whitelist proposal transaction
 func GetCmdManageContractDeploymentWhitelistProposal(cdcP *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
@@ -286,3 +533,184 @@ Where proposal.json contains:
 		},
 	}
 }
+
+// GetCmdScheduleChainConfigUpgradeProposal implements a command handler for submitting a schedule chain config upgrade
+// proposal transaction
+func GetCmdScheduleChainConfigUpgradeProposal(cdcP *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	return &cobra.Command{
+		Use:   "chain-config-upgrade [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to schedule the activation of evm forks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to activate the forks of the evm chain config at a future height.
+The proposal details must be supplied via a JSON file. The forks are one or more of homestead, eip150, eip155,
+eip158, byzantium, constantinople, petersburg, istanbul, muir_glacier, yolo_v2 and ewasm.
+
+Example:
+$ %s tx gov submit-proposal chain-config-upgrade <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title":"Activate istanbul",
+  "description":"Will activate the istanbul fork of the evm at height 1000000",
+  "forks": ["istanbul"],
+  "height": "1000000",
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "100.000000000000000000"
+    }
+  ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cdc := cdcP.GetCdc()
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := evmutils.ParseScheduleChainConfigUpgradeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewScheduleChainConfigUpgradeProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.Forks,
+				proposal.Height,
+			)
+
+			err = content.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdUpdateGasScheduleProposal implements a command handler for submitting an update gas schedule proposal
+// transaction
+func GetCmdUpdateGasScheduleProposal(cdcP *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-gas-schedule [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the gas schedule of the evm execution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to apply a gas schedule to the evm execution from a future height.
+The proposal details must be supplied via a JSON file. The gas used by the evm execution is multiplied by the
+execution_gas_multiplier, at most %d. The refund of the cleared storage slots is capped to the gas used divided by the
+refund_quotient, at least %d, and disabled if it's 0.
+
+Example:
+$ %s tx gov submit-proposal update-gas-schedule <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title":"Update the gas schedule",
+  "description":"Will double the gas of the evm execution and enable the refunds from height 1000000",
+  "schedule": {
+    "execution_gas_multiplier": "2.000000000000000000",
+    "refund_quotient": "5"
+  },
+  "height": "1000000",
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "100.000000000000000000"
+    }
+  ]
+}
+`, types.MaxExecutionGasMultiplier, types.MinRefundQuotient, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cdc := cdcP.GetCdc()
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := evmutils.ParseUpdateGasScheduleProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateGasScheduleProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.Schedule,
+				proposal.Height,
+			)
+
+			err = content.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdUpdateMaxGasLimitPerTxProposal implements a command handler for submitting an update max gas limit per tx
+// proposal transaction
+func GetCmdUpdateMaxGasLimitPerTxProposal(cdcP *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-max-gas-limit-per-tx [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the max gas limit of an evm tx",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to update the max gas limit of an evm tx, at least %d.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal update-max-gas-limit-per-tx <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title":"Update the max gas limit per tx",
+  "description":"Will raise the max gas limit of an evm tx to 50000000",
+  "max_gas_limit_per_tx": "50000000",
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "100.000000000000000000"
+    }
+  ]
+}
+`, ethparams.TxGas, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cdc := cdcP.GetCdc()
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := evmutils.ParseUpdateMaxGasLimitPerTxProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateMaxGasLimitPerTxProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.MaxGasLimitPerTx,
+			)
+
+			err = content.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
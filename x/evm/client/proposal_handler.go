@@ -26,4 +26,16 @@ var (
 		cli.GetCmdManageSysContractAddressProposal,
 		rest.ManageSysContractAddressProposalRESTHandler,
 	)
+	ScheduleChainConfigUpgradeProposalHandler = govcli.NewProposalHandler(
+		cli.GetCmdScheduleChainConfigUpgradeProposal,
+		rest.ScheduleChainConfigUpgradeProposalRESTHandler,
+	)
+	UpdateGasScheduleProposalHandler = govcli.NewProposalHandler(
+		cli.GetCmdUpdateGasScheduleProposal,
+		rest.UpdateGasScheduleProposalRESTHandler,
+	)
+	UpdateMaxGasLimitPerTxProposalHandler = govcli.NewProposalHandler(
+		cli.GetCmdUpdateMaxGasLimitPerTxProposal,
+		rest.UpdateMaxGasLimitPerTxProposalRESTHandler,
+	)
 )
@@ -62,3 +62,151 @@ func postManageSysContractAddressProposalHandlerFn(cliCtx context.CLIContext) ht
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+type ScheduleChainConfigUpgradeProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Forks  []string `json:"forks" yaml:"forks"`
+	Height int64    `json:"height" yaml:"height"`
+
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.SysCoins   `json:"deposit" yaml:"deposit"`
+}
+
+// ScheduleChainConfigUpgradeProposalRESTHandler defines evm proposal handler
+func ScheduleChainConfigUpgradeProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "schedule_chain_config_upgrade",
+		Handler:  postScheduleChainConfigUpgradeProposalHandlerFn(cliCtx),
+	}
+}
+
+func postScheduleChainConfigUpgradeProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ScheduleChainConfigUpgradeProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewScheduleChainConfigUpgradeProposal(
+			req.Title,
+			req.Description,
+			req.Forks,
+			req.Height,
+		)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			comm.HandleErrorMsg(w, cliCtx, comm.CodeInvalidParam, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type UpdateGasScheduleProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	Schedule types.GasSchedule `json:"schedule" yaml:"schedule"`
+	Height   int64             `json:"height" yaml:"height"`
+
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.SysCoins   `json:"deposit" yaml:"deposit"`
+}
+
+// UpdateGasScheduleProposalRESTHandler defines evm proposal handler
+func UpdateGasScheduleProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_gas_schedule",
+		Handler:  postUpdateGasScheduleProposalHandlerFn(cliCtx),
+	}
+}
+
+func postUpdateGasScheduleProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateGasScheduleProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewUpdateGasScheduleProposal(
+			req.Title,
+			req.Description,
+			req.Schedule,
+			req.Height,
+		)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			comm.HandleErrorMsg(w, cliCtx, comm.CodeInvalidParam, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type UpdateMaxGasLimitPerTxProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+
+	MaxGasLimitPerTx uint64 `json:"max_gas_limit_per_tx" yaml:"max_gas_limit_per_tx"`
+
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit  sdk.SysCoins   `json:"deposit" yaml:"deposit"`
+}
+
+// UpdateMaxGasLimitPerTxProposalRESTHandler defines evm proposal handler
+func UpdateMaxGasLimitPerTxProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_max_gas_limit_per_tx",
+		Handler:  postUpdateMaxGasLimitPerTxProposalHandlerFn(cliCtx),
+	}
+}
+
+func postUpdateMaxGasLimitPerTxProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateMaxGasLimitPerTxProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewUpdateMaxGasLimitPerTxProposal(
+			req.Title,
+			req.Description,
+			req.MaxGasLimitPerTx,
+		)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			comm.HandleErrorMsg(w, cliCtx, comm.CodeInvalidParam, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
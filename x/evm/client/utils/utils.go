@@ -48,6 +48,35 @@ type (
 		Deposit      sdk.SysCoins   `json:"deposit" yaml:"deposit"`
 	}
 
+	// ScheduleChainConfigUpgradeProposalJSON defines a ScheduleChainConfigUpgradeProposal with a deposit used to parse
+	// schedule chain config upgrade proposals from a JSON file.
+	ScheduleChainConfigUpgradeProposalJSON struct {
+		Title       string       `json:"title" yaml:"title"`
+		Description string       `json:"description" yaml:"description"`
+		Forks       []string     `json:"forks" yaml:"forks"`
+		Height      int64        `json:"height" yaml:"height"`
+		Deposit     sdk.SysCoins `json:"deposit" yaml:"deposit"`
+	}
+
+	// UpdateGasScheduleProposalJSON defines an UpdateGasScheduleProposal with a deposit used to parse update gas
+	// schedule proposals from a JSON file.
+	UpdateGasScheduleProposalJSON struct {
+		Title       string            `json:"title" yaml:"title"`
+		Description string            `json:"description" yaml:"description"`
+		Schedule    types.GasSchedule `json:"schedule" yaml:"schedule"`
+		Height      int64             `json:"height" yaml:"height"`
+		Deposit     sdk.SysCoins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// UpdateMaxGasLimitPerTxProposalJSON defines an UpdateMaxGasLimitPerTxProposal with a deposit used to parse update
+	// max gas limit per tx proposals from a JSON file.
+	UpdateMaxGasLimitPerTxProposalJSON struct {
+		Title            string       `json:"title" yaml:"title"`
+		Description      string       `json:"description" yaml:"description"`
+		MaxGasLimitPerTx uint64       `json:"max_gas_limit_per_tx" yaml:"max_gas_limit_per_tx"`
+		Deposit          sdk.SysCoins `json:"deposit" yaml:"deposit"`
+	}
+
 	ResponseBlockContract struct {
 		Address      string                `json:"address" yaml:"address"`
 		BlockMethods types.ContractMethods `json:"block_methods" yaml:"block_methods"`
@@ -106,3 +135,39 @@ func ParseManageSysContractAddressProposalJSON(cdc *codec.Codec, proposalFilePat
 	cdc.MustUnmarshalJSON(contents, &proposal)
 	return
 }
+
+// ParseScheduleChainConfigUpgradeProposalJSON parses json from proposal file to ScheduleChainConfigUpgradeProposal struct
+func ParseScheduleChainConfigUpgradeProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal ScheduleChainConfigUpgradeProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
+
+// ParseUpdateGasScheduleProposalJSON parses json from proposal file to UpdateGasScheduleProposal struct
+func ParseUpdateGasScheduleProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal UpdateGasScheduleProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
+
+// ParseUpdateMaxGasLimitPerTxProposalJSON parses json from proposal file to UpdateMaxGasLimitPerTxProposal struct
+func ParseUpdateMaxGasLimitPerTxProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal UpdateMaxGasLimitPerTxProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
@@ -30,6 +30,8 @@ func NewHandler(k *Keeper) sdk.Handler {
 			if err != nil {
 				err = sdkerrors.New(types.ModuleName, types.CodeSpaceEvmCallFailed, err.Error())
 			}
+		} else if userOpsMsg, ok := msg.(types.MsgHandleUserOps); ok {
+			result, err = k.HandleUserOps(ctx, userOpsMsg)
 		} else {
 			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
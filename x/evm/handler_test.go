@@ -11,15 +11,19 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethcmn "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/okex/exchain/app"
 	"github.com/okex/exchain/app/crypto/ethsecp256k1"
 	ethermint "github.com/okex/exchain/app/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 	auth "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
 	"github.com/okex/exchain/x/evm"
 	"github.com/okex/exchain/x/evm/keeper"
 	"github.com/okex/exchain/x/evm/types"
@@ -362,6 +366,68 @@ func (suite *EvmTestSuite) TestSendTransaction() {
 	suite.Require().EqualValues(expectedGas, suite.ctx.GasMeter().GasConsumed())
 }
 
+func (suite *EvmTestSuite) TestSendTransactionWithSendRestriction() {
+	gasLimit := uint64(100000)
+	gasPrice := big.NewInt(10000)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err, "failed to create key")
+	sender := ethcrypto.PubkeyToAddress(priv.ToECDSA().PublicKey)
+	suite.app.EvmKeeper.SetBalance(suite.ctx, sender, big.NewInt(100))
+
+	blocked := ethcmn.Address{0x1}
+	suite.app.BankKeeper.AppendSendRestriction(func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(sdk.AccAddress(blocked.Bytes())) {
+			return nil, sdkerrors.ErrUnauthorized
+		}
+		return toAddr, nil
+	})
+	defer suite.app.BankKeeper.ClearSendRestriction()
+
+	// the value transfer to the blocked address is vetoed
+	tx := types.NewMsgEthereumTx(1, &blocked, big.NewInt(1), gasLimit, gasPrice, nil)
+	suite.Require().NoError(tx.Sign(big.NewInt(3), priv.ToECDSA()))
+	suite.ctx.SetGasMeter(sdk.NewInfiniteGasMeter())
+	_, err = suite.handler(suite.ctx, tx)
+	suite.Require().Error(err)
+	suite.Require().Equal(big.NewInt(100), suite.app.EvmKeeper.GetBalance(suite.ctx, sender))
+
+	// the call to the blocked address without value isn't restricted
+	tx = types.NewMsgEthereumTx(1, &blocked, big.NewInt(0), gasLimit, gasPrice, nil)
+	suite.Require().NoError(tx.Sign(big.NewInt(3), priv.ToECDSA()))
+	_, err = suite.handler(suite.ctx, tx)
+	suite.Require().NoError(err)
+
+	// the contract passes the value it's called with to the blocked address
+	relay := ethcmn.HexToAddress("0x3000000000000000000000000000000000000004")
+	suite.stateDB.SetCode(relay, common.FromHex("0x6000600060006000347301000000000000000000000000000000000000005af100"))
+	_, err = suite.stateDB.Commit(false)
+	suite.Require().NoError(err)
+
+	// the value transfer of the internal call is vetoed as well
+	tx = types.NewMsgEthereumTx(2, &relay, big.NewInt(1), gasLimit, gasPrice, nil)
+	suite.Require().NoError(tx.Sign(big.NewInt(3), priv.ToECDSA()))
+	_, err = suite.handler(suite.ctx, tx)
+	suite.Require().Error(err)
+	suite.Require().Equal(big.NewInt(100), suite.app.EvmKeeper.GetBalance(suite.ctx, sender))
+	suite.Require().Zero(suite.app.EvmKeeper.GetBalance(suite.ctx, relay).Sign())
+
+	suite.app.BankKeeper.ClearSendRestriction()
+	suite.app.BankKeeper.AppendSendRestriction(func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if fromAddr.Equals(sdk.AccAddress(sender.Bytes())) {
+			return nil, sdkerrors.ErrUnauthorized
+		}
+		return toAddr, nil
+	})
+
+	// the value of a contract creation is vetoed too
+	tx = types.NewMsgEthereumTx(2, nil, big.NewInt(1), gasLimit, gasPrice, common.FromHex("0x00"))
+	suite.Require().NoError(tx.Sign(big.NewInt(3), priv.ToECDSA()))
+	_, err = suite.handler(suite.ctx, tx)
+	suite.Require().Error(err)
+	suite.Require().Equal(big.NewInt(100), suite.app.EvmKeeper.GetBalance(suite.ctx, sender))
+}
+
 func (suite *EvmTestSuite) TestOutOfGasWhenDeployContract() {
 	// Test contract:
 	//http://remix.ethereum.org/#optimize=false&evmVersion=istanbul&version=soljson-v0.5.15+commit.6a57276f.js
@@ -590,6 +656,221 @@ func (suite *EvmTestSuite) TestErrorWhenDeployContract() {
 	suite.Require().NotNil(sdkErr)
 }
 
+func (suite *EvmTestSuite) TestCodeSizeLimitsWhenDeployContract() {
+	gasLimit := uint64(100000)
+	gasPrice := big.NewInt(1000000)
+
+	// the contract of TestHandlerLogs, whose code is 53 bytes
+	bytecode := common.FromHex("0x6080604052348015600f57600080fd5b5060117f775a94827b8fd9b519d36cd827093c664f93347070a554f65e4a6f56cd73889860405160405180910390a2603580604b6000396000f3fe6080604052600080fdfea165627a7a723058206cab665f0f557620554bb45adf266708d2bd349b8a4314bdff205ee8440e3c240029")
+	deploy := func() error {
+		priv, err := ethsecp256k1.GenerateKey()
+		suite.Require().NoError(err, "failed to create key")
+		tx := types.NewMsgEthereumTx(1, nil, big.NewInt(0), gasLimit, gasPrice, bytecode)
+		suite.Require().NoError(tx.Sign(big.NewInt(3), priv.ToECDSA()))
+		_, err = suite.handler(suite.ctx, tx)
+		return err
+	}
+
+	suite.app.EvmKeeper.SetMaxInitCodeSize(suite.ctx, uint64(len(bytecode)-1))
+	err := deploy()
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), types.ErrMaxInitCodeSizeExceeded.Error())
+
+	suite.app.EvmKeeper.SetMaxInitCodeSize(suite.ctx, uint64(len(bytecode)))
+	suite.app.EvmKeeper.SetMaxCodeSize(suite.ctx, 52)
+	err = deploy()
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), vm.ErrMaxCodeSizeExceeded.Error())
+
+	suite.app.EvmKeeper.SetMaxCodeSize(suite.ctx, 53)
+	suite.Require().NoError(deploy())
+}
+
+func (suite *EvmTestSuite) TestGasScheduleWhenDeployContract() {
+	gasLimit := uint64(100000)
+	gasPrice := big.NewInt(1000000)
+
+	// the contract of TestHandlerLogs
+	bytecode := common.FromHex("0x6080604052348015600f57600080fd5b5060117f775a94827b8fd9b519d36cd827093c664f93347070a554f65e4a6f56cd73889860405160405180910390a2603580604b6000396000f3fe6080604052600080fdfea165627a7a723058206cab665f0f557620554bb45adf266708d2bd349b8a4314bdff205ee8440e3c240029")
+	intrinsicGas, err := core.IntrinsicGas(bytecode, nil, true, true, true)
+	suite.Require().NoError(err)
+	deploy := func() (uint64, error) {
+		priv, err := ethsecp256k1.GenerateKey()
+		suite.Require().NoError(err, "failed to create key")
+		tx := types.NewMsgEthereumTx(1, nil, big.NewInt(0), gasLimit, gasPrice, bytecode)
+		suite.Require().NoError(tx.Sign(big.NewInt(3), priv.ToECDSA()))
+		suite.ctx.SetGasMeter(sdk.NewGasMeter(gasLimit))
+		_, err = suite.handler(suite.ctx, tx)
+		return suite.ctx.GasMeter().GasConsumed() - intrinsicGas, err
+	}
+
+	executionGas, err := deploy()
+	suite.Require().NoError(err)
+
+	// the gas of the evm execution is doubled
+	suite.app.EvmKeeper.SetGasSchedule(suite.ctx, types.GasSchedule{ExecutionGasMultiplier: sdk.NewDec(2)})
+	gas, err := deploy()
+	suite.Require().NoError(err)
+	suite.Require().Equal(2*executionGas, gas)
+
+	// the tx runs out of gas once the multiplied gas exceeds its limit
+	suite.app.EvmKeeper.SetGasSchedule(suite.ctx, types.GasSchedule{ExecutionGasMultiplier: sdk.NewDec(10)})
+	gas, err = deploy()
+	suite.Require().Error(err)
+	suite.Require().Equal(gasLimit-intrinsicGas, gas)
+}
+
+func (suite *EvmTestSuite) TestRelayMetaTransaction() {
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+	suite.ctx.SetBlockHeight(2)
+
+	gasLimit := uint64(200000)
+	gasPrice := big.NewInt(1000000)
+	chainID := big.NewInt(3)
+
+	// the target stores the last 20 bytes of the call data, which is the signer appended by the forwarder
+	target := ethcmn.HexToAddress("0x3000000000000000000000000000000000000003")
+	suite.stateDB.SetCode(target, common.FromHex("0x36601490033560601c60005500"))
+	_, err := suite.stateDB.Commit(false)
+	suite.Require().NoError(err)
+
+	user, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	userAddr := ethcrypto.PubkeyToAddress(user.ToECDSA().PublicKey)
+	other, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	sponsor, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+
+	relay := func(mtx types.MetaTransaction, signer *ethsecp256k1.PrivKey) error {
+		sig, err := mtx.Sign(chainID, signer.ToECDSA())
+		suite.Require().NoError(err)
+		input, err := types.GetForwarderInput(mtx, sig)
+		suite.Require().NoError(err)
+		tx := types.NewMsgEthereumTx(1, &types.ForwarderAddress, big.NewInt(0), gasLimit, gasPrice, input)
+		suite.Require().NoError(tx.Sign(chainID, sponsor.ToECDSA()))
+		_, err = suite.handler(suite.ctx, tx)
+		return err
+	}
+	mtx := types.MetaTransaction{From: userAddr, To: target, Gas: 50000, Data: []byte{0x12, 0x34}}
+
+	err = relay(mtx, &other)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), types.ErrInvalidMetaTx.Error())
+
+	expired := mtx
+	expired.Deadline = uint64(suite.ctx.BlockTime().Unix() - 1)
+	err = relay(expired, &user)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "expired")
+
+	suite.Require().NoError(relay(mtx, &user))
+	csdb := types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	suite.Require().Equal(ethcmn.BytesToHash(userAddr.Bytes()), csdb.GetState(target, ethcmn.Hash{}))
+	suite.Require().Equal(uint64(1), types.GetForwarderNonce(csdb, userAddr))
+
+	// the relayed meta transaction can't be replayed
+	err = relay(mtx, &user)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "invalid nonce")
+}
+
+// hibernatedContracts is a ContractHibernation of the given contracts
+type hibernatedContracts map[ethcmn.Address]bool
+
+func (h hibernatedContracts) IsContractHibernated(_ sdk.Context, contract ethcmn.Address) bool {
+	return h[contract]
+}
+
+func (suite *EvmTestSuite) TestContractHibernation() {
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+	defer suite.app.EvmKeeper.SetContractHibernation(suite.app.RentKeeper)
+	suite.ctx.SetBlockHeight(2)
+
+	gasLimit := uint64(100000)
+	gasPrice := big.NewInt(1000000)
+	chainID := big.NewInt(3)
+
+	// the target stores the last 20 bytes of the call data
+	target := ethcmn.HexToAddress("0x3000000000000000000000000000000000000003")
+	suite.stateDB.SetCode(target, common.FromHex("0x36601490033560601c60005500"))
+	suite.stateDB.SetState(target, ethcmn.Hash{}, ethcmn.BytesToHash(target.Bytes()))
+	changes := suite.stateDB.StorageChanges()
+	suite.Require().Equal(&types.StorageChange{SlotDelta: 1, CodeDeployed: true}, changes[target])
+	_, err := suite.stateDB.Commit(false)
+	suite.Require().NoError(err)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	call := func(nonce uint64, data []byte) error {
+		tx := types.NewMsgEthereumTx(nonce, &target, big.NewInt(0), gasLimit, gasPrice, data)
+		suite.Require().NoError(tx.Sign(chainID, priv.ToECDSA()))
+		_, err := suite.handler(suite.ctx, tx)
+		return err
+	}
+
+	// clearing the slot frees it
+	suite.Require().NoError(call(0, make([]byte, 20)))
+	csdb := types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	suite.Require().Equal(ethcmn.Hash{}, csdb.GetState(target, ethcmn.Hash{}))
+
+	// the hibernated contract can't be called
+	suite.app.EvmKeeper.SetContractHibernation(hibernatedContracts{target: true})
+	err = call(1, target.Bytes())
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), types.ErrContractHibernated.Error())
+
+	suite.app.EvmKeeper.SetContractHibernation(hibernatedContracts{})
+	suite.Require().NoError(call(1, target.Bytes()))
+}
+
+func (suite *EvmTestSuite) TestContractDeploymentWhitelistWithFactory() {
+	gasLimit := uint64(100000)
+	gasPrice := big.NewInt(1000000)
+	chainID := big.NewInt(3)
+
+	// the factory creates an empty contract with CREATE whenever it's called
+	factory := ethcmn.HexToAddress("0x4000000000000000000000000000000000000004")
+	suite.stateDB.SetCode(factory, common.FromHex("0x600060006000f05000"))
+	_, err := suite.stateDB.Commit(false)
+	suite.Require().NoError(err)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.EnableContractDeploymentWhitelist = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	callFactory := func(nonce uint64) error {
+		tx := types.NewMsgEthereumTx(nonce, &factory, big.NewInt(0), gasLimit, gasPrice, nil)
+		suite.Require().NoError(tx.Sign(chainID, priv.ToECDSA()))
+		_, err := suite.handler(suite.ctx, tx)
+		return err
+	}
+
+	// before venus5, the sender out of the whitelist deploys contracts through the factory
+	suite.Require().NoError(callFactory(0))
+	csdb := types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	suite.Require().Equal(uint64(1), csdb.GetNonce(factory))
+
+	// since venus5, the sender out of the whitelist can't deploy contracts through the factory
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+	suite.ctx.SetBlockHeight(2)
+	err = callFactory(1)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "is not allowed to deploy a contract")
+	csdb = types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	suite.Require().Equal(uint64(1), csdb.GetNonce(factory))
+
+	suite.stateDB.SetContractDeploymentWhitelist(types.AddressList{priv.PubKey().Address().Bytes()})
+	suite.Require().NoError(callFactory(2))
+	csdb = types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	suite.Require().Equal(uint64(2), csdb.GetNonce(factory))
+}
+
 func (suite *EvmTestSuite) TestDefaultMsgHandler() {
 	tx := sdk.NewTestMsg()
 	_, sdkErr := suite.handler(suite.ctx, tx)
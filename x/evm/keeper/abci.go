@@ -23,6 +23,9 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	if req.Header.GetHeight() == tmtypes.GetMarsHeight() {
 		migrateDataInMarsHeight(ctx, k)
 	}
+	if !ctx.IsTraceTx() {
+		types.BeginTraceSinkBlock(req.Header.GetHeight())
+	}
 
 	if req.Header.LastBlockId.GetHash() == nil || req.Header.GetHeight() < 1 {
 		return
@@ -31,6 +34,11 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	// Gas costs are handled within msg handler so costs should be ignored
 	ctx.SetGasMeter(sdk.NewInfiniteGasMeter())
 
+	// activate the forks of the chain config scheduled at this height, before any tx of the block is executed
+	k.applyChainConfigUpgrades(ctx)
+	// apply the gas schedule scheduled at this height
+	k.applyGasScheduleUpgrade(ctx)
+
 	// Set the hash -> height and height -> hash mapping.
 	currentHash := req.Hash
 	lastHash := req.Header.LastBlockId.GetHash()
@@ -38,12 +46,17 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 	blockHash := common.BytesToHash(currentHash)
 	k.SetHeightHash(ctx, uint64(height), common.BytesToHash(lastHash))
+	if tmtypes.HigherThanVenus5(req.Header.GetHeight()) {
+		// keep the hashes of the recent blocks in the state for the BLOCKHASH opcode
+		k.SetRecentBlockHash(ctx, uint64(height), common.BytesToHash(lastHash))
+	}
 	k.SetBlockHeight(ctx, lastHash, height)
 	// Add latest block height and hash to cache
 	k.AddHeightHashToCache(req.Header.GetHeight(), blockHash.Hex())
 
 	// reset counters that are used on CommitStateDB.Prepare
 	if !ctx.IsTraceTx() {
+		k.beginChainConfigCache(req.Header.GetHeight())
 		k.Bloom = big.NewInt(0)
 		k.TxCount = 0
 		k.LogSize = 0
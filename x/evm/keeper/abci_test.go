@@ -9,7 +9,9 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
 	"github.com/okex/exchain/x/evm/types"
 	"github.com/okex/exchain/x/evm/watcher"
 	"github.com/spf13/viper"
@@ -129,3 +131,104 @@ func (suite *KeeperTestSuite) TestResetCache() {
 	suite.Require().Zero(len(suite.stateDB.WithContext(suite.ctx).Preimages()))
 	suite.Require().Zero(suite.stateDB.GetRefund())
 }
+
+func (suite *KeeperTestSuite) TestBeginBlockChainConfigUpgrade() {
+	// the chain config is kept in the same store before and after the upgrade, whatever the mars height is
+	suite.ctx.SetBlockHeight(2)
+	config := types.DefaultChainConfig()
+	config.IstanbulBlock = sdk.NewInt(-1)
+	config.MuirGlacierBlock = sdk.NewInt(-1)
+	suite.app.EvmKeeper.SetChainConfig(suite.ctx, config)
+	suite.Require().NoError(suite.app.EvmKeeper.ScheduleChainConfigUpgrade(suite.ctx, []string{types.ForkIstanbul, types.ForkMuirGlacier}, 10))
+	suite.Require().Equal([]types.ChainConfigUpgrade{
+		{Fork: types.ForkIstanbul, Height: 10},
+		{Fork: types.ForkMuirGlacier, Height: 10},
+	}, suite.app.EvmKeeper.GetChainConfigUpgrades(suite.ctx))
+
+	beginBlock := func(height int64) {
+		suite.ctx.SetBlockHeight(height)
+		suite.app.EvmKeeper.BeginBlock(suite.ctx, abci.RequestBeginBlock{
+			Header: abci.Header{
+				LastBlockId: abci.BlockID{
+					Hash: ethcmn.FromHex(hex),
+				},
+				Height: height,
+			},
+		})
+	}
+
+	// the forks stay inactive before the height
+	beginBlock(9)
+	config, found := suite.app.EvmKeeper.GetChainConfig(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().False(config.IsIstanbul())
+	suite.Require().Len(suite.app.EvmKeeper.GetChainConfigUpgrades(suite.ctx), 2)
+
+	beginBlock(10)
+	config, found = suite.app.EvmKeeper.GetChainConfig(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().True(config.IsIstanbul())
+	suite.Require().Equal(sdk.NewInt(10), config.IstanbulBlock)
+	suite.Require().Equal(sdk.NewInt(10), config.MuirGlacierBlock)
+	suite.Require().Empty(suite.app.EvmKeeper.GetChainConfigUpgrades(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockRecentBlockHashes() {
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+
+	blockHash := func(height int64) ethcmn.Hash {
+		return ethcmn.BigToHash(big.NewInt(height))
+	}
+	beginBlock := func(height int64) {
+		suite.ctx.SetBlockHeight(height)
+		suite.app.EvmKeeper.BeginBlock(suite.ctx, abci.RequestBeginBlock{
+			Header: abci.Header{LastBlockId: abci.BlockID{Hash: blockHash(height - 1).Bytes()}, Height: height},
+		})
+	}
+	for height := int64(2); height <= 300; height++ {
+		beginBlock(height)
+	}
+
+	csdb := types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	getHash := types.GetHashFn(suite.ctx, csdb)
+	for height := int64(300 - types.RecentBlockHashesSize); height < 300; height++ {
+		hash, found := csdb.GetRecentBlockHash(uint64(height))
+		suite.Require().True(found)
+		suite.Require().Equal(blockHash(height), hash)
+		suite.Require().Equal(blockHash(height), getHash(uint64(height)))
+	}
+	// the slots of the older blocks are taken by the recent blocks
+	_, found := csdb.GetRecentBlockHash(300 - types.RecentBlockHashesSize - 1)
+	suite.Require().False(found)
+	_, found = csdb.GetRecentBlockHash(300)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockGasScheduleUpgrade() {
+	schedule := types.GasSchedule{ExecutionGasMultiplier: sdk.NewDecWithPrec(15, 1), RefundQuotient: 5}
+	suite.Require().NoError(suite.app.EvmKeeper.ScheduleGasScheduleUpgrade(suite.ctx, schedule, 10))
+	suite.Require().Equal([]types.GasScheduleUpgrade{{Schedule: schedule, Height: 10}},
+		suite.app.EvmKeeper.GetGasScheduleUpgrades(suite.ctx))
+
+	beginBlock := func(height int64) {
+		suite.ctx.SetBlockHeight(height)
+		suite.app.EvmKeeper.BeginBlock(suite.ctx, abci.RequestBeginBlock{
+			Header: abci.Header{
+				LastBlockId: abci.BlockID{
+					Hash: ethcmn.FromHex(hex),
+				},
+				Height: height,
+			},
+		})
+	}
+
+	// the default gas schedule applies before the height
+	beginBlock(9)
+	suite.Require().Equal(types.DefaultGasSchedule(), suite.app.EvmKeeper.GetGasSchedule(suite.ctx))
+	suite.Require().Len(suite.app.EvmKeeper.GetGasScheduleUpgrades(suite.ctx), 1)
+
+	beginBlock(10)
+	suite.Require().Equal(schedule, suite.app.EvmKeeper.GetGasSchedule(suite.ctx))
+	suite.Require().Empty(suite.app.EvmKeeper.GetGasScheduleUpgrades(suite.ctx))
+}
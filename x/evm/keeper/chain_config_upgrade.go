@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+// GetChainConfigUpgrades returns the chain config upgrades scheduled by governance, ordered by height
+func (k *Keeper) GetChainConfigUpgrades(ctx sdk.Context) []types.ChainConfigUpgrade {
+	store := k.paramSpace.CustomKVStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixChainConfigUpgrade)
+	defer iterator.Close()
+
+	var upgrades []types.ChainConfigUpgrade
+	for ; iterator.Valid(); iterator.Next() {
+		height, fork := types.SplitChainConfigUpgradeKey(iterator.Key())
+		upgrades = append(upgrades, types.ChainConfigUpgrade{Fork: fork, Height: height})
+	}
+	return upgrades
+}
+
+// CheckChainConfigUpgrade checks the forks can be scheduled to activate at the height. The forks must be inactive and
+// not scheduled yet, and the chain config with all the scheduled upgrades applied must keep the forks in order.
+func (k *Keeper) CheckChainConfigUpgrade(ctx sdk.Context, forks []string, height int64) error {
+	if height <= ctx.BlockHeight() {
+		return sdkerrors.Wrapf(types.ErrInvalidChainConfigUpgrade, "height %d is not after the current height %d", height, ctx.BlockHeight())
+	}
+	config, found := k.GetChainConfig(ctx)
+	if !found {
+		return types.ErrChainConfigNotFound
+	}
+
+	scheduled := make(map[string]int64)
+	for _, upgrade := range k.GetChainConfigUpgrades(ctx) {
+		scheduled[upgrade.Fork] = upgrade.Height
+		if err := config.SetForkBlock(upgrade.Fork, upgrade.Height); err != nil {
+			return err
+		}
+	}
+	for _, fork := range forks {
+		block, err := config.ForkBlock(fork)
+		if err != nil {
+			return err
+		}
+		if h, ok := scheduled[fork]; ok {
+			return sdkerrors.Wrapf(types.ErrInvalidChainConfigUpgrade, "fork %s is already scheduled at height %d", fork, h)
+		}
+		if !block.IsNegative() {
+			return sdkerrors.Wrapf(types.ErrInvalidChainConfigUpgrade, "fork %s is already activated at height %s", fork, block)
+		}
+		if err := config.SetForkBlock(fork, height); err != nil {
+			return err
+		}
+	}
+
+	if err := config.EthereumConfig(nil).CheckConfigForkOrder(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidChainConfigUpgrade, err.Error())
+	}
+	return nil
+}
+
+// ScheduleChainConfigUpgrade schedules the forks to activate at the height
+func (k *Keeper) ScheduleChainConfigUpgrade(ctx sdk.Context, forks []string, height int64) error {
+	if err := k.CheckChainConfigUpgrade(ctx, forks, height); err != nil {
+		return err
+	}
+	store := k.paramSpace.CustomKVStore(ctx)
+	for _, fork := range forks {
+		store.Set(types.GetChainConfigUpgradeKey(height, fork), []byte{1})
+	}
+	return nil
+}
+
+// applyChainConfigUpgrades activates the forks scheduled at the height of ctx in the chain config
+func (k *Keeper) applyChainConfigUpgrades(ctx sdk.Context) {
+	store := k.paramSpace.CustomKVStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetChainConfigUpgradeHeightPrefix(ctx.BlockHeight()))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, common.CopyBytes(iterator.Key()))
+	}
+	iterator.Close()
+	if len(keys) == 0 {
+		return
+	}
+
+	config, found := k.GetChainConfig(ctx)
+	if !found {
+		panic(types.ErrChainConfigNotFound)
+	}
+	for _, key := range keys {
+		height, fork := types.SplitChainConfigUpgradeKey(key)
+		if err := config.SetForkBlock(fork, height); err != nil {
+			panic(err)
+		}
+		store.Delete(key)
+		k.Logger().Info("evm chain config upgraded", "fork", fork, "height", height)
+	}
+	k.SetChainConfig(ctx, config)
+}
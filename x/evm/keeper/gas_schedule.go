@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+// GetGasSchedule returns the gas schedule of the evm execution
+func (k *Keeper) GetGasSchedule(ctx sdk.Context) types.GasSchedule {
+	return types.GetGasSchedule(ctx, k.paramSpace)
+}
+
+// SetGasSchedule sets the gas schedule of the evm execution
+func (k *Keeper) SetGasSchedule(ctx sdk.Context, schedule types.GasSchedule) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyGasSchedule, &schedule)
+}
+
+// GetGasScheduleUpgrades returns the gas schedules scheduled by governance, ordered by height
+func (k *Keeper) GetGasScheduleUpgrades(ctx sdk.Context) []types.GasScheduleUpgrade {
+	store := k.paramSpace.CustomKVStore(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixGasScheduleUpgrade)
+	defer iterator.Close()
+
+	var upgrades []types.GasScheduleUpgrade
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.GasSchedule
+		types.ModuleCdc.MustUnmarshalBinaryBare(iterator.Value(), &schedule)
+		upgrades = append(upgrades, types.GasScheduleUpgrade{
+			Schedule: schedule,
+			Height:   types.SplitGasScheduleUpgradeKey(iterator.Key()),
+		})
+	}
+	return upgrades
+}
+
+// CheckGasScheduleUpgrade checks the gas schedule can be scheduled to apply from the height, which must be in the
+// future and have no gas schedule scheduled yet
+func (k *Keeper) CheckGasScheduleUpgrade(ctx sdk.Context, schedule types.GasSchedule, height int64) error {
+	if height <= ctx.BlockHeight() {
+		return sdkerrors.Wrapf(types.ErrInvalidGasScheduleUpgrade, "height %d is not after the current height %d", height, ctx.BlockHeight())
+	}
+	if err := schedule.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidGasScheduleUpgrade, err.Error())
+	}
+	if k.paramSpace.CustomKVStore(ctx).Has(types.GetGasScheduleUpgradeKey(height)) {
+		return sdkerrors.Wrapf(types.ErrInvalidGasScheduleUpgrade, "a gas schedule is already scheduled at height %d", height)
+	}
+	return nil
+}
+
+// ScheduleGasScheduleUpgrade schedules the gas schedule to apply from the height
+func (k *Keeper) ScheduleGasScheduleUpgrade(ctx sdk.Context, schedule types.GasSchedule, height int64) error {
+	if err := k.CheckGasScheduleUpgrade(ctx, schedule, height); err != nil {
+		return err
+	}
+	k.paramSpace.CustomKVStore(ctx).Set(types.GetGasScheduleUpgradeKey(height), types.ModuleCdc.MustMarshalBinaryBare(schedule))
+	return nil
+}
+
+// applyGasScheduleUpgrade applies the gas schedule scheduled at the height of ctx
+func (k *Keeper) applyGasScheduleUpgrade(ctx sdk.Context) {
+	store := k.paramSpace.CustomKVStore(ctx)
+	key := types.GetGasScheduleUpgradeKey(ctx.BlockHeight())
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+
+	var schedule types.GasSchedule
+	types.ModuleCdc.MustUnmarshalBinaryBare(bz, &schedule)
+	k.SetGasSchedule(ctx, schedule)
+	store.Delete(key)
+	k.Logger().Info("evm gas schedule upgraded", "height", ctx.BlockHeight(), "schedule", schedule.String())
+}
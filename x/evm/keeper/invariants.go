@@ -36,6 +36,10 @@ func (k Keeper) BalanceInvariant() sdk.Invariant {
 				// ignore non EthAccounts
 				return false
 			}
+			if sdk.IsWasmAddress(ethAccount.GetAddress()) {
+				// ignore the wasm contracts, which have no eth address
+				return false
+			}
 
 			accountBalance := ethAccount.GetCoins().AmountOf(sdk.DefaultBondDenom)
 			evmBalance := csdb.GetBalance(ethAccount.EthAddress())
@@ -76,6 +80,10 @@ func (k Keeper) NonceInvariant() sdk.Invariant {
 				// ignore non EthAccounts
 				return false
 			}
+			if sdk.IsWasmAddress(ethAccount.GetAddress()) {
+				// ignore the wasm contracts, which have no eth address
+				return false
+			}
 
 			evmNonce := csdb.GetNonce(ethAccount.EthAddress())
 
@@ -79,9 +79,10 @@ type Keeper struct {
 	// cache chain config
 	cci *chainConfigInfo
 
-	hooks   types.EvmHooks
-	logger  log.Logger
-	Watcher *watcher.Watcher
+	hooks       types.EvmHooks
+	hibernation types.ContractHibernation
+	logger      log.Logger
+	Watcher     *watcher.Watcher
 
 	heightCache *lru.Cache // Cache for the most recent block heights
 	hashCache   *lru.Cache // Cache for the most recent block hash
@@ -95,6 +96,17 @@ type chainConfigInfo struct {
 	// gasReduced: cached chain config reduces gas costs.
 	// when use cached chain config, we restore the gas cost(gasReduced)
 	gasReduced sdk.Gas
+
+	// setHeight: the height the chain config is set at, the chain config read at or before it isn't cached,
+	// otherwise a checkTx or a query could cache the config before the set in the middle of the block
+	setHeight int64
+
+	// beginHeight: the height of the current block, the chain config read by the queries of the previous heights
+	// isn't cached
+	beginHeight int64
+
+	// cachedHeight: the height the cached chain config is read at
+	cachedHeight int64
 }
 
 // NewKeeper generates new evm module keeper
@@ -205,6 +217,7 @@ func (k *Keeper) GenerateCSDBParams() types.CommitStateDBParams {
 		AccountKeeper: k.accountKeeper,
 		SupplyKeeper:  k.supplyKeeper,
 		BankKeeper:    k.bankKeeper,
+		Hibernation:   k.hibernation,
 		Ada:           k.Ada,
 		Cdc:           k.cdc,
 		DB:            k.db,
@@ -312,6 +325,11 @@ func (k Keeper) SetHeightHash(ctx sdk.Context, height uint64, hash ethcmn.Hash)
 	types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx).SetHeightHash(height, hash)
 }
 
+// SetRecentBlockHash sets the block header hash of the height in the ring buffer of the recent block hashes.
+func (k *Keeper) SetRecentBlockHash(ctx sdk.Context, height uint64, hash ethcmn.Hash) {
+	types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx).SetRecentBlockHash(height, hash)
+}
+
 // ----------------------------------------------------------------------------
 // Block bloom bits mapping functions
 // Required by Web3 API.
@@ -419,9 +437,10 @@ func (k Keeper) GetChainConfig(ctx sdk.Context) (types.ChainConfig, bool) {
 	gasStop := ctx.GasMeter().GasConsumed()
 
 	// only cache chain config result when we found it, or try to found again.
-	if found {
+	if found && !ctx.IsTraceTx() && ctx.BlockHeight() > k.cci.setHeight && ctx.BlockHeight() >= k.cci.beginHeight {
 		k.cci.cc = &chainConfig
 		k.cci.gasReduced = gasStop - gasStart
+		k.cci.cachedHeight = ctx.BlockHeight()
 	}
 
 	return chainConfig, found
@@ -442,6 +461,16 @@ func (k *Keeper) SetChainConfig(ctx sdk.Context, config types.ChainConfig) {
 
 	// invalid the chainConfig
 	k.cci.cc = nil
+	k.cci.setHeight = ctx.BlockHeight()
+}
+
+// beginChainConfigCache is called at the beginning of the block, at the first block after the node starts it
+// invalidates the chain config cached by a query of a previous height
+func (k *Keeper) beginChainConfigCache(height int64) {
+	if k.cci.beginHeight == 0 && k.cci.cc != nil && k.cci.cachedHeight < height-1 {
+		k.cci.cc = nil
+	}
+	k.cci.beginHeight = height
 }
 
 // SetGovKeeper sets keeper of gov
@@ -497,6 +526,14 @@ func (k *Keeper) GetHooks() types.EvmHooks {
 	return k.hooks
 }
 
+// SetContractHibernation sets the module telling whether a contract is hibernated, the calls to the hibernated
+// contracts fail. It takes effect from the next block.
+func (k *Keeper) SetContractHibernation(hibernation types.ContractHibernation) *Keeper {
+	k.hibernation = hibernation
+
+	return k
+}
+
 // CallEvmHooks delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) CallEvmHooks(ctx sdk.Context, st *types.StateTransition, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
@@ -129,7 +129,8 @@ func (k *Keeper) OnStop(ctx sdk.Context) error {
 		}
 	}
 
-	return nil
+	// the writes of the async commit are applied to the database before exiting
+	return mpt.FlushAsyncCommit()
 }
 
 // PushData2Database writes all associated state in cache to the database
@@ -236,6 +237,7 @@ func (k *Keeper) Commit(ctx sdk.Context) {
 		})
 		k.SetMptRootHash(ctx, root)
 		k.rootHash = root
+		k.recordDestructedStorages(ctx)
 	}
 }
 
@@ -94,8 +94,6 @@ type TxResult struct {
 }
 
 func (k *Keeper) saveParallelTxResult(tx sdk.Tx, resultData *types.ResultData, resp abci.ResponseDeliverTx) {
-	if !k.Watcher.Enabled() {
-		return
-	}
+	// the watcher also records the txs for the receipt store and the transfer store without the watch db
 	k.Watcher.SaveParallelTx(tx, resultData, resp)
 }
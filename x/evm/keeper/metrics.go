@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"sync"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	sysmetrics "github.com/okex/exchain/libs/system/metrics"
+)
+
+// MetricsSubsystem is a subsystem shared by all metrics exposed by this package.
+const MetricsSubsystem = "evm"
+
+// Metrics contains the metrics of the evm state
+type Metrics struct {
+	// Number of the destructed contracts whose storage is left for the cleanup.
+	DestructedContracts metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		DestructedContracts: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "destructed_contracts",
+			Help:      "Number of the destructed contracts whose storage is left for the cleanup.",
+		}, nil),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		DestructedContracts: discard.NewCounter(),
+	}
+}
+
+var (
+	evmMetrics     *Metrics
+	evmMetricsOnce sync.Once
+)
+
+// GetMetrics returns the metrics of the evm state, which are reported if the metrics of the app are enabled
+func GetMetrics() *Metrics {
+	evmMetricsOnce.Do(func() {
+		if sysmetrics.Enabled() {
+			evmMetrics = PrometheusMetrics(sysmetrics.Namespace())
+		} else {
+			evmMetrics = NopMetrics()
+		}
+	})
+	return evmMetrics
+}
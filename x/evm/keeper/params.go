@@ -35,3 +35,23 @@ func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 	types.GetEvmParamsCache().SetNeedParamsUpdate()
 }
+
+// GetMaxCodeSize returns the max size of the code of the contracts deployed by txs
+func (k *Keeper) GetMaxCodeSize(ctx sdk.Context) uint64 {
+	return types.GetMaxCodeSize(ctx, k.paramSpace)
+}
+
+// SetMaxCodeSize sets the max size of the code of the contracts deployed by txs
+func (k *Keeper) SetMaxCodeSize(ctx sdk.Context, size uint64) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyMaxCodeSize, &size)
+}
+
+// GetMaxInitCodeSize returns the max size of the initcode of the contract creation txs, 0 means no limit
+func (k *Keeper) GetMaxInitCodeSize(ctx sdk.Context) uint64 {
+	return types.GetMaxInitCodeSize(ctx, k.paramSpace)
+}
+
+// SetMaxInitCodeSize sets the max size of the initcode of the contract creation txs
+func (k *Keeper) SetMaxInitCodeSize(ctx sdk.Context, size uint64) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyMaxInitCodeSize, &size)
+}
@@ -16,3 +16,23 @@ func (suite *KeeperTestSuite) TestParams() {
 	newParams = suite.app.EvmKeeper.GetParams(*suite.ctx.SetDeliver())
 	suite.Require().Equal(newParams, params)
 }
+
+func (suite *KeeperTestSuite) TestCodeSizeParams() {
+	// the limits of the evm until they're set
+	suite.Require().Equal(uint64(types.MaxCodeSizeCeiling), suite.app.EvmKeeper.GetMaxCodeSize(suite.ctx))
+	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetMaxInitCodeSize(suite.ctx))
+
+	suite.app.EvmKeeper.SetMaxCodeSize(suite.ctx, 1024)
+	suite.app.EvmKeeper.SetMaxInitCodeSize(suite.ctx, 2048)
+	suite.Require().Equal(uint64(1024), suite.app.EvmKeeper.GetMaxCodeSize(suite.ctx))
+	suite.Require().Equal(uint64(2048), suite.app.EvmKeeper.GetMaxInitCodeSize(suite.ctx))
+
+	// the params set by governance are validated against the ceilings
+	subspace, found := suite.app.ParamsKeeper.GetSubspace(types.DefaultParamspace)
+	suite.Require().True(found)
+	suite.Require().NoError(subspace.Update(suite.ctx, types.ParamStoreKeyMaxCodeSize, []byte(`"24576"`)))
+	suite.Require().Error(subspace.Update(suite.ctx, types.ParamStoreKeyMaxCodeSize, []byte(`"24577"`)))
+	suite.Require().Error(subspace.Update(suite.ctx, types.ParamStoreKeyMaxCodeSize, []byte(`"0"`)))
+	suite.Require().NoError(subspace.Update(suite.ctx, types.ParamStoreKeyMaxInitCodeSize, []byte(`"0"`)))
+	suite.Require().Error(subspace.Update(suite.ctx, types.ParamStoreKeyMaxInitCodeSize, []byte(`"49153"`)))
+}
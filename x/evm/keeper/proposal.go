@@ -17,7 +17,9 @@ var _ govKeeper.ProposalHandler = (*Keeper)(nil)
 func (k Keeper) GetMinDeposit(ctx sdk.Context, content sdkGov.Content) (minDeposit sdk.SysCoins) {
 	switch content.(type) {
 	case types.ManageContractDeploymentWhitelistProposal, types.ManageContractBlockedListProposal,
-		types.ManageContractMethodBlockedListProposal, types.ManageSysContractAddressProposal:
+		types.ManageContractMethodBlockedListProposal, types.ManageSysContractAddressProposal,
+		types.ScheduleChainConfigUpgradeProposal, types.UpdateGasScheduleProposal,
+		types.UpdateMaxGasLimitPerTxProposal:
 		minDeposit = k.govKeeper.GetDepositParams(ctx).MinDeposit
 	}
 
@@ -28,7 +30,9 @@ func (k Keeper) GetMinDeposit(ctx sdk.Context, content sdkGov.Content) (minDepos
 func (k Keeper) GetMaxDepositPeriod(ctx sdk.Context, content sdkGov.Content) (maxDepositPeriod time.Duration) {
 	switch content.(type) {
 	case types.ManageContractDeploymentWhitelistProposal, types.ManageContractBlockedListProposal,
-		types.ManageContractMethodBlockedListProposal, types.ManageSysContractAddressProposal:
+		types.ManageContractMethodBlockedListProposal, types.ManageSysContractAddressProposal,
+		types.ScheduleChainConfigUpgradeProposal, types.UpdateGasScheduleProposal,
+		types.UpdateMaxGasLimitPerTxProposal:
 		maxDepositPeriod = k.govKeeper.GetDepositParams(ctx).MaxDepositPeriod
 	}
 
@@ -39,7 +43,9 @@ func (k Keeper) GetMaxDepositPeriod(ctx sdk.Context, content sdkGov.Content) (ma
 func (k Keeper) GetVotingPeriod(ctx sdk.Context, content sdkGov.Content) (votingPeriod time.Duration) {
 	switch content.(type) {
 	case types.ManageContractDeploymentWhitelistProposal, types.ManageContractBlockedListProposal,
-		types.ManageContractMethodBlockedListProposal, types.ManageSysContractAddressProposal:
+		types.ManageContractMethodBlockedListProposal, types.ManageSysContractAddressProposal,
+		types.ScheduleChainConfigUpgradeProposal, types.UpdateGasScheduleProposal,
+		types.UpdateMaxGasLimitPerTxProposal:
 		votingPeriod = k.govKeeper.GetVotingParams(ctx).VotingPeriod
 	}
 
@@ -81,6 +87,13 @@ func (k Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg govTypes.MsgSubmitPr
 			return types.ErrNotContracAddress(fmt.Errorf(content.ContractAddr.String()))
 		}
 		return nil
+	case types.ScheduleChainConfigUpgradeProposal:
+		return k.CheckChainConfigUpgrade(ctx, content.Forks, content.Height)
+	case types.UpdateGasScheduleProposal:
+		return k.CheckGasScheduleUpgrade(ctx, content.Schedule, content.Height)
+	case types.UpdateMaxGasLimitPerTxProposal:
+		// the max gas limit per tx is checked by the ValidateBasic of the proposal
+		return nil
 	default:
 		return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized %s proposal content type: %T", types.DefaultCodespace, content))
 	}
@@ -307,3 +307,189 @@ func (suite *KeeperTestSuite) TestProposal_ManageSysContractAddressProposal() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestProposal_ScheduleChainConfigUpgradeProposal() {
+	addr1 := ethcmn.BytesToAddress([]byte{0x01}).Bytes()
+	proposal := types.NewScheduleChainConfigUpgradeProposal(
+		"default title",
+		"default description",
+		[]string{types.ForkIstanbul},
+		100,
+	)
+
+	minDeposit := suite.app.EvmKeeper.GetMinDeposit(suite.ctx, proposal)
+	require.Equal(suite.T(), sdk.SysCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(100))}, minDeposit)
+
+	maxDepositPeriod := suite.app.EvmKeeper.GetMaxDepositPeriod(suite.ctx, proposal)
+	require.Equal(suite.T(), time.Hour*24, maxDepositPeriod)
+
+	votingPeriod := suite.app.EvmKeeper.GetVotingPeriod(suite.ctx, proposal)
+	require.Equal(suite.T(), time.Hour*72, votingPeriod)
+
+	testCases := []struct {
+		msg     string
+		prepare func()
+		success bool
+	}{
+		{
+			"fail check when the fork is already activated",
+			func() {},
+			false,
+		},
+		{
+			"pass check when the fork is inactive",
+			func() {
+				config := types.DefaultChainConfig()
+				config.IstanbulBlock = sdk.NewInt(-1)
+				config.MuirGlacierBlock = sdk.NewInt(-1)
+				suite.app.EvmKeeper.SetChainConfig(suite.ctx, config)
+			},
+			true,
+		},
+		{
+			"fail check when the height isn't after the current height",
+			func() {
+				proposal.Height = suite.ctx.BlockHeight()
+			},
+			false,
+		},
+		{
+			"fail check when the fork is already scheduled",
+			func() {
+				proposal.Height = 100
+				suite.Require().NoError(suite.app.EvmKeeper.ScheduleChainConfigUpgrade(suite.ctx, []string{types.ForkIstanbul}, 50))
+			},
+			false,
+		},
+		{
+			"fail check when the forks are out of order",
+			func() {
+				proposal.Forks = []string{types.ForkMuirGlacier}
+				proposal.Height = 10
+			},
+			false,
+		},
+		{
+			"pass check when the forks are in order",
+			func() {
+				proposal.Height = 50
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tc.prepare()
+
+			msg := govtypes.NewMsgSubmitProposal(proposal, minDeposit, addr1)
+			err := suite.app.EvmKeeper.CheckMsgSubmitProposal(suite.ctx, msg)
+			if tc.success {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestProposal_UpdateGasScheduleProposal() {
+	addr1 := ethcmn.BytesToAddress([]byte{0x01}).Bytes()
+	proposal := types.NewUpdateGasScheduleProposal(
+		"default title",
+		"default description",
+		types.GasSchedule{ExecutionGasMultiplier: sdk.NewDec(2)},
+		100,
+	)
+
+	minDeposit := suite.app.EvmKeeper.GetMinDeposit(suite.ctx, proposal)
+	require.Equal(suite.T(), sdk.SysCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(100))}, minDeposit)
+
+	maxDepositPeriod := suite.app.EvmKeeper.GetMaxDepositPeriod(suite.ctx, proposal)
+	require.Equal(suite.T(), time.Hour*24, maxDepositPeriod)
+
+	votingPeriod := suite.app.EvmKeeper.GetVotingPeriod(suite.ctx, proposal)
+	require.Equal(suite.T(), time.Hour*72, votingPeriod)
+
+	testCases := []struct {
+		msg     string
+		prepare func()
+		success bool
+	}{
+		{
+			"pass check",
+			func() {},
+			true,
+		},
+		{
+			"fail check when the height isn't after the current height",
+			func() {
+				proposal.Height = suite.ctx.BlockHeight()
+			},
+			false,
+		},
+		{
+			"fail check when the schedule is invalid",
+			func() {
+				proposal.Height = 100
+				proposal.Schedule.RefundQuotient = 1
+			},
+			false,
+		},
+		{
+			"fail check when a gas schedule is already scheduled at the height",
+			func() {
+				proposal.Schedule.RefundQuotient = 0
+				suite.Require().NoError(suite.app.EvmKeeper.ScheduleGasScheduleUpgrade(suite.ctx, types.DefaultGasSchedule(), 100))
+			},
+			false,
+		},
+		{
+			"pass check at another height",
+			func() {
+				proposal.Height = 101
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tc.prepare()
+
+			msg := govtypes.NewMsgSubmitProposal(proposal, minDeposit, addr1)
+			err := suite.app.EvmKeeper.CheckMsgSubmitProposal(suite.ctx, msg)
+			if tc.success {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestProposal_UpdateMaxGasLimitPerTxProposal() {
+	addr1 := ethcmn.BytesToAddress([]byte{0x01}).Bytes()
+	proposal := types.NewUpdateMaxGasLimitPerTxProposal(
+		"default title",
+		"default description",
+		50000000,
+	)
+
+	minDeposit := suite.app.EvmKeeper.GetMinDeposit(suite.ctx, proposal)
+	require.Equal(suite.T(), sdk.SysCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(100))}, minDeposit)
+
+	maxDepositPeriod := suite.app.EvmKeeper.GetMaxDepositPeriod(suite.ctx, proposal)
+	require.Equal(suite.T(), time.Hour*24, maxDepositPeriod)
+
+	votingPeriod := suite.app.EvmKeeper.GetVotingPeriod(suite.ctx, proposal)
+	require.Equal(suite.T(), time.Hour*72, votingPeriod)
+
+	msg := govtypes.NewMsgSubmitProposal(proposal, minDeposit, addr1)
+	suite.Require().NoError(msg.ValidateBasic())
+	suite.Require().NoError(suite.app.EvmKeeper.CheckMsgSubmitProposal(suite.ctx, msg))
+
+	// the max gas limit per tx can't be lower than the gas of a transfer
+	proposal.MaxGasLimitPerTx = 20999
+	suite.Require().Error(proposal.ValidateBasic())
+}
@@ -64,6 +64,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryContractMethodBlockedList(ctx, keeper)
 		case types.QuerySysContractAddress:
 			return querySysContractAddress(ctx, keeper)
+		case types.QueryChainConfigUpgrades:
+			return queryChainConfigUpgrades(ctx, &keeper)
+		case types.QueryGasSchedule:
+			return queryGasSchedule(ctx, &keeper)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown query endpoint")
 		}
@@ -80,6 +84,29 @@ func queryContractMethodBlockedList(ctx sdk.Context, keeper Keeper) (res []byte,
 	return res, nil
 }
 
+func queryChainConfigUpgrades(ctx sdk.Context, keeper *Keeper) (res []byte, err sdk.Error) {
+	upgrades := keeper.GetChainConfigUpgrades(ctx)
+	res, errUnmarshal := codec.MarshalJSONIndent(types.ModuleCdc, upgrades)
+	if errUnmarshal != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal result to JSON", errUnmarshal.Error()))
+	}
+
+	return res, nil
+}
+
+func queryGasSchedule(ctx sdk.Context, keeper *Keeper) (res []byte, err sdk.Error) {
+	gasSchedule := types.QueryResGasSchedule{
+		Schedule: keeper.GetGasSchedule(ctx),
+		Upgrades: keeper.GetGasScheduleUpgrades(ctx),
+	}
+	res, errUnmarshal := codec.MarshalJSONIndent(types.ModuleCdc, gasSchedule)
+	if errUnmarshal != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal result to JSON", errUnmarshal.Error()))
+	}
+
+	return res, nil
+}
+
 func queryContractBlockedList(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
 	blockedList := types.CreateEmptyCommitStateDB(keeper.GeneratePureCSDBParams(), ctx).GetContractBlockedList()
 	res, errUnmarshal := codec.MarshalJSONIndent(types.ModuleCdc, blockedList)
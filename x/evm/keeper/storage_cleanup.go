@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethstate "github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/trie"
+	bloomfilter "github.com/holiman/bloomfilter/v2"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/store/mpt"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+// StorageCleanupStats is the space reclaimed from the storage tries of the destructed contracts
+type StorageCleanupStats struct {
+	Contracts uint64
+	Nodes     uint64
+	Bytes     uint64
+}
+
+func (s StorageCleanupStats) String() string {
+	return fmt.Sprintf("contracts: %d, nodes: %d, size: %s", s.Contracts, s.Nodes, ethcmn.StorageSize(s.Bytes))
+}
+
+// recordDestructedStorages persists the storage roots of the contracts destructed in the block, so that their storage
+// tries are reclaimed by CleanDestructedStorages once the block is stored
+func (k *Keeper) recordDestructedStorages(ctx sdk.Context) {
+	destructed := k.EvmStateDb.TakeDestructedStorages()
+	if len(destructed) == 0 {
+		return
+	}
+
+	batch := k.db.TrieDB().DiskDB().NewBatch()
+	for addr, root := range destructed {
+		batch.Put(types.AppendDestructedStorageKey(uint64(ctx.BlockHeight()), addr), root.Bytes())
+	}
+	if err := batch.Write(); err != nil {
+		k.Logger().Error("failed to record the destructed storages", "height", ctx.BlockHeight(), "err", err)
+		return
+	}
+	GetMetrics().DestructedContracts.Add(float64(len(destructed)))
+}
+
+// CleanDestructedStorages deletes the nodes of the storage tries of the contracts destructed at or before the latest
+// stored height of the evm, keeping the nodes shared with the latest stored state of the evm and of the accounts. The
+// nodes are content addressed, so the node must be stopped while the live nodes are collected and the others deleted.
+// NOTE: the state of the destructed contracts at the historical heights isn't queryable afterwards.
+func CleanDestructedStorages(db ethstate.Database, bloomSize uint64, logger log.Logger) (StorageCleanupStats, error) {
+	var stats StorageCleanupStats
+	diskdb := db.TrieDB().DiskDB()
+
+	evmHeight, evmRoot, err := latestStoredRoot(diskdb, mpt.KeyPrefixEvmLatestStoredHeight, mpt.KeyPrefixEvmRootMptHash)
+	if err != nil {
+		return stats, err
+	}
+	_, accRoot, err := latestStoredRoot(diskdb, mpt.KeyPrefixAccLatestStoredHeight, mpt.KeyPrefixAccRootMptHash)
+	if err != nil {
+		return stats, err
+	}
+
+	live, err := collectLiveNodes(db, evmRoot, accRoot, bloomSize)
+	if err != nil {
+		return stats, err
+	}
+	logger.Info("collected the live nodes", "evmHeight", evmHeight, "evmRoot", evmRoot, "accRoot", accRoot)
+
+	it := diskdb.NewIterator(mpt.KeyPrefixEvmDestructedStorage, nil)
+	defer it.Release()
+	for it.Next() {
+		if !types.IsDestructedStorageKey(it.Key()) {
+			continue
+		}
+		height, addr := types.SplitDestructedStorageKey(it.Key())
+		if height > evmHeight {
+			break
+		}
+
+		batch := diskdb.NewBatch()
+		nodes, size, err := deleteStorageNodes(db, batch, live, ethcmn.BytesToHash(it.Value()))
+		if err != nil {
+			return stats, err
+		}
+		batch.Delete(ethcmn.CopyBytes(it.Key()))
+		if err := batch.Write(); err != nil {
+			return stats, err
+		}
+
+		stats.Contracts++
+		stats.Nodes += nodes
+		stats.Bytes += size
+		logger.Debug("reclaimed the storage of the destructed contract", "height", height, "address", addr, "nodes", nodes)
+	}
+	return stats, it.Error()
+}
+
+func latestStoredRoot(diskdb ethdb.KeyValueReader, heightKey, rootPrefix []byte) (uint64, ethcmn.Hash, error) {
+	heightBytes, err := diskdb.Get(heightKey)
+	if err != nil || len(heightBytes) == 0 {
+		return 0, ethcmn.Hash{}, nil
+	}
+	root, err := diskdb.Get(append(rootPrefix, heightBytes...))
+	if err != nil {
+		return 0, ethcmn.Hash{}, err
+	}
+	return sdk.BigEndianToUint64(heightBytes), ethcmn.BytesToHash(root), nil
+}
+
+// collectLiveNodes adds the nodes of the account trie, the evm trie and the storage tries referenced by the evm trie
+// to a bloom filter of bloomSize megabytes, a false positive only keeps a node which could be deleted
+func collectLiveNodes(db ethstate.Database, evmRoot, accRoot ethcmn.Hash, bloomSize uint64) (*bloomfilter.Filter, error) {
+	live, err := bloomfilter.New(bloomSize*1024*1024*8, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	addNodes := func(root ethcmn.Hash, onLeaf func(leaf []byte) error) error {
+		if root == (ethcmn.Hash{}) || root == ethtypes.EmptyRootHash {
+			return nil
+		}
+		tr, err := trie.New(root, db.TrieDB())
+		if err != nil {
+			return err
+		}
+		it := tr.NodeIterator(nil)
+		for it.Next(true) {
+			if hash := it.Hash(); hash != (ethcmn.Hash{}) {
+				live.AddHash(binary.BigEndian.Uint64(hash[:8]))
+			}
+			if it.Leaf() && onLeaf != nil {
+				if err := onLeaf(it.LeafBlob()); err != nil {
+					return err
+				}
+			}
+		}
+		return it.Error()
+	}
+
+	if err := addNodes(accRoot, nil); err != nil {
+		return nil, err
+	}
+	err = addNodes(evmRoot, func(leaf []byte) error {
+		return addNodes(ethcmn.BytesToHash(leaf), nil)
+	})
+	return live, err
+}
+
+// deleteStorageNodes deletes the nodes of the storage trie which aren't live in the batch. The nodes shared with a
+// storage trie reclaimed before are missing, so the iteration stops at them.
+func deleteStorageNodes(db ethstate.Database, batch ethdb.Batch, live *bloomfilter.Filter, root ethcmn.Hash) (nodes, size uint64, err error) {
+	tr, err := trie.New(root, db.TrieDB())
+	if err != nil {
+		if _, ok := err.(*trie.MissingNodeError); ok {
+			return 0, 0, nil
+		}
+		return 0, 0, err
+	}
+
+	diskdb := db.TrieDB().DiskDB()
+	it := tr.NodeIterator(nil)
+	for it.Next(true) {
+		hash := it.Hash()
+		if hash == (ethcmn.Hash{}) || live.ContainsHash(binary.BigEndian.Uint64(hash[:8])) {
+			continue
+		}
+		blob, err := diskdb.Get(hash.Bytes())
+		if err != nil || len(blob) == 0 {
+			continue
+		}
+		batch.Delete(hash.Bytes())
+		nodes++
+		size += uint64(len(blob))
+	}
+	if _, ok := it.Error().(*trie.MissingNodeError); ok {
+		return nodes, size, nil
+	}
+	return nodes, size, it.Error()
+}
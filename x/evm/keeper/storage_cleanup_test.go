@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	ethstate "github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/store/mpt"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/x/evm/keeper"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+func commitStorageTrie(t *testing.T, db ethstate.Database, slots map[string]string) ethcmn.Hash {
+	tr, err := trie.NewSecure(ethcmn.Hash{}, db.TrieDB())
+	require.NoError(t, err)
+	for k, v := range slots {
+		require.NoError(t, tr.TryUpdate(ethcmn.BytesToHash([]byte(k)).Bytes(), []byte(v)))
+	}
+	root, err := tr.Commit(nil)
+	require.NoError(t, err)
+	require.NoError(t, db.TrieDB().Commit(root, false, nil))
+	return root
+}
+
+func TestCleanDestructedStorages(t *testing.T) {
+	db := ethstate.NewDatabase(rawdb.NewMemoryDatabase())
+	diskdb := db.TrieDB().DiskDB()
+
+	destructed := commitStorageTrie(t, db, map[string]string{"a": "1", "b": "2", "c": "3"})
+	live := commitStorageTrie(t, db, map[string]string{"d": "4", "e": "5"})
+
+	// the evm trie only references the storage of the live contract
+	liveAddr := ethcmn.HexToAddress("0x1")
+	evmTrie, err := trie.NewSecure(ethcmn.Hash{}, db.TrieDB())
+	require.NoError(t, err)
+	require.NoError(t, evmTrie.TryUpdate(liveAddr.Bytes(), live.Bytes()))
+	evmRoot, err := evmTrie.Commit(func(_ [][]byte, _ []byte, leaf []byte, parent ethcmn.Hash) error {
+		db.TrieDB().Reference(ethcmn.BytesToHash(leaf), parent)
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.TrieDB().Commit(evmRoot, false, nil))
+	require.NoError(t, diskdb.Put(mpt.KeyPrefixEvmLatestStoredHeight, sdk.Uint64ToBigEndian(10)))
+	require.NoError(t, diskdb.Put(append(mpt.KeyPrefixEvmRootMptHash, sdk.Uint64ToBigEndian(10)...), evmRoot.Bytes()))
+
+	// the storage destructed after the latest stored height is kept
+	destructedKey := types.AppendDestructedStorageKey(5, ethcmn.HexToAddress("0x2"))
+	sharedKey := types.AppendDestructedStorageKey(5, ethcmn.HexToAddress("0x3"))
+	pendingKey := types.AppendDestructedStorageKey(11, ethcmn.HexToAddress("0x4"))
+	require.NoError(t, diskdb.Put(destructedKey, destructed.Bytes()))
+	require.NoError(t, diskdb.Put(sharedKey, live.Bytes()))
+	require.NoError(t, diskdb.Put(pendingKey, destructed.Bytes()))
+
+	stats, err := keeper.CleanDestructedStorages(db, 1, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stats.Contracts)
+	require.True(t, stats.Nodes > 0)
+	require.True(t, stats.Bytes > 0)
+
+	has, _ := diskdb.Has(destructed.Bytes())
+	require.False(t, has)
+	has, _ = diskdb.Has(live.Bytes())
+	require.True(t, has)
+	has, _ = diskdb.Has(destructedKey)
+	require.False(t, has)
+	has, _ = diskdb.Has(sharedKey)
+	require.False(t, has)
+	has, _ = diskdb.Has(pendingKey)
+	require.True(t, has)
+
+	// the live storage is still readable
+	tr, err := trie.NewSecure(live, db.TrieDB())
+	require.NoError(t, err)
+	value, err := tr.TryGet(ethcmn.BytesToHash([]byte("d")).Bytes())
+	require.NoError(t, err)
+	require.Equal(t, []byte("4"), value)
+}
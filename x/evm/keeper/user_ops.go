@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethermint "github.com/okex/exchain/app/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+// GetUserOpNonce returns the nonce of the next user operation of the contract account
+func (k *Keeper) GetUserOpNonce(ctx sdk.Context, addr common.Address) uint64 {
+	store := k.paramSpace.CustomKVStore(ctx)
+	bz := store.Get(types.GetUserOpNonceKey(addr))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k *Keeper) setUserOpNonce(ctx sdk.Context, addr common.Address, nonce uint64) {
+	store := k.paramSpace.CustomKVStore(ctx)
+	store.Set(types.GetUserOpNonceKey(addr), sdk.Uint64ToBigEndian(nonce))
+}
+
+// ValidateUserOps checks the senders, nonces and prefunds of the user operations against the state, without calling
+// the contract accounts. It's used to reject the invalid user operations before they are included in a block.
+func (k *Keeper) ValidateUserOps(ctx sdk.Context, msg types.MsgHandleUserOps) error {
+	csdb := types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx)
+	nonces := make(map[common.Address]uint64)
+	prefunds := make(map[common.Address]*big.Int)
+	for i, op := range msg.Ops {
+		sender := op.SenderAddress()
+		nonce, ok := nonces[sender]
+		if !ok {
+			nonce = k.GetUserOpNonce(ctx, sender)
+		}
+		prefund, ok := prefunds[sender]
+		if !ok {
+			prefund = new(big.Int)
+		}
+		prefund.Add(prefund, op.Prefund())
+
+		if err := checkUserOp(csdb, op, nonce, prefund); err != nil {
+			return sdkerrors.Wrapf(err, "user operation %d", i)
+		}
+		nonces[sender], prefunds[sender] = nonce+1, prefund
+	}
+	return nil
+}
+
+// HandleUserOps validates and executes the user operations in order. Each contract account is called from the
+// entrypoint to validate its user operation, which must return zero, then called with the call data of the user
+// operation. An invalid user operation fails the whole msg, while a failed execution only fails the user operation
+// itself. The contract accounts reimburse the bundler with the fee of the gas used by their user operations.
+func (k *Keeper) HandleUserOps(ctx sdk.Context, msg types.MsgHandleUserOps) (*sdk.Result, error) {
+	config, found := k.GetChainConfig(ctx)
+	if !found {
+		return nil, types.ErrChainConfigNotFound
+	}
+	chainID, err := ethermint.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	bundler := common.BytesToAddress(sdk.MustAccAddressFromBech32(msg.Bundler).Bytes())
+
+	for i, op := range msg.Ops {
+		sender := op.SenderAddress()
+		csdb := types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx)
+		if err := checkUserOp(csdb, op, k.GetUserOpNonce(ctx, sender), op.Prefund()); err != nil {
+			return nil, sdkerrors.Wrapf(err, "user operation %d", i)
+		}
+		k.setUserOpNonce(ctx, sender, op.Nonce+1)
+
+		input, err := types.GetValidateUserOpInput(op.Hash(chainID), op.Signature)
+		if err != nil {
+			return nil, err
+		}
+		ret, verificationGasUsed, err := k.callAsEntryPoint(ctx, config, chainID, sender, input, op.VerificationGasLimit)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrUserOpValidation, "user operation %d: %s", i, err)
+		}
+		validationData, err := types.GetValidateUserOpOutput(ret)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrUserOpValidation, "user operation %d: %s", i, err)
+		}
+		if validationData.Sign() != 0 {
+			return nil, sdkerrors.Wrapf(types.ErrUserOpValidation, "user operation %d: validation data %s", i, validationData)
+		}
+
+		_, callGasUsed, callErr := k.callAsEntryPoint(ctx, config, chainID, sender, op.CallData, op.CallGasLimit)
+
+		gasUsed := verificationGasUsed + callGasUsed
+		fee := op.Fee(gasUsed)
+		csdb = types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx)
+		if csdb.GetBalance(sender).Cmp(fee) < 0 {
+			return nil, sdkerrors.Wrapf(types.ErrUserOpPrefund, "user operation %d: balance of %s is less than the fee %s", i, sender, fee)
+		}
+		csdb.SubBalance(sender, fee)
+		csdb.AddBalance(bundler, fee)
+		csdb.Commit(false)
+
+		event := sdk.NewEvent(types.EventTypeUserOperation,
+			sdk.NewAttribute(types.AttributeKeyUserOpSender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyUserOpNonce, strconv.FormatUint(op.Nonce, 10)),
+			sdk.NewAttribute(types.AttributeKeyUserOpHash, op.Hash(chainID).String()),
+			sdk.NewAttribute(types.AttributeKeyUserOpSuccess, strconv.FormatBool(callErr == nil)),
+			sdk.NewAttribute(types.AttributeKeyUserOpGasUsed, strconv.FormatUint(gasUsed, 10)),
+			sdk.NewAttribute(types.AttributeKeyUserOpFee, fee.String()),
+		)
+		if callErr != nil {
+			event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyUserOpError, callErr.Error()))
+		}
+		ctx.EventManager().EmitEvent(event)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Bundler),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// checkUserOp checks the user operation is from a contract account with the expected nonce and enough balance
+func checkUserOp(csdb *types.CommitStateDB, op types.UserOperation, nonce uint64, prefund *big.Int) error {
+	sender := op.SenderAddress()
+	if len(csdb.GetCode(sender)) == 0 {
+		return sdkerrors.Wrapf(types.ErrUserOpSender, "%s is not a contract account", sender)
+	}
+	if op.Nonce != nonce {
+		return sdkerrors.Wrapf(types.ErrUserOpNonce, "expected %d, got %d", nonce, op.Nonce)
+	}
+	if balance := csdb.GetBalance(sender); balance.Cmp(prefund) < 0 {
+		return sdkerrors.Wrapf(types.ErrUserOpPrefund, "balance of %s is %s, prefund %s", sender, balance, prefund)
+	}
+	return nil
+}
+
+// callAsEntryPoint calls the contract account from the entrypoint, the call is metered by its own gas limit, and
+// the gas used is consumed from the gas meter of ctx afterwards
+func (k *Keeper) callAsEntryPoint(ctx sdk.Context, config types.ChainConfig, chainID *big.Int, to common.Address, data []byte, gasLimit uint64) ([]byte, uint64, error) {
+	intrinsicGas, err := core.IntrinsicGas(data, []ethtypes.AccessTuple{}, false, config.IsHomestead(), config.IsIstanbul())
+	if err != nil {
+		return nil, 0, err
+	}
+	if intrinsicGas > gasLimit {
+		return nil, 0, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "intrinsic gas %d, gas limit %d", intrinsicGas, gasLimit)
+	}
+
+	acc := k.accountKeeper.GetAccount(ctx, types.EntryPointAddress.Bytes())
+	if acc == nil {
+		acc = k.accountKeeper.NewAccountWithAddress(ctx, types.EntryPointAddress.Bytes())
+	}
+	nonce := acc.GetSequence()
+	txHash := common.BytesToHash(tmtypes.Tx(ctx.TxBytes()).Hash(ctx.BlockHeight()))
+
+	callCtx := ctx
+	gasMeter := sdk.NewGasMeter(gasLimit)
+	callCtx.SetGasMeter(gasMeter)
+	st := types.StateTransition{
+		AccountNonce: nonce,
+		Price:        big.NewInt(0),
+		GasLimit:     gasLimit,
+		Recipient:    &to,
+		Amount:       big.NewInt(0),
+		Payload:      data,
+		Csdb:         types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), callCtx),
+		ChainID:      chainID,
+		TxHash:       &txHash,
+		Sender:       types.EntryPointAddress,
+		Simulate:     ctx.IsCheckTx(),
+	}
+	_, resData, err, _, _ := st.TransitionDb(callCtx, config)
+	gasUsed := gasMeter.GasConsumed()
+	ctx.GasMeter().ConsumeGas(gasUsed, "user operation")
+	if err != nil {
+		return nil, gasUsed, err
+	}
+
+	st.Csdb.Commit(false)
+	acc.SetSequence(nonce + 1)
+	k.accountKeeper.SetAccount(ctx, acc)
+	return resData.Ret, gasUsed, nil
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+// userOpAccountCode returns the runtime code of a contract account, whose validateUserOp returns the first word of
+// the signature, and the other calls store the first word of the call data in slot 0
+func userOpAccountCode() []byte {
+	selector := ethcrypto.Keccak256([]byte("validateUserOp(bytes32,bytes)"))[:4]
+	return hexutil.MustDecode(fmt.Sprintf("0x60003560e01c63%x1460165760003560005500%s", selector, "5b60643560005260206000f3"))
+}
+
+// setupUserOpAccount enables the evm calls and deploys the contract account with some balance
+func (suite *KeeperTestSuite) setupUserOpAccount(account ethcmn.Address) {
+	params := types.DefaultParams()
+	params.EnableCall = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+
+	csdb := suite.stateDB.WithContext(suite.ctx)
+	csdb.SetCode(account, userOpAccountCode())
+	csdb.AddBalance(account, big.NewInt(1000000000))
+	_, err := csdb.Commit(false)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestHandleUserOps() {
+	account := ethcmn.HexToAddress("0x1000000000000000000000000000000000000001")
+	bundler := sdk.AccAddress(ethcmn.HexToAddress("0x2000000000000000000000000000000000000002").Bytes())
+	callData := ethcmn.LeftPadBytes([]byte{0x1}, 32)
+	validSig, invalidSig := make([]byte, 32), ethcmn.LeftPadBytes([]byte{0x1}, 32)
+
+	newOp := func(nonce uint64, sig []byte) types.UserOperation {
+		return types.UserOperation{
+			Sender:               account.String(),
+			Nonce:                nonce,
+			CallData:             callData,
+			CallGasLimit:         100000,
+			VerificationGasLimit: 100000,
+			MaxFeePerGas:         sdk.NewInt(1),
+			Signature:            sig,
+		}
+	}
+
+	testCases := []struct {
+		msg            string
+		malleate       func() types.MsgHandleUserOps
+		expValidateErr error
+		expHandleErr   error
+	}{
+		{
+			"sender isn't a contract account",
+			func() types.MsgHandleUserOps {
+				op := newOp(0, validSig)
+				op.Sender = suite.address.String()
+				return types.NewMsgHandleUserOps(bundler, []types.UserOperation{op})
+			},
+			types.ErrUserOpSender,
+			types.ErrUserOpSender,
+		},
+		{
+			"wrong nonce",
+			func() types.MsgHandleUserOps {
+				return types.NewMsgHandleUserOps(bundler, []types.UserOperation{newOp(1, validSig)})
+			},
+			types.ErrUserOpNonce,
+			types.ErrUserOpNonce,
+		},
+		{
+			"insufficient prefund",
+			func() types.MsgHandleUserOps {
+				op := newOp(0, validSig)
+				op.MaxFeePerGas = sdk.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+				return types.NewMsgHandleUserOps(bundler, []types.UserOperation{op})
+			},
+			types.ErrUserOpPrefund,
+			types.ErrUserOpPrefund,
+		},
+		{
+			"invalid signature",
+			func() types.MsgHandleUserOps {
+				return types.NewMsgHandleUserOps(bundler, []types.UserOperation{newOp(0, invalidSig)})
+			},
+			nil,
+			types.ErrUserOpValidation,
+		},
+		{
+			"sequential user operations",
+			func() types.MsgHandleUserOps {
+				return types.NewMsgHandleUserOps(bundler, []types.UserOperation{newOp(0, validSig), newOp(1, validSig)})
+			},
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			suite.setupUserOpAccount(account)
+
+			msg := tc.malleate()
+			suite.Require().NoError(msg.ValidateBasic())
+			err := suite.app.EvmKeeper.ValidateUserOps(suite.ctx, msg)
+			if tc.expValidateErr != nil {
+				suite.Require().True(errors.Is(err, tc.expValidateErr), err)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			_, err = suite.app.EvmKeeper.HandleUserOps(suite.ctx, msg)
+			if tc.expHandleErr != nil {
+				suite.Require().True(errors.Is(err, tc.expHandleErr), err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			csdb := types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+			suite.Require().Equal(uint64(len(msg.Ops)), suite.app.EvmKeeper.GetUserOpNonce(suite.ctx, account))
+			suite.Require().Equal(ethcmn.BytesToHash(callData), csdb.GetState(account, ethcmn.Hash{}))
+			fee := csdb.GetBalance(ethcmn.BytesToAddress(bundler))
+			suite.Require().True(fee.Sign() > 0)
+			suite.Require().Equal(big.NewInt(1000000000), new(big.Int).Add(csdb.GetBalance(account), fee))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleUserOpsWithFailedExecution() {
+	account := ethcmn.HexToAddress("0x1000000000000000000000000000000000000001")
+	bundler := sdk.AccAddress(ethcmn.HexToAddress("0x2000000000000000000000000000000000000002").Bytes())
+	suite.setupUserOpAccount(account)
+
+	// the call gas limit only covers the intrinsic gas, so the execution runs out of gas
+	callData := ethcmn.LeftPadBytes([]byte{0x1}, 32)
+	op := types.UserOperation{
+		Sender:               account.String(),
+		CallData:             callData,
+		CallGasLimit:         21000 + 31*4 + 16,
+		VerificationGasLimit: 100000,
+		MaxFeePerGas:         sdk.NewInt(1),
+		Signature:            make([]byte, 32),
+	}
+	res, err := suite.app.EvmKeeper.HandleUserOps(suite.ctx, types.NewMsgHandleUserOps(bundler, []types.UserOperation{op}))
+	suite.Require().NoError(err)
+
+	var event sdk.Event
+	for _, e := range res.Events {
+		if e.Type == types.EventTypeUserOperation {
+			event = e
+		}
+	}
+	attrs := make(map[string]string)
+	for _, attr := range event.Attributes {
+		attrs[string(attr.Key)] = string(attr.Value)
+	}
+	suite.Require().Equal("false", attrs[types.AttributeKeyUserOpSuccess])
+	suite.Require().NotEmpty(attrs[types.AttributeKeyUserOpError])
+
+	// the nonce is used and the fee is paid, while the execution is reverted
+	csdb := types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetUserOpNonce(suite.ctx, account))
+	suite.Require().Equal(ethcmn.Hash{}, csdb.GetState(account, ethcmn.Hash{}))
+	suite.Require().Equal(attrs[types.AttributeKeyUserOpFee], csdb.GetBalance(ethcmn.BytesToAddress(bundler)).String())
+}
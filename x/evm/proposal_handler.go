@@ -23,6 +23,12 @@ func NewManageContractDeploymentWhitelistProposalHandler(k *Keeper) govTypes.Han
 				return handleManageSysContractAddressProposal(ctx, k, content)
 			}
 			return common.ErrUnknownProposalType(types.DefaultCodespace, content.ProposalType())
+		case types.ScheduleChainConfigUpgradeProposal:
+			return handleScheduleChainConfigUpgradeProposal(ctx, k, content)
+		case types.UpdateGasScheduleProposal:
+			return handleUpdateGasScheduleProposal(ctx, k, content)
+		case types.UpdateMaxGasLimitPerTxProposal:
+			return handleUpdateMaxGasLimitPerTxProposal(ctx, k, content)
 		default:
 			return common.ErrUnknownProposalType(types.DefaultCodespace, content.ProposalType())
 		}
@@ -79,3 +85,23 @@ func handleManageSysContractAddressProposal(ctx sdk.Context, k *Keeper,
 	// remove system contract address
 	return k.DelSysContractAddress(ctx)
 }
+
+func handleScheduleChainConfigUpgradeProposal(ctx sdk.Context, k *Keeper,
+	p types.ScheduleChainConfigUpgradeProposal) sdk.Error {
+	// the height may have been reached during the voting period, which fails the proposal
+	return k.ScheduleChainConfigUpgrade(ctx, p.Forks, p.Height)
+}
+
+func handleUpdateGasScheduleProposal(ctx sdk.Context, k *Keeper,
+	p types.UpdateGasScheduleProposal) sdk.Error {
+	// the height may have been reached during the voting period, which fails the proposal
+	return k.ScheduleGasScheduleUpgrade(ctx, p.Schedule, p.Height)
+}
+
+func handleUpdateMaxGasLimitPerTxProposal(ctx sdk.Context, k *Keeper,
+	p types.UpdateMaxGasLimitPerTxProposal) sdk.Error {
+	params := k.GetParams(ctx)
+	params.MaxGasLimitPerTx = p.MaxGasLimitPerTx
+	k.SetParams(ctx, params)
+	return nil
+}
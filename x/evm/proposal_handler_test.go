@@ -2,6 +2,7 @@ package evm_test
 
 import (
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	ttypes "github.com/okex/exchain/libs/tendermint/types"
 	"github.com/okex/exchain/x/evm"
 	"github.com/okex/exchain/x/evm/types"
@@ -429,3 +430,149 @@ func (suite *EvmTestSuite) TestProposalHandler_ManageSysContractAddressProposal(
 		})
 	}
 }
+
+func (suite *EvmTestSuite) TestProposalHandler_ScheduleChainConfigUpgradeProposal() {
+	suite.govHandler = evm.NewManageContractDeploymentWhitelistProposalHandler(suite.app.EvmKeeper)
+
+	config := types.DefaultChainConfig()
+	config.IstanbulBlock = sdk.NewInt(-1)
+	config.MuirGlacierBlock = sdk.NewInt(-1)
+	suite.app.EvmKeeper.SetChainConfig(suite.ctx, config)
+
+	govProposal := &govtypes.Proposal{}
+
+	testCases := []struct {
+		msg     string
+		prepare func()
+		success bool
+	}{
+		{
+			msg: "schedule istanbul",
+			prepare: func() {
+				govProposal.Content = types.NewScheduleChainConfigUpgradeProposal(
+					"default title",
+					"default description",
+					[]string{types.ForkIstanbul},
+					100,
+				)
+			},
+			success: true,
+		},
+		{
+			msg: "schedule istanbul again",
+			prepare: func() {
+				govProposal.Content = types.NewScheduleChainConfigUpgradeProposal(
+					"default title",
+					"default description",
+					[]string{types.ForkIstanbul},
+					200,
+				)
+			},
+			success: false,
+		},
+		{
+			msg: "schedule muir glacier at a passed height",
+			prepare: func() {
+				govProposal.Content = types.NewScheduleChainConfigUpgradeProposal(
+					"default title",
+					"default description",
+					[]string{types.ForkMuirGlacier},
+					suite.ctx.BlockHeight(),
+				)
+			},
+			success: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tc.prepare()
+
+			err := suite.govHandler(suite.ctx, govProposal)
+			if tc.success {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	suite.Require().Equal([]types.ChainConfigUpgrade{{Fork: types.ForkIstanbul, Height: 100}},
+		suite.app.EvmKeeper.GetChainConfigUpgrades(suite.ctx))
+}
+
+func (suite *EvmTestSuite) TestProposalHandler_UpdateGasScheduleProposal() {
+	suite.govHandler = evm.NewManageContractDeploymentWhitelistProposalHandler(suite.app.EvmKeeper)
+
+	schedule := types.GasSchedule{ExecutionGasMultiplier: sdk.NewDec(2), RefundQuotient: 5}
+	govProposal := &govtypes.Proposal{}
+
+	testCases := []struct {
+		msg     string
+		prepare func()
+		success bool
+	}{
+		{
+			msg: "schedule at height 100",
+			prepare: func() {
+				govProposal.Content = types.NewUpdateGasScheduleProposal(
+					"default title",
+					"default description",
+					schedule,
+					100,
+				)
+			},
+			success: true,
+		},
+		{
+			msg: "schedule at height 100 again",
+			prepare: func() {
+				govProposal.Content = types.NewUpdateGasScheduleProposal(
+					"default title",
+					"default description",
+					types.DefaultGasSchedule(),
+					100,
+				)
+			},
+			success: false,
+		},
+		{
+			msg: "schedule at a passed height",
+			prepare: func() {
+				govProposal.Content = types.NewUpdateGasScheduleProposal(
+					"default title",
+					"default description",
+					types.DefaultGasSchedule(),
+					suite.ctx.BlockHeight(),
+				)
+			},
+			success: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tc.prepare()
+
+			err := suite.govHandler(suite.ctx, govProposal)
+			if tc.success {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	suite.Require().Equal([]types.GasScheduleUpgrade{{Schedule: schedule, Height: 100}},
+		suite.app.EvmKeeper.GetGasScheduleUpgrades(suite.ctx))
+}
+
+func (suite *EvmTestSuite) TestProposalHandler_UpdateMaxGasLimitPerTxProposal() {
+	suite.govHandler = evm.NewManageContractDeploymentWhitelistProposalHandler(suite.app.EvmKeeper)
+
+	govProposal := &govtypes.Proposal{
+		Content: types.NewUpdateMaxGasLimitPerTxProposal("default title", "default description", 50000000),
+	}
+	suite.Require().NoError(suite.govHandler(suite.ctx, govProposal))
+	suite.Require().Equal(uint64(50000000), suite.app.EvmKeeper.GetParams(suite.ctx).MaxGasLimitPerTx)
+}
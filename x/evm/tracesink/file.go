@@ -0,0 +1,43 @@
+package tracesink
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+
+	"github.com/okex/exchain/x/evm/types"
+)
+
+// FileWriter appends the traces to a file as JSON lines, one trace per line
+type FileWriter struct {
+	file *os.File
+}
+
+// NewFileWriter opens the file at path to append the traces, the file is created if it doesn't exist
+func NewFileWriter(path string) (*FileWriter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileWriter{file: file}, nil
+}
+
+// Write implements types.TraceWriter, the traces of a block are synced to the file together
+func (w *FileWriter) Write(_ int64, traces []*types.TxTrace) error {
+	buf := bufio.NewWriter(w.file)
+	enc := json.NewEncoder(buf)
+	for _, trace := range traces {
+		if err := enc.Encode(trace); err != nil {
+			return err
+		}
+	}
+	if err := buf.Flush(); err != nil {
+		return err
+	}
+	return w.file.Sync()
+}
+
+// Close closes the file
+func (w *FileWriter) Close() error {
+	return w.file.Close()
+}
@@ -0,0 +1,98 @@
+package tracesink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+
+	"github.com/okex/exchain/x/evm/types"
+)
+
+const (
+	// TraceSinkGRPCServiceName is the name of the gRPC service receiving the traces
+	TraceSinkGRPCServiceName = "exchain.evm.TraceSink"
+	// TraceSinkGRPCMethod is the unary method receiving the traces of a block as a BlockTraces
+	TraceSinkGRPCMethod = "WriteBlockTraces"
+)
+
+// BlockTraces are the traces of the evm txs of a block sent to the gRPC service
+type BlockTraces struct {
+	Height int64            `json:"height"`
+	Traces []*types.TxTrace `json:"traces"`
+}
+
+// WriteBlockTracesResponse is the empty response of the gRPC service
+type WriteBlockTracesResponse struct{}
+
+// jsonGRPCCodec encodes the messages of the gRPC service with JSON, so the service is implemented without generated
+// protobuf messages
+type jsonGRPCCodec struct{}
+
+func (jsonGRPCCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonGRPCCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonGRPCCodec) Name() string {
+	return "json"
+}
+
+// GRPCWriter sends the traces of each block to a gRPC service, the connection is re-established with backoff if lost
+// and the traces are sent once the service is reachable
+type GRPCWriter struct {
+	conn *grpc.ClientConn
+}
+
+// NewGRPCWriter returns a GRPCWriter connecting to the service at address
+func NewGRPCWriter(address string) (*GRPCWriter, error) {
+	conn, err := grpc.Dial(address,
+		grpc.WithInsecure(),
+		grpc.WithConnectParams(grpc.ConnectParams{Backoff: backoff.DefaultConfig}),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(jsonGRPCCodec{}), grpc.WaitForReady(true)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &GRPCWriter{conn: conn}, nil
+}
+
+// Write implements types.TraceWriter
+func (w *GRPCWriter) Write(height int64, traces []*types.TxTrace) error {
+	return w.conn.Invoke(context.Background(), fmt.Sprintf("/%s/%s", TraceSinkGRPCServiceName, TraceSinkGRPCMethod),
+		&BlockTraces{Height: height, Traces: traces}, &WriteBlockTracesResponse{})
+}
+
+// Close closes the connection
+func (w *GRPCWriter) Close() error {
+	return w.conn.Close()
+}
+
+// NewTraceSinkServer returns a gRPC server of the service receiving the traces, handle is called with the traces of
+// each block
+func NewTraceSinkServer(handle func(*BlockTraces) error) *grpc.Server {
+	server := grpc.NewServer(grpc.ForceServerCodec(jsonGRPCCodec{}))
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: TraceSinkGRPCServiceName,
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: TraceSinkGRPCMethod,
+			Handler: func(_ interface{}, _ context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				req := &BlockTraces{}
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				if err := handle(req); err != nil {
+					return nil, err
+				}
+				return &WriteBlockTracesResponse{}, nil
+			},
+		}},
+	}, nil)
+	return server
+}
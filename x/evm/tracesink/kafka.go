@@ -0,0 +1,45 @@
+package tracesink
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/okex/exchain/x/evm/types"
+)
+
+// KafkaWriter sends the traces to a kafka topic, one message per trace keyed by the tx hash
+type KafkaWriter struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaWriter returns a KafkaWriter sending the traces to the topic of the comma separated brokers
+func NewKafkaWriter(brokers string, topic string) *KafkaWriter {
+	return &KafkaWriter{
+		writer: kafka.NewWriter(kafka.WriterConfig{
+			Brokers:  strings.Split(brokers, ","),
+			Topic:    topic,
+			Balancer: &kafka.Hash{},
+		}),
+	}
+}
+
+// Write implements types.TraceWriter
+func (w *KafkaWriter) Write(_ int64, traces []*types.TxTrace) error {
+	msgs := make([]kafka.Message, 0, len(traces))
+	for _, trace := range traces {
+		value, err := json.Marshal(trace)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, kafka.Message{Key: trace.TxHash.Bytes(), Value: value})
+	}
+	return w.writer.WriteMessages(context.Background(), msgs...)
+}
+
+// Close closes the writer
+func (w *KafkaWriter) Close() error {
+	return w.writer.Close()
+}
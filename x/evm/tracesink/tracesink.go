@@ -0,0 +1,65 @@
+package tracesink
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/viper"
+
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+const (
+	FlagTraceSink       = "evm.trace-sink"
+	FlagTraceSinkTarget = "evm.trace-sink.target"
+	FlagTraceSinkTopic  = "evm.trace-sink.kafka-topic"
+	FlagTraceSinkConfig = "evm.trace-sink.config"
+
+	SinkFile  = "file"
+	SinkGRPC  = "grpc"
+	SinkKafka = "kafka"
+
+	DefaultKafkaTopic = "evm-traces"
+)
+
+// Init sets the trace sink of the evm txs configured by the flags, the txs aren't traced if no sink is configured.
+// The target is the path of the file, the address of the grpc service or the comma separated kafka brokers.
+func Init(logger log.Logger) error {
+	sink := viper.GetString(FlagTraceSink)
+	if sink == "" {
+		return nil
+	}
+	config := &types.TraceConfig{}
+	if configStr := viper.GetString(FlagTraceSinkConfig); configStr != "" {
+		if err := json.Unmarshal([]byte(configStr), config); err != nil {
+			return fmt.Errorf("invalid %s: %s", FlagTraceSinkConfig, err)
+		}
+	}
+	target := viper.GetString(FlagTraceSinkTarget)
+	if target == "" {
+		return fmt.Errorf("%s is required by the %s trace sink", FlagTraceSinkTarget, sink)
+	}
+
+	var writer types.TraceWriter
+	var err error
+	switch sink {
+	case SinkFile:
+		writer, err = NewFileWriter(target)
+	case SinkGRPC:
+		writer, err = NewGRPCWriter(target)
+	case SinkKafka:
+		topic := viper.GetString(FlagTraceSinkTopic)
+		if topic == "" {
+			topic = DefaultKafkaTopic
+		}
+		writer = NewKafkaWriter(target, topic)
+	default:
+		return fmt.Errorf("unknown trace sink %s, the sinks are %s, %s and %s", sink, SinkFile, SinkGRPC, SinkKafka)
+	}
+	if err != nil {
+		return err
+	}
+	logger.Info("tracing the evm txs", "sink", sink, "target", target)
+	return types.SetTraceSink(config, writer, logger.With("module", "tracesink"))
+}
@@ -0,0 +1,61 @@
+package tracesink
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/x/evm/types"
+)
+
+func testTraces(height int64) []*types.TxTrace {
+	return []*types.TxTrace{
+		{Height: height, TxHash: common.HexToHash("0x1"), Trace: json.RawMessage(`{"gas":21000}`)},
+		{Height: height, TxHash: common.HexToHash("0x2"), Trace: json.RawMessage(`"execution reverted"`)},
+	}
+}
+
+func TestFileWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	writer, err := NewFileWriter(path)
+	require.NoError(t, err)
+	require.NoError(t, writer.Write(1, testTraces(1)))
+	require.NoError(t, writer.Write(2, testTraces(2)[:1]))
+	require.NoError(t, writer.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var traces []*types.TxTrace
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var trace types.TxTrace
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &trace))
+		traces = append(traces, &trace)
+	}
+	require.Equal(t, append(testTraces(1), testTraces(2)[:1]...), traces)
+}
+
+func TestGRPCWriter(t *testing.T) {
+	received := make(chan *BlockTraces, 1)
+	server := NewTraceSinkServer(func(traces *BlockTraces) error {
+		received <- traces
+		return nil
+	})
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	writer, err := NewGRPCWriter(lis.Addr().String())
+	require.NoError(t, err)
+	defer writer.Close()
+	require.NoError(t, writer.Write(3, testTraces(3)))
+	require.Equal(t, &BlockTraces{Height: 3, Traces: testTraces(3)}, <-received)
+}
@@ -2,7 +2,9 @@ package base
 
 import (
 	"math/big"
+	"strconv"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
@@ -113,10 +115,33 @@ func (tx *Tx) EmitEvent(msg *types.MsgEthereumTx, result *Result) {
 		)
 	}
 
+	if types.GetEnableLogEvents() && result.ResultData != nil {
+		tx.Ctx.EventManager().EmitEvents(logEvents(result.ResultData.Logs))
+	}
+
 	// set the events to the result
 	result.ExecResult.Result.Events = tx.Ctx.EventManager().Events()
 }
 
+// logEvents returns an event for each log of the tx with the contract address, the topics under their positions and
+// the data of the log, the index is the position of the log in the tx
+func logEvents(logs []*ethtypes.Log) sdk.Events {
+	events := make(sdk.Events, 0, len(logs))
+	for i, log := range logs {
+		attrs := make([]sdk.Attribute, 0, len(log.Topics)+3)
+		attrs = append(attrs,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, log.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyLogIndex, strconv.Itoa(i)),
+		)
+		for j, topic := range log.Topics {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyLogTopicPrefix+strconv.Itoa(j), topic.String()))
+		}
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyLogData, hexutil.Encode(log.Data)))
+		events = append(events, sdk.NewEvent(types.EventTypeEvmLog, attrs...))
+	}
+	return events
+}
+
 func NewTx(config Config) *Tx {
 	return &Tx{
 		Ctx:    config.Ctx,
@@ -0,0 +1,37 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+func Test_logEvents(t *testing.T) {
+	contract := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	logs := []*ethtypes.Log{
+		{Address: contract, Topics: []common.Hash{common.HexToHash("0x1"), common.HexToHash("0x2")}, Data: []byte{0xab}},
+		{Address: contract},
+	}
+
+	events := logEvents(logs)
+	require.Equal(t, sdk.Events{
+		sdk.NewEvent(types.EventTypeEvmLog,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contract.String()),
+			sdk.NewAttribute(types.AttributeKeyLogIndex, "0"),
+			sdk.NewAttribute("topic0", common.HexToHash("0x1").String()),
+			sdk.NewAttribute("topic1", common.HexToHash("0x2").String()),
+			sdk.NewAttribute(types.AttributeKeyLogData, "0xab"),
+		),
+		sdk.NewEvent(types.EventTypeEvmLog,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contract.String()),
+			sdk.NewAttribute(types.AttributeKeyLogIndex, "1"),
+			sdk.NewAttribute(types.AttributeKeyLogData, "0x"),
+		),
+	}, events)
+	require.Empty(t, logEvents(nil))
+}
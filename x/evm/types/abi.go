@@ -3,9 +3,13 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"math/big"
+	"reflect"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type ABI struct {
@@ -52,3 +56,74 @@ func (a *ABI) EncodeOutput(methodName string, data []byte) ([]byte, error) {
 	}
 	return method.Outputs.PackValues([]interface{}{string(data)})
 }
+
+// DecodedCallData is the calldata of a contract call decoded with the abi of the contract
+type DecodedCallData struct {
+	Method    string       `json:"method"`
+	Signature string       `json:"signature"`
+	Args      []DecodedArg `json:"args"`
+}
+
+type DecodedArg struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// DecodeCallData decodes the calldata with the method its selector matches
+func (a *ABI) DecodeCallData(data []byte) (*DecodedCallData, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("calldata of %d bytes has no method selector", len(data))
+	}
+	method, err := a.MethodById(data[:4])
+	if err != nil {
+		return nil, err
+	}
+	values, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the args of %s: %s", method.Sig, err)
+	}
+
+	callData := &DecodedCallData{Method: method.Name, Signature: method.Sig, Args: make([]DecodedArg, len(values))}
+	for i, value := range values {
+		callData.Args[i] = DecodedArg{
+			Name:  method.Inputs[i].Name,
+			Type:  method.Inputs[i].Type.String(),
+			Value: abiValueToJSON(value),
+		}
+	}
+	return callData, nil
+}
+
+// abiValueToJSON formats the bytes as hex and the integers as decimal strings, which json can't keep precisely
+func abiValueToJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []byte:
+		return hexutil.Bytes(v)
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(bz), rv)
+			return hexutil.Bytes(bz)
+		}
+		fallthrough
+	case reflect.Slice:
+		values := make([]interface{}, rv.Len())
+		for i := range values {
+			values[i] = abiValueToJSON(rv.Index(i).Interface())
+		}
+		return values
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64:
+		return fmt.Sprint(value)
+	}
+	return value
+}
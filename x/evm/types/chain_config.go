@@ -22,8 +22,9 @@ import (
 // specify that negative Int values will be considered as nil. See getBlockValue for reference.
 //
 // NOTE 2: This type is not a configurable Param since the SDK does not allow for validation against
-// a previous stored parameter values or the current block height (retrieved from context). If you
-// want to update the config values, use an software upgrade procedure.
+// a previous stored parameter values or the current block height (retrieved from context). The inactive
+// forks can be scheduled to activate at a future height with a ScheduleChainConfigUpgradeProposal, other
+// updates of the config values need a software upgrade procedure.
 type ChainConfig struct {
 	HomesteadBlock sdk.Int `json:"homestead_block" yaml:"homestead_block"` // Homestead switch block (< 0 no fork, 0 = already homestead)
 
@@ -103,6 +104,77 @@ func DefaultChainConfig() ChainConfig {
 	}
 }
 
+// names of the forks in the chain config that governance can schedule the activation of. The DAO fork is left out,
+// since it's a one-off irregular state change of the ethereum mainnet
+const (
+	ForkHomestead      = "homestead"
+	ForkEIP150         = "eip150"
+	ForkEIP155         = "eip155"
+	ForkEIP158         = "eip158"
+	ForkByzantium      = "byzantium"
+	ForkConstantinople = "constantinople"
+	ForkPetersburg     = "petersburg"
+	ForkIstanbul       = "istanbul"
+	ForkMuirGlacier    = "muir_glacier"
+	ForkYoloV2         = "yolo_v2"
+	ForkEWASM          = "ewasm"
+)
+
+// forkBlock returns the pointer to the switch block of the fork
+func (cc *ChainConfig) forkBlock(fork string) (*sdk.Int, error) {
+	switch fork {
+	case ForkHomestead:
+		return &cc.HomesteadBlock, nil
+	case ForkEIP150:
+		return &cc.EIP150Block, nil
+	case ForkEIP155:
+		return &cc.EIP155Block, nil
+	case ForkEIP158:
+		return &cc.EIP158Block, nil
+	case ForkByzantium:
+		return &cc.ByzantiumBlock, nil
+	case ForkConstantinople:
+		return &cc.ConstantinopleBlock, nil
+	case ForkPetersburg:
+		return &cc.PetersburgBlock, nil
+	case ForkIstanbul:
+		return &cc.IstanbulBlock, nil
+	case ForkMuirGlacier:
+		return &cc.MuirGlacierBlock, nil
+	case ForkYoloV2:
+		return &cc.YoloV2Block, nil
+	case ForkEWASM:
+		return &cc.EWASMBlock, nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidChainConfigUpgrade, "unknown fork %s", fork)
+	}
+}
+
+// ForkBlock returns the switch block of the fork, negative means the fork isn't activated
+func (cc ChainConfig) ForkBlock(fork string) (sdk.Int, error) {
+	block, err := cc.forkBlock(fork)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return *block, nil
+}
+
+// SetForkBlock sets the switch block of the fork
+func (cc *ChainConfig) SetForkBlock(fork string, height int64) error {
+	block, err := cc.forkBlock(fork)
+	if err != nil {
+		return err
+	}
+	*block = sdk.NewInt(height)
+	return nil
+}
+
+// ChainConfigUpgrade is a fork of the chain config scheduled by governance to activate at the height
+type ChainConfigUpgrade struct {
+	Fork   string `json:"fork" yaml:"fork"`
+	Height int64  `json:"height" yaml:"height"`
+}
+
 func getBlockValue(block sdk.Int) *big.Int {
 	if block.IsNegative() {
 		return nil
@@ -27,6 +27,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(ManageContractBlockedListProposal{}, ManageContractBlockedListProposalName, nil)
 	cdc.RegisterConcrete(ManageContractMethodBlockedListProposal{}, "okexchain/evm/ManageContractMethodBlockedListProposal", nil)
 	cdc.RegisterConcrete(ManageSysContractAddressProposal{}, "okexchain/evm/ManageSysContractAddressProposal", nil)
+	cdc.RegisterConcrete(ScheduleChainConfigUpgradeProposal{}, "okexchain/evm/ScheduleChainConfigUpgradeProposal", nil)
+	cdc.RegisterConcrete(UpdateGasScheduleProposal{}, "okexchain/evm/UpdateGasScheduleProposal", nil)
+	cdc.RegisterConcrete(UpdateMaxGasLimitPerTxProposal{}, "okexchain/evm/UpdateMaxGasLimitPerTxProposal", nil)
+	cdc.RegisterConcrete(MsgHandleUserOps{}, "okexchain/evm/MsgHandleUserOps", nil)
 
 	cdc.RegisterConcreteUnmarshaller(ChainConfigName, func(c *amino.Codec, bytes []byte) (interface{}, int, error) {
 		var cc ChainConfig
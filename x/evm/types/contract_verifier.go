@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/vm"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 	"math/big"
 )
 
@@ -19,8 +20,9 @@ func NewContractVerifier(params Params) *ContractVerifier {
 	return &ContractVerifier{params: params}
 }
 
-// Verify check the contract whether is blocked.
-// It never return error,because in this chain if it blocked, not allow to execute next opCode.In Ethereum call failed,the call err is deal in contract code ether evm.
+// Verify check the contract whether is blocked or hibernated.
+// It never return error for the blocked contract,because in this chain if it blocked, not allow to execute next opCode.In Ethereum call failed,the call err is deal in contract code ether evm.
+// The call to a hibernated contract returns an error instead, so it fails as a reverted call the caller can handle.
 // If current call/delegatecall/callcode contract method is blocked,it will be panic,then it's deal logic at defer{recover}.
 // If contract all method blocked,it will not be panic in Verify. it will be panic in stateDb.GetCode().
 func (cv ContractVerifier) Verify(stateDB vm.StateDB, op vm.OpCode, from, to common.Address, input []byte, value *big.Int) error {
@@ -29,6 +31,9 @@ func (cv ContractVerifier) Verify(stateDB vm.StateDB, op vm.OpCode, from, to com
 	if !ok {
 		panic(ErrContractBlockedVerify{"unknown stateDB expected CommitStateDB"})
 	}
+	if op != vm.SELFDESTRUCT && csdb.IsContractHibernated(to) {
+		return sdkerrors.Wrapf(ErrContractHibernated, "%s", to.String())
+	}
 	//check whether contract has been blocked
 	if !cv.params.EnableContractBlockedList {
 		return nil
@@ -57,6 +57,33 @@ var (
 	// ErrEmptyAddressBlockedContract returns an error if the contract method is empty
 	ErrEmptyAddressBlockedContract = sdkerrors.Register(ModuleName, 19, "Empty address in contract method blocked list is not allowed")
 
+	// ErrMaxInitCodeSizeExceeded returns an error if the initcode of a contract creation tx exceeds the max initcode size
+	ErrMaxInitCodeSizeExceeded = sdkerrors.Register(ModuleName, 24, "max initcode size exceeded")
+
+	// ErrUserOpSender returns an error if the sender of a user operation isn't a contract account
+	ErrUserOpSender = sdkerrors.Register(ModuleName, 25, "invalid user operation sender")
+
+	// ErrUserOpNonce returns an error if the nonce of a user operation isn't the next one of the contract account
+	ErrUserOpNonce = sdkerrors.Register(ModuleName, 26, "invalid user operation nonce")
+
+	// ErrUserOpPrefund returns an error if the contract account can't pay for its user operation
+	ErrUserOpPrefund = sdkerrors.Register(ModuleName, 27, "insufficient user operation prefund")
+
+	// ErrUserOpValidation returns an error if the contract account fails to validate its user operation
+	ErrUserOpValidation = sdkerrors.Register(ModuleName, 28, "user operation validation failed")
+
+	// ErrInvalidMetaTx returns an error if the meta transaction relayed to the forwarder is invalid
+	ErrInvalidMetaTx = sdkerrors.Register(ModuleName, 29, "invalid meta transaction")
+
+	// ErrInvalidChainConfigUpgrade returns an error if a scheduled upgrade of the chain config is invalid
+	ErrInvalidChainConfigUpgrade = sdkerrors.Register(ModuleName, 30, "invalid chain config upgrade")
+
+	// ErrInvalidGasScheduleUpgrade returns an error if a scheduled update of the gas schedule is invalid
+	ErrInvalidGasScheduleUpgrade = sdkerrors.Register(ModuleName, 31, "invalid gas schedule upgrade")
+
+	// ErrContractHibernated returns an error if a hibernated contract is called
+	ErrContractHibernated = sdkerrors.Register(ModuleName, 32, "contract is hibernated")
+
 	CodeSpaceEvmCallFailed = uint32(7)
 
 	ErrorHexData = "HexData"
@@ -135,6 +162,12 @@ func ErrCodeProposerMustBeValidator() sdk.Error {
 	return sdkerrors.New(DefaultCodespace, 23, "the proposal of proposer must be validator")
 }
 
+// ErrContractDeploymentRestricted is panicked with when a contract is created in the tx of a deployer out of the
+// contract deployment whitelist
+type ErrContractDeploymentRestricted struct {
+	Deployer sdk.AccAddress
+}
+
 type ErrContractBlockedVerify struct {
 	Descriptor string
 }
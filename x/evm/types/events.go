@@ -1,10 +1,46 @@
 package types
 
+import (
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
 // Evm module events
 const (
-	EventTypeEthereumTx = TypeMsgEthereumTx
+	EventTypeEthereumTx    = TypeMsgEthereumTx
+	EventTypeUserOperation = "user_operation"
+	EventTypeEvmLog        = "evm_log"
 
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
 	AttributeValueCategory      = ModuleName
+
+	AttributeKeyLogIndex       = "index"
+	AttributeKeyLogData        = "data"
+	AttributeKeyLogTopicPrefix = "topic"
+
+	AttributeKeyUserOpSender  = "sender"
+	AttributeKeyUserOpNonce   = "nonce"
+	AttributeKeyUserOpHash    = "user_op_hash"
+	AttributeKeyUserOpSuccess = "success"
+	AttributeKeyUserOpGasUsed = "gas_used"
+	AttributeKeyUserOpFee     = "fee"
+	AttributeKeyUserOpError   = "error"
 )
+
+const FlagEnableLogEvents = "evm.log-events"
+
+var (
+	enableLogEvents     bool
+	onceEnableLogEvents sync.Once
+)
+
+// GetEnableLogEvents returns whether the logs of the evm txs are emitted as events, so the subscribers of the events
+// and the tx indexer of tendermint filter the logs. The events don't change the consensus results of the txs.
+func GetEnableLogEvents() bool {
+	onceEnableLogEvents.Do(func() {
+		enableLogEvents = viper.GetBool(FlagEnableLogEvents)
+	})
+	return enableLogEvents
+}
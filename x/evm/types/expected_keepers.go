@@ -25,11 +25,15 @@ type SupplyKeeper interface {
 type Subspace interface {
 	GetParamSet(ctx sdk.Context, ps params.ParamSet)
 	SetParamSet(ctx sdk.Context, ps params.ParamSet)
+	Has(ctx sdk.Context, key []byte) bool
+	Get(ctx sdk.Context, key []byte, ptr interface{})
+	Set(ctx sdk.Context, key []byte, value interface{})
 	CustomKVStore(ctx sdk.Context) sdk.KVStore
 }
 
 type BankKeeper interface {
 	BlacklistedAddr(addr sdk.AccAddress) bool
+	ApplySendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error)
 }
 
 // StakingKeeper for validator verify
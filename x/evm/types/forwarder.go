@@ -0,0 +1,194 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// ForwarderName is the name the forwarder address is derived from
+	ForwarderName = "evm-forwarder"
+
+	// ForwarderABIJSON is the abi of the method a sponsor calls the forwarder with to relay a meta transaction
+	ForwarderABIJSON       = `[{"inputs":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"gas","type":"uint256"},{"internalType":"uint256","name":"nonce","type":"uint256"},{"internalType":"uint256","name":"deadline","type":"uint256"},{"internalType":"bytes","name":"data","type":"bytes"},{"internalType":"bytes","name":"signature","type":"bytes"}],"name":"execute","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+	ForwarderExecuteMethod = "execute"
+)
+
+var (
+	// ForwarderAddress is the canonical trusted forwarder of ERC-2771. The txs calling it are handled natively:
+	// the meta transaction in the input is verified against the signature and the nonce of its signer, then the
+	// target is called from the forwarder with the signer appended to the call data. The contracts calling the
+	// forwarder aren't forwarded, as the forwarder has no code.
+	ForwarderAddress common.Address
+
+	ForwarderABI *ABI
+)
+
+func init() {
+	ForwarderAddress = common.BytesToAddress(authtypes.NewModuleAddress(ForwarderName).Bytes())
+
+	var err error
+	if ForwarderABI, err = NewABI(ForwarderABIJSON); err != nil {
+		panic(err)
+	}
+}
+
+// MetaTransaction is a call signed by the user and relayed by a sponsor, who pays for the gas
+type MetaTransaction struct {
+	From common.Address
+	To   common.Address
+	Gas  uint64
+	// sequential nonce of the signer, tracked by the forwarder
+	Nonce uint64
+	// unix time in seconds after which the meta transaction expires, zero means never
+	Deadline uint64
+	Data     []byte
+}
+
+// Hash returns the hash signed by the user, which commits to the forwarder and the chain id, so that the signature
+// can't be replayed elsewhere
+func (mtx MetaTransaction) Hash(chainID *big.Int) common.Hash {
+	word := func(b []byte) []byte {
+		return common.LeftPadBytes(b, 32)
+	}
+	return crypto.Keccak256Hash(
+		word(ForwarderAddress.Bytes()),
+		word(chainID.Bytes()),
+		word(mtx.From.Bytes()),
+		word(mtx.To.Bytes()),
+		word(new(big.Int).SetUint64(mtx.Gas).Bytes()),
+		word(new(big.Int).SetUint64(mtx.Nonce).Bytes()),
+		word(new(big.Int).SetUint64(mtx.Deadline).Bytes()),
+		crypto.Keccak256(mtx.Data),
+	)
+}
+
+// Sign signs the meta transaction with the key as eth_sign does with the hash of it
+func (mtx MetaTransaction) Sign(chainID *big.Int, key *ecdsa.PrivateKey) ([]byte, error) {
+	hash := mtx.Hash(chainID)
+	sig, err := crypto.Sign(accounts.TextHash(hash.Bytes()), key)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// VerifySignature checks the signature is signed by the signer of the meta transaction with eth_sign
+func (mtx MetaTransaction) VerifySignature(chainID *big.Int, sig []byte) error {
+	if len(sig) != crypto.SignatureLength {
+		return sdkerrors.Wrapf(ErrInvalidMetaTx, "invalid signature length %d", len(sig))
+	}
+	sig = common.CopyBytes(sig)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	hash := mtx.Hash(chainID)
+	pubKey, err := crypto.SigToPub(accounts.TextHash(hash.Bytes()), sig)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidMetaTx, "invalid signature: %s", err)
+	}
+	if signer := crypto.PubkeyToAddress(*pubKey); !bytes.Equal(signer.Bytes(), mtx.From.Bytes()) {
+		return sdkerrors.Wrapf(ErrInvalidMetaTx, "signed by %s instead of %s", signer, mtx.From)
+	}
+	return nil
+}
+
+// ForwardedData returns the data the target is called with, which is appended with the signer as ERC-2771 specifies
+func (mtx MetaTransaction) ForwardedData() []byte {
+	data := make([]byte, 0, len(mtx.Data)+common.AddressLength)
+	data = append(data, mtx.Data...)
+	return append(data, mtx.From.Bytes()...)
+}
+
+// GetForwarderInput returns the input of the tx relaying the signed meta transaction
+func GetForwarderInput(mtx MetaTransaction, sig []byte) ([]byte, error) {
+	return ForwarderABI.Pack(ForwarderExecuteMethod, mtx.From, mtx.To, new(big.Int).SetUint64(mtx.Gas),
+		new(big.Int).SetUint64(mtx.Nonce), new(big.Int).SetUint64(mtx.Deadline), mtx.Data, sig)
+}
+
+// ParseForwarderInput returns the meta transaction and its signature from the input of the tx relaying it
+func ParseForwarderInput(input []byte) (mtx MetaTransaction, sig []byte, err error) {
+	if !ForwarderABI.IsMatchFunction(ForwarderExecuteMethod, input) {
+		return mtx, nil, sdkerrors.Wrap(ErrInvalidMetaTx, "unknown method of the forwarder")
+	}
+	res, err := ForwarderABI.DecodeInputParam(ForwarderExecuteMethod, input)
+	if err != nil {
+		return mtx, nil, sdkerrors.Wrap(ErrInvalidMetaTx, err.Error())
+	}
+
+	toUint64 := func(i interface{}) (uint64, error) {
+		n := i.(*big.Int)
+		if !n.IsUint64() {
+			return 0, sdkerrors.Wrapf(ErrInvalidMetaTx, "%s overflows uint64", n)
+		}
+		return n.Uint64(), nil
+	}
+	mtx.From, mtx.To = res[0].(common.Address), res[1].(common.Address)
+	if mtx.Gas, err = toUint64(res[2]); err != nil {
+		return mtx, nil, err
+	}
+	if mtx.Nonce, err = toUint64(res[3]); err != nil {
+		return mtx, nil, err
+	}
+	if mtx.Deadline, err = toUint64(res[4]); err != nil {
+		return mtx, nil, err
+	}
+	mtx.Data = res[5].([]byte)
+	return mtx, res[6].([]byte), nil
+}
+
+// forwarderNonceKey returns the storage slot of the forwarder where the nonce of the signer is kept
+func forwarderNonceKey(signer common.Address) common.Hash {
+	return crypto.Keccak256Hash(signer.Bytes())
+}
+
+// GetForwarderNonce returns the nonce of the next meta transaction of the signer
+func GetForwarderNonce(csdb *CommitStateDB, signer common.Address) uint64 {
+	return csdb.GetState(ForwarderAddress, forwarderNonceKey(signer)).Big().Uint64()
+}
+
+func setForwarderNonce(csdb *CommitStateDB, signer common.Address, nonce uint64) {
+	// the forwarder is kept non-empty, otherwise it's deleted with its storage as an empty account
+	if csdb.GetNonce(ForwarderAddress) == 0 {
+		csdb.SetNonce(ForwarderAddress, 1)
+	}
+	csdb.SetState(ForwarderAddress, forwarderNonceKey(signer), common.BigToHash(new(big.Int).SetUint64(nonce)))
+}
+
+// forward relays the meta transaction in the input of the tx calling the forwarder, it returns the gas left of
+// gasLimit as evm.Call does
+func (st *StateTransition) forward(ctx sdk.Context, csdb *CommitStateDB, evm *vm.EVM, gasLimit uint64) ([]byte, uint64, error) {
+	if st.Amount != nil && st.Amount.Sign() != 0 {
+		return nil, gasLimit, sdkerrors.Wrap(ErrInvalidMetaTx, "the forwarder can't receive value")
+	}
+	mtx, sig, err := ParseForwarderInput(st.Payload)
+	if err != nil {
+		return nil, gasLimit, err
+	}
+	if err := mtx.VerifySignature(st.ChainID, sig); err != nil {
+		return nil, gasLimit, err
+	}
+	if nonce := GetForwarderNonce(csdb, mtx.From); mtx.Nonce != nonce {
+		return nil, gasLimit, sdkerrors.Wrapf(ErrInvalidMetaTx, "invalid nonce, expected %d, got %d", nonce, mtx.Nonce)
+	}
+	if mtx.Deadline != 0 && uint64(ctx.BlockTime().Unix()) > mtx.Deadline {
+		return nil, gasLimit, sdkerrors.Wrapf(ErrInvalidMetaTx, "expired at %d", mtx.Deadline)
+	}
+	if mtx.Gas > gasLimit {
+		return nil, gasLimit, sdkerrors.Wrapf(ErrInvalidMetaTx, "gas %d exceeds the gas left %d", mtx.Gas, gasLimit)
+	}
+	setForwarderNonce(csdb, mtx.From, mtx.Nonce+1)
+
+	ret, leftOverGas, err := evm.Call(vm.AccountRef(ForwarderAddress), mtx.To, mtx.ForwardedData(), mtx.Gas, big.NewInt(0))
+	return ret, gasLimit - mtx.Gas + leftOverGas, err
+}
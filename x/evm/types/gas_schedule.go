@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// MaxExecutionGasMultiplier is the ceiling of the multiplier of the gas consumed by the evm execution
+	MaxExecutionGasMultiplier = 10
+	// MinRefundQuotient is the smallest quotient of the refund cap, the refund of the evm is at most half of the gas used
+	MinRefundQuotient = 2
+)
+
+// GasSchedule tunes the gas consumed by the evm execution of the txs. It's updated by governance at the height of a
+// proposal, see UpdateGasScheduleProposal.
+type GasSchedule struct {
+	// ExecutionGasMultiplier scales the gas of the evm execution, i.e. the costs of the opcodes and the calls. The evm
+	// runs with the gas limit of the tx divided by the multiplier, and the gas it uses is multiplied back.
+	ExecutionGasMultiplier sdk.Dec `json:"execution_gas_multiplier" yaml:"execution_gas_multiplier"`
+	// RefundQuotient enables the refund of the gas of the cleared storage slots (SSTORE refunds), capped to the gas
+	// used divided by the quotient as EIP-3529 does. The refunds are disabled if it's 0.
+	RefundQuotient uint64 `json:"refund_quotient" yaml:"refund_quotient"`
+}
+
+// DefaultGasSchedule returns the gas schedule of the evm without the governance overrides
+func DefaultGasSchedule() GasSchedule {
+	return GasSchedule{
+		ExecutionGasMultiplier: sdk.OneDec(),
+	}
+}
+
+// Validate validates the gas schedule
+func (gs GasSchedule) Validate() error {
+	if gs.ExecutionGasMultiplier.IsNil() || !gs.ExecutionGasMultiplier.IsPositive() {
+		return fmt.Errorf("execution gas multiplier must be positive")
+	}
+	if gs.ExecutionGasMultiplier.GT(sdk.NewDec(MaxExecutionGasMultiplier)) {
+		return fmt.Errorf("execution gas multiplier must not be larger than %d: %s", MaxExecutionGasMultiplier, gs.ExecutionGasMultiplier)
+	}
+	if gs.RefundQuotient != 0 && gs.RefundQuotient < MinRefundQuotient {
+		return fmt.Errorf("refund quotient must be 0 or at least %d: %d", MinRefundQuotient, gs.RefundQuotient)
+	}
+	return nil
+}
+
+// EVMGasLimit returns the gas limit of the evm execution of a tx left with gasLimit
+func (gs GasSchedule) EVMGasLimit(gasLimit uint64) uint64 {
+	if gs.ExecutionGasMultiplier.Equal(sdk.OneDec()) {
+		return gasLimit
+	}
+	return sdk.NewDecFromBigInt(new(big.Int).SetUint64(gasLimit)).Quo(gs.ExecutionGasMultiplier).TruncateInt().Uint64()
+}
+
+// GasConsumed returns the gas consumed by the evm execution of a tx left with gasLimit, whose evm execution was left
+// with evmLeftOverGas of evmGasLimit and accumulated the refund. The whole gasLimit is consumed if the evm ran out of
+// gas.
+func (gs GasSchedule) GasConsumed(gasLimit, evmGasLimit, evmLeftOverGas, refund uint64) uint64 {
+	if evmLeftOverGas == 0 {
+		return gasLimit
+	}
+	evmGasUsed := evmGasLimit - evmLeftOverGas
+	if gs.RefundQuotient > 0 {
+		if maxRefund := evmGasUsed / gs.RefundQuotient; refund > maxRefund {
+			refund = maxRefund
+		}
+		evmGasUsed -= refund
+	}
+	if gs.ExecutionGasMultiplier.Equal(sdk.OneDec()) {
+		return evmGasUsed
+	}
+	gas := gs.ExecutionGasMultiplier.MulInt(sdk.NewIntFromUint64(evmGasUsed)).Ceil().TruncateInt()
+	if !gas.IsUint64() || gas.Uint64() > gasLimit {
+		return gasLimit
+	}
+	return gas.Uint64()
+}
+
+func (gs GasSchedule) String() string {
+	return fmt.Sprintf(`GasSchedule:
+  ExecutionGasMultiplier: %s
+  RefundQuotient:         %d`, gs.ExecutionGasMultiplier, gs.RefundQuotient)
+}
+
+// GasScheduleUpgrade is a gas schedule scheduled by governance to apply from the height
+type GasScheduleUpgrade struct {
+	Schedule GasSchedule `json:"schedule" yaml:"schedule"`
+	Height   int64       `json:"height" yaml:"height"`
+}
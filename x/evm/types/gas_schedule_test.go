@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+func TestGasScheduleValidate(t *testing.T) {
+	require.NoError(t, DefaultGasSchedule().Validate())
+	require.NoError(t, GasSchedule{ExecutionGasMultiplier: sdk.NewDecWithPrec(5, 1), RefundQuotient: 5}.Validate())
+	require.Error(t, GasSchedule{}.Validate())
+	require.Error(t, GasSchedule{ExecutionGasMultiplier: sdk.ZeroDec()}.Validate())
+	require.Error(t, GasSchedule{ExecutionGasMultiplier: sdk.NewDec(MaxExecutionGasMultiplier + 1)}.Validate())
+	require.Error(t, GasSchedule{ExecutionGasMultiplier: sdk.OneDec(), RefundQuotient: 1}.Validate())
+}
+
+func TestGasScheduleGasConsumed(t *testing.T) {
+	testCases := []struct {
+		name           string
+		schedule       GasSchedule
+		gasLimit       uint64
+		evmGasLimit    uint64
+		evmLeftOverGas uint64
+		refund         uint64
+		gasConsumed    uint64
+	}{
+		{"default", DefaultGasSchedule(), 1000, 1000, 400, 100, 600},
+		{"out of gas", DefaultGasSchedule(), 1000, 1000, 0, 0, 1000},
+		{"refund under the cap", GasSchedule{sdk.OneDec(), 5}, 1000, 1000, 400, 100, 500},
+		{"refund over the cap", GasSchedule{sdk.OneDec(), 5}, 1000, 1000, 400, 200, 480},
+		{"doubled", GasSchedule{sdk.NewDec(2), 0}, 1000, 500, 200, 0, 600},
+		{"doubled with refund", GasSchedule{sdk.NewDec(2), 2}, 1000, 500, 200, 100, 400},
+		{"rounded up", GasSchedule{sdk.NewDecWithPrec(15, 1), 0}, 1000, 666, 665, 0, 2},
+		{"halved", GasSchedule{sdk.NewDecWithPrec(5, 1), 0}, 1000, 2000, 1000, 0, 500},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.evmGasLimit, tc.schedule.EVMGasLimit(tc.gasLimit))
+			require.Equal(t, tc.gasConsumed, tc.schedule.GasConsumed(tc.gasLimit, tc.evmGasLimit, tc.evmLeftOverGas, tc.refund))
+		})
+	}
+}
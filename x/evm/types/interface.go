@@ -15,6 +15,11 @@ type EvmHooks interface {
 	PostTxProcessing(ctx sdk.Context, st *StateTransition, receipt *ethtypes.Receipt) error
 }
 
+// ContractHibernation tells whether a contract is hibernated, the calls to a hibernated contract fail
+type ContractHibernation interface {
+	IsContractHibernated(ctx sdk.Context, contract common.Address) bool
+}
+
 // EvmLogHandler defines the interface for evm log handler
 type EvmLogHandler interface {
 	// EventID Return the id of the log signature it handles
@@ -32,10 +32,23 @@ var (
 	KeyPrefixContractDeploymentWhitelist = []byte{0x08}
 	KeyPrefixContractBlockedList         = []byte{0x09}
 	KeyPrefixSysContractAddress          = []byte{0x10}
+	KeyPrefixUserOpNonce                 = []byte{0x11}
+	KeyPrefixChainConfigUpgrade          = []byte{0x12}
+	KeyPrefixRecentBlockHash             = []byte{0x13}
+	KeyPrefixGasScheduleUpgrade          = []byte{0x14}
 
 	KeyPrefixEvmRootHash = []byte("evmRootHash")
 )
 
+// RecentBlockHashesSize is the size of the ring buffer of the recent block hashes, the BLOCKHASH opcode only returns
+// the hashes of the 256 latest blocks
+const RecentBlockHashesSize = 256
+
+// GetRecentBlockHashKey returns the key of the slot of the height in the ring buffer of the recent block hashes
+func GetRecentBlockHashKey(height uint64) []byte {
+	return append(KeyPrefixRecentBlockHash, byte(height%RecentBlockHashesSize))
+}
+
 // HeightHashKey returns the key for the given chain epoch and height.
 // The key will be composed in the following order:
 //   key = prefix + bytes(height)
@@ -79,3 +92,34 @@ func splitBlockedContractAddress(key []byte) sdk.AccAddress {
 func GetSysContractAddressKey() []byte {
 	return append(KeyPrefixSysContractAddress, []byte(SysContractAddressKey)...)
 }
+
+// GetUserOpNonceKey builds the key for the user operation nonce of a contract account
+func GetUserOpNonceKey(addr ethcmn.Address) []byte {
+	return append(KeyPrefixUserOpNonce, addr.Bytes()...)
+}
+
+// GetChainConfigUpgradeHeightPrefix builds the prefix of the chain config upgrades scheduled at the height
+func GetChainConfigUpgradeHeightPrefix(height int64) []byte {
+	return append(KeyPrefixChainConfigUpgrade, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetChainConfigUpgradeKey builds the key for the fork of the chain config scheduled at the height
+func GetChainConfigUpgradeKey(height int64, fork string) []byte {
+	return append(GetChainConfigUpgradeHeightPrefix(height), []byte(fork)...)
+}
+
+// SplitChainConfigUpgradeKey splits the key of a scheduled chain config upgrade into the height and the fork
+func SplitChainConfigUpgradeKey(key []byte) (int64, string) {
+	key = key[len(KeyPrefixChainConfigUpgrade):]
+	return int64(sdk.BigEndianToUint64(key[:8])), string(key[8:])
+}
+
+// GetGasScheduleUpgradeKey builds the key for the gas schedule scheduled at the height
+func GetGasScheduleUpgradeKey(height int64) []byte {
+	return append(KeyPrefixGasScheduleUpgrade, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// SplitGasScheduleUpgradeKey returns the height of the key of a scheduled gas schedule
+func SplitGasScheduleUpgradeKey(key []byte) int64 {
+	return int64(sdk.BigEndianToUint64(key[len(KeyPrefixGasScheduleUpgrade):]))
+}
@@ -5,6 +5,9 @@ import (
 
 	ethcmn "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/store/mpt"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 )
 
 const (
@@ -23,6 +26,7 @@ var (
  * KeyPrefixStorage           not stored in db directly
  * KeyPrefixChainConfig       = []byte{0x06}
  * KeyPrefixHeightHash        = []byte{0x07}
+ * KeyPrefixEvmDestructedStorage = []byte{0x15}
  *
  * Below are functions used for setting in DiskDB
  */
@@ -45,6 +49,15 @@ func AppendHeightHashKey(height uint64) []byte {
 	return append(KeyPrefixHeightHash, HeightHashKey(height)...)
 }
 
+// AppendDestructedStorageKey returns the key of the storage root of a contract destructed at the height, the keys are
+// ordered by height
+func AppendDestructedStorageKey(height uint64, addr ethcmn.Address) []byte {
+	key := make([]byte, 0, len(mpt.KeyPrefixEvmDestructedStorage)+Uint64Length+ethcmn.AddressLength)
+	key = append(key, mpt.KeyPrefixEvmDestructedStorage...)
+	key = append(key, sdk.Uint64ToBigEndian(height)...)
+	return append(key, addr.Bytes()...)
+}
+
 /*
  * Split
  */
@@ -52,6 +65,11 @@ func SplitUpgradedCodeHashKey(key []byte) []byte {
 	return key[len(UpgradedKeyPrefixCode):]
 }
 
+func SplitDestructedStorageKey(key []byte) (height uint64, addr ethcmn.Address) {
+	key = key[len(mpt.KeyPrefixEvmDestructedStorage):]
+	return sdk.BigEndianToUint64(key[:Uint64Length]), ethcmn.BytesToAddress(key[Uint64Length:])
+}
+
 /*
  * IsKey
  */
@@ -70,6 +88,11 @@ func IsCodeHashKey(key []byte) bool {
 		len(key) == (len(UpgradedKeyPrefixCode)+ethcmn.HashLength)
 }
 
+func IsDestructedStorageKey(key []byte) bool {
+	return bytes.HasPrefix(key, mpt.KeyPrefixEvmDestructedStorage) &&
+		len(key) == (len(mpt.KeyPrefixEvmDestructedStorage)+Uint64Length+ethcmn.AddressLength)
+}
+
 func IsHeightHashKey(key []byte) bool {
 	return bytes.HasPrefix(key, KeyPrefixHeightHash) &&
 		len(key) == (len(KeyPrefixHeightHash)+Uint64Length)
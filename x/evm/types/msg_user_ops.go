@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = MsgHandleUserOps{}
+
+const TypeMsgHandleUserOps = "handle_user_ops"
+
+// MsgHandleUserOps is submitted by a bundler to validate and execute the user operations of the contract accounts,
+// the bundler pays the fee of the tx, and is reimbursed by the contract accounts
+type MsgHandleUserOps struct {
+	// bech32 address of the bundler
+	Bundler string          `json:"bundler"`
+	Ops     []UserOperation `json:"ops"`
+}
+
+// NewMsgHandleUserOps creates new instance of MsgHandleUserOps
+func NewMsgHandleUserOps(bundler sdk.AccAddress, ops []UserOperation) MsgHandleUserOps {
+	return MsgHandleUserOps{
+		Bundler: bundler.String(),
+		Ops:     ops,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgHandleUserOps) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgHandleUserOps) Type() string { return TypeMsgHandleUserOps }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgHandleUserOps) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bundler); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid bundler address %s", msg.Bundler)
+	}
+
+	if len(msg.Ops) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no user operation")
+	}
+	if len(msg.Ops) > MaxUserOpsPerMsg {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "too many user operations, the limit is %d", MaxUserOpsPerMsg)
+	}
+
+	for i, op := range msg.Ops {
+		if err := op.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, fmt.Sprintf("user operation %d", i))
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgHandleUserOps) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgHandleUserOps) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Bundler)}
+}
@@ -6,7 +6,9 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/ethereum/go-ethereum/core/vm"
+	ethparams "github.com/ethereum/go-ethereum/params"
 
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/params"
 )
 
@@ -14,6 +16,11 @@ const (
 	// DefaultParamspace for params keeper
 	DefaultParamspace       = ModuleName
 	defaultMaxGasLimitPerTx = 30000000
+
+	// MaxCodeSizeCeiling is the ceiling of the max code size param, which is the code size limit of the evm itself
+	MaxCodeSizeCeiling = ethparams.MaxCodeSize
+	// MaxInitCodeSizeCeiling is the ceiling of the max initcode size param, which is the limit of EIP-3860
+	MaxInitCodeSizeCeiling = 2 * MaxCodeSizeCeiling
 )
 
 // Parameter keys
@@ -24,11 +31,48 @@ var (
 	ParamStoreKeyContractDeploymentWhitelist = []byte("EnableContractDeploymentWhitelist")
 	ParamStoreKeyContractBlockedList         = []byte("EnableContractBlockedList")
 	ParamStoreKeyMaxGasLimitPerTx            = []byte("MaxGasLimitPerTx")
+	ParamStoreKeyMaxCodeSize                 = []byte("MaxCodeSize")
+	ParamStoreKeyMaxInitCodeSize             = []byte("MaxInitCodeSize")
+	ParamStoreKeyGasSchedule                 = []byte("GasSchedule")
 )
 
-// ParamKeyTable returns the parameter key table.
+// ParamKeyTable returns the parameter key table. The code size limits and the gas schedule aren't part of Params,
+// they're absent on the existing chains until they're set by governance.
 func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable().RegisterParamSet(&Params{})
+	return params.NewKeyTable().RegisterParamSet(&Params{}).
+		RegisterType(params.NewParamSetPair(ParamStoreKeyMaxCodeSize, new(uint64), validateMaxCodeSize)).
+		RegisterType(params.NewParamSetPair(ParamStoreKeyMaxInitCodeSize, new(uint64), validateMaxInitCodeSize)).
+		RegisterType(params.NewParamSetPair(ParamStoreKeyGasSchedule, &GasSchedule{}, validateGasSchedule))
+}
+
+// GetMaxCodeSize returns the max size of the code of the contracts deployed by txs, which is the limit of the evm
+// until it's lowered by governance
+func GetMaxCodeSize(ctx sdk.Context, space Subspace) uint64 {
+	size := uint64(MaxCodeSizeCeiling)
+	if space.Has(ctx, ParamStoreKeyMaxCodeSize) {
+		space.Get(ctx, ParamStoreKeyMaxCodeSize, &size)
+	}
+	return size
+}
+
+// GetMaxInitCodeSize returns the max size of the initcode of the contract creation txs, 0 means no limit
+func GetMaxInitCodeSize(ctx sdk.Context, space Subspace) uint64 {
+	var size uint64
+	if space.Has(ctx, ParamStoreKeyMaxInitCodeSize) {
+		space.Get(ctx, ParamStoreKeyMaxInitCodeSize, &size)
+	}
+	return size
+}
+
+// GetGasSchedule returns the gas schedule of the evm execution, which is the default one until it's updated by
+// governance
+func GetGasSchedule(ctx sdk.Context, space Subspace) GasSchedule {
+	if !space.Has(ctx, ParamStoreKeyGasSchedule) {
+		return DefaultGasSchedule()
+	}
+	var schedule GasSchedule
+	space.Get(ctx, ParamStoreKeyGasSchedule, &schedule)
+	return schedule
 }
 
 // Params defines the EVM module parameters
@@ -39,7 +83,8 @@ type Params struct {
 	EnableCall bool `json:"enable_call" yaml:"enable_call"`
 	// ExtraEIPs defines the additional EIPs for the vm.Config
 	ExtraEIPs []int `json:"extra_eips" yaml:"extra_eips"`
-	// EnableContractDeploymentWhitelist controls the authorization of contract deployer
+	// EnableContractDeploymentWhitelist controls the authorization of contract deployer, which covers the contracts
+	// created by CREATE/CREATE2 in the txs of the deployer as well
 	EnableContractDeploymentWhitelist bool `json:"enable_contract_deployment_whitelist" yaml:"enable_contract_deployment_whitelist"`
 	// EnableContractBlockedList controls the availability of contracts
 	EnableContractBlockedList bool `json:"enable_contract_blocked_list" yaml:"enable_contract_blocked_list"`
@@ -125,3 +170,33 @@ func validateUint64(i interface{}) error {
 	}
 	return nil
 }
+
+func validateMaxCodeSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 || v > MaxCodeSizeCeiling {
+		return fmt.Errorf("max code size must be in (0, %d]: %d", MaxCodeSizeCeiling, v)
+	}
+	return nil
+}
+
+func validateMaxInitCodeSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > MaxInitCodeSizeCeiling {
+		return fmt.Errorf("max initcode size must not be larger than %d: %d", MaxInitCodeSizeCeiling, v)
+	}
+	return nil
+}
+
+func validateGasSchedule(i interface{}) error {
+	v, ok := i.(GasSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	ethparams "github.com/ethereum/go-ethereum/params"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/libs/tendermint/global"
 	"github.com/okex/exchain/libs/tendermint/types"
@@ -20,6 +21,12 @@ const (
 	proposalTypeManageContractMethodBlockedList = "ManageContractMethodBlockedList"
 	// proposalTypeManageSysContractAddress defines the type for a ManageSysContractAddress
 	proposalTypeManageSysContractAddress = "ManageSysContractAddress"
+	// proposalTypeScheduleChainConfigUpgrade defines the type for a ScheduleChainConfigUpgrade
+	proposalTypeScheduleChainConfigUpgrade = "ScheduleChainConfigUpgrade"
+	// proposalTypeUpdateGasSchedule defines the type for a UpdateGasSchedule
+	proposalTypeUpdateGasSchedule = "UpdateGasSchedule"
+	// proposalTypeUpdateMaxGasLimitPerTx defines the type for a UpdateMaxGasLimitPerTx
+	proposalTypeUpdateMaxGasLimitPerTx = "UpdateMaxGasLimitPerTx"
 )
 
 func init() {
@@ -27,10 +34,16 @@ func init() {
 	govtypes.RegisterProposalType(proposalTypeManageContractBlockedList)
 	govtypes.RegisterProposalType(proposalTypeManageContractMethodBlockedList)
 	govtypes.RegisterProposalType(proposalTypeManageSysContractAddress)
+	govtypes.RegisterProposalType(proposalTypeScheduleChainConfigUpgrade)
+	govtypes.RegisterProposalType(proposalTypeUpdateGasSchedule)
+	govtypes.RegisterProposalType(proposalTypeUpdateMaxGasLimitPerTx)
 	govtypes.RegisterProposalTypeCodec(ManageContractDeploymentWhitelistProposal{}, "okexchain/evm/ManageContractDeploymentWhitelistProposal")
 	govtypes.RegisterProposalTypeCodec(ManageContractBlockedListProposal{}, "okexchain/evm/ManageContractBlockedListProposal")
 	govtypes.RegisterProposalTypeCodec(ManageContractMethodBlockedListProposal{}, "okexchain/evm/ManageContractMethodBlockedListProposal")
 	govtypes.RegisterProposalTypeCodec(ManageSysContractAddressProposal{}, "okexchain/evm/ManageSysContractAddressProposal")
+	govtypes.RegisterProposalTypeCodec(ScheduleChainConfigUpgradeProposal{}, "okexchain/evm/ScheduleChainConfigUpgradeProposal")
+	govtypes.RegisterProposalTypeCodec(UpdateGasScheduleProposal{}, "okexchain/evm/UpdateGasScheduleProposal")
+	govtypes.RegisterProposalTypeCodec(UpdateMaxGasLimitPerTxProposal{}, "okexchain/evm/UpdateMaxGasLimitPerTxProposal")
 }
 
 var (
@@ -38,6 +51,9 @@ var (
 	_ govtypes.Content = (*ManageContractBlockedListProposal)(nil)
 	_ govtypes.Content = (*ManageContractMethodBlockedListProposal)(nil)
 	_ govtypes.Content = (*ManageSysContractAddressProposal)(nil)
+	_ govtypes.Content = (*ScheduleChainConfigUpgradeProposal)(nil)
+	_ govtypes.Content = (*UpdateGasScheduleProposal)(nil)
+	_ govtypes.Content = (*UpdateMaxGasLimitPerTxProposal)(nil)
 )
 
 // ManageContractDeploymentWhitelistProposal - structure for the proposal to add or delete deployer addresses from whitelist
@@ -453,3 +469,274 @@ func (mp ManageSysContractAddressProposal) String() string {
 	)
 	return strings.TrimSpace(builder.String())
 }
+
+// ScheduleChainConfigUpgradeProposal - structure for the proposal to activate the forks of the evm chain config at
+// the height
+type ScheduleChainConfigUpgradeProposal struct {
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description" yaml:"description"`
+	Forks       []string `json:"forks" yaml:"forks"`
+	Height      int64    `json:"height" yaml:"height"`
+}
+
+// NewScheduleChainConfigUpgradeProposal creates a new instance of ScheduleChainConfigUpgradeProposal
+func NewScheduleChainConfigUpgradeProposal(title, description string, forks []string, height int64,
+) ScheduleChainConfigUpgradeProposal {
+	return ScheduleChainConfigUpgradeProposal{
+		Title:       title,
+		Description: description,
+		Forks:       forks,
+		Height:      height,
+	}
+}
+
+// GetTitle returns title of a schedule chain config upgrade proposal object
+func (sp ScheduleChainConfigUpgradeProposal) GetTitle() string {
+	return sp.Title
+}
+
+// GetDescription returns description of a schedule chain config upgrade proposal object
+func (sp ScheduleChainConfigUpgradeProposal) GetDescription() string {
+	return sp.Description
+}
+
+// ProposalRoute returns route key of a schedule chain config upgrade proposal object
+func (sp ScheduleChainConfigUpgradeProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a schedule chain config upgrade proposal object
+func (sp ScheduleChainConfigUpgradeProposal) ProposalType() string {
+	return proposalTypeScheduleChainConfigUpgrade
+}
+
+// ValidateBasic validates a schedule chain config upgrade proposal
+func (sp ScheduleChainConfigUpgradeProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(sp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(sp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(sp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(sp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if sp.ProposalType() != proposalTypeScheduleChainConfigUpgrade {
+		return govtypes.ErrInvalidProposalType(sp.ProposalType())
+	}
+
+	if sp.Height <= 0 {
+		return govtypes.ErrInvalidProposalContent("height must be positive")
+	}
+
+	if len(sp.Forks) == 0 {
+		return govtypes.ErrInvalidProposalContent("forks are required")
+	}
+
+	config := DefaultChainConfig()
+	forks := make(map[string]struct{}, len(sp.Forks))
+	for _, fork := range sp.Forks {
+		if _, err := config.ForkBlock(fork); err != nil {
+			return govtypes.ErrInvalidProposalContent(err.Error())
+		}
+		if _, ok := forks[fork]; ok {
+			return govtypes.ErrInvalidProposalContent(fmt.Sprintf("duplicated fork %s", fork))
+		}
+		forks[fork] = struct{}{}
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of a ScheduleChainConfigUpgradeProposal
+func (sp ScheduleChainConfigUpgradeProposal) String() string {
+	var builder strings.Builder
+	builder.WriteString(
+		fmt.Sprintf(`ScheduleChainConfigUpgradeProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ Forks:					%s
+ Height:				%d
+`,
+			sp.Title, sp.Description, sp.ProposalType(), strings.Join(sp.Forks, ","), sp.Height),
+	)
+	return strings.TrimSpace(builder.String())
+}
+
+// UpdateGasScheduleProposal - structure for the proposal to apply the gas schedule of the evm execution from the height
+type UpdateGasScheduleProposal struct {
+	Title       string      `json:"title" yaml:"title"`
+	Description string      `json:"description" yaml:"description"`
+	Schedule    GasSchedule `json:"schedule" yaml:"schedule"`
+	Height      int64       `json:"height" yaml:"height"`
+}
+
+// NewUpdateGasScheduleProposal creates a new instance of UpdateGasScheduleProposal
+func NewUpdateGasScheduleProposal(title, description string, schedule GasSchedule, height int64,
+) UpdateGasScheduleProposal {
+	return UpdateGasScheduleProposal{
+		Title:       title,
+		Description: description,
+		Schedule:    schedule,
+		Height:      height,
+	}
+}
+
+// GetTitle returns title of an update gas schedule proposal object
+func (up UpdateGasScheduleProposal) GetTitle() string {
+	return up.Title
+}
+
+// GetDescription returns description of an update gas schedule proposal object
+func (up UpdateGasScheduleProposal) GetDescription() string {
+	return up.Description
+}
+
+// ProposalRoute returns route key of an update gas schedule proposal object
+func (up UpdateGasScheduleProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of an update gas schedule proposal object
+func (up UpdateGasScheduleProposal) ProposalType() string {
+	return proposalTypeUpdateGasSchedule
+}
+
+// ValidateBasic validates an update gas schedule proposal
+func (up UpdateGasScheduleProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(up.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(up.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(up.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(up.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if up.ProposalType() != proposalTypeUpdateGasSchedule {
+		return govtypes.ErrInvalidProposalType(up.ProposalType())
+	}
+
+	if up.Height <= 0 {
+		return govtypes.ErrInvalidProposalContent("height must be positive")
+	}
+
+	if err := up.Schedule.Validate(); err != nil {
+		return govtypes.ErrInvalidProposalContent(err.Error())
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of a UpdateGasScheduleProposal
+func (up UpdateGasScheduleProposal) String() string {
+	var builder strings.Builder
+	builder.WriteString(
+		fmt.Sprintf(`UpdateGasScheduleProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ ExecutionGasMultiplier:	%s
+ RefundQuotient:		%d
+ Height:				%d
+`,
+			up.Title, up.Description, up.ProposalType(), up.Schedule.ExecutionGasMultiplier, up.Schedule.RefundQuotient,
+			up.Height),
+	)
+	return strings.TrimSpace(builder.String())
+}
+
+// UpdateMaxGasLimitPerTxProposal - structure for the proposal to update the max gas limit of an evm tx
+type UpdateMaxGasLimitPerTxProposal struct {
+	Title            string `json:"title" yaml:"title"`
+	Description      string `json:"description" yaml:"description"`
+	MaxGasLimitPerTx uint64 `json:"max_gas_limit_per_tx" yaml:"max_gas_limit_per_tx"`
+}
+
+// NewUpdateMaxGasLimitPerTxProposal creates a new instance of UpdateMaxGasLimitPerTxProposal
+func NewUpdateMaxGasLimitPerTxProposal(title, description string, maxGasLimitPerTx uint64,
+) UpdateMaxGasLimitPerTxProposal {
+	return UpdateMaxGasLimitPerTxProposal{
+		Title:            title,
+		Description:      description,
+		MaxGasLimitPerTx: maxGasLimitPerTx,
+	}
+}
+
+// GetTitle returns title of an update max gas limit per tx proposal object
+func (up UpdateMaxGasLimitPerTxProposal) GetTitle() string {
+	return up.Title
+}
+
+// GetDescription returns description of an update max gas limit per tx proposal object
+func (up UpdateMaxGasLimitPerTxProposal) GetDescription() string {
+	return up.Description
+}
+
+// ProposalRoute returns route key of an update max gas limit per tx proposal object
+func (up UpdateMaxGasLimitPerTxProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of an update max gas limit per tx proposal object
+func (up UpdateMaxGasLimitPerTxProposal) ProposalType() string {
+	return proposalTypeUpdateMaxGasLimitPerTx
+}
+
+// ValidateBasic validates an update max gas limit per tx proposal
+func (up UpdateMaxGasLimitPerTxProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(up.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(up.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(up.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(up.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if up.ProposalType() != proposalTypeUpdateMaxGasLimitPerTx {
+		return govtypes.ErrInvalidProposalType(up.ProposalType())
+	}
+
+	// a tx can't use less gas than the intrinsic gas of a transfer
+	if up.MaxGasLimitPerTx < ethparams.TxGas {
+		return govtypes.ErrInvalidProposalContent(
+			fmt.Sprintf("max gas limit per tx must be at least %d", ethparams.TxGas))
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of a UpdateMaxGasLimitPerTxProposal
+func (up UpdateMaxGasLimitPerTxProposal) String() string {
+	var builder strings.Builder
+	builder.WriteString(
+		fmt.Sprintf(`UpdateMaxGasLimitPerTxProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ MaxGasLimitPerTx:		%d
+`,
+			up.Title, up.Description, up.ProposalType(), up.MaxGasLimitPerTx),
+	)
+	return strings.TrimSpace(builder.String())
+}
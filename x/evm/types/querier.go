@@ -30,6 +30,8 @@ const (
 	QueryContractBlockedList         = "contract-blocked-list"
 	QueryContractMethodBlockedList   = "contract-method-blocked-list"
 	QuerySysContractAddress          = "system-contract-address"
+	QueryChainConfigUpgrades         = "chain-config-upgrades"
+	QueryGasSchedule                 = "gas-schedule"
 )
 
 // QueryResBalance is response type for balance query
@@ -123,3 +125,9 @@ type QueryResAccount struct {
 }
 
 type QueryResExportAccount = GenesisAccount
+
+// QueryResGasSchedule is response type for gas schedule query
+type QueryResGasSchedule struct {
+	Schedule GasSchedule          `json:"schedule"`
+	Upgrades []GasScheduleUpgrade `json:"upgrades"`
+}
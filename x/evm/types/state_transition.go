@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"strings"
 
+	"github.com/okex/exchain/libs/system/telemetry"
 	"github.com/okex/exchain/libs/system/trace"
 	"github.com/okex/exchain/libs/tendermint/types"
 
@@ -19,6 +20,7 @@ import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 	"github.com/okex/exchain/libs/cosmos-sdk/types/innertx"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // StateTransition defines data to transitionDB in evm
@@ -38,6 +40,13 @@ type StateTransition struct {
 	Simulate   bool // i.e CheckTx execution
 	TraceTx    bool // reexcute tx or its predesessors
 	TraceTxLog bool // trace tx for its evm logs (predesessors are set to false)
+
+	// StorageChanges are the changes of the storage footprints of the accounts made by the tx, they're set once the
+	// tx is executed successfully in a block after Venus5
+	StorageChanges map[common.Address]*StorageChange
+
+	// sendRestrictionErr is the error of the first value transfer of the evm vetoed by the send restrictions
+	sendRestrictionErr error
 }
 
 // GasInfo returns the gas limit, gas consumed and gas refunded from the EVM transition
@@ -72,6 +81,13 @@ func GetHashFn(ctx sdk.Context, csdb *CommitStateDB) vm.GetHashFunc {
 		case ctx.BlockHeight() > int64(height):
 			// Case 2: if the chain is not the current height we need to retrieve the hash from the store for the
 			// current chain epoch. This only applies if the current height is greater than the requested height.
+			// The hashes of the recent blocks are kept in the state after Venus5, the other hashes are looked up
+			// in the hashes recorded by the node.
+			if types.HigherThanVenus5(ctx.BlockHeight()) {
+				if hash, ok := csdb.WithContext(ctx).GetRecentBlockHash(height); ok {
+					return hash
+				}
+			}
 			return csdb.WithContext(ctx).GetHeightHash(height)
 
 		default:
@@ -92,7 +108,7 @@ func (st *StateTransition) newEVM(
 	// Create context for evm
 	blockCtx := vm.BlockContext{
 		CanTransfer: core.CanTransfer,
-		Transfer:    core.Transfer,
+		Transfer:    st.transfer(csdb),
 		GetHash:     GetHashFn(ctx, csdb),
 		Coinbase:    common.BytesToAddress(ctx.BlockProposerAddress()),
 		BlockNumber: big.NewInt(ctx.BlockHeight()),
@@ -109,6 +125,18 @@ func (st *StateTransition) newEVM(
 	return vm.NewEVM(blockCtx, txCtx, csdb, config.EthereumConfig(st.ChainID), vmConfig)
 }
 
+// transfer returns the function moving the value of the calls and contract creations of the evm, the send
+// restrictions of the bank apply to all of them. As the evm can't fail a transfer, the first vetoed one is recorded
+// and fails the whole tx once the evm returns.
+func (st *StateTransition) transfer(csdb *CommitStateDB) vm.TransferFunc {
+	return func(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+		if st.sendRestrictionErr == nil {
+			st.sendRestrictionErr = csdb.ApplySendRestriction(sender, recipient, amount)
+		}
+		core.Transfer(db, sender, recipient, amount)
+	}
+}
+
 func (st *StateTransition) applyOverrides(ctx sdk.Context, csdb *CommitStateDB) error {
 	overrideBytes := ctx.OverrideBytes()
 	if overrideBytes != nil {
@@ -125,10 +153,16 @@ func (st *StateTransition) applyOverrides(ctx sdk.Context, csdb *CommitStateDB)
 // TransitionDb will transition the state by applying the current transaction and
 // returning the evm execution result.
 // NOTE: State transition checks are run during AnteHandler execution.
-func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exeRes *ExecutionResult, resData *ResultData, err error, innerTxs, erc20Contracts interface{}) {
+func (st *StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exeRes *ExecutionResult, resData *ResultData, err error, innerTxs, erc20Contracts interface{}) {
 	preSSId := st.Csdb.Snapshot()
 	contractCreation := st.Recipient == nil
 
+	span := ctx.StartSpan("evm.StateTransition",
+		attribute.Bool("contract_creation", contractCreation),
+		attribute.Int64("gas_limit", int64(st.GasLimit)),
+	)
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	defer func() {
 		if e := recover(); e != nil {
 			if !st.Simulate {
@@ -140,6 +174,8 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 			switch rType := e.(type) {
 			case ErrContractBlockedVerify:
 				err = ErrCallBlockedContract(rType.Descriptor)
+			case ErrContractDeploymentRestricted:
+				err = ErrUnauthorizedAccount(rType.Deployer)
 			default:
 				panic(e)
 			}
@@ -185,6 +221,13 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 
 	params := csdb.GetParams()
 
+	// since venus5, the deployer out of the whitelist can't deploy contracts either directly or by the contracts it calls
+	if types.HigherThanVenus5(ctx.BlockHeight()) &&
+		params.EnableContractDeploymentWhitelist && !csdb.IsDeployerInWhitelist(st.Sender.Bytes()) {
+		csdb.SetDeploymentRestricted(st.Sender.Bytes())
+		defer csdb.SetDeploymentRestricted(nil)
+	}
+
 	var senderStr = EthAddressToString(&st.Sender)
 
 	to := ""
@@ -194,13 +237,23 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 		recipientStr = to
 	}
 	tracer := newTracer(ctx, st.TxHash)
+	tracedBySink := isTracedBySink(ctx, st)
+	if tracedBySink {
+		tracer = gTraceSink.newTracer(st.TxHash)
+	}
 	vmConfig := vm.Config{
 		ExtraEips:        params.ExtraEIPs,
-		Debug:            st.TraceTxLog,
+		Debug:            st.TraceTxLog || tracedBySink,
 		Tracer:           tracer,
 		ContractVerifier: NewContractVerifier(params),
 	}
 
+	// the evm runs with the gas limit scaled by the gas schedule, and its gas usage is scaled back
+	gasSchedule := GetGasSchedule(ctx, csdb.paramSpace)
+	evmGasLimit := gasSchedule.EVMGasLimit(gasLimit)
+	// the refund counter isn't cleared after the txs which aren't finalised, so the refund of the tx is counted from here
+	refundBefore := csdb.GetRefund()
+
 	evm := st.newEVM(ctx, csdb, gasLimit, st.Price, &config, vmConfig)
 
 	var (
@@ -240,15 +293,27 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 			return exeRes, resData, ErrUnauthorizedAccount(senderAccAddr), innerTxs, erc20Contracts
 		}
 
+		if maxInitCodeSize := GetMaxInitCodeSize(ctx, csdb.paramSpace); maxInitCodeSize > 0 && uint64(len(st.Payload)) > maxInitCodeSize {
+			if !st.Simulate {
+				st.Csdb.RevertToSnapshot(preSSId)
+			}
+
+			return exeRes, resData, sdkerrors.Wrapf(ErrMaxInitCodeSizeExceeded, "initcode size %d, limit %d", len(st.Payload), maxInitCodeSize), innerTxs, erc20Contracts
+		}
+
 		StartTxLog(trace.EVMCORE)
 		defer StopTxLog(trace.EVMCORE)
 		nonce := evm.StateDB.GetNonce(st.Sender)
-		ret, contractAddress, leftOverGas, err = evm.Create(senderRef, st.Payload, gasLimit, st.Amount)
+		ret, contractAddress, leftOverGas, err = evm.Create(senderRef, st.Payload, evmGasLimit, st.Amount)
+		if err == nil && uint64(len(ret)) > GetMaxCodeSize(ctx, csdb.paramSpace) {
+			// the code is reverted with all the gas consumed, as the evm does with the code exceeding its own limit
+			err, leftOverGas = vm.ErrMaxCodeSizeExceeded, 0
+		}
 
 		contractAddressStr := EthAddressToString(&contractAddress)
 		recipientLog = strings.Join([]string{"contract address ", contractAddressStr}, "")
 
-		innertx.UpdateDefaultInnerTx(callTx, contractAddressStr, innertx.CosmosCallType, innertx.EvmCreateName, gasLimit-leftOverGas, nonce)
+		innertx.UpdateDefaultInnerTx(callTx, contractAddressStr, innertx.CosmosCallType, innertx.EvmCreateName, evmGasLimit-leftOverGas, nonce)
 	default:
 		if !params.EnableCall {
 			if !st.Simulate {
@@ -262,7 +327,12 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 		csdb.SetNonce(st.Sender, csdb.GetNonce(st.Sender)+1)
 		StartTxLog(trace.EVMCORE)
 		defer StopTxLog(trace.EVMCORE)
-		ret, leftOverGas, err = evm.Call(senderRef, *st.Recipient, st.Payload, gasLimit, st.Amount)
+		// since venus5, the txs calling the forwarder relay the meta transactions in their input
+		if types.HigherThanVenus5(ctx.BlockHeight()) && *st.Recipient == ForwarderAddress {
+			ret, leftOverGas, err = st.forward(ctx, csdb, evm, evmGasLimit)
+		} else {
+			ret, leftOverGas, err = evm.Call(senderRef, *st.Recipient, st.Payload, evmGasLimit, st.Amount)
+		}
 
 		if recipientStr == "" {
 			recipientStr = EthAddressToString(st.Recipient)
@@ -270,10 +340,20 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 
 		recipientLog = strings.Join([]string{"recipient address ", recipientStr}, "")
 
-		innertx.UpdateDefaultInnerTx(callTx, recipientStr, innertx.CosmosCallType, innertx.EvmCallName, gasLimit-leftOverGas, 0)
+		innertx.UpdateDefaultInnerTx(callTx, recipientStr, innertx.CosmosCallType, innertx.EvmCallName, evmGasLimit-leftOverGas, 0)
+	}
+
+	// the value of the calls and contract creations moves the coins of the bank, so a transfer vetoed by the send
+	// restrictions of the bank fails the tx
+	if err == nil && st.sendRestrictionErr != nil {
+		err = st.sendRestrictionErr
 	}
 
-	gasConsumed := gasLimit - leftOverGas
+	var refund uint64
+	if refundAfter := csdb.GetRefund(); refundAfter > refundBefore {
+		refund = refundAfter - refundBefore
+	}
+	gasConsumed := gasSchedule.GasConsumed(gasLimit, evmGasLimit, leftOverGas, refund)
 
 	innerTxs, erc20Contracts = innertx.ParseInnerTxAndContract(evm, err != nil)
 
@@ -304,6 +384,20 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 			exeRes.TraceLogs = traceLogs
 		}
 	}()
+	if tracedBySink {
+		defer func() {
+			result := &core.ExecutionResult{
+				UsedGas:    gasConsumed,
+				Err:        err,
+				ReturnData: ret,
+			}
+			trace, traceErr := GetTracerResult(tracer, result)
+			if traceErr != nil {
+				trace, _ = json.Marshal(traceErr.Error())
+			}
+			gTraceSink.add(ctx.BlockHeight(), *st.TxHash, trace)
+		}()
+	}
 	if err != nil {
 		if !st.Simulate {
 			st.Csdb.RevertToSnapshot(preSSId)
@@ -336,6 +430,9 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 	}
 
 	if !st.Simulate {
+		if types.HigherThanVenus5(ctx.BlockHeight()) {
+			st.StorageChanges = csdb.StorageChanges()
+		}
 		if types.HigherThanMars(ctx.BlockHeight()) {
 			if ctx.IsDeliver() {
 				csdb.IntermediateRoot(true)
@@ -373,7 +470,7 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (exe
 		GasInfo: GasInfo{
 			GasConsumed: gasConsumed,
 			GasLimit:    gasLimit,
-			GasRefunded: leftOverGas,
+			GasRefunded: gasLimit - gasConsumed,
 		},
 	}
 	return
@@ -523,3 +523,69 @@ func (suite *StateDBTestSuite) TestTransitionDb() {
 	suite.Require().Equal(fromBalance, sdk.NewDec(4940).BigInt())
 	suite.Require().Equal(toBalance, sdk.NewDec(50).BigInt())
 }
+
+func (suite *StateDBTestSuite) TestTransitionDbForwarder() {
+	addr := sdk.AccAddress(suite.address.Bytes())
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+	_ = acc.SetCoins(sdk.NewCoins(ethermint.NewPhotonCoin(sdk.NewInt(5000))))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	// the target stores the last 20 bytes of the call data, which is the signer appended by the forwarder
+	target := ethcmn.HexToAddress("0x3000000000000000000000000000000000000003")
+	suite.stateDB.SetCode(target, common.FromHex("0x36601490033560601c60005500"))
+
+	user, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	userAddr := ethcrypto.PubkeyToAddress(user.ToECDSA().PublicKey)
+	other, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+
+	chainID := big.NewInt(3)
+	relay := func(mtx types.MetaTransaction, signer ethsecp256k1.PrivKey) error {
+		sig, err := mtx.Sign(chainID, signer.ToECDSA())
+		suite.Require().NoError(err)
+		input, err := types.GetForwarderInput(mtx, sig)
+		suite.Require().NoError(err)
+		st := types.StateTransition{
+			AccountNonce: 0,
+			Price:        sdk.NewDec(10).BigInt(),
+			GasLimit:     200000,
+			Recipient:    &types.ForwarderAddress,
+			Amount:       big.NewInt(0),
+			Payload:      input,
+			ChainID:      chainID,
+			Csdb:         suite.stateDB,
+			TxHash:       &ethcmn.Hash{},
+			Sender:       suite.address,
+			Simulate:     suite.ctx.IsCheckTx(),
+		}
+		_, _, err, _, _ = st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+		return err
+	}
+	mtx := types.MetaTransaction{From: userAddr, To: target, Gas: 50000, Data: []byte{0x12, 0x34}}
+
+	// before venus5, the forwarder is called as an empty account
+	suite.Require().NoError(relay(mtx, user))
+	suite.Require().Equal(ethcmn.Hash{}, suite.stateDB.GetState(target, ethcmn.Hash{}))
+	suite.Require().Zero(types.GetForwarderNonce(suite.stateDB, userAddr))
+
+	types2.UnittestOnlySetMilestoneVenus5Height(1)
+	defer types2.UnittestOnlySetMilestoneVenus5Height(0)
+	suite.ctx.SetBlockHeight(2)
+
+	// the meta transaction signed by another key is rejected
+	err = relay(mtx, other)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), types.ErrInvalidMetaTx.Error())
+	suite.Require().Zero(types.GetForwarderNonce(suite.stateDB, userAddr))
+
+	suite.Require().NoError(relay(mtx, user))
+	suite.Require().Equal(ethcmn.BytesToHash(userAddr.Bytes()), suite.stateDB.GetState(target, ethcmn.Hash{}))
+	suite.Require().Equal(uint64(1), types.GetForwarderNonce(suite.stateDB, userAddr))
+
+	// the relayed meta transaction can't be replayed
+	err = relay(mtx, user)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "invalid nonce")
+	suite.Require().Equal(uint64(1), types.GetForwarderNonce(suite.stateDB, userAddr))
+}
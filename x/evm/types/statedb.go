@@ -41,6 +41,7 @@ type CommitStateDBParams struct {
 	AccountKeeper AccountKeeper
 	SupplyKeeper  SupplyKeeper
 	BankKeeper    BankKeeper
+	Hibernation   ContractHibernation
 	Ada           DbAdapter
 	// Amino codec
 	Cdc *codec.Codec
@@ -79,6 +80,10 @@ type CommitStateDB struct {
 	prefetcher   *mpt.TriePrefetcher
 	originalRoot ethcmn.Hash
 
+	storageRootPrefetcher *mpt.StorageRootPrefetcher // warms the storage roots of the contracts of the block txs
+	// guards storageRootPrefetcher, which is fed by the pre-delivery of the txs on another goroutine
+	storageRootPrefetcherLock sync.RWMutex
+
 	// TODO: We need to store the context as part of the structure itself opposed
 	// to being passed as a parameter (as it should be) in order to implement the
 	// StateDB interface. Perhaps there is a better way.
@@ -89,6 +94,7 @@ type CommitStateDB struct {
 	accountKeeper AccountKeeper
 	supplyKeeper  SupplyKeeper
 	bankKeeper    BankKeeper
+	hibernation   ContractHibernation
 
 	// array that hold 'live' objects, which will get modified while processing a
 	// state transition
@@ -133,6 +139,12 @@ type CommitStateDB struct {
 	cdc *codec.Codec
 
 	updatedAccount map[ethcmn.Address]struct{} // will destroy every block
+
+	// the storage roots of the contracts destructed since the last TakeDestructedStorages
+	destructedStorages map[ethcmn.Address]ethcmn.Hash
+
+	// the deployer whose tx is restricted from deploying contracts, see SetDeploymentRestricted
+	restrictedDeployer sdk.AccAddress
 }
 
 type StoreProxy interface {
@@ -169,6 +181,7 @@ func NewCommitStateDB(csdbParams CommitStateDBParams) *CommitStateDB {
 		accountKeeper: csdbParams.AccountKeeper,
 		supplyKeeper:  csdbParams.SupplyKeeper,
 		bankKeeper:    csdbParams.BankKeeper,
+		hibernation:   csdbParams.Hibernation,
 		cdc:           csdbParams.Cdc,
 
 		stateObjects:        make(map[ethcmn.Address]*stateObject),
@@ -198,6 +211,7 @@ func ResetCommitStateDB(csdb *CommitStateDB, csdbParams CommitStateDBParams, ctx
 	csdb.accountKeeper = csdbParams.AccountKeeper
 	csdb.supplyKeeper = csdbParams.SupplyKeeper
 	csdb.bankKeeper = csdbParams.BankKeeper
+	csdb.hibernation = csdbParams.Hibernation
 	csdb.cdc = csdbParams.Cdc
 
 	if csdb.stateObjects != nil {
@@ -292,7 +306,9 @@ func ResetCommitStateDB(csdb *CommitStateDB, csdbParams CommitStateDBParams, ctx
 		csdb.updatedAccount = make(map[ethcmn.Address]struct{})
 	}
 
+	csdb.destructedStorages = nil
 	csdb.prefetcher = nil
+	csdb.stopStorageRootPrefetcher()
 	csdb.ctx = *ctx
 	csdb.refund = 0
 	csdb.thash = ethcmn.Hash{}
@@ -380,6 +396,24 @@ func (csdb *CommitStateDB) SetHeightHash(height uint64, hash ethcmn.Hash) {
 	}
 }
 
+// SetRecentBlockHash sets the hash of the block at the height in the ring buffer of the recent block hashes, replacing
+// the hash of the block RecentBlockHashesSize blocks before.
+func (csdb *CommitStateDB) SetRecentBlockHash(height uint64, hash ethcmn.Hash) {
+	store := csdb.paramSpace.CustomKVStore(csdb.ctx)
+	store.Set(GetRecentBlockHashKey(height), append(sdk.Uint64ToBigEndian(height), hash.Bytes()...))
+}
+
+// GetRecentBlockHash returns the hash of the block at the height from the ring buffer of the recent block hashes, it
+// returns false if the block is not in the ring buffer.
+func (csdb *CommitStateDB) GetRecentBlockHash(height uint64) (ethcmn.Hash, bool) {
+	store := csdb.paramSpace.CustomKVStore(csdb.ctx)
+	bz := store.Get(GetRecentBlockHashKey(height))
+	if len(bz) != Uint64Length+ethcmn.HashLength || sdk.BigEndianToUint64(bz[:Uint64Length]) != height {
+		return ethcmn.Hash{}, false
+	}
+	return ethcmn.BytesToHash(bz[Uint64Length:]), true
+}
+
 // SetParams sets the evm parameters to the param space.
 func (csdb *CommitStateDB) SetParams(params Params) {
 	csdb.params = &params
@@ -468,6 +502,12 @@ func (csdb *CommitStateDB) SetCode(addr ethcmn.Address, code []byte) {
 		defer trace.StopTxLog(funcName)
 	}
 
+	// the code of an account is only set by the evm when a contract is created, so the contracts created by CREATE or
+	// CREATE2 in the tx of a restricted deployer are rejected here
+	if csdb.restrictedDeployer != nil {
+		panic(ErrContractDeploymentRestricted{Deployer: csdb.restrictedDeployer})
+	}
+
 	so := csdb.GetOrNewStateObject(addr)
 	if so != nil {
 		hash := Keccak256HashWithCache(code)
@@ -914,6 +954,7 @@ func (csdb *CommitStateDB) Commit(deleteEmptyObjects bool) (ethcmn.Hash, error)
 			csdb.prefetcher = nil
 		}()
 	}
+	csdb.stopStorageRootPrefetcher()
 
 	// Now we're about to start to write changes to the trie. The trie is so far
 	// _untouched_. We can check with the prefetcher, if it can give us a trie
@@ -1071,6 +1112,70 @@ func (csdb *CommitStateDB) IntermediateRoot(deleteEmptyObjects bool) ethcmn.Hash
 	return ethcmn.Hash{}
 }
 
+// ApplySendRestriction runs the send restrictions of the bank on the value transferred by the evm from the sender to
+// the recipient. A restriction redirecting the coins is rejected, as the evm transfers the value to the recipient itself.
+func (csdb *CommitStateDB) ApplySendRestriction(from, to ethcmn.Address, amount *big.Int) error {
+	if amount == nil || amount.Sign() <= 0 {
+		return nil
+	}
+
+	fromAddr, toAddr := sdk.AccAddress(from.Bytes()), sdk.AccAddress(to.Bytes())
+	amt := sdk.NewCoins(sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDecFromBigIntWithPrec(amount, sdk.Precision)})
+	newToAddr, err := csdb.bankKeeper.ApplySendRestriction(csdb.ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+	if !newToAddr.Equals(toAddr) {
+		return fmt.Errorf("the transfer to <%s> can't be redirected to <%s> in evm", toAddr.String(), newToAddr.String())
+	}
+	return nil
+}
+
+// IsContractHibernated returns whether the contract is hibernated for running out of its storage rent, the calls to
+// a hibernated contract fail
+func (csdb *CommitStateDB) IsContractHibernated(contract ethcmn.Address) bool {
+	if csdb.hibernation == nil || !tmtypes.HigherThanVenus5(csdb.ctx.BlockHeight()) {
+		return false
+	}
+	return csdb.hibernation.IsContractHibernated(csdb.ctx, contract)
+}
+
+// StorageChange is the change of the storage footprint of an account made by a tx
+type StorageChange struct {
+	// SlotDelta is the number of the storage slots turned from empty to non-empty minus the number of the slots
+	// turned from non-empty to empty
+	SlotDelta int64
+	// CodeDeployed tells whether the code of the account is deployed in the block
+	CodeDeployed bool
+	// Destructed tells whether the account is self-destructed
+	Destructed bool
+}
+
+// StorageChanges returns the changes of the storage footprints of the accounts modified by the current tx, it must be
+// called before the tx is finalised. The accounts whose footprints don't change are left out.
+func (csdb *CommitStateDB) StorageChanges() map[ethcmn.Address]*StorageChange {
+	changes := make(map[ethcmn.Address]*StorageChange)
+	for addr := range csdb.journal.dirties {
+		so, ok := csdb.stateObjects[addr]
+		if !ok {
+			continue
+		}
+		change := &StorageChange{CodeDeployed: so.dirtyCode, Destructed: so.suicided}
+		for key, value := range so.dirtyStorage {
+			committed := so.GetCommittedState(csdb.db, key)
+			if committed == (ethcmn.Hash{}) && value != (ethcmn.Hash{}) {
+				change.SlotDelta++
+			} else if committed != (ethcmn.Hash{}) && value == (ethcmn.Hash{}) {
+				change.SlotDelta--
+			}
+		}
+		if change.SlotDelta != 0 || change.CodeDeployed || change.Destructed {
+			changes[addr] = change
+		}
+	}
+	return changes
+}
+
 // updateStateObject writes the given state object to the store.
 func (csdb *CommitStateDB) updateStateObject(so *stateObject) error {
 	// NOTE: we don't use sdk.NewCoin here to avoid panic on test importer's genesis
@@ -1478,6 +1583,12 @@ func (csdb *CommitStateDB) GetContractDeploymentWhitelist() (whitelist AddressLi
 	return
 }
 
+// SetDeploymentRestricted restricts the deployer from deploying any contract until it's reset with nil. It's set
+// during the state transition of a tx whose sender isn't in the contract deployment whitelist.
+func (csdb *CommitStateDB) SetDeploymentRestricted(deployer sdk.AccAddress) {
+	csdb.restrictedDeployer = deployer
+}
+
 // IsDeployerInWhitelist checks whether the deployer is in the whitelist as a distributor
 func (csdb *CommitStateDB) IsDeployerInWhitelist(deployerAddr sdk.AccAddress) bool {
 	var bs StoreProxy
@@ -100,11 +100,28 @@ func (csdb *CommitStateDB) UpdateAccountStorageInfo(so *stateObject) {
 func (csdb *CommitStateDB) DeleteAccountStorageInfo(so *stateObject) {
 	// Delete the account from the trie
 	addr := so.Address()
+	// the storage trie of the contract isn't referenced any more, record it for the cleanup
+	if enc, err := csdb.trie.TryGet(addr[:]); err == nil && len(enc) > 0 {
+		if root := ethcmn.BytesToHash(enc); root != types.EmptyRootHash {
+			if csdb.destructedStorages == nil {
+				csdb.destructedStorages = make(map[ethcmn.Address]ethcmn.Hash)
+			}
+			csdb.destructedStorages[addr] = root
+		}
+	}
 	if err := csdb.trie.TryDelete(addr[:]); err != nil {
 		csdb.SetError(fmt.Errorf("deleteStateObject (%x) error: %v", addr[:], err))
 	}
 }
 
+// TakeDestructedStorages returns the storage roots of the contracts destructed since the last call, which are no
+// longer referenced by the storage trie of the evm
+func (csdb *CommitStateDB) TakeDestructedStorages() map[ethcmn.Address]ethcmn.Hash {
+	destructed := csdb.destructedStorages
+	csdb.destructedStorages = nil
+	return destructed
+}
+
 func (csdb *CommitStateDB) GetStateByKeyMpt(addr ethcmn.Address, key ethcmn.Hash) ethcmn.Hash {
 	var (
 		enc []byte
@@ -263,6 +280,15 @@ func (csdb *CommitStateDB) StartPrefetcher(namespace string) {
 	}
 
 	csdb.prefetcher = mpt.NewTriePrefetcher(csdb.db, csdb.originalRoot, namespace)
+
+	csdb.stopStorageRootPrefetcher()
+	if mpt.TrieBlockPrefetch {
+		if p, err := mpt.NewStorageRootPrefetcher(csdb.db, csdb.originalRoot); err == nil {
+			csdb.storageRootPrefetcherLock.Lock()
+			csdb.storageRootPrefetcher = p
+			csdb.storageRootPrefetcherLock.Unlock()
+		}
+	}
 }
 
 // StopPrefetcher terminates a running prefetcher and reports any leftover stats
@@ -272,6 +298,28 @@ func (csdb *CommitStateDB) StopPrefetcher() {
 		csdb.prefetcher.Close()
 		csdb.prefetcher = nil
 	}
+	csdb.stopStorageRootPrefetcher()
+}
+
+// PrefetchStorageRoots loads the storage roots of the contracts in the background before the txs of the block read
+// them, it's safe to call concurrently with the execution of the txs
+func (csdb *CommitStateDB) PrefetchStorageRoots(addrs ...ethcmn.Address) {
+	csdb.storageRootPrefetcherLock.RLock()
+	defer csdb.storageRootPrefetcherLock.RUnlock()
+
+	if p := csdb.storageRootPrefetcher; p != nil {
+		p.Prefetch(addrs...)
+	}
+}
+
+func (csdb *CommitStateDB) stopStorageRootPrefetcher() {
+	csdb.storageRootPrefetcherLock.Lock()
+	defer csdb.storageRootPrefetcherLock.Unlock()
+
+	if csdb.storageRootPrefetcher != nil {
+		csdb.storageRootPrefetcher.Close()
+		csdb.storageRootPrefetcher = nil
+	}
 }
 
 func (csdb *CommitStateDB) GetRootTrie() ethstate.Trie {
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+)
+
+// traceSinkQueueSize is the number of the committed blocks whose traces wait to be written, the commit waits for the
+// writer if the queue is full
+const traceSinkQueueSize = 64
+
+// TxTrace is the trace of an evm tx delivered in a block
+type TxTrace struct {
+	Height int64           `json:"height"`
+	TxHash common.Hash     `json:"txHash"`
+	Trace  json.RawMessage `json:"trace"`
+}
+
+// TraceWriter writes the traces of the evm txs of a committed block to a sink, e.g. a file or an external service
+type TraceWriter interface {
+	Write(height int64, traces []*TxTrace) error
+}
+
+// traceSink traces the evm txs delivered in the blocks, and writes the traces of each block with the writer in the
+// background once the block is committed
+type traceSink struct {
+	config *TraceConfig
+	writer TraceWriter
+	logger log.Logger
+
+	mtx    sync.Mutex
+	height int64
+	index  map[common.Hash]int
+	traces []*TxTrace
+
+	queue chan []*TxTrace
+}
+
+var gTraceSink *traceSink
+
+// SetTraceSink makes the evm trace every tx delivered in the blocks with the tracer of config, and write the traces of
+// the committed blocks with writer. It must be called before the blocks are delivered.
+func SetTraceSink(config *TraceConfig, writer TraceWriter, logger log.Logger) error {
+	if err := TestTracerConfig(config); err != nil {
+		return err
+	}
+	s := &traceSink{
+		config: config,
+		writer: writer,
+		logger: logger,
+		index:  make(map[common.Hash]int),
+		queue:  make(chan []*TxTrace, traceSinkQueueSize),
+	}
+	go s.writeRoutine()
+	gTraceSink = s
+	return nil
+}
+
+// BeginTraceSinkBlock drops the traces of the txs delivered before, it's called at the beginning of a block since a
+// block executed ahead of its commit may be dropped and another block is executed at the same height
+func BeginTraceSinkBlock(height int64) {
+	if gTraceSink == nil {
+		return
+	}
+	gTraceSink.mtx.Lock()
+	defer gTraceSink.mtx.Unlock()
+	gTraceSink.reset(height)
+}
+
+// CommitTraceSink writes the traces of the txs of the committed block in the background
+func CommitTraceSink() {
+	if gTraceSink == nil {
+		return
+	}
+	gTraceSink.mtx.Lock()
+	traces := gTraceSink.traces
+	gTraceSink.reset(0)
+	gTraceSink.mtx.Unlock()
+	if len(traces) > 0 {
+		gTraceSink.queue <- traces
+	}
+}
+
+// isTracedBySink returns whether the tx of st delivered in ctx is traced for the trace sink, the txs traced for the
+// rpc aren't
+func isTracedBySink(ctx sdk.Context, st *StateTransition) bool {
+	return gTraceSink != nil && !ctx.IsCheckTx() && !ctx.IsTraceTx() && !st.Simulate && !st.TraceTx &&
+		!st.TraceTxLog && st.TxHash != nil
+}
+
+func (s *traceSink) newTracer(txHash *common.Hash) vm.Tracer {
+	return newConfigTracer(s.config, txHash)
+}
+
+// add adds the trace of a tx of the block, the trace of a tx executed again replaces the former one
+func (s *traceSink) add(height int64, txHash common.Hash, trace []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if height != s.height {
+		s.reset(height)
+	}
+	txTrace := &TxTrace{Height: height, TxHash: txHash, Trace: trace}
+	if i, ok := s.index[txHash]; ok {
+		s.traces[i] = txTrace
+		return
+	}
+	s.index[txHash] = len(s.traces)
+	s.traces = append(s.traces, txTrace)
+}
+
+func (s *traceSink) reset(height int64) {
+	s.height = height
+	s.index = make(map[common.Hash]int)
+	s.traces = nil
+}
+
+func (s *traceSink) writeRoutine() {
+	for traces := range s.queue {
+		height := traces[0].Height
+		if err := s.writer.Write(height, traces); err != nil {
+			s.logger.Error("failed to write the evm tx traces", "height", height, "err", err)
+		}
+	}
+}
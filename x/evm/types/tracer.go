@@ -153,28 +153,33 @@ func newTracer(ctx sdk.Context, txHash *common.Hash) (tracer vm.Tracer) {
 				return NewNoOpTracer()
 			}
 		}
-		if traceConfig.Tracer == "" {
-			//Basic tracer with config
-			logConfig := vm.LogConfig{
-				DisableMemory:     traceConfig.DisableMemory,
-				DisableStorage:    traceConfig.DisableStorage,
-				DisableStack:      traceConfig.DisableStack,
-				DisableReturnData: traceConfig.DisableReturnData,
-				Debug:             traceConfig.Debug,
-			}
-			return vm.NewStructLogger(&logConfig)
-		}
-		// Json-based tracer
-		tCtx := &tracers.Context{
-			TxHash: *txHash,
-		}
-		tracer, err = tracers.New(traceConfig.Tracer, tCtx)
-		if err != nil {
-			return NewNoOpTracer()
-		}
-		return tracer
+		return newConfigTracer(traceConfig, txHash)
 	} else {
 		//no op tracer
 		return NewNoOpTracer()
 	}
 }
+
+// newConfigTracer creates the tracer of the config, the struct logger if the config has no tracer
+func newConfigTracer(traceConfig *TraceConfig, txHash *common.Hash) vm.Tracer {
+	if traceConfig.Tracer == "" {
+		//Basic tracer with config
+		logConfig := vm.LogConfig{
+			DisableMemory:     traceConfig.DisableMemory,
+			DisableStorage:    traceConfig.DisableStorage,
+			DisableStack:      traceConfig.DisableStack,
+			DisableReturnData: traceConfig.DisableReturnData,
+			Debug:             traceConfig.Debug,
+		}
+		return vm.NewStructLogger(&logConfig)
+	}
+	// Json-based tracer
+	tCtx := &tracers.Context{
+		TxHash: *txHash,
+	}
+	tracer, err := tracers.New(traceConfig.Tracer, tCtx)
+	if err != nil {
+		return NewNoOpTracer()
+	}
+	return tracer
+}
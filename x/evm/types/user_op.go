@@ -0,0 +1,133 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// EntryPointName is the name the entrypoint address is derived from
+	EntryPointName = "evm-entry-point"
+
+	// UserOpAccountABIJSON is the abi of the method a contract account implements to validate the user operations
+	UserOpAccountABIJSON = `[{"inputs":[{"internalType":"bytes32","name":"userOpHash","type":"bytes32"},{"internalType":"bytes","name":"signature","type":"bytes"}],"name":"validateUserOp","outputs":[{"internalType":"uint256","name":"validationData","type":"uint256"}],"stateMutability":"nonpayable","type":"function"}]`
+	UserOpValidateMethod = "validateUserOp"
+
+	// MaxUserOpsPerMsg is the max number of the user operations handled in one msg
+	MaxUserOpsPerMsg = 64
+)
+
+var (
+	// EntryPointAddress is the address the contract accounts are called from to validate and execute the user
+	// operations. Unlike the ERC-4337 entrypoint contract, it's a native module address without code, which the
+	// contract accounts should only accept the calls of.
+	EntryPointAddress common.Address
+
+	UserOpAccountABI *ABI
+)
+
+func init() {
+	EntryPointAddress = common.BytesToAddress(authtypes.NewModuleAddress(EntryPointName).Bytes())
+
+	var err error
+	if UserOpAccountABI, err = NewABI(UserOpAccountABIJSON); err != nil {
+		panic(err)
+	}
+}
+
+// UserOperation is an operation of a contract account, which is validated and paid for by the contract itself
+type UserOperation struct {
+	// hex address of the contract account
+	Sender string `json:"sender"`
+	// sequential nonce of the contract account, managed by the entrypoint
+	Nonce uint64 `json:"nonce"`
+	// data the contract account is called with to execute the operation
+	CallData []byte `json:"call_data"`
+	// gas limit of the execution
+	CallGasLimit uint64 `json:"call_gas_limit"`
+	// gas limit of the validation
+	VerificationGasLimit uint64 `json:"verification_gas_limit"`
+	// price in wei of the gas, which the contract account reimburses the bundler with
+	MaxFeePerGas sdk.Int `json:"max_fee_per_gas"`
+	// signature checked by the contract account
+	Signature []byte `json:"signature"`
+}
+
+// ValidateBasic runs stateless checks on the user operation
+func (op UserOperation) ValidateBasic() error {
+	if !common.IsHexAddress(op.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", op.Sender)
+	}
+	if op.CallGasLimit == 0 || op.VerificationGasLimit == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "gas limits of the user operation must be positive")
+	}
+	if op.MaxFeePerGas.IsNil() || op.MaxFeePerGas.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max fee per gas of the user operation must not be negative")
+	}
+	return nil
+}
+
+// SenderAddress returns the address of the contract account
+func (op UserOperation) SenderAddress() common.Address {
+	return common.HexToAddress(op.Sender)
+}
+
+// MaxGas returns the max gas the user operation consumes
+func (op UserOperation) MaxGas() uint64 {
+	return op.CallGasLimit + op.VerificationGasLimit
+}
+
+// Prefund returns the max fee the contract account pays for the user operation
+func (op UserOperation) Prefund() *big.Int {
+	return op.Fee(op.MaxGas())
+}
+
+// Fee returns the fee of the gas used by the user operation
+func (op UserOperation) Fee(gasUsed uint64) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), op.MaxFeePerGas.BigInt())
+}
+
+// Hash returns the hash the contract account validates the signature against, which commits to the entrypoint and
+// the chain id, so that the signature can't be replayed elsewhere
+func (op UserOperation) Hash(chainID *big.Int) common.Hash {
+	word := func(b []byte) []byte {
+		return common.LeftPadBytes(b, 32)
+	}
+	return crypto.Keccak256Hash(
+		word(EntryPointAddress.Bytes()),
+		word(chainID.Bytes()),
+		word(op.SenderAddress().Bytes()),
+		word(new(big.Int).SetUint64(op.Nonce).Bytes()),
+		crypto.Keccak256(op.CallData),
+		word(new(big.Int).SetUint64(op.CallGasLimit).Bytes()),
+		word(new(big.Int).SetUint64(op.VerificationGasLimit).Bytes()),
+		word(op.MaxFeePerGas.BigInt().Bytes()),
+	)
+}
+
+// GetValidateUserOpInput returns the input of the call validating the user operation
+func GetValidateUserOpInput(userOpHash common.Hash, signature []byte) ([]byte, error) {
+	return UserOpAccountABI.Pack(UserOpValidateMethod, [32]byte(userOpHash), signature)
+}
+
+// GetValidateUserOpOutput returns the validation data returned by the contract account, zero means valid
+func GetValidateUserOpOutput(data []byte) (*big.Int, error) {
+	res, err := UserOpAccountABI.Unpack(UserOpValidateMethod, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) != 1 {
+		return nil, fmt.Errorf("unexpected output of %s: %v", UserOpValidateMethod, res)
+	}
+	validationData, ok := res[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected output of %s: %v", UserOpValidateMethod, res)
+	}
+	return validationData, nil
+}
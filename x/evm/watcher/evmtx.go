@@ -53,6 +53,13 @@ func (etx *evmTx) GetIndex() uint64 {
 	return etx.index
 }
 
+func (etx *evmTx) GetTxData() *types.TxData {
+	if etx == nil || etx.msgEvmTx == nil {
+		return nil
+	}
+	return &etx.msgEvmTx.Data
+}
+
 type MsgEthTx struct {
 	*Transaction
 	Key []byte
@@ -49,9 +49,10 @@ func putHashPrefixKey(key []byte) {
 }
 
 type Querier struct {
-	store *WatchStore
-	sw    bool
-	lru   *lru.Cache
+	store    *WatchStore
+	sw       bool
+	lru      *lru.Cache
+	receipts *ReceiptStore
 }
 
 func (q Querier) enabled() bool {
@@ -67,11 +68,15 @@ func NewQuerier() *Querier {
 	if e != nil {
 		panic(errors.New("Failed to init LRU Cause " + e.Error()))
 	}
-	return &Querier{store: InstanceOfWatchStore(), sw: IsWatcherEnabled(), lru: lru}
+	return &Querier{store: InstanceOfWatchStore(), sw: IsWatcherEnabled(), lru: lru, receipts: InstanceOfReceiptStore()}
 }
 
 func (q Querier) GetTransactionReceipt(hash common.Hash) (*TransactionReceipt, error) {
 	if !q.enabled() {
+		// the receipts are persisted in the receipt store without the watch db
+		if q.receipts != nil {
+			return q.receipts.Get(hash)
+		}
 		return nil, errDisable
 	}
 	var protoReceipt prototypes.TransactionReceipt
@@ -149,7 +154,10 @@ func (q Querier) GetBlockByHash(hash common.Hash, fullTx bool) (*Block, error) {
 		block.Transactions = txList
 	}
 	block.UncleHash = ethtypes.EmptyUncleHash
-	block.ReceiptsRoot = ethtypes.EmptyRootHash
+	// the receipts root isn't saved with the blocks saved before it was derived
+	if block.ReceiptsRoot == (common.Hash{}) {
+		block.ReceiptsRoot = ethtypes.EmptyRootHash
+	}
 
 	return &block, nil
 }
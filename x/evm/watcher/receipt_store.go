@@ -0,0 +1,85 @@
+package watcher
+
+import (
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/viper"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	dbm "github.com/okex/exchain/libs/tm-db"
+	prototypes "github.com/okex/exchain/x/evm/watcher/proto"
+)
+
+const (
+	FlagReceiptStore = "receipt-store"
+
+	ReceiptStoreDBName = "receipts"
+)
+
+var (
+	gReceiptStore    *ReceiptStore
+	onceReceiptStore sync.Once
+)
+
+// ReceiptStore persists the receipts of the evm txs keyed by tx hash at commit, so that a receipt is looked up
+// without the watch db of fast-query or the tx indexer of tendermint
+type ReceiptStore struct {
+	db dbm.DB
+}
+
+// IsReceiptStoreEnabled returns whether the receipts are persisted in the receipt store
+func IsReceiptStoreEnabled() bool {
+	return viper.GetBool(FlagReceiptStore)
+}
+
+// InstanceOfReceiptStore returns the receipt store, it returns nil if the receipt store isn't enabled
+func InstanceOfReceiptStore() *ReceiptStore {
+	onceReceiptStore.Do(func() {
+		if IsReceiptStoreEnabled() {
+			db, err := sdk.NewDB(ReceiptStoreDBName, filepath.Join(viper.GetString(flags.FlagHome), WatchDbDir))
+			if err != nil {
+				panic(err)
+			}
+			gReceiptStore = NewReceiptStore(db)
+		}
+	})
+	return gReceiptStore
+}
+
+// NewReceiptStore creates a receipt store persisting the receipts in db
+func NewReceiptStore(db dbm.DB) *ReceiptStore {
+	return &ReceiptStore{db: db}
+}
+
+// Write persists the receipts of a block in a batch
+func (s *ReceiptStore) Write(receipts []*MsgTransactionReceipt) error {
+	if len(receipts) == 0 {
+		return nil
+	}
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	for _, receipt := range receipts {
+		batch.Set(receipt.txHash, []byte(receipt.GetValue()))
+	}
+	return batch.Write()
+}
+
+// Get returns the receipt of the evm tx
+func (s *ReceiptStore) Get(hash common.Hash) (*TransactionReceipt, error) {
+	bz, err := s.db.Get(hash.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, errNotFound
+	}
+	var protoReceipt prototypes.TransactionReceipt
+	if err := proto.Unmarshal(bz, &protoReceipt); err != nil {
+		return nil, err
+	}
+	return protoToReceipt(&protoReceipt), nil
+}
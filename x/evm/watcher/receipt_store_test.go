@@ -0,0 +1,58 @@
+package watcher
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	dbm "github.com/okex/exchain/libs/tm-db"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+func TestReceiptStore(t *testing.T) {
+	store := NewReceiptStore(dbm.NewMemDB())
+	// the watcher records the receipts for the receipt store only
+	w := &Watcher{cumulativeGas: make(map[uint64]uint64), log: log.NewNopLogger(), receiptStore: store}
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	to := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	msg := types.NewMsgEthereumTx(0, &to, big.NewInt(10), 21000, big.NewInt(1), nil)
+	require.NoError(t, msg.Sign(big.NewInt(66), priv.ToECDSA()))
+	require.NoError(t, msg.VerifySig(big.NewInt(66), 1))
+	txHash := common.BytesToHash(msg.TxHash())
+	blockHash := common.HexToHash("0x1")
+	txLog := &ethtypes.Log{Address: to, Topics: []common.Hash{common.HexToHash("0x2")}, BlockNumber: 10, TxHash: txHash, BlockHash: blockHash}
+
+	w.NewHeight(10, blockHash, abci.Header{Height: 10})
+	w.SaveTransactionReceipt(TransactionSuccess, msg, txHash, 0, &types.ResultData{Logs: []*ethtypes.Log{txLog}}, 21000)
+	require.Empty(t, w.batch)
+	_, err = store.Get(txHash)
+	require.Error(t, err)
+
+	w.Commit()
+	receipt, err := store.Get(txHash)
+	require.NoError(t, err)
+	require.Equal(t, txHash.String(), receipt.TransactionHash)
+	require.Equal(t, blockHash.String(), receipt.BlockHash)
+	require.Equal(t, uint64(10), uint64(receipt.BlockNumber))
+	require.Equal(t, uint64(TransactionSuccess), uint64(receipt.Status))
+	require.Equal(t, uint64(21000), uint64(receipt.CumulativeGasUsed))
+	require.Equal(t, msg.GetFrom(), receipt.From)
+	require.Equal(t, &to, receipt.To)
+	require.Equal(t, []*ethtypes.Log{txLog}, receipt.Logs)
+
+	// the querier looks the receipt up in the receipt store without the watch db
+	q := Querier{receipts: store}
+	res, err := q.GetTransactionReceipt(txHash)
+	require.NoError(t, err)
+	require.Equal(t, receipt, res)
+	_, err = q.GetTransactionReceipt(blockHash)
+	require.Error(t, err)
+}
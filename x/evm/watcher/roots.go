@@ -0,0 +1,48 @@
+package watcher
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+// txDataList is the list of the evm txs of a block, encoded in the trie as the legacy txs of ethereum
+type txDataList []*types.TxData
+
+func (l txDataList) Len() int { return len(l) }
+
+func (l txDataList) EncodeIndex(i int, w *bytes.Buffer) {
+	_ = rlp.Encode(w, l[i])
+}
+
+// DeriveTransactionsRoot returns the root of the trie of the evm txs of a block, as the transactionsRoot of an
+// ethereum header. It is the empty root hash if there are no evm txs.
+func DeriveTransactionsRoot(txs []*types.TxData) common.Hash {
+	return ethtypes.DeriveSha(txDataList(txs), trie.NewStackTrie(nil))
+}
+
+// DeriveReceiptsRoot returns the root of the trie of the receipts of a block, as the receiptsRoot of an ethereum
+// header. The receipts are sorted by tx index first.
+func DeriveReceiptsRoot(receipts ethtypes.Receipts) common.Hash {
+	sort.SliceStable(receipts, func(i, j int) bool {
+		return receipts[i].TransactionIndex < receipts[j].TransactionIndex
+	})
+	return ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil))
+}
+
+// NewEthReceipt returns the consensus fields of the receipt of an evm tx, from which the receipts root is derived
+func NewEthReceipt(status uint32, txIndex, cumulativeGas uint64, data *types.ResultData) *ethtypes.Receipt {
+	return &ethtypes.Receipt{
+		Type:              ethtypes.LegacyTxType,
+		Status:            uint64(status),
+		CumulativeGasUsed: cumulativeGas,
+		Bloom:             data.Bloom,
+		Logs:              data.Logs,
+		TransactionIndex:  uint(txIndex),
+	}
+}
@@ -0,0 +1,62 @@
+package watcher
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/app/crypto/ethsecp256k1"
+	"github.com/okex/exchain/x/evm/types"
+)
+
+func TestDeriveRoots(t *testing.T) {
+	require.Equal(t, ethtypes.EmptyRootHash, DeriveTransactionsRoot(nil))
+	require.Equal(t, ethtypes.EmptyRootHash, DeriveReceiptsRoot(nil))
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	to := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	var txs []*types.TxData
+	var ethTxs ethtypes.Transactions
+	for i := uint64(0); i < 3; i++ {
+		msg := types.NewMsgEthereumTx(i, &to, big.NewInt(10), 21000, big.NewInt(1), nil)
+		if i == 2 {
+			msg = types.NewMsgEthereumTxContract(i, nil, 100000, big.NewInt(1), []byte{0x60, 0x80})
+		}
+		require.NoError(t, msg.Sign(big.NewInt(66), priv.ToECDSA()))
+		txs = append(txs, &msg.Data)
+		ethTxs = append(ethTxs, ethtypes.NewTx(&ethtypes.LegacyTx{
+			Nonce:    msg.Data.AccountNonce,
+			GasPrice: msg.Data.Price,
+			Gas:      msg.Data.GasLimit,
+			To:       msg.Data.Recipient,
+			Value:    msg.Data.Amount,
+			Data:     msg.Data.Payload,
+			V:        msg.Data.V,
+			R:        msg.Data.R,
+			S:        msg.Data.S,
+		}))
+	}
+	// the evm txs are in the trie as the legacy txs of ethereum
+	require.Equal(t, ethtypes.DeriveSha(ethTxs, trie.NewStackTrie(nil)), DeriveTransactionsRoot(txs))
+
+	log := &ethtypes.Log{Address: to, Topics: []common.Hash{common.HexToHash("0x1")}, Data: []byte{0x1}}
+	data := &types.ResultData{Bloom: ethtypes.BytesToBloom(ethtypes.LogsBloom([]*ethtypes.Log{log})), Logs: []*ethtypes.Log{log}}
+	expected := ethtypes.Receipts{
+		{Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 21000, Bloom: data.Bloom, Logs: data.Logs},
+		{Status: ethtypes.ReceiptStatusFailed, CumulativeGasUsed: 42000},
+	}
+	// the receipts are sorted by tx index
+	receipts := ethtypes.Receipts{
+		NewEthReceipt(TransactionFailed, 1, 42000, &types.ResultData{}),
+		NewEthReceipt(TransactionSuccess, 0, 21000, data),
+	}
+	root := DeriveReceiptsRoot(receipts)
+	require.Equal(t, ethtypes.DeriveSha(expected, trie.NewStackTrie(nil)), root)
+	require.NotEqual(t, ethtypes.EmptyRootHash, root)
+	require.Equal(t, data.Bloom, ethtypes.CreateBloom(receipts))
+}
@@ -0,0 +1,287 @@
+package watcher
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/viper"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/bank"
+	tm "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/kv"
+	dbm "github.com/okex/exchain/libs/tm-db"
+	"github.com/okex/exchain/x/evm/types"
+	wasmtypes "github.com/okex/exchain/x/wasm/types"
+)
+
+const (
+	FlagTransferStore = "transfer-store"
+
+	TransferStoreDBName = "transfers"
+
+	TransferKindNative = "native"
+	TransferKindERC20  = "erc20"
+	TransferKindCW20   = "cw20"
+)
+
+var (
+	gTransferStore    *TransferStore
+	onceTransferStore sync.Once
+
+	// erc20TransferTopic is the topic of the erc20 event Transfer(address,address,uint256)
+	erc20TransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+	// cw20TransferActions are the actions of the cw20 contracts moving tokens
+	cw20TransferActions = map[string]bool{
+		"transfer":      true,
+		"transfer_from": true,
+		"send":          true,
+		"send_from":     true,
+		"mint":          true,
+		"burn":          true,
+		"burn_from":     true,
+	}
+)
+
+// Transfer is a transfer of the native coins, erc20 or cw20 tokens between two accounts. The amount of the native
+// coins is a decimal of the coin, the amount of the tokens is the integer amount of the contract.
+type Transfer struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	TxHash      common.Hash    `json:"transactionHash"`
+	TxIndex     hexutil.Uint64 `json:"transactionIndex"`
+	Kind        string         `json:"kind"`
+	Token       string         `json:"token"`
+	From        common.Address `json:"from"`
+	To          common.Address `json:"to"`
+	Amount      string         `json:"amount"`
+}
+
+// TransferStore indexes the transfers of the delivered txs by the accounts sending and receiving them at commit,
+// so the whole transfer history of an account is queried from a single store
+type TransferStore struct {
+	db dbm.DB
+}
+
+// IsTransferStoreEnabled returns whether the transfers are indexed in the transfer store
+func IsTransferStoreEnabled() bool {
+	return viper.GetBool(FlagTransferStore)
+}
+
+// InstanceOfTransferStore returns the transfer store, it returns nil if the transfer store isn't enabled
+func InstanceOfTransferStore() *TransferStore {
+	onceTransferStore.Do(func() {
+		if IsTransferStoreEnabled() {
+			db, err := sdk.NewDB(TransferStoreDBName, filepath.Join(viper.GetString(flags.FlagHome), WatchDbDir))
+			if err != nil {
+				panic(err)
+			}
+			gTransferStore = NewTransferStore(db)
+		}
+	})
+	return gTransferStore
+}
+
+// NewTransferStore creates a transfer store indexing the transfers in db
+func NewTransferStore(db dbm.DB) *TransferStore {
+	return &TransferStore{db: db}
+}
+
+// transferKey is the key of the nth transfer of a block under an account, so the transfers of an account are ordered
+// as they happened
+func transferKey(addr common.Address, height uint64, n int) []byte {
+	key := make([]byte, common.AddressLength+12)
+	copy(key, addr.Bytes())
+	binary.BigEndian.PutUint64(key[common.AddressLength:], height)
+	binary.BigEndian.PutUint32(key[common.AddressLength+8:], uint32(n))
+	return key
+}
+
+// Write indexes the transfers of a block in a batch, in the order of the transfers in the block
+func (s *TransferStore) Write(transfers []*Transfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	for i, transfer := range transfers {
+		bz, err := json.Marshal(transfer)
+		if err != nil {
+			return err
+		}
+		// the minted tokens have no sender and the burned tokens have no recipient
+		height := uint64(transfer.BlockNumber)
+		if transfer.From != (common.Address{}) {
+			batch.Set(transferKey(transfer.From, height, i), bz)
+		}
+		if transfer.To != (common.Address{}) && transfer.To != transfer.From {
+			batch.Set(transferKey(transfer.To, height, i), bz)
+		}
+	}
+	return batch.Write()
+}
+
+// GetTransfers returns the transfers sent or received by the account from the latest one, skipping the offset latest
+// transfers
+func (s *TransferStore) GetTransfers(addr common.Address, offset, limit int) ([]*Transfer, error) {
+	it, err := s.db.ReverseIterator(addr.Bytes(), sdk.PrefixEndBytes(addr.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	transfers := make([]*Transfer, 0, limit)
+	for ; it.Valid() && len(transfers) < limit; it.Next() {
+		if offset > 0 {
+			offset--
+			continue
+		}
+		var transfer Transfer
+		if err := json.Unmarshal(it.Value(), &transfer); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, &transfer)
+	}
+	return transfers, nil
+}
+
+// parseTransfers returns the transfers of a delivered tx, the native transfers from the bank transfer events and the
+// value of the evm tx, the erc20 transfers from the logs of the evm tx and the cw20 transfers from the wasm events.
+// The value and the logs of the evm tx are only taken if the tx succeeds.
+func parseTransfers(height uint64, txHash common.Hash, txIndex uint64, resp *tm.ResponseDeliverTx, resultData *types.ResultData) []*Transfer {
+	var transfers []*Transfer
+	add := func(kind, token string, from, to common.Address, amount string) {
+		transfers = append(transfers, &Transfer{
+			BlockNumber: hexutil.Uint64(height),
+			TxHash:      txHash,
+			TxIndex:     hexutil.Uint64(txIndex),
+			Kind:        kind,
+			Token:       token,
+			From:        from,
+			To:          to,
+			Amount:      amount,
+		})
+	}
+
+	var evmValue *big.Int
+	var evmSender, evmRecipient *common.Address
+	for _, event := range resp.Events {
+		switch event.Type {
+		case bank.EventTypeTransfer:
+			attrs := eventAttributes(event.Attributes)
+			from, _ := parseTransferAddress(attrs[bank.AttributeKeySender])
+			to, ok := parseTransferAddress(attrs[bank.AttributeKeyRecipient])
+			if !ok {
+				continue
+			}
+			coins, err := sdk.ParseDecCoins(attrs[sdk.AttributeKeyAmount])
+			if err != nil {
+				continue
+			}
+			for _, coin := range coins {
+				add(TransferKindNative, coin.Denom, from, to, coin.Amount.String())
+			}
+		case types.EventTypeEthereumTx:
+			for _, attr := range event.Attributes {
+				switch string(attr.Key) {
+				case sdk.AttributeKeyAmount:
+					evmValue, _ = new(big.Int).SetString(string(attr.Value), 10)
+				case types.AttributeKeyRecipient:
+					if addr, ok := parseTransferAddress(string(attr.Value)); ok {
+						evmRecipient = &addr
+					}
+				}
+			}
+		case sdk.EventTypeMessage:
+			attrs := eventAttributes(event.Attributes)
+			if attrs[sdk.AttributeKeyModule] == types.AttributeValueCategory {
+				if addr, ok := parseTransferAddress(attrs[sdk.AttributeKeySender]); ok {
+					evmSender = &addr
+				}
+			}
+		case wasmtypes.WasmModuleEventType:
+			for _, attrs := range contractAttributes(event.Attributes) {
+				contract, ok := parseTransferAddress(attrs[wasmtypes.AttributeKeyContractAddr])
+				if !ok || !cw20TransferActions[attrs["action"]] {
+					continue
+				}
+				from, okFrom := parseTransferAddress(attrs["from"])
+				to, okTo := parseTransferAddress(attrs["to"])
+				if !okFrom && !okTo {
+					continue
+				}
+				add(TransferKindCW20, contract.String(), from, to, attrs["amount"])
+			}
+		}
+	}
+	if !resp.IsOK() {
+		return transfers
+	}
+
+	if resultData != nil && evmRecipient == nil && resultData.ContractAddress != (common.Address{}) {
+		// the value of the contract creation is sent to the created contract
+		evmRecipient = &resultData.ContractAddress
+	}
+	if evmValue != nil && evmValue.Sign() > 0 && evmSender != nil && evmRecipient != nil {
+		add(TransferKindNative, sdk.DefaultBondDenom, *evmSender, *evmRecipient,
+			sdk.NewDecFromBigIntWithPrec(evmValue, sdk.Precision).String())
+	}
+	if resultData != nil {
+		for _, log := range resultData.Logs {
+			if from, to, amount, ok := parseERC20Transfer(log); ok {
+				add(TransferKindERC20, log.Address.String(), from, to, amount.String())
+			}
+		}
+	}
+	return transfers
+}
+
+// parseERC20Transfer parses the erc20 Transfer log, the erc721 Transfer log with an indexed token id isn't matched
+func parseERC20Transfer(log *ethtypes.Log) (from, to common.Address, amount *big.Int, ok bool) {
+	if len(log.Topics) != 3 || log.Topics[0] != erc20TransferTopic || len(log.Data) != common.HashLength {
+		return
+	}
+	return common.BytesToAddress(log.Topics[1].Bytes()), common.BytesToAddress(log.Topics[2].Bytes()),
+		new(big.Int).SetBytes(log.Data), true
+}
+
+// parseTransferAddress parses a bech32 or hex address
+func parseTransferAddress(s string) (common.Address, bool) {
+	if common.IsHexAddress(s) {
+		return common.HexToAddress(s), true
+	}
+	addr, err := sdk.AccAddressFromBech32(s)
+	if err != nil || len(addr) != common.AddressLength {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(addr), true
+}
+
+func eventAttributes(attrs []kv.Pair) map[string]string {
+	m := make(map[string]string, len(attrs))
+	for _, attr := range attrs {
+		m[string(attr.Key)] = string(attr.Value)
+	}
+	return m
+}
+
+// contractAttributes splits the attributes of a wasm event into the attributes of each contract, the attributes of a
+// contract start with its address
+func contractAttributes(attrs []kv.Pair) []map[string]string {
+	var contracts []map[string]string
+	for _, attr := range attrs {
+		if string(attr.Key) == wasmtypes.AttributeKeyContractAddr || len(contracts) == 0 {
+			contracts = append(contracts, make(map[string]string))
+		}
+		contracts[len(contracts)-1][string(attr.Key)] = string(attr.Value)
+	}
+	return contracts
+}
@@ -0,0 +1,121 @@
+package watcher
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/bank"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/kv"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	dbm "github.com/okex/exchain/libs/tm-db"
+	"github.com/okex/exchain/x/evm/types"
+	wasmtypes "github.com/okex/exchain/x/wasm/types"
+)
+
+func newTestEvent(typ string, attrs ...string) abci.Event {
+	event := abci.Event{Type: typ}
+	for i := 0; i < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, kv.Pair{Key: []byte(attrs[i]), Value: []byte(attrs[i+1])})
+	}
+	return event
+}
+
+func TestTransferStore(t *testing.T) {
+	store := NewTransferStore(dbm.NewMemDB())
+	// the watcher records the transfers for the transfer store only
+	w := &Watcher{cumulativeGas: make(map[uint64]uint64), log: log.NewNopLogger(), transferStore: store}
+
+	alice := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	bob := common.HexToAddress("0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359")
+	erc20 := common.HexToAddress("0x1")
+	cw20 := common.HexToAddress("0x2")
+
+	w.NewHeight(10, common.HexToHash("0x10"), abci.Header{Height: 10})
+	// a bank send of two coins
+	w.saveTransfers([]byte{1}, &abci.ResponseDeliverTx{Events: []abci.Event{
+		newTestEvent(bank.EventTypeTransfer,
+			bank.AttributeKeyRecipient, sdk.AccAddress(bob.Bytes()).String(),
+			bank.AttributeKeySender, sdk.AccAddress(alice.Bytes()).String(),
+			sdk.AttributeKeyAmount, "1.500000000000000000okt,2.000000000000000000usdt"),
+	}}, nil)
+	// an evm tx sending value and erc20 tokens
+	erc20Log := &ethtypes.Log{
+		Address: erc20,
+		Topics:  []common.Hash{erc20TransferTopic, alice.Hash(), bob.Hash()},
+		Data:    common.BigToHash(big.NewInt(100)).Bytes(),
+	}
+	w.saveTransfers([]byte{2}, &abci.ResponseDeliverTx{Events: []abci.Event{
+		newTestEvent(types.EventTypeEthereumTx, sdk.AttributeKeyAmount, "1000000000000000000"),
+		newTestEvent(sdk.EventTypeMessage, sdk.AttributeKeyModule, types.AttributeValueCategory, sdk.AttributeKeySender, alice.String()),
+		newTestEvent(types.EventTypeEthereumTx, types.AttributeKeyRecipient, erc20.String()),
+	}}, &types.ResultData{Logs: []*ethtypes.Log{erc20Log}})
+	// a failed evm tx only keeps the transfers of its events
+	w.saveTransfers([]byte{3}, &abci.ResponseDeliverTx{Code: 1, Events: []abci.Event{
+		newTestEvent(types.EventTypeEthereumTx, sdk.AttributeKeyAmount, "1"),
+		newTestEvent(sdk.EventTypeMessage, sdk.AttributeKeyModule, types.AttributeValueCategory, sdk.AttributeKeySender, alice.String()),
+		newTestEvent(types.EventTypeEthereumTx, types.AttributeKeyRecipient, bob.String()),
+	}}, &types.ResultData{Logs: []*ethtypes.Log{erc20Log}})
+	// a cw20 mint to bob, a transfer from bob and an action which isn't a transfer
+	w.saveTransfers([]byte{4}, &abci.ResponseDeliverTx{Events: []abci.Event{
+		newTestEvent(wasmtypes.WasmModuleEventType,
+			wasmtypes.AttributeKeyContractAddr, sdk.AccAddress(cw20.Bytes()).String(), "action", "mint", "to", bob.String(), "amount", "7",
+			wasmtypes.AttributeKeyContractAddr, cw20.String(), "action", "transfer", "from", bob.String(), "to", alice.String(), "amount", "3",
+			wasmtypes.AttributeKeyContractAddr, cw20.String(), "action", "increase_allowance", "owner", bob.String(), "spender", alice.String()),
+	}}, nil)
+	_, err := store.GetTransfers(bob, 0, 10)
+	require.NoError(t, err)
+
+	w.Commit()
+	require.Empty(t, w.transfers)
+
+	transfers, err := store.GetTransfers(bob, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, transfers, 5)
+	expected := []struct {
+		txIndex  uint64
+		kind     string
+		token    string
+		from, to common.Address
+		amount   string
+	}{
+		{3, TransferKindCW20, cw20.String(), bob, alice, "3"},
+		{3, TransferKindCW20, cw20.String(), common.Address{}, bob, "7"},
+		{1, TransferKindERC20, erc20.String(), alice, bob, "100"},
+		{0, TransferKindNative, "usdt", alice, bob, "2.000000000000000000"},
+		{0, TransferKindNative, "okt", alice, bob, "1.500000000000000000"},
+	}
+	for i, transfer := range transfers {
+		require.Equal(t, uint64(10), uint64(transfer.BlockNumber))
+		require.Equal(t, common.BytesToHash([]byte{byte(expected[i].txIndex + 1)}), transfer.TxHash)
+		require.Equal(t, expected[i].txIndex, uint64(transfer.TxIndex))
+		require.Equal(t, expected[i].kind, transfer.Kind)
+		require.Equal(t, expected[i].token, transfer.Token)
+		require.Equal(t, expected[i].from, transfer.From)
+		require.Equal(t, expected[i].to, transfer.To)
+		require.Equal(t, expected[i].amount, transfer.Amount)
+	}
+
+	// the value of the evm tx is a transfer of alice only
+	transfers, err = store.GetTransfers(alice, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, transfers, 5)
+	require.Equal(t, TransferKindNative, transfers[2].Kind)
+	require.Equal(t, erc20, transfers[2].To)
+	require.Equal(t, "1.000000000000000000", transfers[2].Amount)
+
+	// paginated from the latest transfer
+	transfers, err = store.GetTransfers(bob, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+	require.Equal(t, "7", transfers[0].Amount)
+	require.Equal(t, "100", transfers[1].Amount)
+	transfers, err = store.GetTransfers(bob, 5, 2)
+	require.NoError(t, err)
+	require.Empty(t, transfers)
+}
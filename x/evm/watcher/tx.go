@@ -16,10 +16,20 @@ type WatchTx interface {
 	GetTxHash() common.Hash
 	GetFailedReceipts(cumulativeGas, gasUsed uint64) *TransactionReceipt
 	GetIndex() uint64
+	GetTxData() *types.TxData
 }
 
 func (w *Watcher) RecordTxAndFailedReceipt(tx tm.TxEssentials, resp *tm.ResponseDeliverTx, txDecoder sdk.TxDecoder) {
-	if !w.Enabled() {
+	if resp != nil {
+		var resultData *types.ResultData
+		if resp.IsOK() && w.IsRealEvmTx(resp) {
+			if data, err := types.DecodeResultData(resp.Data); err == nil {
+				resultData = &data
+			}
+		}
+		w.saveTransfers(tx.TxHash(), resp, resultData)
+	}
+	if !w.recordsReceipts() {
 		return
 	}
 
@@ -50,6 +60,9 @@ func (w *Watcher) RecordTxAndFailedReceipt(tx tm.TxEssentials, resp *tm.Response
 			w.SaveTransactionReceipt(TransactionSuccess, evmTx, watchTx.GetTxHash(), watchTx.GetIndex(), &types.ResultData{}, uint64(resp.GasUsed))
 		}
 	case sdk.StdTxType:
+		if !w.Enabled() {
+			return
+		}
 		w.blockStdTxs = append(w.blockStdTxs, common.BytesToHash(realTx.TxHash()))
 		txResult := &ctypes.ResultTx{
 			Hash:     tx.TxHash(),
@@ -128,10 +141,17 @@ func (w *Watcher) saveTx(tx WatchTx) {
 			w.InfuraKeeper.OnSaveTransaction(*ethTx)
 		}
 	}
+	if !w.Enabled() {
+		// only the receipts are recorded for the receipt store
+		return
+	}
 	if txWatchMessage := tx.GetTxWatchMessage(); txWatchMessage != nil {
 		w.batch = append(w.batch, txWatchMessage)
 	}
 	w.blockTxs = append(w.blockTxs, tx.GetTxHash())
+	if txData := tx.GetTxData(); txData != nil {
+		w.blockEthTxs = append(w.blockEthTxs, txData)
+	}
 }
 
 func (w *Watcher) saveFailedReceipts(watchTx WatchTx, gasUsed uint64) {
@@ -140,19 +160,15 @@ func (w *Watcher) saveFailedReceipts(watchTx WatchTx, gasUsed uint64) {
 	}
 	w.UpdateCumulativeGas(watchTx.GetIndex(), gasUsed)
 	receipt := watchTx.GetFailedReceipts(w.cumulativeGas[watchTx.GetIndex()], gasUsed)
-	if w.InfuraKeeper != nil {
-		w.InfuraKeeper.OnSaveTransactionReceipt(*receipt)
-	}
-	wMsg := NewMsgTransactionReceipt(*receipt, watchTx.GetTxHash())
-	if wMsg != nil {
-		w.batch = append(w.batch, wMsg)
-	}
+	w.blockReceipts = append(w.blockReceipts, NewEthReceipt(TransactionFailed, watchTx.GetIndex(), w.cumulativeGas[watchTx.GetIndex()], &types.ResultData{}))
+	w.saveReceipt(*receipt, watchTx.GetTxHash())
 }
 
 // SaveParallelTx saves parallel transactions and transactionReceipts to watcher
 func (w *Watcher) SaveParallelTx(realTx sdk.Tx, resultData *types.ResultData, resp tm.ResponseDeliverTx) {
+	w.saveTransfers(realTx.TxHash(), &resp, resultData)
 
-	if !w.Enabled() {
+	if !w.recordsReceipts() {
 		return
 	}
 
@@ -177,6 +193,9 @@ func (w *Watcher) SaveParallelTx(realTx sdk.Tx, resultData *types.ResultData, re
 			w.saveFailedReceipts(watchTx, uint64(resp.GasUsed))
 		}
 	case sdk.StdTxType:
+		if !w.Enabled() {
+			return
+		}
 		w.blockStdTxs = append(w.blockStdTxs, common.BytesToHash(realTx.TxHash()))
 		txResult := &ctypes.ResultTx{
 			Hash:     realTx.TxHash(),
@@ -187,3 +206,13 @@ func (w *Watcher) SaveParallelTx(realTx sdk.Tx, resultData *types.ResultData, re
 		w.saveStdTxResponse(txResult)
 	}
 }
+
+// saveTransfers adds the transfers of the delivered tx to the transfers indexed by the transfer store, every delivered
+// tx of the block must be passed in order
+func (w *Watcher) saveTransfers(txHash []byte, resp *tm.ResponseDeliverTx, resultData *types.ResultData) {
+	if w.transferStore == nil {
+		return
+	}
+	w.transfers = append(w.transfers, parseTransfers(w.height, common.BytesToHash(txHash), w.txIndex, resp, resultData)...)
+	w.txIndex++
+}
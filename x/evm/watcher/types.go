@@ -658,15 +658,12 @@ func (tr *Transaction) GetValue() string {
 	return string(buf)
 }
 
-func newBlock(height uint64, blockBloom ethtypes.Bloom, blockHash common.Hash, header abci.Header, gasLimit uint64, gasUsed *big.Int, txs interface{}) Block {
+func newBlock(height uint64, blockBloom ethtypes.Bloom, blockHash common.Hash, header abci.Header, gasLimit uint64, gasUsed *big.Int, txs interface{},
+	transactionsRoot, receiptsRoot common.Hash) Block {
 	timestamp := header.Time.Unix()
 	if timestamp < 0 {
 		timestamp = time.Now().Unix()
 	}
-	transactionsRoot := ethtypes.EmptyRootHash
-	if len(header.DataHash) > 0 {
-		transactionsRoot = common.BytesToHash(header.DataHash)
-	}
 	return Block{
 		Number:           hexutil.Uint64(height),
 		Hash:             blockHash,
@@ -686,7 +683,7 @@ func newBlock(height uint64, blockBloom ethtypes.Bloom, blockHash common.Hash, h
 		GasUsed:          (*hexutil.Big)(gasUsed),
 		Timestamp:        hexutil.Uint64(timestamp),
 		Uncles:           []common.Hash{},
-		ReceiptsRoot:     common.Hash{},
+		ReceiptsRoot:     receiptsRoot,
 		Transactions:     txs,
 	}
 }
@@ -37,6 +37,8 @@ type Watcher struct {
 	gasUsed        uint64
 	blockTxs       []common.Hash
 	blockStdTxs    []common.Hash
+	blockEthTxs    []*evmtypes.TxData
+	blockReceipts  ethtypes.Receipts
 	enable         bool
 	firstUse       bool
 	delayEraseKey  [][]byte
@@ -51,6 +53,11 @@ type Watcher struct {
 	filterMap     map[string]struct{}
 	InfuraKeeper  InfuraKeeper
 	delAccountMtx sync.Mutex
+	receiptStore  *ReceiptStore
+	receipts      []*MsgTransactionReceipt
+	transferStore *TransferStore
+	transfers     []*Transfer
+	txIndex       uint64
 }
 
 var (
@@ -85,6 +92,8 @@ func NewWatcher(logger log.Logger) *Watcher {
 		checkWd:        viper.GetBool(FlagCheckWd),
 		filterMap:      make(map[string]struct{}),
 		eraseKeyFilter: make(map[string][]byte),
+		receiptStore:   InstanceOfReceiptStore(),
+		transferStore:  InstanceOfTransferStore(),
 	}
 }
 
@@ -109,12 +118,17 @@ func (w *Watcher) Enable(enable bool) {
 	w.enable = enable
 }
 
+// recordsReceipts returns whether the receipts are recorded, for the watch db or the receipt store
+func (w *Watcher) recordsReceipts() bool {
+	return w.Enabled() || w.receiptStore != nil
+}
+
 func (w *Watcher) GetEvmTxIndex() uint64 {
 	return w.evmTxIndex
 }
 
 func (w *Watcher) NewHeight(height uint64, blockHash common.Hash, header types.Header) {
-	if !w.Enabled() {
+	if !w.recordsReceipts() && w.transferStore == nil {
 		return
 	}
 	w.header = header
@@ -130,25 +144,39 @@ func (w *Watcher) NewHeight(height uint64, blockHash common.Hash, header types.H
 	w.gasUsed = 0
 	w.blockTxs = []common.Hash{}
 	w.blockStdTxs = []common.Hash{}
+	w.blockEthTxs = nil
+	w.blockReceipts = nil
+	w.receipts = nil
+	w.transfers = nil
+	w.txIndex = 0
 }
 
 func (w *Watcher) SaveTransactionReceipt(status uint32, msg *evmtypes.MsgEthereumTx, txHash common.Hash, txIndex uint64, data *evmtypes.ResultData, gasUsed uint64) {
-	if !w.Enabled() {
+	if !w.recordsReceipts() {
 		return
 	}
 	w.UpdateCumulativeGas(txIndex, gasUsed)
 	tr := newTransactionReceipt(status, msg, txHash, w.blockHash, txIndex, w.height, data, w.cumulativeGas[txIndex], gasUsed)
+	w.blockReceipts = append(w.blockReceipts, NewEthReceipt(status, txIndex, w.cumulativeGas[txIndex], data))
+	w.saveReceipt(tr, txHash)
+}
+
+// saveReceipt adds the receipt to the batch of the watch db, and to the receipts persisted by the receipt store
+func (w *Watcher) saveReceipt(tr TransactionReceipt, txHash common.Hash) {
 	if w.InfuraKeeper != nil {
 		w.InfuraKeeper.OnSaveTransactionReceipt(tr)
 	}
 	wMsg := NewMsgTransactionReceipt(tr, txHash)
-	if wMsg != nil {
+	if w.Enabled() {
 		w.batch = append(w.batch, wMsg)
 	}
+	if w.receiptStore != nil {
+		w.receipts = append(w.receipts, wMsg)
+	}
 }
 
 func (w *Watcher) UpdateCumulativeGas(txIndex, gasUsed uint64) {
-	if !w.Enabled() {
+	if !w.recordsReceipts() {
 		return
 	}
 	if len(w.cumulativeGas) == 0 {
@@ -215,7 +243,8 @@ func (w *Watcher) SaveBlock(bloom ethtypes.Bloom) {
 	if !w.Enabled() {
 		return
 	}
-	block := newBlock(w.height, bloom, w.blockHash, w.header, uint64(0xffffffff), big.NewInt(int64(w.gasUsed)), w.blockTxs)
+	block := newBlock(w.height, bloom, w.blockHash, w.header, uint64(0xffffffff), big.NewInt(int64(w.gasUsed)), w.blockTxs,
+		DeriveTransactionsRoot(w.blockEthTxs), DeriveReceiptsRoot(w.blockReceipts))
 	if w.InfuraKeeper != nil {
 		w.InfuraKeeper.OnSaveBlock(block)
 	}
@@ -323,6 +352,18 @@ func (w *Watcher) CommitCodeHashToDb(hash []byte, code []byte) {
 }
 
 func (w *Watcher) Commit() {
+	if w.receiptStore != nil {
+		if err := w.receiptStore.Write(w.receipts); err != nil {
+			w.log.Error("failed to persist the receipts", "height", w.height, "err", err)
+		}
+		w.receipts = nil
+	}
+	if w.transferStore != nil {
+		if err := w.transferStore.Write(w.transfers); err != nil {
+			w.log.Error("failed to index the transfers", "height", w.height, "err", err)
+		}
+		w.transfers = nil
+	}
 	if !w.Enabled() {
 		return
 	}
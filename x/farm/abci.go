@@ -2,6 +2,7 @@ package farm
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
@@ -14,6 +15,21 @@ import (
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	logger := k.Logger(ctx)
 
+	// end the boosted locks reaching the unlock time, the tokens are kept locked without the boost
+	for _, boostedLock := range k.GetEndedBoostedLocks(ctx, ctx.BlockTime()) {
+		rewards, err := k.EndBoostedLock(ctx, boostedLock)
+		if err != nil {
+			panic(err)
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeBoostedLockEnd,
+			sdk.NewAttribute(types.AttributeKeyAddress, boostedLock.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPool, boostedLock.PoolName),
+			sdk.NewAttribute(types.AttributeKeyLockID, strconv.FormatUint(boostedLock.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyClaimed, rewards.String()),
+		))
+	}
+
 	moduleAcc := k.SupplyKeeper().GetModuleAccount(ctx, MintFarmingAccount)
 	yieldedNativeTokenAmt := moduleAcc.GetCoins().AmountOf(sdk.DefaultBondDenom)
 	logger.Debug(fmt.Sprintf("MintFarmingAccount [%s] balance: %s%s",
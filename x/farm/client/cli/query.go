@@ -7,6 +7,7 @@ import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/version"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
 	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
@@ -14,6 +15,8 @@ import (
 	"github.com/okex/exchain/x/farm/types"
 )
 
+const flagPool = "pool"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	// Group farm queries under a subcommand
@@ -30,6 +33,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdQueryPools(queryRoute, cdc),
 			GetCmdQueryPoolNum(queryRoute, cdc),
 			GetCmdQueryLockInfo(queryRoute, cdc),
+			GetCmdQueryLockBoosts(queryRoute, cdc),
+			GetCmdQueryLockSchedule(queryRoute, cdc),
 			GetCmdQueryEarnings(queryRoute, cdc),
 			GetCmdQueryAccount(queryRoute, cdc),
 			GetCmdQueryAccountsLockedTo(queryRoute, cdc),
@@ -360,3 +365,82 @@ $ %s query farm lock-info pool-eth-xxb ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02
 		},
 	}
 }
+
+// GetCmdQueryLockBoosts gets the lock boosts offered by a specific pool
+func GetCmdQueryLockBoosts(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "lock-boosts [pool-name]",
+		Short: "query the lock boosts offered by a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the reward multipliers of the lock durations offered by a specific pool and the early unlock penalty rate.
+
+Example:
+$ %s query farm lock-boosts pool-eth-xxb
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			jsonBytes, err := cdc.MarshalJSON(types.NewQueryPoolParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryLockBoosts)
+			bz, _, err := cliCtx.QueryWithData(route, jsonBytes)
+			if err != nil {
+				return err
+			}
+
+			var poolLockBoosts types.PoolLockBoosts
+			cdc.MustUnmarshalJSON(bz, &poolLockBoosts)
+			return cliCtx.PrintOutput(poolLockBoosts)
+		},
+	}
+}
+
+// GetCmdQueryLockSchedule gets the boosted locks of an account
+func GetCmdQueryLockSchedule(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-schedule [address]",
+		Short: "query the boosted locks of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the boosted locks of an account with their unlock time, in all the pools or a specific pool.
+
+Example:
+$ %s query farm lock-schedule ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02 --pool pool-eth-xxb
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			accAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			poolName := viper.GetString(flagPool)
+			jsonBytes, err := cdc.MarshalJSON(types.NewQueryPoolAccountParams(poolName, accAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryLockSchedule)
+			bz, _, err := cliCtx.QueryWithData(route, jsonBytes)
+			if err != nil {
+				return err
+			}
+
+			var boostedLocks types.BoostedLocks
+			cdc.MustUnmarshalJSON(bz, &boostedLocks)
+			return cliCtx.PrintOutput(boostedLocks)
+		},
+	}
+	cmd.Flags().String(flagPool, "", "query the boosted locks in a specific pool only")
+	return cmd
+}
@@ -6,6 +6,7 @@ import (
 	interfacetypes "github.com/okex/exchain/libs/cosmos-sdk/codec/types"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
@@ -36,6 +37,9 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdLock(cdc),
 		GetCmdUnlock(cdc),
 		GetCmdClaim(cdc),
+		GetCmdSetLockBoosts(cdc),
+		GetCmdBoostedLock(cdc),
+		GetCmdEarlyUnlock(cdc),
 	)...)
 	return farmTxCmd
 }
@@ -221,6 +225,132 @@ $ %s tx farm claim --from mykey
 	return cmd
 }
 
+func GetCmdSetLockBoosts(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-lock-boosts [pool-name] [lock-boosts] [early-unlock-penalty-rate]",
+		Short: "set the reward multipliers of the lock durations offered by a farm pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the reward multipliers of the lock durations offered by a farm pool and the penalty rate
+of unlocking before the lock ends. The lock boosts are removed with an empty string.
+
+Example:
+$ %s tx farm set-lock-boosts pool-eth-xxb "720h:1.5,2160h:2" 0.1 --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			lockBoosts, err := parseLockBoosts(args[1])
+			if err != nil {
+				return err
+			}
+
+			penaltyRate, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			poolName := args[0]
+			msg := types.NewMsgSetLockBoosts(cliCtx.GetFromAddress(), poolName, lockBoosts, penaltyRate)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetCmdBoostedLock(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "boosted-lock [pool-name] [amount] [lock-duration]",
+		Short: "lock a number of tokens for a lock duration to get boosted rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock a number of tokens for one of the lock durations offered by the pool to get boosted rewards.
+The tokens can't be unlocked before the lock ends without paying the early unlock penalty.
+
+Example:
+$ %s tx farm boosted-lock pool-eth-xxb 5eth 720h --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseDecCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			lockDuration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			poolName := args[0]
+			msg := types.NewMsgBoostedLock(poolName, cliCtx.GetFromAddress(), amount, lockDuration)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetCmdEarlyUnlock(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "early-unlock [lock-id]",
+		Short: "unlock the tokens of a boosted lock before it ends with a penalty",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unlock the tokens of a boosted lock before it ends. The early unlock penalty is deducted
+from the tokens and returned to the pool as rewards.
+
+Example:
+$ %s tx farm early-unlock 1 --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			lockID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEarlyUnlock(cliCtx.GetFromAddress(), lockID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// parseLockBoosts parses the lock boosts in the format of "duration:multiplier,duration:multiplier"
+func parseLockBoosts(str string) (types.LockBoosts, error) {
+	lockBoosts := types.LockBoosts{}
+	if strings.TrimSpace(str) == "" {
+		return lockBoosts, nil
+	}
+	for _, item := range strings.Split(str, ",") {
+		kv := strings.Split(strings.TrimSpace(item), ":")
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid lock boost %s, expected duration:multiplier", item)
+		}
+		lockDuration, err := time.ParseDuration(kv[0])
+		if err != nil {
+			return nil, err
+		}
+		multiplier, err := sdk.NewDecFromStr(kv[1])
+		if err != nil {
+			return nil, err
+		}
+		lockBoosts = append(lockBoosts, types.NewLockBoost(lockDuration, multiplier))
+	}
+	return lockBoosts, nil
+}
+
 // GetCmdManageWhiteListProposal implements a command handler for submitting a farm manage white list proposal transaction
 func GetCmdManageWhiteListProposal(cdcP *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
 	return &cobra.Command{
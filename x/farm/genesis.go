@@ -37,6 +37,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 
 	k.SetParams(ctx, data.Params)
 
+	for _, poolLockBoosts := range data.PoolLockBoosts {
+		k.SetPoolLockBoosts(ctx, poolLockBoosts)
+	}
+
+	for _, boostedLock := range data.BoostedLocks {
+		k.AddBoostedLock(ctx, boostedLock)
+	}
+
+	if data.NextBoostedLockID > k.GetNextBoostedLockID(ctx) {
+		k.SetNextBoostedLockID(ctx, data.NextBoostedLockID)
+	}
+
 	// init module account
 	moduleAcc := k.SupplyKeeper().GetModuleAccount(ctx, types.ModuleName)
 	if moduleAcc == nil {
@@ -105,5 +117,23 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) (data types.GenesisState) {
 
 	params := k.GetParams(ctx)
 
-	return types.NewGenesisState(pools, lockInfos, allHistoricalRewards, allCurRewards, whiteList, params)
+	data = types.NewGenesisState(pools, lockInfos, allHistoricalRewards, allCurRewards, whiteList, params)
+
+	data.PoolLockBoosts = make([]types.PoolLockBoosts, 0)
+	k.IterateAllPoolLockBoosts(ctx,
+		func(poolLockBoosts types.PoolLockBoosts) (stop bool) {
+			data.PoolLockBoosts = append(data.PoolLockBoosts, poolLockBoosts)
+			return false
+		},
+	)
+
+	data.BoostedLocks = make(types.BoostedLocks, 0)
+	k.IterateAllBoostedLocks(ctx,
+		func(boostedLock types.BoostedLock) (stop bool) {
+			data.BoostedLocks = append(data.BoostedLocks, boostedLock)
+			return false
+		},
+	)
+	data.NextBoostedLockID = k.GetNextBoostedLockID(ctx)
+	return data
 }
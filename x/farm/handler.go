@@ -52,6 +52,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgClaim(ctx, k, msg)
 			}
+		case types.MsgSetLockBoosts:
+			name = "handleMsgSetLockBoosts"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgSetLockBoosts(ctx, k, msg)
+			}
+		case types.MsgBoostedLock:
+			name = "handleMsgBoostedLock"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgBoostedLock(ctx, k, msg)
+			}
+		case types.MsgEarlyUnlock:
+			name = "handleMsgEarlyUnlock"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgEarlyUnlock(ctx, k, msg)
+			}
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return types.ErrUnknownFarmMsgType(errMsg).Result()
@@ -0,0 +1,146 @@
+package farm
+
+import (
+	"strconv"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/farm/keeper"
+	"github.com/okex/exchain/x/farm/types"
+)
+
+func handleMsgSetLockBoosts(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetLockBoosts) (*sdk.Result, error) {
+	// 0. check pool and owner
+	pool, found := k.GetFarmPool(ctx, msg.PoolName)
+	if !found {
+		return types.ErrNoFarmPoolFound(msg.PoolName).Result()
+	}
+
+	if !pool.Owner.Equals(msg.Owner) {
+		return types.ErrInvalidPoolOwner(msg.Owner.String(), msg.PoolName).Result()
+	}
+
+	// 1. set or remove the lock boosts, the boosted locks already created are not affected
+	if len(msg.LockBoosts) == 0 {
+		k.DeletePoolLockBoosts(ctx, msg.PoolName)
+	} else {
+		k.SetPoolLockBoosts(ctx, types.NewPoolLockBoosts(msg.PoolName, msg.LockBoosts, msg.EarlyUnlockPenaltyRate))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetLockBoosts,
+		sdk.NewAttribute(types.AttributeKeyAddress, msg.Owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPool, msg.PoolName),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgBoostedLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgBoostedLock) (*sdk.Result, error) {
+	// 1. Get the multiplier of the lock duration
+	poolLockBoosts, found := k.GetPoolLockBoosts(ctx, msg.PoolName)
+	if !found {
+		return types.ErrLockDurationNotBoosted(msg.PoolName, msg.LockDuration).Result()
+	}
+	multiplier, found := poolLockBoosts.LockBoosts.GetMultiplier(msg.LockDuration)
+	if !found {
+		return types.ErrLockDurationNotBoosted(msg.PoolName, msg.LockDuration).Result()
+	}
+
+	// 2. Lock the tokens, the rewards of the pool are settled for the address here
+	if res, err := handleMsgLock(ctx, k, types.NewMsgLock(msg.PoolName, msg.Address, msg.Amount)); err != nil {
+		return res, err
+	}
+
+	// 3. Boost the locked tokens until the unlock time
+	boostedLock := types.NewBoostedLock(
+		k.GetNextBoostedLockID(ctx), msg.Address, msg.PoolName, msg.Amount, multiplier,
+		poolLockBoosts.EarlyUnlockPenaltyRate, ctx.BlockTime().Add(msg.LockDuration),
+	)
+	k.AddBoostedLock(ctx, boostedLock)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBoostedLock,
+		sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+		sdk.NewAttribute(types.AttributeKeyPool, msg.PoolName),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyLockID, strconv.FormatUint(boostedLock.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyMultiplier, multiplier.String()),
+		sdk.NewAttribute(types.AttributeKeyUnlockTime, boostedLock.UnlockTime.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgEarlyUnlock(ctx sdk.Context, k keeper.Keeper, msg types.MsgEarlyUnlock) (*sdk.Result, error) {
+	// 1.1 Get the boosted lock and the lock info
+	boostedLock, found := k.GetBoostedLock(ctx, msg.Address, msg.LockID)
+	if !found {
+		return types.ErrNoBoostedLockFound(msg.Address.String(), msg.LockID).Result()
+	}
+	lockInfo, found := k.GetLockInfo(ctx, msg.Address, boostedLock.PoolName)
+	if !found {
+		return types.ErrNoLockInfoFound(msg.Address.String(), boostedLock.PoolName).Result()
+	}
+
+	// 1.2 Get the pool info
+	pool, poolFound := k.GetFarmPool(ctx, boostedLock.PoolName)
+	if !poolFound {
+		return types.ErrNoFarmPoolFound(boostedLock.PoolName).Result()
+	}
+	remainAmount := lockInfo.Amount.Amount.Sub(boostedLock.Amount.Amount)
+	if !remainAmount.IsZero() && remainAmount.LT(pool.MinLockAmount.Amount) {
+		return types.ErrLockAmountBelowMinimum(pool.MinLockAmount.Amount, remainAmount).Result()
+	}
+
+	// 2. Calculate how many provided token & native token could be yielded in current period
+	updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
+
+	// 3. Withdraw rewards with the boost
+	rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Update the lock info, then remove the boost
+	k.UpdateLockInfo(ctx, msg.Address, pool.Name, boostedLock.Amount.Amount.Neg())
+	k.RemoveBoostedLock(ctx, boostedLock)
+
+	// 5. Send the locked-tokens deducted the penalty from farm module account to its own account
+	penalty := sdk.NewDecCoinFromDec(boostedLock.Amount.Denom, boostedLock.Amount.Amount.MulTruncate(boostedLock.PenaltyRate))
+	unlockedAmount := boostedLock.Amount.Sub(penalty)
+	if unlockedAmount.IsPositive() {
+		if err = k.SupplyKeeper().SendCoinsFromModuleToAccount(ctx, ModuleName, msg.Address, unlockedAmount.ToCoins()); err != nil {
+			return nil, types.ErrSendCoinsFromModuleToAccountFailed(err.Error())
+		}
+	}
+
+	// 6. Return the penalty to the pool as the rewards of current period
+	if penalty.IsPositive() {
+		if err = k.SupplyKeeper().SendCoinsFromModuleToModule(ctx, ModuleName, YieldFarmingAccount, penalty.ToCoins()); err != nil {
+			return nil, types.ErrSendCoinsFromModuleToAccountFailed(err.Error())
+		}
+		current := k.GetPoolCurrentRewards(ctx, pool.Name)
+		current.Rewards = current.Rewards.Add2(penalty.ToCoins())
+		k.SetPoolCurrentRewards(ctx, pool.Name, current)
+		updatedPool.TotalAccumulatedRewards = updatedPool.TotalAccumulatedRewards.Add2(penalty.ToCoins())
+	}
+
+	// 7. Update farm pool
+	updatedPool.TotalValueLocked = updatedPool.TotalValueLocked.Sub(boostedLock.Amount)
+	if updatedPool.TotalAccumulatedRewards.IsAllLT(rewards) {
+		panic("should not happen")
+	}
+	updatedPool.TotalAccumulatedRewards = updatedPool.TotalAccumulatedRewards.Sub(rewards)
+	k.SetFarmPool(ctx, updatedPool)
+
+	// 8. notify backend
+	k.OnClaim(ctx, msg.Address, pool.Name, rewards)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEarlyUnlock,
+		sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+		sdk.NewAttribute(types.AttributeKeyPool, pool.Name),
+		sdk.NewAttribute(types.AttributeKeyLockID, strconv.FormatUint(boostedLock.ID, 10)),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, unlockedAmount.String()),
+		sdk.NewAttribute(types.AttributeKeyPenalty, penalty.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -95,6 +95,12 @@ func handleMsgUnlock(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnlock) (*sd
 		return types.ErrInsufficientAmount(lockInfo.Amount.String(), msg.Amount.String()).Result()
 	}
 
+	// the tokens in boosted locks can only be unlocked by early unlock before the locks end
+	_, boostedLockedAmount := k.GetAddressBoostedAmount(ctx, msg.Address, msg.PoolName)
+	if lockInfo.Amount.Amount.Sub(boostedLockedAmount).LT(msg.Amount.Amount) {
+		return types.ErrAmountLockedByBoostedLocks(boostedLockedAmount.String(), msg.Amount.String()).Result()
+	}
+
 	// 1.2 Get the pool info
 	pool, poolFound := k.GetFarmPool(ctx, msg.PoolName)
 	if !poolFound {
@@ -113,6 +113,7 @@ func handleMsgDestroyPool(ctx sdk.Context, k keeper.Keeper, msg types.MsgDestroy
 		},
 	)
 	k.DeletePoolCurrentRewards(ctx, msg.PoolName)
+	k.DeletePoolLockBoosts(ctx, msg.PoolName)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeDestroyPool,
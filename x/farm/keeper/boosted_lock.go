@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/farm/types"
+)
+
+// SetPoolLockBoosts sets the lock boosts offered by a pool into store
+func (k Keeper) SetPoolLockBoosts(ctx sdk.Context, poolLockBoosts types.PoolLockBoosts) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPoolLockBoostsKey(poolLockBoosts.PoolName), k.cdc.MustMarshalBinaryLengthPrefixed(poolLockBoosts))
+}
+
+// GetPoolLockBoosts gets the lock boosts offered by a pool from store
+func (k Keeper) GetPoolLockBoosts(ctx sdk.Context, poolName string) (poolLockBoosts types.PoolLockBoosts, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolLockBoostsKey(poolName))
+	if bz == nil {
+		return poolLockBoosts, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &poolLockBoosts)
+	return poolLockBoosts, true
+}
+
+// DeletePoolLockBoosts deletes the lock boosts offered by a pool from store. The boosted locks already created
+// are not affected
+func (k Keeper) DeletePoolLockBoosts(ctx sdk.Context, poolName string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolLockBoostsKey(poolName))
+}
+
+// IterateAllPoolLockBoosts iterates over all the lock boosts offered by pools
+func (k Keeper) IterateAllPoolLockBoosts(ctx sdk.Context, handler func(poolLockBoosts types.PoolLockBoosts) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PoolLockBoostsPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var poolLockBoosts types.PoolLockBoosts
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &poolLockBoosts)
+		if handler(poolLockBoosts) {
+			break
+		}
+	}
+}
+
+// GetPoolBoostedAmount gets the sum of the boosted amount of all the boosted locks in a pool
+func (k Keeper) GetPoolBoostedAmount(ctx sdk.Context, poolName string) (boostedAmount sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolBoostedAmountKey(poolName))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &boostedAmount)
+	return
+}
+
+func (k Keeper) setPoolBoostedAmount(ctx sdk.Context, poolName string, boostedAmount sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if boostedAmount.IsZero() {
+		store.Delete(types.GetPoolBoostedAmountKey(poolName))
+		return
+	}
+	store.Set(types.GetPoolBoostedAmountKey(poolName), k.cdc.MustMarshalBinaryLengthPrefixed(boostedAmount))
+}
+
+// GetNextBoostedLockID gets the id for the next boosted lock
+func (k Keeper) GetNextBoostedLockID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.BoostedLockIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextBoostedLockID sets the id for the next boosted lock
+func (k Keeper) SetNextBoostedLockID(ctx sdk.Context, lockID uint64) {
+	ctx.KVStore(k.storeKey).Set(types.BoostedLockIDKey, sdk.Uint64ToBigEndian(lockID))
+}
+
+// GetBoostedLock gets a boosted lock of an address from store
+func (k Keeper) GetBoostedLock(ctx sdk.Context, addr sdk.AccAddress, lockID uint64) (boostedLock types.BoostedLock, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBoostedLockKey(addr, lockID))
+	if bz == nil {
+		return boostedLock, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &boostedLock)
+	return boostedLock, true
+}
+
+// AddBoostedLock sets a boosted lock into store, inserts it into the queue ordered by unlock time and
+// adds its boosted amount to the pool. The rewards of the pool must have been settled for the owner before it's called
+func (k Keeper) AddBoostedLock(ctx sdk.Context, boostedLock types.BoostedLock) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBoostedLockKey(boostedLock.Owner, boostedLock.ID), k.cdc.MustMarshalBinaryLengthPrefixed(boostedLock))
+	store.Set(types.GetBoostedLockQueueKey(boostedLock.UnlockTime, boostedLock.ID), boostedLock.Owner.Bytes())
+
+	poolBoostedAmount := k.GetPoolBoostedAmount(ctx, boostedLock.PoolName)
+	k.setPoolBoostedAmount(ctx, boostedLock.PoolName, poolBoostedAmount.Add(boostedLock.BoostedAmount()))
+	if boostedLock.ID >= k.GetNextBoostedLockID(ctx) {
+		k.SetNextBoostedLockID(ctx, boostedLock.ID+1)
+	}
+}
+
+// RemoveBoostedLock deletes a boosted lock from store and the queue, then subtracts its boosted amount from the pool.
+// The rewards of the pool must have been settled for the owner before it's called
+func (k Keeper) RemoveBoostedLock(ctx sdk.Context, boostedLock types.BoostedLock) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBoostedLockKey(boostedLock.Owner, boostedLock.ID))
+	store.Delete(types.GetBoostedLockQueueKey(boostedLock.UnlockTime, boostedLock.ID))
+
+	poolBoostedAmount := k.GetPoolBoostedAmount(ctx, boostedLock.PoolName).Sub(boostedLock.BoostedAmount())
+	if poolBoostedAmount.IsNegative() {
+		panic("pool boosted amount should not be negative")
+	}
+	k.setPoolBoostedAmount(ctx, boostedLock.PoolName, poolBoostedAmount)
+}
+
+// GetBoostedLocks gets the boosted locks of an address in a pool, or in all the pools if the pool name is empty
+func (k Keeper) GetBoostedLocks(ctx sdk.Context, addr sdk.AccAddress, poolName string) (boostedLocks types.BoostedLocks) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetBoostedLocksPrefix(addr))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var boostedLock types.BoostedLock
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &boostedLock)
+		if poolName == "" || boostedLock.PoolName == poolName {
+			boostedLocks = append(boostedLocks, boostedLock)
+		}
+	}
+	return
+}
+
+// GetAddressBoostedAmount gets the boosted amount and the locked amount of the boosted locks of an address in a pool
+func (k Keeper) GetAddressBoostedAmount(ctx sdk.Context, addr sdk.AccAddress, poolName string) (boostedAmount, lockedAmount sdk.Dec) {
+	boostedAmount, lockedAmount = sdk.ZeroDec(), sdk.ZeroDec()
+	for _, boostedLock := range k.GetBoostedLocks(ctx, addr, poolName) {
+		boostedAmount = boostedAmount.Add(boostedLock.BoostedAmount())
+		lockedAmount = lockedAmount.Add(boostedLock.Amount.Amount)
+	}
+	return
+}
+
+// IterateAllBoostedLocks iterates over all the boosted locks
+func (k Keeper) IterateAllBoostedLocks(ctx sdk.Context, handler func(boostedLock types.BoostedLock) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BoostedLockPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var boostedLock types.BoostedLock
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &boostedLock)
+		if handler(boostedLock) {
+			break
+		}
+	}
+}
+
+// GetEndedBoostedLocks gets all the boosted locks whose unlock time is not after the given time
+func (k Keeper) GetEndedBoostedLocks(ctx sdk.Context, blockTime time.Time) (boostedLocks types.BoostedLocks) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.BoostedLockQueuePrefix,
+		sdk.InclusiveEndBytes(types.GetBoostedLockQueueTimePrefix(blockTime)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		lockID := binary.BigEndian.Uint64(key[len(key)-8:])
+		boostedLock, found := k.GetBoostedLock(ctx, sdk.AccAddress(iterator.Value()), lockID)
+		if !found {
+			panic("the boosted lock in queue can't be found")
+		}
+		boostedLocks = append(boostedLocks, boostedLock)
+	}
+	return
+}
+
+// EndBoostedLock settles the rewards of the owner and removes the boosted lock. The locked tokens stay in the pool
+// without the boost and can be unlocked at any time
+func (k Keeper) EndBoostedLock(ctx sdk.Context, boostedLock types.BoostedLock) (sdk.SysCoins, sdk.Error) {
+	pool, found := k.GetFarmPool(ctx, boostedLock.PoolName)
+	if !found {
+		// the pool has been destroyed, there is nothing to settle
+		k.RemoveBoostedLock(ctx, boostedLock)
+		return sdk.SysCoins{}, nil
+	}
+
+	// 1. Calculate how many provided token & native token could be yielded in current period
+	updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
+
+	// 2. Withdraw rewards with the boost
+	rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens, boostedLock.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Update the lock info, then remove the boost
+	k.UpdateLockInfo(ctx, boostedLock.Owner, pool.Name, sdk.ZeroDec())
+	k.RemoveBoostedLock(ctx, boostedLock)
+
+	// 4. Update farm pool
+	if updatedPool.TotalAccumulatedRewards.IsAllLT(rewards) {
+		panic("should not happen")
+	}
+	updatedPool.TotalAccumulatedRewards = updatedPool.TotalAccumulatedRewards.Sub(rewards)
+	k.SetFarmPool(ctx, updatedPool)
+
+	// 5. notify backend
+	k.OnClaim(ctx, boostedLock.Owner, pool.Name, rewards)
+	return rewards, nil
+}
@@ -79,13 +79,14 @@ func (k Keeper) IncrementPoolPeriod(
 ) uint64 {
 	// 1. fetch current period rewards
 	rewards := k.GetPoolCurrentRewards(ctx, poolName)
-	// 2. calculate current reward ratio
+	// 2. calculate current reward ratio, the boosted amount of the boosted locks is counted in the total locked value
 	rewards.Rewards = rewards.Rewards.Add2(yieldedTokens)
+	totalWeight := totalValueLocked.Amount.Add(k.GetPoolBoostedAmount(ctx, poolName))
 	var currentRatio sdk.SysCoins
-	if totalValueLocked.IsZero() {
+	if totalWeight.IsZero() {
 		currentRatio = sdk.SysCoins{}
 	} else {
-		currentRatio = rewards.Rewards.QuoDecTruncate(totalValueLocked.Amount)
+		currentRatio = rewards.Rewards.QuoDecTruncate(totalWeight)
 	}
 
 	// 3.1 get the previous pool historical rewards
@@ -137,8 +138,12 @@ func (k Keeper) calculateRewards(
 	}
 
 	startingPeriod := lockInfo.ReferencePeriod
+	// the boosted amount of the boosted locks is counted in the locked amount
+	weightedAmount := lockInfo.Amount
+	boostedAmount, _ := k.GetAddressBoostedAmount(ctx, addr, poolName)
+	weightedAmount.Amount = weightedAmount.Amount.Add(boostedAmount)
 	// calculate rewards for final period
-	return k.calculateLockRewardsBetween(ctx, poolName, startingPeriod, endingPeriod, lockInfo.Amount)
+	return k.calculateLockRewardsBetween(ctx, poolName, startingPeriod, endingPeriod, weightedAmount)
 }
 
 // calculateLockRewardsBetween calculate the rewards accrued by a pool between two periods
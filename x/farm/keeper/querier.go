@@ -36,6 +36,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryAccountsLockedTo(ctx, req, k)
 		case types.QueryPoolNum:
 			return queryPoolNum(ctx, k)
+		case types.QueryLockBoosts:
+			return queryLockBoosts(ctx, req, k)
+		case types.QueryLockSchedule:
+			return queryLockSchedule(ctx, req, k)
 		default:
 			return nil, types.ErrUnknownFarmQueryType("failed. unknown farm query endpoint")
 		}
@@ -192,6 +196,48 @@ func queryPoolNum(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	return res, nil
 }
 
+func queryLockBoosts(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryPoolParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, defaultQueryErrParseParams(err)
+	}
+
+	if !k.HasFarmPool(ctx, params.PoolName) {
+		return nil, types.ErrNoFarmPoolFound(params.PoolName)
+	}
+
+	poolLockBoosts, found := k.GetPoolLockBoosts(ctx, params.PoolName)
+	if !found {
+		poolLockBoosts = types.NewPoolLockBoosts(params.PoolName, types.LockBoosts{}, sdk.ZeroDec())
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, poolLockBoosts)
+	if err != nil {
+		return nil, defaultQueryErrJSONMarshal(err)
+	}
+
+	return res, nil
+}
+
+func queryLockSchedule(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryPoolAccountParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, defaultQueryErrParseParams(err)
+	}
+
+	boostedLocks := k.GetBoostedLocks(ctx, params.AccAddress, params.PoolName)
+	if boostedLocks == nil {
+		boostedLocks = types.BoostedLocks{}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, boostedLocks)
+	if err != nil {
+		return nil, defaultQueryErrJSONMarshal(err)
+	}
+
+	return res, nil
+}
+
 func defaultQueryErrJSONMarshal(err error) sdk.Error {
 	return common.ErrMarshalJSONFailed(err.Error())
 }
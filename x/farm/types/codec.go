@@ -12,6 +12,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgUnlock{}, "okexchain/farm/MsgUnlock", nil)
 	cdc.RegisterConcrete(MsgClaim{}, "okexchain/farm/MsgClaim", nil)
 	cdc.RegisterConcrete(MsgProvide{}, "okexchain/farm/MsgProvide", nil)
+	cdc.RegisterConcrete(MsgSetLockBoosts{}, "okexchain/farm/MsgSetLockBoosts", nil)
+	cdc.RegisterConcrete(MsgBoostedLock{}, "okexchain/farm/MsgBoostedLock", nil)
+	cdc.RegisterConcrete(MsgEarlyUnlock{}, "okexchain/farm/MsgEarlyUnlock", nil)
 	cdc.RegisterConcrete(ManageWhiteListProposal{}, "okexchain/farm/ManageWhiteListProposal", nil)
 }
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 
@@ -35,6 +36,10 @@ const (
 	CodeLockAmountBelowMinimum             uint32 = 66019
 	CodeSendCoinsFromModuleToAccountFailed uint32 = 66020
 	CodeSwapTokenPairNotExist              uint32 = 66021
+	CodeInvalidLockBoosts                  uint32 = 66022
+	CodeLockDurationNotBoosted             uint32 = 66023
+	CodeNoBoostedLockFound                 uint32 = 66024
+	CodeAmountLockedByBoostedLocks         uint32 = 66025
 )
 
 // ErrInvalidInput returns an error when an input parameter is invalid
@@ -149,3 +154,26 @@ func ErrSendCoinsFromModuleToAccountFailed(content string) sdk.EnvelopedErr {
 func ErrSwapTokenPairNotExist(tokenName string) sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeSwapTokenPairNotExist, fmt.Sprintf("failed. swap token pair %s does not exist", tokenName))}
 }
+
+// ErrInvalidLockBoosts returns an error when the lock boosts of a pool are invalid
+func ErrInvalidLockBoosts(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeInvalidLockBoosts, fmt.Sprintf("failed. invalid lock boosts: %s", msg))}
+}
+
+// ErrLockDurationNotBoosted returns an error when the pool doesn't offer a boost for the lock duration
+func ErrLockDurationNotBoosted(poolName string, lockDuration time.Duration) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeLockDurationNotBoosted,
+		fmt.Sprintf("failed. pool %s doesn't offer a boost for lock duration %s", poolName, lockDuration))}
+}
+
+// ErrNoBoostedLockFound returns an error when the boosted lock of an address doesn't exist
+func ErrNoBoostedLockFound(addr string, lockID uint64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeNoBoostedLockFound,
+		fmt.Sprintf("failed. boosted lock %d of %s does not exist", lockID, addr))}
+}
+
+// ErrAmountLockedByBoostedLocks returns an error when unlocking the tokens of the boosted locks not ended
+func ErrAmountLockedByBoostedLocks(boostedAmount, inputAmount string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultParamspace, CodeAmountLockedByBoostedLocks,
+		fmt.Sprintf("failed. %s is locked by boosted locks not ended, %s can't be unlocked", boostedAmount, inputAmount))}
+}
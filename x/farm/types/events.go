@@ -9,6 +9,11 @@ const (
 	EventTypeUnlock      = "unlock"
 	EventTypeClaim       = "claim"
 
+	EventTypeSetLockBoosts  = "set-lock-boosts"
+	EventTypeBoostedLock    = "boosted-lock"
+	EventTypeEarlyUnlock    = "early-unlock"
+	EventTypeBoostedLockEnd = "boosted-lock-end"
+
 	AttributeKeyAddress             = "address"
 	AttributeKeyPool                = "pool"
 	AttributeKeyStartHeightToYield  = "start_height_to_yield"
@@ -18,6 +23,10 @@ const (
 	AttributeKeyDeposit             = "deposit"
 	AttributeKeyWithdraw            = "withdraw"
 	AttributeKeyClaimed             = "claimed"
+	AttributeKeyLockID              = "lock_id"
+	AttributeKeyMultiplier          = "multiplier"
+	AttributeKeyUnlockTime          = "unlock_time"
+	AttributeKeyPenalty             = "penalty"
 
 	AttributeValueCategory = ModuleName
 )
@@ -33,6 +33,9 @@ type GenesisState struct {
 	PoolCurrentRewards    []PoolCurrentRewardsRecord    `json:"current_rewards" yaml:"current_rewards"`
 	WhiteList             PoolNameList                  `json:"pools_yield_native_token" yaml:"pools_yield_native_token"`
 	Params                Params                        `json:"params" yaml:"params"`
+	PoolLockBoosts        []PoolLockBoosts              `json:"pool_lock_boosts" yaml:"pool_lock_boosts"`
+	BoostedLocks          BoostedLocks                  `json:"boosted_locks" yaml:"boosted_locks"`
+	NextBoostedLockID     uint64                        `json:"next_boosted_lock_id" yaml:"next_boosted_lock_id"`
 }
 
 // NewGenesisState creates a new GenesisState object
@@ -57,6 +60,9 @@ func DefaultGenesisState() GenesisState {
 		PoolHistoricalRewards: []PoolHistoricalRewardsRecord{},
 		PoolCurrentRewards:    []PoolCurrentRewardsRecord{},
 		Params:                DefaultParams(),
+		PoolLockBoosts:        []PoolLockBoosts{},
+		BoostedLocks:          BoostedLocks{},
+		NextBoostedLockID:     1,
 	}
 }
 
@@ -77,5 +83,18 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("actual reference count(%d) is not equal to expected reference count(%d)",
 			actualReferenceCount, expectedReferenceCount)
 	}
+
+	for _, poolLockBoosts := range data.PoolLockBoosts {
+		if err := poolLockBoosts.LockBoosts.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid lock boosts of pool %s: %s", poolLockBoosts.PoolName, err)
+		}
+	}
+
+	for _, boostedLock := range data.BoostedLocks {
+		if boostedLock.ID >= data.NextBoostedLockID {
+			return fmt.Errorf("id of boosted lock(%d) should be less than next boosted lock id(%d)",
+				boostedLock.ID, data.NextBoostedLockID)
+		}
+	}
 	return nil
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 )
@@ -39,6 +40,11 @@ var (
 	PoolsYieldNativeTokenPrefix = []byte{0x04}
 	PoolHistoricalRewardsPrefix = []byte{0x05}
 	PoolCurrentRewardsPrefix    = []byte{0x06}
+	PoolLockBoostsPrefix        = []byte{0x07}
+	PoolBoostedAmountPrefix     = []byte{0x08}
+	BoostedLockPrefix           = []byte{0x09}
+	BoostedLockQueuePrefix      = []byte{0x0A}
+	BoostedLockIDKey            = []byte{0x0B}
 )
 
 const (
@@ -87,3 +93,33 @@ func GetPoolHistoricalRewardsPrefix(poolName string) []byte {
 func GetPoolCurrentRewardsKey(poolName string) []byte {
 	return append(PoolCurrentRewardsPrefix, []byte(poolName)...)
 }
+
+// GetPoolLockBoostsKey gets the key for the lock boosts of a pool
+func GetPoolLockBoostsKey(poolName string) []byte {
+	return append(PoolLockBoostsPrefix, []byte(poolName)...)
+}
+
+// GetPoolBoostedAmountKey gets the key for the total boosted amount of a pool
+func GetPoolBoostedAmountKey(poolName string) []byte {
+	return append(PoolBoostedAmountPrefix, []byte(poolName)...)
+}
+
+// GetBoostedLocksPrefix gets the prefix key for all the boosted locks of an address
+func GetBoostedLocksPrefix(addr sdk.AccAddress) []byte {
+	return append(BoostedLockPrefix, addr.Bytes()...)
+}
+
+// GetBoostedLockKey gets the key for a boosted lock of an address
+func GetBoostedLockKey(addr sdk.AccAddress, lockID uint64) []byte {
+	return append(GetBoostedLocksPrefix(addr), sdk.Uint64ToBigEndian(lockID)...)
+}
+
+// GetBoostedLockQueueTimePrefix gets the prefix key for the boosted locks ending at unlockTime
+func GetBoostedLockQueueTimePrefix(unlockTime time.Time) []byte {
+	return append(BoostedLockQueuePrefix, sdk.FormatTimeBytes(unlockTime)...)
+}
+
+// GetBoostedLockQueueKey gets the key for a boosted lock in the queue ordered by unlock time
+func GetBoostedLockQueueKey(unlockTime time.Time, lockID uint64) []byte {
+	return append(GetBoostedLockQueueTimePrefix(unlockTime), sdk.Uint64ToBigEndian(lockID)...)
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// MaxLockBoosts is the max number of lock durations a pool can offer
+	MaxLockBoosts = 10
+)
+
+var (
+	// MaxLockBoostMultiplier is the max reward multiplier of a lock duration
+	MaxLockBoostMultiplier = sdk.NewDec(10)
+)
+
+// LockBoost is the reward multiplier offered for the tokens locked for LockDuration
+type LockBoost struct {
+	LockDuration time.Duration `json:"lock_duration" yaml:"lock_duration"`
+	Multiplier   sdk.Dec       `json:"multiplier" yaml:"multiplier"`
+}
+
+// NewLockBoost creates a new instance of LockBoost
+func NewLockBoost(lockDuration time.Duration, multiplier sdk.Dec) LockBoost {
+	return LockBoost{
+		LockDuration: lockDuration,
+		Multiplier:   multiplier,
+	}
+}
+
+// String returns a human readable string representation of LockBoost
+func (lb LockBoost) String() string {
+	return fmt.Sprintf("%s: %sx", lb.LockDuration, lb.Multiplier)
+}
+
+// LockBoosts is a collection of LockBoost sorted by LockDuration
+type LockBoosts []LockBoost
+
+// String returns a human readable string representation of LockBoosts
+func (lbs LockBoosts) String() string {
+	out := make([]string, 0, len(lbs))
+	for _, lb := range lbs {
+		out = append(out, lb.String())
+	}
+	return strings.Join(out, ", ")
+}
+
+// ValidateBasic checks the lock boosts are sorted by lock duration and the multipliers are in (1, MaxLockBoostMultiplier]
+func (lbs LockBoosts) ValidateBasic() sdk.Error {
+	if len(lbs) > MaxLockBoosts {
+		return ErrInvalidLockBoosts(fmt.Sprintf("too many lock boosts: %d > %d", len(lbs), MaxLockBoosts))
+	}
+	for i, lb := range lbs {
+		if lb.LockDuration <= 0 {
+			return ErrInvalidLockBoosts(fmt.Sprintf("lock duration %s should be positive", lb.LockDuration))
+		}
+		if i > 0 && lb.LockDuration <= lbs[i-1].LockDuration {
+			return ErrInvalidLockBoosts("lock boosts must be sorted by lock duration without duplicates")
+		}
+		if lb.Multiplier.IsNil() || lb.Multiplier.LTE(sdk.OneDec()) || lb.Multiplier.GT(MaxLockBoostMultiplier) {
+			return ErrInvalidLockBoosts(fmt.Sprintf("multiplier of %s should be in (1, %s]", lb.LockDuration, MaxLockBoostMultiplier))
+		}
+	}
+	return nil
+}
+
+// GetMultiplier returns the reward multiplier offered for the lock duration
+func (lbs LockBoosts) GetMultiplier(lockDuration time.Duration) (sdk.Dec, bool) {
+	for _, lb := range lbs {
+		if lb.LockDuration == lockDuration {
+			return lb.Multiplier, true
+		}
+	}
+	return sdk.Dec{}, false
+}
+
+// PoolLockBoosts is the lock boosts offered by a farm pool and the penalty rate of unlocking before the lock ends
+type PoolLockBoosts struct {
+	PoolName               string     `json:"pool_name" yaml:"pool_name"`
+	LockBoosts             LockBoosts `json:"lock_boosts" yaml:"lock_boosts"`
+	EarlyUnlockPenaltyRate sdk.Dec    `json:"early_unlock_penalty_rate" yaml:"early_unlock_penalty_rate"`
+}
+
+// NewPoolLockBoosts creates a new instance of PoolLockBoosts
+func NewPoolLockBoosts(poolName string, lockBoosts LockBoosts, earlyUnlockPenaltyRate sdk.Dec) PoolLockBoosts {
+	return PoolLockBoosts{
+		PoolName:               poolName,
+		LockBoosts:             lockBoosts,
+		EarlyUnlockPenaltyRate: earlyUnlockPenaltyRate,
+	}
+}
+
+// String returns a human readable string representation of PoolLockBoosts
+func (plb PoolLockBoosts) String() string {
+	return fmt.Sprintf(`Pool Lock Boosts:
+  Pool Name:                  %s
+  Lock Boosts:                %s
+  Early Unlock Penalty Rate:  %s`,
+		plb.PoolName, plb.LockBoosts, plb.EarlyUnlockPenaltyRate)
+}
+
+// BoostedLock is a part of the locked tokens that can't be unlocked before UnlockTime without paying PenaltyRate
+// of it. It is weighted by Multiplier when the rewards of the pool are distributed
+type BoostedLock struct {
+	ID          uint64         `json:"id" yaml:"id"`
+	Owner       sdk.AccAddress `json:"owner" yaml:"owner"`
+	PoolName    string         `json:"pool_name" yaml:"pool_name"`
+	Amount      sdk.SysCoin    `json:"amount" yaml:"amount"`
+	Multiplier  sdk.Dec        `json:"multiplier" yaml:"multiplier"`
+	PenaltyRate sdk.Dec        `json:"penalty_rate" yaml:"penalty_rate"`
+	UnlockTime  time.Time      `json:"unlock_time" yaml:"unlock_time"`
+}
+
+// NewBoostedLock creates a new instance of BoostedLock
+func NewBoostedLock(id uint64, owner sdk.AccAddress, poolName string, amount sdk.SysCoin, multiplier, penaltyRate sdk.Dec,
+	unlockTime time.Time) BoostedLock {
+	return BoostedLock{
+		ID:          id,
+		Owner:       owner,
+		PoolName:    poolName,
+		Amount:      amount,
+		Multiplier:  multiplier,
+		PenaltyRate: penaltyRate,
+		UnlockTime:  unlockTime,
+	}
+}
+
+// BoostedAmount returns the extra weight the lock adds to the locked amount
+func (bl BoostedLock) BoostedAmount() sdk.Dec {
+	return bl.Amount.Amount.MulTruncate(bl.Multiplier.Sub(sdk.OneDec()))
+}
+
+// String returns a human readable string representation of BoostedLock
+func (bl BoostedLock) String() string {
+	return fmt.Sprintf(`Boosted Lock:
+  ID:            %d
+  Owner:         %s
+  Pool Name:     %s
+  Amount:        %s
+  Multiplier:    %s
+  Penalty Rate:  %s
+  Unlock Time:   %s`,
+		bl.ID, bl.Owner, bl.PoolName, bl.Amount, bl.Multiplier, bl.PenaltyRate, bl.UnlockTime)
+}
+
+// BoostedLocks is a collection of BoostedLock
+type BoostedLocks []BoostedLock
+
+// String returns a human readable string representation of BoostedLocks
+func (bls BoostedLocks) String() (out string) {
+	for _, bl := range bls {
+		out += bl.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
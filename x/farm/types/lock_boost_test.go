@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockBoostsValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		lockBoosts LockBoosts
+		valid      bool
+	}{
+		{"empty", LockBoosts{}, true},
+		{"sorted", LockBoosts{
+			NewLockBoost(time.Hour, sdk.NewDecWithPrec(15, 1)),
+			NewLockBoost(2*time.Hour, sdk.NewDec(2)),
+		}, true},
+		{"max multiplier", LockBoosts{NewLockBoost(time.Hour, MaxLockBoostMultiplier)}, true},
+		{"unsorted", LockBoosts{
+			NewLockBoost(2*time.Hour, sdk.NewDec(2)),
+			NewLockBoost(time.Hour, sdk.NewDecWithPrec(15, 1)),
+		}, false},
+		{"duplicated", LockBoosts{
+			NewLockBoost(time.Hour, sdk.NewDec(2)),
+			NewLockBoost(time.Hour, sdk.NewDec(3)),
+		}, false},
+		{"zero duration", LockBoosts{NewLockBoost(0, sdk.NewDec(2))}, false},
+		{"multiplier not above one", LockBoosts{NewLockBoost(time.Hour, sdk.OneDec())}, false},
+		{"multiplier too large", LockBoosts{NewLockBoost(time.Hour, MaxLockBoostMultiplier.Add(sdk.OneDec()))}, false},
+		{"nil multiplier", LockBoosts{NewLockBoost(time.Hour, sdk.Dec{})}, false},
+	}
+
+	for _, tc := range tests {
+		err := tc.lockBoosts.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, tc.name)
+		} else {
+			require.NotNil(t, err, tc.name)
+		}
+	}
+
+	tooMany := make(LockBoosts, MaxLockBoosts+1)
+	for i := range tooMany {
+		tooMany[i] = NewLockBoost(time.Duration(i+1)*time.Hour, sdk.NewDec(2))
+	}
+	require.NotNil(t, tooMany.ValidateBasic())
+}
+
+func TestLockBoostsGetMultiplier(t *testing.T) {
+	lockBoosts := LockBoosts{
+		NewLockBoost(time.Hour, sdk.NewDecWithPrec(15, 1)),
+		NewLockBoost(2*time.Hour, sdk.NewDec(2)),
+	}
+
+	multiplier, found := lockBoosts.GetMultiplier(2 * time.Hour)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(2), multiplier)
+
+	_, found = lockBoosts.GetMultiplier(3 * time.Hour)
+	require.False(t, found)
+}
+
+func TestBoostedLockBoostedAmount(t *testing.T) {
+	addr := sdk.AccAddress([]byte("testBoostedLockAddr"))
+	boostedLock := NewBoostedLock(1, addr, "pool", sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)),
+		sdk.NewDecWithPrec(15, 1), sdk.NewDecWithPrec(1, 1), time.Now())
+	require.Equal(t, sdk.NewDec(50), boostedLock.BoostedAmount())
+}
+
+func TestLockBoostMsgsValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("testBoostedLockAddr"))
+	lockBoosts := LockBoosts{NewLockBoost(time.Hour, sdk.NewDec(2))}
+	amount := sdk.NewDecCoinFromDec("xxb", sdk.NewDec(10))
+
+	require.Nil(t, NewMsgSetLockBoosts(addr, "pool", lockBoosts, sdk.NewDecWithPrec(1, 1)).ValidateBasic())
+	require.Nil(t, NewMsgSetLockBoosts(addr, "pool", LockBoosts{}, sdk.ZeroDec()).ValidateBasic())
+	require.NotNil(t, NewMsgSetLockBoosts(addr, "pool", lockBoosts, sdk.OneDec()).ValidateBasic())
+	require.NotNil(t, NewMsgSetLockBoosts(addr, "pool", lockBoosts, sdk.NewDec(-1)).ValidateBasic())
+	require.NotNil(t, NewMsgSetLockBoosts(nil, "pool", lockBoosts, sdk.ZeroDec()).ValidateBasic())
+	require.NotNil(t, NewMsgSetLockBoosts(addr, "", lockBoosts, sdk.ZeroDec()).ValidateBasic())
+
+	require.Nil(t, NewMsgBoostedLock("pool", addr, amount, time.Hour).ValidateBasic())
+	require.NotNil(t, NewMsgBoostedLock("pool", addr, amount, 0).ValidateBasic())
+	require.NotNil(t, NewMsgBoostedLock("pool", nil, amount, time.Hour).ValidateBasic())
+	require.NotNil(t, NewMsgBoostedLock("pool", addr, sdk.NewDecCoinFromDec("xxb", sdk.ZeroDec()), time.Hour).ValidateBasic())
+
+	require.Nil(t, NewMsgEarlyUnlock(addr, 1).ValidateBasic())
+	require.NotNil(t, NewMsgEarlyUnlock(nil, 1).ValidateBasic())
+}
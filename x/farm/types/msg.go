@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 )
 
@@ -13,6 +16,10 @@ const (
 	lockMsgType        = "lock"
 	unlockMsgType      = "unlock"
 	claimMsgType       = "claim"
+
+	setLockBoostsMsgType = "set_lock_boosts"
+	boostedLockMsgType   = "boosted_lock"
+	earlyUnlockMsgType   = "early_unlock"
 )
 
 type MsgCreatePool struct {
@@ -299,3 +306,143 @@ func (m MsgClaim) GetSignBytes() []byte {
 func (m MsgClaim) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Address}
 }
+
+type MsgSetLockBoosts struct {
+	Owner                  sdk.AccAddress `json:"owner" yaml:"owner"`
+	PoolName               string         `json:"pool_name" yaml:"pool_name"`
+	LockBoosts             LockBoosts     `json:"lock_boosts" yaml:"lock_boosts"`
+	EarlyUnlockPenaltyRate sdk.Dec        `json:"early_unlock_penalty_rate" yaml:"early_unlock_penalty_rate"`
+}
+
+func NewMsgSetLockBoosts(owner sdk.AccAddress, poolName string, lockBoosts LockBoosts,
+	earlyUnlockPenaltyRate sdk.Dec) MsgSetLockBoosts {
+	return MsgSetLockBoosts{
+		Owner:                  owner,
+		PoolName:               poolName,
+		LockBoosts:             lockBoosts,
+		EarlyUnlockPenaltyRate: earlyUnlockPenaltyRate,
+	}
+}
+
+var _ sdk.Msg = MsgSetLockBoosts{}
+
+func (m MsgSetLockBoosts) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetLockBoosts) Type() string {
+	return setLockBoostsMsgType
+}
+
+func (m MsgSetLockBoosts) ValidateBasic() sdk.Error {
+	if m.PoolName == "" || len(m.PoolName) > MaxPoolNameLength {
+		return ErrInvalidInput(m.PoolName)
+	}
+	if m.Owner.Empty() {
+		return ErrNilAddress()
+	}
+	if m.EarlyUnlockPenaltyRate.IsNil() || m.EarlyUnlockPenaltyRate.IsNegative() ||
+		m.EarlyUnlockPenaltyRate.GTE(sdk.OneDec()) {
+		return ErrInvalidInput("early unlock penalty rate should be in [0, 1)")
+	}
+	return m.LockBoosts.ValidateBasic()
+}
+
+func (m MsgSetLockBoosts) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgSetLockBoosts) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Owner}
+}
+
+type MsgBoostedLock struct {
+	PoolName     string         `json:"pool_name" yaml:"pool_name"`
+	Address      sdk.AccAddress `json:"address" yaml:"address"`
+	Amount       sdk.SysCoin    `json:"amount" yaml:"amount"`
+	LockDuration time.Duration  `json:"lock_duration" yaml:"lock_duration"`
+}
+
+func NewMsgBoostedLock(poolName string, address sdk.AccAddress, amount sdk.SysCoin,
+	lockDuration time.Duration) MsgBoostedLock {
+	return MsgBoostedLock{
+		PoolName:     poolName,
+		Address:      address,
+		Amount:       amount,
+		LockDuration: lockDuration,
+	}
+}
+
+var _ sdk.Msg = MsgBoostedLock{}
+
+func (m MsgBoostedLock) Route() string {
+	return RouterKey
+}
+
+func (m MsgBoostedLock) Type() string {
+	return boostedLockMsgType
+}
+
+func (m MsgBoostedLock) ValidateBasic() sdk.Error {
+	if m.PoolName == "" || len(m.PoolName) > MaxPoolNameLength {
+		return ErrInvalidInput(m.PoolName)
+	}
+	if m.Address.Empty() {
+		return ErrNilAddress()
+	}
+	if m.Amount.Amount.LTE(sdk.ZeroDec()) || !m.Amount.IsValid() {
+		return ErrInvalidInputAmount(m.Amount.Amount.String())
+	}
+	if m.LockDuration <= 0 {
+		return ErrInvalidInput(fmt.Sprintf("lock duration %s should be positive", m.LockDuration))
+	}
+	return nil
+}
+
+func (m MsgBoostedLock) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgBoostedLock) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Address}
+}
+
+type MsgEarlyUnlock struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	LockID  uint64         `json:"lock_id" yaml:"lock_id"`
+}
+
+func NewMsgEarlyUnlock(address sdk.AccAddress, lockID uint64) MsgEarlyUnlock {
+	return MsgEarlyUnlock{
+		Address: address,
+		LockID:  lockID,
+	}
+}
+
+var _ sdk.Msg = MsgEarlyUnlock{}
+
+func (m MsgEarlyUnlock) Route() string {
+	return RouterKey
+}
+
+func (m MsgEarlyUnlock) Type() string {
+	return earlyUnlockMsgType
+}
+
+func (m MsgEarlyUnlock) ValidateBasic() sdk.Error {
+	if m.Address.Empty() {
+		return ErrNilAddress()
+	}
+	return nil
+}
+
+func (m MsgEarlyUnlock) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgEarlyUnlock) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Address}
+}
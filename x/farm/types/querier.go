@@ -12,10 +12,13 @@ const (
 	QueryAccount          = "account"
 	QueryAccountsLockedTo = "accounts-locked-to"
 	QueryPoolNum          = "pool-num"
+	QueryLockBoosts       = "lock-boosts"
+	QueryLockSchedule     = "lock-schedule"
 )
 
 // QueryPoolParams defines the params for the following queries:
 // - 'custom/farm/pool'
+// - 'custom/farm/lock-boosts'
 type QueryPoolParams struct {
 	PoolName string
 }
@@ -44,6 +47,7 @@ func NewQueryPoolsParams(page, limit int) QueryPoolsParams {
 // QueryPoolAccountParams defines the params for the following queries:
 // - 'custom/farm/earnings'
 // - 'custom/farm/lock-info'
+// - 'custom/farm/lock-schedule', all the pools are queried with an empty pool name
 type QueryPoolAccountParams struct {
 	PoolName   string
 	AccAddress sdk.AccAddress
@@ -0,0 +1,17 @@
+package feeabs
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/feeabs/keeper"
+)
+
+// EndBlocker moves the fees paid in the whitelisted denoms in the block from the fee collector to the reserve, in
+// exchange for the native token paid from the reserve. The fees of the block are collected before the end blockers
+// run, so a failure breaks the fee collector invariant and halts the chain
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if err := k.SettleConvertedFees(ctx); err != nil {
+		panic(fmt.Sprintf("settle the converted fees failed: %s", err))
+	}
+}
@@ -0,0 +1,20 @@
+package feeabs
+
+import (
+	"github.com/okex/exchain/x/feeabs/keeper"
+	"github.com/okex/exchain/x/feeabs/types"
+)
+
+const (
+	ModuleName   = types.ModuleName
+	TStoreKey    = types.TStoreKey
+	QuerierRoute = types.QuerierRoute
+)
+
+var (
+	NewKeeper = keeper.NewKeeper
+)
+
+type (
+	Keeper = keeper.Keeper
+)
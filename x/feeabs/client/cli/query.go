@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/x/feeabs/types"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	// Group feeabs queries under a subcommand
+	feeabsQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	feeabsQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryNativeFee(queryRoute, cdc),
+			GetCmdQueryParams(queryRoute, cdc),
+		)...,
+	)
+
+	return feeabsQueryCmd
+}
+
+// GetCmdQueryNativeFee gets the native fee query command.
+func GetCmdQueryNativeFee(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "native-fee [fee]",
+		Short: "query the native token a fee paid in a whitelisted denom is converted to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount of the native token a fee paid in a whitelisted denom is converted to,
+and the rate it's converted at.
+
+Example:
+$ %s query feeabs native-fee 1.5usdt
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryNativeFeeParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryNativeFee)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var nativeFee types.NativeFee
+			cdc.MustUnmarshalJSON(resp, &nativeFee)
+			return cliCtx.PrintOutput(nativeFee)
+		},
+	}
+}
+
+// GetCmdQueryParams gets the feeabs params query command.
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "query the current feeabs parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as feeabs parameters, including the denoms the fees can be paid in and
+the minimum gas prices enforced by consensus.
+
+Example:
+$ %s query feeabs params
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParameters)
+			resp, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(resp, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
@@ -0,0 +1,67 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/rest"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/feeabs/types"
+)
+
+// RegisterRoutes registers feeabs-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// get the native token a fee is converted to
+	r.HandleFunc(
+		"/feeabs/native_fee/{fee}",
+		queryNativeFeeHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get the current feeabs parameter values
+	r.HandleFunc(
+		"/feeabs/parameters",
+		queryParamsHandlerFn(cliCtx),
+	).Methods("GET")
+}
+
+func queryNativeFeeHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		jsonBytes, err := cliCtx.Codec.MarshalJSON(types.NewQueryNativeFeeParams(mux.Vars(r)["fee"]))
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorCodecFails)
+			return
+		}
+
+		queryWithData(w, cliCtx, types.QueryNativeFee, jsonBytes)
+	}
+}
+
+func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		queryWithData(w, cliCtx, types.QueryParameters, nil)
+	}
+}
+
+func queryWithData(w http.ResponseWriter, cliCtx context.CLIContext, endpoint string, data []byte) {
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, endpoint)
+	res, height, err := cliCtx.QueryWithData(route, data)
+	if err != nil {
+		common.HandleErrorResponseV2(w, http.StatusInternalServerError, common.ErrorABCIQueryFails)
+		return
+	}
+
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, res)
+}
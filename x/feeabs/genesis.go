@@ -0,0 +1,17 @@
+package feeabs
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/feeabs/keeper"
+	"github.com/okex/exchain/x/feeabs/types"
+)
+
+// InitGenesis initializes the feeabs module's state from a given genesis state
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
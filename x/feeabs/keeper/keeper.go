@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	ammswaptypes "github.com/okex/exchain/x/ammswap/types"
+	"github.com/okex/exchain/x/feeabs/types"
+	"github.com/okex/exchain/x/params"
+)
+
+// Keeper of the feeabs module converts the fees paid in the whitelisted denoms to the native token. The converted
+// fees are paid to the fee collector from the reserve of the module account, and the fees paid are moved to the
+// reserve in exchange
+type Keeper struct {
+	cdc        *codec.Codec
+	tkey       sdk.StoreKey
+	paramSpace types.Subspace
+
+	oracleKeeper types.OracleKeeper
+	swapKeeper   types.SwapKeeper
+	supplyKeeper types.SupplyKeeper
+}
+
+// NewKeeper creates new instances of the feeabs Keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	tkey sdk.StoreKey,
+	ps params.Subspace,
+	ok types.OracleKeeper,
+	sk types.SwapKeeper,
+	supplyKeeper types.SupplyKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:          cdc,
+		tkey:         tkey,
+		paramSpace:   ps,
+		oracleKeeper: ok,
+		swapKeeper:   sk,
+		supplyKeeper: supplyKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of feeabs parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
+
+// SetParams sets the feeabs parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetFeeDenom returns the fee denom if the denom is whitelisted to pay the fees
+func (k Keeper) GetFeeDenom(ctx sdk.Context, denom string) (types.FeeDenom, bool) {
+	return k.GetParams(ctx).GetFeeDenom(denom)
+}
+
+// GetRate returns the amount of the native token that one unit of a whitelisted fee denom is worth
+func (k Keeper) GetRate(ctx sdk.Context, feeDenom types.FeeDenom) (sdk.Dec, sdk.Error) {
+	switch feeDenom.PriceSource {
+	case types.PriceSourceOracle:
+		// the oracle quotes how many units of the denom one native token is worth
+		exchangeRate, found := k.oracleKeeper.GetExchangeRate(ctx, feeDenom.Denom)
+		if !found || !exchangeRate.IsPositive() {
+			return sdk.Dec{}, types.ErrNoPriceFound(feeDenom.Denom, feeDenom.PriceSource)
+		}
+		return sdk.OneDec().Quo(exchangeRate), nil
+	case types.PriceSourceAmmSwap:
+		pair, err := k.swapKeeper.GetSwapTokenPair(ctx, ammswaptypes.GetSwapTokenPairName(feeDenom.Denom, sdk.DefaultBondDenom))
+		if err != nil {
+			return sdk.Dec{}, types.ErrNoPriceFound(feeDenom.Denom, feeDenom.PriceSource)
+		}
+		nativeReserve, denomReserve := pair.BasePooledCoin, pair.QuotePooledCoin
+		if nativeReserve.Denom != sdk.DefaultBondDenom {
+			nativeReserve, denomReserve = denomReserve, nativeReserve
+		}
+		if !denomReserve.IsPositive() || nativeReserve.Amount.LT(k.GetParams(ctx).MinPoolLiquidity) {
+			return sdk.Dec{}, types.ErrNoPriceFound(feeDenom.Denom, feeDenom.PriceSource)
+		}
+		return nativeReserve.Amount.Quo(denomReserve.Amount), nil
+	default:
+		return sdk.Dec{}, types.ErrNoPriceFound(feeDenom.Denom, feeDenom.PriceSource)
+	}
+}
+
+// ConvertFee converts a fee paid in a whitelisted denom to the native token, and returns the rate converted at
+func (k Keeper) ConvertFee(ctx sdk.Context, fee sdk.SysCoin) (nativeFee sdk.SysCoin, rate sdk.Dec, priceSource string, err sdk.Error) {
+	feeDenom, found := k.GetFeeDenom(ctx, fee.Denom)
+	if !found {
+		return sdk.SysCoin{}, sdk.Dec{}, "", types.ErrFeeDenomNotWhitelisted(fee.Denom)
+	}
+
+	rate, err = k.GetRate(ctx, feeDenom)
+	if err != nil {
+		return sdk.SysCoin{}, sdk.Dec{}, "", err
+	}
+
+	return sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, fee.Amount.MulTruncate(rate)), rate, feeDenom.PriceSource, nil
+}
+
+// GetMinGasPrices returns the minimum gas prices enforced by consensus on a tx containing the msgs
+func (k Keeper) GetMinGasPrices(ctx sdk.Context, msgs []sdk.Msg) sdk.SysCoins {
+	return k.GetParams(ctx).GetMinGasPrices(msgs)
+}
+
+// PayNativeFee pays the fee converted to the native token to the fee collector from the reserve of the module. The
+// fee paid in the whitelisted denom is collected by the fee collector at the end of the block, and is recorded to be
+// moved to the reserve by SettleConvertedFees then
+func (k Keeper) PayNativeFee(ctx sdk.Context, fee, nativeFee sdk.SysCoin) sdk.Error {
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName,
+		sdk.NewCoins(nativeFee)); err != nil {
+		return types.ErrInsufficientReserve(nativeFee)
+	}
+
+	store := ctx.TransientStore(k.tkey)
+	key := types.GetConvertedFeeKey(fee.Denom)
+	amount := fee.Amount
+	if bz := store.Get(key); bz != nil {
+		var converted sdk.Dec
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &converted)
+		amount = amount.Add(converted)
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(amount))
+	return nil
+}
+
+// GetConvertedFees returns the fees paid in the whitelisted denoms and converted in the block
+func (k Keeper) GetConvertedFees(ctx sdk.Context) sdk.SysCoins {
+	iterator := sdk.KVStorePrefixIterator(ctx.TransientStore(k.tkey), types.ConvertedFeeKeyPrefix)
+	defer iterator.Close()
+
+	var fees sdk.SysCoins
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Dec
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &amount)
+		fees = append(fees, sdk.NewDecCoinFromDec(string(iterator.Key()[len(types.ConvertedFeeKeyPrefix):]), amount))
+	}
+	return fees
+}
+
+// SettleConvertedFees moves the fees converted in the block from the fee collector to the reserve of the module. It
+// must be called after the fees of the block are collected
+func (k Keeper) SettleConvertedFees(ctx sdk.Context) error {
+	fees := k.GetConvertedFees(ctx)
+	if fees.IsZero() {
+		return nil
+	}
+	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, fees)
+}
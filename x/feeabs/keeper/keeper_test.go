@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	ammswaptypes "github.com/okex/exchain/x/ammswap/types"
+	"github.com/okex/exchain/x/feeabs/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockOracleKeeper map[string]sdk.Dec
+
+func (m mockOracleKeeper) GetExchangeRate(_ sdk.Context, denom string) (sdk.Dec, bool) {
+	exchangeRate, found := m[denom]
+	return exchangeRate, found
+}
+
+type mockSwapKeeper map[string]ammswaptypes.SwapTokenPair
+
+func (m mockSwapKeeper) GetSwapTokenPair(_ sdk.Context, tokenPairName string) (ammswaptypes.SwapTokenPair, error) {
+	pair, found := m[tokenPairName]
+	if !found {
+		return pair, errors.New("no swap token pair found")
+	}
+	return pair, nil
+}
+
+func TestConvertFee(t *testing.T) {
+	ok := mockOracleKeeper{"usdt": sdk.NewDec(20)}
+	sk := mockSwapKeeper{
+		ammswaptypes.GetSwapTokenPairName("usdk", sdk.DefaultBondDenom): {
+			BasePooledCoin:  sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1000)),
+			QuotePooledCoin: sdk.NewDecCoinFromDec("usdk", sdk.NewDec(4000)),
+		},
+		ammswaptypes.GetSwapTokenPairName("btck", sdk.DefaultBondDenom): {
+			BasePooledCoin:  sdk.NewDecCoinFromDec("btck", sdk.NewDec(1)),
+			QuotePooledCoin: sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(50)),
+		},
+	}
+	ctx, k := createTestInput(t, ok, sk, mockSupplyKeeper{})
+
+	// priced by the oracle, 1okt = 20usdt
+	nativeFee, rate, priceSource, err := k.ConvertFee(ctx, sdk.NewDecCoinFromDec("usdt", sdk.NewDec(2)))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 1)), nativeFee)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), rate)
+	require.Equal(t, types.PriceSourceOracle, priceSource)
+
+	// priced by the pool, 1000okt / 4000usdk
+	nativeFee, rate, priceSource, err = k.ConvertFee(ctx, sdk.NewDecCoinFromDec("usdk", sdk.NewDec(2)))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(5, 1)), nativeFee)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), rate)
+	require.Equal(t, types.PriceSourceAmmSwap, priceSource)
+
+	// the pool with too little native token pooled can't price the fees
+	_, _, _, err = k.ConvertFee(ctx, sdk.NewDecCoinFromDec("btck", sdk.NewDec(1)))
+	require.NotNil(t, err)
+
+	// not whitelisted
+	_, _, _, err = k.ConvertFee(ctx, sdk.NewDecCoinFromDec("eth", sdk.NewDec(1)))
+	require.NotNil(t, err)
+
+	// no exchange rate voted
+	delete(ok, "usdt")
+	_, _, _, err = k.ConvertFee(ctx, sdk.NewDecCoinFromDec("usdt", sdk.NewDec(1)))
+	require.NotNil(t, err)
+}
+
+func TestPayNativeFee(t *testing.T) {
+	supplyKeeper := mockSupplyKeeper{
+		types.ModuleName:           sdk.NewCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1))),
+		authtypes.FeeCollectorName: sdk.NewCoins(sdk.NewDecCoinFromDec("usdt", sdk.NewDec(3))),
+	}
+	ctx, k := createTestInput(t, mockOracleKeeper{}, mockSwapKeeper{}, supplyKeeper)
+
+	usdt := sdk.NewDecCoinFromDec("usdt", sdk.NewDec(2))
+	require.Nil(t, k.PayNativeFee(ctx, usdt, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 1))))
+	require.Nil(t, k.PayNativeFee(ctx, sdk.NewDecCoinFromDec("usdt", sdk.NewDec(1)),
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(2, 1))))
+	require.Equal(t, sdk.NewCoins(sdk.NewDecCoinFromDec("usdt", sdk.NewDec(3))), k.GetConvertedFees(ctx))
+
+	// the reserve can't pay more than it holds
+	require.NotNil(t, k.PayNativeFee(ctx, usdt, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1))))
+	require.Equal(t, sdk.NewCoins(sdk.NewDecCoinFromDec("usdt", sdk.NewDec(3))), k.GetConvertedFees(ctx))
+
+	require.Nil(t, k.SettleConvertedFees(ctx))
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 1)),
+		sdk.NewDecCoinFromDec("usdt", sdk.NewDec(3)),
+	), supplyKeeper[types.ModuleName])
+	require.Equal(t, sdk.NewCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(6, 1))),
+		supplyKeeper[authtypes.FeeCollectorName])
+}
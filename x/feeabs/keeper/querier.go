@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/feeabs/types"
+)
+
+// NewQuerier creates a new querier for feeabs clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParameters:
+			return queryParams(ctx, k)
+		case types.QueryNativeFee:
+			return queryNativeFee(ctx, req, k)
+		default:
+			return nil, types.ErrUnknownFeeAbsQueryType(path[0])
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	return marshalJSON(k.GetParams(ctx))
+}
+
+func queryNativeFee(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryNativeFeeParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	fee, err := sdk.ParseDecCoin(params.Fee)
+	if err != nil {
+		return nil, types.ErrInvalidFee(err.Error())
+	}
+
+	nativeFee, rate, priceSource, sdkErr := k.ConvertFee(ctx, fee)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	return marshalJSON(types.NativeFee{
+		Fee:         fee.String(),
+		NativeFee:   nativeFee.String(),
+		Rate:        rate.String(),
+		PriceSource: priceSource,
+	})
+}
+
+func marshalJSON(o interface{}) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, o)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
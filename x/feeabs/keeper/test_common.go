@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/store"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	dbm "github.com/okex/exchain/libs/tm-db"
+	"github.com/okex/exchain/x/feeabs/types"
+	"github.com/okex/exchain/x/params"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSupplyKeeper map[string]sdk.Coins
+
+func (m mockSupplyKeeper) SendCoinsFromModuleToModule(_ sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	balance, hasNeg := m[senderModule].SafeSub(amt)
+	if hasNeg {
+		return errors.New("insufficient funds")
+	}
+	m[senderModule] = balance
+	m[recipientModule] = m[recipientModule].Add(amt...)
+	return nil
+}
+
+func createTestInput(t *testing.T, ok types.OracleKeeper, sk types.SwapKeeper, supplyKeeper types.SupplyKeeper) (sdk.Context, Keeper) {
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	tkeyFeeAbs := sdk.NewTransientStoreKey(types.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(tkeyFeeAbs, sdk.StoreTypeTransient, db)
+	require.Nil(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid", Height: 10}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	k := NewKeeper(cdc, tkeyFeeAbs, pk.Subspace(types.ModuleName), ok, sk, supplyKeeper)
+	k.SetParams(ctx, types.NewParams([]types.FeeDenom{
+		types.NewFeeDenom("usdt", types.PriceSourceOracle),
+		types.NewFeeDenom("usdk", types.PriceSourceAmmSwap),
+		types.NewFeeDenom("btck", types.PriceSourceAmmSwap),
+	}, sdk.NewDec(100), types.DefaultMinGasPrices, types.DefaultModuleMinGasPrices))
+	return ctx, k
+}
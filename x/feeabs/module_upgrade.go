@@ -0,0 +1,38 @@
+package feeabs
+
+import (
+	store "github.com/okex/exchain/libs/cosmos-sdk/store/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/upgrade"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/feeabs/types"
+)
+
+// RegisterTask sets the default params at the upgrade height. The module keeps its state in the params store only,
+// so there is no store to be filtered
+func (am AppModule) RegisterTask() upgrade.HeightTask {
+	return upgrade.NewHeightTask(
+		0, func(ctx sdk.Context) error {
+			if am.Sealed() {
+				return nil
+			}
+			InitGenesis(ctx, am.keeper, types.DefaultGenesisState())
+			return nil
+		})
+}
+
+func (am AppModule) CommitFilter() *store.StoreFilter {
+	return nil
+}
+
+func (am AppModule) PruneFilter() *store.StoreFilter {
+	return nil
+}
+
+func (am AppModule) VersionFilter() *store.VersionFilter {
+	return nil
+}
+
+func (am AppModule) UpgradeHeight() int64 {
+	return tmtypes.GetVenus5Height()
+}
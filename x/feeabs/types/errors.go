@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	DefaultCodespace string = ModuleName
+
+	CodeFeeDenomNotWhitelisted uint32 = 72000
+	CodeInvalidFee             uint32 = 72001
+	CodeNoPriceFound           uint32 = 72002
+	CodeUnknownFeeAbsQueryType uint32 = 72003
+	CodeInsufficientReserve    uint32 = 72004
+)
+
+// ErrFeeDenomNotWhitelisted returns an error when the fees are paid in a denom not whitelisted
+func ErrFeeDenomNotWhitelisted(denom string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeFeeDenomNotWhitelisted,
+		fmt.Sprintf("failed. fees can't be paid in %s, it's not whitelisted", denom))}
+}
+
+// ErrInvalidFee returns an error when the fee can't be converted to the native token
+func ErrInvalidFee(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidFee, fmt.Sprintf("failed. invalid fee: %s", msg))}
+}
+
+// ErrNoPriceFound returns an error when the price of a fee denom can't be found from its price source
+func ErrNoPriceFound(denom, priceSource string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNoPriceFound,
+		fmt.Sprintf("failed. no price of %s is found from %s", denom, priceSource))}
+}
+
+// ErrUnknownFeeAbsQueryType returns an error when the query endpoint is unknown
+func ErrUnknownFeeAbsQueryType(path string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownFeeAbsQueryType, fmt.Sprintf("unknown feeabs query endpoint: %s", path))}
+}
+
+// ErrInsufficientReserve returns an error when the reserve of the module can't pay the converted fee
+func ErrInsufficientReserve(nativeFee sdk.SysCoin) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInsufficientReserve,
+		fmt.Sprintf("failed. the reserve of the feeabs module is insufficient to pay %s", nativeFee))}
+}
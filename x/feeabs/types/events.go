@@ -0,0 +1,12 @@
+package types
+
+// feeabs module event types
+const (
+	EventTypeFeeConversion = "fee_conversion"
+
+	AttributeKeyFee         = "fee"
+	AttributeKeyNativeFee   = "native_fee"
+	AttributeKeyRate        = "rate"
+	AttributeKeyPriceSource = "price_source"
+	AttributeKeyFeePayer    = "fee_payer"
+)
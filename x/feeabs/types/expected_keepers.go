@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	ammswaptypes "github.com/okex/exchain/x/ammswap/types"
+	"github.com/okex/exchain/x/params"
+)
+
+// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type
+type Subspace interface {
+	GetParamSet(ctx sdk.Context, ps params.ParamSet)
+	SetParamSet(ctx sdk.Context, ps params.ParamSet)
+}
+
+// OracleKeeper defines the expected oracle keeper providing the exchange rates of the native token
+type OracleKeeper interface {
+	GetExchangeRate(ctx sdk.Context, denom string) (exchangeRate sdk.Dec, found bool)
+}
+
+// SwapKeeper defines the expected ammswap keeper providing the pools of the token pairs
+type SwapKeeper interface {
+	GetSwapTokenPair(ctx sdk.Context, tokenPairName string) (ammswaptypes.SwapTokenPair, error)
+}
+
+// SupplyKeeper defines the expected supply keeper moving the fees between the fee collector and the reserve
+type SupplyKeeper interface {
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
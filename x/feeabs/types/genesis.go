@@ -0,0 +1,23 @@
+package types
+
+// GenesisState is the state of the feeabs module that must be provided at genesis
+type GenesisState struct {
+	Params Params `json:"params" yaml:"params"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params) GenesisState {
+	return GenesisState{
+		Params: params,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// ValidateGenesis validates the feeabs genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	return data.Params.Validate()
+}
@@ -0,0 +1,22 @@
+package types
+
+const (
+	// ModuleName is the name of the feeabs module
+	ModuleName = "feeabs"
+
+	// TStoreKey is the string transient store representation
+	TStoreKey = "transient_" + ModuleName
+
+	// QuerierRoute is the querier route for the feeabs module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// ConvertedFeeKeyPrefix is the prefix of the fees converted in the block, kept in the transient store
+	ConvertedFeeKeyPrefix = []byte{0x01}
+)
+
+// GetConvertedFeeKey returns the key of the fees paid in a denom and converted in the block
+func GetConvertedFeeKey(denom string) []byte {
+	return append(ConvertedFeeKeyPrefix, []byte(denom)...)
+}
@@ -0,0 +1,225 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/params"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// PriceSourceOracle prices a fee denom by the exchange rate aggregated by the oracle module
+	PriceSourceOracle = "oracle"
+	// PriceSourceAmmSwap prices a fee denom by the reserves of its pool with the native token in the ammswap module
+	PriceSourceAmmSwap = "ammswap"
+)
+
+// Parameter store key
+var (
+	DefaultFeeDenoms          = []FeeDenom{}
+	DefaultMinPoolLiquidity   = sdk.NewDec(10000)
+	DefaultMinGasPrices       = sdk.SysCoins{}
+	DefaultModuleMinGasPrices = []ModuleMinGasPrices{}
+
+	ParamStoreKeyFeeDenoms          = []byte("FeeDenoms")
+	ParamStoreKeyMinPoolLiquidity   = []byte("MinPoolLiquidity")
+	ParamStoreKeyMinGasPrices       = []byte("MinGasPrices")
+	ParamStoreKeyModuleMinGasPrices = []byte("ModuleMinGasPrices")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// FeeDenom is a denom the fees can be paid in besides the native token, and where its price is got from
+type FeeDenom struct {
+	Denom       string `json:"denom" yaml:"denom"`
+	PriceSource string `json:"price_source" yaml:"price_source"`
+}
+
+// NewFeeDenom creates a new instance of FeeDenom
+func NewFeeDenom(denom, priceSource string) FeeDenom {
+	return FeeDenom{
+		Denom:       denom,
+		PriceSource: priceSource,
+	}
+}
+
+// ModuleMinGasPrices is the minimum gas prices of the txs containing the msgs routed to a module, overriding
+// the global minimum gas prices
+type ModuleMinGasPrices struct {
+	Module       string       `json:"module" yaml:"module"`
+	MinGasPrices sdk.SysCoins `json:"min_gas_prices" yaml:"min_gas_prices"`
+}
+
+// NewModuleMinGasPrices creates a new instance of ModuleMinGasPrices
+func NewModuleMinGasPrices(module string, minGasPrices sdk.SysCoins) ModuleMinGasPrices {
+	return ModuleMinGasPrices{
+		Module:       module,
+		MinGasPrices: minGasPrices,
+	}
+}
+
+// Params defines the feeabs module params
+type Params struct {
+	// fee_denoms defines the denoms the fees can be paid in besides the native token
+	FeeDenoms []FeeDenom `json:"fee_denoms" yaml:"fee_denoms"`
+	// min_pool_liquidity defines the minimum amount of the native token pooled in an ammswap pool for the pool to
+	// price a fee denom, so that a thin pool can't be moved to pay the fees cheaply
+	MinPoolLiquidity sdk.Dec `json:"min_pool_liquidity" yaml:"min_pool_liquidity"`
+	// min_gas_prices defines the minimum gas prices per denom enforced by consensus on all the txs. When it's set,
+	// the minimum gas prices configured locally by the node operators are ignored
+	MinGasPrices sdk.SysCoins `json:"min_gas_prices" yaml:"min_gas_prices"`
+	// module_min_gas_prices defines the minimum gas prices of the txs routed to the modules, overriding min_gas_prices
+	ModuleMinGasPrices []ModuleMinGasPrices `json:"module_min_gas_prices" yaml:"module_min_gas_prices"`
+}
+
+// NewParams creates a new Params object
+func NewParams(feeDenoms []FeeDenom, minPoolLiquidity sdk.Dec, minGasPrices sdk.SysCoins,
+	moduleMinGasPrices []ModuleMinGasPrices) Params {
+	return Params{
+		FeeDenoms:          feeDenoms,
+		MinPoolLiquidity:   minPoolLiquidity,
+		MinGasPrices:       minGasPrices,
+		ModuleMinGasPrices: moduleMinGasPrices,
+	}
+}
+
+// DefaultParams returns the default parameters of the feeabs module
+func DefaultParams() Params {
+	return NewParams(DefaultFeeDenoms, DefaultMinPoolLiquidity, DefaultMinGasPrices, DefaultModuleMinGasPrices)
+}
+
+// String implements the fmt.Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyFeeDenoms, &p.FeeDenoms, validateFeeDenoms),
+		params.NewParamSetPair(ParamStoreKeyMinPoolLiquidity, &p.MinPoolLiquidity, validateMinPoolLiquidity),
+		params.NewParamSetPair(ParamStoreKeyMinGasPrices, &p.MinGasPrices, common.ValidateSysCoins("min gas prices")),
+		params.NewParamSetPair(ParamStoreKeyModuleMinGasPrices, &p.ModuleMinGasPrices, validateModuleMinGasPrices),
+	}
+}
+
+// Validate checks all the params
+func (p Params) Validate() error {
+	if err := validateFeeDenoms(p.FeeDenoms); err != nil {
+		return err
+	}
+	if err := validateMinPoolLiquidity(p.MinPoolLiquidity); err != nil {
+		return err
+	}
+	if err := common.ValidateSysCoins("min gas prices")(p.MinGasPrices); err != nil {
+		return err
+	}
+	return validateModuleMinGasPrices(p.ModuleMinGasPrices)
+}
+
+// GetFeeDenom returns the whitelisted fee denom
+func (p Params) GetFeeDenom(denom string) (FeeDenom, bool) {
+	for _, feeDenom := range p.FeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom, true
+		}
+	}
+	return FeeDenom{}, false
+}
+
+// GetMinGasPrices returns the minimum gas prices of a tx containing the msgs. Each msg requires the minimum gas prices
+// of its module if set, otherwise the global ones, and the tx requires the highest price of each denom among its msgs
+func (p Params) GetMinGasPrices(msgs []sdk.Msg) sdk.SysCoins {
+	var minGasPrices sdk.SysCoins
+	for _, msg := range msgs {
+		msgMinGasPrices := p.MinGasPrices
+		for _, moduleMinGasPrices := range p.ModuleMinGasPrices {
+			if moduleMinGasPrices.Module == msg.Route() {
+				msgMinGasPrices = moduleMinGasPrices.MinGasPrices
+				break
+			}
+		}
+		minGasPrices = maxDecCoins(minGasPrices, msgMinGasPrices)
+	}
+	return minGasPrices
+}
+
+// maxDecCoins returns the highest amount of each denom in the two coins
+func maxDecCoins(a, b sdk.SysCoins) sdk.SysCoins {
+	result := a
+	for _, coin := range b {
+		if amount := result.AmountOf(coin.Denom); amount.LT(coin.Amount) {
+			result = result.Add(sdk.NewDecCoinFromDec(coin.Denom, coin.Amount.Sub(amount)))
+		}
+	}
+	return result
+}
+
+func validateFeeDenoms(i interface{}) error {
+	v, ok := i.([]FeeDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]bool, len(v))
+	for _, feeDenom := range v {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return err
+		}
+		if feeDenom.Denom == sdk.DefaultBondDenom {
+			return fmt.Errorf("the native token %s can't be a fee denom", feeDenom.Denom)
+		}
+		if feeDenom.PriceSource != PriceSourceOracle && feeDenom.PriceSource != PriceSourceAmmSwap {
+			return fmt.Errorf("price source of %s should be %s or %s", feeDenom.Denom, PriceSourceOracle, PriceSourceAmmSwap)
+		}
+		if denoms[feeDenom.Denom] {
+			return fmt.Errorf("duplicated fee denom %s", feeDenom.Denom)
+		}
+		denoms[feeDenom.Denom] = true
+	}
+
+	return nil
+}
+
+func validateMinPoolLiquidity(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min pool liquidity should not be negative")
+	}
+
+	return nil
+}
+
+func validateModuleMinGasPrices(i interface{}) error {
+	v, ok := i.([]ModuleMinGasPrices)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	modules := make(map[string]bool, len(v))
+	for _, moduleMinGasPrices := range v {
+		if len(moduleMinGasPrices.Module) == 0 {
+			return fmt.Errorf("module of the min gas prices should not be empty")
+		}
+		if !moduleMinGasPrices.MinGasPrices.IsValid() {
+			return fmt.Errorf("invalid min gas prices of %s: %s", moduleMinGasPrices.Module, moduleMinGasPrices.MinGasPrices)
+		}
+		if modules[moduleMinGasPrices.Module] {
+			return fmt.Errorf("duplicated min gas prices of %s", moduleMinGasPrices.Module)
+		}
+		modules[moduleMinGasPrices.Module] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	feeDenomParams := func(feeDenoms []FeeDenom, minPoolLiquidity sdk.Dec) Params {
+		return NewParams(feeDenoms, minPoolLiquidity, DefaultMinGasPrices, DefaultModuleMinGasPrices)
+	}
+	minGasPrices := sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 9))
+	gasPriceParams := func(minGasPrices sdk.SysCoins, moduleMinGasPrices ...ModuleMinGasPrices) Params {
+		return NewParams(DefaultFeeDenoms, DefaultMinPoolLiquidity, minGasPrices, moduleMinGasPrices)
+	}
+
+	tests := []struct {
+		name   string
+		params Params
+		valid  bool
+	}{
+		{"default", DefaultParams(), true},
+		{"fee denoms", feeDenomParams([]FeeDenom{
+			NewFeeDenom("usdt", PriceSourceOracle),
+			NewFeeDenom("usdk", PriceSourceAmmSwap),
+		}, sdk.ZeroDec()), true},
+		{"native token", feeDenomParams([]FeeDenom{NewFeeDenom(sdk.DefaultBondDenom, PriceSourceOracle)}, sdk.ZeroDec()), false},
+		{"unknown price source", feeDenomParams([]FeeDenom{NewFeeDenom("usdt", "dex")}, sdk.ZeroDec()), false},
+		{"invalid denom", feeDenomParams([]FeeDenom{NewFeeDenom("U", PriceSourceOracle)}, sdk.ZeroDec()), false},
+		{"duplicated", feeDenomParams([]FeeDenom{
+			NewFeeDenom("usdt", PriceSourceOracle),
+			NewFeeDenom("usdt", PriceSourceAmmSwap),
+		}, sdk.ZeroDec()), false},
+		{"negative min pool liquidity", feeDenomParams(DefaultFeeDenoms, sdk.NewDec(-1)), false},
+		{"nil min pool liquidity", feeDenomParams(DefaultFeeDenoms, sdk.Dec{}), false},
+		{"min gas prices", gasPriceParams(minGasPrices, NewModuleMinGasPrices("wasm", minGasPrices)), true},
+		{"invalid min gas prices", gasPriceParams(sdk.SysCoins{sdk.DecCoin{Denom: "U", Amount: sdk.OneDec()}}), false},
+		{"empty module", gasPriceParams(minGasPrices, NewModuleMinGasPrices("", minGasPrices)), false},
+		{"duplicated module", gasPriceParams(minGasPrices, NewModuleMinGasPrices("wasm", minGasPrices),
+			NewModuleMinGasPrices("wasm", sdk.SysCoins{})), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.params.Validate()
+		if tc.valid {
+			require.Nil(t, err, tc.name)
+		} else {
+			require.NotNil(t, err, tc.name)
+		}
+	}
+
+	feeDenom, found := tests[1].params.GetFeeDenom("usdk")
+	require.True(t, found)
+	require.Equal(t, PriceSourceAmmSwap, feeDenom.PriceSource)
+	_, found = tests[1].params.GetFeeDenom("okt")
+	require.False(t, found)
+}
+
+type testMsg struct {
+	sdk.Msg
+	route string
+}
+
+func (msg testMsg) Route() string { return msg.route }
+
+func TestParamsGetMinGasPrices(t *testing.T) {
+	params := NewParams(DefaultFeeDenoms, DefaultMinPoolLiquidity,
+		sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 9)),
+		[]ModuleMinGasPrices{
+			NewModuleMinGasPrices("wasm", sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(5, 9))),
+			NewModuleMinGasPrices("token", sdk.SysCoins{}),
+			NewModuleMinGasPrices("farm", sdk.NewDecCoinsFromDec("usdt", sdk.NewDecWithPrec(2, 9))),
+		})
+
+	require.Equal(t, sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 9)),
+		params.GetMinGasPrices([]sdk.Msg{testMsg{route: "staking"}}))
+	require.True(t, params.GetMinGasPrices([]sdk.Msg{testMsg{route: "token"}}).IsZero())
+	require.Equal(t, sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(5, 9)),
+		params.GetMinGasPrices([]sdk.Msg{testMsg{route: "staking"}, testMsg{route: "wasm"}, testMsg{route: "token"}}))
+	require.Equal(t, sdk.SysCoins{
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 9)),
+		sdk.NewDecCoinFromDec("usdt", sdk.NewDecWithPrec(2, 9)),
+	}, params.GetMinGasPrices([]sdk.Msg{testMsg{route: "farm"}, testMsg{route: "staking"}}))
+}
@@ -0,0 +1,28 @@
+package types
+
+const (
+	// QueryParameters is the query endpoint of the feeabs params
+	QueryParameters = "params"
+	// QueryNativeFee is the query endpoint of the native value of a fee paid in a whitelisted denom
+	QueryNativeFee = "native_fee"
+)
+
+// QueryNativeFeeParams is the params of the query of the native value of a fee
+type QueryNativeFeeParams struct {
+	Fee string `json:"fee"`
+}
+
+// NewQueryNativeFeeParams creates a new instance of QueryNativeFeeParams
+func NewQueryNativeFeeParams(fee string) QueryNativeFeeParams {
+	return QueryNativeFeeParams{
+		Fee: fee,
+	}
+}
+
+// NativeFee is a fee paid in a whitelisted denom with its native value and the rate converted at
+type NativeFee struct {
+	Fee         string `json:"fee" yaml:"fee"`
+	NativeFee   string `json:"native_fee" yaml:"native_fee"`
+	Rate        string `json:"rate" yaml:"rate"`
+	PriceSource string `json:"price_source" yaml:"price_source"`
+}
@@ -0,0 +1,183 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/input"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+
+	"github.com/okex/exchain/x/gov/types"
+)
+
+const (
+	flagOutputFile = "output-file"
+
+	defaultDraftProposalFile = "draft_proposal.json"
+)
+
+// draftProposal is the proposal JSON file written by draft-proposal, which is
+// submitted with submit-proposal --proposal.
+type draftProposal struct {
+	Content json.RawMessage `json:"content"`
+	Deposit string          `json:"deposit"`
+}
+
+// getCmdDraftProposal implements the interactive drafting of a proposal of any
+// registered type.
+func getCmdDraftProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "draft-proposal",
+		Short: "Generate a proposal JSON file ready to submit, interactively",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Walk through the fields of a proposal of any registered type, including the evm and wasm
+proposals, validate it and write it to a proposal JSON file.
+The string fields are entered as is, the other fields as JSON values, e.g. ["addr1","addr2"] for a list.
+
+Example:
+$ %s tx gov draft-proposal --output-file=proposal.json
+$ %s tx gov submit-proposal --proposal=proposal.json --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			buf := bufio.NewReader(cmd.InOrStdin())
+			draft, err := draftProposalFromInput(cdc, buf, cmd.ErrOrStderr())
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(draft, "", "  ")
+			if err != nil {
+				return err
+			}
+			output := viper.GetString(flagOutputFile)
+			if err := ioutil.WriteFile(output, bz, 0644); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.ErrOrStderr(), "The proposal is written to %s, submit it with:\n%s tx gov submit-proposal --proposal=%s --from <key_or_address>\n",
+				output, version.ClientName, output)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagOutputFile, defaultDraftProposalFile, "the proposal JSON file to write")
+
+	return cmd
+}
+
+// draftProposalFromInput prompts for the type, the content and the deposit of a
+// proposal, and validates them.
+func draftProposalFromInput(cdc *codec.Codec, buf *bufio.Reader, w io.Writer) (*draftProposal, error) {
+	names := types.RegisteredProposalContents()
+	for i, name := range names {
+		fmt.Fprintf(w, "%3d) %s\n", i+1, name)
+	}
+	name, err := input.GetString(fmt.Sprintf("Select the proposal type [1-%d]:", len(names)), buf)
+	if err != nil {
+		return nil, err
+	}
+	if i, err := strconv.Atoi(name); err == nil && i >= 1 && i <= len(names) {
+		name = names[i-1]
+	}
+	ptr, ok := types.NewProposalContent(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown proposal type %s", name)
+	}
+
+	v := reflect.ValueOf(ptr).Elem()
+	if err := promptFields(cdc, v, buf); err != nil {
+		return nil, err
+	}
+	content, ok := v.Interface().(types.Content)
+	if !ok {
+		if content, ok = ptr.(types.Content); !ok {
+			return nil, fmt.Errorf("%s isn't a proposal content", name)
+		}
+	}
+	if err := content.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid proposal: %w", err)
+	}
+
+	deposit, err := input.GetString(fmt.Sprintf("deposit, e.g. 100%s:", sdk.DefaultBondDenom), buf)
+	if err != nil {
+		return nil, err
+	}
+	coins, err := sdk.ParseDecCoins(deposit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deposit: %w", err)
+	}
+	if !coins.IsValid() || coins.Empty() {
+		return nil, fmt.Errorf("invalid deposit %s", deposit)
+	}
+
+	bz, err := cdc.MarshalJSON(content)
+	if err != nil {
+		return nil, err
+	}
+	return &draftProposal{Content: bz, Deposit: coins.String()}, nil
+}
+
+// promptFields prompts for the exported fields of the struct by their JSON
+// names. An empty input leaves the field empty.
+func promptFields(cdc *codec.Codec, v reflect.Value, buf *bufio.Reader) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		value, err := input.GetString(fmt.Sprintf("%s (%s):", name, field.Type), buf)
+		if err != nil {
+			return err
+		}
+		if value == "" {
+			continue
+		}
+		if err := parseFieldValue(cdc, v.Field(i), value); err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// parseFieldValue sets the field from its JSON value. The strings and the values
+// encoded as JSON strings, e.g. addresses or int64s, may be entered unquoted.
+func parseFieldValue(cdc *codec.Codec, field reflect.Value, value string) error {
+	if field.Kind() == reflect.String {
+		field.SetString(value)
+		return nil
+	}
+
+	ptr := reflect.New(field.Type())
+	err := cdc.UnmarshalJSON([]byte(value), ptr.Interface())
+	if err != nil {
+		quoted, _ := json.Marshal(value)
+		if cdc.UnmarshalJSON(quoted, ptr.Interface()) != nil {
+			return err
+		}
+	}
+	field.Set(ptr.Elem())
+	return nil
+}
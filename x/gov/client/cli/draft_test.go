@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"bufio"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+
+	"github.com/okex/exchain/x/gov/types"
+)
+
+func TestDraftProposalFromInput(t *testing.T) {
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+
+	draft := func(in string) (*draftProposal, error) {
+		return draftProposalFromInput(cdc, bufio.NewReader(strings.NewReader(in)), ioutil.Discard)
+	}
+
+	// the proposal type is selected by its number or its name
+	d, err := draft("okexchain/gov/TextProposal\nmy title\nmy description\n10okt\n")
+	require.NoError(t, err)
+	require.Equal(t, "10.000000000000000000okt", d.Deposit)
+
+	var content types.Content
+	require.NoError(t, cdc.UnmarshalJSON(d.Content, &content))
+	require.Equal(t, types.NewTextProposal("my title", "my description"), content)
+
+	_, err = draft("unknown\n")
+	require.Error(t, err)
+
+	// the proposal and the deposit are validated
+	_, err = draft("okexchain/gov/TextProposal\n\nmy description\n10okt\n")
+	require.Error(t, err)
+	_, err = draft("okexchain/gov/TextProposal\nmy title\nmy description\n10\n")
+	require.Error(t, err)
+	_, err = draft("okexchain/gov/TextProposal\nmy title\n")
+	require.Error(t, err)
+}
+
+func TestParseFieldValue(t *testing.T) {
+	cdc := codec.New()
+	var fields struct {
+		Str    string
+		Int    int64
+		Bool   bool
+		Uint64 []uint64
+	}
+
+	require.NoError(t, parseFieldValue(cdc, fieldByName(&fields, "Str"), `"quoted"`))
+	require.Equal(t, `"quoted"`, fields.Str)
+	// the values encoded as JSON strings may be unquoted
+	require.NoError(t, parseFieldValue(cdc, fieldByName(&fields, "Int"), "100"))
+	require.Equal(t, int64(100), fields.Int)
+	require.NoError(t, parseFieldValue(cdc, fieldByName(&fields, "Int"), `"200"`))
+	require.Equal(t, int64(200), fields.Int)
+	require.NoError(t, parseFieldValue(cdc, fieldByName(&fields, "Bool"), "true"))
+	require.True(t, fields.Bool)
+	require.NoError(t, parseFieldValue(cdc, fieldByName(&fields, "Uint64"), `["1","2"]`))
+	require.Equal(t, []uint64{1, 2}, fields.Uint64)
+
+	require.Error(t, parseFieldValue(cdc, fieldByName(&fields, "Bool"), "yes"))
+}
+
+func fieldByName(ptr interface{}, name string) reflect.Value {
+	return reflect.ValueOf(ptr).Elem().FieldByName(name)
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
 	"strconv"
@@ -43,6 +44,8 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	// Content is the amino JSON of a proposal content of any type, drafted by draft-proposal
+	Content json.RawMessage
 }
 
 // proposalFlags defines the core required fields of a proposal. It is used to
@@ -79,6 +82,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 		GetCmdVote(cdc),
 		cmdSubmitProp,
 	)...)
+	govTxCmd.AddCommand(getCmdDraftProposal(cdc))
 
 	return govTxCmd
 }
@@ -108,6 +112,8 @@ Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" \
 	--deposit="10%s" --from mykey
+
+The proposal JSON file may instead contain the content of a proposal of any type, see draft-proposal.
 `,
 				version.ClientName, sdk.DefaultBondDenom, version.ClientName, sdk.DefaultBondDenom,
 			),
@@ -128,6 +134,11 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 			}
 
 			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
+			if len(proposal.Content) != 0 {
+				if err := cdc.UnmarshalJSON(proposal.Content, &content); err != nil {
+					return err
+				}
+			}
 			msg := types.NewMsgSubmitProposal(content, amount, cliCtx.GetFromAddress())
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -56,13 +56,7 @@ func handleInActiveProposals(ctx sdk.Context, k keeper.Keeper, logger log.Logger
 		k.DeleteProposal(ctx, proposal.ProposalID)
 		k.DistributeDeposits(ctx, proposal.ProposalID)
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeInactiveProposal,
-				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
-				sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalDropped),
-			),
-		)
+		ctx.EventManager().EmitEvent(types.NewInactiveProposalEvent(proposal.ProposalID))
 
 		logger.Info(
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
@@ -93,13 +87,7 @@ func handleActiveProposals(ctx sdk.Context, k keeper.Keeper, logger log.Logger)
 			),
 		)
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeActiveProposal,
-				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
-				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
-			),
-		)
+		ctx.EventManager().EmitEvent(types.NewActiveProposalEvent(proposal.ProposalID, tagValue))
 		return false
 	})
 }
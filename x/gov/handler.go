@@ -122,14 +122,7 @@ func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg MsgVote) (*sdk.Result,
 		k.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
 		proposal.VotingEndTime = ctx.BlockHeader().Time
 		k.DeleteVotes(ctx, proposal.ProposalID)
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeProposalVoteTally,
-				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
-				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
-				sdk.NewAttribute(types.AttributeKeyProposalLog, logMsg),
-			),
-		)
+		ctx.EventManager().EmitEvent(types.NewProposalVoteTallyEvent(proposal.ProposalID, tagValue, logMsg))
 	}
 	k.SetProposal(ctx, proposal)
 
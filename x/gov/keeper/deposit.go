@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"fmt"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/gov/types"
 )
@@ -44,12 +43,7 @@ func tryEnterVotingPeriod(
 			proposalHandler.AfterDepositPeriodPassed(ctx, *proposal)
 		}
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				eventType,
-				sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.ProposalID)),
-			),
-		)
+		ctx.EventManager().EmitEvent(types.NewVotingPeriodStartEvent(eventType, proposal.ProposalID))
 	}
 }
 
@@ -98,13 +92,7 @@ func (keeper Keeper) AddDeposit(
 	// Add or update deposit object
 	updateDeposit(ctx, keeper, proposalID, depositorAddr, depositAmount)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeProposalDeposit,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, depositAmount.String()),
-			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
-		),
-	)
+	ctx.EventManager().EmitEvent(types.NewProposalDepositEvent(proposalID, depositAmount))
 
 	return nil
 }
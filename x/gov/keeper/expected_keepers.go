@@ -1,12 +1,18 @@
 package keeper
 
 import (
+	authexported "github.com/okex/exchain/libs/cosmos-sdk/x/auth/exported"
 	supplyexported "github.com/okex/exchain/libs/cosmos-sdk/x/supply/exported"
 	stakingexported "github.com/okex/exchain/x/staking/exported"
 
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 )
 
+// AccountKeeper defines the expected account keeper used for simulations
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authexported.Account
+}
+
 // BankKeeper defines expected bank keeper
 type BankKeeper interface {
 	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
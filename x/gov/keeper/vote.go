@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"fmt"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/x/gov/types"
 )
@@ -36,13 +35,7 @@ func (keeper Keeper) AddVote(
 
 	keeper.SetVote(ctx, proposalID, vote)
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
-			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
-		),
-	)
+	ctx.EventManager().EmitEvent(types.NewProposalVoteEvent(proposalID, option))
 
 	return nil, voteFeeStr
 }
@@ -2,7 +2,9 @@ package gov
 
 import (
 	"encoding/json"
+	"fmt"
 	"math/rand"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
@@ -15,6 +17,7 @@ import (
 	"github.com/okex/exchain/x/gov/client/cli"
 	"github.com/okex/exchain/x/gov/client/rest"
 	"github.com/okex/exchain/x/gov/keeper"
+	"github.com/okex/exchain/x/gov/simulation"
 	"github.com/okex/exchain/x/gov/types"
 	"github.com/okex/exchain/x/wasm/watcher"
 	"github.com/spf13/cobra"
@@ -88,14 +91,16 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 	keeper       Keeper
+	accKeeper    keeper.AccountKeeper
 	supplyKeeper keeper.SupplyKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(keeper Keeper, supplyKeeper keeper.SupplyKeeper) AppModule {
+func NewAppModule(keeper Keeper, accKeeper keeper.AccountKeeper, supplyKeeper keeper.SupplyKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         keeper,
+		accKeeper:      accKeeper,
 		supplyKeeper:   supplyKeeper,
 	}
 }
@@ -158,28 +163,85 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 }
 
 // AppModuleSimulation functions
-// TODO: implement the AppModuleSimulation interface
 
-// GenerateGenesisState creates a randomized GenState of the staking module.
+// GenerateGenesisState creates a randomized GenState of the gov module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	var minDeposit sdk.SysCoins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, simulation.DepositParamsMinDeposit, &minDeposit, simState.Rand,
+		func(r *rand.Rand) { minDeposit = simulation.GenDepositParamsMinDeposit(r) },
+	)
+
+	var depositPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, simulation.DepositParamsDepositPeriod, &depositPeriod, simState.Rand,
+		func(r *rand.Rand) { depositPeriod = simulation.GenDepositParamsDepositPeriod(r) },
+	)
+
+	var votingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, simulation.VotingParamsVotingPeriod, &votingPeriod, simState.Rand,
+		func(r *rand.Rand) { votingPeriod = simulation.GenVotingParamsVotingPeriod(r) },
+	)
+
+	var quorum sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, simulation.TallyParamsQuorum, &quorum, simState.Rand,
+		func(r *rand.Rand) { quorum = simulation.GenTallyParamsQuorum(r) },
+	)
+
+	var threshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, simulation.TallyParamsThreshold, &threshold, simState.Rand,
+		func(r *rand.Rand) { threshold = simulation.GenTallyParamsThreshold(r) },
+	)
+
+	var veto sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, simulation.TallyParamsVeto, &veto, simState.Rand,
+		func(r *rand.Rand) { veto = simulation.GenTallyParamsVeto(r) },
+	)
+
+	var yesInVotePeriod sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, simulation.TallyParamsYesInVotePeriod, &yesInVotePeriod, simState.Rand,
+		func(r *rand.Rand) { yesInVotePeriod = simulation.GenTallyParamsYesInVotePeriod(r) },
+	)
+
+	govGenesis := DefaultGenesisState()
+	govGenesis.DepositParams = NewDepositParams(minDeposit, depositPeriod)
+	govGenesis.VotingParams = NewVotingParams(votingPeriod)
+	govGenesis.TallyParams = TallyParams{
+		Quorum:          quorum,
+		Threshold:       threshold,
+		Veto:            veto,
+		YesInVotePeriod: yesInVotePeriod,
+	}
+
+	fmt.Printf("Selected randomly generated governance parameters:\n%s\n",
+		codec.MustMarshalJSONIndent(simState.Cdc, govGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(govGenesis)
 }
 
-// ProposalContents doesn't return any content functions for governance proposals.
+// ProposalContents returns all the gov content functions used to
+// simulate governance proposals.
 func (AppModule) ProposalContents(_ module.SimulationState) []sim.WeightedProposalContent {
-	return nil
+	return simulation.ProposalContents()
 }
 
-// RandomizedParams creates randomized staking param changes for the simulator.
+// RandomizedParams creates randomized gov param changes for the simulator.
 func (AppModule) RandomizedParams(r *rand.Rand) []sim.ParamChange {
-	return nil
+	return simulation.ParamChanges(r)
 }
 
-// RegisterStoreDecoder registers a decoder for staking module's types
+// RegisterStoreDecoder registers a decoder for gov module's types
 func (AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
 
 }
 
-// WeightedOperations returns the all the staking module operations with their respective weights.
+// WeightedOperations returns the all the gov module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []sim.WeightedOperation {
-	return nil
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc, am.accKeeper, am.keeper, NewHandler(am.keeper), simState.Contents,
+	)
 }
@@ -72,7 +72,7 @@ func TestNewAppModuleBasic(t *testing.T) {
 	// todo: check diff after GetQueryCmd
 	moduleBasic.GetQueryCmd(cdc)
 
-	appModule := NewAppModule(gk, gk.SupplyKeeper())
+	appModule := NewAppModule(gk, nil, gk.SupplyKeeper())
 	require.Equal(t, types.ModuleName, appModule.Name())
 
 	// todo: check diff after RegisterInvariants
@@ -0,0 +1,60 @@
+package gov
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/baseapp"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/gov/types"
+)
+
+var (
+	ErrCheckSignerFail = errors.New("check signer fail")
+)
+
+func init() {
+	RegisterConvert()
+}
+
+// RegisterConvert registers the conversions of the gov msgs invoked through the system contract, so that the evm
+// accounts can deposit on and vote for the proposals with an evm tx
+func RegisterConvert() {
+	enableHeight := tmtypes.GetVenus5Height()
+	baseapp.RegisterCmHandle("okexchain/gov/MsgDeposit", baseapp.NewCMHandle(ConvertDepositMsg, enableHeight))
+	baseapp.RegisterCmHandle("okexchain/gov/MsgVote", baseapp.NewCMHandle(ConvertVoteMsg, enableHeight))
+}
+
+func ConvertDepositMsg(data []byte, signers []sdk.AccAddress) (sdk.Msg, error) {
+	newMsg := types.MsgDeposit{}
+	err := json.Unmarshal(data, &newMsg)
+	if err != nil {
+		return nil, err
+	}
+	err = newMsg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	if ok := common.CheckSignerAddress(signers, newMsg.GetSigners()); !ok {
+		return nil, ErrCheckSignerFail
+	}
+	return newMsg, nil
+}
+
+func ConvertVoteMsg(data []byte, signers []sdk.AccAddress) (sdk.Msg, error) {
+	newMsg := types.MsgVote{}
+	err := json.Unmarshal(data, &newMsg)
+	if err != nil {
+		return nil, err
+	}
+	err = newMsg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	if ok := common.CheckSignerAddress(signers, newMsg.GetSigners()); !ok {
+		return nil, ErrCheckSignerFail
+	}
+	return newMsg, nil
+}
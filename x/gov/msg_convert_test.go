@@ -0,0 +1,127 @@
+package gov
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertDepositMsg(t *testing.T) {
+	addr, err := sdk.AccAddressFromHex("B2910E22Bb23D129C02d122B77B462ceB0E89Db9")
+	require.NoError(t, err)
+	amount := sdk.SysCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(15, 1))}
+
+	testcases := []struct {
+		msgstr  string
+		res     types.MsgDeposit
+		fnCheck func(msg sdk.Msg, err error, res types.MsgDeposit)
+	}{
+		{
+			msgstr: fmt.Sprintf(`{"proposal_id": 1,"depositor": "%s","amount": [{"denom": "okt","amount": "1.5"}]}`, addr.String()),
+			res:    types.NewMsgDeposit(addr, 1, amount),
+			fnCheck: func(msg sdk.Msg, err error, res types.MsgDeposit) {
+				require.NoError(t, err)
+				require.Equal(t, res, msg.(types.MsgDeposit))
+			},
+		},
+		{
+			msgstr: `{"proposal_id": 2,"depositor": "0xB2910E22Bb23D129C02d122B77B462ceB0E89Db9","amount": [{"denom": "okt","amount": "1.5"}]}`,
+			res:    types.NewMsgDeposit(addr, 2, amount),
+			fnCheck: func(msg sdk.Msg, err error, res types.MsgDeposit) {
+				require.NoError(t, err)
+				require.Equal(t, res, msg.(types.MsgDeposit))
+			},
+		},
+		// error
+		{
+			msgstr: "123",
+			res:    types.NewMsgDeposit(addr, 1, amount),
+			fnCheck: func(msg sdk.Msg, err error, res types.MsgDeposit) {
+				require.Error(t, err)
+				require.Nil(t, msg)
+			},
+		},
+		{
+			msgstr: `{"proposal_id": 1,"depositor": "0xB2910E22Bb23D129C02d122B77B462ceB0E89Db9","amount": [{"denom": "okt","amount": "-1.5"}]}`,
+			res:    types.NewMsgDeposit(addr, 1, amount),
+			fnCheck: func(msg sdk.Msg, err error, res types.MsgDeposit) {
+				require.Error(t, err)
+				require.Nil(t, msg)
+			},
+		},
+		{
+			msgstr: `{"proposal_id": 1,"depositor": "0x889Fb79ac5Ec9C1Ee86Db2D3f3857Dd3D4af0C2E","amount": [{"denom": "okt","amount": "1.5"}]}`,
+			res:    types.NewMsgDeposit(addr, 1, amount),
+			fnCheck: func(msg sdk.Msg, err error, res types.MsgDeposit) {
+				require.Equal(t, ErrCheckSignerFail, err)
+				require.Nil(t, msg)
+			},
+		},
+	}
+
+	for _, ts := range testcases {
+		msg, err := ConvertDepositMsg([]byte(ts.msgstr), ts.res.GetSigners())
+		ts.fnCheck(msg, err, ts.res)
+	}
+}
+
+func TestConvertVoteMsg(t *testing.T) {
+	addr, err := sdk.AccAddressFromHex("B2910E22Bb23D129C02d122B77B462ceB0E89Db9")
+	require.NoError(t, err)
+
+	testcases := []struct {
+		msgstr  string
+		res     types.MsgVote
+		fnCheck func(msg sdk.Msg, err error, res types.MsgVote)
+	}{
+		{
+			msgstr: fmt.Sprintf(`{"proposal_id": 1,"voter": "%s","option": "Yes"}`, addr.String()),
+			res:    types.NewMsgVote(addr, 1, types.OptionYes),
+			fnCheck: func(msg sdk.Msg, err error, res types.MsgVote) {
+				require.NoError(t, err)
+				require.Equal(t, res, msg.(types.MsgVote))
+			},
+		},
+		{
+			msgstr: `{"proposal_id": 2,"voter": "0xB2910E22Bb23D129C02d122B77B462ceB0E89Db9","option": "NoWithVeto"}`,
+			res:    types.NewMsgVote(addr, 2, types.OptionNoWithVeto),
+			fnCheck: func(msg sdk.Msg, err error, res types.MsgVote) {
+				require.NoError(t, err)
+				require.Equal(t, res, msg.(types.MsgVote))
+			},
+		},
+		// error
+		{
+			msgstr: `{"proposal_id": 1,"voter": "0xB2910E22Bb23D129C02d122B77B462ceB0E89Db9","option": "Maybe"}`,
+			res:    types.NewMsgVote(addr, 1, types.OptionYes),
+			fnCheck: func(msg sdk.Msg, err error, res types.MsgVote) {
+				require.Error(t, err)
+				require.Nil(t, msg)
+			},
+		},
+		{
+			msgstr: `{"proposal_id": 1,"voter": "","option": "Yes"}`,
+			res:    types.NewMsgVote(addr, 1, types.OptionYes),
+			fnCheck: func(msg sdk.Msg, err error, res types.MsgVote) {
+				require.Error(t, err)
+				require.Nil(t, msg)
+			},
+		},
+		{
+			msgstr: `{"proposal_id": 1,"voter": "0x889Fb79ac5Ec9C1Ee86Db2D3f3857Dd3D4af0C2E","option": "Yes"}`,
+			res:    types.NewMsgVote(addr, 1, types.OptionYes),
+			fnCheck: func(msg sdk.Msg, err error, res types.MsgVote) {
+				require.Equal(t, ErrCheckSignerFail, err)
+				require.Nil(t, msg)
+			},
+		},
+	}
+
+	for _, ts := range testcases {
+		msg, err := ConvertVoteMsg([]byte(ts.msgstr), ts.res.GetSigners())
+		ts.fnCheck(msg, err, ts.res)
+	}
+}
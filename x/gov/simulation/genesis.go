@@ -0,0 +1,57 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
+)
+
+// Simulation parameter constants
+const (
+	DepositParamsMinDeposit    = "deposit_params_min_deposit"
+	DepositParamsDepositPeriod = "deposit_params_deposit_period"
+	VotingParamsVotingPeriod   = "voting_params_voting_period"
+	TallyParamsQuorum          = "tally_params_quorum"
+	TallyParamsThreshold       = "tally_params_threshold"
+	TallyParamsVeto            = "tally_params_veto"
+	TallyParamsYesInVotePeriod = "tally_params_yes_in_vote_period"
+)
+
+// GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
+func GenDepositParamsDepositPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
+}
+
+// GenDepositParamsMinDeposit randomized DepositParamsMinDeposit
+func GenDepositParamsMinDeposit(r *rand.Rand) sdk.SysCoins {
+	return sdk.SysCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(simulation.RandIntBetween(r, 1, 1e3))))}
+}
+
+// GenVotingParamsVotingPeriod randomized VotingParamsVotingPeriod
+func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
+}
+
+// GenTallyParamsQuorum randomized TallyParamsQuorum
+func GenTallyParamsQuorum(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 334, 500)), 3)
+}
+
+// GenTallyParamsThreshold randomized TallyParamsThreshold
+func GenTallyParamsThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 450, 550)), 3)
+}
+
+// GenTallyParamsVeto randomized TallyParamsVeto
+func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
+}
+
+// GenTallyParamsYesInVotePeriod randomized TallyParamsYesInVotePeriod
+func GenTallyParamsYesInVotePeriod(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 600, 800)), 3)
+}
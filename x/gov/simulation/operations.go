@@ -0,0 +1,205 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/baseapp"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/okex/exchain/libs/cosmos-sdk/simapp/params"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
+	"github.com/okex/exchain/x/gov/keeper"
+	"github.com/okex/exchain/x/gov/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgDeposit = "op_weight_msg_deposit"
+	OpWeightMsgVote    = "op_weight_msg_vote"
+)
+
+// the proposals carry long descriptions, which cost more gas to be stored again by every msg on them
+const govMsgGas = 10 * helpers.DefaultGenTxGas
+
+// WeightedOperations returns all the operations from the module with their respective weights. The gov handler is
+// used to skip the msgs that would be rejected, as their checks are spread over the proposal handlers of the modules.
+func WeightedOperations(
+	appParams simulation.AppParams, cdc *codec.Codec, ak keeper.AccountKeeper, k keeper.Keeper,
+	handler sdk.Handler, wContents []simulation.WeightedProposalContent,
+) simulation.WeightedOperations {
+
+	var (
+		weightMsgDeposit int
+		weightMsgVote    int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeposit = simappparams.DefaultWeightMsgDeposit
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgVote, &weightMsgVote, nil,
+		func(_ *rand.Rand) {
+			weightMsgVote = simappparams.DefaultWeightMsgVote
+		},
+	)
+
+	// generate the weighted operations for the proposal contents
+	var wProposalOps simulation.WeightedOperations
+
+	for _, wContent := range wContents {
+		wContent := wContent // pin variable
+		var weight int
+		appParams.GetOrGenerate(cdc, wContent.AppParamsKey, &weight, nil,
+			func(_ *rand.Rand) { weight = wContent.DefaultWeight })
+
+		wProposalOps = append(
+			wProposalOps,
+			simulation.NewWeightedOperation(
+				weight,
+				SimulateSubmitProposal(ak, k, handler, wContent.ContentSimulatorFn),
+			),
+		)
+	}
+
+	wGovOps := simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgDeposit,
+			SimulateMsgDeposit(ak, k, handler),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgVote,
+			SimulateMsgVote(ak, k, handler),
+		),
+	}
+
+	return append(wProposalOps, wGovOps...)
+}
+
+// SimulateSubmitProposal simulates creating a msg Submit Proposal with the content and an initial deposit between the
+// required ratio of the min deposit and the min deposit of the proposal
+func SimulateSubmitProposal(
+	ak keeper.AccountKeeper, k keeper.Keeper, handler sdk.Handler, contentSim simulation.ContentSimulatorFn,
+) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		content := contentSim(r, ctx, accs)
+		if content == nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		minInitDeposit := k.GetDepositParams(ctx).MinDeposit.AmountOf(sdk.DefaultBondDenom).Mul(sdk.NewDecWithPrec(1, 1))
+		maxInitDeposit := minDeposit(ctx, k, content)
+		if maxInitDeposit.LT(minInitDeposit) {
+			maxInitDeposit = minInitDeposit
+		}
+		deposit := sdk.SysCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom,
+			minInitDeposit.Add(simulation.RandomDecAmount(r, maxInitDeposit.Sub(minInitDeposit))))}
+
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		msg := types.NewMsgSubmitProposal(content, deposit, simAccount.Address)
+		if !isMsgAccepted(ctx, handler, msg) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		return helpers.GenAndDeliverTxWithRandFees(r, app, ctx, ak, simAccount, msg, deposit,
+			govMsgGas, chainID)
+	}
+}
+
+// SimulateMsgDeposit generates a MsgDeposit with random values to a random proposal in the deposit period
+func SimulateMsgDeposit(ak keeper.AccountKeeper, k keeper.Keeper, handler sdk.Handler) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		proposal, ok := randomProposal(r, ctx, k, types.StatusDepositPeriod)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		amount := simulation.RandomDecAmount(r, minDeposit(ctx, k, proposal.Content))
+		if !amount.IsPositive() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		deposit := sdk.SysCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, amount)}
+
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		msg := types.NewMsgDeposit(simAccount.Address, proposal.ProposalID, deposit)
+		if !isMsgAccepted(ctx, handler, msg) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		return helpers.GenAndDeliverTxWithRandFees(r, app, ctx, ak, simAccount, msg, deposit,
+			govMsgGas, chainID)
+	}
+}
+
+// SimulateMsgVote generates a MsgVote with random values to a random proposal in the voting period
+func SimulateMsgVote(ak keeper.AccountKeeper, k keeper.Keeper, handler sdk.Handler) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		proposal, ok := randomProposal(r, ctx, k, types.StatusVotingPeriod)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		msg := types.NewMsgVote(simAccount.Address, proposal.ProposalID, randomVotingOption(r))
+		if !isMsgAccepted(ctx, handler, msg) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		return helpers.GenAndDeliverTxWithRandFees(r, app, ctx, ak, simAccount, msg, nil,
+			govMsgGas, chainID)
+	}
+}
+
+// isMsgAccepted runs the msg on a cached context to tell whether the handler accepts it
+func isMsgAccepted(ctx sdk.Context, handler sdk.Handler, msg sdk.Msg) bool {
+	if msg.ValidateBasic() != nil {
+		return false
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := handler(cacheCtx, msg)
+	return err == nil
+}
+
+// minDeposit returns the deposit for the proposal of the content to enter the voting period
+func minDeposit(ctx sdk.Context, k keeper.Keeper, content types.Content) sdk.Dec {
+	if k.ProposalHandlerRouter().HasRoute(content.ProposalRoute()) {
+		return k.ProposalHandlerRouter().GetRoute(content.ProposalRoute()).
+			GetMinDeposit(ctx, content).AmountOf(sdk.DefaultBondDenom)
+	}
+	return k.GetDepositParams(ctx).MinDeposit.AmountOf(sdk.DefaultBondDenom)
+}
+
+// randomProposal returns a random proposal in the status
+func randomProposal(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, status types.ProposalStatus) (types.Proposal, bool) {
+	proposals := k.GetProposalsFiltered(ctx, nil, nil, status, 0)
+	if len(proposals) == 0 {
+		return types.Proposal{}, false
+	}
+	return proposals[r.Intn(len(proposals))], true
+}
+
+// randomVotingOption picks a random voting option
+func randomVotingOption(r *rand.Rand) types.VoteOption {
+	switch r.Intn(4) {
+	case 0:
+		return types.OptionYes
+	case 1:
+		return types.OptionAbstain
+	case 2:
+		return types.OptionNo
+	default:
+		return types.OptionNoWithVeto
+	}
+}
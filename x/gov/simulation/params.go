@@ -0,0 +1,39 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
+	"github.com/okex/exchain/x/gov/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.DefaultParamspace, string(types.ParamStoreKeyDepositParams),
+			func(r *rand.Rand) string {
+				return string(types.ModuleCdc.MustMarshalJSON(
+					types.NewDepositParams(GenDepositParamsMinDeposit(r), GenDepositParamsDepositPeriod(r)),
+				))
+			},
+		),
+		simulation.NewSimParamChange(types.DefaultParamspace, string(types.ParamStoreKeyVotingParams),
+			func(r *rand.Rand) string {
+				return string(types.ModuleCdc.MustMarshalJSON(types.NewVotingParams(GenVotingParamsVotingPeriod(r))))
+			},
+		),
+		simulation.NewSimParamChange(types.DefaultParamspace, string(types.ParamStoreKeyTallyParams),
+			func(r *rand.Rand) string {
+				return string(types.ModuleCdc.MustMarshalJSON(types.TallyParams{
+					Quorum:          GenTallyParamsQuorum(r),
+					Threshold:       GenTallyParamsThreshold(r),
+					Veto:            GenTallyParamsVeto(r),
+					YesInVotePeriod: GenTallyParamsYesInVotePeriod(r),
+				}))
+			},
+		),
+	}
+}
@@ -0,0 +1,33 @@
+package simulation
+
+import (
+	"math/rand"
+
+	simappparams "github.com/okex/exchain/libs/cosmos-sdk/simapp/params"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	govtypes "github.com/okex/exchain/libs/cosmos-sdk/x/gov/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
+	"github.com/okex/exchain/x/gov/types"
+)
+
+// OpWeightSubmitTextProposal app params key for text proposal
+const OpWeightSubmitTextProposal = "op_weight_submit_text_proposal"
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents() []simulation.WeightedProposalContent {
+	return []simulation.WeightedProposalContent{
+		{
+			AppParamsKey:       OpWeightSubmitTextProposal,
+			DefaultWeight:      simappparams.DefaultWeightTextProposal,
+			ContentSimulatorFn: SimulateTextProposalContent,
+		},
+	}
+}
+
+// SimulateTextProposalContent returns a random text proposal content.
+func SimulateTextProposalContent(r *rand.Rand, _ sdk.Context, _ []simulation.Account) govtypes.Content {
+	return types.NewTextProposal(
+		simulation.RandStringOfLength(r, 140),
+		simulation.RandStringOfLength(r, 5000),
+	)
+}
@@ -1,12 +1,22 @@
 package types
 
 import (
+	"reflect"
+	"sort"
+
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 )
 
 // module codec
 var ModuleCdc = codec.New()
 
+// proposalContents keeps the registered proposal content types by their amino
+// names, so that the proposals of any type can be drafted.
+var proposalContents = map[string]reflect.Type{
+	"okexchain/gov/TextProposal":            reflect.TypeOf(TextProposal{}),
+	"okexchain/gov/SoftwareUpgradeProposal": reflect.TypeOf(SoftwareUpgradeProposal{}),
+}
+
 // RegisterCodec registers all the necessary types and interfaces for
 // governance.
 func RegisterCodec(cdc *codec.Codec) {
@@ -25,6 +35,31 @@ func RegisterCodec(cdc *codec.Codec) {
 // to be correctly Amino encoded and decoded.
 func RegisterProposalTypeCodec(o interface{}, name string) {
 	ModuleCdc.RegisterConcrete(o, name, nil)
+	proposalContents[name] = reflect.TypeOf(o)
+}
+
+// RegisteredProposalContents returns the sorted amino names of the registered
+// proposal content types.
+func RegisteredProposalContents() []string {
+	names := make([]string, 0, len(proposalContents))
+	for name := range proposalContents {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewProposalContent returns a pointer to a zero proposal content of the
+// registered type name, and false if the name isn't registered.
+func NewProposalContent(name string) (interface{}, bool) {
+	t, ok := proposalContents[name]
+	if !ok {
+		return nil, false
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return reflect.New(t).Interface(), true
 }
 
 // TODO determine a good place to seal this codec
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProposalContent(t *testing.T) {
+	names := RegisteredProposalContents()
+	require.Contains(t, names, "okexchain/gov/TextProposal")
+	require.Contains(t, names, "okexchain/gov/SoftwareUpgradeProposal")
+
+	content, ok := NewProposalContent("okexchain/gov/TextProposal")
+	require.True(t, ok)
+	require.Equal(t, &TextProposal{}, content)
+
+	_, ok = NewProposalContent("okexchain/gov/UnknownProposal")
+	require.False(t, ok)
+}
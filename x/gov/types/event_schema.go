@@ -0,0 +1,121 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// schemas of the typed events emitted by the gov module
+var (
+	attrSchemaProposalID = sdk.NewEventAttributeSchema(AttributeKeyProposalID, sdk.EventValueTypeUint,
+		"id of the proposal")
+	attrSchemaVotingPeriodStart = sdk.NewEventAttributeSchema(AttributeKeyVotingPeriodStart, sdk.EventValueTypeUint,
+		"id of the proposal entering the voting period")
+	attrSchemaProposalResult = sdk.NewEventAttributeSchema(AttributeKeyProposalResult, sdk.EventValueTypeString,
+		"result of the proposal: proposal_dropped, proposal_passed, proposal_rejected or proposal_failed")
+
+	EventSchemaSubmitProposal = sdk.NewEventSchema(ModuleName, EventTypeSubmitProposal,
+		"a proposal is submitted, or enters the voting period by its initial deposit",
+		attrSchemaProposalID.AsOptional(),
+		attrSchemaVotingPeriodStart.AsOptional(),
+	)
+	EventSchemaProposalDeposit = sdk.NewEventSchema(ModuleName, EventTypeProposalDeposit,
+		"a deposit is made on a proposal, or the proposal enters the voting period by the deposit",
+		sdk.NewEventAttributeSchema(sdk.AttributeKeyAmount, sdk.EventValueTypeCoins, "amount deposited").AsOptional(),
+		attrSchemaProposalID.AsOptional(),
+		attrSchemaVotingPeriodStart.AsOptional(),
+	)
+	EventSchemaProposalVote = sdk.NewEventSchema(ModuleName, EventTypeProposalVote,
+		"a vote is cast on a proposal",
+		sdk.NewEventAttributeSchema(AttributeKeyOption, sdk.EventValueTypeString, "option voted: Yes, Abstain, No or NoWithVeto"),
+		attrSchemaProposalID,
+	)
+	EventSchemaProposalVoteTally = sdk.NewEventSchema(ModuleName, EventTypeProposalVoteTally,
+		"a vote ends the voting period of a proposal before the voting end time",
+		attrSchemaProposalID,
+		attrSchemaProposalResult,
+		sdk.NewEventAttributeSchema(AttributeKeyProposalLog, sdk.EventValueTypeString, "log of the proposal result"),
+	)
+	EventSchemaInactiveProposal = sdk.NewEventSchema(ModuleName, EventTypeInactiveProposal,
+		"a proposal is dropped for not meeting the min deposit in the deposit period",
+		attrSchemaProposalID,
+		attrSchemaProposalResult,
+	)
+	EventSchemaActiveProposal = sdk.NewEventSchema(ModuleName, EventTypeActiveProposal,
+		"a proposal is tallied at the voting end time",
+		attrSchemaProposalID,
+		attrSchemaProposalResult,
+	)
+)
+
+func init() {
+	sdk.RegisterEventSchemas(
+		EventSchemaSubmitProposal,
+		EventSchemaProposalDeposit,
+		EventSchemaProposalVote,
+		EventSchemaProposalVoteTally,
+		EventSchemaInactiveProposal,
+		EventSchemaActiveProposal,
+	)
+}
+
+func formatProposalID(proposalID uint64) string {
+	return strconv.FormatUint(proposalID, 10)
+}
+
+// NewSubmitProposalEvent creates the event of a proposal submitted
+func NewSubmitProposalEvent(proposalID uint64) sdk.Event {
+	return EventSchemaSubmitProposal.NewEvent(sdk.NewAttribute(AttributeKeyProposalID, formatProposalID(proposalID)))
+}
+
+// NewVotingPeriodStartEvent creates the event of a proposal entering the voting period by the deposit of the
+// submit_proposal or the proposal_deposit event type
+func NewVotingPeriodStartEvent(eventType string, proposalID uint64) sdk.Event {
+	schema := EventSchemaProposalDeposit
+	if eventType == EventTypeSubmitProposal {
+		schema = EventSchemaSubmitProposal
+	}
+	return schema.NewEvent(sdk.NewAttribute(AttributeKeyVotingPeriodStart, formatProposalID(proposalID)))
+}
+
+// NewProposalDepositEvent creates the event of a deposit made on a proposal
+func NewProposalDepositEvent(proposalID uint64, amount sdk.SysCoins) sdk.Event {
+	return EventSchemaProposalDeposit.NewEvent(
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(AttributeKeyProposalID, formatProposalID(proposalID)),
+	)
+}
+
+// NewProposalVoteEvent creates the event of a vote cast on a proposal
+func NewProposalVoteEvent(proposalID uint64, option VoteOption) sdk.Event {
+	return EventSchemaProposalVote.NewEvent(
+		sdk.NewAttribute(AttributeKeyOption, option.String()),
+		sdk.NewAttribute(AttributeKeyProposalID, formatProposalID(proposalID)),
+	)
+}
+
+// NewProposalVoteTallyEvent creates the event of a proposal tallied when a vote ends its voting period
+func NewProposalVoteTallyEvent(proposalID uint64, result, log string) sdk.Event {
+	return EventSchemaProposalVoteTally.NewEvent(
+		sdk.NewAttribute(AttributeKeyProposalID, formatProposalID(proposalID)),
+		sdk.NewAttribute(AttributeKeyProposalResult, result),
+		sdk.NewAttribute(AttributeKeyProposalLog, log),
+	)
+}
+
+// NewInactiveProposalEvent creates the event of a proposal dropped in the deposit period
+func NewInactiveProposalEvent(proposalID uint64) sdk.Event {
+	return EventSchemaInactiveProposal.NewEvent(
+		sdk.NewAttribute(AttributeKeyProposalID, formatProposalID(proposalID)),
+		sdk.NewAttribute(AttributeKeyProposalResult, AttributeValueProposalDropped),
+	)
+}
+
+// NewActiveProposalEvent creates the event of a proposal tallied at the voting end time
+func NewActiveProposalEvent(proposalID uint64, result string) sdk.Event {
+	return EventSchemaActiveProposal.NewEvent(
+		sdk.NewAttribute(AttributeKeyProposalID, formatProposalID(proposalID)),
+		sdk.NewAttribute(AttributeKeyProposalResult, result),
+	)
+}
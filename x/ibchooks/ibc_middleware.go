@@ -0,0 +1,163 @@
+package ibchooks
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/okex/exchain/libs/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/okex/exchain/libs/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/okex/exchain/libs/ibc-go/modules/core/04-channel/types"
+	porttypes "github.com/okex/exchain/libs/ibc-go/modules/core/05-port/types"
+	"github.com/okex/exchain/libs/ibc-go/modules/core/exported"
+	"github.com/okex/exchain/x/ibchooks/types"
+)
+
+var _ porttypes.Middleware = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks of the ibc hooks middleware. It
+// executes the wasm contract named in the memo of a received ICS-20 transfer
+// with the transferred funds.
+type IBCMiddleware struct {
+	app            porttypes.Middleware
+	contractKeeper types.ContractKeeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the contract keeper and underlying application
+func NewIBCMiddleware(app porttypes.Middleware, contractKeeper types.ContractKeeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:            app,
+		contractKeeper: contractKeeper,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID string, channelID string, chanCap *capabilitytypes.Capability, counterparty channeltypes.Counterparty, version string) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID, channelID string, chanCap *capabilitytypes.Capability, counterparty channeltypes.Counterparty, version, counterpartyVersion string) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyChannelID string, counterpartyVersion string) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. If the memo requests a
+// contract call the funds are credited to an account derived from the channel
+// and the original sender, which then executes the contract with them. A failing
+// execution results in an error acknowledgement, reverting the receive.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	metadata, isHook, err := types.ParseWasmMemo(data.Memo)
+	if !isHook {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgementV4(err)
+	}
+
+	contract, err := sdk.AccAddressFromBech32(metadata.Contract)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgementV4(sdkerrors.Wrapf(types.ErrInvalidWasmMemo, "invalid contract address: %s", err))
+	}
+	// the receiver has to be the contract as well, so that senders unaware of
+	// the hooks can not be tricked into calling a contract
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil || !receiver.Equals(contract) {
+		return channeltypes.NewErrorAcknowledgementV4(types.ErrInvalidReceiver)
+	}
+
+	intermediateSender := types.DeriveIntermediateSender(packet.GetDestChannel(), data.Sender)
+	data.Receiver = intermediateSender.String()
+	overridePacket := packet
+	overridePacket.Data = data.GetBytes()
+	ack := im.app.OnRecvPacket(ctx, overridePacket, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	funds, err := receivedFunds(packet, data)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgementV4(err)
+	}
+	result, err := im.contractKeeper.Execute(ctx, contract, intermediateSender, metadata.Msg, funds)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgementV4(sdkerrors.Wrap(types.ErrWasmHookExecuteFail, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWasmHook,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
+			sdk.NewAttribute(types.AttributeKeyIntermediateSender, intermediateSender.String()),
+			sdk.NewAttribute(types.AttributeKeyResult, string(result)),
+		),
+	)
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+// NegotiateAppVersion implements the IBCModule interface
+func (im IBCMiddleware) NegotiateAppVersion(ctx sdk.Context, order channeltypes.Order, connectionID string, portID string, counterparty channeltypes.Counterparty, proposedVersion string) (string, error) {
+	return im.app.NegotiateAppVersion(ctx, order, connectionID, portID, counterparty, proposedVersion)
+}
+
+// SendPacket implements the ICS4 Wrapper interface
+func (im IBCMiddleware) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	return im.app.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface
+func (im IBCMiddleware) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	return im.app.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion returns the application version of the underlying application
+func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.app.GetAppVersion(ctx, portID, channelID)
+}
+
+// receivedFunds returns the coins credited on this chain for the packet
+func receivedFunds(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) (sdk.Coins, error) {
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	denom := transfertypes.ReceivedDenom(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+	if denom == sdk.DefaultIbcWei {
+		denom = sdk.DefaultBondDenom
+	}
+	return sdk.NewCoins(sdk.NewCoin(denom, sdk.NewDecFromIntWithPrec(amount, sdk.Precision))), nil
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+var (
+	ErrInvalidWasmMemo     = sdkerrors.Register(ModuleName, 2, "invalid wasm hook memo")
+	ErrInvalidReceiver     = sdkerrors.Register(ModuleName, 3, "receiver must be the contract of the wasm hook")
+	ErrWasmHookExecuteFail = sdkerrors.Register(ModuleName, 4, "wasm hook contract execution failed")
+)
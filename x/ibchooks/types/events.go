@@ -0,0 +1,9 @@
+package types
+
+const (
+	EventTypeWasmHook = "ibc_wasm_hook"
+
+	AttributeKeyContract           = "contract"
+	AttributeKeyIntermediateSender = "intermediate_sender"
+	AttributeKeyResult             = "result"
+)
@@ -0,0 +1,9 @@
+package types
+
+const (
+	ModuleName = "ibchooks"
+
+	// SenderPrefix is mixed into the derivation of the intermediate sender
+	// which executes the contract on behalf of a counterparty chain account
+	SenderPrefix = "ibc-wasm-hook-intermediary"
+)
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	"github.com/okex/exchain/libs/tendermint/crypto"
+)
+
+// wasmMemoKey is the memo key holding the wasm hook instruction
+const wasmMemoKey = "wasm"
+
+// WasmHookMetadata is the contract call requested in the memo of an ICS-20
+// transfer, e.g.
+//
+//	{"wasm": {"contract": "ex1...", "msg": {"do_something": {}}}}
+type WasmHookMetadata struct {
+	Contract string          `json:"contract"`
+	Msg      json.RawMessage `json:"msg"`
+}
+
+// ParseWasmMemo extracts the wasm hook instruction from a transfer memo.
+// The boolean result is false if the memo does not request a contract call.
+func ParseWasmMemo(memo string) (WasmHookMetadata, bool, error) {
+	if !strings.HasPrefix(strings.TrimSpace(memo), "{") {
+		return WasmHookMetadata{}, false, nil
+	}
+
+	var memoObj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &memoObj); err != nil {
+		return WasmHookMetadata{}, false, nil
+	}
+	raw, ok := memoObj[wasmMemoKey]
+	if !ok {
+		return WasmHookMetadata{}, false, nil
+	}
+
+	var metadata WasmHookMetadata
+	if err := json.Unmarshal(raw, &metadata); err != nil {
+		return WasmHookMetadata{}, true, sdkerrors.Wrap(ErrInvalidWasmMemo, err.Error())
+	}
+	return metadata, true, metadata.Validate()
+}
+
+// Validate performs a stateless check of the wasm hook metadata
+func (m WasmHookMetadata) Validate() error {
+	if strings.TrimSpace(m.Contract) == "" {
+		return sdkerrors.Wrap(ErrInvalidWasmMemo, "contract cannot be blank")
+	}
+	var msg map[string]json.RawMessage
+	if err := json.Unmarshal(m.Msg, &msg); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidWasmMemo, "msg must be a json object: %s", err)
+	}
+	return nil
+}
+
+// DeriveIntermediateSender returns the local account executing the contract on
+// behalf of originalSender on the counterparty chain connected through channel.
+// The derivation makes sure a counterparty account can not impersonate a
+// local account.
+func DeriveIntermediateSender(channel, originalSender string) sdk.AccAddress {
+	preImage := fmt.Sprintf("%s/%s/%s", SenderPrefix, channel, originalSender)
+	return sdk.AccAddress(crypto.AddressHash([]byte(preImage)))
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWasmMemo(t *testing.T) {
+	testCases := []struct {
+		name     string
+		memo     string
+		expHook  bool
+		expPass  bool
+		contract string
+	}{
+		{"empty memo", "", false, true, ""},
+		{"plain text memo", "hello", false, true, ""},
+		{"json memo without wasm key", `{"forward":{}}`, false, true, ""},
+		{"valid hook", `{"wasm":{"contract":"ex1contract","msg":{"ping":{}}}}`, true, true, "ex1contract"},
+		{"missing contract", `{"wasm":{"msg":{"ping":{}}}}`, true, false, ""},
+		{"msg is not an object", `{"wasm":{"contract":"ex1contract","msg":"ping"}}`, true, false, ""},
+		{"hook is not an object", `{"wasm":"ex1contract"}`, true, false, ""},
+	}
+
+	for _, tc := range testCases {
+		metadata, isHook, err := ParseWasmMemo(tc.memo)
+		require.Equal(t, tc.expHook, isHook, tc.name)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.contract, metadata.Contract, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestDeriveIntermediateSender(t *testing.T) {
+	sender := DeriveIntermediateSender("channel-0", "cosmos1sender")
+	require.Len(t, sender, 20)
+	require.Equal(t, sender, DeriveIntermediateSender("channel-0", "cosmos1sender"))
+	require.NotEqual(t, sender, DeriveIntermediateSender("channel-1", "cosmos1sender"))
+	require.NotEqual(t, sender, DeriveIntermediateSender("channel-0", "cosmos1other"))
+}
@@ -0,0 +1,29 @@
+package oracle
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/oracle/keeper"
+)
+
+// EndBlocker tallies the votes at the last block of every vote period, and punishes the validators missing too many
+// vote periods at the last block of every slash window
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	if !isPeriodLastBlock(ctx, params.VotePeriod) {
+		return
+	}
+	k.TallyVotes(ctx, params)
+
+	if isPeriodLastBlock(ctx, params.SlashWindow) {
+		k.PunishMissingValidators(ctx, params)
+	}
+}
+
+func isPeriodLastBlock(ctx sdk.Context, period uint64) bool {
+	return uint64(ctx.BlockHeight()+1)%period == 0
+}
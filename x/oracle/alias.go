@@ -0,0 +1,21 @@
+package oracle
+
+import (
+	"github.com/okex/exchain/x/oracle/keeper"
+	"github.com/okex/exchain/x/oracle/types"
+)
+
+const (
+	ModuleName   = types.ModuleName
+	StoreKey     = types.StoreKey
+	RouterKey    = types.RouterKey
+	QuerierRoute = types.QuerierRoute
+)
+
+var (
+	NewKeeper = keeper.NewKeeper
+)
+
+type (
+	Keeper = keeper.Keeper
+)
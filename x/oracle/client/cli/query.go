@@ -0,0 +1,207 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/x/oracle/types"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	// Group oracle queries under a subcommand
+	oracleQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	oracleQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryExchangeRate(queryRoute, cdc),
+			GetCmdQueryExchangeRates(queryRoute, cdc),
+			GetCmdQueryVotes(queryRoute, cdc),
+			GetCmdQueryMissCounter(queryRoute, cdc),
+			GetCmdQueryParams(queryRoute, cdc),
+		)...,
+	)
+
+	return oracleQueryCmd
+}
+
+// GetCmdQueryExchangeRate gets the exchange rate query command.
+func GetCmdQueryExchangeRate(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exchange-rate [denom]",
+		Short: "query the exchange rate of the native token in a denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the exchange rate of the native token in a denom aggregated in the last vote period.
+
+Example:
+$ %s query oracle exchange-rate usdt
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryExchangeRateParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryExchangeRate)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var exchangeRate sdk.Dec
+			cdc.MustUnmarshalJSON(resp, &exchangeRate)
+			return cliCtx.PrintOutput(exchangeRate)
+		},
+	}
+}
+
+// GetCmdQueryExchangeRates gets the exchange rates query command.
+func GetCmdQueryExchangeRates(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "exchange-rates",
+		Short: "query the exchange rates of the native token in all the denoms",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the exchange rates of the native token in all the denoms aggregated in the last vote period.
+
+Example:
+$ %s query oracle exchange-rates
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryExchangeRates)
+			resp, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var exchangeRates sdk.DecCoins
+			cdc.MustUnmarshalJSON(resp, &exchangeRates)
+			return cliCtx.PrintOutput(exchangeRates)
+		},
+	}
+}
+
+// GetCmdQueryVotes gets the votes query command.
+func GetCmdQueryVotes(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "votes",
+		Short: "query the votes in the current vote period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the exchange rates voted by the validators in the current vote period.
+
+Example:
+$ %s query oracle votes
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVotes)
+			resp, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var votes types.ExchangeRateVotes
+			cdc.MustUnmarshalJSON(resp, &votes)
+			return cliCtx.PrintOutput(votes)
+		},
+	}
+}
+
+// GetCmdQueryMissCounter gets the miss counter query command.
+func GetCmdQueryMissCounter(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "miss-counter [validator]",
+		Short: "query the vote periods a validator missed in the current slash window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of vote periods a validator missed in the current slash window.
+
+Example:
+$ %s query oracle miss-counter exvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryMissCounter)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var missCounter types.MissCounter
+			cdc.MustUnmarshalJSON(resp, &missCounter)
+			return cliCtx.PrintOutput(missCounter)
+		},
+	}
+}
+
+// GetCmdQueryParams gets the oracle params query command.
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "query the current oracle parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as oracle parameters.
+
+Example:
+$ %s query oracle params
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParameters)
+			resp, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(resp, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
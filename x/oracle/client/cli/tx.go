@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	"github.com/okex/exchain/x/oracle/types"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	oracleTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		SuggestionsMinimumDistance: 2,
+	}
+
+	oracleTxCmd.AddCommand(client.PostCommands(
+		GetCmdExchangeRateVote(cdc),
+	)...)
+	return oracleTxCmd
+}
+
+// GetCmdExchangeRateVote gets the exchange rate vote command
+func GetCmdExchangeRateVote(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [exchange-rates]",
+		Short: "vote the exchange rates of the native token in the whitelisted denoms",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote the exchange rates of the native token in the whitelisted denoms for the current vote period.
+The vote must be signed by the operator of a bonded validator, and a later vote in the same vote period
+replaces the former one.
+
+Example:
+$ %s tx oracle vote 18.25usdt,0.00062btc --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			exchangeRates, err := sdk.ParseDecCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			feeder := cliCtx.GetFromAddress()
+			msg := types.NewMsgExchangeRateVote(exchangeRates, feeder, sdk.ValAddress(feeder))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
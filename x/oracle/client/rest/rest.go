@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/rest"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/oracle/types"
+)
+
+// RegisterRoutes registers oracle-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// get the exchange rate of a denom
+	r.HandleFunc(
+		"/oracle/exchange_rate/{denom}",
+		queryExchangeRateHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get the exchange rates of all the denoms
+	r.HandleFunc(
+		"/oracle/exchange_rates",
+		queryHandlerFn(cliCtx, types.QueryExchangeRates),
+	).Methods("GET")
+
+	// get the votes in the current vote period
+	r.HandleFunc(
+		"/oracle/votes",
+		queryHandlerFn(cliCtx, types.QueryVotes),
+	).Methods("GET")
+
+	// get the miss counter of a validator
+	r.HandleFunc(
+		"/oracle/miss_counter/{validator}",
+		queryMissCounterHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get the current oracle parameter values
+	r.HandleFunc(
+		"/oracle/parameters",
+		queryHandlerFn(cliCtx, types.QueryParameters),
+	).Methods("GET")
+}
+
+func queryExchangeRateHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		jsonBytes, err := cliCtx.Codec.MarshalJSON(types.NewQueryExchangeRateParams(mux.Vars(r)["denom"]))
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorCodecFails)
+			return
+		}
+
+		queryWithData(w, cliCtx, types.QueryExchangeRate, jsonBytes)
+	}
+}
+
+func queryMissCounterHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validator"])
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeCreateAddrFromBech32Failed, err.Error())
+			return
+		}
+
+		jsonBytes, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(valAddr))
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorCodecFails)
+			return
+		}
+
+		queryWithData(w, cliCtx, types.QueryMissCounter, jsonBytes)
+	}
+}
+
+func queryHandlerFn(cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		queryWithData(w, cliCtx, endpoint, nil)
+	}
+}
+
+func queryWithData(w http.ResponseWriter, cliCtx context.CLIContext, endpoint string, data []byte) {
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, endpoint)
+	res, height, err := cliCtx.QueryWithData(route, data)
+	if err != nil {
+		common.HandleErrorResponseV2(w, http.StatusInternalServerError, common.ErrorABCIQueryFails)
+		return
+	}
+
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, res)
+}
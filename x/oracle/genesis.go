@@ -0,0 +1,24 @@
+package oracle
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/oracle/keeper"
+	"github.com/okex/exchain/x/oracle/types"
+)
+
+// InitGenesis initializes the oracle module's state from a given genesis state
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	k.UpdateExchangeRates(ctx, data.ExchangeRates)
+	for _, vote := range data.Votes {
+		k.SetVote(ctx, vote)
+	}
+	for _, missCounter := range data.MissCounters {
+		k.SetMissCounter(ctx, missCounter.Validator, missCounter.Count)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetExchangeRates(ctx), k.GetVotes(ctx), k.GetMissCounters(ctx))
+}
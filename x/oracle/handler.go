@@ -0,0 +1,68 @@
+package oracle
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/oracle/keeper"
+	"github.com/okex/exchain/x/oracle/types"
+)
+
+// NewHandler creates an sdk.Handler for all the oracle type messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx.SetEventManager(sdk.NewEventManager())
+
+		if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+			return nil, types.ErrOracleNotSupported(ctx.BlockHeight())
+		}
+
+		switch msg := msg.(type) {
+		case types.MsgExchangeRateVote:
+			return handleMsgExchangeRateVote(ctx, k, msg)
+		default:
+			return nil, types.ErrUnknownOracleMsgType(fmt.Sprintf("%T", msg))
+		}
+	}
+}
+
+func handleMsgExchangeRateVote(ctx sdk.Context, k keeper.Keeper, msg types.MsgExchangeRateVote) (*sdk.Result, error) {
+	// 1. only the operator of a bonded validator can vote
+	validator := k.StakingKeeper().Validator(ctx, msg.Validator)
+	if validator == nil {
+		return nil, types.ErrNoValidatorFound(msg.Validator.String())
+	}
+	if !validator.IsBonded() {
+		return nil, types.ErrValidatorNotBonded(msg.Validator.String())
+	}
+	if !msg.Feeder.Equals(sdk.AccAddress(msg.Validator)) {
+		return nil, types.ErrInvalidFeeder(msg.Feeder.String(), msg.Validator.String())
+	}
+
+	// 2. all the denoms voted must be whitelisted
+	params := k.GetParams(ctx)
+	for _, exchangeRate := range msg.ExchangeRates {
+		if !params.IsWhitelisted(exchangeRate.Denom) {
+			return nil, types.ErrDenomNotWhitelisted(exchangeRate.Denom)
+		}
+	}
+
+	// 3. replace the former vote in the current vote period
+	k.SetVote(ctx, types.NewExchangeRateVote(msg.ExchangeRates, msg.Validator))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeExchangeRateVote,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Validator.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeder, msg.Feeder.String()),
+			sdk.NewAttribute(types.AttributeKeyExchangeRates, msg.ExchangeRates.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Feeder.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
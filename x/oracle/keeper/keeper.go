@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/x/oracle/types"
+	"github.com/okex/exchain/x/params"
+)
+
+// Keeper of the oracle module maintains the exchange rates voted by validators
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	paramSpace types.Subspace
+
+	stakingKeeper types.StakingKeeper
+	evmKeeper     types.EvmKeeper
+}
+
+// NewKeeper creates new instances of the oracle Keeper
+func NewKeeper(
+	storeKey sdk.StoreKey,
+	cdc *codec.Codec,
+	ps params.Subspace,
+	sk types.StakingKeeper,
+	ek types.EvmKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		paramSpace:    ps,
+		stakingKeeper: sk,
+		evmKeeper:     ek,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// StakingKeeper returns the staking keeper
+func (k Keeper) StakingKeeper() types.StakingKeeper {
+	return k.stakingKeeper
+}
+
+// GetParams returns the total set of oracle parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
+
+// SetParams sets the oracle parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetExchangeRate gets the aggregated exchange rate of the native token in a denom
+func (k Keeper) GetExchangeRate(ctx sdk.Context, denom string) (exchangeRate sdk.Dec, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetExchangeRateKey(denom))
+	if bz == nil {
+		return sdk.ZeroDec(), false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &exchangeRate)
+	return exchangeRate, true
+}
+
+// SetExchangeRate sets the aggregated exchange rate of a denom into store
+func (k Keeper) SetExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
+	ctx.KVStore(k.storeKey).Set(types.GetExchangeRateKey(denom), k.cdc.MustMarshalBinaryLengthPrefixed(exchangeRate))
+}
+
+// DeleteExchangeRate deletes the aggregated exchange rate of a denom from store
+func (k Keeper) DeleteExchangeRate(ctx sdk.Context, denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetExchangeRateKey(denom))
+}
+
+// IterateExchangeRates iterates over the aggregated exchange rates ordered by denom
+func (k Keeper) IterateExchangeRates(ctx sdk.Context, handler func(denom string, exchangeRate sdk.Dec) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ExchangeRatePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var exchangeRate sdk.Dec
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &exchangeRate)
+		if handler(string(iterator.Key()[len(types.ExchangeRatePrefix):]), exchangeRate) {
+			break
+		}
+	}
+}
+
+// GetExchangeRates gets all the aggregated exchange rates
+func (k Keeper) GetExchangeRates(ctx sdk.Context) (exchangeRates sdk.DecCoins) {
+	k.IterateExchangeRates(ctx, func(denom string, exchangeRate sdk.Dec) bool {
+		exchangeRates = append(exchangeRates, sdk.NewDecCoinFromDec(denom, exchangeRate))
+		return false
+	})
+	return
+}
+
+// GetVote gets the vote of a validator in the current vote period
+func (k Keeper) GetVote(ctx sdk.Context, valAddr sdk.ValAddress) (vote types.ExchangeRateVote, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetVoteKey(valAddr))
+	if bz == nil {
+		return vote, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &vote)
+	return vote, true
+}
+
+// SetVote sets the vote of a validator into store, replacing its former vote in the current vote period
+func (k Keeper) SetVote(ctx sdk.Context, vote types.ExchangeRateVote) {
+	ctx.KVStore(k.storeKey).Set(types.GetVoteKey(vote.Validator), k.cdc.MustMarshalBinaryLengthPrefixed(vote))
+}
+
+// DeleteVote deletes the vote of a validator from store
+func (k Keeper) DeleteVote(ctx sdk.Context, valAddr sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetVoteKey(valAddr))
+}
+
+// IterateVotes iterates over the votes in the current vote period ordered by validator
+func (k Keeper) IterateVotes(ctx sdk.Context, handler func(vote types.ExchangeRateVote) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VotePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.ExchangeRateVote
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &vote)
+		if handler(vote) {
+			break
+		}
+	}
+}
+
+// GetVotes gets all the votes in the current vote period
+func (k Keeper) GetVotes(ctx sdk.Context) (votes types.ExchangeRateVotes) {
+	k.IterateVotes(ctx, func(vote types.ExchangeRateVote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+	return
+}
+
+// GetMissCounter gets the number of vote periods a validator missed in the current slash window
+func (k Keeper) GetMissCounter(ctx sdk.Context, valAddr sdk.ValAddress) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetMissCounterKey(valAddr))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetMissCounter sets the number of vote periods a validator missed in the current slash window
+func (k Keeper) SetMissCounter(ctx sdk.Context, valAddr sdk.ValAddress, count uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetMissCounterKey(valAddr), sdk.Uint64ToBigEndian(count))
+}
+
+// DeleteMissCounter deletes the miss counter of a validator from store
+func (k Keeper) DeleteMissCounter(ctx sdk.Context, valAddr sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetMissCounterKey(valAddr))
+}
+
+// IterateMissCounters iterates over the miss counters ordered by validator
+func (k Keeper) IterateMissCounters(ctx sdk.Context, handler func(missCounter types.MissCounter) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.MissCounterPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := sdk.ValAddress(iterator.Key()[len(types.MissCounterPrefix):])
+		if handler(types.NewMissCounter(valAddr, binary.BigEndian.Uint64(iterator.Value()))) {
+			break
+		}
+	}
+}
+
+// GetMissCounters gets the miss counters of all the validators
+func (k Keeper) GetMissCounters(ctx sdk.Context) (missCounters []types.MissCounter) {
+	k.IterateMissCounters(ctx, func(missCounter types.MissCounter) bool {
+		missCounters = append(missCounters, missCounter)
+		return false
+	})
+	return
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/oracle/types"
+)
+
+// NewQuerier creates a new querier for oracle clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryExchangeRate:
+			return queryExchangeRate(ctx, req, k)
+		case types.QueryExchangeRates:
+			return queryExchangeRates(ctx, k)
+		case types.QueryVotes:
+			return queryVotes(ctx, k)
+		case types.QueryMissCounter:
+			return queryMissCounter(ctx, req, k)
+		case types.QueryParameters:
+			return queryParams(ctx, k)
+		default:
+			return nil, types.ErrUnknownOracleQueryType(path[0])
+		}
+	}
+}
+
+func queryExchangeRate(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryExchangeRateParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	exchangeRate, found := k.GetExchangeRate(ctx, params.Denom)
+	if !found {
+		return nil, types.ErrNoExchangeRateFound(params.Denom)
+	}
+	return marshalJSON(exchangeRate)
+}
+
+func queryExchangeRates(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	exchangeRates := k.GetExchangeRates(ctx)
+	if exchangeRates == nil {
+		exchangeRates = sdk.DecCoins{}
+	}
+	return marshalJSON(exchangeRates)
+}
+
+func queryVotes(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	votes := k.GetVotes(ctx)
+	if votes == nil {
+		votes = types.ExchangeRateVotes{}
+	}
+	return marshalJSON(votes)
+}
+
+func queryMissCounter(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+	return marshalJSON(types.NewMissCounter(params.Validator, k.GetMissCounter(ctx, params.Validator)))
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	return marshalJSON(k.GetParams(ctx))
+}
+
+func marshalJSON(o interface{}) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, o)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"fmt"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/okex/exchain/x/oracle/types"
+	stakingexported "github.com/okex/exchain/x/staking/exported"
+)
+
+// GetBondedValidators gets the bonded validators ordered by power
+func (k Keeper) GetBondedValidators(ctx sdk.Context) (validators []stakingexported.ValidatorI) {
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingexported.ValidatorI) bool {
+		validators = append(validators, validator)
+		return false
+	})
+	return
+}
+
+// TallyVotes aggregates the votes of the bonded validators in the current vote period. The exchange rate of a
+// whitelisted denom is the weighted median of its ballot if the voters hold at least VoteThreshold of the bonded
+// power, otherwise it's removed rather than left stale. A validator missing any whitelisted denom in its vote has
+// its miss counter increased. All the votes are cleared afterwards
+func (k Keeper) TallyVotes(ctx sdk.Context, params types.Params) sdk.DecCoins {
+	validators := k.GetBondedValidators(ctx)
+	powers := make(map[string]int64, len(validators))
+	var totalPower int64
+	for _, validator := range validators {
+		powers[validator.GetOperator().String()] = validator.GetConsensusPower()
+		totalPower += validator.GetConsensusPower()
+	}
+
+	// 1. organize the votes of the bonded validators into ballots by denom
+	ballots := make(map[string]types.Ballot, len(params.Whitelist))
+	voted := make(map[string]sdk.DecCoins, len(validators))
+	var voters []sdk.ValAddress
+	k.IterateVotes(ctx, func(vote types.ExchangeRateVote) bool {
+		voters = append(voters, vote.Validator)
+		power, found := powers[vote.Validator.String()]
+		if !found {
+			return false
+		}
+		for _, exchangeRate := range vote.ExchangeRates {
+			if params.IsWhitelisted(exchangeRate.Denom) {
+				ballots[exchangeRate.Denom] = append(ballots[exchangeRate.Denom],
+					types.NewBallotVote(exchangeRate.Amount, vote.Validator, power))
+			}
+		}
+		voted[vote.Validator.String()] = vote.ExchangeRates
+		return false
+	})
+	for _, voter := range voters {
+		k.DeleteVote(ctx, voter)
+	}
+
+	// 2. aggregate the ballots passing the threshold
+	threshold := params.VoteThreshold.MulInt64(totalPower)
+	exchangeRates := sdk.DecCoins{}
+	for _, denom := range params.Whitelist {
+		ballot := ballots[denom]
+		if ballot.Power() == 0 || sdk.NewDec(ballot.Power()).LT(threshold) {
+			continue
+		}
+		exchangeRates = append(exchangeRates, sdk.NewDecCoinFromDec(denom, ballot.WeightedMedian()))
+	}
+	exchangeRates = exchangeRates.Sort()
+	k.UpdateExchangeRates(ctx, exchangeRates)
+
+	// 3. count the validators missing any whitelisted denom
+	for _, validator := range validators {
+		rates := voted[validator.GetOperator().String()]
+		for _, denom := range params.Whitelist {
+			if !rates.AmountOf(denom).IsPositive() {
+				k.SetMissCounter(ctx, validator.GetOperator(), k.GetMissCounter(ctx, validator.GetOperator())+1)
+				break
+			}
+		}
+	}
+	return exchangeRates
+}
+
+// PunishMissingValidators jails the bonded validators who voted in less than MinValidPerWindow of the vote periods
+// in the slash window, the same way as the slashing module does for downtime, then resets all the miss counters
+func (k Keeper) PunishMissingValidators(ctx sdk.Context, params types.Params) {
+	votePeriods := params.SlashWindow / params.VotePeriod
+	minValidPeriods := params.MinValidPerWindow.MulInt64(int64(votePeriods))
+	for _, validator := range k.GetBondedValidators(ctx) {
+		missCount := k.GetMissCounter(ctx, validator.GetOperator())
+		if missCount > votePeriods {
+			missCount = votePeriods
+		}
+		if validator.IsJailed() || !sdk.NewDec(int64(votePeriods-missCount)).LT(minValidPeriods) {
+			continue
+		}
+
+		consAddr := validator.GetConsAddr()
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeOracleSlash,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.GetOperator().String()),
+			sdk.NewAttribute(types.AttributeKeyMissCount, fmt.Sprintf("%d", missCount)),
+			sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+		))
+		// don't slash tokens, just jail the validator
+		k.stakingKeeper.Jail(ctx, consAddr)
+		k.stakingKeeper.AppendAbandonedValidatorAddrs(ctx, consAddr)
+		k.Logger(ctx).Info(fmt.Sprintf("validator %s jailed for missing %d of %d oracle vote periods",
+			validator.GetOperator(), missCount, votePeriods))
+	}
+
+	for _, missCounter := range k.GetMissCounters(ctx) {
+		k.DeleteMissCounter(ctx, missCounter.Validator)
+	}
+}
+
+// UpdateExchangeRates replaces all the aggregated exchange rates and mirrors them into the feed contract
+func (k Keeper) UpdateExchangeRates(ctx sdk.Context, exchangeRates sdk.DecCoins) {
+	removed := k.GetExchangeRates(ctx)
+	for _, exchangeRate := range removed {
+		k.DeleteExchangeRate(ctx, exchangeRate.Denom)
+	}
+	for _, exchangeRate := range exchangeRates {
+		k.SetExchangeRate(ctx, exchangeRate.Denom, exchangeRate.Amount)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeExchangeRateUpdate,
+			sdk.NewAttribute(types.AttributeKeyDenom, exchangeRate.Denom),
+			sdk.NewAttribute(types.AttributeKeyExchangeRate, exchangeRate.Amount.String()),
+		))
+	}
+	k.syncFeedContract(ctx, removed, exchangeRates)
+}
+
+// syncFeedContract writes the exchange rates into the storage of the feed contract, so that EVM contracts can read them
+func (k Keeper) syncFeedContract(ctx sdk.Context, removed, exchangeRates sdk.DecCoins) {
+	if k.evmKeeper == nil {
+		return
+	}
+
+	csdb := evmtypes.CreateEmptyCommitStateDB(k.evmKeeper.GenerateCSDBParams(), ctx)
+	if len(csdb.GetCode(types.FeedContractAddress)) == 0 {
+		csdb.SetCode(types.FeedContractAddress, types.FeedContractCode)
+	}
+	for _, exchangeRate := range removed {
+		csdb.SetState(types.FeedContractAddress, types.GetFeedSlot(exchangeRate.Denom), ethcmn.Hash{})
+	}
+	for _, exchangeRate := range exchangeRates {
+		csdb.SetState(types.FeedContractAddress, types.GetFeedSlot(exchangeRate.Denom), types.GetFeedValue(exchangeRate.Amount))
+	}
+	if _, err := csdb.Commit(false); err != nil {
+		k.Logger(ctx).Error("failed to write the exchange rates into the feed contract", "error", err)
+	}
+}
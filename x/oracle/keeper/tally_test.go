@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/store"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	dbm "github.com/okex/exchain/libs/tm-db"
+	"github.com/okex/exchain/x/oracle/types"
+	"github.com/okex/exchain/x/params"
+	stakingexported "github.com/okex/exchain/x/staking/exported"
+	"github.com/stretchr/testify/require"
+)
+
+type mockValidator struct {
+	stakingexported.ValidatorI
+	operator sdk.ValAddress
+	power    int64
+	jailed   bool
+}
+
+func (v *mockValidator) GetOperator() sdk.ValAddress  { return v.operator }
+func (v *mockValidator) GetConsAddr() sdk.ConsAddress { return sdk.ConsAddress(v.operator) }
+func (v *mockValidator) GetConsensusPower() int64     { return v.power }
+func (v *mockValidator) IsJailed() bool               { return v.jailed }
+func (v *mockValidator) IsBonded() bool               { return true }
+
+type mockStakingKeeper struct {
+	validators []*mockValidator
+	abandoned  []sdk.ConsAddress
+}
+
+func (sk *mockStakingKeeper) Validator(_ sdk.Context, address sdk.ValAddress) stakingexported.ValidatorI {
+	for _, validator := range sk.validators {
+		if validator.operator.Equals(address) {
+			return validator
+		}
+	}
+	return nil
+}
+
+func (sk *mockStakingKeeper) IterateBondedValidatorsByPower(_ sdk.Context,
+	fn func(index int64, validator stakingexported.ValidatorI) (stop bool)) {
+	for i, validator := range sk.validators {
+		if fn(int64(i), validator) {
+			break
+		}
+	}
+}
+
+func (sk *mockStakingKeeper) Jail(_ sdk.Context, consAddr sdk.ConsAddress) {
+	for _, validator := range sk.validators {
+		if validator.GetConsAddr().Equals(consAddr) {
+			validator.jailed = true
+		}
+	}
+}
+
+func (sk *mockStakingKeeper) AppendAbandonedValidatorAddrs(_ sdk.Context, consAddr sdk.ConsAddress) {
+	sk.abandoned = append(sk.abandoned, consAddr)
+}
+
+func createTestInput(t *testing.T, powers ...int64) (sdk.Context, Keeper, *mockStakingKeeper) {
+	keyOracle := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.Nil(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid"}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+
+	sk := &mockStakingKeeper{}
+	for i, power := range powers {
+		sk.validators = append(sk.validators, &mockValidator{
+			operator: sdk.ValAddress([]byte{byte(i + 1)}),
+			power:    power,
+		})
+	}
+
+	k := NewKeeper(keyOracle, cdc, pk.Subspace(types.ModuleName), sk, nil)
+	params := types.DefaultParams()
+	params.Whitelist = []string{"btc", "usdt"}
+	k.SetParams(ctx, params)
+	return ctx, k, sk
+}
+
+func TestTallyVotes(t *testing.T) {
+	ctx, k, sk := createTestInput(t, 10, 20, 30, 40)
+	params := k.GetParams(ctx)
+
+	// usdt is voted by the validators with 90% of the power, btc by only 30%
+	k.SetVote(ctx, types.NewExchangeRateVote(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("usdt", sdk.NewDec(17)),
+	), sk.validators[0].operator))
+	k.SetVote(ctx, types.NewExchangeRateVote(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("usdt", sdk.NewDec(18)),
+	), sk.validators[1].operator))
+	k.SetVote(ctx, types.NewExchangeRateVote(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("btc", sdk.NewDecWithPrec(6, 4)),
+		sdk.NewDecCoinFromDec("usdt", sdk.NewDec(19)),
+	), sk.validators[2].operator))
+
+	exchangeRates := k.TallyVotes(ctx, params)
+	require.Equal(t, 1, len(exchangeRates))
+	rate, found := k.GetExchangeRate(ctx, "usdt")
+	require.True(t, found)
+	require.True(t, sdk.NewDec(18).Equal(rate))
+	_, found = k.GetExchangeRate(ctx, "btc")
+	require.False(t, found)
+
+	// the votes are cleared, the validators missing any whitelisted denom are counted
+	require.Equal(t, 0, len(k.GetVotes(ctx)))
+	require.Equal(t, uint64(1), k.GetMissCounter(ctx, sk.validators[0].operator))
+	require.Equal(t, uint64(1), k.GetMissCounter(ctx, sk.validators[1].operator))
+	require.Equal(t, uint64(0), k.GetMissCounter(ctx, sk.validators[2].operator))
+	require.Equal(t, uint64(1), k.GetMissCounter(ctx, sk.validators[3].operator))
+
+	// the exchange rate without enough votes in the next vote period is removed instead of being left stale
+	k.TallyVotes(ctx, params)
+	require.Equal(t, 0, len(k.GetExchangeRates(ctx)))
+	require.Equal(t, uint64(1), k.GetMissCounter(ctx, sk.validators[2].operator))
+}
+
+func TestPunishMissingValidators(t *testing.T) {
+	ctx, k, sk := createTestInput(t, 10, 20)
+	params := k.GetParams(ctx)
+	votePeriods := params.SlashWindow / params.VotePeriod
+
+	// the first validator voted in 5% of the vote periods which is just enough, the second one in less
+	k.SetMissCounter(ctx, sk.validators[0].operator, votePeriods*95/100)
+	k.SetMissCounter(ctx, sk.validators[1].operator, votePeriods*95/100+1)
+
+	k.PunishMissingValidators(ctx, params)
+	require.False(t, sk.validators[0].jailed)
+	require.True(t, sk.validators[1].jailed)
+	require.Equal(t, []sdk.ConsAddress{sk.validators[1].GetConsAddr()}, sk.abandoned)
+	require.Equal(t, 0, len(k.GetMissCounters(ctx)))
+}
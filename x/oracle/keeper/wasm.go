@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/oracle/types"
+)
+
+// NewWasmQuerier returns the querier of the oracle custom plugin of wasm contracts reading the exchange rates
+func NewWasmQuerier(k Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query types.OracleQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: err.Error(), Request: request}
+		}
+
+		switch {
+		case query.ExchangeRate != nil:
+			denom := query.ExchangeRate.Denom
+			exchangeRate, found := k.GetExchangeRate(ctx, denom)
+			if !found {
+				return nil, types.ErrNoExchangeRateFound(denom)
+			}
+			return json.Marshal(types.ExchangeRateResponse{Denom: denom, ExchangeRate: exchangeRate})
+		case query.ExchangeRates != nil:
+			res := types.ExchangeRatesResponse{ExchangeRates: []types.ExchangeRateResponse{}}
+			k.IterateExchangeRates(ctx, func(denom string, exchangeRate sdk.Dec) bool {
+				res.ExchangeRates = append(res.ExchangeRates, types.ExchangeRateResponse{Denom: denom, ExchangeRate: exchangeRate})
+				return false
+			})
+			return json.Marshal(res)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown oracle query variant"}
+		}
+	}
+}
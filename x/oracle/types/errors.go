@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	DefaultCodespace string = ModuleName
+
+	CodeInvalidAddress         uint32 = 70000
+	CodeInvalidExchangeRates   uint32 = 70001
+	CodeDenomNotWhitelisted    uint32 = 70002
+	CodeNoValidatorFound       uint32 = 70003
+	CodeValidatorNotBonded     uint32 = 70004
+	CodeInvalidFeeder          uint32 = 70005
+	CodeNoExchangeRateFound    uint32 = 70006
+	CodeUnknownOracleMsgType   uint32 = 70007
+	CodeUnknownOracleQueryType uint32 = 70008
+	CodeOracleNotSupported     uint32 = 70009
+)
+
+// ErrInvalidAddress returns an error when an address is invalid
+func ErrInvalidAddress(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidAddress, fmt.Sprintf("failed. invalid address: %s", msg))}
+}
+
+// ErrInvalidExchangeRates returns an error when the exchange rates of a vote are invalid
+func ErrInvalidExchangeRates(exchangeRates string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidExchangeRates,
+		fmt.Sprintf("failed. invalid exchange rates %s, they should be positive and sorted by denom without duplicates", exchangeRates))}
+}
+
+// ErrDenomNotWhitelisted returns an error when a vote contains a denom not in the whitelist
+func ErrDenomNotWhitelisted(denom string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeDenomNotWhitelisted, fmt.Sprintf("failed. denom %s is not in the oracle whitelist", denom))}
+}
+
+// ErrNoValidatorFound returns an error when a validator doesn't exist
+func ErrNoValidatorFound(valAddr string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNoValidatorFound, fmt.Sprintf("failed. validator %s does not exist", valAddr))}
+}
+
+// ErrValidatorNotBonded returns an error when a validator not in the bonded set votes
+func ErrValidatorNotBonded(valAddr string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeValidatorNotBonded, fmt.Sprintf("failed. validator %s is not bonded", valAddr))}
+}
+
+// ErrInvalidFeeder returns an error when a vote is not signed by the operator of the validator
+func ErrInvalidFeeder(feeder, valAddr string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidFeeder,
+		fmt.Sprintf("failed. %s is not the operator of validator %s", feeder, valAddr))}
+}
+
+// ErrNoExchangeRateFound returns an error when the exchange rate of a denom doesn't exist
+func ErrNoExchangeRateFound(denom string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNoExchangeRateFound, fmt.Sprintf("failed. exchange rate of %s does not exist", denom))}
+}
+
+// ErrUnknownOracleMsgType returns an error when the msg type is unknown
+func ErrUnknownOracleMsgType(msgType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownOracleMsgType, fmt.Sprintf("unrecognized oracle message type: %s", msgType))}
+}
+
+// ErrUnknownOracleQueryType returns an error when the query endpoint is unknown
+func ErrUnknownOracleQueryType(queryType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownOracleQueryType, fmt.Sprintf("unknown oracle query endpoint: %s", queryType))}
+}
+
+// ErrOracleNotSupported returns an error when the oracle module is used before the upgrade height
+func ErrOracleNotSupported(height int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeOracleNotSupported, fmt.Sprintf("failed. oracle is not supported at height %d", height))}
+}
@@ -0,0 +1,18 @@
+package types
+
+// oracle module event types
+const (
+	EventTypeExchangeRateVote   = "exchange_rate_vote"
+	EventTypeExchangeRateUpdate = "exchange_rate_update"
+	EventTypeOracleSlash        = "oracle_slash"
+
+	AttributeKeyValidator     = "validator"
+	AttributeKeyFeeder        = "feeder"
+	AttributeKeyExchangeRates = "exchange_rates"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyExchangeRate  = "exchange_rate"
+	AttributeKeyMissCount     = "miss_count"
+	AttributeKeyJailed        = "jailed"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/okex/exchain/x/params"
+	stakingexported "github.com/okex/exchain/x/staking/exported"
+)
+
+// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type
+type Subspace interface {
+	GetParamSet(ctx sdk.Context, ps params.ParamSet)
+	SetParamSet(ctx sdk.Context, ps params.ParamSet)
+}
+
+// StakingKeeper defines the expected staking keeper
+type StakingKeeper interface {
+	Validator(ctx sdk.Context, address sdk.ValAddress) stakingexported.ValidatorI
+	IterateBondedValidatorsByPower(ctx sdk.Context, fn func(index int64, validator stakingexported.ValidatorI) (stop bool))
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
+	AppendAbandonedValidatorAddrs(ctx sdk.Context, consAddr sdk.ConsAddress)
+}
+
+// EvmKeeper defines the expected evm keeper used to write the exchange rates into the feed contract
+type EvmKeeper interface {
+	GenerateCSDBParams() evmtypes.CommitStateDBParams
+}
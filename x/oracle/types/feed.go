@@ -0,0 +1,40 @@
+package types
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+)
+
+// The precompiled contracts of the EVM can't read the state of the chain, so the exchange rates are mirrored into the
+// storage of a system contract at FeedContractAddress instead. The storage slot of a denom is keccak256(denom) and
+// its value is the exchange rate as an 18 decimals fixed point number, 0 if the exchange rate is not available.
+// The code of the contract returns the slot given as the 32 bytes calldata:
+//
+//	(bool ok, bytes memory out) = feed.staticcall(abi.encode(keccak256(bytes("usdt"))));
+//	uint256 rate = abi.decode(out, (uint256));
+var (
+	// FeedContractCode is PUSH1 0 CALLDATALOAD SLOAD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	FeedContractCode = []byte{0x60, 0x00, 0x35, 0x54, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+
+	// FeedContractAddress is the address of the feed contract derived from the oracle module name
+	FeedContractAddress ethcmn.Address
+)
+
+func init() {
+	FeedContractAddress = ethcmn.BytesToAddress(authtypes.NewModuleAddress(ModuleName + "-feed").Bytes())
+}
+
+// GetFeedSlot returns the storage slot of the exchange rate of a denom in the feed contract
+func GetFeedSlot(denom string) ethcmn.Hash {
+	return ethcrypto.Keccak256Hash([]byte(denom))
+}
+
+// GetFeedValue returns the storage value of an exchange rate in the feed contract
+func GetFeedValue(exchangeRate sdk.Dec) ethcmn.Hash {
+	if exchangeRate.IsNil() || !exchangeRate.IsPositive() {
+		return ethcmn.Hash{}
+	}
+	return ethcmn.BigToHash(exchangeRate.BigInt())
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// GenesisState is the state of the oracle module that must be provided at genesis
+type GenesisState struct {
+	Params        Params            `json:"params" yaml:"params"`
+	ExchangeRates sdk.DecCoins      `json:"exchange_rates" yaml:"exchange_rates"`
+	Votes         ExchangeRateVotes `json:"votes" yaml:"votes"`
+	MissCounters  []MissCounter     `json:"miss_counters" yaml:"miss_counters"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, exchangeRates sdk.DecCoins, votes ExchangeRateVotes, missCounters []MissCounter) GenesisState {
+	return GenesisState{
+		Params:        params,
+		ExchangeRates: exchangeRates,
+		Votes:         votes,
+		MissCounters:  missCounters,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), nil, nil, nil)
+}
+
+// ValidateGenesis validates the oracle genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	if !data.ExchangeRates.IsValid() {
+		return fmt.Errorf("invalid exchange rates %s", data.ExchangeRates)
+	}
+
+	voters := make(map[string]bool, len(data.Votes))
+	for _, vote := range data.Votes {
+		if vote.Validator.Empty() {
+			return fmt.Errorf("validator of vote is empty")
+		}
+		if voters[vote.Validator.String()] {
+			return fmt.Errorf("duplicated vote of validator %s", vote.Validator)
+		}
+		if len(vote.ExchangeRates) == 0 || !vote.ExchangeRates.IsValid() {
+			return fmt.Errorf("invalid exchange rates %s voted by %s", vote.ExchangeRates, vote.Validator)
+		}
+		voters[vote.Validator.String()] = true
+	}
+
+	validators := make(map[string]bool, len(data.MissCounters))
+	for _, missCounter := range data.MissCounters {
+		if missCounter.Validator.Empty() {
+			return fmt.Errorf("validator of miss counter is empty")
+		}
+		if validators[missCounter.Validator.String()] {
+			return fmt.Errorf("duplicated miss counter of validator %s", missCounter.Validator)
+		}
+		validators[missCounter.Validator.String()] = true
+	}
+	return nil
+}
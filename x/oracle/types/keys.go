@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the oracle module
+	ModuleName = "oracle"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the oracle module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the oracle module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// ExchangeRatePrefix is the prefix of the aggregated exchange rates, denom -> sdk.Dec
+	ExchangeRatePrefix = []byte{0x01}
+	// VotePrefix is the prefix of the votes of the current vote period, validator -> ExchangeRateVote
+	VotePrefix = []byte{0x02}
+	// MissCounterPrefix is the prefix of the vote periods missed by validators in the current slash window,
+	// validator -> uint64
+	MissCounterPrefix = []byte{0x03}
+)
+
+// GetExchangeRateKey returns the store key of the exchange rate of a denom
+func GetExchangeRateKey(denom string) []byte {
+	return append(ExchangeRatePrefix, []byte(denom)...)
+}
+
+// GetVoteKey returns the store key of the vote of a validator
+func GetVoteKey(valAddr sdk.ValAddress) []byte {
+	return append(VotePrefix, valAddr.Bytes()...)
+}
+
+// GetMissCounterKey returns the store key of the miss counter of a validator
+func GetMissCounterKey(valAddr sdk.ValAddress) []byte {
+	return append(MissCounterPrefix, valAddr.Bytes()...)
+}
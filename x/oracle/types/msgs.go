@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = MsgExchangeRateVote{}
+
+// MsgExchangeRateVote submits the exchange rates of the native token in the whitelisted denoms observed by a
+// validator in the current vote period. A later vote in the same period replaces the former one
+type MsgExchangeRateVote struct {
+	ExchangeRates sdk.DecCoins   `json:"exchange_rates" yaml:"exchange_rates"`
+	Feeder        sdk.AccAddress `json:"feeder" yaml:"feeder"`
+	Validator     sdk.ValAddress `json:"validator" yaml:"validator"`
+}
+
+// NewMsgExchangeRateVote creates a new instance of MsgExchangeRateVote
+func NewMsgExchangeRateVote(exchangeRates sdk.DecCoins, feeder sdk.AccAddress, valAddr sdk.ValAddress) MsgExchangeRateVote {
+	return MsgExchangeRateVote{
+		ExchangeRates: exchangeRates,
+		Feeder:        feeder,
+		Validator:     valAddr,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgExchangeRateVote) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgExchangeRateVote) Type() string { return "exchange_rate_vote" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgExchangeRateVote) ValidateBasic() sdk.Error {
+	if msg.Feeder.Empty() {
+		return ErrInvalidAddress("feeder is empty")
+	}
+	if msg.Validator.Empty() {
+		return ErrInvalidAddress("validator is empty")
+	}
+	if len(msg.ExchangeRates) == 0 || !msg.ExchangeRates.IsValid() {
+		return ErrInvalidExchangeRates(msg.ExchangeRates.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgExchangeRateVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgExchangeRateVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Feeder}
+}
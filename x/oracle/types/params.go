@@ -0,0 +1,162 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/params"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Parameter store key
+var (
+	DefaultVotePeriod        = uint64(10)                // blocks
+	DefaultVoteThreshold     = sdk.NewDecWithPrec(50, 2) // 50%
+	DefaultWhitelist         = []string{}
+	DefaultSlashWindow       = uint64(10000)            // blocks
+	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 5%
+
+	ParamStoreKeyVotePeriod        = []byte("VotePeriod")
+	ParamStoreKeyVoteThreshold     = []byte("VoteThreshold")
+	ParamStoreKeyWhitelist         = []byte("Whitelist")
+	ParamStoreKeySlashWindow       = []byte("SlashWindow")
+	ParamStoreKeyMinValidPerWindow = []byte("MinValidPerWindow")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params defines the oracle module params
+type Params struct {
+	// vote_period defines the number of blocks during which the votes are collected before the ballots are tallied
+	VotePeriod uint64 `json:"vote_period" yaml:"vote_period"`
+	// vote_threshold defines the minimum proportion of the bonded power voting for a denom to update its exchange rate
+	VoteThreshold sdk.Dec `json:"vote_threshold" yaml:"vote_threshold"`
+	// whitelist defines the denoms whose exchange rates are voted
+	Whitelist []string `json:"whitelist" yaml:"whitelist"`
+	// slash_window defines the number of blocks over which the missed vote periods of a validator are counted.
+	// It must be a multiple of vote_period
+	SlashWindow uint64 `json:"slash_window" yaml:"slash_window"`
+	// min_valid_per_window defines the minimum proportion of the vote periods in a slash window a validator must
+	// vote in, otherwise the validator is jailed
+	MinValidPerWindow sdk.Dec `json:"min_valid_per_window" yaml:"min_valid_per_window"`
+}
+
+// NewParams creates a new Params object
+func NewParams(votePeriod uint64, voteThreshold sdk.Dec, whitelist []string, slashWindow uint64, minValidPerWindow sdk.Dec) Params {
+	return Params{
+		VotePeriod:        votePeriod,
+		VoteThreshold:     voteThreshold,
+		Whitelist:         whitelist,
+		SlashWindow:       slashWindow,
+		MinValidPerWindow: minValidPerWindow,
+	}
+}
+
+// DefaultParams returns the default parameters of the oracle module
+func DefaultParams() Params {
+	return NewParams(DefaultVotePeriod, DefaultVoteThreshold, DefaultWhitelist, DefaultSlashWindow, DefaultMinValidPerWindow)
+}
+
+// String implements the fmt.Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyVotePeriod, &p.VotePeriod, validatePeriod),
+		params.NewParamSetPair(ParamStoreKeyVoteThreshold, &p.VoteThreshold, validateRatio),
+		params.NewParamSetPair(ParamStoreKeyWhitelist, &p.Whitelist, validateWhitelist),
+		params.NewParamSetPair(ParamStoreKeySlashWindow, &p.SlashWindow, validatePeriod),
+		params.NewParamSetPair(ParamStoreKeyMinValidPerWindow, &p.MinValidPerWindow, validateRatio),
+	}
+}
+
+// Validate checks all the params and that the slash window is a multiple of the vote period
+func (p Params) Validate() error {
+	if err := validatePeriod(p.VotePeriod); err != nil {
+		return err
+	}
+	if err := validateRatio(p.VoteThreshold); err != nil {
+		return err
+	}
+	if err := validateWhitelist(p.Whitelist); err != nil {
+		return err
+	}
+	if err := validatePeriod(p.SlashWindow); err != nil {
+		return err
+	}
+	if err := validateRatio(p.MinValidPerWindow); err != nil {
+		return err
+	}
+	if p.SlashWindow%p.VotePeriod != 0 {
+		return fmt.Errorf("slash window %d should be a multiple of vote period %d", p.SlashWindow, p.VotePeriod)
+	}
+	return nil
+}
+
+// IsWhitelisted checks whether the exchange rate of the denom is voted
+func (p Params) IsWhitelisted(denom string) bool {
+	for _, d := range p.Whitelist {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+func validatePeriod(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("period should be positive")
+	}
+
+	return nil
+}
+
+func validateRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("invalid parameter: nil")
+	}
+
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("ratio %s should be in [0, 1]", v)
+	}
+
+	return nil
+}
+
+func validateWhitelist(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]bool, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if denoms[denom] {
+			return fmt.Errorf("duplicated denom %s in whitelist", denom)
+		}
+		denoms[denom] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// QueryExchangeRate is the query endpoint of the exchange rate of a denom
+	QueryExchangeRate = "exchange_rate"
+	// QueryExchangeRates is the query endpoint of the exchange rates of all the denoms
+	QueryExchangeRates = "exchange_rates"
+	// QueryVotes is the query endpoint of the votes in the current vote period
+	QueryVotes = "votes"
+	// QueryMissCounter is the query endpoint of the miss counter of a validator
+	QueryMissCounter = "miss_counter"
+	// QueryParameters is the query endpoint of the oracle params
+	QueryParameters = "params"
+)
+
+// QueryExchangeRateParams is the params of the query of the exchange rate of a denom
+type QueryExchangeRateParams struct {
+	Denom string `json:"denom"`
+}
+
+// NewQueryExchangeRateParams creates a new instance of QueryExchangeRateParams
+func NewQueryExchangeRateParams(denom string) QueryExchangeRateParams {
+	return QueryExchangeRateParams{
+		Denom: denom,
+	}
+}
+
+// QueryValidatorParams is the params of the queries about a validator
+type QueryValidatorParams struct {
+	Validator sdk.ValAddress `json:"validator"`
+}
+
+// NewQueryValidatorParams creates a new instance of QueryValidatorParams
+func NewQueryValidatorParams(valAddr sdk.ValAddress) QueryValidatorParams {
+	return QueryValidatorParams{
+		Validator: valAddr,
+	}
+}
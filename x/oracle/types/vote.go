@@ -0,0 +1,119 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// ExchangeRateVote is the exchange rates voted by a validator in the current vote period
+type ExchangeRateVote struct {
+	ExchangeRates sdk.DecCoins   `json:"exchange_rates" yaml:"exchange_rates"`
+	Validator     sdk.ValAddress `json:"validator" yaml:"validator"`
+}
+
+// NewExchangeRateVote creates a new instance of ExchangeRateVote
+func NewExchangeRateVote(exchangeRates sdk.DecCoins, valAddr sdk.ValAddress) ExchangeRateVote {
+	return ExchangeRateVote{
+		ExchangeRates: exchangeRates,
+		Validator:     valAddr,
+	}
+}
+
+// String returns a human readable string representation of ExchangeRateVote
+func (v ExchangeRateVote) String() string {
+	return fmt.Sprintf(`Exchange Rate Vote:
+  Validator:       %s
+  Exchange Rates:  %s`,
+		v.Validator, v.ExchangeRates)
+}
+
+// ExchangeRateVotes is a collection of ExchangeRateVote
+type ExchangeRateVotes []ExchangeRateVote
+
+// String returns a human readable string representation of ExchangeRateVotes
+func (vs ExchangeRateVotes) String() (out string) {
+	for _, v := range vs {
+		out += v.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// BallotVote is the exchange rate of a denom voted by a validator, weighted by the power of the validator
+type BallotVote struct {
+	ExchangeRate sdk.Dec
+	Voter        sdk.ValAddress
+	Power        int64
+}
+
+// NewBallotVote creates a new instance of BallotVote
+func NewBallotVote(exchangeRate sdk.Dec, voter sdk.ValAddress, power int64) BallotVote {
+	return BallotVote{
+		ExchangeRate: exchangeRate,
+		Voter:        voter,
+		Power:        power,
+	}
+}
+
+// Ballot is the votes on the exchange rate of a denom
+type Ballot []BallotVote
+
+// Power returns the sum of the power of the voters
+func (b Ballot) Power() (power int64) {
+	for _, vote := range b {
+		power += vote.Power
+	}
+	return
+}
+
+// WeightedMedian returns the exchange rate at which the voters with lower or equal rates hold at least half of the
+// power of the ballot. The votes are ordered by exchange rate, then by voter, so it's deterministic
+func (b Ballot) WeightedMedian() sdk.Dec {
+	if len(b) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	sorted := make(Ballot, len(b))
+	copy(sorted, b)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].ExchangeRate.Equal(sorted[j].ExchangeRate) {
+			return sorted[i].ExchangeRate.LT(sorted[j].ExchangeRate)
+		}
+		return bytes.Compare(sorted[i].Voter, sorted[j].Voter) < 0
+	})
+
+	total := sorted.Power()
+	var cumulative int64
+	for _, vote := range sorted {
+		cumulative += vote.Power
+		if cumulative*2 >= total {
+			return vote.ExchangeRate
+		}
+	}
+	return sorted[len(sorted)-1].ExchangeRate
+}
+
+// MissCounter is the number of vote periods a validator missed in the current slash window
+type MissCounter struct {
+	Validator sdk.ValAddress `json:"validator" yaml:"validator"`
+	Count     uint64         `json:"count" yaml:"count"`
+}
+
+// NewMissCounter creates a new instance of MissCounter
+func NewMissCounter(valAddr sdk.ValAddress, count uint64) MissCounter {
+	return MissCounter{
+		Validator: valAddr,
+		Count:     count,
+	}
+}
+
+// String returns a human readable string representation of MissCounter
+func (mc MissCounter) String() string {
+	return fmt.Sprintf(`Miss Counter:
+  Validator:  %s
+  Count:      %d`,
+		mc.Validator, mc.Count)
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBallotWeightedMedian(t *testing.T) {
+	valA := sdk.ValAddress([]byte("testOracleValidatorA"))
+	valB := sdk.ValAddress([]byte("testOracleValidatorB"))
+	valC := sdk.ValAddress([]byte("testOracleValidatorC"))
+
+	tests := []struct {
+		name   string
+		ballot Ballot
+		median sdk.Dec
+		power  int64
+	}{
+		{"empty", Ballot{}, sdk.ZeroDec(), 0},
+		{"single", Ballot{NewBallotVote(sdk.NewDec(10), valA, 1)}, sdk.NewDec(10), 1},
+		{"equal power", Ballot{
+			NewBallotVote(sdk.NewDec(30), valA, 1),
+			NewBallotVote(sdk.NewDec(10), valB, 1),
+			NewBallotVote(sdk.NewDec(20), valC, 1),
+		}, sdk.NewDec(20), 3},
+		{"weighted by power", Ballot{
+			NewBallotVote(sdk.NewDec(10), valA, 1),
+			NewBallotVote(sdk.NewDec(20), valB, 1),
+			NewBallotVote(sdk.NewDec(30), valC, 10),
+		}, sdk.NewDec(30), 12},
+		{"exactly half", Ballot{
+			NewBallotVote(sdk.NewDec(10), valA, 5),
+			NewBallotVote(sdk.NewDec(20), valB, 5),
+		}, sdk.NewDec(10), 10},
+	}
+
+	for _, tc := range tests {
+		require.True(t, tc.median.Equal(tc.ballot.WeightedMedian()), tc.name)
+		require.Equal(t, tc.power, tc.ballot.Power(), tc.name)
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.Nil(t, DefaultParams().Validate())
+
+	params := DefaultParams()
+	params.Whitelist = []string{"usdt", "btc"}
+	require.Nil(t, params.Validate())
+	require.True(t, params.IsWhitelisted("btc"))
+	require.False(t, params.IsWhitelisted("eth"))
+
+	params.Whitelist = []string{"usdt", "usdt"}
+	require.NotNil(t, params.Validate())
+
+	params = DefaultParams()
+	params.VotePeriod = 0
+	require.NotNil(t, params.Validate())
+
+	params = DefaultParams()
+	params.SlashWindow = params.VotePeriod*100 + 1
+	require.NotNil(t, params.Validate())
+
+	params = DefaultParams()
+	params.VoteThreshold = sdk.NewDecWithPrec(11, 1)
+	require.NotNil(t, params.Validate())
+
+	params = DefaultParams()
+	params.MinValidPerWindow = sdk.NewDec(-1)
+	require.NotNil(t, params.Validate())
+}
+
+func TestMsgExchangeRateVoteValidateBasic(t *testing.T) {
+	feeder := sdk.AccAddress([]byte("testOracleValidatorA"))
+	valAddr := sdk.ValAddress(feeder)
+	exchangeRates := sdk.NewDecCoins(sdk.NewDecCoinFromDec("btc", sdk.NewDecWithPrec(62, 5)),
+		sdk.NewDecCoinFromDec("usdt", sdk.NewDecWithPrec(1825, 2)))
+
+	require.Nil(t, NewMsgExchangeRateVote(exchangeRates, feeder, valAddr).ValidateBasic())
+	require.NotNil(t, NewMsgExchangeRateVote(exchangeRates, nil, valAddr).ValidateBasic())
+	require.NotNil(t, NewMsgExchangeRateVote(exchangeRates, feeder, nil).ValidateBasic())
+	require.NotNil(t, NewMsgExchangeRateVote(sdk.DecCoins{}, feeder, valAddr).ValidateBasic())
+	unsorted := sdk.DecCoins{exchangeRates[1], exchangeRates[0]}
+	require.NotNil(t, NewMsgExchangeRateVote(unsorted, feeder, valAddr).ValidateBasic())
+}
+
+func TestValidateGenesis(t *testing.T) {
+	valAddr := sdk.ValAddress([]byte("testOracleValidatorA"))
+	exchangeRates := sdk.NewDecCoins(sdk.NewDecCoinFromDec("usdt", sdk.NewDecWithPrec(1825, 2)))
+
+	require.Nil(t, ValidateGenesis(DefaultGenesisState()))
+
+	data := DefaultGenesisState()
+	data.ExchangeRates = exchangeRates
+	data.Votes = ExchangeRateVotes{NewExchangeRateVote(exchangeRates, valAddr)}
+	data.MissCounters = []MissCounter{NewMissCounter(valAddr, 3)}
+	require.Nil(t, ValidateGenesis(data))
+
+	data.Votes = append(data.Votes, NewExchangeRateVote(exchangeRates, valAddr))
+	require.NotNil(t, ValidateGenesis(data))
+
+	data.Votes = nil
+	data.MissCounters = append(data.MissCounters, NewMissCounter(valAddr, 1))
+	require.NotNil(t, ValidateGenesis(data))
+}
+
+func TestGetFeedValue(t *testing.T) {
+	require.Equal(t, "0x0000000000000000000000000000000000000000000000000de0b6b3a7640000",
+		GetFeedValue(sdk.OneDec()).Hex())
+	require.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000000000",
+		GetFeedValue(sdk.ZeroDec()).Hex())
+	require.NotEqual(t, GetFeedSlot("usdt"), GetFeedSlot("btc"))
+}
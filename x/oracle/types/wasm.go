@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// WasmPluginVersion is the version of WasmPluginSchema
+const WasmPluginVersion = 1
+
+// WasmPluginSchema is the json schema of the oracle queries of wasm contracts
+const WasmPluginSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "title": "OracleQuery",
+  "oneOf": [
+    {
+      "type": "object",
+      "required": ["exchange_rate"],
+      "properties": {
+        "exchange_rate": {
+          "type": "object",
+          "required": ["denom"],
+          "properties": {"denom": {"type": "string"}}
+        }
+      },
+      "additionalProperties": false
+    },
+    {
+      "type": "object",
+      "required": ["exchange_rates"],
+      "properties": {"exchange_rates": {"type": "object"}},
+      "additionalProperties": false
+    }
+  ]
+}`
+
+// OracleQuery is the oracle query of wasm contracts routed to the oracle module under its module name, e.g.
+//
+//	{"oracle":{"exchange_rate":{"denom":"usdt"}}}
+//
+// Only one of the fields is set
+type OracleQuery struct {
+	ExchangeRate  *ExchangeRateQuery  `json:"exchange_rate,omitempty"`
+	ExchangeRates *ExchangeRatesQuery `json:"exchange_rates,omitempty"`
+}
+
+// ExchangeRateQuery queries the exchange rate of a denom
+type ExchangeRateQuery struct {
+	Denom string `json:"denom"`
+}
+
+// ExchangeRatesQuery queries the exchange rates of all the denoms
+type ExchangeRatesQuery struct{}
+
+// ExchangeRateResponse is the response of ExchangeRateQuery
+type ExchangeRateResponse struct {
+	Denom        string  `json:"denom"`
+	ExchangeRate sdk.Dec `json:"exchange_rate"`
+}
+
+// ExchangeRatesResponse is the response of ExchangeRatesQuery
+type ExchangeRatesResponse struct {
+	ExchangeRates []ExchangeRateResponse `json:"exchange_rates"`
+}
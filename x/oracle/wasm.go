@@ -0,0 +1,18 @@
+package oracle
+
+import (
+	"encoding/json"
+
+	"github.com/okex/exchain/x/oracle/keeper"
+	"github.com/okex/exchain/x/oracle/types"
+	"github.com/okex/exchain/x/wasm"
+)
+
+// RegisterWasmPlugin registers the custom querier of the exchange rates under the module name
+func RegisterWasmPlugin(plugins *wasm.CustomPlugins, k keeper.Keeper) {
+	plugins.Register(types.ModuleName, wasm.CustomPlugin{
+		Version: types.WasmPluginVersion,
+		Schema:  json.RawMessage(types.WasmPluginSchema),
+		Querier: keeper.NewWasmQuerier(k),
+	})
+}
@@ -19,27 +19,29 @@ const (
 // nolint
 // types aliases
 type (
-	Keeper           = keeper.Keeper
-	Order            = types.Order
-	DepthBook        = types.DepthBook
-	MatchResult      = types.MatchResult
-	Deal             = types.Deal
-	Params           = types.Params
-	MsgNewOrder      = types.MsgNewOrder
-	MsgCancelOrder   = types.MsgCancelOrder
-	MsgNewOrders     = types.MsgNewOrders
-	MsgCancelOrders  = types.MsgCancelOrders
-	BlockMatchResult = types.BlockMatchResult
+	Keeper            = keeper.Keeper
+	Order             = types.Order
+	DepthBook         = types.DepthBook
+	MatchResult       = types.MatchResult
+	Deal              = types.Deal
+	Params            = types.Params
+	MsgNewOrder       = types.MsgNewOrder
+	MsgCancelOrder    = types.MsgCancelOrder
+	MsgNewOrders      = types.MsgNewOrders
+	MsgCancelOrders   = types.MsgCancelOrders
+	MsgNewMarketOrder = types.MsgNewMarketOrder
+	BlockMatchResult  = types.BlockMatchResult
 )
 
 // nolint
 // functions aliases
 var (
-	RegisterCodec     = types.RegisterCodec
-	DefaultParams     = types.DefaultParams
-	NewMsgNewOrder    = types.NewMsgNewOrder
-	NewMsgCancelOrder = types.NewMsgCancelOrder
-	NewKeeper         = keeper.NewKeeper
-	NewQuerier        = keeper.NewQuerier
-	FormatOrderIDsKey = types.FormatOrderIDsKey
+	RegisterCodec        = types.RegisterCodec
+	DefaultParams        = types.DefaultParams
+	NewMsgNewOrder       = types.NewMsgNewOrder
+	NewMsgCancelOrder    = types.NewMsgCancelOrder
+	NewMsgNewMarketOrder = types.NewMsgNewMarketOrder
+	NewKeeper            = keeper.NewKeeper
+	NewQuerier           = keeper.NewQuerier
+	FormatOrderIDsKey    = types.FormatOrderIDsKey
 )
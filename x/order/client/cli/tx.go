@@ -26,6 +26,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(client.PostCommands(
 		getCmdNewOrder(cdc),
 		getCmdCancelOrder(cdc),
+		getCmdNewMarketOrder(cdc),
 	)...)
 
 	return txCmd
@@ -111,6 +112,51 @@ func handleNewOrder(cmd *cobra.Command, cdc *codec.Codec, product string, side s
 	return err
 }
 
+func getCmdNewMarketOrder(cdc *codec.Codec) *cobra.Command {
+	// new market order flags
+	var product string
+	var side string
+	var quantity string
+	var maxSlippage string
+	cmd := &cobra.Command{
+		Use:   "market",
+		Short: "place a new market order, the unfilled quantity is cancelled after matching in the block",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(product) == 0 || len(side) == 0 || len(quantity) == 0 {
+				return errors.New("invalid param format")
+			}
+			quantityDec, err := sdk.NewDecFromStr(quantity)
+			if err != nil {
+				return errors.New(err.Error())
+			}
+			maxSlippageDec, err := sdk.NewDecFromStr(maxSlippage)
+			if err != nil {
+				return errors.New(err.Error())
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.MsgNewMarketOrder{
+				Sender:      cliCtx.GetFromAddress(),
+				Product:     product,
+				Side:        side,
+				Quantity:    quantityDec,
+				MaxSlippage: maxSlippageDec,
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringVarP(&product, "product", "", "", "Trading pair in full name of the tokens: ${baseAssetSymbol}_${quoteAssetSymbol}, for example \"mycoin_okt\".")
+	cmd.Flags().StringVarP(&side, "side", "s", "", "BUY or SELL")
+	cmd.Flags().StringVarP(&quantity, "quantity", "q", "", "The quantity of the order")
+	cmd.Flags().StringVarP(&maxSlippage, "max-slippage", "", "0.05", "The max deviation of the filled price from the best opposite price, e.g. 0.05 means 5%")
+	return cmd
+}
+
 func getCmdCancelOrder(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "cancel [order-id]",
@@ -47,6 +47,9 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) {
 		orderNum := keeper.GetBlockOrderNum(ctx, height)
 		keeper.SetBlockOrderNum(ctx, height, orderNum+1)
 		keeper.SetOrder(ctx, order.OrderID, order)
+		if order.IsMarketOrder() {
+			keeper.SetMarketOrderID(ctx, order.OrderID)
+		}
 
 		// update depth book and orderIDsMap in cache
 		keeper.InsertOrderIntoDepthBook(order)
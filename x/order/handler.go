@@ -23,6 +23,8 @@ func CalculateGas(msg sdk.Msg, params *types.Params) (gas uint64) {
 		gas = msg.CalculateGas(params.NewOrderMsgGasUnit)
 	case types.MsgCancelOrders:
 		gas = msg.CalculateGas(params.CancelOrderMsgGasUnit)
+	case types.MsgNewMarketOrder:
+		gas = msg.CalculateGas(params.NewOrderMsgGasUnit)
 	default:
 		gas = math.MaxUint64
 	}
@@ -65,6 +67,11 @@ func NewOrderHandler(keeper keeper.Keeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgCancelOrders(ctx, keeper, msg, logger)
 			}
+		case types.MsgNewMarketOrder:
+			name = "handleMsgNewMarketOrder"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgNewMarketOrder(ctx, keeper, msg, logger)
+			}
 		default:
 			errMsg := fmt.Sprintf("Invalid msg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -243,6 +250,84 @@ func ValidateMsgNewOrders(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrde
 
 }
 
+// getMarketOrderFromMsg: build the market order with the price bounded by the max slippage
+// from the best opposite price in depth book
+func getMarketOrderFromMsg(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewMarketOrder) (*types.Order, error) {
+	tokenPair := k.GetDexKeeper().GetTokenPair(ctx, msg.Product)
+	if tokenPair == nil {
+		return nil, types.ErrTokenPairNotExist(msg.Product)
+	}
+
+	book := k.GetDepthBookCopy(msg.Product)
+	price, ok := book.MarketOrderPrice(msg.Side, msg.MaxSlippage, tokenPair.MaxPriceDigit)
+	if !ok {
+		return nil, types.ErrNoMarketLiquidity(msg.Product, msg.Side)
+	}
+
+	newOrderMsg := MsgNewOrder{
+		Sender:   msg.Sender,
+		Product:  msg.Product,
+		Side:     msg.Side,
+		Price:    price,
+		Quantity: msg.Quantity,
+	}
+	if err := checkOrderNewMsg(ctx, k, newOrderMsg); err != nil {
+		return nil, err
+	}
+	if k.IsProductLocked(ctx, msg.Product) {
+		return nil, types.ErrIsProductLocked(msg.Product)
+	}
+
+	return getOrderFromMsg(ctx, k, newOrderMsg, "1"), nil
+}
+
+func handleMsgNewMarketOrder(ctx sdk.Context, k Keeper, msg types.MsgNewMarketOrder,
+	logger log.Logger) (*sdk.Result, error) {
+	order, err := getMarketOrderFromMsg(ctx, k, msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.PlaceMarketOrder(ctx, order); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName)),
+		sdk.NewEvent(
+			types.EventTypeMarketOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, order.OrderID),
+			sdk.NewAttribute(types.AttributeKeyProduct, order.Product),
+			sdk.NewAttribute(types.AttributeKeySide, order.Side),
+			sdk.NewAttribute(types.AttributeKeyPrice, order.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyQuantity, order.Quantity.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxSlippage, msg.MaxSlippage.String()),
+		),
+	})
+
+	logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+		"    msg<Product:%s,Sender:%s,Quantity:%s,Side:%s,MaxSlippage:%s>\n"+
+		"    result<The User have created a market order {ID:%s,Price:%s} >\n",
+		ctx.BlockHeight(), "handleMsgNewMarketOrder",
+		msg.Product, msg.Sender, msg.Quantity.String(), msg.Side, msg.MaxSlippage.String(),
+		order.OrderID, order.Price.String()))
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// ValidateMsgNewMarketOrder validates whether the msg of newMarketOrder is valid.
+func ValidateMsgNewMarketOrder(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewMarketOrder) error {
+	order, err := getMarketOrderFromMsg(ctx, k, msg)
+	if err != nil {
+		return err
+	}
+	if _, err = k.TryPlaceOrder(ctx, order); err != nil {
+		return common.ErrInsufficientCoins(DefaultParamspace, err.Error())
+	}
+	return nil
+}
+
 func handleCancelOrder(context sdk.Context, k Keeper, sender sdk.AccAddress, orderID string, logger log.Logger) (
 	types.OrderResult, sdk.CacheMultiStore) {
 
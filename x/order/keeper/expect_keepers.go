@@ -49,4 +49,7 @@ type DexKeeper interface {
 	GetLockedProductsCopy(ctx sdk.Context) *types.ProductLockMap
 	IsAnyProductLocked(ctx sdk.Context) bool
 	GetOperator(ctx sdk.Context, addr sdk.AccAddress) (operator dex.DEXOperator, isExist bool)
+	// Fee tiers
+	GetTradeFeeRate(ctx sdk.Context, product string, quantity sdk.Dec) (sdk.Dec, bool)
+	AddFeeStatistics(ctx sdk.Context, product string, operator sdk.AccAddress, fees sdk.SysCoins)
 }
@@ -15,6 +15,7 @@ const minFee = "0.00000001"
 // GetFeeKeeper is an interface for calculating handling fees
 type GetFeeKeeper interface {
 	GetLastPrice(ctx sdk.Context, product string) sdk.Dec
+	GetTradeFeeRate(ctx sdk.Context, product string, quantity sdk.Dec, feeParams *types.Params) sdk.Dec
 }
 
 // GetOrderNewFee is used to calculate the handling fee that needs to be locked when placing an order
@@ -58,7 +59,7 @@ func GetDealFee(order *types.Order, fillAmt sdk.Dec, ctx sdk.Context, keeper Get
 	}
 
 	minFeeDec := sdk.MustNewDecFromStr(minFee)
-	feeAmt := quantity.Mul(feeParams.TradeFeeRate)
+	feeAmt := quantity.Mul(keeper.GetTradeFeeRate(ctx, order.Product, fillAmt, feeParams))
 	if feeAmt.GT(minFeeDec) {
 		return sdk.SysCoins{sdk.NewDecCoinFromDec(symbol, feeAmt)}
 	}
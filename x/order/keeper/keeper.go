@@ -404,6 +404,15 @@ func (k Keeper) GetProductFeeReceiver(ctx sdk.Context, product string) (sdk.AccA
 	return tokenPair.Owner, nil
 }
 
+// GetTradeFeeRate gets the trade fee rate of a deal from the fee tiers of product by the filled quantity,
+// the trade fee rate in params is used if no fee tier matches
+func (k Keeper) GetTradeFeeRate(ctx sdk.Context, product string, quantity sdk.Dec, feeParams *types.Params) sdk.Dec {
+	if rate, found := k.GetDexKeeper().GetTradeFeeRate(ctx, product, quantity); found {
+		return rate
+	}
+	return feeParams.TradeFeeRate
+}
+
 // AddFeeDetail adds detail message of fee to tokenKeeper
 func (k Keeper) AddFeeDetail(ctx sdk.Context, from sdk.AccAddress, coins sdk.SysCoins,
 	feeType string) {
@@ -428,6 +437,9 @@ func (k Keeper) SendFeesToProductOwner(ctx sdk.Context, coins sdk.SysCoins, from
 		log.Printf("Send fee(%s) to address(%s) failed\n", coins.String(), to.String())
 		return "", types.ErrSendCoinsFailed(coins.String(), to.String())
 	}
+	if tokenPair := k.GetDexKeeper().GetTokenPair(ctx, product); tokenPair != nil {
+		k.GetDexKeeper().AddFeeStatistics(ctx, product, tokenPair.Owner, coins)
+	}
 	return to.String(), nil
 }
 
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+
+	"github.com/okex/exchain/x/order/types"
+)
+
+// SetMarketOrderID records a market order whose unfilled quantity will be cancelled after matching
+func (k Keeper) SetMarketOrderID(ctx sdk.Context, orderID string) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Set(types.GetMarketOrderIDKey(orderID), []byte{})
+}
+
+// DropMarketOrderID deletes the market order record from keeper
+func (k Keeper) DropMarketOrderID(ctx sdk.Context, orderID string) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Delete(types.GetMarketOrderIDKey(orderID))
+}
+
+// GetMarketOrderIDs gets all the market orders waiting for cancellation of their unfilled quantity
+func (k Keeper) GetMarketOrderIDs(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.MarketOrderIDKey)
+	defer iter.Close()
+
+	var orderIDs []string
+	for ; iter.Valid(); iter.Next() {
+		orderIDs = append(orderIDs, types.GetKey(iter))
+	}
+	return orderIDs
+}
+
+// PlaceMarketOrder places the market order into depth book with its slippage bounded price
+func (k Keeper) PlaceMarketOrder(ctx sdk.Context, order *types.Order) error {
+	order.OrderType = types.OrderTypeMarket
+	if err := k.PlaceOrder(ctx, order); err != nil {
+		return err
+	}
+	k.SetMarketOrderID(ctx, order.OrderID)
+	return nil
+}
+
+// CancelUnfilledMarketOrders cancels the unfilled quantity of market orders after matching.
+// Market orders of a locked product are left until the product is unlocked
+func (k Keeper) CancelUnfilledMarketOrders(ctx sdk.Context, logger log.Logger) {
+	for _, orderID := range k.GetMarketOrderIDs(ctx) {
+		order := k.GetOrder(ctx, orderID)
+		if order == nil || order.Status != types.OrderStatusOpen {
+			k.DropMarketOrderID(ctx, orderID)
+			continue
+		}
+		if k.IsProductLocked(ctx, order.Product) {
+			continue
+		}
+
+		k.CancelOrder(ctx, order, logger)
+		k.DropMarketOrderID(ctx, orderID)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeMarketOrderCancelled,
+			sdk.NewAttribute(types.AttributeKeyOrderID, order.OrderID),
+			sdk.NewAttribute(types.AttributeKeyProduct, order.Product),
+			sdk.NewAttribute(types.AttributeKeySide, order.Side),
+			sdk.NewAttribute(types.AttributeKeyFilledQuantity, order.Quantity.Sub(order.RemainQuantity).String()),
+			sdk.NewAttribute(types.AttributeKeyRemainQuantity, order.RemainQuantity.String()),
+		))
+		logger.Debug(fmt.Sprintf("unfilled market order (%s) cancelled, status: %s", order.OrderID,
+			types.OrderStatus(order.Status)))
+	}
+}
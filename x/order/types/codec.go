@@ -8,6 +8,7 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgNewOrders{}, "okexchain/order/MsgNew", nil)
 	cdc.RegisterConcrete(MsgCancelOrders{}, "okexchain/order/MsgCancel", nil)
+	cdc.RegisterConcrete(MsgNewMarketOrder{}, "okexchain/order/MsgNewMarket", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout this module
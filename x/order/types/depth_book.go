@@ -126,3 +126,37 @@ func (depthBook *DepthBook) Copy() *DepthBook {
 	itemList = append(itemList, depthBook.Items...)
 	return &DepthBook{Items: itemList}
 }
+
+// MarketOrderPrice : the limit price of a market order, which is the best opposite price in depth book
+// moved by maxSlippage, rounded to priceDigit without exceeding the slippage bound.
+// It returns false if there is no opposite order in depth book
+func (depthBook *DepthBook) MarketOrderPrice(side string, maxSlippage sdk.Dec, priceDigit int64) (sdk.Dec, bool) {
+	tick := sdk.NewDecWithPrec(1, priceDigit)
+	if side == BuyOrder {
+		// lowest sell price, items are sorted by price desc
+		for i := len(depthBook.Items) - 1; i >= 0; i-- {
+			if depthBook.Items[i].SellQuantity.IsPositive() {
+				bound := depthBook.Items[i].Price.Mul(sdk.OneDec().Add(maxSlippage))
+				price := bound.RoundDecimal(priceDigit)
+				if price.GT(bound) {
+					price = price.Sub(tick)
+				}
+				return price, true
+			}
+		}
+		return sdk.ZeroDec(), false
+	}
+
+	// highest buy price
+	for _, item := range depthBook.Items {
+		if item.BuyQuantity.IsPositive() {
+			bound := item.Price.Mul(sdk.OneDec().Sub(maxSlippage))
+			price := bound.RoundDecimal(priceDigit)
+			if price.LT(bound) {
+				price = price.Add(tick)
+			}
+			return price, price.IsPositive()
+		}
+	}
+	return sdk.ZeroDec(), false
+}
@@ -38,6 +38,8 @@ const (
 	CodeNotOrderOwner                         uint32 = 63026
 	CodeProductIsEmpty                        uint32 = 63027
 	CodeAllOrderFailedToExecute               uint32 = 63028
+	CodeInvalidMaxSlippage                    uint32 = 63029
+	CodeNoMarketLiquidity                     uint32 = 63030
 )
 
 func ErrInvalidAddress(address string) sdk.EnvelopedErr {
@@ -152,3 +154,11 @@ func ErrNotOrderOwner(orderID string) sdk.EnvelopedErr {
 func ErrAllOrderFailedToExecute() sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeAllOrderFailedToExecute, "all order items failed to execute")}
 }
+
+func ErrInvalidMaxSlippage(maxSlippage sdk.Dec) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidMaxSlippage, fmt.Sprintf("invalid max slippage: %s, it should be in [0, 1)", maxSlippage))}
+}
+
+func ErrNoMarketLiquidity(product, side string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNoMarketLiquidity, fmt.Sprintf("no opposite orders in depth book of %s for %s market order", product, side))}
+}
@@ -0,0 +1,16 @@
+package types
+
+// order module event types
+const (
+	EventTypeMarketOrder          = "market_order"
+	EventTypeMarketOrderCancelled = "market_order_cancelled"
+
+	AttributeKeyOrderID        = "order_id"
+	AttributeKeyProduct        = "product"
+	AttributeKeySide           = "side"
+	AttributeKeyPrice          = "price"
+	AttributeKeyQuantity       = "quantity"
+	AttributeKeyMaxSlippage    = "max_slippage"
+	AttributeKeyFilledQuantity = "filled_quantity"
+	AttributeKeyRemainQuantity = "remain_quantity"
+)
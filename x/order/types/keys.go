@@ -46,6 +46,9 @@ var (
 	LastExpiredBlockHeightKey = []byte{0x18}
 	OpenOrderNumKey           = []byte{0x19}
 	StoreOrderNumKey          = []byte{0x20}
+
+	// iterator key of market orders waiting for their unfilled quantity to be cancelled
+	MarketOrderIDKey = []byte{0x21}
 )
 
 // nolint
@@ -73,6 +76,11 @@ func GetOrderNumPerBlockKey(blockHeight int64) []byte {
 	return append(OrderNumPerBlockKey, sdk.Uint64ToBigEndian(uint64(blockHeight))...)
 }
 
+// nolint
+func GetMarketOrderIDKey(orderID string) []byte {
+	return append(MarketOrderIDKey, []byte(orderID)...)
+}
+
 // nolint
 func GetExpireBlockHeightKey(blockHeight int64) []byte {
 	return append(ExpireBlockHeightKey, sdk.Uint64ToBigEndian(uint64(blockHeight))...)
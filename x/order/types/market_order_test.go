@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/crypto/secp256k1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgNewMarketOrder_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := []struct {
+		name     string
+		msg      MsgNewMarketOrder
+		expError bool
+	}{
+		{"valid buy order", NewMsgNewMarketOrder(addr, "xxb_okt", BuyOrder, "1.0", "0.05"), false},
+		{"valid sell order without slippage", NewMsgNewMarketOrder(addr, "xxb_okt", SellOrder, "1.0", "0"), false},
+		{"empty sender", NewMsgNewMarketOrder(nil, "xxb_okt", BuyOrder, "1.0", "0.05"), true},
+		{"invalid product", NewMsgNewMarketOrder(addr, "xxb", BuyOrder, "1.0", "0.05"), true},
+		{"invalid side", NewMsgNewMarketOrder(addr, "xxb_okt", "HOLD", "1.0", "0.05"), true},
+		{"zero quantity", NewMsgNewMarketOrder(addr, "xxb_okt", BuyOrder, "0", "0.05"), true},
+		{"negative slippage", NewMsgNewMarketOrder(addr, "xxb_okt", BuyOrder, "1.0", "-0.05"), true},
+		{"slippage too large", NewMsgNewMarketOrder(addr, "xxb_okt", SellOrder, "1.0", "1"), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+	}
+}
+
+func TestDepthBook_MarketOrderPrice(t *testing.T) {
+	book := &DepthBook{Items: []DepthBookItem{
+		{Price: sdk.MustNewDecFromStr("10.5"), BuyQuantity: sdk.ZeroDec(), SellQuantity: sdk.OneDec()},
+		{Price: sdk.MustNewDecFromStr("10.3"), BuyQuantity: sdk.ZeroDec(), SellQuantity: sdk.OneDec()},
+		{Price: sdk.MustNewDecFromStr("9.9"), BuyQuantity: sdk.OneDec(), SellQuantity: sdk.ZeroDec()},
+	}}
+
+	// 10.3 * 1.05 = 10.815, rounded down into the slippage bound
+	price, ok := book.MarketOrderPrice(BuyOrder, sdk.MustNewDecFromStr("0.05"), 2)
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("10.81"), price)
+
+	// 9.9 * 0.95 = 9.405, rounded up into the slippage bound
+	price, ok = book.MarketOrderPrice(SellOrder, sdk.MustNewDecFromStr("0.05"), 2)
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("9.41"), price)
+
+	price, ok = book.MarketOrderPrice(SellOrder, sdk.ZeroDec(), 2)
+	require.True(t, ok)
+	require.Equal(t, sdk.MustNewDecFromStr("9.9"), price)
+
+	_, ok = (&DepthBook{Items: book.Items[:2]}).MarketOrderPrice(SellOrder, sdk.ZeroDec(), 2)
+	require.False(t, ok)
+}
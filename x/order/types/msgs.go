@@ -142,6 +142,77 @@ func (msg MsgNewOrders) CalculateGas(gasUnit uint64) uint64 {
 	return uint64(len(msg.OrderItems)) * gasUnit
 }
 
+// nolint
+type MsgNewMarketOrder struct {
+	Sender      sdk.AccAddress `json:"sender"`       // order maker address
+	Product     string         `json:"product"`      // product for trading pair in full name of the tokens
+	Side        string         `json:"side"`         // BUY/SELL
+	Quantity    sdk.Dec        `json:"quantity"`     // quantity of the order
+	MaxSlippage sdk.Dec        `json:"max_slippage"` // max deviation from the best opposite price, e.g. 0.05 means 5%
+}
+
+// NewMsgNewMarketOrder is a constructor function for MsgNewMarketOrder
+func NewMsgNewMarketOrder(sender sdk.AccAddress, product string, side string, quantity string,
+	maxSlippage string) MsgNewMarketOrder {
+	return MsgNewMarketOrder{
+		Sender:      sender,
+		Product:     product,
+		Side:        side,
+		Quantity:    sdk.MustNewDecFromStr(quantity),
+		MaxSlippage: sdk.MustNewDecFromStr(maxSlippage),
+	}
+}
+
+// nolint
+func (msg MsgNewMarketOrder) Route() string { return "order" }
+
+// nolint
+func (msg MsgNewMarketOrder) Type() string { return "market" }
+
+// ValidateBasic : Implements Msg.
+func (msg MsgNewMarketOrder) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrInvalidAddress(msg.Sender.String())
+	}
+	if len(msg.Product) == 0 {
+		return ErrOrderItemProductCountsIsEmpty()
+	}
+	symbols := strings.Split(msg.Product, "_")
+	if len(symbols) != 2 {
+		return ErrOrderItemProductFormat()
+	}
+	if symbols[0] == symbols[1] {
+		return ErrOrderItemProductSymbolIsEqual()
+	}
+	if msg.Side != BuyOrder && msg.Side != SellOrder {
+		return ErrOrderItemSideIsNotBuyAndSell()
+	}
+	if !msg.Quantity.IsPositive() {
+		return ErrOrderItemPriceOrQuantityIsNotPositive()
+	}
+	if msg.MaxSlippage.IsNil() || msg.MaxSlippage.IsNegative() || msg.MaxSlippage.GTE(sdk.OneDec()) {
+		return ErrInvalidMaxSlippage(msg.MaxSlippage)
+	}
+
+	return nil
+}
+
+// GetSignBytes : encodes the message for signing
+func (msg MsgNewMarketOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgNewMarketOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// Calculate customize gas
+func (msg MsgNewMarketOrder) CalculateGas(gasUnit uint64) uint64 {
+	return gasUnit
+}
+
 // nolint
 type MsgCancelOrders struct {
 	Sender   sdk.AccAddress `json:"sender"` // order maker address
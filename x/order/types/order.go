@@ -52,6 +52,12 @@ const (
 	//OrderStatusPartialFilled          = 6
 )
 
+// nolint
+const (
+	OrderTypeLimit  = ""
+	OrderTypeMarket = "MARKET"
+)
+
 // nolint
 const (
 	OrderExtraInfoKeyNewFee     = "newFee"
@@ -77,7 +83,8 @@ type Order struct {
 	Timestamp         int64          `json:"timestamp"`        // created timestamp
 	OrderExpireBlocks int64          `json:"order_expire_blocks"`
 	FeePerBlock       sdk.SysCoin    `json:"fee_per_block"`
-	ExtraInfo         string         `json:"extra_info"` // extra info of order in json format
+	ExtraInfo         string         `json:"extra_info"`           // extra info of order in json format
+	OrderType         string         `json:"order_type,omitempty"` // empty for limit orders, see OrderTypeXXX
 }
 
 // nolint
@@ -104,6 +111,11 @@ func NewOrder(txHash string, sender sdk.AccAddress, product, side string, price,
 	return order
 }
 
+// IsMarketOrder returns true if the unfilled quantity of the order is cancelled right after matching
+func (order *Order) IsMarketOrder() bool {
+	return order.OrderType == OrderTypeMarket
+}
+
 func (order *Order) String() string {
 	if orderJSON, err := json.Marshal(order); err != nil {
 		panic(err)
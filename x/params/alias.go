@@ -30,6 +30,8 @@ type (
 	ParamChange = sdkparams.ParamChange
 	// ParameterChangeProposal is alias of ParameterChangeProposal in types
 	ParameterChangeProposal = types.ParameterChangeProposal
+	// HaltSchedule is alias of HaltSchedule in types
+	HaltSchedule = types.HaltSchedule
 )
 
 var (
@@ -9,6 +9,7 @@ import (
 
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 )
 
@@ -21,6 +22,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	queryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdQueryParamChanges(queryRoute, cdc),
+		GetCmdQueryHaltSchedule(queryRoute, cdc),
+		GetCmdQueryCosmosGasFactor(queryRoute, cdc),
 	)...)
 
 	return queryCmd
@@ -51,3 +55,93 @@ $ exchaincli query params params
 		},
 	}
 }
+
+// GetCmdQueryParamChanges implements the query param changes command.
+func GetCmdQueryParamChanges(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "changes [subspace]",
+		Short: "Query the audit trail of the param changes",
+		Long: strings.TrimSpace(`Query the params changed by the proposals, with their old and new values, the proposal ID
+and the height of the change. The changes of all subspaces are queried if no subspace is given:
+
+$ exchaincli query params changes
+$ exchaincli query params changes evm
+`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var subspace string
+			if len(args) == 1 {
+				subspace = args[0]
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryParamChangesParams(subspace))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParamChanges)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var records []types.ParamChangeRecord
+			cdc.MustUnmarshalJSON(res, &records)
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
+
+// GetCmdQueryHaltSchedule implements the query halt schedule command.
+func GetCmdQueryHaltSchedule(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "halt",
+		Short: "Query the halt of the chain scheduled by governance",
+		Long: strings.TrimSpace(`Query the height and the unix time of the planned halt of the chain, 0 means not scheduled:
+
+$ exchaincli query params halt
+`),
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryHaltSchedule)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var schedule types.HaltSchedule
+			cdc.MustUnmarshalJSON(bz, &schedule)
+			return cliCtx.PrintOutput(schedule)
+		},
+	}
+}
+
+// GetCmdQueryCosmosGasFactor implements the query cosmos gas factor command.
+func GetCmdQueryCosmosGasFactor(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cosmos-gas-factor",
+		Short: "Query the factor converting the gas of the cosmos txs to the gas of the block",
+		Long: strings.TrimSpace(`Query the factor converting the gas of the cosmos txs to the gas of the block, the evm and
+cosmos txs of a block share its gas limit:
+
+$ exchaincli query params cosmos-gas-factor
+`),
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCosmosGasFactor)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var factor sdk.Dec
+			cdc.MustUnmarshalJSON(bz, &factor)
+			return cliCtx.PrintOutput(factor)
+		},
+	}
+}
@@ -1,6 +1,8 @@
 package params
 
 import (
+	"fmt"
+
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdkparams "github.com/okex/exchain/libs/cosmos-sdk/x/params"
@@ -10,7 +12,8 @@ import (
 
 // Keeper is the struct of params keeper
 type Keeper struct {
-	cdc *codec.Codec
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
 	sdkparams.Keeper
 	// the reference to the Paramstore to get and set gov specific params
 	paramSpace sdkparams.Subspace
@@ -21,17 +24,22 @@ type Keeper struct {
 	// the reference to the GovKeeper to insert waiting queue
 	gk      GovKeeper
 	signals []func()
+	// the validators of the params of the subspaces, run on the params changed by a proposal
+	validators map[string][]types.ParamsValidator
 }
 
 // NewKeeper creates a new instance of params keeper
 func NewKeeper(cdc *codec.Codec, key *sdk.KVStoreKey, tkey *sdk.TransientStoreKey) (
 	k Keeper) {
 	k = Keeper{
-		Keeper:  sdkparams.NewKeeper(cdc, key, tkey),
-		signals: make([]func(), 0),
+		Keeper:     sdkparams.NewKeeper(cdc, key, tkey),
+		signals:    make([]func(), 0),
+		validators: make(map[string][]types.ParamsValidator),
 	}
 	k.cdc = cdc
+	k.storeKey = key
 	k.paramSpace = k.Subspace(DefaultParamspace).WithKeyTable(types.ParamKeyTable())
+	k.validators[DefaultParamspace] = []types.ParamsValidator{k.validateHaltSchedule}
 	return k
 }
 
@@ -61,3 +69,72 @@ func (keeper Keeper) GetParams(ctx sdk.Context) types.Params {
 	keeper.paramSpace.GetParamSet(ctx, &params)
 	return params
 }
+
+// GetHaltSchedule gets the halt of the chain scheduled by governance
+func (keeper Keeper) GetHaltSchedule(ctx sdk.Context) (schedule types.HaltSchedule) {
+	keeper.paramSpace.GetIfExists(ctx, types.KeyHaltHeight, &schedule.Height)
+	keeper.paramSpace.GetIfExists(ctx, types.KeyHaltTime, &schedule.Time)
+	return
+}
+
+// SetHaltSchedule sets the halt of the chain, a zero schedule cancels it
+func (keeper Keeper) SetHaltSchedule(ctx sdk.Context, schedule types.HaltSchedule) {
+	keeper.paramSpace.Set(ctx, types.KeyHaltHeight, &schedule.Height)
+	keeper.paramSpace.Set(ctx, types.KeyHaltTime, &schedule.Time)
+}
+
+// GetCosmosGasFactor gets the factor converting the gas of the cosmos txs to the gas of the block
+func (keeper Keeper) GetCosmosGasFactor(ctx sdk.Context) sdk.Dec {
+	factor := types.DefaultCosmosGasFactor()
+	keeper.paramSpace.GetIfExists(ctx, types.KeyCosmosGasFactor, &factor)
+	return factor
+}
+
+// SetCosmosGasFactor sets the factor converting the gas of the cosmos txs to the gas of the block
+func (keeper Keeper) SetCosmosGasFactor(ctx sdk.Context, factor sdk.Dec) {
+	keeper.paramSpace.Set(ctx, types.KeyCosmosGasFactor, &factor)
+}
+
+// ConsumeHaltSchedule clears the halt schedule and returns true if the block of ctx is the last one before the halt,
+// so that the chain resumes once the nodes are restarted
+func (keeper Keeper) ConsumeHaltSchedule(ctx sdk.Context) (types.HaltSchedule, bool) {
+	schedule := keeper.GetHaltSchedule(ctx)
+	if schedule.IsZero() || !schedule.Reached(ctx.BlockHeight(), ctx.BlockTime()) {
+		return schedule, false
+	}
+
+	keeper.SetHaltSchedule(ctx, types.HaltSchedule{})
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeHalt,
+		sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", schedule.Height)),
+		sdk.NewAttribute(types.AttributeKeyHaltTime, fmt.Sprintf("%d", schedule.Time)),
+	))
+	return schedule, true
+}
+
+// validateHaltSchedule rejects the proposals scheduling a halt that is already reached
+func (keeper Keeper) validateHaltSchedule(ctx sdk.Context) error {
+	return keeper.GetHaltSchedule(ctx).ValidateAt(ctx.BlockHeight(), ctx.BlockTime())
+}
+
+// recordParamChange appends the index-th param changed by a proposal to the audit trail of the param changes
+func (keeper Keeper) recordParamChange(ctx sdk.Context, record types.ParamChangeRecord, index int) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetParamChangeKey(record.Height, record.ProposalID, index), keeper.cdc.MustMarshalBinaryLengthPrefixed(record))
+}
+
+// GetParamChanges gets the audit trail of the changes of the params in a subspace, or in all subspaces if subspace
+// is empty, sorted by height
+func (keeper Keeper) GetParamChanges(ctx sdk.Context, subspace string) (records []types.ParamChangeRecord) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), types.ParamChangeKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ParamChangeRecord
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		if len(subspace) == 0 || record.Subspace == subspace {
+			records = append(records, record)
+		}
+	}
+	return
+}
@@ -0,0 +1,88 @@
+package params
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+
+	"github.com/okex/exchain/x/params/types"
+)
+
+func newParamProposal(key []byte, value string) types.ParameterChangeProposal {
+	return types.NewParameterChangeProposal("title", "description",
+		[]ParamChange{NewParamChange(DefaultParamspace, string(key), value)}, 0)
+}
+
+func TestHaltSchedule(t *testing.T) {
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+
+	ctx, keeper := createTestInput(t)
+	blockTime := time.Unix(1700000000, 0)
+	ctx = ctx.WithBlockTime(blockTime)
+	require.True(t, keeper.GetHaltSchedule(ctx).IsZero())
+
+	// the halt must be ahead of the current block
+	cacheCtx, _ := ctx.CacheContext()
+	require.Error(t, changeParams(cacheCtx, &keeper, newParamProposal(types.KeyHaltHeight, `"10"`), 1))
+	cacheCtx, _ = ctx.CacheContext()
+	require.Error(t, changeParams(cacheCtx, &keeper, newParamProposal(types.KeyHaltTime, `"1700000000"`), 2))
+	require.True(t, keeper.GetHaltSchedule(ctx).IsZero())
+
+	require.NoError(t, changeParams(ctx, &keeper, newParamProposal(types.KeyHaltHeight, `"15"`), 3))
+	require.Equal(t, types.NewHaltSchedule(15, 0), keeper.GetHaltSchedule(ctx))
+
+	querier := NewQuerier(keeper)
+	bz, err := querier(ctx, []string{types.QueryHaltSchedule}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var schedule types.HaltSchedule
+	keeper.cdc.MustUnmarshalJSON(bz, &schedule)
+	require.Equal(t, types.NewHaltSchedule(15, 0), schedule)
+
+	_, reached := keeper.ConsumeHaltSchedule(ctx.WithBlockHeight(14))
+	require.False(t, reached)
+	schedule, reached = keeper.ConsumeHaltSchedule(ctx.WithBlockHeight(15))
+	require.True(t, reached)
+	require.Equal(t, types.NewHaltSchedule(15, 0), schedule)
+	// the schedule is consumed, so that the chain resumes after the restart
+	require.True(t, keeper.GetHaltSchedule(ctx).IsZero())
+	_, reached = keeper.ConsumeHaltSchedule(ctx.WithBlockHeight(16))
+	require.False(t, reached)
+
+	require.NoError(t, changeParams(ctx, &keeper, newParamProposal(types.KeyHaltTime, `"1700000060"`), 4))
+	_, reached = keeper.ConsumeHaltSchedule(ctx.WithBlockTime(blockTime.Add(time.Second * 59)))
+	require.False(t, reached)
+	_, reached = keeper.ConsumeHaltSchedule(ctx.WithBlockTime(blockTime.Add(time.Second * 61)))
+	require.True(t, reached)
+	require.True(t, keeper.GetHaltSchedule(ctx).IsZero())
+}
+
+func TestCosmosGasFactor(t *testing.T) {
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+
+	ctx, keeper := createTestInput(t)
+	require.True(t, keeper.GetCosmosGasFactor(ctx).Equal(types.DefaultCosmosGasFactor()))
+
+	// the factor must be positive
+	for _, value := range []string{`"0"`, `"-1.5"`, `"abc"`} {
+		cacheCtx, _ := ctx.CacheContext()
+		require.Error(t, changeParams(cacheCtx, &keeper, newParamProposal(types.KeyCosmosGasFactor, value), 1))
+	}
+	require.True(t, keeper.GetCosmosGasFactor(ctx).Equal(types.DefaultCosmosGasFactor()))
+
+	require.NoError(t, changeParams(ctx, &keeper, newParamProposal(types.KeyCosmosGasFactor, `"2.5"`), 2))
+	require.True(t, keeper.GetCosmosGasFactor(ctx).Equal(sdk.NewDecWithPrec(25, 1)))
+
+	querier := NewQuerier(keeper)
+	bz, err := querier(ctx, []string{types.QueryCosmosGasFactor}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var factor sdk.Dec
+	keeper.cdc.MustUnmarshalJSON(bz, &factor)
+	require.True(t, factor.Equal(sdk.NewDecWithPrec(25, 1)))
+}
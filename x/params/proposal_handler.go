@@ -12,6 +12,7 @@ import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 	sdkparams "github.com/okex/exchain/libs/cosmos-sdk/x/params"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
 )
 
 // NewParamChangeProposalHandler returns the rollback function of the param proposal handler
@@ -37,21 +38,31 @@ func handleParameterChangeProposal(ctx sdk.Context, k *Keeper, proposal *govtype
 	}
 
 	defer k.gk.RemoveFromWaitingProposalQueue(ctx, paramProposal.Height, proposal.ProposalID)
-	return changeParams(ctx, k, paramProposal)
+	return changeParams(ctx, k, paramProposal, proposal.ProposalID)
 }
 
-func changeParams(ctx sdk.Context, k *Keeper, paramProposal types.ParameterChangeProposal) sdk.Error {
+func changeParams(ctx sdk.Context, k *Keeper, paramProposal types.ParameterChangeProposal, proposalID uint64) sdk.Error {
 	defer k.signalUpdate()
-	for _, c := range paramProposal.Changes {
+	for i, c := range paramProposal.Changes {
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
 			return sdkerrors.Wrap(sdkparams.ErrUnknownSubspace, c.Subspace)
 		}
 
+		oldValue := ss.GetRaw(ctx, []byte(c.Key))
 		err := ss.Update(ctx, []byte(c.Key), []byte(c.Value))
 		if err != nil {
 			return sdkerrors.Wrap(sdkparams.ErrSettingParameter, err.Error())
 		}
+
+		// the audit trail records the whole value of the param after the change
+		if tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+			k.recordParamChange(ctx, types.NewParamChangeRecord(c.Subspace, c.Key, string(oldValue),
+				string(ss.GetRaw(ctx, []byte(c.Key))), proposalID, ctx.BlockHeight()), i)
+		}
+	}
+	if tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+		return k.validateParams(ctx, paramProposal.Changes)
 	}
 	return nil
 }
@@ -59,6 +70,28 @@ func changeParams(ctx sdk.Context, k *Keeper, paramProposal types.ParameterChang
 func (k *Keeper) RegisterSignal(handler func()) {
 	k.signals = append(k.signals, handler)
 }
+
+// RegisterParamsValidator registers a validator of the params in a subspace, which is run when a proposal changes
+// any of them
+func (k *Keeper) RegisterParamsValidator(subspace string, validator types.ParamsValidator) {
+	k.validators[subspace] = append(k.validators[subspace], validator)
+}
+
+func (k *Keeper) validateParams(ctx sdk.Context, changes []types.ParamChange) sdk.Error {
+	validated := make(map[string]bool)
+	for _, c := range changes {
+		if validated[c.Subspace] {
+			continue
+		}
+		validated[c.Subspace] = true
+		for _, validator := range k.validators[c.Subspace] {
+			if err := validator(ctx); err != nil {
+				return sdkerrors.Wrap(sdkparams.ErrSettingParameter, fmt.Sprintf("invalid %s params: %s", c.Subspace, err))
+			}
+		}
+	}
+	return nil
+}
 func (k *Keeper) signalUpdate() {
 	for i, _ := range k.signals {
 		k.signals[i]()
@@ -136,7 +169,7 @@ func (keeper Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg govtypes.MsgSub
 
 	// run simulation with cache context
 	cacheCtx, _ := ctx.CacheContext()
-	return changeParams(cacheCtx, &keeper, paramsChangeProposal)
+	return changeParams(cacheCtx, &keeper, paramsChangeProposal, 0)
 }
 
 // nolint
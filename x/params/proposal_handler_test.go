@@ -0,0 +1,93 @@
+package params
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/store"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	dbm "github.com/okex/exchain/libs/tm-db"
+
+	"github.com/okex/exchain/x/params/types"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper) {
+	keyParams := sdk.NewKVStoreKey(StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	keeper := NewKeeper(codec.New(), keyParams, tkeyParams)
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10}, false, log.NewNopLogger())
+	keeper.SetParams(ctx, types.DefaultParams())
+	return ctx, keeper
+}
+
+func newMaxBlockHeightProposal(value string) types.ParameterChangeProposal {
+	return types.NewParameterChangeProposal("title", "description",
+		[]ParamChange{NewParamChange(DefaultParamspace, string(types.KeyMaxBlockHeight), value)}, 0)
+}
+
+func TestParamChangeAuditTrail(t *testing.T) {
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+
+	ctx, keeper := createTestInput(t)
+	cdc := keeper.cdc
+	change := func(ctx sdk.Context, value string, proposalID uint64) {
+		require.NoError(t, changeParams(ctx, &keeper, newMaxBlockHeightProposal(value), proposalID))
+	}
+	change(ctx, `"200000"`, 1)
+	change(ctx.WithBlockHeight(20), `"300000"`, 2)
+	// no change is recorded before venus5
+	change(ctx.WithBlockHeight(1), `"400000"`, 3)
+	require.Equal(t, uint64(400000), keeper.GetParams(ctx).MaxBlockHeight)
+
+	expected := []types.ParamChangeRecord{
+		types.NewParamChangeRecord(DefaultParamspace, "MaxBlockHeight", `"100000"`, `"200000"`, 1, 10),
+		types.NewParamChangeRecord(DefaultParamspace, "MaxBlockHeight", `"200000"`, `"300000"`, 2, 20),
+	}
+	require.Equal(t, expected, keeper.GetParamChanges(ctx, ""))
+	require.Equal(t, expected, keeper.GetParamChanges(ctx, DefaultParamspace))
+	require.Empty(t, keeper.GetParamChanges(ctx, "evm"))
+
+	querier := NewQuerier(keeper)
+	bz, err := querier(ctx, []string{types.QueryParamChanges},
+		abci.RequestQuery{Data: cdc.MustMarshalJSON(types.NewQueryParamChangesParams(DefaultParamspace))})
+	require.NoError(t, err)
+	var records []types.ParamChangeRecord
+	cdc.MustUnmarshalJSON(bz, &records)
+	require.Equal(t, expected, records)
+}
+
+func TestParamsValidator(t *testing.T) {
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+
+	ctx, keeper := createTestInput(t)
+	// a copy of the keeper made before the registration validates the params too
+	copied := keeper
+	keeper.RegisterParamsValidator(DefaultParamspace, func(ctx sdk.Context) error {
+		if params := keeper.GetParams(ctx); params.MaxBlockHeight > 500000 {
+			return fmt.Errorf("max block height %d is over 500000", params.MaxBlockHeight)
+		}
+		return nil
+	})
+
+	require.NoError(t, changeParams(ctx, &copied, newMaxBlockHeightProposal(`"500000"`), 1))
+	cacheCtx, _ := ctx.CacheContext()
+	require.Error(t, changeParams(cacheCtx, &copied, newMaxBlockHeightProposal(`"600000"`), 2))
+	require.Equal(t, uint64(500000), keeper.GetParams(ctx).MaxBlockHeight)
+
+	// the params aren't validated before venus5
+	require.NoError(t, changeParams(ctx.WithBlockHeight(1), &copied, newMaxBlockHeightProposal(`"600000"`), 3))
+}
@@ -16,6 +16,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryParams:
 			return queryParams(ctx, req, keeper)
+		case types.QueryParamChanges:
+			return queryParamChanges(ctx, req, keeper)
+		case types.QueryHaltSchedule:
+			return queryHaltSchedule(ctx, keeper)
+		case types.QueryCosmosGasFactor:
+			return queryCosmosGasFactor(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown params query endpoint")
 		}
@@ -29,3 +35,38 @@ func queryParams(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, s
 	}
 	return bz, nil
 }
+
+func queryParamChanges(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryParamChangesParams
+	if len(req.Data) != 0 {
+		if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	records := keeper.GetParamChanges(ctx, params.Subspace)
+	if records == nil {
+		records = []types.ParamChangeRecord{}
+	}
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, records)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryHaltSchedule(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetHaltSchedule(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryCosmosGasFactor(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetCosmosGasFactor(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	QueryCosmosGasFactor = "cosmos_gas_factor"
+)
+
+var (
+	// KeyCosmosGasFactor is the key of the factor converting the gas of the cosmos txs to the gas of the block, which
+	// is shared with the evm txs. It isn't part of Params and is absent on the existing chains until it's set.
+	KeyCosmosGasFactor = []byte("CosmosGasFactor")
+)
+
+// DefaultCosmosGasFactor returns the factor of the chains where it isn't set, the cosmos txs take their gas as it is
+func DefaultCosmosGasFactor() sdk.Dec {
+	return sdk.OneDec()
+}
+
+func validateCosmosGasFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("cosmos gas factor must be positive: %s", v)
+	}
+	return nil
+}
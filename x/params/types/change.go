@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// ParamChangeRecord is the record of a param changed by a proposal in the audit trail of the param changes
+type ParamChangeRecord struct {
+	Subspace   string `json:"subspace"`
+	Key        string `json:"key"`
+	OldValue   string `json:"old_value"`
+	NewValue   string `json:"new_value"`
+	ProposalID uint64 `json:"proposal_id"`
+	Height     int64  `json:"height"`
+}
+
+// NewParamChangeRecord creates a new instance of ParamChangeRecord
+func NewParamChangeRecord(subspace, key, oldValue, newValue string, proposalID uint64, height int64) ParamChangeRecord {
+	return ParamChangeRecord{
+		Subspace:   subspace,
+		Key:        key,
+		OldValue:   oldValue,
+		NewValue:   newValue,
+		ProposalID: proposalID,
+		Height:     height,
+	}
+}
+
+func (r ParamChangeRecord) String() string {
+	return fmt.Sprintf(`Param Change:
+  Subspace:    %s
+  Key:         %s
+  Old Value:   %s
+  New Value:   %s
+  Proposal ID: %d
+  Height:      %d`, r.Subspace, r.Key, r.OldValue, r.NewValue, r.ProposalID, r.Height)
+}
+
+// GetParamChangeKey gets the key of the record of the index-th param changed by a proposal at a height,
+// the records are sorted by height
+func GetParamChangeKey(height int64, proposalID uint64, index int) []byte {
+	key := append(ParamChangeKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+	key = append(key, sdk.Uint64ToBigEndian(proposalID)...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(index))...)
+}
+
+// QueryParamChangesParams is the params of the query of the param changes, the changes of all subspaces are
+// queried if Subspace is empty
+type QueryParamChangesParams struct {
+	Subspace string `json:"subspace"`
+}
+
+// NewQueryParamChangesParams creates a new instance of QueryParamChangesParams
+func NewQueryParamChangesParams(subspace string) QueryParamChangesParams {
+	return QueryParamChangesParams{Subspace: subspace}
+}
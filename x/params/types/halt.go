@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	QueryHaltSchedule = "halt"
+
+	EventTypeHalt        = "halt"
+	AttributeKeyHeight   = "height"
+	AttributeKeyHaltTime = "halt_time"
+)
+
+var (
+	// KeyHaltHeight and KeyHaltTime are the keys of the halt schedule set by governance, they aren't part of Params
+	// and are absent on the existing chains until they're set
+	KeyHaltHeight = []byte("HaltHeight")
+	KeyHaltTime   = []byte("HaltTime")
+)
+
+// HaltSchedule is the planned halt of the chain set by governance. The chain halts after the commit of the first block
+// at or above Height, or with a block time at or after Time in unix seconds, 0 means not set for both.
+type HaltSchedule struct {
+	Height uint64 `json:"height"`
+	Time   uint64 `json:"time"`
+}
+
+// NewHaltSchedule creates a new instance of HaltSchedule
+func NewHaltSchedule(height, time uint64) HaltSchedule {
+	return HaltSchedule{Height: height, Time: time}
+}
+
+// IsZero returns true if no halt is scheduled
+func (s HaltSchedule) IsZero() bool {
+	return s.Height == 0 && s.Time == 0
+}
+
+// Reached returns true if the block of the height and the time is the last one before the halt
+func (s HaltSchedule) Reached(height int64, blockTime time.Time) bool {
+	return (s.Height > 0 && uint64(height) >= s.Height) || (s.Time > 0 && blockTime.Unix() >= int64(s.Time))
+}
+
+// ValidateAt checks that the schedule is still ahead of the block of the height and the time
+func (s HaltSchedule) ValidateAt(height int64, blockTime time.Time) error {
+	if s.Height > 0 && s.Height <= uint64(height) {
+		return fmt.Errorf("halt height %d must be higher than the current height %d", s.Height, height)
+	}
+	if s.Time > 0 && int64(s.Time) <= blockTime.Unix() {
+		return fmt.Errorf("halt time %d must be later than the current block time %d", s.Time, blockTime.Unix())
+	}
+	return nil
+}
+
+func (s HaltSchedule) String() string {
+	return fmt.Sprintf(`Height: %d,
+Time:   %d,
+`, s.Height, s.Time)
+}
+
+func validateHaltValue(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
@@ -7,3 +7,9 @@ var (
 	KeyVotingPeriod     = []byte("VotingPeriod")
 	KeyMaxBlockHeight   = []byte("MaxBlockHeight")
 )
+
+var (
+	// ParamChangeKeyPrefix is the prefix of the audit trail of the param changes in the params store, which never
+	// collides with the subspaces prefixed by their names
+	ParamChangeKeyPrefix = []byte{0x01}
+)
@@ -12,12 +12,20 @@ import (
 )
 
 const (
-	QueryParams = "params"
+	QueryParams       = "params"
+	QueryParamChanges = "changes"
 )
 
-// ParamKeyTable returns the key declaration for parameters
+// ParamsValidator validates the params of a module with the changes of a proposal applied in ctx, so that a proposal
+// leaving the params in an invalid state is rejected when submitted rather than executed
+type ParamsValidator func(ctx sdk.Context) error
+
+// ParamKeyTable returns the key declaration for parameters, including the halt schedule and the cosmos gas factor
 func ParamKeyTable() sdkparams.KeyTable {
-	return sdkparams.NewKeyTable().RegisterParamSet(&Params{})
+	return sdkparams.NewKeyTable().RegisterParamSet(&Params{}).
+		RegisterType(sdkparams.NewParamSetPair(KeyHaltHeight, new(uint64), validateHaltValue)).
+		RegisterType(sdkparams.NewParamSetPair(KeyHaltTime, new(uint64), validateHaltValue)).
+		RegisterType(sdkparams.NewParamSetPair(KeyCosmosGasFactor, new(sdk.Dec), validateCosmosGasFactor))
 }
 
 // Params is the struct of the parameters in this module
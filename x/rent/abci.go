@@ -0,0 +1,43 @@
+package rent
+
+import (
+	"strconv"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/rent/keeper"
+	"github.com/okex/exchain/x/rent/types"
+)
+
+// EndBlocker charges the due rents up to the max charges per block if the rent is enabled, the rest of the due rents
+// are charged first in the following blocks. A contract whose balance can't cover its rent is hibernated instead
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return
+	}
+	for _, due := range k.GetDueContractRents(ctx, params.MaxChargesPerBlock) {
+		rent, cost := k.ChargeRent(ctx, due, params)
+		if rent.Hibernated {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeHibernate,
+				sdk.NewAttribute(types.AttributeKeyContract, rent.Contract.String()),
+				sdk.NewAttribute(types.AttributeKeyFootprint, strconv.FormatUint(rent.Footprint(), 10)),
+				sdk.NewAttribute(types.AttributeKeyBalance, rent.Balance.String()),
+			))
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeChargeRent,
+			sdk.NewAttribute(types.AttributeKeyContract, rent.Contract.String()),
+			sdk.NewAttribute(types.AttributeKeyFootprint, strconv.FormatUint(rent.Footprint(), 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, cost.String()),
+			sdk.NewAttribute(types.AttributeKeyNextHeight, strconv.FormatInt(rent.NextHeight, 10)),
+		))
+	}
+}
@@ -0,0 +1,21 @@
+package rent
+
+import (
+	"github.com/okex/exchain/x/rent/keeper"
+	"github.com/okex/exchain/x/rent/types"
+)
+
+const (
+	ModuleName   = types.ModuleName
+	StoreKey     = types.StoreKey
+	RouterKey    = types.RouterKey
+	QuerierRoute = types.QuerierRoute
+)
+
+var (
+	NewKeeper = keeper.NewKeeper
+)
+
+type (
+	Keeper = keeper.Keeper
+)
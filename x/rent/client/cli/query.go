@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/x/rent/types"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	// Group rent queries under a subcommand
+	rentQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	rentQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryContract(queryRoute, cdc),
+			GetCmdQueryHibernated(queryRoute, cdc),
+			GetCmdQueryParams(queryRoute, cdc),
+		)...,
+	)
+
+	return rentQueryCmd
+}
+
+// GetCmdQueryContract gets the contract rent query command.
+func GetCmdQueryContract(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "contract [contract]",
+		Short: "query the rent of a contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the storage footprint of a contract, the balance prepaid for its rent and the height of
+its next charge. The contract can be given as a bech32 address or a 0x prefixed hex address.
+
+Example:
+$ %s query rent contract 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryContractParams(contract))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryContract)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var rent types.ContractRent
+			cdc.MustUnmarshalJSON(resp, &rent)
+			return cliCtx.PrintOutput(rent)
+		},
+	}
+}
+
+// GetCmdQueryHibernated gets the hibernated contracts query command.
+func GetCmdQueryHibernated(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "hibernated",
+		Short: "query the rents of the hibernated contracts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rents of the contracts hibernated for running out of their balances.
+
+Example:
+$ %s query rent hibernated
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryHibernated)
+			resp, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var rents types.ContractRents
+			cdc.MustUnmarshalJSON(resp, &rents)
+			return cliCtx.PrintOutput(rents)
+		},
+	}
+}
+
+// GetCmdQueryParams gets the rent params query command.
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "query the current rent parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as rent parameters.
+
+Example:
+$ %s query rent params
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParameters)
+			resp, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(resp, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	"github.com/okex/exchain/x/rent/types"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	rentTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		SuggestionsMinimumDistance: 2,
+	}
+
+	rentTxCmd.AddCommand(client.PostCommands(
+		GetCmdDepositRent(cdc),
+		GetCmdRestoreContract(cdc),
+	)...)
+	return rentTxCmd
+}
+
+// GetCmdDepositRent gets the deposit rent command
+func GetCmdDepositRent(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit [contract] [amount]",
+		Short: "top up the balance prepaid for the rent of a contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Top up the balance paying the rents of the storage footprint of a contract, anyone can deposit
+to any contract tracked by the rent module. The contract is hibernated once its balance can't cover its rent.
+
+Example:
+$ %s tx rent deposit 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed 1okt --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseDecCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositRent(cliCtx.GetFromAddress(), contract, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdRestoreContract gets the restore contract command
+func GetCmdRestoreContract(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [contract]",
+		Short: "restore a hibernated contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Restore a hibernated contract, the rent of a period is charged from its balance which must be
+topped up first to cover it.
+
+Example:
+$ %s tx rent restore 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRestoreContract(cliCtx.GetFromAddress(), contract)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
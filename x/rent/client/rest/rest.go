@@ -0,0 +1,83 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/rest"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/rent/types"
+)
+
+// RegisterRoutes registers rent-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// get the rent of a contract
+	r.HandleFunc(
+		"/rent/contracts/{contract}",
+		queryContractHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get the rents of the hibernated contracts
+	r.HandleFunc(
+		"/rent/hibernated",
+		queryHibernatedHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get the rent params
+	r.HandleFunc(
+		"/rent/params",
+		queryParamsHandlerFn(cliCtx),
+	).Methods("GET")
+}
+
+func queryContractHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		contract, err := sdk.AccAddressFromBech32(mux.Vars(r)["contract"])
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeCreateAddrFromBech32Failed, err.Error())
+			return
+		}
+		queryWithParams(w, r, cliCtx, types.QueryContract, types.NewQueryContractParams(contract))
+	}
+}
+
+func queryHibernatedHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		queryWithParams(w, r, cliCtx, types.QueryHibernated, nil)
+	}
+}
+
+func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		queryWithParams(w, r, cliCtx, types.QueryParameters, nil)
+	}
+}
+
+func queryWithParams(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, path string, params interface{}) {
+	cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+	if !ok {
+		return
+	}
+
+	var jsonBytes []byte
+	if params != nil {
+		var err error
+		if jsonBytes, err = cliCtx.Codec.MarshalJSON(params); err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorCodecFails)
+			return
+		}
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+	res, height, err := cliCtx.QueryWithData(route, jsonBytes)
+	if err != nil {
+		common.HandleErrorResponseV2(w, http.StatusInternalServerError, common.ErrorABCIQueryFails)
+		return
+	}
+
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, res)
+}
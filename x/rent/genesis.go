@@ -0,0 +1,36 @@
+package rent
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/rent/keeper"
+	"github.com/okex/exchain/x/rent/types"
+)
+
+// InitGenesis initializes the rent module's state from a given genesis state
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	// if module account doesn't exist, it will create automatically
+	moduleAcc := k.SupplyKeeper().GetModuleAccount(ctx, types.ModuleName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	k.SetParams(ctx, data.Params)
+
+	// the module account must cover the balances of all the contracts
+	balances := sdk.SysCoins{}
+	for _, rent := range data.Contracts {
+		k.SetContractRent(ctx, rent)
+		balances = balances.Add(rent.Balance)
+	}
+	if !moduleAcc.GetCoins().IsAllGTE(balances) {
+		panic(fmt.Sprintf("%s module account balance %s is less than the balances %s of the contracts",
+			types.ModuleName, moduleAcc.GetCoins(), balances))
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetContractRents(ctx))
+}
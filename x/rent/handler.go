@@ -0,0 +1,85 @@
+package rent
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/rent/keeper"
+	"github.com/okex/exchain/x/rent/types"
+)
+
+// NewHandler creates an sdk.Handler for all the rent type messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx.SetEventManager(sdk.NewEventManager())
+
+		if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+			return nil, types.ErrRentNotSupported(ctx.BlockHeight())
+		}
+
+		switch msg := msg.(type) {
+		case types.MsgDepositRent:
+			return handleMsgDepositRent(ctx, k, msg)
+		case types.MsgRestoreContract:
+			return handleMsgRestoreContract(ctx, k, msg)
+		default:
+			return nil, types.ErrUnknownRentMsgType(fmt.Sprintf("%T", msg))
+		}
+	}
+}
+
+func handleMsgDepositRent(ctx sdk.Context, k keeper.Keeper, msg types.MsgDepositRent) (*sdk.Result, error) {
+	rent, found := k.GetContractRent(ctx, msg.Contract)
+	if !found {
+		return nil, types.ErrNoContractFound(msg.Contract.String())
+	}
+
+	rent, err := k.DepositRent(ctx, msg.Sender, rent, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDepositRent,
+			sdk.NewAttribute(types.AttributeKeyContract, rent.Contract.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBalance, rent.Balance.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRestoreContract(ctx sdk.Context, k keeper.Keeper, msg types.MsgRestoreContract) (*sdk.Result, error) {
+	rent, found := k.GetContractRent(ctx, msg.Contract)
+	if !found {
+		return nil, types.ErrNoContractFound(msg.Contract.String())
+	}
+
+	rent, cost, err := k.RestoreContract(ctx, rent, k.GetParams(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRestoreContract,
+			sdk.NewAttribute(types.AttributeKeyContract, rent.Contract.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, cost.String()),
+			sdk.NewAttribute(types.AttributeKeyNextHeight, strconv.FormatInt(rent.NextHeight, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wrapper struct for rent keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks return the wrapper hooks struct for the Keeper
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing is a wrapper for calling the EVM PostTxProcessing hook on
+// the module keeper
+func (h Hooks) PostTxProcessing(ctx sdk.Context, st *evmtypes.StateTransition, receipt *ethtypes.Receipt) error {
+	return h.k.PostTxProcessing(ctx, st, receipt)
+}
+
+// PostTxProcessing implements EvmHooks.PostTxProcessing. It tracks the storage footprints of the contracts changed by
+// the tx if the rent is enabled.
+func (k Keeper) PostTxProcessing(ctx sdk.Context, st *evmtypes.StateTransition, receipt *ethtypes.Receipt) error {
+	if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) || len(st.StorageChanges) == 0 {
+		return nil
+	}
+
+	// the footprints are tracked without gas, so that the gas of the txs is the same whether the rent is enabled or not
+	currentGasMeter := ctx.GasMeter()
+	infGasMeter := sdk.GetReusableInfiniteGasMeter()
+	ctx.SetGasMeter(infGasMeter)
+	defer func() {
+		ctx.SetGasMeter(currentGasMeter)
+		sdk.ReturnInfiniteGasMeter(infGasMeter)
+	}()
+
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return nil
+	}
+	return k.TrackStorageChanges(ctx, st.StorageChanges, st.Csdb.GetCodeSize, params)
+}
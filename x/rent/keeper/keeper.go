@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/okex/exchain/x/params"
+	"github.com/okex/exchain/x/rent/types"
+)
+
+var _ evmtypes.ContractHibernation = Keeper{}
+
+// Keeper of the rent store
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	paramSpace   types.Subspace
+	supplyKeeper types.SupplyKeeper
+}
+
+// NewKeeper creates a rent keeper
+func NewKeeper(supplyKeeper types.SupplyKeeper, key sdk.StoreKey, cdc *codec.Codec, ps params.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:     key,
+		cdc:          cdc,
+		paramSpace:   ps,
+		supplyKeeper: supplyKeeper,
+	}
+}
+
+// SupplyKeeper returns the supply keeper
+func (k Keeper) SupplyKeeper() types.SupplyKeeper {
+	return k.supplyKeeper
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of rent parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
+
+// SetParams sets the rent parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetContractRent gets the rent of a contract from store
+func (k Keeper) GetContractRent(ctx sdk.Context, contract sdk.AccAddress) (rent types.ContractRent, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractKey(contract))
+	if bz == nil {
+		return rent, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &rent)
+	return rent, true
+}
+
+// SetContractRent sets the rent of a contract and its index by the next height, or by the hibernation of the contract,
+// into store. The rent must be deleted first if its next height or its hibernation changes
+func (k Keeper) SetContractRent(ctx sdk.Context, rent types.ContractRent) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractKey(rent.Contract), k.cdc.MustMarshalBinaryLengthPrefixed(rent))
+	if rent.Hibernated {
+		store.Set(types.GetHibernatedKey(rent.Contract), []byte{})
+	} else {
+		store.Set(types.GetScheduleKey(rent.NextHeight, rent.Contract), []byte{})
+	}
+}
+
+// DeleteContractRent deletes the rent of a contract and its indexes from store
+func (k Keeper) DeleteContractRent(ctx sdk.Context, rent types.ContractRent) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetContractKey(rent.Contract))
+	store.Delete(types.GetHibernatedKey(rent.Contract))
+	store.Delete(types.GetScheduleKey(rent.NextHeight, rent.Contract))
+}
+
+// IsContractHibernated returns whether the contract is hibernated, it implements the evm ContractHibernation
+func (k Keeper) IsContractHibernated(ctx sdk.Context, contract common.Address) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetHibernatedKey(contract.Bytes()))
+}
+
+// IterateContractRents iterates over the rents of all the tracked contracts
+func (k Keeper) IterateContractRents(ctx sdk.Context, handler func(rent types.ContractRent) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ContractPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rent types.ContractRent
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &rent)
+		if handler(rent) {
+			break
+		}
+	}
+}
+
+// GetContractRents gets the rents of all the tracked contracts
+func (k Keeper) GetContractRents(ctx sdk.Context) (rents types.ContractRents) {
+	k.IterateContractRents(ctx, func(rent types.ContractRent) bool {
+		rents = append(rents, rent)
+		return false
+	})
+	return
+}
+
+// GetHibernatedContractRents gets the rents of all the hibernated contracts
+func (k Keeper) GetHibernatedContractRents(ctx sdk.Context) (rents types.ContractRents) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.HibernatedPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		rent, found := k.GetContractRent(ctx, iterator.Key()[len(types.HibernatedPrefix):])
+		if !found {
+			panic("the hibernated contract can't be found")
+		}
+		rents = append(rents, rent)
+	}
+	return
+}
+
+// GetDueContractRents gets at most limit rents whose next height is not after the height of the block, in the order
+// of their next heights and contracts
+func (k Keeper) GetDueContractRents(ctx sdk.Context, limit uint64) (rents types.ContractRents) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.SchedulePrefix, types.GetScheduleKey(ctx.BlockHeight()+1, nil))
+	defer iterator.Close()
+	for ; iterator.Valid() && uint64(len(rents)) < limit; iterator.Next() {
+		key := iterator.Key()
+		rent, found := k.GetContractRent(ctx, key[len(types.SchedulePrefix)+8:])
+		if !found {
+			panic("the scheduled contract can't be found")
+		}
+		rents = append(rents, rent)
+	}
+	return
+}
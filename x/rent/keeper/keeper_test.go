@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/okex/exchain/x/rent/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrackStorageChanges(t *testing.T) {
+	ctx, k, sk := createTestInput(t)
+	params := k.GetParams(ctx)
+	deployed, existing, account := common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3")
+	codeSizes := map[common.Address]int{deployed: 1000, existing: 500}
+	codeSize := func(addr common.Address) int { return codeSizes[addr] }
+
+	// the deployed contract and the contract filling slots are tracked, the accounts without code and the contracts
+	// only clearing slots aren't
+	require.NoError(t, k.TrackStorageChanges(ctx, map[common.Address]*evmtypes.StorageChange{
+		deployed: {SlotDelta: 3, CodeDeployed: true},
+		existing: {SlotDelta: 2},
+		account:  {SlotDelta: 1},
+	}, codeSize, params))
+	require.NoError(t, k.TrackStorageChanges(ctx, map[common.Address]*evmtypes.StorageChange{
+		common.HexToAddress("0x4"): {SlotDelta: -1},
+	}, func(common.Address) int { return 100 }, params))
+	require.Len(t, k.GetContractRents(ctx), 2)
+
+	rent, found := k.GetContractRent(ctx, deployed.Bytes())
+	require.True(t, found)
+	require.Equal(t, uint64(1000+3*types.SlotSize), rent.Footprint())
+	require.Equal(t, int64(110), rent.NextHeight)
+
+	// the slots cleared are subtracted, the ones filled before the tracking aren't counted
+	require.NoError(t, k.TrackStorageChanges(ctx, map[common.Address]*evmtypes.StorageChange{
+		deployed: {SlotDelta: -1},
+		existing: {SlotDelta: -5},
+	}, codeSize, params))
+	rent, _ = k.GetContractRent(ctx, deployed.Bytes())
+	require.Equal(t, uint64(2), rent.Slots)
+	rent, _ = k.GetContractRent(ctx, existing.Bytes())
+	require.Equal(t, uint64(0), rent.Slots)
+
+	// the balance of a self-destructed contract is paid to the fee collector
+	other := sdk.AccAddress("other")
+	sk.balances[other.String()] = sdk.NewCoins(okt(10))
+	_, err := k.DepositRent(ctx, other, rent, okt(10))
+	require.NoError(t, err)
+	require.NoError(t, k.TrackStorageChanges(ctx, map[common.Address]*evmtypes.StorageChange{
+		existing: {Destructed: true},
+	}, codeSize, params))
+	_, found = k.GetContractRent(ctx, existing.Bytes())
+	require.False(t, found)
+	require.Equal(t, sdk.NewCoins(okt(10)), sk.balances[auth.FeeCollectorName])
+}
+
+func TestChargeAndRestoreContract(t *testing.T) {
+	ctx, k, sk := createTestInput(t)
+	params := k.GetParams(ctx)
+	contract := common.HexToAddress("0x1")
+	require.NoError(t, k.TrackStorageChanges(ctx, map[common.Address]*evmtypes.StorageChange{
+		contract: {SlotDelta: 1, CodeDeployed: true},
+	}, func(common.Address) int { return 36 }, params))
+	// the rent of the 100-byte footprint is 0.01okt
+	rent, _ := k.GetContractRent(ctx, contract.Bytes())
+	require.Equal(t, okt(100), params.Rent(rent.Footprint()))
+
+	sender := sdk.AccAddress("sender")
+	sk.balances[sender.String()] = sdk.NewCoins(okt(1000))
+	rent, err := k.DepositRent(ctx, sender, rent, okt(150))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(okt(150)), sk.balances[types.ModuleName])
+
+	// the rent isn't due before its next height
+	ctx.SetBlockHeight(109)
+	require.Empty(t, k.GetDueContractRents(ctx, params.MaxChargesPerBlock))
+
+	ctx.SetBlockHeight(110)
+	due := k.GetDueContractRents(ctx, params.MaxChargesPerBlock)
+	require.Equal(t, types.ContractRents{rent}, due)
+	rent, cost := k.ChargeRent(ctx, due[0], params)
+	require.False(t, rent.Hibernated)
+	require.Equal(t, okt(100), cost)
+	require.Equal(t, okt(50), rent.Balance)
+	require.Equal(t, int64(210), rent.NextHeight)
+	require.Equal(t, sdk.NewCoins(okt(100)), sk.balances[auth.FeeCollectorName])
+	require.Empty(t, k.GetDueContractRents(ctx, params.MaxChargesPerBlock))
+	require.False(t, k.IsContractHibernated(ctx, contract))
+
+	// the contract is hibernated once its balance can't cover the rent
+	ctx.SetBlockHeight(210)
+	rent, _ = k.ChargeRent(ctx, rent, params)
+	require.True(t, rent.Hibernated)
+	require.Equal(t, okt(50), rent.Balance)
+	require.True(t, k.IsContractHibernated(ctx, contract))
+	require.Equal(t, types.ContractRents{rent}, k.GetHibernatedContractRents(ctx))
+	ctx.SetBlockHeight(1000)
+	require.Empty(t, k.GetDueContractRents(ctx, params.MaxChargesPerBlock))
+
+	// it's restored once its balance covers the rent again
+	_, _, err = k.RestoreContract(ctx, rent, params)
+	require.Error(t, err)
+	rent, err = k.DepositRent(ctx, sender, rent, okt(100))
+	require.NoError(t, err)
+	rent, cost, err = k.RestoreContract(ctx, rent, params)
+	require.NoError(t, err)
+	require.Equal(t, okt(100), cost)
+	require.Equal(t, okt(50), rent.Balance)
+	require.Equal(t, int64(1100), rent.NextHeight)
+	require.False(t, k.IsContractHibernated(ctx, contract))
+	require.Empty(t, k.GetHibernatedContractRents(ctx))
+	_, _, err = k.RestoreContract(ctx, rent, params)
+	require.Error(t, err)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/rent/types"
+)
+
+// NewQuerier creates a new querier for rent clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryContract:
+			return queryContract(ctx, req, k)
+		case types.QueryHibernated:
+			return queryHibernated(ctx, k)
+		case types.QueryParameters:
+			return queryParams(ctx, k)
+		default:
+			return nil, types.ErrUnknownRentQueryType(path[0])
+		}
+	}
+}
+
+func queryContract(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryContractParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	rent, found := k.GetContractRent(ctx, params.Contract)
+	if !found {
+		return nil, types.ErrNoContractFound(params.Contract.String())
+	}
+	return marshalJSON(rent)
+}
+
+func queryHibernated(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	rents := k.GetHibernatedContractRents(ctx)
+	if rents == nil {
+		rents = types.ContractRents{}
+	}
+	return marshalJSON(rents)
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	return marshalJSON(k.GetParams(ctx))
+}
+
+func marshalJSON(o interface{}) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, o)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
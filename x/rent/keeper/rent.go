@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/okex/exchain/x/rent/types"
+)
+
+// TrackStorageChanges updates the storage footprints of the contracts by the storage changes of a tx. A contract is
+// tracked once its code is deployed or it fills a slot, and its first rent is charged a period later. The rent of a
+// self-destructed contract is removed, its balance is paid to the fee collector.
+func (k Keeper) TrackStorageChanges(ctx sdk.Context, changes map[common.Address]*evmtypes.StorageChange,
+	codeSize func(addr common.Address) int, params types.Params) error {
+	addrs := make([]common.Address, 0, len(changes))
+	for addr := range changes {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+
+	for _, addr := range addrs {
+		change := changes[addr]
+		rent, found := k.GetContractRent(ctx, addr.Bytes())
+		if change.Destructed {
+			if found {
+				if err := k.RemoveContractRent(ctx, rent); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if !found {
+			if !change.CodeDeployed && change.SlotDelta <= 0 {
+				continue
+			}
+			size := codeSize(addr)
+			if size == 0 {
+				continue
+			}
+			rent = types.NewContractRent(addr.Bytes(), uint64(size), 0, ctx.BlockHeight()+params.Period)
+		}
+		rent.AddSlots(change.SlotDelta)
+		k.SetContractRent(ctx, rent)
+	}
+	return nil
+}
+
+// DepositRent escrows the amount from the sender into the module account and adds it to the balance of the contract
+func (k Keeper) DepositRent(ctx sdk.Context, sender sdk.AccAddress, rent types.ContractRent, amount sdk.SysCoin) (types.ContractRent, error) {
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount.ToCoins()); err != nil {
+		return rent, types.ErrSendCoinsFromAccountToModuleFailed(err.Error())
+	}
+
+	rent.Balance = rent.Balance.Add(amount)
+	k.SetContractRent(ctx, rent)
+	return rent, nil
+}
+
+// ChargeRent pays the rent of a period for the footprint of the contract from its balance to the fee collector, and
+// schedules the next charge a period later. A contract whose balance can't cover the rent is hibernated instead, and
+// keeps its balance for its restoration.
+func (k Keeper) ChargeRent(ctx sdk.Context, rent types.ContractRent, params types.Params) (types.ContractRent, sdk.SysCoin) {
+	cost := params.Rent(rent.Footprint())
+	k.DeleteContractRent(ctx, rent)
+	if rent.Balance.IsLT(cost) {
+		rent.Hibernated = true
+		rent.NextHeight = 0
+		k.SetContractRent(ctx, rent)
+		return rent, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.ZeroDec())
+	}
+
+	if cost.IsPositive() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auth.FeeCollectorName, cost.ToCoins()); err != nil {
+			panic(fmt.Sprintf("failed to pay the rent %s of contract %s: %s", cost, rent.Contract, err))
+		}
+	}
+	rent.Balance = rent.Balance.Sub(cost)
+	rent.Hibernated = false
+	rent.NextHeight = ctx.BlockHeight() + params.Period
+	k.SetContractRent(ctx, rent)
+	return rent, cost
+}
+
+// RestoreContract restores a hibernated contract, charging the rent of a period from its balance
+func (k Keeper) RestoreContract(ctx sdk.Context, rent types.ContractRent, params types.Params) (types.ContractRent, sdk.SysCoin, error) {
+	if !rent.Hibernated {
+		return rent, sdk.SysCoin{}, types.ErrContractNotHibernated(rent.Contract.String())
+	}
+	if cost := params.Rent(rent.Footprint()); rent.Balance.IsLT(cost) {
+		return rent, sdk.SysCoin{}, types.ErrInsufficientRent(rent.Balance, cost)
+	}
+
+	rent, cost := k.ChargeRent(ctx, rent, params)
+	return rent, cost, nil
+}
+
+// RemoveContractRent pays the rest of the balance of the contract to the fee collector and stops tracking the contract
+func (k Keeper) RemoveContractRent(ctx sdk.Context, rent types.ContractRent) error {
+	if rent.Balance.IsPositive() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auth.FeeCollectorName, rent.Balance.ToCoins()); err != nil {
+			return err
+		}
+	}
+
+	k.DeleteContractRent(ctx, rent)
+	return nil
+}
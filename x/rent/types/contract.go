@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// SlotSize is the size in bytes of a storage slot in the footprint of a contract, a slot stores a 32-byte key and a
+// 32-byte value
+const SlotSize = 64
+
+// ContractRent is the storage footprint of a contract and the balance prepaid for its rent. The rent of a period is
+// charged from the balance at NextHeight, the contract is hibernated once its balance can't cover the rent, and the
+// calls to a hibernated contract fail until it's restored. The slots filled before the contract is tracked aren't
+// counted in the footprint.
+type ContractRent struct {
+	Contract   sdk.AccAddress `json:"contract" yaml:"contract"`
+	CodeSize   uint64         `json:"code_size" yaml:"code_size"`
+	Slots      uint64         `json:"slots" yaml:"slots"`
+	Balance    sdk.SysCoin    `json:"balance" yaml:"balance"`
+	NextHeight int64          `json:"next_height" yaml:"next_height"`
+	Hibernated bool           `json:"hibernated" yaml:"hibernated"`
+}
+
+// NewContractRent creates a new instance of ContractRent with an empty balance
+func NewContractRent(contract sdk.AccAddress, codeSize, slots uint64, nextHeight int64) ContractRent {
+	return ContractRent{
+		Contract:   contract,
+		CodeSize:   codeSize,
+		Slots:      slots,
+		Balance:    sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.ZeroDec()),
+		NextHeight: nextHeight,
+	}
+}
+
+// Footprint returns the storage footprint of the contract in bytes
+func (c ContractRent) Footprint() uint64 {
+	return c.CodeSize + c.Slots*SlotSize
+}
+
+// AddSlots adds the delta to the slots of the contract, the slots cleared which aren't counted are ignored
+func (c *ContractRent) AddSlots(delta int64) {
+	if delta < 0 && uint64(-delta) > c.Slots {
+		c.Slots = 0
+		return
+	}
+	c.Slots = uint64(int64(c.Slots) + delta)
+}
+
+// ValidateBasic checks the address and the balance of the rent
+func (c ContractRent) ValidateBasic() sdk.Error {
+	if c.Contract.Empty() {
+		return ErrInvalidAddress("contract is empty")
+	}
+	if c.Balance.Amount.IsNil() || !c.Balance.IsValid() || c.Balance.Denom != sdk.DefaultBondDenom {
+		return ErrInvalidDeposit(fmt.Sprintf("balance %s", c.Balance))
+	}
+	return nil
+}
+
+// String returns a human readable string representation of ContractRent
+func (c ContractRent) String() string {
+	return fmt.Sprintf(`Contract Rent:
+  Contract:     %s
+  Code Size:    %d
+  Slots:        %d
+  Footprint:    %d
+  Balance:      %s
+  Next Height:  %d
+  Hibernated:   %t`,
+		c.Contract, c.CodeSize, c.Slots, c.Footprint(), c.Balance, c.NextHeight, c.Hibernated)
+}
+
+// ContractRents is a collection of ContractRent
+type ContractRents []ContractRent
+
+// String returns a human readable string representation of ContractRents
+func (cs ContractRents) String() (out string) {
+	for _, c := range cs {
+		out += c.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// validateDeposit checks that the deposit is a positive amount of the native token, in which the rents are paid
+func validateDeposit(deposit sdk.SysCoin) sdk.Error {
+	if deposit.Amount.IsNil() || !deposit.IsValid() || !deposit.IsPositive() {
+		return ErrInvalidDeposit(deposit.String())
+	}
+	if deposit.Denom != sdk.DefaultBondDenom {
+		return ErrInvalidDeposit(fmt.Sprintf("%s, only %s is accepted", deposit, sdk.DefaultBondDenom))
+	}
+	return nil
+}
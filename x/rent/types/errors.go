@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	DefaultCodespace string = ModuleName
+
+	CodeInvalidAddress                     uint32 = 74000
+	CodeInvalidDeposit                     uint32 = 74001
+	CodeNoContractFound                    uint32 = 74002
+	CodeContractNotHibernated              uint32 = 74003
+	CodeInsufficientRent                   uint32 = 74004
+	CodeSendCoinsFromAccountToModuleFailed uint32 = 74005
+	CodeUnknownRentMsgType                 uint32 = 74006
+	CodeUnknownRentQueryType               uint32 = 74007
+	CodeRentNotSupported                   uint32 = 74008
+)
+
+// ErrInvalidAddress returns an error when an address is invalid
+func ErrInvalidAddress(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidAddress, fmt.Sprintf("failed. invalid address: %s", msg))}
+}
+
+// ErrInvalidDeposit returns an error when the deposit of a rent is invalid
+func ErrInvalidDeposit(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidDeposit, fmt.Sprintf("failed. invalid deposit: %s", msg))}
+}
+
+// ErrNoContractFound returns an error when the storage of a contract isn't tracked
+func ErrNoContractFound(contract string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNoContractFound, fmt.Sprintf("failed. the rent of contract %s does not exist", contract))}
+}
+
+// ErrContractNotHibernated returns an error when a contract which isn't hibernated is restored
+func ErrContractNotHibernated(contract string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeContractNotHibernated, fmt.Sprintf("failed. contract %s is not hibernated", contract))}
+}
+
+// ErrInsufficientRent returns an error when the balance of a contract can't cover its rent
+func ErrInsufficientRent(balance, rent sdk.SysCoin) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInsufficientRent, fmt.Sprintf("failed. the balance %s is less than the rent %s", balance, rent))}
+}
+
+// ErrSendCoinsFromAccountToModuleFailed returns an error when it fails to send coins from an account to the module
+func ErrSendCoinsFromAccountToModuleFailed(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeSendCoinsFromAccountToModuleFailed, fmt.Sprintf("failed. send coins from account to module failed: %s", msg))}
+}
+
+// ErrUnknownRentMsgType returns an error when the msg type is unknown
+func ErrUnknownRentMsgType(msgType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownRentMsgType, fmt.Sprintf("unrecognized rent message type: %s", msgType))}
+}
+
+// ErrUnknownRentQueryType returns an error when the query path is unknown
+func ErrUnknownRentQueryType(path string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownRentQueryType, fmt.Sprintf("unknown rent query endpoint: %s", path))}
+}
+
+// ErrRentNotSupported returns an error when the rent module is not enabled at the height
+func ErrRentNotSupported(height int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeRentNotSupported, fmt.Sprintf("rent module is not supported at height %d", height))}
+}
@@ -0,0 +1,17 @@
+package types
+
+// rent module event types
+const (
+	EventTypeDepositRent     = "deposit_rent"
+	EventTypeRestoreContract = "restore_contract"
+	EventTypeChargeRent      = "charge_rent"
+	EventTypeHibernate       = "hibernate_contract"
+
+	AttributeKeyContract   = "contract"
+	AttributeKeyAmount     = "amount"
+	AttributeKeyFootprint  = "footprint"
+	AttributeKeyBalance    = "balance"
+	AttributeKeyNextHeight = "next_height"
+
+	AttributeValueCategory = ModuleName
+)
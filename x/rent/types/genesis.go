@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState is the state of the rent module that must be provided at genesis
+type GenesisState struct {
+	Params    Params        `json:"params" yaml:"params"`
+	Contracts ContractRents `json:"contracts" yaml:"contracts"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, contracts ContractRents) GenesisState {
+	return GenesisState{
+		Params:    params,
+		Contracts: contracts,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// ValidateGenesis validates the rent genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	contracts := make(map[string]bool, len(data.Contracts))
+	for _, contract := range data.Contracts {
+		if err := contract.ValidateBasic(); err != nil {
+			return err
+		}
+		if !contract.Hibernated && contract.NextHeight <= 0 {
+			return fmt.Errorf("next height %d of contract %s should be positive", contract.NextHeight, contract.Contract)
+		}
+		if contracts[contract.Contract.String()] {
+			return fmt.Errorf("duplicated contract %s", contract.Contract)
+		}
+		contracts[contract.Contract.String()] = true
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the rent module
+	ModuleName = "rent"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the rent module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the rent module
+	QuerierRoute = ModuleName
+)
+
+var (
+	ContractPrefix   = []byte{0x01}
+	SchedulePrefix   = []byte{0x02}
+	HibernatedPrefix = []byte{0x03}
+)
+
+// GetContractKey gets the key for the rent of a contract
+func GetContractKey(contract sdk.AccAddress) []byte {
+	return append(ContractPrefix, contract.Bytes()...)
+}
+
+// GetScheduleKey gets the key for the index of a contract by the height of its next charge, so that the due contracts
+// are iterated in the order of their heights
+func GetScheduleKey(height int64, contract sdk.AccAddress) []byte {
+	return append(append(SchedulePrefix, sdk.Uint64ToBigEndian(uint64(height))...), contract.Bytes()...)
+}
+
+// GetHibernatedKey gets the key for the index of a hibernated contract, which is looked up by every call in the evm
+func GetHibernatedKey(contract sdk.AccAddress) []byte {
+	return append(HibernatedPrefix, contract.Bytes()...)
+}
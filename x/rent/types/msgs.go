@@ -0,0 +1,95 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = MsgDepositRent{}
+	_ sdk.Msg = MsgRestoreContract{}
+)
+
+// MsgDepositRent tops up the balance prepaid for the rent of a contract, anyone can deposit to any tracked contract
+type MsgDepositRent struct {
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+	Amount   sdk.SysCoin    `json:"amount" yaml:"amount"`
+}
+
+// NewMsgDepositRent creates a new instance of MsgDepositRent
+func NewMsgDepositRent(sender, contract sdk.AccAddress, amount sdk.SysCoin) MsgDepositRent {
+	return MsgDepositRent{
+		Sender:   sender,
+		Contract: contract,
+		Amount:   amount,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgDepositRent) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgDepositRent) Type() string { return "deposit_rent" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDepositRent) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrInvalidAddress("sender is empty")
+	}
+	if msg.Contract.Empty() {
+		return ErrInvalidAddress("contract is empty")
+	}
+	return validateDeposit(msg.Amount)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDepositRent) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDepositRent) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgRestoreContract restores a hibernated contract whose balance covers the rent of a period, which is charged on
+// the restoration
+type MsgRestoreContract struct {
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+}
+
+// NewMsgRestoreContract creates a new instance of MsgRestoreContract
+func NewMsgRestoreContract(sender, contract sdk.AccAddress) MsgRestoreContract {
+	return MsgRestoreContract{
+		Sender:   sender,
+		Contract: contract,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRestoreContract) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRestoreContract) Type() string { return "restore_contract" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRestoreContract) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return ErrInvalidAddress("sender is empty")
+	}
+	if msg.Contract.Empty() {
+		return ErrInvalidAddress("contract is empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRestoreContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRestoreContract) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDepositRentValidateBasic(t *testing.T) {
+	sender, contract := sdk.AccAddress("sender"), sdk.AccAddress("contract")
+	amount := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.OneDec())
+
+	tests := []struct {
+		name string
+		msg  MsgDepositRent
+		ok   bool
+	}{
+		{"valid", NewMsgDepositRent(sender, contract, amount), true},
+		{"empty sender", NewMsgDepositRent(nil, contract, amount), false},
+		{"empty contract", NewMsgDepositRent(sender, nil, amount), false},
+		{"zero amount", NewMsgDepositRent(sender, contract, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.ZeroDec())), false},
+		{"amount not okt", NewMsgDepositRent(sender, contract, sdk.NewDecCoinFromDec("usdt", sdk.OneDec())), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.ok {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
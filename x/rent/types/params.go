@@ -0,0 +1,129 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/params"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Parameter store key
+var (
+	DefaultEnabled                   = false
+	DefaultPeriod             int64  = 28800
+	DefaultMaxChargesPerBlock uint64 = 100
+	DefaultPricePerByte              = sdk.NewDecWithPrec(1, 8)
+
+	ParamStoreKeyEnabled            = []byte("Enabled")
+	ParamStoreKeyPeriod             = []byte("Period")
+	ParamStoreKeyMaxChargesPerBlock = []byte("MaxChargesPerBlock")
+	ParamStoreKeyPricePerByte       = []byte("PricePerByte")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params defines the rent module params
+type Params struct {
+	// enabled turns on the tracking of the storage footprints and the charges of the rents. The hibernated contracts
+	// stay hibernated until they're restored once it's turned off
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// period is the number of the blocks a rent covers
+	Period int64 `json:"period" yaml:"period"`
+	// max_charges_per_block caps the number of the rents charged at the end of a block, the due rents above the cap
+	// are charged in the following blocks
+	MaxChargesPerBlock uint64 `json:"max_charges_per_block" yaml:"max_charges_per_block"`
+	// price_per_byte is the rent in okt of a byte of the storage footprint for a period
+	PricePerByte sdk.Dec `json:"price_per_byte" yaml:"price_per_byte"`
+}
+
+// NewParams creates a new Params object
+func NewParams(enabled bool, period int64, maxChargesPerBlock uint64, pricePerByte sdk.Dec) Params {
+	return Params{
+		Enabled:            enabled,
+		Period:             period,
+		MaxChargesPerBlock: maxChargesPerBlock,
+		PricePerByte:       pricePerByte,
+	}
+}
+
+// DefaultParams returns the default parameters of the rent module
+func DefaultParams() Params {
+	return NewParams(DefaultEnabled, DefaultPeriod, DefaultMaxChargesPerBlock, DefaultPricePerByte)
+}
+
+// String implements the fmt.Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyEnabled, &p.Enabled, validateBool),
+		params.NewParamSetPair(ParamStoreKeyPeriod, &p.Period, validatePeriod),
+		params.NewParamSetPair(ParamStoreKeyMaxChargesPerBlock, &p.MaxChargesPerBlock, validateMaxChargesPerBlock),
+		params.NewParamSetPair(ParamStoreKeyPricePerByte, &p.PricePerByte, validatePricePerByte),
+	}
+}
+
+// Validate checks all the params
+func (p Params) Validate() error {
+	if err := validatePeriod(p.Period); err != nil {
+		return err
+	}
+	if err := validateMaxChargesPerBlock(p.MaxChargesPerBlock); err != nil {
+		return err
+	}
+	return validatePricePerByte(p.PricePerByte)
+}
+
+// Rent returns the rent of a storage footprint in bytes for a period
+func (p Params) Rent(footprint uint64) sdk.SysCoin {
+	return sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, p.PricePerByte.MulInt64(int64(footprint)))
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("period must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateMaxChargesPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max charges per block must be positive")
+	}
+	return nil
+}
+
+func validatePricePerByte(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("price per byte must not be negative: %s", v)
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// QueryParameters is the query endpoint of the rent params
+	QueryParameters = "params"
+	// QueryContract is the query endpoint of the rent of a contract
+	QueryContract = "contract"
+	// QueryHibernated is the query endpoint of the rents of the hibernated contracts
+	QueryHibernated = "hibernated"
+)
+
+// QueryContractParams is the params of the query of the rent of a contract
+type QueryContractParams struct {
+	Contract sdk.AccAddress `json:"contract"`
+}
+
+// NewQueryContractParams creates a new instance of QueryContractParams
+func NewQueryContractParams(contract sdk.AccAddress) QueryContractParams {
+	return QueryContractParams{
+		Contract: contract,
+	}
+}
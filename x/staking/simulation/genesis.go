@@ -0,0 +1,145 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/module"
+	authtypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/supply"
+	"github.com/okex/exchain/x/staking/types"
+)
+
+// Simulation parameter constants
+const (
+	UnbondingTime      = "unbonding_time"
+	MaxValidators      = "max_validators"
+	Epoch              = "epoch"
+	MaxValsToAddShares = "max_validators_to_add_shares"
+)
+
+// GenUnbondingTime randomized UnbondingTime
+func GenUnbondingTime(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60, 60*60*24*3*2)) * time.Second
+}
+
+// GenMaxValidators randomized MaxValidators
+func GenMaxValidators(r *rand.Rand) uint16 {
+	return uint16(r.Intn(250) + 1)
+}
+
+// GenEpoch randomized Epoch
+func GenEpoch(r *rand.Rand) uint16 {
+	return uint16(simulation.RandIntBetween(r, 1, 20))
+}
+
+// GenMaxValsToAddShares randomized MaxValsToAddShares
+func GenMaxValsToAddShares(r *rand.Rand) uint16 {
+	return uint16(simulation.RandIntBetween(r, 1, 30))
+}
+
+// RandomizedGenState generates a random GenesisState for staking. The first NumBonded accounts create a validator
+// each, locking the min self delegation and adding the rest of their initial stake to it as shares. The locked coins
+// are put into the bonded pool account of the auth genesis, as the supply genesis counts them apart from the accounts.
+func RandomizedGenState(simState *module.SimulationState) {
+	// params
+	var unbondTime time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, UnbondingTime, &unbondTime, simState.Rand,
+		func(r *rand.Rand) { unbondTime = GenUnbondingTime(r) },
+	)
+
+	var maxValidators uint16
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxValidators, &maxValidators, simState.Rand,
+		func(r *rand.Rand) { maxValidators = GenMaxValidators(r) },
+	)
+
+	var epoch uint16
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Epoch, &epoch, simState.Rand,
+		func(r *rand.Rand) { epoch = GenEpoch(r) },
+	)
+
+	var maxValsToAddShares uint16
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxValsToAddShares, &maxValsToAddShares, simState.Rand,
+		func(r *rand.Rand) { maxValsToAddShares = GenMaxValsToAddShares(r) },
+	)
+
+	// NOTE: the slashing module need to be defined after the staking module on the
+	// NewSimulationManager constructor for this to work
+	simState.UnbondTime = unbondTime
+
+	params := types.NewParams(simState.UnbondTime, maxValidators, epoch, maxValsToAddShares,
+		types.DefaultMinDelegation, types.DefaultMinSelfDelegation)
+
+	// validators & delegators
+	var (
+		validators []types.ValidatorExported
+		delegators []types.Delegator
+		allShares  []types.SharesExported
+	)
+
+	stake := sdk.NewDec(simState.InitialStake)
+	msd := params.MinSelfDelegation
+	if stake.LT(msd) {
+		msd = stake
+	}
+	tokens := stake.Sub(msd)
+
+	for i := 0; i < int(simState.NumBonded); i++ {
+		delAddr := simState.Accounts[i].Address
+		valAddr := sdk.ValAddress(delAddr)
+
+		validator := types.NewValidator(valAddr, simState.Accounts[i].PubKey,
+			types.NewDescription(fmt.Sprintf("sim-validator-%d", i), "", "", ""), msd)
+		// the min self delegation is always worth one share
+		validator.DelegatorShares = sdk.OneDec()
+
+		if tokens.IsPositive() {
+			delegator := types.NewDelegator(delAddr)
+			delegator.ValidatorAddresses = []sdk.ValAddress{valAddr}
+			delegator.Shares = tokens
+			delegator.Tokens = tokens
+			delegators = append(delegators, delegator)
+
+			allShares = append(allShares, types.NewSharesExported(delAddr, valAddr, tokens))
+			validator.DelegatorShares = validator.DelegatorShares.Add(tokens)
+		}
+		validators = append(validators, validator.Export())
+	}
+
+	addBondedPoolAccount(simState, stake.MulInt64(simState.NumBonded))
+
+	stakingGenesis := types.GenesisState{
+		Params:         params,
+		LastTotalPower: sdk.ZeroInt(),
+		Validators:     validators,
+		Delegators:     delegators,
+		AllShares:      allShares,
+	}
+
+	fmt.Printf("Selected randomly generated staking parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, stakingGenesis.Params))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(stakingGenesis)
+}
+
+// addBondedPoolAccount adds the bonded pool holding the bonded tokens of the genesis into the auth genesis
+func addBondedPoolAccount(simState *module.SimulationState, bonded sdk.Dec) {
+	var authGenesis authtypes.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[authtypes.ModuleName], &authGenesis)
+
+	bondedPool := supply.NewEmptyModuleAccount(types.BondedPoolName, supply.Burner, supply.Staking)
+	if err := bondedPool.SetCoins(sdk.NewCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, bonded))); err != nil {
+		panic(err)
+	}
+	authGenesis.Accounts = append(authGenesis.Accounts, bondedPool)
+
+	simState.GenState[authtypes.ModuleName] = simState.Cdc.MustMarshalJSON(authGenesis)
+}
@@ -1,53 +1,234 @@
 package simulation
 
 import (
+	"fmt"
 	"math/rand"
 
+	"github.com/okex/exchain/libs/cosmos-sdk/baseapp"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	"github.com/okex/exchain/libs/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/okex/exchain/libs/cosmos-sdk/simapp/params"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	govtypes "github.com/okex/exchain/libs/cosmos-sdk/x/gov/types"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
+	"github.com/okex/exchain/libs/tendermint/crypto/ed25519"
 	"github.com/okex/exchain/x/params/types"
+	"github.com/okex/exchain/x/staking/keeper"
+	stakingtypes "github.com/okex/exchain/x/staking/types"
 )
 
-// SimulateParamChangeProposalContent returns random parameter change content.
-// It will generate a ParameterChangeProposal object with anywhere between 1 and
-// the total amount of defined parameters changes, all of which have random valid values.
-func SimulateParamChangeProposalContent(paramChangePool []simulation.ParamChange) simulation.ContentSimulatorFn {
-	return func(r *rand.Rand, _ sdk.Context, _ []simulation.Account) govtypes.Content {
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateValidator = "op_weight_msg_create_validator"
+	OpWeightMsgDeposit         = "op_weight_msg_deposit"
+	OpWeightMsgWithdraw        = "op_weight_msg_withdraw"
+	OpWeightMsgAddShares       = "op_weight_msg_add_shares"
+)
 
-		lenParamChange := len(paramChangePool)
-		if lenParamChange == 0 {
-			panic("param changes array is empty")
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simulation.AppParams, cdc *codec.Codec, ak stakingtypes.AccountKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var (
+		weightMsgCreateValidator int
+		weightMsgDeposit         int
+		weightMsgWithdraw        int
+		weightMsgAddShares       int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateValidator = simappparams.DefaultWeightMsgCreateValidator
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeposit = simappparams.DefaultWeightMsgDelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdraw, &weightMsgWithdraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdraw = simappparams.DefaultWeightMsgUndelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddShares, &weightMsgAddShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddShares = simappparams.DefaultWeightMsgAddShares
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateValidator,
+			SimulateMsgCreateValidator(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDeposit,
+			SimulateMsgDeposit(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdraw,
+			SimulateMsgWithdraw(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAddShares,
+			SimulateMsgAddShares(ak, k),
+		),
+	}
+}
+
+// SimulateMsgCreateValidator generates a MsgCreateValidator with random values
+func SimulateMsgCreateValidator(ak stakingtypes.AccountKeeper, k keeper.Keeper) simulation.Operation { // nolint:interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		valAddr := sdk.ValAddress(simAccount.Address)
+
+		// ensure the validator doesn't exist already
+		if _, found := k.GetValidator(ctx, valAddr); found {
+			return simulation.NoOpMsg(stakingtypes.ModuleName), nil, nil
 		}
 
-		numChanges := simulation.RandIntBetween(r, 1, lenParamChange)
-		paramChanges := make([]types.ParamChange, numChanges)
+		consPubKey := ed25519.GenPrivKeyFromSecret([]byte(simulation.RandStringOfLength(r, 32))).PubKey()
+		if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(consPubKey)); found {
+			return simulation.NoOpMsg(stakingtypes.ModuleName), nil, nil
+		}
 
-		// map from key to empty struct; used only for look-up of the keys of the
-		// parameters that are already in the random set of changes.
-		paramChangesKeys := make(map[string]struct{})
+		// the handler always locks the min self delegation of the params
+		account := ak.GetAccount(ctx, simAccount.Address)
+		msd := sdk.NewDecCoinFromDec(k.BondDenom(ctx), k.ParamsMinSelfDelegation(ctx))
+		if account == nil || !msd.IsPositive() ||
+			account.SpendableCoins(ctx.BlockTime()).AmountOf(msd.Denom).LT(msd.Amount) {
+			return simulation.NoOpMsg(stakingtypes.ModuleName), nil, nil
+		}
 
-		for i := 0; i < numChanges; i++ {
-			spc := paramChangePool[r.Intn(len(paramChangePool))]
+		description := stakingtypes.NewDescription(
+			fmt.Sprintf("sim-%s", simulation.RandStringOfLength(r, 10)),
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 10),
+		)
+
+		msg := stakingtypes.NewMsgCreateValidator(valAddr, consPubKey, description, msd)
+		return helpers.GenAndDeliverTxWithRandFees(r, app, ctx, ak, simAccount, msg, sdk.NewCoins(msd),
+			helpers.DefaultGenTxGas, chainID)
+	}
+}
+
+// SimulateMsgDeposit generates a MsgDeposit with random values
+func SimulateMsgDeposit(ak stakingtypes.AccountKeeper, k keeper.Keeper) simulation.Operation { // nolint:interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		account := ak.GetAccount(ctx, simAccount.Address)
+		if account == nil {
+			return simulation.NoOpMsg(stakingtypes.ModuleName), nil, nil
+		}
+
+		denom, minDelegation := k.BondDenom(ctx), k.ParamsMinDelegation(ctx)
+		balance := account.SpendableCoins(ctx.BlockTime()).AmountOf(denom)
+		if balance.LT(minDelegation) {
+			return simulation.NoOpMsg(stakingtypes.ModuleName), nil, nil
+		}
+
+		amount := sdk.NewDecCoinFromDec(denom, minDelegation.Add(simulation.RandomDecAmount(r, balance.Sub(minDelegation))))
+		if !amount.IsPositive() {
+			return simulation.NoOpMsg(stakingtypes.ModuleName), nil, nil
+		}
 
-			// do not include duplicate parameter changes for a given subspace/key
-			_, ok := paramChangesKeys[spc.ComposedKey()]
-			for ok {
-				spc = paramChangePool[r.Intn(len(paramChangePool))]
-				_, ok = paramChangesKeys[spc.ComposedKey()]
+		msg := stakingtypes.NewMsgDeposit(simAccount.Address, amount)
+		return helpers.GenAndDeliverTxWithRandFees(r, app, ctx, ak, simAccount, msg, sdk.NewCoins(amount),
+			helpers.DefaultGenTxGas, chainID)
+	}
+}
+
+// SimulateMsgWithdraw generates a MsgWithdraw with random values
+func SimulateMsgWithdraw(ak stakingtypes.AccountKeeper, k keeper.Keeper) simulation.Operation { // nolint:interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		delegator, found := k.GetDelegator(ctx, simAccount.Address)
+		minDelegation := k.ParamsMinDelegation(ctx)
+		if !found || delegator.Tokens.LT(minDelegation) {
+			return simulation.NoOpMsg(stakingtypes.ModuleName), nil, nil
+		}
+
+		quantity := minDelegation.Add(simulation.RandomDecAmount(r, delegator.Tokens.Sub(minDelegation)))
+		// a proxy has to unregister before withdrawing all its tokens
+		if !quantity.IsPositive() || (delegator.IsProxy && quantity.Equal(delegator.Tokens)) {
+			return simulation.NoOpMsg(stakingtypes.ModuleName), nil, nil
+		}
+
+		msg := stakingtypes.NewMsgWithdraw(simAccount.Address, sdk.NewDecCoinFromDec(k.BondDenom(ctx), quantity))
+		return helpers.GenAndDeliverTxWithRandFees(r, app, ctx, ak, simAccount, msg, nil, helpers.DefaultGenTxGas, chainID)
+	}
+}
+
+// SimulateMsgAddShares generates a MsgAddShares to random validators
+func SimulateMsgAddShares(ak stakingtypes.AccountKeeper, k keeper.Keeper) simulation.Operation { // nolint:interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		delegator, found := k.GetDelegator(ctx, simAccount.Address)
+		if !found || delegator.Tokens.IsZero() || delegator.HasProxy() {
+			return simulation.NoOpMsg(stakingtypes.ModuleName), nil, nil
+		}
+
+		// the shares can't be added to the dismissed validators
+		var candidates []sdk.ValAddress
+		for _, validator := range k.GetAllValidators(ctx) {
+			if !validator.MinSelfDelegation.IsZero() {
+				candidates = append(candidates, validator.OperatorAddress)
 			}
+		}
 
-			// add a new distinct parameter to the set of changes and register the key
-			// to avoid further duplicates
-			paramChangesKeys[spc.ComposedKey()] = struct{}{}
-			paramChanges[i] = types.NewParamChange(spc.Subspace, spc.Key, spc.SimValue(r))
+		maxVals := int(k.ParamsMaxValsToAddShares(ctx))
+		if len(candidates) < maxVals {
+			maxVals = len(candidates)
 		}
+		if maxVals == 0 {
+			return simulation.NoOpMsg(stakingtypes.ModuleName), nil, nil
+		}
+
+		valAddrs := make([]sdk.ValAddress, simulation.RandIntBetween(r, 1, maxVals+1))
+		for i, idx := range r.Perm(len(candidates))[:len(valAddrs)] {
+			valAddrs[i] = candidates[idx]
+		}
+
+		msg := stakingtypes.NewMsgAddShares(simAccount.Address, valAddrs)
+		return helpers.GenAndDeliverTxWithRandFees(r, app, ctx, ak, simAccount, msg, nil, helpers.DefaultGenTxGas, chainID)
+	}
+}
+
+// SimulateParamChangeProposalContent returns random parameter change content.
+// It will generate a ParameterChangeProposal object with a random parameter change of the pool, as a proposal
+// can only change one parameter.
+func SimulateParamChangeProposalContent(paramChangePool []simulation.ParamChange) simulation.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simulation.Account) govtypes.Content {
+		if len(paramChangePool) == 0 {
+			panic("param changes array is empty")
+		}
+
+		spc := paramChangePool[r.Intn(len(paramChangePool))]
+		paramChanges := []types.ParamChange{types.NewParamChange(spc.Subspace, spc.Key, spc.SimValue(r))}
 
 		return types.NewParameterChangeProposal(
 			simulation.RandStringOfLength(r, 140),  // title
 			simulation.RandStringOfLength(r, 5000), // description
 			paramChanges,                           // set of changes
-			100,
+			uint64(ctx.BlockHeight())+uint64(simulation.RandIntBetween(r, 1, 100)), // height to apply the changes
 		)
 	}
 }
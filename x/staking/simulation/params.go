@@ -0,0 +1,38 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
+	"github.com/okex/exchain/x/staking/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxValidators),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxValidators(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyUnbondingTime),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenUnbondingTime(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyEpoch),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenEpoch(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxValsToAddShares),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxValsToAddShares(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,21 @@
+package stream
+
+import (
+	"github.com/okex/exchain/x/stream/keeper"
+	"github.com/okex/exchain/x/stream/types"
+)
+
+const (
+	ModuleName   = types.ModuleName
+	StoreKey     = types.StoreKey
+	RouterKey    = types.RouterKey
+	QuerierRoute = types.QuerierRoute
+)
+
+var (
+	NewKeeper = keeper.NewKeeper
+)
+
+type (
+	Keeper = keeper.Keeper
+)
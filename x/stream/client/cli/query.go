@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/x/stream/types"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	// Group stream queries under a subcommand
+	streamQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	streamQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryStream(queryRoute, cdc),
+			GetCmdQueryStreams(queryRoute, cdc),
+		)...,
+	)
+
+	return streamQueryCmd
+}
+
+// GetCmdQueryStream gets the stream query command.
+func GetCmdQueryStream(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "stream [stream-id]",
+		Short: "query a stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a stream with the amount withdrawable by its recipient at the latest block.
+
+Example:
+$ %s query stream stream 1
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryStreamParams(streamID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryStream)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var stream types.StreamResponse
+			cdc.MustUnmarshalJSON(resp, &stream)
+			return cliCtx.PrintOutput(stream)
+		},
+	}
+}
+
+// GetCmdQueryStreams gets the streams query command.
+func GetCmdQueryStreams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "streams [address]",
+		Short: "query the streams paid by or to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the streams paid by an address followed by the ones paid to it.
+The address can be given as a bech32 address or a 0x prefixed hex address.
+
+Example:
+$ %s query stream streams ex1hw4r48aww06ldrfeuq2v438ujnl6alszzzqpph
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryStreamsParams(addr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryStreams)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var streams []types.StreamResponse
+			cdc.MustUnmarshalJSON(resp, &streams)
+			return cliCtx.PrintOutput(streams)
+		},
+	}
+}
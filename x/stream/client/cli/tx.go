@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	client "github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	"github.com/okex/exchain/x/stream/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const flagStartTime = "start-time"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	streamTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		SuggestionsMinimumDistance: 2,
+	}
+
+	streamTxCmd.AddCommand(client.PostCommands(
+		GetCmdCreateStream(cdc),
+		GetCmdWithdrawStream(cdc),
+		GetCmdCancelStream(cdc),
+	)...)
+	return streamTxCmd
+}
+
+// GetCmdCreateStream gets the create stream command
+func GetCmdCreateStream(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [recipient] [deposit] [duration]",
+		Short: "create a stream paying the deposit to the recipient linearly over the duration",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a stream that escrows the deposit and pays it to the recipient linearly over the duration.
+The recipient can be given as a bech32 address or a 0x prefixed hex address. The stream starts at the
+block time of the transaction unless --start-time is set.
+
+Example:
+$ %s tx stream create ex1hw4r48aww06ldrfeuq2v438ujnl6alszzzqpph 1000xxb 720h --from mykey
+$ %s tx stream create 0xbbE4733d85bc2b90682147779DA49caB38C0aA1F 1000xxb 720h --start-time 2026-11-01T00:00:00Z --from mykey
+`, version.ClientName, version.ClientName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseDecCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			startTime := time.Now().UTC()
+			if startTimeStr := viper.GetString(flagStartTime); startTimeStr != "" {
+				if startTime, err = time.Parse(time.RFC3339, startTimeStr); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgCreateStream(cliCtx.GetFromAddress(), recipient, deposit, startTime, startTime.Add(duration))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagStartTime, "", "the start time of the stream in RFC3339 format, defaults to now")
+	return cmd
+}
+
+// GetCmdWithdrawStream gets the withdraw stream command
+func GetCmdWithdrawStream(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw [stream-id]",
+		Short: "withdraw the tokens accrued to the recipient of a stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the tokens accrued to the recipient of a stream so far.
+
+Example:
+$ %s tx stream withdraw 1 --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawStream(cliCtx.GetFromAddress(), streamID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdCancelStream gets the cancel stream command
+func GetCmdCancelStream(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [stream-id]",
+		Short: "cancel a stream, paying the accrued tokens to the recipient and refunding the rest to the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a stream by its sender or recipient. The tokens accrued so far are paid to the recipient
+and the rest of the deposit is refunded to the sender.
+
+Example:
+$ %s tx stream cancel 1 --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelStream(cliCtx.GetFromAddress(), streamID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,35 @@
+package stream
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/stream/keeper"
+	"github.com/okex/exchain/x/stream/types"
+)
+
+// InitGenesis initializes the stream module's state from a given genesis state
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	// if module account doesn't exist, it will create automatically
+	moduleAcc := k.SupplyKeeper().GetModuleAccount(ctx, types.ModuleName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	// the escrowed deposits must cover the remaining amounts of all the streams
+	remaining := sdk.SysCoins{}
+	for _, stream := range data.Streams {
+		k.SetStream(ctx, stream)
+		remaining = remaining.Add(sdk.NewDecCoinFromDec(stream.Deposit.Denom, stream.RemainingAmount()))
+	}
+	if !moduleAcc.GetCoins().IsAllGTE(remaining) {
+		panic(fmt.Sprintf("%s module account balance %s is less than the remaining deposits %s",
+			types.ModuleName, moduleAcc.GetCoins(), remaining))
+	}
+	k.SetNextStreamID(ctx, data.NextStreamID)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetStreams(ctx), k.GetNextStreamID(ctx))
+}
@@ -0,0 +1,120 @@
+package stream
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/stream/keeper"
+	"github.com/okex/exchain/x/stream/types"
+)
+
+// NewHandler creates an sdk.Handler for all the stream type messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx.SetEventManager(sdk.NewEventManager())
+
+		if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+			return nil, types.ErrStreamNotSupported(ctx.BlockHeight())
+		}
+
+		switch msg := msg.(type) {
+		case types.MsgCreateStream:
+			return handleMsgCreateStream(ctx, k, msg)
+		case types.MsgWithdrawStream:
+			return handleMsgWithdrawStream(ctx, k, msg)
+		case types.MsgCancelStream:
+			return handleMsgCancelStream(ctx, k, msg)
+		default:
+			return nil, types.ErrUnknownStreamMsgType(fmt.Sprintf("%T", msg))
+		}
+	}
+}
+
+func handleMsgCreateStream(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateStream) (*sdk.Result, error) {
+	stream, err := k.CreateStream(ctx, msg.Sender, msg.Recipient, msg.Deposit, msg.StartTime, msg.StopTime)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(stream.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, stream.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, stream.Deposit.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, stream.StartTime.String()),
+			sdk.NewAttribute(types.AttributeKeyStopTime, stream.StopTime.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgWithdrawStream(ctx sdk.Context, k keeper.Keeper, msg types.MsgWithdrawStream) (*sdk.Result, error) {
+	stream, found := k.GetStream(ctx, msg.StreamID)
+	if !found {
+		return nil, types.ErrNoStreamFound(msg.StreamID)
+	}
+	if !stream.Recipient.Equals(msg.Recipient) {
+		return nil, types.ErrNotStreamRecipient(msg.Recipient.String(), msg.StreamID)
+	}
+
+	withdrawn, err := k.WithdrawFromStream(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(stream.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, withdrawn.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Recipient.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelStream(ctx sdk.Context, k keeper.Keeper, msg types.MsgCancelStream) (*sdk.Result, error) {
+	stream, found := k.GetStream(ctx, msg.StreamID)
+	if !found {
+		return nil, types.ErrNoStreamFound(msg.StreamID)
+	}
+	if !stream.Sender.Equals(msg.Address) && !stream.Recipient.Equals(msg.Address) {
+		return nil, types.ErrNotStreamParticipant(msg.Address.String(), msg.StreamID)
+	}
+
+	recipientShare, senderRefund, err := k.CancelStream(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(stream.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, stream.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipientShare, recipientShare.String()),
+			sdk.NewAttribute(types.AttributeKeySenderRefund, senderRefund.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
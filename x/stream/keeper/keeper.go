@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/x/stream/types"
+)
+
+// Keeper of the stream store
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	supplyKeeper types.SupplyKeeper
+	tokenKeeper  types.TokenKeeper
+}
+
+// NewKeeper creates a stream keeper
+func NewKeeper(supplyKeeper types.SupplyKeeper, tokenKeeper types.TokenKeeper, key sdk.StoreKey, cdc *codec.Codec) Keeper {
+	return Keeper{
+		storeKey:     key,
+		cdc:          cdc,
+		supplyKeeper: supplyKeeper,
+		tokenKeeper:  tokenKeeper,
+	}
+}
+
+// SupplyKeeper returns the supply keeper
+func (k Keeper) SupplyKeeper() types.SupplyKeeper {
+	return k.supplyKeeper
+}
+
+// TokenKeeper returns the token keeper
+func (k Keeper) TokenKeeper() types.TokenKeeper {
+	return k.tokenKeeper
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// GetNextStreamID gets the id for the next stream
+func (k Keeper) GetNextStreamID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.StreamIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextStreamID sets the id for the next stream
+func (k Keeper) SetNextStreamID(ctx sdk.Context, streamID uint64) {
+	ctx.KVStore(k.storeKey).Set(types.StreamIDKey, sdk.Uint64ToBigEndian(streamID))
+}
+
+// GetStream gets a stream from store
+func (k Keeper) GetStream(ctx sdk.Context, streamID uint64) (stream types.Stream, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetStreamKey(streamID))
+	if bz == nil {
+		return stream, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &stream)
+	return stream, true
+}
+
+// SetStream sets a stream and its indexes by the sender and the recipient into store
+func (k Keeper) SetStream(ctx sdk.Context, stream types.Stream) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetStreamKey(stream.ID), k.cdc.MustMarshalBinaryLengthPrefixed(stream))
+	store.Set(types.GetSenderStreamKey(stream.Sender, stream.ID), []byte{})
+	store.Set(types.GetRecipientStreamKey(stream.Recipient, stream.ID), []byte{})
+}
+
+// DeleteStream deletes a stream and its indexes from store
+func (k Keeper) DeleteStream(ctx sdk.Context, stream types.Stream) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetStreamKey(stream.ID))
+	store.Delete(types.GetSenderStreamKey(stream.Sender, stream.ID))
+	store.Delete(types.GetRecipientStreamKey(stream.Recipient, stream.ID))
+}
+
+// IterateStreams iterates over all the streams
+func (k Keeper) IterateStreams(ctx sdk.Context, handler func(stream types.Stream) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.StreamPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stream types.Stream
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &stream)
+		if handler(stream) {
+			break
+		}
+	}
+}
+
+// GetStreams gets all the streams
+func (k Keeper) GetStreams(ctx sdk.Context) (streams types.Streams) {
+	k.IterateStreams(ctx, func(stream types.Stream) bool {
+		streams = append(streams, stream)
+		return false
+	})
+	return
+}
+
+// GetStreamsBySender gets all the streams paid by an address
+func (k Keeper) GetStreamsBySender(ctx sdk.Context, sender sdk.AccAddress) types.Streams {
+	return k.getIndexedStreams(ctx, types.GetSenderStreamsPrefix(sender), func(stream types.Stream) bool {
+		return stream.Sender.Equals(sender)
+	})
+}
+
+// GetStreamsByRecipient gets all the streams paid to an address
+func (k Keeper) GetStreamsByRecipient(ctx sdk.Context, recipient sdk.AccAddress) types.Streams {
+	return k.getIndexedStreams(ctx, types.GetRecipientStreamsPrefix(recipient), func(stream types.Stream) bool {
+		return stream.Recipient.Equals(recipient)
+	})
+}
+
+// getIndexedStreams gets the streams from an index. The addresses of different lengths may share the prefix,
+// so the streams are filtered by the participant again
+func (k Keeper) getIndexedStreams(ctx sdk.Context, prefix []byte, filter func(stream types.Stream) bool) (streams types.Streams) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		stream, found := k.GetStream(ctx, binary.BigEndian.Uint64(key[len(key)-8:]))
+		if !found {
+			panic("the indexed stream can't be found")
+		}
+		if filter(stream) {
+			streams = append(streams, stream)
+		}
+	}
+	return
+}
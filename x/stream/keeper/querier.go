@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/common"
+	"github.com/okex/exchain/x/stream/types"
+)
+
+// NewQuerier creates a new querier for stream clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryStream:
+			return queryStream(ctx, req, k)
+		case types.QueryStreams:
+			return queryStreams(ctx, req, k)
+		default:
+			return nil, types.ErrUnknownStreamQueryType(path[0])
+		}
+	}
+}
+
+func queryStream(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryStreamParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	stream, found := k.GetStream(ctx, params.StreamID)
+	if !found {
+		return nil, types.ErrNoStreamFound(params.StreamID)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewStreamResponse(stream, stream.WithdrawableAmount(ctx.BlockTime())))
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
+
+// queryStreams returns the streams paid by the address followed by the ones paid to it
+func queryStreams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryStreamsParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	streams := append(k.GetStreamsBySender(ctx, params.Address), k.GetStreamsByRecipient(ctx, params.Address)...)
+	responses := make([]types.StreamResponse, 0, len(streams))
+	for _, stream := range streams {
+		responses = append(responses, types.NewStreamResponse(stream, stream.WithdrawableAmount(ctx.BlockTime())))
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, responses)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
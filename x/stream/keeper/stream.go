@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/stream/types"
+)
+
+// CreateStream escrows the deposit from the sender into the module account and creates the stream
+func (k Keeper) CreateStream(ctx sdk.Context, sender, recipient sdk.AccAddress, deposit sdk.SysCoin,
+	startTime, stopTime time.Time) (types.Stream, error) {
+	if !stopTime.After(ctx.BlockTime()) {
+		return types.Stream{}, types.ErrInvalidStreamTime("stop time should be after the block time")
+	}
+	if k.tokenKeeper.IsContractAddress(ctx, recipient) {
+		return types.Stream{}, types.ErrBlockedRecipient(recipient.String())
+	}
+	if err := k.tokenKeeper.CheckTransferAllowed(ctx, sender, recipient, deposit.ToCoins()); err != nil {
+		return types.Stream{}, err
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, deposit.ToCoins()); err != nil {
+		return types.Stream{}, types.ErrSendCoinsFromAccountToModuleFailed(err.Error())
+	}
+
+	stream := types.NewStream(k.GetNextStreamID(ctx), sender, recipient, deposit, startTime, stopTime)
+	k.SetStream(ctx, stream)
+	k.SetNextStreamID(ctx, stream.ID+1)
+	return stream, nil
+}
+
+// WithdrawFromStream pays all the accrued part of the stream not withdrawn yet to the recipient. The stream is
+// removed once the whole deposit is withdrawn
+func (k Keeper) WithdrawFromStream(ctx sdk.Context, stream types.Stream) (sdk.SysCoin, error) {
+	withdrawable := sdk.NewDecCoinFromDec(stream.Deposit.Denom, stream.WithdrawableAmount(ctx.BlockTime()))
+	if !withdrawable.IsPositive() {
+		return withdrawable, types.ErrNothingToWithdraw(stream.ID, ctx.BlockTime())
+	}
+	if err := k.tokenKeeper.CheckTransferAllowed(ctx, stream.Sender, stream.Recipient, withdrawable.ToCoins()); err != nil {
+		return withdrawable, err
+	}
+	if err := k.payFromStream(ctx, stream.Recipient, withdrawable); err != nil {
+		return withdrawable, err
+	}
+
+	stream.Withdrawn = stream.Withdrawn.Add(withdrawable.Amount)
+	if stream.RemainingAmount().IsZero() {
+		k.DeleteStream(ctx, stream)
+	} else {
+		k.SetStream(ctx, stream)
+	}
+	return withdrawable, nil
+}
+
+// CancelStream pays the accrued part of the stream not withdrawn yet to the recipient, refunds the rest of the
+// deposit to the sender and removes the stream
+func (k Keeper) CancelStream(ctx sdk.Context, stream types.Stream) (recipientShare, senderRefund sdk.SysCoin, err error) {
+	withdrawable := stream.WithdrawableAmount(ctx.BlockTime())
+	recipientShare = sdk.NewDecCoinFromDec(stream.Deposit.Denom, withdrawable)
+	senderRefund = sdk.NewDecCoinFromDec(stream.Deposit.Denom, stream.RemainingAmount().Sub(withdrawable))
+
+	if recipientShare.IsPositive() {
+		if err = k.tokenKeeper.CheckTransferAllowed(ctx, stream.Sender, stream.Recipient, recipientShare.ToCoins()); err != nil {
+			return
+		}
+		if err = k.payFromStream(ctx, stream.Recipient, recipientShare); err != nil {
+			return
+		}
+	}
+	if senderRefund.IsPositive() {
+		if err = k.payFromStream(ctx, stream.Sender, senderRefund); err != nil {
+			return
+		}
+	}
+
+	k.DeleteStream(ctx, stream)
+	return
+}
+
+func (k Keeper) payFromStream(ctx sdk.Context, addr sdk.AccAddress, amount sdk.SysCoin) error {
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amount.ToCoins()); err != nil {
+		return types.ErrSendCoinsFromModuleToAccountFailed(err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateStream{}, "okexchain/stream/MsgCreateStream", nil)
+	cdc.RegisterConcrete(MsgWithdrawStream{}, "okexchain/stream/MsgWithdrawStream", nil)
+	cdc.RegisterConcrete(MsgCancelStream{}, "okexchain/stream/MsgCancelStream", nil)
+}
+
+// ModuleCdc defines the module codec
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
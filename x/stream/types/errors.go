@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+)
+
+const (
+	DefaultCodespace string = ModuleName
+
+	CodeInvalidAddress                     uint32 = 69000
+	CodeInvalidDeposit                     uint32 = 69001
+	CodeInvalidStreamTime                  uint32 = 69002
+	CodeNoStreamFound                      uint32 = 69003
+	CodeNotStreamRecipient                 uint32 = 69004
+	CodeNotStreamParticipant               uint32 = 69005
+	CodeNothingToWithdraw                  uint32 = 69006
+	CodeBlockedRecipient                   uint32 = 69007
+	CodeSendCoinsFromAccountToModuleFailed uint32 = 69008
+	CodeSendCoinsFromModuleToAccountFailed uint32 = 69009
+	CodeUnknownStreamMsgType               uint32 = 69010
+	CodeUnknownStreamQueryType             uint32 = 69011
+	CodeStreamNotSupported                 uint32 = 69012
+)
+
+// ErrInvalidAddress returns an error when an address is invalid
+func ErrInvalidAddress(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidAddress, fmt.Sprintf("failed. invalid address: %s", msg))}
+}
+
+// ErrInvalidDeposit returns an error when the deposit of a stream is invalid
+func ErrInvalidDeposit(deposit string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidDeposit, fmt.Sprintf("failed. invalid deposit %s", deposit))}
+}
+
+// ErrInvalidStreamTime returns an error when the start time and the stop time of a stream are invalid
+func ErrInvalidStreamTime(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidStreamTime, fmt.Sprintf("failed. invalid stream time: %s", msg))}
+}
+
+// ErrNoStreamFound returns an error when a stream doesn't exist
+func ErrNoStreamFound(streamID uint64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNoStreamFound, fmt.Sprintf("failed. stream %d does not exist", streamID))}
+}
+
+// ErrNotStreamRecipient returns an error when an address other than the recipient withdraws from a stream
+func ErrNotStreamRecipient(addr string, streamID uint64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNotStreamRecipient, fmt.Sprintf("failed. %s is not the recipient of stream %d", addr, streamID))}
+}
+
+// ErrNotStreamParticipant returns an error when an address neither the sender nor the recipient cancels a stream
+func ErrNotStreamParticipant(addr string, streamID uint64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNotStreamParticipant, fmt.Sprintf("failed. %s is neither the sender nor the recipient of stream %d", addr, streamID))}
+}
+
+// ErrNothingToWithdraw returns an error when nothing of a stream has accrued since the last withdrawal
+func ErrNothingToWithdraw(streamID uint64, blockTime time.Time) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNothingToWithdraw, fmt.Sprintf("failed. nothing of stream %d is withdrawable at %s", streamID, blockTime))}
+}
+
+// ErrBlockedRecipient returns an error when the recipient of a stream is not allowed to receive the tokens
+func ErrBlockedRecipient(addr string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeBlockedRecipient, fmt.Sprintf("failed. %s is not allowed to receive a stream", addr))}
+}
+
+// ErrSendCoinsFromAccountToModuleFailed returns an error when it fails to send coins from an account to the module
+func ErrSendCoinsFromAccountToModuleFailed(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeSendCoinsFromAccountToModuleFailed, fmt.Sprintf("failed. send coins from account to module failed: %s", msg))}
+}
+
+// ErrSendCoinsFromModuleToAccountFailed returns an error when it fails to send coins from the module to an account
+func ErrSendCoinsFromModuleToAccountFailed(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeSendCoinsFromModuleToAccountFailed, fmt.Sprintf("failed. send coins from module to account failed: %s", msg))}
+}
+
+// ErrUnknownStreamMsgType returns an error when the msg type is unknown
+func ErrUnknownStreamMsgType(msgType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownStreamMsgType, fmt.Sprintf("unrecognized stream message type: %s", msgType))}
+}
+
+// ErrUnknownStreamQueryType returns an error when the query path is unknown
+func ErrUnknownStreamQueryType(path string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownStreamQueryType, fmt.Sprintf("unknown stream query endpoint: %s", path))}
+}
+
+// ErrStreamNotSupported returns an error when the stream module is not enabled at the height
+func ErrStreamNotSupported(height int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeStreamNotSupported, fmt.Sprintf("stream module is not supported at height %d", height))}
+}
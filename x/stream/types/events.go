@@ -0,0 +1,19 @@
+package types
+
+// stream module event types
+const (
+	EventTypeCreateStream   = "create_stream"
+	EventTypeWithdrawStream = "withdraw_stream"
+	EventTypeCancelStream   = "cancel_stream"
+
+	AttributeKeyStreamID       = "stream_id"
+	AttributeKeySender         = "sender"
+	AttributeKeyRecipient      = "recipient"
+	AttributeKeyDeposit        = "deposit"
+	AttributeKeyStartTime      = "start_time"
+	AttributeKeyStopTime       = "stop_time"
+	AttributeKeyRecipientShare = "recipient_share"
+	AttributeKeySenderRefund   = "sender_refund"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	supplyexported "github.com/okex/exchain/libs/cosmos-sdk/x/supply/exported"
+)
+
+// SupplyKeeper defines the expected supply keeper to escrow the deposits of the streams
+type SupplyKeeper interface {
+	GetModuleAccount(ctx sdk.Context, moduleName string) supplyexported.ModuleAccountI
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// TokenKeeper defines the expected token keeper to check the transfers of the streamed tokens
+type TokenKeeper interface {
+	IsContractAddress(ctx sdk.Context, addr sdk.AccAddress) bool
+	CheckTransferAllowed(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.SysCoins) error
+}
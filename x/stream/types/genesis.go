@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState is the state of the stream module that must be provided at genesis
+type GenesisState struct {
+	Streams      Streams `json:"streams" yaml:"streams"`
+	NextStreamID uint64  `json:"next_stream_id" yaml:"next_stream_id"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(streams Streams, nextStreamID uint64) GenesisState {
+	return GenesisState{
+		Streams:      streams,
+		NextStreamID: nextStreamID,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(nil, 1)
+}
+
+// ValidateGenesis validates the stream genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	if data.NextStreamID == 0 {
+		return fmt.Errorf("next stream id should be positive")
+	}
+	ids := make(map[uint64]bool, len(data.Streams))
+	for _, stream := range data.Streams {
+		if err := stream.ValidateBasic(); err != nil {
+			return err
+		}
+		if ids[stream.ID] {
+			return fmt.Errorf("duplicated stream id %d", stream.ID)
+		}
+		if stream.ID == 0 || stream.ID >= data.NextStreamID {
+			return fmt.Errorf("stream id %d should be in [1, %d)", stream.ID, data.NextStreamID)
+		}
+		ids[stream.ID] = true
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the stream module
+	ModuleName = "stream"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the stream module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the stream module
+	QuerierRoute = ModuleName
+)
+
+var (
+	StreamPrefix          = []byte{0x01}
+	SenderStreamPrefix    = []byte{0x02}
+	RecipientStreamPrefix = []byte{0x03}
+	StreamIDKey           = []byte{0x04}
+)
+
+// GetStreamKey gets the key for a stream
+func GetStreamKey(streamID uint64) []byte {
+	return append(StreamPrefix, sdk.Uint64ToBigEndian(streamID)...)
+}
+
+// GetSenderStreamsPrefix gets the prefix key for all the streams paid by an address
+func GetSenderStreamsPrefix(sender sdk.AccAddress) []byte {
+	return append(SenderStreamPrefix, sender.Bytes()...)
+}
+
+// GetSenderStreamKey gets the key for the index of a stream paid by an address
+func GetSenderStreamKey(sender sdk.AccAddress, streamID uint64) []byte {
+	return append(GetSenderStreamsPrefix(sender), sdk.Uint64ToBigEndian(streamID)...)
+}
+
+// GetRecipientStreamsPrefix gets the prefix key for all the streams paid to an address
+func GetRecipientStreamsPrefix(recipient sdk.AccAddress) []byte {
+	return append(RecipientStreamPrefix, recipient.Bytes()...)
+}
+
+// GetRecipientStreamKey gets the key for the index of a stream paid to an address
+func GetRecipientStreamKey(recipient sdk.AccAddress, streamID uint64) []byte {
+	return append(GetRecipientStreamsPrefix(recipient), sdk.Uint64ToBigEndian(streamID)...)
+}
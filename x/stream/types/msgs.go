@@ -0,0 +1,131 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = MsgCreateStream{}
+	_ sdk.Msg = MsgWithdrawStream{}
+	_ sdk.Msg = MsgCancelStream{}
+)
+
+// MsgCreateStream creates a stream paying the deposit to the recipient from the start time to the stop time
+type MsgCreateStream struct {
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Deposit   sdk.SysCoin    `json:"deposit" yaml:"deposit"`
+	StartTime time.Time      `json:"start_time" yaml:"start_time"`
+	StopTime  time.Time      `json:"stop_time" yaml:"stop_time"`
+}
+
+// NewMsgCreateStream creates a new instance of MsgCreateStream
+func NewMsgCreateStream(sender, recipient sdk.AccAddress, deposit sdk.SysCoin, startTime, stopTime time.Time) MsgCreateStream {
+	return MsgCreateStream{
+		Sender:    sender,
+		Recipient: recipient,
+		Deposit:   deposit,
+		StartTime: startTime,
+		StopTime:  stopTime,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCreateStream) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCreateStream) Type() string { return "create_stream" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateStream) ValidateBasic() sdk.Error {
+	return validateStream(msg.Sender, msg.Recipient, msg.Deposit, msg.StartTime, msg.StopTime)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCreateStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateStream) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgWithdrawStream withdraws all the accrued part of a stream to its recipient
+type MsgWithdrawStream struct {
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	StreamID  uint64         `json:"stream_id" yaml:"stream_id"`
+}
+
+// NewMsgWithdrawStream creates a new instance of MsgWithdrawStream
+func NewMsgWithdrawStream(recipient sdk.AccAddress, streamID uint64) MsgWithdrawStream {
+	return MsgWithdrawStream{
+		Recipient: recipient,
+		StreamID:  streamID,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgWithdrawStream) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgWithdrawStream) Type() string { return "withdraw_stream" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWithdrawStream) ValidateBasic() sdk.Error {
+	if msg.Recipient.Empty() {
+		return ErrInvalidAddress("recipient is empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgWithdrawStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgWithdrawStream) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Recipient}
+}
+
+// MsgCancelStream cancels a stream by its sender or recipient. The accrued part not withdrawn is paid to the
+// recipient and the rest of the deposit is refunded to the sender
+type MsgCancelStream struct {
+	Address  sdk.AccAddress `json:"address" yaml:"address"`
+	StreamID uint64         `json:"stream_id" yaml:"stream_id"`
+}
+
+// NewMsgCancelStream creates a new instance of MsgCancelStream
+func NewMsgCancelStream(addr sdk.AccAddress, streamID uint64) MsgCancelStream {
+	return MsgCancelStream{
+		Address:  addr,
+		StreamID: streamID,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelStream) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelStream) Type() string { return "cancel_stream" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelStream) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrInvalidAddress("address is empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelStream) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// QueryStream is the query endpoint of a stream
+	QueryStream = "stream"
+	// QueryStreams is the query endpoint of the streams of an address
+	QueryStreams = "streams"
+)
+
+// QueryStreamParams is the params of the query of a stream
+type QueryStreamParams struct {
+	StreamID uint64 `json:"stream_id"`
+}
+
+// NewQueryStreamParams creates a new instance of QueryStreamParams
+func NewQueryStreamParams(streamID uint64) QueryStreamParams {
+	return QueryStreamParams{
+		StreamID: streamID,
+	}
+}
+
+// QueryStreamsParams is the params of the query of the streams paid by or to an address
+type QueryStreamsParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// NewQueryStreamsParams creates a new instance of QueryStreamsParams
+func NewQueryStreamsParams(addr sdk.AccAddress) QueryStreamsParams {
+	return QueryStreamsParams{
+		Address: addr,
+	}
+}
+
+// StreamResponse is a stream with the amounts accrued to the recipient at the height of the query
+type StreamResponse struct {
+	Stream
+	Withdrawable sdk.Dec `json:"withdrawable" yaml:"withdrawable"`
+	Remaining    sdk.Dec `json:"remaining" yaml:"remaining"`
+}
+
+// NewStreamResponse creates a new instance of StreamResponse
+func NewStreamResponse(stream Stream, withdrawable sdk.Dec) StreamResponse {
+	return StreamResponse{
+		Stream:       stream,
+		Withdrawable: withdrawable,
+		Remaining:    stream.RemainingAmount(),
+	}
+}
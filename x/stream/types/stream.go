@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// Stream is a continuous payment from Sender to Recipient. The deposit accrues to the recipient linearly
+// from StartTime to StopTime, and the recipient can withdraw the accrued part at any time
+type Stream struct {
+	ID        uint64         `json:"id" yaml:"id"`
+	Sender    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Deposit   sdk.SysCoin    `json:"deposit" yaml:"deposit"`
+	Withdrawn sdk.Dec        `json:"withdrawn" yaml:"withdrawn"`
+	StartTime time.Time      `json:"start_time" yaml:"start_time"`
+	StopTime  time.Time      `json:"stop_time" yaml:"stop_time"`
+}
+
+// NewStream creates a new instance of Stream
+func NewStream(id uint64, sender, recipient sdk.AccAddress, deposit sdk.SysCoin, startTime, stopTime time.Time) Stream {
+	return Stream{
+		ID:        id,
+		Sender:    sender,
+		Recipient: recipient,
+		Deposit:   deposit,
+		Withdrawn: sdk.ZeroDec(),
+		StartTime: startTime,
+		StopTime:  stopTime,
+	}
+}
+
+// AccruedAmount returns the amount of the deposit accrued to the recipient at blockTime, withdrawn or not
+func (s Stream) AccruedAmount(blockTime time.Time) sdk.Dec {
+	switch {
+	case !blockTime.After(s.StartTime):
+		return sdk.ZeroDec()
+	case !blockTime.Before(s.StopTime):
+		return s.Deposit.Amount
+	default:
+		elapsed := blockTime.Sub(s.StartTime).Nanoseconds()
+		duration := s.StopTime.Sub(s.StartTime).Nanoseconds()
+		return s.Deposit.Amount.MulInt64(elapsed).QuoInt64(duration)
+	}
+}
+
+// WithdrawableAmount returns the amount the recipient can withdraw at blockTime
+func (s Stream) WithdrawableAmount(blockTime time.Time) sdk.Dec {
+	return s.AccruedAmount(blockTime).Sub(s.Withdrawn)
+}
+
+// RemainingAmount returns the amount of the deposit not withdrawn yet
+func (s Stream) RemainingAmount() sdk.Dec {
+	return s.Deposit.Amount.Sub(s.Withdrawn)
+}
+
+// ValidateBasic checks the participants, the deposit and the time of the stream
+func (s Stream) ValidateBasic() sdk.Error {
+	if err := validateStream(s.Sender, s.Recipient, s.Deposit, s.StartTime, s.StopTime); err != nil {
+		return err
+	}
+	if s.Withdrawn.IsNil() || s.Withdrawn.IsNegative() || s.Withdrawn.GT(s.Deposit.Amount) {
+		return ErrInvalidDeposit(fmt.Sprintf("withdrawn %s of deposit %s", s.Withdrawn, s.Deposit))
+	}
+	return nil
+}
+
+// String returns a human readable string representation of Stream
+func (s Stream) String() string {
+	return fmt.Sprintf(`Stream:
+  ID:          %d
+  Sender:      %s
+  Recipient:   %s
+  Deposit:     %s
+  Withdrawn:   %s
+  Start Time:  %s
+  Stop Time:   %s`,
+		s.ID, s.Sender, s.Recipient, s.Deposit, s.Withdrawn, s.StartTime, s.StopTime)
+}
+
+// Streams is a collection of Stream
+type Streams []Stream
+
+// String returns a human readable string representation of Streams
+func (ss Streams) String() (out string) {
+	for _, s := range ss {
+		out += s.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+func validateStream(sender, recipient sdk.AccAddress, deposit sdk.SysCoin, startTime, stopTime time.Time) sdk.Error {
+	if sender.Empty() {
+		return ErrInvalidAddress("sender is empty")
+	}
+	if recipient.Empty() {
+		return ErrInvalidAddress("recipient is empty")
+	}
+	if sender.Equals(recipient) {
+		return ErrInvalidAddress("sender and recipient should be different")
+	}
+	if deposit.Amount.IsNil() || !deposit.IsValid() || !deposit.IsPositive() {
+		return ErrInvalidDeposit(deposit.String())
+	}
+	if !stopTime.After(startTime) {
+		return ErrInvalidStreamTime(fmt.Sprintf("stop time %s should be after start time %s", stopTime, startTime))
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testSender    = sdk.AccAddress([]byte("testStreamSender"))
+	testRecipient = sdk.AccAddress([]byte("testStreamRecipient"))
+	testStartTime = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestStreamAmounts(t *testing.T) {
+	stream := NewStream(1, testSender, testRecipient, sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)),
+		testStartTime, testStartTime.Add(100*time.Second))
+
+	tests := []struct {
+		name      string
+		blockTime time.Time
+		accrued   sdk.Dec
+	}{
+		{"before start", testStartTime.Add(-time.Second), sdk.ZeroDec()},
+		{"at start", testStartTime, sdk.ZeroDec()},
+		{"in the middle", testStartTime.Add(25 * time.Second), sdk.NewDec(25)},
+		{"at stop", testStartTime.Add(100 * time.Second), sdk.NewDec(100)},
+		{"after stop", testStartTime.Add(time.Hour), sdk.NewDec(100)},
+	}
+	for _, tc := range tests {
+		require.True(t, tc.accrued.Equal(stream.AccruedAmount(tc.blockTime)), tc.name)
+		require.True(t, tc.accrued.Equal(stream.WithdrawableAmount(tc.blockTime)), tc.name)
+	}
+
+	stream.Withdrawn = sdk.NewDec(25)
+	require.True(t, sdk.NewDec(75).Equal(stream.RemainingAmount()))
+	require.True(t, sdk.ZeroDec().Equal(stream.WithdrawableAmount(testStartTime.Add(25*time.Second))))
+	require.True(t, sdk.NewDec(25).Equal(stream.WithdrawableAmount(testStartTime.Add(50*time.Second))))
+	require.True(t, sdk.NewDec(75).Equal(stream.WithdrawableAmount(testStartTime.Add(time.Hour))))
+}
+
+func TestStreamValidateBasic(t *testing.T) {
+	deposit := sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100))
+	stopTime := testStartTime.Add(time.Hour)
+
+	stream := NewStream(1, testSender, testRecipient, deposit, testStartTime, stopTime)
+	require.Nil(t, stream.ValidateBasic())
+
+	stream.Withdrawn = sdk.NewDec(101)
+	require.NotNil(t, stream.ValidateBasic())
+	stream.Withdrawn = sdk.NewDec(-1)
+	require.NotNil(t, stream.ValidateBasic())
+	stream.Withdrawn = sdk.Dec{}
+	require.NotNil(t, stream.ValidateBasic())
+
+	require.NotNil(t, NewStream(1, nil, testRecipient, deposit, testStartTime, stopTime).ValidateBasic())
+	require.NotNil(t, NewStream(1, testSender, nil, deposit, testStartTime, stopTime).ValidateBasic())
+	require.NotNil(t, NewStream(1, testSender, testSender, deposit, testStartTime, stopTime).ValidateBasic())
+	require.NotNil(t, NewStream(1, testSender, testRecipient, sdk.NewDecCoinFromDec("xxb", sdk.ZeroDec()),
+		testStartTime, stopTime).ValidateBasic())
+	require.NotNil(t, NewStream(1, testSender, testRecipient, deposit, testStartTime, testStartTime).ValidateBasic())
+}
+
+func TestStreamMsgsValidateBasic(t *testing.T) {
+	deposit := sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100))
+	stopTime := testStartTime.Add(time.Hour)
+
+	require.Nil(t, NewMsgCreateStream(testSender, testRecipient, deposit, testStartTime, stopTime).ValidateBasic())
+	require.NotNil(t, NewMsgCreateStream(testSender, testRecipient, deposit, stopTime, testStartTime).ValidateBasic())
+	require.NotNil(t, NewMsgCreateStream(nil, testRecipient, deposit, testStartTime, stopTime).ValidateBasic())
+
+	require.Nil(t, NewMsgWithdrawStream(testRecipient, 1).ValidateBasic())
+	require.NotNil(t, NewMsgWithdrawStream(nil, 1).ValidateBasic())
+
+	require.Nil(t, NewMsgCancelStream(testSender, 1).ValidateBasic())
+	require.NotNil(t, NewMsgCancelStream(nil, 1).ValidateBasic())
+}
+
+func TestValidateGenesis(t *testing.T) {
+	deposit := sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100))
+	stream := NewStream(1, testSender, testRecipient, deposit, testStartTime, testStartTime.Add(time.Hour))
+
+	require.Nil(t, ValidateGenesis(DefaultGenesisState()))
+	require.Nil(t, ValidateGenesis(GenesisState{Streams: Streams{stream}, NextStreamID: 2}))
+	require.NotNil(t, ValidateGenesis(GenesisState{Streams: Streams{stream}, NextStreamID: 1}))
+	require.NotNil(t, ValidateGenesis(GenesisState{Streams: Streams{stream, stream}, NextStreamID: 2}))
+}
@@ -26,6 +26,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	queryCmd.AddCommand(flags.GetCommands(
 		getCmdQueryParams(queryRoute, cdc),
 		getCmdTokenInfo(queryRoute, cdc),
+		getCmdTokenControl(queryRoute, cdc),
+		getCmdTokenMetadata(queryRoute, cdc),
 		//getAccountCmd(queryRoute, cdc),
 	)...)
 
@@ -79,6 +81,58 @@ func getCmdTokenInfo(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// getCmdTokenControl queries the pause status and the frozen addresses of a token
+func getCmdTokenControl(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "control [symbol]",
+		Short: "query the pause status and the frozen addresses of a token",
+		Long: strings.TrimSpace(`Query the pause status and the frozen addresses of a token:
+
+$ exchaincli query token control xxb
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryControl, args[0])
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var control types.TokenControl
+			cdc.MustUnmarshalJSON(bz, &control)
+			return cliCtx.PrintOutput(control)
+		},
+	}
+}
+
+// getCmdTokenMetadata queries the metadata of a token
+func getCmdTokenMetadata(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "metadata [symbol]",
+		Short: "query the metadata uri, display denom and description of a token",
+		Long: strings.TrimSpace(`Query the metadata uri, display denom and description of a token:
+
+$ exchaincli query token metadata xxb
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryMetadata, args[0])
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var metadata types.TokenMetadataResp
+			cdc.MustUnmarshalJSON(bz, &metadata)
+			return cliCtx.PrintOutput(metadata)
+		},
+	}
+}
+
 // getCmdQueryParams implements the query params command.
 func getCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -10,10 +10,14 @@ import (
 	"github.com/okex/exchain/libs/cosmos-sdk/client"
 	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	interfacetypes "github.com/okex/exchain/libs/cosmos-sdk/codec/types"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/version"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	authTypes "github.com/okex/exchain/libs/cosmos-sdk/x/auth"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/auth/client/utils"
+	"github.com/okex/exchain/x/gov"
+	tokenutils "github.com/okex/exchain/x/token/client/utils"
 	"github.com/okex/exchain/x/token/types"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -31,6 +35,8 @@ const (
 	Mintable      = "mintable"
 	Transfers     = "transfers"
 	TransfersFile = "transfers-file"
+	URI           = "uri"
+	DisplayDenom  = "display-denom"
 )
 
 const (
@@ -69,6 +75,11 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		getCmdTransferOwnership(cdc),
 		getCmdConfirmOwnership(cdc),
 		getCmdTokenEdit(cdc),
+		getCmdUpdateTokenMetadata(cdc),
+		getCmdTokenPause(cdc, true),
+		getCmdTokenPause(cdc, false),
+		getCmdTokenFreeze(cdc, true),
+		getCmdTokenFreeze(cdc, false),
 	)...)
 
 	return distTxCmd
@@ -427,6 +438,55 @@ func getCmdTokenEdit(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// getCmdUpdateTokenMetadata is the CLI command for sending a UpdateTokenMetadata transaction
+func getCmdUpdateTokenMetadata(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-metadata",
+		Short: "set the metadata uri, display denom and desc of a token",
+		Long: strings.TrimSpace(`Set the metadata uri, display denom and desc of a token. The values not specified are cleared:
+
+$ exchaincli tx token update-metadata -s xxb --uri https://example.com/xxb.json --display-denom XXB --desc "xxb token"
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := authTypes.NewAccountRetriever(cliCtx).EnsureExists(cliCtx.FromAddress); err != nil {
+				return err
+			}
+
+			flags := cmd.Flags()
+			symbol, err := flags.GetString(Symbol)
+			if err != nil {
+				return errSymbolNotValid
+			}
+			uri, err := flags.GetString(URI)
+			if err != nil {
+				return err
+			}
+			displayDenom, err := flags.GetString(DisplayDenom)
+			if err != nil {
+				return err
+			}
+			tokenDesc, err := flags.GetString(TokenDesc)
+			if err != nil || len(tokenDesc) > TokenDescLenLimit {
+				return errTokenDescNotValid
+			}
+
+			msg := types.NewMsgUpdateTokenMetadata(cliCtx.FromAddress, symbol, uri, displayDenom, tokenDesc)
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().StringP(Symbol, "s", "", "symbol of the token")
+	cmd.Flags().String(URI, "", "uri of the metadata of the token, e.g. the logo")
+	cmd.Flags().String(DisplayDenom, "", "denom displayed by wallets")
+	cmd.Flags().String(TokenDesc, "", "description of the token")
+
+	return cmd
+}
+
 // getCmdConfirmOwnership is the CLI command for sending a ConfirmOwnership transaction
 func getCmdConfirmOwnership(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -460,3 +520,125 @@ func getCmdConfirmOwnership(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().StringP("symbol", "s", "", "symbol of the token to be transferred")
 	return cmd
 }
+
+// getCmdTokenPause is the CLI command for sending a TokenPause transaction
+func getCmdTokenPause(cdc *codec.Codec, paused bool) *cobra.Command {
+	use, short := "pause", "pause all the transfers of the token"
+	if !paused {
+		use, short = "unpause", "resume the transfers of the paused token"
+	}
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			if err := authTypes.NewAccountRetriever(cliCtx).EnsureExists(cliCtx.FromAddress); err != nil {
+				return err
+			}
+
+			symbol, err := cmd.Flags().GetString(Symbol)
+			if err != nil {
+				return errSymbolNotValid
+			}
+
+			msg := types.NewMsgTokenPause(cliCtx.GetFromAddress(), symbol, paused)
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().StringP(Symbol, "s", "", "symbol of the token")
+	return cmd
+}
+
+// getCmdTokenFreeze is the CLI command for sending a TokenFreeze transaction
+func getCmdTokenFreeze(cdc *codec.Codec, frozen bool) *cobra.Command {
+	use, short := "freeze [address...]", "freeze the addresses from sending or receiving the token"
+	if !frozen {
+		use, short = "unfreeze [address...]", "unfreeze the frozen addresses of the token"
+	}
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			if err := authTypes.NewAccountRetriever(cliCtx).EnsureExists(cliCtx.FromAddress); err != nil {
+				return err
+			}
+
+			symbol, err := cmd.Flags().GetString(Symbol)
+			if err != nil {
+				return errSymbolNotValid
+			}
+
+			addrs := make([]sdk.AccAddress, 0, len(args))
+			for _, arg := range args {
+				addr, err := sdk.AccAddressFromBech32(arg)
+				if err != nil {
+					return fmt.Errorf("invalid address：%s", arg)
+				}
+				addrs = append(addrs, addr)
+			}
+
+			msg := types.NewMsgTokenFreeze(cliCtx.GetFromAddress(), symbol, addrs, frozen)
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().StringP(Symbol, "s", "", "symbol of the token")
+	return cmd
+}
+
+// GetCmdTokenControlProposal implements a command handler for submitting a token control proposal transaction
+func GetCmdTokenControlProposal(cdcP *codec.CodecProxy, reg interfacetypes.InterfaceRegistry) *cobra.Command {
+	return &cobra.Command{
+		Use:   "token-control [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to pause, unpause a token or freeze, unfreeze the addresses of a token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a token control proposal along with an initial deposit, which overrides the controls of
+the token owner. The action is one of pause, unpause, freeze and unfreeze, the addresses are only required
+by freeze and unfreeze. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal token-control <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "freeze the address of xxb",
+ "description": "freeze the address stealing xxb",
+ "symbol": "xxb",
+ "action": "freeze",
+ "addresses": ["ex1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02"],
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cdc := cdcP.GetCdc()
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := tokenutils.ParseTokenControlProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewTokenControlProposal(proposal.Title, proposal.Description, proposal.Symbol,
+				proposal.Action, proposal.Addresses)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
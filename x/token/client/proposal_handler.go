@@ -0,0 +1,12 @@
+package client
+
+import (
+	"github.com/okex/exchain/x/gov/client"
+	"github.com/okex/exchain/x/token/client/cli"
+	"github.com/okex/exchain/x/token/client/rest"
+)
+
+var (
+	// TokenControlProposalHandler alias gov NewProposalHandler
+	TokenControlProposalHandler = client.NewProposalHandler(cli.GetCmdTokenControlProposal, rest.TokenControlProposalRESTHandler)
+)
@@ -0,0 +1,11 @@
+package rest
+
+import (
+	"github.com/okex/exchain/libs/cosmos-sdk/client/context"
+	govRest "github.com/okex/exchain/x/gov/client/rest"
+)
+
+// TokenControlProposalRESTHandler defines token control proposal handler
+func TokenControlProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
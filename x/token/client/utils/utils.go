@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// TokenControlProposalJSON defines a TokenControlProposal with a deposit used to parse token control proposals
+// from a JSON file.
+type TokenControlProposalJSON struct {
+	Title       string           `json:"title" yaml:"title"`
+	Description string           `json:"description" yaml:"description"`
+	Symbol      string           `json:"symbol" yaml:"symbol"`
+	Action      string           `json:"action" yaml:"action"`
+	Addresses   []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+	Deposit     sdk.SysCoins     `json:"deposit" yaml:"deposit"`
+}
+
+// ParseTokenControlProposalJSON parses json from proposal file to TokenControlProposalJSON struct
+func ParseTokenControlProposalJSON(cdc *codec.Codec, proposalFilePath string) (proposal TokenControlProposalJSON,
+	err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	err = cdc.UnmarshalJSON(contents, &proposal)
+	return
+}
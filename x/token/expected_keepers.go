@@ -3,6 +3,7 @@ package token
 import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	supplyexported "github.com/okex/exchain/libs/cosmos-sdk/x/supply/exported"
+	govtypes "github.com/okex/exchain/x/gov/types"
 )
 
 // SupplyKeeper defines the expected supply Keeper (noalias)
@@ -24,3 +25,9 @@ type SupplyKeeper interface {
 type StakingKeeper interface {
 	IsValidator(ctx sdk.Context, addr sdk.AccAddress) bool
 }
+
+// GovKeeper defines the expected gov Keeper (noalias)
+type GovKeeper interface {
+	GetDepositParams(ctx sdk.Context) govtypes.DepositParams
+	GetVotingParams(ctx sdk.Context) govtypes.VotingParams
+}
@@ -14,10 +14,12 @@ const DefaultTokenOwner = "ex10q0rk5qnyag7wfvvt7rtphlw589m7frs3hvqmf"
 
 // all state that must be provided in genesis file
 type GenesisState struct {
-	Params       types.Params     `json:"params"`
-	Tokens       []types.Token    `json:"tokens"`
-	LockedAssets []types.AccCoins `json:"locked_assets"`
-	LockedFees   []types.AccCoins `json:"locked_fees"`
+	Params       types.Params          `json:"params"`
+	Tokens       []types.Token         `json:"tokens"`
+	LockedAssets []types.AccCoins      `json:"locked_assets"`
+	LockedFees   []types.AccCoins      `json:"locked_fees"`
+	Controls     []types.TokenControl  `json:"controls,omitempty"`
+	Metadata     []types.TokenMetadata `json:"metadata,omitempty"`
 }
 
 // default GenesisState used by Cosmos Hub
@@ -64,6 +66,26 @@ func validateGenesis(data GenesisState) error {
 			return errors.New(err.Error())
 		}
 	}
+
+	symbols := make(map[string]bool, len(data.Tokens))
+	for _, token := range data.Tokens {
+		symbols[token.Symbol] = true
+	}
+
+	for _, control := range data.Controls {
+		if !symbols[control.Symbol] {
+			return fmt.Errorf("token %s of the control does not exist", control.Symbol)
+		}
+	}
+
+	for _, metadata := range data.Metadata {
+		if !symbols[metadata.Symbol] {
+			return fmt.Errorf("token %s of the metadata does not exist", metadata.Symbol)
+		}
+		if err := metadata.ValidateBasic(); err != nil {
+			return errors.New(err.Error())
+		}
+	}
 	return nil
 }
 
@@ -93,6 +115,17 @@ func initGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 			panic(err)
 		}
 	}
+
+	for _, control := range data.Controls {
+		keeper.SetTokenPaused(ctx, control.Symbol, control.Paused)
+		for _, addr := range control.FrozenAddresses {
+			keeper.SetAddressFrozen(ctx, control.Symbol, addr, true)
+		}
+	}
+
+	for _, metadata := range data.Metadata {
+		keeper.SetTokenMetadata(ctx, metadata)
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -118,5 +151,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 		Tokens:       tokens,
 		LockedAssets: lockedAsset,
 		LockedFees:   lockedFees,
+		Controls:     keeper.GetTokenControls(ctx),
+		Metadata:     keeper.GetTokensMetadata(ctx),
 	}
 }
@@ -67,6 +67,21 @@ func NewTokenHandler(keeper Keeper, protocolVersion version.ProtocolVersionType)
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgTokenModify(ctx, keeper, msg, logger)
 			}
+		case types.MsgTokenPause:
+			name = "handleMsgTokenPause"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgTokenPause(ctx, keeper, msg, logger)
+			}
+		case types.MsgTokenFreeze:
+			name = "handleMsgTokenFreeze"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgTokenFreeze(ctx, keeper, msg, logger)
+			}
+		case types.MsgUpdateTokenMetadata:
+			name = "handleMsgUpdateTokenMetadata"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgUpdateTokenMetadata(ctx, keeper, msg, logger)
+			}
 		case WalletTokenTransfer:
 			name = "handleWalletMsgSend"
 			handlerFun = func() (*sdk.Result, error) {
@@ -464,3 +479,40 @@ func handleMsgTokenModify(ctx sdk.Context, keeper Keeper, msg types.MsgTokenModi
 	)
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgUpdateTokenMetadata(ctx sdk.Context, keeper Keeper, msg types.MsgUpdateTokenMetadata, logger log.Logger) (*sdk.Result, error) {
+	token := keeper.GetTokenInfo(ctx, msg.Symbol)
+	// check owner
+	if !token.Owner.Equals(msg.Owner) {
+		return types.ErrInputOwnerIsNotEqualTokenOwner(msg.Owner).Result()
+	}
+
+	// update the description of the token and its metadata
+	token.Description = msg.Description
+	keeper.UpdateToken(ctx, token)
+	keeper.SetTokenMetadata(ctx, types.NewTokenMetadata(msg.Symbol, msg.URI, msg.DisplayDenom))
+
+	// deduction fee
+	feeDecCoins := keeper.GetParams(ctx).FeeModify.ToCoins()
+	err := keeper.supplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Owner, keeper.feeCollectorName, feeDecCoins)
+	if err != nil {
+		return types.ErrSendCoinsFromAccountToModuleFailed(feeDecCoins.String()).Result()
+	}
+
+	name := "handleMsgUpdateTokenMetadata"
+	if logger != nil {
+		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+			"                           msg<Owner:%s,Symbol:%s,URI:%s,DisplayDenom:%s,Description:%s>\n",
+			ctx.BlockHeight(), name,
+			msg.Owner, msg.Symbol, msg.URI, msg.DisplayDenom, msg.Description))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyFee, keeper.GetParams(ctx).FeeModify.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
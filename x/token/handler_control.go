@@ -0,0 +1,88 @@
+package token
+
+import (
+	"fmt"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/x/token/types"
+)
+
+func handleMsgTokenPause(ctx sdk.Context, keeper Keeper, msg types.MsgTokenPause, logger log.Logger) (*sdk.Result, error) {
+	token := keeper.GetTokenInfo(ctx, msg.Symbol)
+	// check owner
+	if !token.Owner.Equals(msg.Owner) {
+		return types.ErrInputOwnerIsNotEqualTokenOwner(msg.Owner).Result()
+	}
+
+	setTokenPaused(ctx, keeper, msg.Symbol, msg.Paused, msg.Owner.String())
+
+	name := "handleMsgTokenPause"
+	if logger != nil {
+		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+			"                           msg<Owner:%s,Symbol:%s,Paused:%t>\n",
+			ctx.BlockHeight(), name,
+			msg.Owner, msg.Symbol, msg.Paused))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName)),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgTokenFreeze(ctx sdk.Context, keeper Keeper, msg types.MsgTokenFreeze, logger log.Logger) (*sdk.Result, error) {
+	token := keeper.GetTokenInfo(ctx, msg.Symbol)
+	// check owner
+	if !token.Owner.Equals(msg.Owner) {
+		return types.ErrInputOwnerIsNotEqualTokenOwner(msg.Owner).Result()
+	}
+
+	setAddressesFrozen(ctx, keeper, msg.Symbol, msg.Addresses, msg.Frozen, msg.Owner.String())
+
+	name := "handleMsgTokenFreeze"
+	if logger != nil {
+		logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+			"                           msg<Owner:%s,Symbol:%s,Addresses:%v,Frozen:%t>\n",
+			ctx.BlockHeight(), name,
+			msg.Owner, msg.Symbol, msg.Addresses, msg.Frozen))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName)),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// setTokenPaused sets the pause status of a token and emits the event with the operator,
+// which is the token owner or the governance
+func setTokenPaused(ctx sdk.Context, keeper Keeper, symbol string, paused bool, operator string) {
+	keeper.SetTokenPaused(ctx, symbol, paused)
+
+	eventType := types.EventTypePauseToken
+	if !paused {
+		eventType = types.EventTypeUnpauseToken
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+		sdk.NewAttribute(types.AttributeKeyOperator, operator),
+	))
+}
+
+// setAddressesFrozen freezes or unfreezes the addresses of a token and emits an event for every address
+func setAddressesFrozen(ctx sdk.Context, keeper Keeper, symbol string, addrs []sdk.AccAddress, frozen bool, operator string) {
+	eventType := types.EventTypeFreezeAddress
+	if !frozen {
+		eventType = types.EventTypeUnfreezeAddress
+	}
+	for _, addr := range addrs {
+		keeper.SetAddressFrozen(ctx, symbol, addr, frozen)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeySymbol, symbol),
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyOperator, operator),
+		))
+	}
+}
@@ -31,6 +31,8 @@ type Keeper struct {
 
 	cdc *codec.Codec // The wire codec for binary encoding/decoding.
 
+	govKeeper GovKeeper
+
 	enableBackend bool // whether open backend plugin
 
 	// cache data in memory to avoid marshal/unmarshal too frequently
@@ -58,6 +60,11 @@ func NewKeeper(bankKeeper bank.Keeper, paramSpace params.Subspace,
 	return k
 }
 
+// SetGovKeeper sets keeper of gov
+func (k *Keeper) SetGovKeeper(gk GovKeeper) {
+	k.govKeeper = gk
+}
+
 // nolint
 func (k Keeper) ResetCache(ctx sdk.Context) {
 	k.cache.reset()
@@ -192,6 +199,9 @@ func (k Keeper) SendCoinsFromAccountToAccount(ctx sdk.Context, from, to sdk.AccA
 
 // nolint
 func (k Keeper) LockCoins(ctx sdk.Context, addr sdk.AccAddress, coins sdk.SysCoins, lockCoinsType int) error {
+	if err := k.CheckTransferAllowed(ctx, addr, nil, coins); err != nil {
+		return err
+	}
 	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, coins); err != nil {
 		return types.ErrSendCoinsFromAccountToModuleFailed(err.Error())
 	}
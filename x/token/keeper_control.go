@@ -0,0 +1,110 @@
+package token
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/token/types"
+)
+
+// IsTokenPaused checks whether all the transfers of a token are paused
+func (k Keeper) IsTokenPaused(ctx sdk.Context, symbol string) bool {
+	return ctx.KVStore(k.tokenStoreKey).Has(types.GetPausedTokenKey(symbol))
+}
+
+// SetTokenPaused sets the pause status of a token
+func (k Keeper) SetTokenPaused(ctx sdk.Context, symbol string, paused bool) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	if paused {
+		store.Set(types.GetPausedTokenKey(symbol), []byte{})
+		return
+	}
+	store.Delete(types.GetPausedTokenKey(symbol))
+}
+
+// IsAddressFrozen checks whether an address is frozen for a token
+func (k Keeper) IsAddressFrozen(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.tokenStoreKey).Has(types.GetFrozenAddressKey(symbol, addr))
+}
+
+// SetAddressFrozen freezes or unfreezes an address for a token
+func (k Keeper) SetAddressFrozen(ctx sdk.Context, symbol string, addr sdk.AccAddress, frozen bool) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	if frozen {
+		store.Set(types.GetFrozenAddressKey(symbol, addr), []byte{})
+		return
+	}
+	store.Delete(types.GetFrozenAddressKey(symbol, addr))
+}
+
+// GetFrozenAddresses gets all the frozen addresses of a token
+func (k Keeper) GetFrozenAddresses(ctx sdk.Context, symbol string) (addrs []sdk.AccAddress) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetFrozenAddressPrefix(symbol))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, addr := types.SplitFrozenAddressKey(iter.Key())
+		addrs = append(addrs, addr)
+	}
+	return addrs
+}
+
+// GetTokenControl gets the pause status and the frozen addresses of a token
+func (k Keeper) GetTokenControl(ctx sdk.Context, symbol string) types.TokenControl {
+	return types.NewTokenControl(symbol, k.IsTokenPaused(ctx, symbol), k.GetFrozenAddresses(ctx, symbol))
+}
+
+// GetTokenControls gets the controls of all the tokens which are paused or have frozen addresses
+func (k Keeper) GetTokenControls(ctx sdk.Context) (controls []types.TokenControl) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	indexes := make(map[string]int)
+	getControl := func(symbol string) *types.TokenControl {
+		if _, ok := indexes[symbol]; !ok {
+			indexes[symbol] = len(controls)
+			controls = append(controls, types.NewTokenControl(symbol, false, nil))
+		}
+		return &controls[indexes[symbol]]
+	}
+
+	pausedIter := sdk.KVStorePrefixIterator(store, types.PrefixPausedTokenKey)
+	defer pausedIter.Close()
+	for ; pausedIter.Valid(); pausedIter.Next() {
+		getControl(string(pausedIter.Key()[len(types.PrefixPausedTokenKey):])).Paused = true
+	}
+
+	frozenIter := sdk.KVStorePrefixIterator(store, types.PrefixFrozenAddressKey)
+	defer frozenIter.Close()
+	for ; frozenIter.Valid(); frozenIter.Next() {
+		symbol, addr := types.SplitFrozenAddressKey(frozenIter.Key())
+		control := getControl(symbol)
+		control.FrozenAddresses = append(control.FrozenAddresses, addr)
+	}
+	return controls
+}
+
+// CheckTransferAllowed checks none of the coins is paused and neither the sender nor the recipient is frozen
+func (k Keeper) CheckTransferAllowed(ctx sdk.Context, from, to sdk.AccAddress, coins sdk.SysCoins) error {
+	for _, coin := range coins {
+		if k.IsTokenPaused(ctx, coin.Denom) {
+			return types.ErrTokenPaused(coin.Denom)
+		}
+		if k.IsAddressFrozen(ctx, coin.Denom, from) {
+			return types.ErrAddressFrozen(coin.Denom, from)
+		}
+		if to != nil && k.IsAddressFrozen(ctx, coin.Denom, to) {
+			return types.ErrAddressFrozen(coin.Denom, to)
+		}
+	}
+	return nil
+}
+
+// SendRestrictionFn is the send restriction of the bank rejecting the transfers of the paused tokens and the ones
+// from or to the frozen addresses since venus5
+func (k Keeper) SendRestrictionFn(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+		return to, nil
+	}
+	if err := k.CheckTransferAllowed(ctx, from, to, amt); err != nil {
+		return nil, err
+	}
+	return to, nil
+}
@@ -0,0 +1,80 @@
+package token
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/bank"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/mock"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/x/token/types"
+)
+
+func TestKeeper_TokenControl(t *testing.T) {
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+
+	mapp, keeper, _ := getMockDexApp(t, 0)
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	ctx.SetBlockHeight(2)
+	bankHandler := bank.NewHandler(mapp.bankKeeper)
+
+	genAccs, testAccounts := CreateGenAccounts(3, sdk.SysCoins{sdk.NewDecCoinFromDec("xxb", sdk.NewDec(1000))})
+	mock.SetGenesis(mapp.App, types.DecAccountArrToBaseAccountArr(genAccs))
+	addrs := []sdk.AccAddress{
+		testAccounts[0].baseAccount.Address,
+		testAccounts[1].baseAccount.Address,
+		testAccounts[2].baseAccount.Address,
+	}
+	keeper.NewToken(ctx, InitTestTokenWithOwner("xxb", addrs[0]))
+	coins := sdk.SysCoins{sdk.NewDecCoinFromDec("xxb", sdk.NewDec(10))}
+
+	require.Nil(t, keeper.SendCoinsFromAccountToAccount(ctx, addrs[1], addrs[2], coins))
+
+	// only the owner can pause the token
+	_, err := handleMsgTokenPause(ctx, keeper, types.NewMsgTokenPause(addrs[1], "xxb", true), nil)
+	require.NotNil(t, err)
+	require.False(t, keeper.IsTokenPaused(ctx, "xxb"))
+
+	_, err = handleMsgTokenPause(ctx, keeper, types.NewMsgTokenPause(addrs[0], "xxb", true), nil)
+	require.Nil(t, err)
+	require.True(t, keeper.IsTokenPaused(ctx, "xxb"))
+	require.NotNil(t, keeper.SendCoinsFromAccountToAccount(ctx, addrs[1], addrs[2], coins))
+	require.NotNil(t, keeper.LockCoins(ctx, addrs[1], coins, types.LockCoinsTypeQuantity))
+
+	_, err = handleMsgTokenPause(ctx, keeper, types.NewMsgTokenPause(addrs[0], "xxb", false), nil)
+	require.Nil(t, err)
+	require.Nil(t, keeper.SendCoinsFromAccountToAccount(ctx, addrs[1], addrs[2], coins))
+
+	// a frozen address can neither send nor receive the token
+	_, err = handleMsgTokenFreeze(ctx, keeper, types.NewMsgTokenFreeze(addrs[0], "xxb", addrs[2:], true), nil)
+	require.Nil(t, err)
+	require.True(t, keeper.IsAddressFrozen(ctx, "xxb", addrs[2]))
+	require.NotNil(t, keeper.SendCoinsFromAccountToAccount(ctx, addrs[1], addrs[2], coins))
+	require.NotNil(t, keeper.SendCoinsFromAccountToAccount(ctx, addrs[2], addrs[1], coins))
+	_, err = bankHandler(ctx, bank.NewMsgSend(addrs[2], addrs[1], coins))
+	require.NotNil(t, err)
+	_, err = bankHandler(ctx, bank.NewMsgMultiSend([]bank.Input{bank.NewInput(addrs[1], coins)},
+		[]bank.Output{bank.NewOutput(addrs[2], coins)}))
+	require.NotNil(t, err)
+	require.Equal(t, []types.TokenControl{types.NewTokenControl("xxb", false, addrs[2:])}, keeper.GetTokenControls(ctx))
+
+	// the governance overrides the controls of the owner
+	proposal := types.NewTokenControlProposal("title", "desc", "xxb", types.TokenControlActionUnfreeze, addrs[2:])
+	require.Nil(t, handleTokenControlProposal(ctx, &keeper, proposal))
+	require.False(t, keeper.IsAddressFrozen(ctx, "xxb", addrs[2]))
+	require.Nil(t, keeper.SendCoinsFromAccountToAccount(ctx, addrs[2], addrs[1], coins))
+	_, err = bankHandler(ctx, bank.NewMsgSend(addrs[2], addrs[1], coins))
+	require.Nil(t, err)
+
+	proposal = types.NewTokenControlProposal("title", "desc", "xxb", types.TokenControlActionPause, nil)
+	require.Nil(t, handleTokenControlProposal(ctx, &keeper, proposal))
+	require.Equal(t, types.NewTokenControl("xxb", true, nil), keeper.GetTokenControl(ctx, "xxb"))
+
+	proposal = types.NewTokenControlProposal("title", "desc", "yyb", types.TokenControlActionPause, nil)
+	require.NotNil(t, handleTokenControlProposal(ctx, &keeper, proposal))
+}
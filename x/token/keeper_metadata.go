@@ -0,0 +1,38 @@
+package token
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/token/types"
+)
+
+// GetTokenMetadata gets the metadata of a token, it's empty if the owner never sets it
+func (k Keeper) GetTokenMetadata(ctx sdk.Context, symbol string) (metadata types.TokenMetadata) {
+	bz := ctx.KVStore(k.tokenStoreKey).Get(types.GetTokenMetadataKey(symbol))
+	if bz == nil {
+		return types.NewTokenMetadata(symbol, "", "")
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
+	return metadata
+}
+
+// SetTokenMetadata sets the metadata of a token into store, the empty metadata is removed
+func (k Keeper) SetTokenMetadata(ctx sdk.Context, metadata types.TokenMetadata) {
+	store := ctx.KVStore(k.tokenStoreKey)
+	if metadata.IsEmpty() {
+		store.Delete(types.GetTokenMetadataKey(metadata.Symbol))
+		return
+	}
+	store.Set(types.GetTokenMetadataKey(metadata.Symbol), k.cdc.MustMarshalBinaryBare(metadata))
+}
+
+// GetTokensMetadata gets the metadata of all the tokens which have set it
+func (k Keeper) GetTokensMetadata(ctx sdk.Context) (metadata []types.TokenMetadata) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.tokenStoreKey), types.PrefixTokenMetadataKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var tm types.TokenMetadata
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &tm)
+		metadata = append(metadata, tm)
+	}
+	return metadata
+}
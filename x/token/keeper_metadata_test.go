@@ -0,0 +1,52 @@
+package token
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/mock"
+	abci "github.com/okex/exchain/libs/tendermint/abci/types"
+	"github.com/okex/exchain/x/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/x/token/types"
+)
+
+func TestKeeper_TokenMetadata(t *testing.T) {
+	mapp, keeper, _ := getMockDexApp(t, 0)
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	genAccs, testAccounts := CreateGenAccounts(2, sdk.SysCoins{sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(100))})
+	mock.SetGenesis(mapp.App, types.DecAccountArrToBaseAccountArr(genAccs))
+	owner := testAccounts[0].baseAccount.Address
+	keeper.NewToken(ctx, InitTestTokenWithOwner("xxb", owner))
+	keeper.SetParams(ctx, types.DefaultParams())
+	require.True(t, keeper.GetTokenMetadata(ctx, "xxb").IsEmpty())
+
+	// only the owner can update the metadata
+	msg := types.NewMsgUpdateTokenMetadata(testAccounts[1].baseAccount.Address, "xxb", "https://example.com/xxb.json", "XXB", "xxb token")
+	_, err := handleMsgUpdateTokenMetadata(ctx, keeper, msg, nil)
+	require.NotNil(t, err)
+
+	msg.Owner = owner
+	_, err = handleMsgUpdateTokenMetadata(ctx, keeper, msg, nil)
+	require.Nil(t, err)
+	require.Equal(t, types.NewTokenMetadata("xxb", "https://example.com/xxb.json", "XXB"), keeper.GetTokenMetadata(ctx, "xxb"))
+	require.Equal(t, []types.TokenMetadata{keeper.GetTokenMetadata(ctx, "xxb")}, keeper.GetTokensMetadata(ctx))
+
+	bz, err := queryMetadata(ctx, []string{"xxb"}, keeper)
+	require.Nil(t, err)
+	var resp types.TokenMetadataResp
+	keeper.cdc.MustUnmarshalJSON(bz, &resp)
+	require.Equal(t, types.TokenMetadataResp{Symbol: "xxb", Description: "xxb token", URI: msg.URI, DisplayDenom: "XXB"}, resp)
+	_, err = queryMetadata(ctx, []string{"yyb"}, keeper)
+	require.NotNil(t, err)
+
+	// the empty metadata is removed
+	_, err = handleMsgUpdateTokenMetadata(ctx, keeper, types.NewMsgUpdateTokenMetadata(owner, "xxb", "", "", ""), nil)
+	require.Nil(t, err)
+	require.True(t, keeper.GetTokenMetadata(ctx, "xxb").IsEmpty())
+	require.Empty(t, keeper.GetTokensMetadata(ctx))
+	require.Equal(t, "", keeper.GetTokenInfo(ctx, "xxb").Description)
+}
@@ -0,0 +1,67 @@
+package token
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkGov "github.com/okex/exchain/x/gov"
+	govKeeper "github.com/okex/exchain/x/gov/keeper"
+	govTypes "github.com/okex/exchain/x/gov/types"
+	"github.com/okex/exchain/x/token/types"
+)
+
+var _ govKeeper.ProposalHandler = (*Keeper)(nil)
+
+// GetMinDeposit returns min deposit
+func (k Keeper) GetMinDeposit(ctx sdk.Context, content sdkGov.Content) (minDeposit sdk.SysCoins) {
+	if _, ok := content.(types.TokenControlProposal); ok {
+		minDeposit = k.govKeeper.GetDepositParams(ctx).MinDeposit
+	}
+
+	return
+}
+
+// GetMaxDepositPeriod returns max deposit period
+func (k Keeper) GetMaxDepositPeriod(ctx sdk.Context, content sdkGov.Content) (maxDepositPeriod time.Duration) {
+	if _, ok := content.(types.TokenControlProposal); ok {
+		maxDepositPeriod = k.govKeeper.GetDepositParams(ctx).MaxDepositPeriod
+	}
+
+	return
+}
+
+// GetVotingPeriod returns voting period
+func (k Keeper) GetVotingPeriod(ctx sdk.Context, content sdkGov.Content) (votingPeriod time.Duration) {
+	if _, ok := content.(types.TokenControlProposal); ok {
+		votingPeriod = k.govKeeper.GetVotingParams(ctx).VotingPeriod
+	}
+
+	return
+}
+
+// CheckMsgSubmitProposal validates MsgSubmitProposal
+func (k Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg govTypes.MsgSubmitProposal) sdk.Error {
+	switch content := msg.Content.(type) {
+	case types.TokenControlProposal:
+		return k.CheckTokenControlProposal(ctx, content)
+	default:
+		return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized token proposal content type: %T", content))
+	}
+}
+
+// nolint
+func (k Keeper) AfterSubmitProposalHandler(_ sdk.Context, _ govTypes.Proposal) {}
+func (k Keeper) AfterDepositPeriodPassed(_ sdk.Context, _ govTypes.Proposal)   {}
+func (k Keeper) RejectedHandler(_ sdk.Context, _ govTypes.Content)             {}
+func (k Keeper) VoteHandler(_ sdk.Context, _ govTypes.Proposal, _ govTypes.Vote) (string, sdk.Error) {
+	return "", nil
+}
+
+// CheckTokenControlProposal checks the token of a token control proposal exists
+func (k Keeper) CheckTokenControlProposal(ctx sdk.Context, proposal types.TokenControlProposal) sdk.Error {
+	if !k.TokenExist(ctx, proposal.Symbol) {
+		return types.ErrTokenNotExist(proposal.Symbol)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package token
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/common"
+	govTypes "github.com/okex/exchain/x/gov/types"
+	"github.com/okex/exchain/x/token/types"
+)
+
+// NewTokenControlProposalHandler handles "gov" type message in "token"
+func NewTokenControlProposalHandler(k *Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, proposal *govTypes.Proposal) (err sdk.Error) {
+		switch content := proposal.Content.(type) {
+		case types.TokenControlProposal:
+			return handleTokenControlProposal(ctx, k, content)
+		default:
+			return common.ErrUnknownProposalType(DefaultCodespace, content.ProposalType())
+		}
+	}
+}
+
+func handleTokenControlProposal(ctx sdk.Context, k *Keeper, p types.TokenControlProposal) sdk.Error {
+	if sdkErr := k.CheckTokenControlProposal(ctx, p); sdkErr != nil {
+		return sdkErr
+	}
+
+	switch p.Action {
+	case types.TokenControlActionPause:
+		setTokenPaused(ctx, *k, p.Symbol, true, types.AttributeValueGovernance)
+	case types.TokenControlActionUnpause:
+		setTokenPaused(ctx, *k, p.Symbol, false, types.AttributeValueGovernance)
+	case types.TokenControlActionFreeze:
+		setAddressesFrozen(ctx, *k, p.Symbol, p.Addresses, true, types.AttributeValueGovernance)
+	case types.TokenControlActionUnfreeze:
+		setAddressesFrozen(ctx, *k, p.Symbol, p.Addresses, false, types.AttributeValueGovernance)
+	default:
+		return types.ErrInvalidTokenControlAction(p.Action)
+	}
+	return nil
+}
@@ -32,6 +32,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryTokensV2(ctx, path[1:], req, keeper)
 		case types.QueryTokenV2:
 			return queryTokenV2(ctx, path[1:], req, keeper)
+		case types.QueryControl:
+			return queryControl(ctx, path[1:], keeper)
+		case types.QueryMetadata:
+			return queryMetadata(ctx, path[1:], keeper)
 		case types.UploadAccount:
 			return uploadAccount(ctx, keeper)
 		default:
@@ -59,6 +63,39 @@ func queryInfo(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 	return bz, nil
 }
 
+func queryControl(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 || !keeper.TokenExist(ctx, path[0]) {
+		symbol := ""
+		if len(path) > 0 {
+			symbol = path[0]
+		}
+		return nil, types.ErrTokenNotExist(symbol)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetTokenControl(ctx, path[0]))
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
+
+func queryMetadata(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, types.ErrTokenNotExist("")
+	}
+	token := keeper.GetTokenInfo(ctx, path[0])
+	if token.Symbol == "" {
+		return nil, types.ErrTokenNotExist(path[0])
+	}
+
+	metadataResp := types.GenTokenMetadataResp(token, keeper.GetTokenMetadata(ctx, path[0]))
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, metadataResp)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+}
+
 func queryTokens(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var tokens []types.Token
 	if len(path) > 0 && path[0] != "" {
@@ -90,6 +90,7 @@ func getMockDexApp(t *testing.T, numGenAccs int) (mockDexApp *MockDexApp, keeper
 		mockDexApp.keyLock,
 		mockDexApp.Cdc.GetCdc(),
 		true, mapp.AccountKeeper)
+	mockDexApp.bankKeeper.AppendSendRestriction(mockDexApp.tokenKeeper.SendRestrictionFn)
 
 	handler := NewTokenHandler(mockDexApp.tokenKeeper, version.CurrentProtocolVersion)
 
@@ -14,6 +14,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgTransferOwnership{}, "okexchain/token/MsgTransferOwnership", nil)
 	cdc.RegisterConcrete(MsgConfirmOwnership{}, "okexchain/token/MsgConfirmOwnership", nil)
 	cdc.RegisterConcrete(MsgTokenModify{}, "okexchain/token/MsgModify", nil)
+	cdc.RegisterConcrete(MsgTokenPause{}, "okexchain/token/MsgPause", nil)
+	cdc.RegisterConcrete(MsgTokenFreeze{}, "okexchain/token/MsgFreeze", nil)
+	cdc.RegisterConcrete(MsgUpdateTokenMetadata{}, "okexchain/token/MsgUpdateMetadata", nil)
+	cdc.RegisterConcrete(TokenControlProposal{}, "okexchain/token/TokenControlProposal", nil)
 
 	// for test
 	//cdc.RegisterConcrete(MsgTokenDestroy{}, "okexchain/token/MsgDestroy", nil)
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/common"
+)
+
+const (
+	// FreezeAddressesLimit is the max number of addresses frozen or unfrozen in one message
+	FreezeAddressesLimit = 100
+)
+
+// TokenControl is the pause status and the frozen addresses of a token
+type TokenControl struct {
+	Symbol          string           `json:"symbol"`
+	Paused          bool             `json:"paused"`
+	FrozenAddresses []sdk.AccAddress `json:"frozen_addresses"`
+}
+
+// NewTokenControl creates a new instance of TokenControl
+func NewTokenControl(symbol string, paused bool, frozenAddresses []sdk.AccAddress) TokenControl {
+	return TokenControl{
+		Symbol:          symbol,
+		Paused:          paused,
+		FrozenAddresses: frozenAddresses,
+	}
+}
+
+// String returns a human readable string representation of TokenControl
+func (tc TokenControl) String() string {
+	addrs := make([]string, 0, len(tc.FrozenAddresses))
+	for _, addr := range tc.FrozenAddresses {
+		addrs = append(addrs, addr.String())
+	}
+	return fmt.Sprintf(`Token Control:
+  Symbol:            %s
+  Paused:            %t
+  Frozen Addresses:  %s`,
+		tc.Symbol, tc.Paused, strings.Join(addrs, ", "))
+}
+
+// validateControlledSymbol checks the symbol of a token to be paused or frozen
+func validateControlledSymbol(symbol string) sdk.Error {
+	if len(symbol) == 0 {
+		return ErrMsgSymbolIsEmpty()
+	}
+	if sdk.ValidateDenom(symbol) != nil {
+		return ErrNotAllowedOriginalSymbol(symbol)
+	}
+	if symbol == common.NativeToken {
+		return ErrNativeTokenNotControllable()
+	}
+	return nil
+}
+
+// validateFreezeAddresses checks the addresses to be frozen or unfrozen are not empty and not duplicated
+func validateFreezeAddresses(addresses []sdk.AccAddress) sdk.Error {
+	if len(addresses) == 0 {
+		return ErrInvalidFreezeAddresses("no address is provided")
+	}
+	if len(addresses) > FreezeAddressesLimit {
+		return ErrInvalidFreezeAddresses(fmt.Sprintf("more than %d addresses", FreezeAddressesLimit))
+	}
+	seen := make(map[string]bool, len(addresses))
+	for _, addr := range addresses {
+		if addr.Empty() {
+			return ErrInvalidFreezeAddresses("empty address")
+		}
+		if seen[addr.String()] {
+			return ErrInvalidFreezeAddresses(fmt.Sprintf("duplicated address %s", addr))
+		}
+		seen[addr.String()] = true
+	}
+	return nil
+}
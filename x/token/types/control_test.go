@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTokenPauseAndFreeze(t *testing.T) {
+	owner := sdk.AccAddress([]byte("controlTestOwner"))
+	addr := sdk.AccAddress([]byte("controlTestAddr1"))
+
+	require.Nil(t, NewMsgTokenPause(owner, "xxb", true).ValidateBasic())
+	require.NotNil(t, NewMsgTokenPause(nil, "xxb", true).ValidateBasic())
+	require.NotNil(t, NewMsgTokenPause(owner, "", true).ValidateBasic())
+	require.NotNil(t, NewMsgTokenPause(owner, common.NativeToken, true).ValidateBasic())
+
+	require.Nil(t, NewMsgTokenFreeze(owner, "xxb", []sdk.AccAddress{addr}, true).ValidateBasic())
+	require.NotNil(t, NewMsgTokenFreeze(owner, "xxb", nil, true).ValidateBasic())
+	require.NotNil(t, NewMsgTokenFreeze(owner, "xxb", []sdk.AccAddress{addr, addr}, true).ValidateBasic())
+	require.NotNil(t, NewMsgTokenFreeze(owner, "xxb", []sdk.AccAddress{{}}, true).ValidateBasic())
+	require.NotNil(t, NewMsgTokenFreeze(owner, "xxb", make([]sdk.AccAddress, FreezeAddressesLimit+1), true).ValidateBasic())
+}
+
+func TestTokenControlProposal(t *testing.T) {
+	addr := sdk.AccAddress([]byte("controlTestAddr1"))
+	tests := []struct {
+		proposal TokenControlProposal
+		valid    bool
+	}{
+		{NewTokenControlProposal("title", "desc", "xxb", TokenControlActionPause, nil), true},
+		{NewTokenControlProposal("title", "desc", "xxb", TokenControlActionUnfreeze, []sdk.AccAddress{addr}), true},
+		{NewTokenControlProposal("title", "desc", "xxb", TokenControlActionPause, []sdk.AccAddress{addr}), false},
+		{NewTokenControlProposal("title", "desc", "xxb", TokenControlActionFreeze, nil), false},
+		{NewTokenControlProposal("title", "desc", "xxb", "burn", nil), false},
+		{NewTokenControlProposal("title", "desc", common.NativeToken, TokenControlActionPause, nil), false},
+		{NewTokenControlProposal("", "desc", "xxb", TokenControlActionPause, nil), false},
+		{NewTokenControlProposal("title", "", "xxb", TokenControlActionPause, nil), false},
+	}
+	for i, tc := range tests {
+		err := tc.proposal.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, i)
+		} else {
+			require.NotNil(t, err, i)
+		}
+	}
+	require.Equal(t, RouterKey, tests[0].proposal.ProposalRoute())
+	require.Equal(t, proposalTypeTokenControl, tests[0].proposal.ProposalType())
+}
+
+func TestFrozenAddressKey(t *testing.T) {
+	addr := sdk.AccAddress([]byte("controlTestAddr1"))
+	symbol, splitAddr := SplitFrozenAddressKey(GetFrozenAddressKey("xxb", addr))
+	require.Equal(t, "xxb", symbol)
+	require.Equal(t, addr, splitAddr)
+}
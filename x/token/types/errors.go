@@ -43,6 +43,13 @@ const (
 	CodeTotalsupplyExceedsTheUpperLimit            uint32 = 61032
 	CodeBlockedContractRecipient                   uint32 = 61033
 	CodeSendCoinsFromAccountToAccountFailed        uint32 = 61034
+	CodeTokenPaused                                uint32 = 61035
+	CodeAddressFrozen                              uint32 = 61036
+	CodeTokenNotExist                              uint32 = 61037
+	CodeNativeTokenNotControllable                 uint32 = 61038
+	CodeInvalidFreezeAddresses                     uint32 = 61039
+	CodeInvalidTokenControlAction                  uint32 = 61040
+	CodeInvalidTokenMetadata                       uint32 = 61041
 )
 
 var (
@@ -81,6 +88,13 @@ var (
 	errCodeConfirmOwnershipAddressNotEqualsMsgAddress = sdkerrors.Register(DefaultCodespace, CodeConfirmOwnershipAddressNotEqualsMsgAddress, "input address is not equal confirm ownership address")
 	errCodeGetDecimalFromDecimalStringFailed          = sdkerrors.Register(DefaultCodespace, CodeGetDecimalFromDecimalStringFailed, "create a decimal from an input decimal string failed")
 	errCodeTotalsupplyExceedsTheUpperLimit            = sdkerrors.Register(DefaultCodespace, CodeTotalsupplyExceedsTheUpperLimit, "total-supply exceeds the upper limit")
+	errCodeTokenPaused                                = sdkerrors.Register(DefaultCodespace, CodeTokenPaused, "token is paused")
+	errCodeAddressFrozen                              = sdkerrors.Register(DefaultCodespace, CodeAddressFrozen, "address is frozen")
+	errCodeTokenNotExist                              = sdkerrors.Register(DefaultCodespace, CodeTokenNotExist, "token does not exist")
+	errCodeNativeTokenNotControllable                 = sdkerrors.Register(DefaultCodespace, CodeNativeTokenNotControllable, "native token can not be paused or frozen")
+	errCodeInvalidFreezeAddresses                     = sdkerrors.Register(DefaultCodespace, CodeInvalidFreezeAddresses, "invalid freeze addresses")
+	errCodeInvalidTokenControlAction                  = sdkerrors.Register(DefaultCodespace, CodeInvalidTokenControlAction, "invalid token control action")
+	errCodeInvalidTokenMetadata                       = sdkerrors.Register(DefaultCodespace, CodeInvalidTokenMetadata, "invalid token metadata")
 )
 
 // ErrBlockedContractRecipient returns an error when a transfer is tried on a blocked contract recipient
@@ -213,3 +227,38 @@ func ErrGetDecimalFromDecimalStringFailed(msg string) sdk.EnvelopedErr {
 func ErrCodeTotalsupplyExceedsTheUpperLimit(totalSupplyAfterMint sdk.Dec, TotalSupplyUpperbound int64) sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeTotalsupplyExceedsTheUpperLimit, fmt.Sprintf("total-supply(%s) exceeds the upper limit(%d)", totalSupplyAfterMint, TotalSupplyUpperbound))}
 }
+
+// ErrTokenPaused returns an error when the token being transferred is paused
+func ErrTokenPaused(symbol string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeTokenPaused, "failed. token %s is paused by its issuer or governance", symbol)}
+}
+
+// ErrAddressFrozen returns an error when a frozen address sends or receives the token
+func ErrAddressFrozen(symbol string, address sdk.AccAddress) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeAddressFrozen, "failed. %s is frozen for token %s", address, symbol)}
+}
+
+// ErrTokenNotExist returns an error when the token does not exist
+func ErrTokenNotExist(symbol string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeTokenNotExist, "failed. token %s does not exist", symbol)}
+}
+
+// ErrNativeTokenNotControllable returns an error when pausing or freezing the native token
+func ErrNativeTokenNotControllable() sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeNativeTokenNotControllable, "failed. native token can not be paused or frozen")}
+}
+
+// ErrInvalidFreezeAddresses returns an error when the addresses to freeze or unfreeze are invalid
+func ErrInvalidFreezeAddresses(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeInvalidFreezeAddresses, "invalid freeze addresses: %s", msg)}
+}
+
+// ErrInvalidTokenControlAction returns an error when the action of a token control proposal is unknown
+func ErrInvalidTokenControlAction(action string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeInvalidTokenControlAction, "invalid token control action: %s", action)}
+}
+
+// ErrInvalidTokenMetadata returns an error when the metadata of a token exceeds the size limits or is malformed
+func ErrInvalidTokenMetadata(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errCodeInvalidTokenMetadata, "invalid token metadata: %s", msg)}
+}
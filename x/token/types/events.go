@@ -0,0 +1,16 @@
+package types
+
+// token module event types
+const (
+	EventTypePauseToken      = "pause_token"
+	EventTypeUnpauseToken    = "unpause_token"
+	EventTypeFreezeAddress   = "freeze_address"
+	EventTypeUnfreezeAddress = "unfreeze_address"
+
+	AttributeKeySymbol   = "symbol"
+	AttributeKeyAddress  = "address"
+	AttributeKeyOperator = "operator"
+
+	// AttributeValueGovernance is the operator of the controls executed by a governance proposal
+	AttributeValueGovernance = "governance"
+)
@@ -30,6 +30,8 @@ const (
 	QueryCurrency   = "currency"
 	QueryAccount    = "accounts"
 	QueryKeysNum    = "store"
+	QueryControl    = "control"
+	QueryMetadata   = "metadata"
 
 	QueryAccountV2 = "accountsV2"
 	QueryTokensV2  = "tokensV2"
@@ -45,6 +47,9 @@ var (
 	PrefixUserTokenKey        = []byte{0x03} // the address prefix of the user-token relationship
 	LockedFeeKey              = []byte{0x04} // the address prefix of the locked order fee coins
 	PrefixConfirmOwnershipKey = []byte{0x05} // the prefix of the confirm ownership key
+	PrefixPausedTokenKey      = []byte{0x06} // the prefix of the paused token key
+	PrefixFrozenAddressKey    = []byte{0x07} // the prefix of the frozen address of a token
+	PrefixTokenMetadataKey    = []byte{0x08} // the prefix of the metadata of a token
 )
 
 func GetUserTokenPrefix(owner sdk.AccAddress) []byte {
@@ -71,3 +76,31 @@ func GetLockFeeAddress(addr sdk.AccAddress) []byte {
 func GetConfirmOwnershipKey(symbol string) []byte {
 	return append(PrefixConfirmOwnershipKey, []byte(symbol)...)
 }
+
+// GetPausedTokenKey gets the key for the pause status of a token
+func GetPausedTokenKey(symbol string) []byte {
+	return append(PrefixPausedTokenKey, []byte(symbol)...)
+}
+
+// GetFrozenAddressPrefix gets the prefix for the frozen addresses of a token
+// the symbol is length prefixed to avoid the conflict of the symbols with the same prefix
+func GetFrozenAddressPrefix(symbol string) []byte {
+	return append(append(PrefixFrozenAddressKey, byte(len(symbol))), []byte(symbol)...)
+}
+
+// GetFrozenAddressKey gets the key for a frozen address of a token
+func GetFrozenAddressKey(symbol string, addr sdk.AccAddress) []byte {
+	return append(GetFrozenAddressPrefix(symbol), addr.Bytes()...)
+}
+
+// SplitFrozenAddressKey splits the symbol and the address from a frozen address key
+func SplitFrozenAddressKey(key []byte) (string, sdk.AccAddress) {
+	symbolLen := int(key[len(PrefixFrozenAddressKey)])
+	symbolStart := len(PrefixFrozenAddressKey) + 1
+	return string(key[symbolStart : symbolStart+symbolLen]), key[symbolStart+symbolLen:]
+}
+
+// GetTokenMetadataKey gets the key for the metadata of a token
+func GetTokenMetadataKey(symbol string) []byte {
+	return append(PrefixTokenMetadataKey, []byte(symbol)...)
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+const (
+	// MetadataURILenLimit is the max length of the metadata uri of a token
+	MetadataURILenLimit = 512
+)
+
+var (
+	reDisplayDenom = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9\-\.]{0,31}$`)
+)
+
+// TokenMetadata is the information used by wallets to render a token, e.g. the uri of its logo and the denom displayed
+type TokenMetadata struct {
+	Symbol       string `json:"symbol"`
+	URI          string `json:"uri"`
+	DisplayDenom string `json:"display_denom"`
+}
+
+// NewTokenMetadata creates a new instance of TokenMetadata
+func NewTokenMetadata(symbol, uri, displayDenom string) TokenMetadata {
+	return TokenMetadata{
+		Symbol:       symbol,
+		URI:          uri,
+		DisplayDenom: displayDenom,
+	}
+}
+
+// IsEmpty checks whether neither the uri nor the display denom is set
+func (tm TokenMetadata) IsEmpty() bool {
+	return len(tm.URI) == 0 && len(tm.DisplayDenom) == 0
+}
+
+// ValidateBasic checks the uri and the display denom are within the size limits and well formed
+func (tm TokenMetadata) ValidateBasic() sdk.Error {
+	return validateMetadata(tm.URI, tm.DisplayDenom, "")
+}
+
+// String returns a human readable string representation of TokenMetadata
+func (tm TokenMetadata) String() string {
+	return fmt.Sprintf(`Token Metadata:
+  Symbol:         %s
+  URI:            %s
+  Display Denom:  %s`,
+		tm.Symbol, tm.URI, tm.DisplayDenom)
+}
+
+// TokenMetadataResp is the metadata of a token with its description
+type TokenMetadataResp struct {
+	Symbol       string `json:"symbol"`
+	Description  string `json:"description"`
+	URI          string `json:"uri"`
+	DisplayDenom string `json:"display_denom"`
+}
+
+// GenTokenMetadataResp creates a TokenMetadataResp from the token and its metadata
+func GenTokenMetadataResp(token Token, metadata TokenMetadata) TokenMetadataResp {
+	return TokenMetadataResp{
+		Symbol:       token.Symbol,
+		Description:  token.Description,
+		URI:          metadata.URI,
+		DisplayDenom: metadata.DisplayDenom,
+	}
+}
+
+// String returns a human readable string representation of TokenMetadataResp
+func (tmr TokenMetadataResp) String() string {
+	return fmt.Sprintf(`Token Metadata:
+  Symbol:         %s
+  Description:    %s
+  URI:            %s
+  Display Denom:  %s`,
+		tmr.Symbol, tmr.Description, tmr.URI, tmr.DisplayDenom)
+}
+
+// validateMetadata checks the uri, the display denom and the description of a token. All of them are optional
+func validateMetadata(uri, displayDenom, description string) sdk.Error {
+	if len(uri) > MetadataURILenLimit {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("the length of uri %d is bigger than %d", len(uri), MetadataURILenLimit))
+	}
+	if len(uri) != 0 {
+		u, err := url.ParseRequestURI(uri)
+		if err != nil || len(u.Scheme) == 0 {
+			return ErrInvalidTokenMetadata(fmt.Sprintf("uri %s is not an absolute uri", uri))
+		}
+	}
+	if len(displayDenom) != 0 && !reDisplayDenom.MatchString(displayDenom) {
+		return ErrInvalidTokenMetadata(fmt.Sprintf("display denom %s should match %s", displayDenom, reDisplayDenom))
+	}
+	if len(description) > DescLenLimit {
+		return ErrDescLenBiggerThanLimit()
+	}
+	return nil
+}
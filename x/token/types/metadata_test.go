@@ -0,0 +1,39 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateTokenMetadata(t *testing.T) {
+	owner := sdk.AccAddress([]byte("metadataTestOwner"))
+	uri := "https://example.com/xxb.json"
+	tests := []struct {
+		name  string
+		msg   MsgUpdateTokenMetadata
+		valid bool
+	}{
+		{"valid", NewMsgUpdateTokenMetadata(owner, "xxb", uri, "XXB", "xxb token"), true},
+		{"clear all", NewMsgUpdateTokenMetadata(owner, "xxb", "", "", ""), true},
+		{"ipfs uri", NewMsgUpdateTokenMetadata(owner, "xxb", "ipfs://QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", "", ""), true},
+		{"empty owner", NewMsgUpdateTokenMetadata(nil, "xxb", uri, "XXB", ""), false},
+		{"empty symbol", NewMsgUpdateTokenMetadata(owner, "", uri, "XXB", ""), false},
+		{"relative uri", NewMsgUpdateTokenMetadata(owner, "xxb", "xxb.json", "XXB", ""), false},
+		{"uri too long", NewMsgUpdateTokenMetadata(owner, "xxb", "https://"+strings.Repeat("x", MetadataURILenLimit), "", ""), false},
+		{"invalid display denom", NewMsgUpdateTokenMetadata(owner, "xxb", uri, "X X B", ""), false},
+		{"display denom too long", NewMsgUpdateTokenMetadata(owner, "xxb", uri, strings.Repeat("X", 33), ""), false},
+		{"desc too long", NewMsgUpdateTokenMetadata(owner, "xxb", uri, "XXB", strings.Repeat("x", DescLenLimit+1)), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, tc.name)
+		} else {
+			require.NotNil(t, err, tc.name)
+		}
+	}
+}
@@ -398,3 +398,125 @@ func (msg MsgConfirmOwnership) GetSignBytes() []byte {
 func (msg MsgConfirmOwnership) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Address}
 }
+
+// MsgTokenPause pauses or unpauses all the transfers of a token by its owner
+type MsgTokenPause struct {
+	Owner  sdk.AccAddress `json:"owner"`
+	Symbol string         `json:"symbol"`
+	Paused bool           `json:"paused"`
+}
+
+func NewMsgTokenPause(owner sdk.AccAddress, symbol string, paused bool) MsgTokenPause {
+	return MsgTokenPause{
+		Owner:  owner,
+		Symbol: symbol,
+		Paused: paused,
+	}
+}
+
+func (msg MsgTokenPause) Route() string { return RouterKey }
+
+func (msg MsgTokenPause) Type() string { return "pause" }
+
+func (msg MsgTokenPause) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return ErrAddressIsRequired()
+	}
+	return validateControlledSymbol(msg.Symbol)
+}
+
+func (msg MsgTokenPause) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgTokenPause) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgTokenFreeze freezes or unfreezes the addresses of a token by its owner,
+// a frozen address can neither send nor receive the token
+type MsgTokenFreeze struct {
+	Owner     sdk.AccAddress   `json:"owner"`
+	Symbol    string           `json:"symbol"`
+	Addresses []sdk.AccAddress `json:"addresses"`
+	Frozen    bool             `json:"frozen"`
+}
+
+func NewMsgTokenFreeze(owner sdk.AccAddress, symbol string, addresses []sdk.AccAddress, frozen bool) MsgTokenFreeze {
+	return MsgTokenFreeze{
+		Owner:     owner,
+		Symbol:    symbol,
+		Addresses: addresses,
+		Frozen:    frozen,
+	}
+}
+
+func (msg MsgTokenFreeze) Route() string { return RouterKey }
+
+func (msg MsgTokenFreeze) Type() string { return "freeze" }
+
+func (msg MsgTokenFreeze) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return ErrAddressIsRequired()
+	}
+	if err := validateControlledSymbol(msg.Symbol); err != nil {
+		return err
+	}
+	return validateFreezeAddresses(msg.Addresses)
+}
+
+func (msg MsgTokenFreeze) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgTokenFreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgUpdateTokenMetadata sets the metadata uri, the display denom and the description of a token by its owner.
+// An empty uri or display denom removes it from the metadata
+type MsgUpdateTokenMetadata struct {
+	Owner        sdk.AccAddress `json:"owner"`
+	Symbol       string         `json:"symbol"`
+	URI          string         `json:"uri"`
+	DisplayDenom string         `json:"display_denom"`
+	Description  string         `json:"description"`
+}
+
+func NewMsgUpdateTokenMetadata(owner sdk.AccAddress, symbol, uri, displayDenom, description string) MsgUpdateTokenMetadata {
+	return MsgUpdateTokenMetadata{
+		Owner:        owner,
+		Symbol:       symbol,
+		URI:          uri,
+		DisplayDenom: displayDenom,
+		Description:  description,
+	}
+}
+
+func (msg MsgUpdateTokenMetadata) Route() string { return RouterKey }
+
+func (msg MsgUpdateTokenMetadata) Type() string { return "update_metadata" }
+
+func (msg MsgUpdateTokenMetadata) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return ErrAddressIsRequired()
+	}
+	if len(msg.Symbol) == 0 {
+		return ErrMsgSymbolIsEmpty()
+	}
+	if sdk.ValidateDenom(msg.Symbol) != nil {
+		return ErrNotAllowedOriginalSymbol(msg.Symbol)
+	}
+	return validateMetadata(msg.URI, msg.DisplayDenom, msg.Description)
+}
+
+func (msg MsgUpdateTokenMetadata) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgUpdateTokenMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
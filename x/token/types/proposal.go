@@ -0,0 +1,122 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	govtypes "github.com/okex/exchain/x/gov/types"
+)
+
+const (
+	// proposalTypeTokenControl defines the type for a TokenControlProposal
+	proposalTypeTokenControl = "TokenControl"
+
+	// actions of the TokenControlProposal
+	TokenControlActionPause    = "pause"
+	TokenControlActionUnpause  = "unpause"
+	TokenControlActionFreeze   = "freeze"
+	TokenControlActionUnfreeze = "unfreeze"
+)
+
+func init() {
+	govtypes.RegisterProposalType(proposalTypeTokenControl)
+	govtypes.RegisterProposalTypeCodec(TokenControlProposal{}, "okexchain/token/TokenControlProposal")
+}
+
+var _ govtypes.Content = (*TokenControlProposal)(nil)
+
+// TokenControlProposal - structure for the proposal to pause, unpause a token or freeze, unfreeze the addresses
+// of a token, which overrides the controls of the token owner
+type TokenControlProposal struct {
+	Title       string           `json:"title" yaml:"title"`
+	Description string           `json:"description" yaml:"description"`
+	Symbol      string           `json:"symbol" yaml:"symbol"`
+	Action      string           `json:"action" yaml:"action"`
+	Addresses   []sdk.AccAddress `json:"addresses" yaml:"addresses"`
+}
+
+// NewTokenControlProposal creates a new instance of TokenControlProposal
+func NewTokenControlProposal(title, description, symbol, action string, addresses []sdk.AccAddress) TokenControlProposal {
+	return TokenControlProposal{
+		Title:       title,
+		Description: description,
+		Symbol:      symbol,
+		Action:      action,
+		Addresses:   addresses,
+	}
+}
+
+// GetTitle returns title of a token control proposal object
+func (tp TokenControlProposal) GetTitle() string {
+	return tp.Title
+}
+
+// GetDescription returns description of a token control proposal object
+func (tp TokenControlProposal) GetDescription() string {
+	return tp.Description
+}
+
+// ProposalRoute returns route key of a token control proposal object
+func (tp TokenControlProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a token control proposal object
+func (tp TokenControlProposal) ProposalType() string {
+	return proposalTypeTokenControl
+}
+
+// ValidateBasic validates a token control proposal
+func (tp TokenControlProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(tp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(tp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(tp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(tp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if tp.ProposalType() != proposalTypeTokenControl {
+		return govtypes.ErrInvalidProposalType(tp.ProposalType())
+	}
+
+	if err := validateControlledSymbol(tp.Symbol); err != nil {
+		return err
+	}
+
+	switch tp.Action {
+	case TokenControlActionPause, TokenControlActionUnpause:
+		if len(tp.Addresses) != 0 {
+			return ErrInvalidFreezeAddresses(fmt.Sprintf("addresses are not allowed by action %s", tp.Action))
+		}
+	case TokenControlActionFreeze, TokenControlActionUnfreeze:
+		return validateFreezeAddresses(tp.Addresses)
+	default:
+		return ErrInvalidTokenControlAction(tp.Action)
+	}
+	return nil
+}
+
+// String returns a human readable string representation of a TokenControlProposal
+func (tp TokenControlProposal) String() string {
+	addrs := make([]string, 0, len(tp.Addresses))
+	for _, addr := range tp.Addresses {
+		addrs = append(addrs, addr.String())
+	}
+	return fmt.Sprintf(`TokenControlProposal:
+ Title:        %s
+ Description:  %s
+ Type:         %s
+ Symbol:       %s
+ Action:       %s
+ Addresses:    %s`,
+		tp.Title, tp.Description, tp.ProposalType(), tp.Symbol, tp.Action, strings.Join(addrs, ", "))
+}
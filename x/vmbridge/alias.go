@@ -5,6 +5,10 @@ import (
 	"github.com/okex/exchain/x/vmbridge/types"
 )
 
+const (
+	ModuleName = types.ModuleName
+)
+
 var (
 	RegisterMsgServer         = types.RegisterMsgServer
 	NewMsgServerImpl          = keeper.NewMsgServerImpl
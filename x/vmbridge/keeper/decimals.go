@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	"github.com/okex/exchain/x/vmbridge/types"
+)
+
+// convertAmount converts the amount bridged between the erc20 and the cw20 contracts of a pair to the decimals of the
+// contract it's bridged to. The amounts of the pairs without decimals in the params are bridged as they are.
+func (k Keeper) convertAmount(ctx sdk.Context, erc20 common.Address, cw20 sdk.AccAddress, amount sdk.Int, toWasm bool) (converted, dust sdk.Int, err error) {
+	if !tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+		return amount, sdk.ZeroInt(), nil
+	}
+	pair, found := k.GetParams(ctx).GetPairDecimals(erc20, cw20)
+	if !found {
+		return amount, sdk.ZeroInt(), nil
+	}
+	if toWasm {
+		return types.ConvertAmount(amount, pair.ERC20Decimals, pair.CW20Decimals)
+	}
+	return types.ConvertAmount(amount, pair.CW20Decimals, pair.ERC20Decimals)
+}
+
+// refundDustToEvm mints the dust of the erc20 tokens bridged to wasm back to the recipient, the erc20 contract
+// accepts the mint as it's sent from the paired cw20 contract
+func (k Keeper) refundDustToEvm(ctx sdk.Context, erc20 common.Address, cw20 string, recipient sdk.AccAddress, dust sdk.Int) error {
+	if len(recipient) != common.AddressLength {
+		return types.ErrDustNotRefundable
+	}
+	input, err := types.GetMintERC20Input(cw20, common.BytesToAddress(recipient), dust.BigInt())
+	if err != nil {
+		return err
+	}
+	_, result, err := k.CallEvm(ctx, &erc20, big.NewInt(0), input)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrDustRefundFailed, err.Error())
+	}
+	success, err := types.GetMintERC20Output(result.Ret)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrDustRefundFailed, err.Error())
+	}
+	if !success {
+		return types.ErrDustRefundFailed
+	}
+	ctx.EventManager().EmitEvent(types.NewRefundDustEvent(cw20, sdk.AccAddress(erc20.Bytes()).String(), recipient.String(), dust))
+	return nil
+}
+
+// refundDustToWasm mints the dust of the cw20 tokens bridged to evm back to the recipient, the cw20 contract accepts
+// the mint as it's sent from the paired erc20 contract
+func (k Keeper) refundDustToWasm(ctx sdk.Context, cw20, erc20, recipient sdk.AccAddress, dust sdk.Int) error {
+	input, err := types.GetMintCW20Input(dust.String(), recipient.String())
+	if err != nil {
+		return err
+	}
+	if _, err := k.wasmKeeper.Execute(ctx, cw20, erc20, input, sdk.Coins{}); err != nil {
+		return sdkerrors.Wrap(types.ErrDustRefundFailed, err.Error())
+	}
+	ctx.EventManager().EmitEvent(types.NewRefundDustEvent(erc20.String(), cw20.String(), recipient.String(), dust))
+	return nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	tmtypes "github.com/okex/exchain/libs/tendermint/types"
+	evmtypes "github.com/okex/exchain/x/evm/types"
+	"github.com/okex/exchain/x/vmbridge/types"
+)
+
+func (suite *KeeperTestSuite) cw20Balance(addr sdk.AccAddress) string {
+	result, err := suite.app.WasmKeeper.QuerySmart(suite.ctx, suite.wasmContract, []byte(fmt.Sprintf("{\"balance\":{\"address\":\"%s\"}}", addr.String())))
+	suite.Require().NoError(err)
+	return string(result)
+}
+
+// setupPairedERC20 copies the test erc20 contract to the address the test cw20 contract accepts the mints from
+func (suite *KeeperTestSuite) setupPairedERC20() {
+	accAddr, err := sdk.AccAddressFromBech32("ex1fnkz39vpxmukf6mp78essh8g0hrzp3gylyd2u8")
+	suite.Require().NoError(err)
+	contract := common.BytesToAddress(accAddr)
+	csdb := evmtypes.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	csdb.SetCode(contract, csdb.GetCode(suite.evmContract))
+	_, err = csdb.Commit(false)
+	suite.Require().NoError(err)
+	suite.evmContract = contract
+
+	update, err := suite.evmABI.Pack("updatewasmContractAddress", suite.wasmContract.String())
+	suite.Require().NoError(err)
+	_, _, err = suite.app.VMBridgeKeeper.CallEvm(suite.ctx, &suite.evmContract, big.NewInt(0), update)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) setPairDecimals(erc20Decimals, cw20Decimals uint32) {
+	suite.keeper.SetParams(suite.ctx, types.NewParams([]types.PairDecimals{{
+		ERC20Contract: suite.evmContract.String(),
+		CW20Contract:  suite.wasmContract.String(),
+		ERC20Decimals: erc20Decimals,
+		CW20Decimals:  cw20Decimals,
+	}}))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SendToWasmDecimals() {
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+	ethAddr := common.BigToAddress(big.NewInt(1))
+	recipient := sdk.AccAddress(ethAddr.Bytes())
+
+	testCases := []struct {
+		msg           string
+		erc20Decimals uint32
+		cw20Decimals  uint32
+		amount        int64
+		cw20Balance   string
+		erc20Balance  int64
+	}{
+		{"same decimals", 3, 3, 1234, "1234", 0},
+		{"scale down with dust", 3, 1, 1234, "12", 34},
+		{"all dust", 3, 1, 34, "0", 34},
+		{"scale up", 1, 3, 12, "1200", 0},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			suite.setupPairedERC20()
+			suite.setPairDecimals(tc.erc20Decimals, tc.cw20Decimals)
+			suite.ctx.SetEventManager(sdk.NewEventManager())
+
+			err := suite.keeper.SendToWasm(suite.ctx, sdk.AccAddress(suite.evmContract.Bytes()), suite.wasmContract.String(), recipient.String(), sdk.NewInt(tc.amount))
+			suite.Require().NoError(err)
+			suite.Require().Equal(fmt.Sprintf("{\"balance\":\"%s\"}", tc.cw20Balance), suite.cw20Balance(recipient))
+			suite.Require().Equal(tc.erc20Balance, suite.queryBalance(ethAddr).Int64())
+
+			refunded := false
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == types.EventTypeRefundDust {
+					refunded = true
+				}
+			}
+			suite.Require().Equal(tc.erc20Balance > 0, refunded)
+		})
+	}
+
+	// the dust isn't refunded to a wasm contract in evm
+	suite.SetupTest()
+	suite.setupPairedERC20()
+	suite.setPairDecimals(3, 1)
+	err := suite.keeper.SendToWasm(suite.ctx, sdk.AccAddress(suite.evmContract.Bytes()), suite.wasmContract.String(), sdk.AccAddress(make([]byte, 32)).String(), sdk.NewInt(1234))
+	suite.Require().Equal(types.ErrDustNotRefundable, err)
+
+	// the decimals aren't converted before venus5
+	suite.SetupTest()
+	suite.setupPairedERC20()
+	suite.setPairDecimals(3, 1)
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+	suite.Require().NoError(suite.keeper.SendToWasm(suite.ctx, sdk.AccAddress(suite.evmContract.Bytes()), suite.wasmContract.String(), recipient.String(), sdk.NewInt(1234)))
+	suite.Require().Equal("{\"balance\":\"1234\"}", suite.cw20Balance(recipient))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SendToEvmDecimals() {
+	tmtypes.UnittestOnlySetMilestoneVenus5Height(1)
+	defer tmtypes.UnittestOnlySetMilestoneVenus5Height(0)
+	ethAddr := common.BigToAddress(big.NewInt(1))
+	recipient := sdk.AccAddress(ethAddr.Bytes())
+
+	testCases := []struct {
+		msg           string
+		erc20Decimals uint32
+		cw20Decimals  uint32
+		amount        int64
+		erc20Balance  int64
+		cw20Balance   string
+	}{
+		{"scale down with dust", 1, 3, 1234, 12, "34"},
+		{"all dust", 1, 3, 34, 0, "34"},
+		{"scale up", 3, 1, 12, 1200, "0"},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			suite.setupPairedERC20()
+			suite.setPairDecimals(tc.erc20Decimals, tc.cw20Decimals)
+
+			success, err := suite.keeper.SendToEvm(suite.ctx, suite.wasmContract.String(), suite.evmContract.String(), ethAddr.String(), sdk.NewInt(tc.amount))
+			suite.Require().NoError(err)
+			suite.Require().True(success)
+			suite.Require().Equal(tc.erc20Balance, suite.queryBalance(ethAddr).Int64())
+			suite.Require().Equal(fmt.Sprintf("{\"balance\":\"%s\"}", tc.cw20Balance), suite.cw20Balance(recipient))
+		})
+	}
+}
@@ -76,6 +76,28 @@ func (k Keeper) SendToEvm(ctx sdk.Context, caller, contract string, recipient st
 		return false, err
 	}
 	recipientAddr := common.BytesToAddress(recipientAccAddr.Bytes())
+
+	// the amount is converted to the decimals of the erc20 contract, and the dust is minted back to the recipient
+	// by the cw20 contract so the tokens aren't truncated
+	if tmtypes.HigherThanVenus5(ctx.BlockHeight()) {
+		cw20, err := sdk.AccAddressFromBech32(caller)
+		if err != nil {
+			return false, err
+		}
+		var dust sdk.Int
+		if amount, dust, err = k.convertAmount(ctx, conrtractAddr, cw20, amount, false); err != nil {
+			return false, err
+		}
+		if dust.IsPositive() {
+			if err := k.refundDustToWasm(ctx, cw20, contractAccAddr, recipientAccAddr, dust); err != nil {
+				return false, err
+			}
+			if amount.IsZero() {
+				return true, nil
+			}
+		}
+	}
+
 	input, err := types.GetMintERC20Input(caller, recipientAddr, amount.BigInt())
 	if err != nil {
 		return false, err
@@ -84,13 +106,23 @@ func (k Keeper) SendToEvm(ctx sdk.Context, caller, contract string, recipient st
 	if err != nil {
 		return false, err
 	}
-	return types.GetMintERC20Output(result.Ret)
+	success, err = types.GetMintERC20Output(result.Ret)
+	if err != nil {
+		return false, err
+	}
+	if success {
+		ctx.EventManager().EmitEvent(types.NewSendToEvmEvent(caller, contract, recipient, amount))
+	}
+	return success, nil
 }
 
 // callEvm execute an evm message from native module
 func (k Keeper) CallEvm(ctx sdk.Context, to *common.Address, value *big.Int, data []byte) (*evmtypes.ExecutionResult, *evmtypes.ResultData, error) {
-	callerAddr := erc20types.IbcEvmModuleETHAddr
+	return k.CallEvmFrom(ctx, erc20types.IbcEvmModuleETHAddr, to, value, data)
+}
 
+// CallEvmFrom executes an evm message sent by the caller, which must be an account controlled by a native module
+func (k Keeper) CallEvmFrom(ctx sdk.Context, callerAddr common.Address, to *common.Address, value *big.Int, data []byte) (*evmtypes.ExecutionResult, *evmtypes.ResultData, error) {
 	config, found := k.evmKeeper.GetChainConfig(ctx)
 	if !found {
 		return nil, nil, types.ErrChainConfigNotFound
@@ -112,6 +144,12 @@ func (k Keeper) CallEvm(ctx sdk.Context, to *common.Address, value *big.Int, dat
 	gasLimit := ctx.GasMeter().Limit()
 	if gasLimit == sdk.NewInfiniteGasMeter().Limit() {
 		gasLimit = k.evmKeeper.GetParams(ctx).MaxGasLimitPerTx
+		// the evm gets the gas limit minus the gas consumed. A wasm tx simulated without a simulation gas limit has an
+		// infinite gas meter, so the gas it consumed is added, otherwise the evm call gets less gas than it does in
+		// the tx and the estimate falls short.
+		if ctx.IsCheckTx() {
+			gasLimit += ctx.GasMeter().GasConsumed()
+		}
 	}
 
 	st := evmtypes.StateTransition{
@@ -193,6 +193,23 @@ func (suite *KeeperTestSuite) TestKeeper_SendToEvm() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestKeeper_SendToEvmSimulateGas() {
+	caller := suite.wasmContract.String()
+	contract := suite.evmContract.String()
+	recipient := common.BigToAddress(big.NewInt(1)).String()
+
+	// a simulation without a gas limit, which consumed nearly the max gas of an evm tx before calling the evm
+	suite.ctx.SetIsCheckTx(true)
+	suite.ctx.SetGasMeter(sdk.NewInfiniteGasMeter())
+	consumed := suite.app.EvmKeeper.GetParams(suite.ctx).MaxGasLimitPerTx - 30000
+	suite.ctx.GasMeter().ConsumeGas(consumed, "wasm execution")
+
+	success, err := suite.app.VMBridgeKeeper.SendToEvm(suite.ctx, caller, contract, recipient, sdk.NewInt(1))
+	suite.Require().NoError(err)
+	suite.Require().True(success)
+	suite.Require().Greater(suite.ctx.GasMeter().GasConsumed(), consumed+30000)
+}
+
 func (suite *KeeperTestSuite) TestSendToWasmEventHandler_Handle() {
 	contractAccAddr, err := sdk.AccAddressFromBech32("ex1fnkz39vpxmukf6mp78essh8g0hrzp3gylyd2u8")
 	suite.Require().NoError(err)
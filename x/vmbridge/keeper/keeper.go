@@ -4,22 +4,29 @@ import (
 	"github.com/okex/exchain/x/vmbridge/types"
 
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	"github.com/okex/exchain/libs/tendermint/libs/log"
+	"github.com/okex/exchain/x/params"
 )
 
 type Keeper struct {
 	cdc *codec.CodecProxy
 
-	logger log.Logger
+	logger     log.Logger
+	paramSpace params.Subspace
 
 	evmKeeper     EVMKeeper
 	wasmKeeper    WASMKeeper
 	accountKeeper AccountKeeper
 }
 
-func NewKeeper(cdc *codec.CodecProxy, logger log.Logger, evmKeeper EVMKeeper, wasmKeeper WASMKeeper, accountKeeper AccountKeeper) *Keeper {
+func NewKeeper(cdc *codec.CodecProxy, logger log.Logger, paramSpace params.Subspace, evmKeeper EVMKeeper, wasmKeeper WASMKeeper, accountKeeper AccountKeeper) *Keeper {
 	logger = logger.With("module", types.ModuleName)
-	return &Keeper{cdc: cdc, logger: logger, evmKeeper: evmKeeper, wasmKeeper: wasmKeeper, accountKeeper: accountKeeper}
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+	return &Keeper{cdc: cdc, logger: logger, paramSpace: paramSpace, evmKeeper: evmKeeper, wasmKeeper: wasmKeeper, accountKeeper: accountKeeper}
 }
 
 func (k Keeper) Logger() log.Logger {
@@ -33,3 +40,14 @@ func (k Keeper) getAminoCodec() *codec.Codec {
 func (k Keeper) GetProtoCodec() *codec.ProtoCodec {
 	return k.cdc.GetProtocMarshal()
 }
+
+// GetParams returns the vmbridge parameters, the params are empty until they're set by a proposal
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyPairDecimals, &params.PairDecimals)
+	return params
+}
+
+// SetParams sets the vmbridge parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
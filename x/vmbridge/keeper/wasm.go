@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
@@ -26,10 +27,6 @@ func (k Keeper) SendToWasm(ctx sdk.Context, caller sdk.AccAddress, wasmContractA
 	if amount.IsNegative() {
 		return types.ErrAmountNegative
 	}
-	input, err := types.GetMintCW20Input(amount.String(), to.String())
-	if err != nil {
-		return err
-	}
 	contractAddr, err := sdk.AccAddressFromBech32(wasmContractAddr)
 	if err != nil {
 		return err
@@ -38,11 +35,38 @@ func (k Keeper) SendToWasm(ctx sdk.Context, caller sdk.AccAddress, wasmContractA
 		return types.ErrIsNotWasmAddr
 	}
 
+	// the amount is converted to the decimals of the cw20 contract, and the dust is minted back to the recipient
+	// by the erc20 contract so the tokens aren't truncated
+	erc20 := common.BytesToAddress(caller)
+	amount, dust, err := k.convertAmount(ctx, erc20, contractAddr, amount, true)
+	if err != nil {
+		return err
+	}
+	if dust.IsPositive() {
+		if err := k.refundDustToEvm(ctx, erc20, wasmContractAddr, to, dust); err != nil {
+			return err
+		}
+		if amount.IsZero() {
+			return nil
+		}
+	}
+
+	input, err := types.GetMintCW20Input(amount.String(), to.String())
+	if err != nil {
+		return err
+	}
 	ret, err := k.wasmKeeper.Execute(ctx, contractAddr, caller, input, sdk.Coins{})
 	if err != nil {
 		k.Logger().Error("wasm return", string(ret))
+		return err
 	}
-	return err
+	ctx.EventManager().EmitEvent(types.NewSendToWasmEvent(caller.String(), wasmContractAddr, recipient, amount))
+	return nil
+}
+
+// CallWasm executes a wasm contract as the caller, which must be an account controlled by a native module
+func (k Keeper) CallWasm(ctx sdk.Context, caller, contractAddr sdk.AccAddress, msg []byte) ([]byte, error) {
+	return k.wasmKeeper.Execute(ctx, contractAddr, caller, msg, sdk.Coins{})
 }
 
 // RegisterSendToEvmEncoder needs to be registered in app setup to handle custom message callbacks
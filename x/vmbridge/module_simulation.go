@@ -0,0 +1,42 @@
+package vmbridge
+
+import (
+	"math/rand"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/types/module"
+	sim "github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
+	"github.com/okex/exchain/x/vmbridge/simulation"
+)
+
+var _ module.AppModuleSimulation = AppModuleSimulation{}
+
+// AppModuleSimulation implements the simulation functions of the vmbridge. The vmbridge isn't an app module, its
+// msgs are only sent by the wasm contracts, so the simulation only randomizes its params.
+type AppModuleSimulation struct{}
+
+// NewAppModuleSimulation creates a new AppModuleSimulation object
+func NewAppModuleSimulation() AppModuleSimulation {
+	return AppModuleSimulation{}
+}
+
+// GenerateGenesisState doesn't generate any genesis state, as the vmbridge has none.
+func (AppModuleSimulation) GenerateGenesisState(_ *module.SimulationState) {}
+
+// ProposalContents doesn't return any content functions for governance proposals.
+func (AppModuleSimulation) ProposalContents(_ module.SimulationState) []sim.WeightedProposalContent {
+	return nil
+}
+
+// RandomizedParams creates randomized vmbridge param changes for the simulator.
+func (AppModuleSimulation) RandomizedParams(r *rand.Rand) []sim.ParamChange {
+	return simulation.ParamChanges(r)
+}
+
+// RegisterStoreDecoder registers a decoder for vmbridge module's types
+func (AppModuleSimulation) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+
+// WeightedOperations doesn't return any vmbridge operation, as its msgs are sent by the wasm contracts.
+func (AppModuleSimulation) WeightedOperations(_ module.SimulationState) []sim.WeightedOperation {
+	return nil
+}
@@ -0,0 +1,45 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	ethcmm "github.com/ethereum/go-ethereum/common"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/codec"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/libs/cosmos-sdk/x/simulation"
+	"github.com/okex/exchain/x/vmbridge/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyPairDecimals),
+			func(r *rand.Rand) string {
+				return string(codec.New().MustMarshalJSON(GenPairDecimals(r)))
+			},
+		),
+	}
+}
+
+// GenPairDecimals randomized PairDecimals of up to 3 random contract pairs
+func GenPairDecimals(r *rand.Rand) []types.PairDecimals {
+	pairs := make([]types.PairDecimals, r.Intn(4))
+	for i := range pairs {
+		erc20 := make([]byte, ethcmm.AddressLength)
+		r.Read(erc20)
+		cw20 := make([]byte, sdk.WasmContractAddrLen)
+		r.Read(cw20)
+
+		pairs[i] = types.PairDecimals{
+			ERC20Contract: ethcmm.BytesToAddress(erc20).Hex(),
+			CW20Contract:  sdk.AccAddress(cw20).String(),
+			ERC20Decimals: uint32(r.Intn(types.MaxDecimals + 1)),
+			CW20Decimals:  uint32(r.Intn(types.MaxDecimals + 1)),
+		}
+	}
+	return pairs
+}
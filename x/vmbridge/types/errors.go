@@ -23,6 +23,10 @@ var (
 	ErrVMBridgeEnable = sdkerrors.Register(ModuleName, 8, "the vmbridge is disable")
 	ErrIsNotOKCAddr   = sdkerrors.Register(ModuleName, 9, "the address prefix must be ex")
 	ErrIsNotETHAddr   = sdkerrors.Register(ModuleName, 10, "the address prefix must be 0x")
+
+	ErrAmountOverflow    = sdkerrors.Register(ModuleName, 12, "the converted amount overflows")
+	ErrDustNotRefundable = sdkerrors.Register(ModuleName, 13, "the dust can not be refunded to a wasm contract recipient")
+	ErrDustRefundFailed  = sdkerrors.Register(ModuleName, 14, "the dust refund failed")
 )
 
 func ErrMsgSendToEvm(str string) sdk.EnvelopedErr {
@@ -0,0 +1,69 @@
+package types
+
+import (
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// schemas of the typed events emitted by the vmbridge module
+var (
+	EventSchemaSendToWasm = sdk.NewEventSchema(ModuleName, EventTypeSendToWasm,
+		"cw20 tokens are minted to the recipient by the erc20 tokens sent from evm",
+		sdk.NewEventAttributeSchema(AttributeKeyCaller, sdk.EventValueTypeAddress, "address of the erc20 contract"),
+		sdk.NewEventAttributeSchema(AttributeKeyContract, sdk.EventValueTypeAddress, "address of the cw20 contract"),
+		sdk.NewEventAttributeSchema(AttributeKeyRecipient, sdk.EventValueTypeAddress, "address of the recipient"),
+		sdk.NewEventAttributeSchema(AttributeKeyAmount, sdk.EventValueTypeUint, "amount of the tokens"),
+	)
+	EventSchemaSendToEvm = sdk.NewEventSchema(ModuleName, EventTypeSendToEvm,
+		"erc20 tokens are minted to the recipient by the cw20 tokens sent from wasm",
+		sdk.NewEventAttributeSchema(AttributeKeyCaller, sdk.EventValueTypeAddress, "address of the cw20 contract"),
+		sdk.NewEventAttributeSchema(AttributeKeyContract, sdk.EventValueTypeAddress, "address of the erc20 contract"),
+		sdk.NewEventAttributeSchema(AttributeKeyRecipient, sdk.EventValueTypeAddress, "address of the recipient"),
+		sdk.NewEventAttributeSchema(AttributeKeyAmount, sdk.EventValueTypeUint, "amount of the tokens"),
+	)
+	EventSchemaRefundDust = sdk.NewEventSchema(ModuleName, EventTypeRefundDust,
+		"the dust of the bridged tokens which doesn't convert to the decimals of the paired contract is minted back to the recipient",
+		sdk.NewEventAttributeSchema(AttributeKeyCaller, sdk.EventValueTypeAddress, "address of the contract the tokens are bridged to"),
+		sdk.NewEventAttributeSchema(AttributeKeyContract, sdk.EventValueTypeAddress, "address of the contract minting the dust"),
+		sdk.NewEventAttributeSchema(AttributeKeyRecipient, sdk.EventValueTypeAddress, "address of the recipient"),
+		sdk.NewEventAttributeSchema(AttributeKeyAmount, sdk.EventValueTypeUint, "amount of the dust"),
+	)
+)
+
+func init() {
+	sdk.RegisterEventSchemas(
+		EventSchemaSendToWasm,
+		EventSchemaSendToEvm,
+		EventSchemaRefundDust,
+	)
+}
+
+// NewSendToWasmEvent creates the event of the tokens sent from evm to wasm
+func NewSendToWasmEvent(caller, contract, recipient string, amount sdk.Int) sdk.Event {
+	return EventSchemaSendToWasm.NewEvent(
+		sdk.NewAttribute(AttributeKeyCaller, caller),
+		sdk.NewAttribute(AttributeKeyContract, contract),
+		sdk.NewAttribute(AttributeKeyRecipient, recipient),
+		sdk.NewAttribute(AttributeKeyAmount, amount.String()),
+	)
+}
+
+// NewSendToEvmEvent creates the event of the tokens sent from wasm to evm
+func NewSendToEvmEvent(caller, contract, recipient string, amount sdk.Int) sdk.Event {
+	return EventSchemaSendToEvm.NewEvent(
+		sdk.NewAttribute(AttributeKeyCaller, caller),
+		sdk.NewAttribute(AttributeKeyContract, contract),
+		sdk.NewAttribute(AttributeKeyRecipient, recipient),
+		sdk.NewAttribute(AttributeKeyAmount, amount.String()),
+	)
+}
+
+// NewRefundDustEvent creates the event of the dust minted back to the recipient by the contract the tokens are bridged
+// from
+func NewRefundDustEvent(caller, contract, recipient string, amount sdk.Int) sdk.Event {
+	return EventSchemaRefundDust.NewEvent(
+		sdk.NewAttribute(AttributeKeyCaller, caller),
+		sdk.NewAttribute(AttributeKeyContract, contract),
+		sdk.NewAttribute(AttributeKeyRecipient, recipient),
+		sdk.NewAttribute(AttributeKeyAmount, amount.String()),
+	)
+}
@@ -0,0 +1,13 @@
+package types
+
+// vmbridge module event types
+const (
+	EventTypeSendToWasm = "send_to_wasm"
+	EventTypeSendToEvm  = "send_to_evm"
+	EventTypeRefundDust = "refund_dust"
+
+	AttributeKeyCaller    = "caller"
+	AttributeKeyContract  = "contract"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyAmount    = "amount"
+)
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/params"
+)
+
+// MaxDecimals caps the decimals of the bridged tokens, so the conversions between them stay in 256 bits
+const MaxDecimals = 36
+
+// Parameter store key
+var (
+	ParamStoreKeyPairDecimals = []byte("PairDecimals")
+)
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// PairDecimals is the decimals of an erc20 contract and the cw20 contract it's paired with in the vmbridge
+type PairDecimals struct {
+	// erc20_contract is the hex address of the erc20 contract
+	ERC20Contract string `json:"erc20_contract" yaml:"erc20_contract"`
+	// cw20_contract is the bech32 address of the cw20 contract
+	CW20Contract  string `json:"cw20_contract" yaml:"cw20_contract"`
+	ERC20Decimals uint32 `json:"erc20_decimals" yaml:"erc20_decimals"`
+	CW20Decimals  uint32 `json:"cw20_decimals" yaml:"cw20_decimals"`
+}
+
+// Validate validates the contracts and the decimals of the pair
+func (p PairDecimals) Validate() error {
+	if !common.IsHexAddress(p.ERC20Contract) {
+		return fmt.Errorf("erc20 contract %s is not a hex address", p.ERC20Contract)
+	}
+	contract, err := sdk.AccAddressFromBech32(p.CW20Contract)
+	if err != nil {
+		return fmt.Errorf("cw20 contract %s is not a bech32 address", p.CW20Contract)
+	}
+	if !sdk.IsWasmAddress(contract) {
+		return fmt.Errorf("cw20 contract %s is not a wasm address", p.CW20Contract)
+	}
+	if p.ERC20Decimals > MaxDecimals || p.CW20Decimals > MaxDecimals {
+		return fmt.Errorf("decimals of the pair %s-%s exceed %d", p.ERC20Contract, p.CW20Contract, MaxDecimals)
+	}
+	return nil
+}
+
+// Params defines the vmbridge params
+type Params struct {
+	// pair_decimals are the decimals of the pairs whose amounts are converted when they're bridged, the amounts of
+	// the other pairs are bridged as they are
+	PairDecimals []PairDecimals `json:"pair_decimals" yaml:"pair_decimals"`
+}
+
+// NewParams creates a new Params object
+func NewParams(pairDecimals []PairDecimals) Params {
+	return Params{
+		PairDecimals: pairDecimals,
+	}
+}
+
+// DefaultParams returns the default parameters of the vmbridge
+func DefaultParams() Params {
+	return NewParams([]PairDecimals{})
+}
+
+// String implements the fmt.Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyPairDecimals, &p.PairDecimals, validatePairDecimals),
+	}
+}
+
+// Validate checks all the params
+func (p Params) Validate() error {
+	return validatePairDecimals(p.PairDecimals)
+}
+
+// GetPairDecimals returns the decimals of the pair of the erc20 and the cw20 contracts
+func (p Params) GetPairDecimals(erc20 common.Address, cw20 sdk.AccAddress) (PairDecimals, bool) {
+	for _, pair := range p.PairDecimals {
+		if common.HexToAddress(pair.ERC20Contract) != erc20 {
+			continue
+		}
+		if contract, err := sdk.AccAddressFromBech32(pair.CW20Contract); err == nil && contract.Equals(cw20) {
+			return pair, true
+		}
+	}
+	return PairDecimals{}, false
+}
+
+func validatePairDecimals(i interface{}) error {
+	v, ok := i.([]PairDecimals)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	erc20s := make(map[common.Address]bool, len(v))
+	for _, pair := range v {
+		if err := pair.Validate(); err != nil {
+			return err
+		}
+		// an erc20 contract mints and burns the tokens of a single cw20 contract
+		erc20 := common.HexToAddress(pair.ERC20Contract)
+		if erc20s[erc20] {
+			return fmt.Errorf("duplicate decimals of erc20 contract %s", pair.ERC20Contract)
+		}
+		erc20s[erc20] = true
+	}
+	return nil
+}
+
+// ConvertAmount converts an amount of a token of the decimals from to the amount of a token of the decimals to. The
+// amount is rounded down, the dust is the remainder in the decimals from which isn't converted.
+func ConvertAmount(amount sdk.Int, from, to uint32) (converted, dust sdk.Int, err error) {
+	if from == to {
+		return amount, sdk.ZeroInt(), nil
+	}
+	if from > to {
+		factor := sdk.NewIntFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(from-to)), nil))
+		return amount.Quo(factor), amount.Mod(factor), nil
+	}
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(to-from)), nil)
+	result := factor.Mul(factor, amount.BigInt())
+	if result.BitLen() > 255 {
+		return sdk.Int{}, sdk.Int{}, ErrAmountOverflow
+	}
+	return sdk.NewIntFromBigInt(result), sdk.ZeroInt(), nil
+}
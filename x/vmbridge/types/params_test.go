@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertAmount(t *testing.T) {
+	testCases := []struct {
+		msg       string
+		amount    sdk.Int
+		from, to  uint32
+		converted sdk.Int
+		dust      sdk.Int
+		err       error
+	}{
+		{"same decimals", sdk.NewInt(1234), 6, 6, sdk.NewInt(1234), sdk.ZeroInt(), nil},
+		{"scale down", sdk.NewInt(1234567), 18, 12, sdk.NewInt(1), sdk.NewInt(234567), nil},
+		{"scale down without dust", sdk.NewInt(2000000), 18, 12, sdk.NewInt(2), sdk.ZeroInt(), nil},
+		{"scale up", sdk.NewInt(12), 6, 18, sdk.NewInt(12000000000000), sdk.ZeroInt(), nil},
+		{"overflow", sdk.NewIntWithDecimal(1, 42), 0, 36, sdk.Int{}, sdk.Int{}, ErrAmountOverflow},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			converted, dust, err := ConvertAmount(tc.amount, tc.from, tc.to)
+			if tc.err != nil {
+				require.Equal(t, tc.err, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.converted.String(), converted.String())
+			require.Equal(t, tc.dust.String(), dust.String())
+		})
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	erc20 := common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	cw20 := sdk.AccAddress(make([]byte, 32))
+	pair := PairDecimals{ERC20Contract: erc20.String(), CW20Contract: cw20.String(), ERC20Decimals: 18, CW20Decimals: 6}
+
+	require.NoError(t, DefaultParams().Validate())
+	params := NewParams([]PairDecimals{pair})
+	require.NoError(t, params.Validate())
+	found, ok := params.GetPairDecimals(erc20, cw20)
+	require.True(t, ok)
+	require.Equal(t, pair, found)
+	_, ok = params.GetPairDecimals(erc20, sdk.AccAddress(erc20.Bytes()))
+	require.False(t, ok)
+
+	require.Error(t, NewParams([]PairDecimals{pair, pair}).Validate())
+	invalid := pair
+	invalid.CW20Contract = sdk.AccAddress(erc20.Bytes()).String()
+	require.Error(t, NewParams([]PairDecimals{invalid}).Validate())
+	invalid = pair
+	invalid.ERC20Contract = "erc20"
+	require.Error(t, NewParams([]PairDecimals{invalid}).Validate())
+	invalid = pair
+	invalid.ERC20Decimals = MaxDecimals + 1
+	require.Error(t, NewParams([]PairDecimals{invalid}).Validate())
+}
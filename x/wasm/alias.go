@@ -63,6 +63,7 @@ var (
 	DefaultQueryPlugins    = keeper.DefaultQueryPlugins
 	BankQuerier            = keeper.BankQuerier
 	NoCustomQuerier        = keeper.NoCustomQuerier
+	NewCustomPlugins       = keeper.NewCustomPlugins
 	StakingQuerier         = keeper.StakingQuerier
 	WasmQuerier            = keeper.WasmQuerier
 	CreateTestInput        = keeper.CreateTestInput
@@ -95,6 +96,8 @@ var (
 	NecessaryProposals               = types.NecessaryProposals
 	ContractCodeHistoryElementPrefix = types.ContractCodeHistoryElementPrefix
 	WithMessageEncoders              = keeper.WithMessageEncoders
+	WithQueryPlugins                 = keeper.WithQueryPlugins
+	WithCustomPlugins                = keeper.WithCustomPlugins
 	SetNeedParamsUpdate              = keeper.SetNeedParamsUpdate
 )
 
@@ -133,6 +136,8 @@ type (
 	QueryHandler                   = keeper.QueryHandler
 	CustomQuerier                  = keeper.CustomQuerier
 	QueryPlugins                   = keeper.QueryPlugins
+	CustomPlugins                  = keeper.CustomPlugins
+	CustomPlugin                   = keeper.CustomPlugin
 	Option                         = keeper.Option
 	ContractOpsKeeper              = types.ContractOpsKeeper
 )
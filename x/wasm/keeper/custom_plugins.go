@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
+	ibcadapter "github.com/okex/exchain/libs/cosmos-sdk/types/ibc-adapter"
+
+	"github.com/okex/exchain/x/wasm/types"
+)
+
+// CustomPluginsQueryRoute is the route of the custom query listing the registered plugins, e.g.
+//
+//	{"custom_plugins":{}}
+const CustomPluginsQueryRoute = "custom_plugins"
+
+// CustomPlugin is the custom messages and queries a module exposes to the contracts under its route
+type CustomPlugin struct {
+	// Version of the schema, increased on the breaking changes of the messages or the queries
+	Version uint64
+	// Schema is the json schema of the messages and the queries
+	Schema json.RawMessage
+	// Encoder encodes the custom messages of the route, nil if the module has no custom message
+	Encoder CustomEncoder
+	// Querier answers the custom queries of the route, nil if the module has no custom query
+	Querier CustomQuerier
+}
+
+// CustomPluginInfo is the description of a registered plugin returned to the contracts
+type CustomPluginInfo struct {
+	Route   string          `json:"route"`
+	Version uint64          `json:"version"`
+	Schema  json.RawMessage `json:"schema,omitempty"`
+}
+
+// CustomPluginsResponse is the response of the custom query listing the registered plugins
+type CustomPluginsResponse struct {
+	Plugins []CustomPluginInfo `json:"plugins"`
+}
+
+// CustomPlugins routes the custom messages and queries of the contracts to the modules registering them. The custom
+// message or query is a json object whose single key is the route of the module and whose value is passed on to the
+// plugin, e.g.
+//
+//	{"oracle":{"exchange_rate":{"denom":"usdt"}}}
+type CustomPlugins struct {
+	plugins map[string]CustomPlugin
+}
+
+// NewCustomPlugins returns an empty registry of the custom plugins
+func NewCustomPlugins() *CustomPlugins {
+	return &CustomPlugins{plugins: make(map[string]CustomPlugin)}
+}
+
+// Register registers the plugin of a module under its route, it panics if the route is taken
+func (p *CustomPlugins) Register(route string, plugin CustomPlugin) *CustomPlugins {
+	if route == "" || route == CustomPluginsQueryRoute {
+		panic(fmt.Sprintf("invalid custom plugin route: %q", route))
+	}
+	if _, ok := p.plugins[route]; ok {
+		panic(fmt.Sprintf("custom plugin route %s has already been registered", route))
+	}
+	if plugin.Schema != nil && !json.Valid(plugin.Schema) {
+		panic(fmt.Sprintf("invalid json schema of the custom plugin route %s", route))
+	}
+	p.plugins[route] = plugin
+	return p
+}
+
+// Plugins returns the description of the registered plugins sorted by route
+func (p *CustomPlugins) Plugins() []CustomPluginInfo {
+	infos := make([]CustomPluginInfo, 0, len(p.plugins))
+	for route, plugin := range p.plugins {
+		infos = append(infos, CustomPluginInfo{Route: route, Version: plugin.Version, Schema: plugin.Schema})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Route < infos[j].Route
+	})
+	return infos
+}
+
+// splitCustomRoute splits the custom message or query into the route and the data of the plugin, ok is false if it
+// isn't an object with a single key
+func splitCustomRoute(request json.RawMessage) (route string, data json.RawMessage, ok bool) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(request, &fields); err != nil || len(fields) != 1 {
+		return "", nil, false
+	}
+	for k, v := range fields {
+		route, data = k, v
+	}
+	return route, data, true
+}
+
+// Encoder returns the custom encoder routing the messages to the plugins, the messages of no plugin are passed on to
+// the fallback
+func (p *CustomPlugins) Encoder(fallback CustomEncoder) CustomEncoder {
+	return func(sender sdk.AccAddress, msg json.RawMessage) ([]ibcadapter.Msg, error) {
+		if r, data, ok := splitCustomRoute(msg); ok {
+			if plugin, found := p.plugins[r]; found {
+				if plugin.Encoder == nil {
+					return nil, sdkerrors.Wrapf(types.ErrUnknownMsg, "custom plugin %s has no message", r)
+				}
+				return plugin.Encoder(sender, data)
+			}
+		}
+		if fallback == nil {
+			return NoCustomMsg(sender, msg)
+		}
+		return fallback(sender, msg)
+	}
+}
+
+// Querier returns the custom querier routing the queries to the plugins, the queries of no plugin are passed on to
+// the fallback
+func (p *CustomPlugins) Querier(fallback CustomQuerier) CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		if r, data, ok := splitCustomRoute(request); ok {
+			if r == CustomPluginsQueryRoute {
+				return json.Marshal(CustomPluginsResponse{Plugins: p.Plugins()})
+			}
+			if plugin, found := p.plugins[r]; found {
+				if plugin.Querier == nil {
+					return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("custom plugin %s has no query", r)}
+				}
+				return plugin.Querier(ctx, data)
+			}
+		}
+		if fallback == nil {
+			return NoCustomQuerier(ctx, request)
+		}
+		return fallback(ctx, request)
+	}
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	ibcadapter "github.com/okex/exchain/libs/cosmos-sdk/types/ibc-adapter"
+	"github.com/okex/exchain/x/wasm/types"
+)
+
+func TestCustomPlugins(t *testing.T) {
+	myMsg := &types.MsgClearAdmin{Sender: "sender"}
+	plugins := NewCustomPlugins().
+		Register("dex", CustomPlugin{
+			Version: 2,
+			Schema:  json.RawMessage(`{"type":"object"}`),
+			Encoder: func(sender sdk.AccAddress, msg json.RawMessage) ([]ibcadapter.Msg, error) {
+				require.JSONEq(t, `{"place_order":{}}`, string(msg))
+				return []ibcadapter.Msg{myMsg}, nil
+			},
+			Querier: func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+				return request, nil
+			},
+		}).
+		Register("farm", CustomPlugin{Version: 1})
+
+	// the routes are unique and the schemas are valid json
+	assert.Panics(t, func() { plugins.Register("dex", CustomPlugin{}) })
+	assert.Panics(t, func() { plugins.Register(CustomPluginsQueryRoute, CustomPlugin{}) })
+	assert.Panics(t, func() { plugins.Register("oracle", CustomPlugin{Schema: json.RawMessage(`{`)}) })
+
+	fallbackMsg := &types.MsgClearAdmin{Sender: "fallback"}
+	encoder := plugins.Encoder(func(sender sdk.AccAddress, msg json.RawMessage) ([]ibcadapter.Msg, error) {
+		return []ibcadapter.Msg{fallbackMsg}, nil
+	})
+	msgs, err := encoder(nil, json.RawMessage(`{"dex":{"place_order":{}}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []ibcadapter.Msg{myMsg}, msgs)
+	_, err = encoder(nil, json.RawMessage(`{"farm":{}}`))
+	assert.True(t, types.ErrUnknownMsg.Is(err))
+	// the messages of no plugin are passed on to the fallback
+	msgs, err = encoder(nil, json.RawMessage(`{"sender":"a","contract":"b"}`))
+	require.NoError(t, err)
+	assert.Equal(t, []ibcadapter.Msg{fallbackMsg}, msgs)
+	_, err = plugins.Encoder(nil)(nil, json.RawMessage(`{"swap":{}}`))
+	assert.True(t, types.ErrUnknownMsg.Is(err))
+
+	querier := plugins.Querier(NoCustomQuerier)
+	res, err := querier(sdk.Context{}, json.RawMessage(`{"dex":{"order":{"id":1}}}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"order":{"id":1}}`, string(res))
+	_, err = querier(sdk.Context{}, json.RawMessage(`{"farm":{}}`))
+	assert.IsType(t, wasmvmtypes.UnsupportedRequest{}, err)
+	_, err = querier(sdk.Context{}, json.RawMessage(`{"swap":{}}`))
+	assert.Equal(t, wasmvmtypes.UnsupportedRequest{Kind: "custom"}, err)
+
+	// the contracts look the versions and the schemas of the plugins up
+	res, err = querier(sdk.Context{}, json.RawMessage(`{"custom_plugins":{}}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"plugins":[{"route":"dex","version":2,"schema":{"type":"object"}},{"route":"farm","version":1}]}`, string(res))
+}
@@ -20,9 +20,10 @@ import (
 	"github.com/okex/exchain/x/wasm/ioutils"
 	"github.com/okex/exchain/x/wasm/types"
 	"github.com/okex/exchain/x/wasm/watcher"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"math"
 	"path/filepath"
-	"strconv"
 	"strings"
 )
 
@@ -86,6 +87,8 @@ type Keeper struct {
 	gasRegister       GasRegister
 	maxQueryStackSize uint32
 	ada               types.DBAdapter
+	// queryPool serves the smart queries of the grpc querier, nil when they are served in the goroutine of the caller
+	queryPool *QueryPool
 }
 
 type defaultAdapter struct{}
@@ -185,6 +188,9 @@ func newKeeper(cdc *codec.CodecProxy,
 		ada:               ada,
 		maxQueryStackSize: types.DefaultMaxQueryStackSize,
 	}
+	if wasmConfig.SmartQueryPoolSize > 0 {
+		keeper.queryPool = NewQueryPool(int(wasmConfig.SmartQueryPoolSize), wasmConfig.SmartQueryTimeout)
+	}
 	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, channelKeeper, queryRouter, keeper)
 	for _, o := range opts {
 		o.apply(keeper)
@@ -274,6 +280,11 @@ func (k Keeper) getInstantiateAccessConfig(ctx sdk.Context) types.AccessType {
 	return k.GetParams(ctx).InstantiateDefaultPermission
 }
 
+// GetAccountKeeper returns the account keeper used by the wasm keeper
+func (k Keeper) GetAccountKeeper() types.AccountKeeper {
+	return k.accountKeeper
+}
+
 // GetParams returns the total set of wasm parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	var params types.Params
@@ -287,6 +298,21 @@ func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
 	GetWasmParamsCache().SetNeedParamsUpdate()
 }
 
+// GetMaxWasmCodeSize returns the max size of the uncompressed wasm code stored, which is types.MaxWasmSize until
+// it's lowered by governance.
+func (k Keeper) GetMaxWasmCodeSize(ctx sdk.Context) uint64 {
+	size := uint64(types.MaxWasmSize)
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyMaxWasmCodeSize) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyMaxWasmCodeSize, &size)
+	}
+	return size
+}
+
+// SetMaxWasmCodeSize sets the max size of the uncompressed wasm code stored.
+func (k Keeper) SetMaxWasmCodeSize(ctx sdk.Context, size uint64) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyMaxWasmCodeSize, &size)
+}
+
 func (k Keeper) OnAccountUpdated(acc exported.Account) {
 	watcher.DeleteAccount(acc.GetAddress())
 }
@@ -308,7 +334,10 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "instantiate access must be subset of default upload access")
 	}
 
-	wasmCode, err = ioutils.Uncompress(wasmCode, uint64(types.MaxWasmSize))
+	// the param is read without gas, so that the gas of storing code is the same as before it's introduced
+	paramCtx := ctx
+	paramCtx.SetGasMeter(sdk.NewInfiniteGasMeter())
+	wasmCode, err = ioutils.Uncompress(wasmCode, k.GetMaxWasmCodeSize(paramCtx))
 	if err != nil {
 		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
@@ -327,10 +356,7 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	codeInfo := types.NewCodeInfo(checksum, creator, *instantiateAccess)
 	k.storeCodeInfo(ctx, codeID, codeInfo)
 
-	evt := sdk.NewEvent(
-		types.EventTypeStoreCode,
-		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
-	)
+	evt := types.NewStoreCodeEvent(codeID)
 	for _, f := range strings.Split(report.RequiredFeatures, ",") {
 		evt.AppendAttributes(sdk.NewAttribute(types.AttributeKeyFeature, strings.TrimSpace(f)))
 	}
@@ -371,6 +397,7 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 
 func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, authZ AuthorizationPolicy) (sdk.AccAddress, []byte, error) {
 	//defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "instantiate")
+	defer startContractSpan(&ctx, "wasm.instantiate", nil, attribute.Int64("code_id", int64(codeID))).End()
 	instanceCosts := k.gasRegister.NewContractInstanceCosts(k.IsPinnedCode(ctx, codeID), len(initMsg))
 	ctx.GasMeter().ConsumeGas(instanceCosts, "Loading CosmWasm module: instantiate")
 
@@ -452,11 +479,7 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 	k.appendToContractHistory(ctx, contractAddress, historyEntry)
 	k.storeContractInfo(ctx, contractAddress, &contractInfo)
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
-		types.EventTypeInstantiate,
-		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
-		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
-	))
+	ctx.EventManager().EmitEvent(types.NewInstantiateEvent(contractAddress, codeID))
 
 	data, err := k.handleContractResponse(ctx, contractAddress, contractInfo.IBCPortID, res.Messages, res.Attributes, res.Data, res.Events)
 	if err != nil {
@@ -469,6 +492,7 @@ func (k Keeper) instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 // Execute executes the contract instance
 func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
 	//defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "execute")
+	defer startContractSpan(&ctx, "wasm.execute", contractAddress).End()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
@@ -513,10 +537,7 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
-		types.EventTypeExecute,
-		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
-	))
+	ctx.EventManager().EmitEvent(types.NewExecuteEvent(contractAddress))
 
 	data, err := k.handleContractResponse(ctx, contractAddress, contractInfo.IBCPortID, res.Messages, res.Attributes, res.Data, res.Events)
 	if err != nil {
@@ -528,6 +549,7 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 
 func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) ([]byte, error) {
 	//defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "migrate")
+	defer startContractSpan(&ctx, "wasm.migrate", contractAddress, attribute.Int64("code_id", int64(newCodeID))).End()
 	migrateSetupCosts := k.gasRegister.InstantiateContractCosts(k.IsPinnedCode(ctx, newCodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(migrateSetupCosts, "Loading CosmWasm module: migrate")
 
@@ -587,11 +609,7 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	k.addToContractCodeSecondaryIndex(ctx, contractAddress, historyEntry)
 	k.storeContractInfo(ctx, contractAddress, contractInfo)
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
-		types.EventTypeMigrate,
-		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(newCodeID, 10)),
-		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
-	))
+	ctx.EventManager().EmitEvent(types.NewMigrateEvent(contractAddress, newCodeID))
 
 	data, err := k.handleContractResponse(ctx, contractAddress, contractInfo.IBCPortID, res.Messages, res.Attributes, res.Data, res.Events)
 	if err != nil {
@@ -606,6 +624,7 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 // place any access controls on it, that is the responsibility or the app developer (who passes the wasm.Keeper in app.go)
 func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
 	//defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "sudo")
+	defer startContractSpan(&ctx, "wasm.sudo", contractAddress).End()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
@@ -625,10 +644,7 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
-		types.EventTypeSudo,
-		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
-	))
+	ctx.EventManager().EmitEvent(types.NewSudoEvent(contractAddress))
 
 	data, err := k.handleContractResponse(ctx, contractAddress, contractInfo.IBCPortID, res.Messages, res.Attributes, res.Data, res.Events)
 	if err != nil {
@@ -640,6 +656,7 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 
 // reply is only called from keeper internal functions (dispatchSubmessages) after processing the submessage
 func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+	defer startContractSpan(&ctx, "wasm.reply", contractAddress).End()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
@@ -660,10 +677,7 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
-		types.EventTypeReply,
-		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
-	))
+	ctx.EventManager().EmitEvent(types.NewReplyEvent(contractAddress))
 
 	data, err := k.handleContractResponse(ctx, contractAddress, contractInfo.IBCPortID, res.Messages, res.Attributes, res.Data, res.Events)
 	if err != nil {
@@ -673,6 +687,16 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 	return data, nil
 }
 
+// startContractSpan starts the tracing span of a call to the contract, the address is only encoded if the span is
+// exported
+func startContractSpan(ctx *sdk.Context, name string, contractAddress sdk.AccAddress, attrs ...attribute.KeyValue) oteltrace.Span {
+	span := ctx.StartSpan(name, attrs...)
+	if span.IsRecording() && contractAddress != nil {
+		span.SetAttributes(attribute.String("contract", contractAddress.String()))
+	}
+	return span
+}
+
 // addToContractCodeSecondaryIndex adds element to the index for contracts-by-codeid queries
 func (k Keeper) addToContractCodeSecondaryIndex(ctx sdk.Context, contractAddress sdk.AccAddress, entry types.ContractCodeHistoryEntry) {
 	store := k.ada.NewStore(ctx.GasMeter(), ctx.KVStore(k.storeKey), nil)
@@ -981,10 +1005,7 @@ func (k Keeper) pinCode(ctx sdk.Context, codeID uint64) error {
 	// store 1 byte to not run into `nil` debugging issues
 	store.Set(types.GetPinnedCodeIndexPrefix(codeID), []byte{1})
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
-		types.EventTypePinCode,
-		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
-	))
+	ctx.EventManager().EmitEvent(types.NewPinCodeEvent(codeID))
 	return nil
 }
 
@@ -1002,10 +1023,7 @@ func (k Keeper) unpinCode(ctx sdk.Context, codeID uint64) error {
 
 	store.Delete(types.GetPinnedCodeIndexPrefix(codeID))
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
-		types.EventTypeUnpinCode,
-		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
-	))
+	ctx.EventManager().EmitEvent(types.NewUnpinCodeEvent(codeID))
 	return nil
 }
 
@@ -1211,7 +1229,9 @@ func moduleLogger(ctx sdk.Context) log.Logger {
 
 // Querier creates a new grpc querier instance
 func Querier(k *Keeper) *grpcQuerier {
-	return NewGrpcQuerier(*k.cdc, k.storeKey, k, k.queryGasLimit)
+	q := NewGrpcQuerier(*k.cdc, k.storeKey, k, k.queryGasLimit)
+	q.queryPool = k.queryPool
+	return q
 }
 
 // QueryGasLimit returns the gas limit for smart queries.
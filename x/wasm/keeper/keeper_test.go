@@ -366,6 +366,28 @@ func TestCreateWithGzippedPayload(t *testing.T) {
 	require.Equal(t, hackatomWasm, storedCode)
 }
 
+func TestCreateWithMaxWasmCodeSize(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	keeper := keepers.ContractKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedAccount(ctx, deposit...)
+	require.Equal(t, uint64(types.MaxWasmSize), keepers.WasmKeeper.GetMaxWasmCodeSize(ctx))
+
+	// the limit applies to the uncompressed code
+	gzippedCode, err := ioutil.ReadFile("./testdata/hackatom.wasm.gzip")
+	require.NoError(t, err, "reading gzipped WASM code")
+	keepers.WasmKeeper.SetMaxWasmCodeSize(ctx, uint64(len(hackatomWasm)-1))
+	_, err = keeper.Create(ctx, creator, gzippedCode, nil)
+	require.True(t, types.ErrCreateFailed.Is(err), err)
+	_, err = keeper.Create(ctx, creator, hackatomWasm, nil)
+	require.True(t, types.ErrCreateFailed.Is(err), err)
+
+	keepers.WasmKeeper.SetMaxWasmCodeSize(ctx, uint64(len(hackatomWasm)))
+	_, err = keeper.Create(ctx, creator, gzippedCode, nil)
+	require.NoError(t, err)
+}
+
 func TestInstantiate(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
 	keeper := keepers.ContractKeeper
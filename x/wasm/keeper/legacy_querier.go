@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 	abci "github.com/okex/exchain/libs/tendermint/abci/types"
@@ -21,6 +22,7 @@ const (
 	QueryContractHistory           = "contract-history"
 	QueryListContractBlockedMethod = "list-contract-blocked-method"
 	QueryParams                    = "params"
+	QueryContractsByEvmAddress     = "contracts-by-evm-address"
 )
 
 const (
@@ -76,6 +78,11 @@ func NewLegacyQuerier(keeper types.ViewKeeper, gasLimit sdk.Gas) sdk.Querier {
 			rsp = queryListContractBlockedMethod(ctx, contractAddr, keeper)
 		case QueryParams:
 			rsp = queryParams(ctx, keeper)
+		case QueryContractsByEvmAddress:
+			if !common.IsHexAddress(path[1]) {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, path[1])
+			}
+			rsp = queryContractsByEvmAddress(ctx, common.HexToAddress(path[1]), keeper)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown data query endpoint")
 		}
@@ -117,7 +124,9 @@ func queryContractState(ctx sdk.Context, bech, queryMethod string, data []byte,
 		return keeper.QueryRaw(ctx, contractAddr, data), nil
 	case QueryMethodContractStateSmart:
 		// we enforce a subjective gas limit on all queries to avoid infinite loops
-		ctx.SetGasMeter(sdk.NewGasMeter(gasLimit))
+		gasMeter, chargeQueryGas := newSmartQueryGasMeter(ctx, gasLimit)
+		defer chargeQueryGas()
+		ctx.SetGasMeter(gasMeter)
 		msg := types.RawContractMessage(data)
 		if err := msg.ValidateBasic(); err != nil {
 			return nil, sdkerrors.Wrap(err, "json msg")
@@ -167,6 +176,19 @@ func queryListContractBlockedMethod(ctx sdk.Context, contractAddr sdk.AccAddress
 	return cmbl
 }
 
+// queryContractsByEvmAddress returns the contracts whose evm representation is the address, there's more than one if
+// their addresses collide
+func queryContractsByEvmAddress(ctx sdk.Context, evmAddr common.Address, keeper types.ViewKeeper) []string {
+	var contracts []string
+	keeper.IterateContractInfo(ctx, func(addr sdk.AccAddress, _ types.ContractInfo) bool {
+		if types.EvmAddress(addr) == evmAddr {
+			contracts = append(contracts, addr.String())
+		}
+		return false
+	})
+	return contracts
+}
+
 func queryParams(ctx sdk.Context, keeper types.ViewKeeper) *types.Params {
 	params := keeper.GetParams(ctx)
 	return &params
@@ -362,3 +362,45 @@ func TestLegacyQueryCodeList(t *testing.T) {
 		})
 	}
 }
+
+func TestLegacyQueryContractsByEvmAddress(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
+	keeper := keepers.WasmKeeper
+
+	// the first two contracts share their last 20 bytes, so they collide as evm addresses
+	contractA := sdk.AccAddress(append(bytes.Repeat([]byte{0x1}, 12), bytes.Repeat([]byte{0xa}, 20)...))
+	contractB := sdk.AccAddress(append(bytes.Repeat([]byte{0x2}, 12), bytes.Repeat([]byte{0xa}, 20)...))
+	contractC := sdk.AccAddress(bytes.Repeat([]byte{0xc}, types.ContractAddrLen))
+	for _, contract := range []sdk.AccAddress{contractA, contractB, contractC} {
+		info := types.ContractInfoFixture()
+		keeper.storeContractInfo(ctx, contract, &info)
+	}
+	require.Equal(t, types.EvmAddress(contractA), types.EvmAddress(contractB))
+
+	var defaultQueryGasLimit sdk.Gas = 3000000
+	q := NewLegacyQuerier(keeper, defaultQueryGasLimit)
+	query := func(evmAddr string) ([]string, error) {
+		res, err := q(ctx, []string{QueryContractsByEvmAddress, evmAddr}, abci.RequestQuery{})
+		if err != nil || res == nil {
+			return nil, err
+		}
+		var contracts []string
+		require.NoError(t, json.Unmarshal(res, &contracts))
+		return contracts, nil
+	}
+
+	contracts, err := query(types.EvmAddress(contractA).String())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{contractA.String(), contractB.String()}, contracts)
+
+	contracts, err = query(types.EvmAddress(contractC).String())
+	require.NoError(t, err)
+	assert.Equal(t, []string{contractC.String()}, contracts)
+
+	contracts, err = query("0x0000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	assert.Empty(t, contracts)
+
+	_, err = query("contract")
+	require.Error(t, err)
+}
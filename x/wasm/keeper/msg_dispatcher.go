@@ -10,6 +10,7 @@ import (
 	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	sdkerrors "github.com/okex/exchain/libs/cosmos-sdk/types/errors"
 	"github.com/okex/exchain/x/wasm/types"
+	"github.com/okex/exchain/x/wasm/watcher"
 )
 
 // Messenger is an extension point for custom wasmd message handling
@@ -89,6 +90,7 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		subCtx, commit := ctx.CacheContext()
 		em := sdk.NewEventManager()
 		subCtx.SetEventManager(em)
+		snapshot := watcher.Snapshot()
 
 		// check how much gas left locally, optionally wrap the gas meter
 		gasRemaining := ctx.GasMeter().Limit() - ctx.GasMeter().GasConsumed()
@@ -119,7 +121,11 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 					})
 				}
 			}
-		} // on failure, revert state from sandbox, and ignore events (just skip doing the above)
+		} else {
+			// on failure, revert state from sandbox, and ignore events. The writes recorded for the fast query are
+			// dropped as well
+			watcher.RevertToSnapshot(snapshot)
+		}
 
 		// we only callback if requested. Short-circuit here the cases we don't want to
 		if (msg.ReplyOn == wasmvmtypes.ReplySuccess || msg.ReplyOn == wasmvmtypes.ReplyNever) && err != nil {
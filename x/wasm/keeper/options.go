@@ -87,6 +87,36 @@ func WithMessageEncoders(x *MessageEncoders) Option {
 	})
 }
 
+// WithCustomPlugins is an optional constructor parameter routing the custom messages and queries to the plugins
+// registered by the modules. The custom messages and queries of no plugin are passed on to the custom encoder and
+// querier set before, so this option is applied after Options `WithMessageEncoders` and `WithQueryPlugins`.
+func WithCustomPlugins(x *CustomPlugins) Option {
+	return optsFn(func(k *Keeper) {
+		q, ok := k.messenger.(*MessageHandlerChain)
+		if !ok {
+			panic(fmt.Sprintf("Unsupported message handler type: %T", k.messenger))
+		}
+		s, ok := q.handlers[0].(SDKMessageHandler)
+		if !ok {
+			panic(fmt.Sprintf("Unexpected message handler type: %T", q.handlers[0]))
+		}
+		e, ok := s.encoders.(MessageEncoders)
+		if !ok {
+			panic(fmt.Sprintf("Unsupported encoder type: %T", s.encoders))
+		}
+		e.Custom = x.Encoder(e.Custom)
+		s.encoders = e
+		q.handlers[0] = s
+
+		p, ok := k.wasmVMQueryHandler.(QueryPlugins)
+		if !ok {
+			panic(fmt.Sprintf("Unsupported query handler type: %T", k.wasmVMQueryHandler))
+		}
+		p.Custom = x.Querier(p.Custom)
+		k.wasmVMQueryHandler = p
+	})
+}
+
 // WithCoinTransferrer is an optional constructor parameter to set a custom coin transferrer
 func WithCoinTransferrer(x CoinTransferrer) Option {
 	return optsFn(func(k *Keeper) {
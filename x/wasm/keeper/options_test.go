@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
 	authkeeper "github.com/okex/exchain/libs/cosmos-sdk/x/auth/keeper"
 	"github.com/okex/exchain/libs/cosmos-sdk/x/params"
 	"github.com/stretchr/testify/assert"
@@ -55,6 +56,14 @@ func TestConstructorOptions(t *testing.T) {
 				assert.IsType(t, &wasmtesting.MockQueryHandler{}, k.wasmVMQueryHandler)
 			},
 		},
+		"custom plugins": {
+			srcOpt: WithCustomPlugins(NewCustomPlugins()),
+			verify: func(t *testing.T, k Keeper) {
+				res, err := k.wasmVMQueryHandler.(QueryPlugins).Custom(sdk.Context{}, []byte(`{"custom_plugins":{}}`))
+				require.NoError(t, err)
+				assert.JSONEq(t, `{"plugins":[]}`, string(res))
+			},
+		},
 		"coin transferrer": {
 			srcOpt: WithCoinTransferrer(&wasmtesting.MockCoinTransferrer{}),
 			verify: func(t *testing.T, k Keeper) {
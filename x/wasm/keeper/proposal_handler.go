@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"encoding/hex"
 	"fmt"
 	"sort"
 	"strings"
@@ -103,10 +102,7 @@ func handleInstantiateProposal(ctx sdk.Context, k types.ContractOpsKeeper, p typ
 		return err
 	}
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
-		types.EventTypeGovContractResult,
-		sdk.NewAttribute(types.AttributeKeyResultDataHex, hex.EncodeToString(data)),
-	))
+	ctx.EventManager().EmitEvent(types.NewGovContractResultEvent(data))
 	return nil
 }
 
@@ -133,10 +129,7 @@ func handleMigrateProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.M
 		return err
 	}
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
-		types.EventTypeGovContractResult,
-		sdk.NewAttribute(types.AttributeKeyResultDataHex, hex.EncodeToString(data)),
-	))
+	ctx.EventManager().EmitEvent(types.NewGovContractResultEvent(data))
 	return nil
 }
 
@@ -154,10 +147,7 @@ func handleSudoProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.Sudo
 		return err
 	}
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
-		types.EventTypeGovContractResult,
-		sdk.NewAttribute(types.AttributeKeyResultDataHex, hex.EncodeToString(data)),
-	))
+	ctx.EventManager().EmitEvent(types.NewGovContractResultEvent(data))
 	return nil
 }
 
@@ -179,10 +169,7 @@ func handleExecuteProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.E
 		return err
 	}
 
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
-		types.EventTypeGovContractResult,
-		sdk.NewAttribute(types.AttributeKeyResultDataHex, hex.EncodeToString(data)),
-	))
+	ctx.EventManager().EmitEvent(types.NewGovContractResultEvent(data))
 	return nil
 }
 
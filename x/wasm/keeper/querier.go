@@ -24,6 +24,7 @@ type grpcQuerier struct {
 	storeKey      sdk.StoreKey
 	keeper        types.ViewKeeper
 	queryGasLimit sdk.Gas
+	queryPool     *QueryPool
 }
 
 // NewGrpcQuerier constructor
@@ -175,7 +176,8 @@ func (q grpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 	}
 
 	ctx := q.UnwrapSDKContext(c)
-	ctx.SetGasMeter(sdk.NewGasMeter(q.queryGasLimit))
+	gasMeter, chargeQueryGas := newSmartQueryGasMeter(ctx, q.queryGasLimit)
+	ctx.SetGasMeter(gasMeter)
 
 	// recover from out-of-gas panic
 	defer func() {
@@ -197,8 +199,10 @@ func (q grpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 					"stacktrace", string(debug.Stack()))
 		}
 	}()
+	// charged before recovering, so that running the query out of gas is recovered too
+	defer chargeQueryGas()
 
-	bz, err := q.keeper.QuerySmart(ctx, contractAddr, req.QueryData)
+	bz, err := q.querySmart(ctx, contractAddr, req.QueryData)
 	switch {
 	case err != nil:
 		return nil, err
@@ -208,6 +212,22 @@ func (q grpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 	return &types.QuerySmartContractStateResponse{Data: bz}, nil
 }
 
+// querySmart serves the smart query from the query pool when it's enabled
+func (q grpcQuerier) querySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	if q.queryPool == nil {
+		return q.keeper.QuerySmart(ctx, contractAddr, req)
+	}
+
+	var bz []byte
+	var err error
+	if poolErr := q.queryPool.Do(func() {
+		bz, err = q.keeper.QuerySmart(ctx, contractAddr, req)
+	}); poolErr != nil {
+		return nil, poolErr
+	}
+	return bz, err
+}
+
 func (q grpcQuerier) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
 
 	if req == nil {
@@ -334,3 +354,18 @@ func (q grpcQuerier) PrefixStore(c context.Context, pre []byte) sdk.KVStore {
 	return prefix.NewStore(ctx.KVStore(q.storeKey), pre)
 
 }
+
+// newSmartQueryGasMeter returns the gas meter of a smart query, limited by gasLimit and by the gas left to the query
+// it's part of, and the function charging the gas consumed by the smart query to that query
+func newSmartQueryGasMeter(ctx sdk.Context, gasLimit sdk.Gas) (sdk.GasMeter, func()) {
+	queryGasMeter := ctx.GasMeter()
+	if limit := queryGasMeter.Limit(); limit > 0 {
+		if left := limit - queryGasMeter.GasConsumedToLimit(); left < gasLimit {
+			gasLimit = left
+		}
+	}
+	gasMeter := sdk.NewGasMeter(gasLimit)
+	return gasMeter, func() {
+		queryGasMeter.ConsumeGas(gasMeter.GasConsumedToLimit(), "wasm smart query")
+	}
+}
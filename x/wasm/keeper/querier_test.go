@@ -161,7 +161,6 @@ func TestQuerySmartContractPanics(t *testing.T) {
 		CodeID:  1,
 		Created: types.NewAbsoluteTxPosition(ctx),
 	})
-	ctx.SetGasMeter(sdk.NewGasMeter(DefaultInstanceCost))
 	ctx.SetLogger(log.TestingLogger())
 
 	specs := map[string]struct {
@@ -183,6 +182,8 @@ func TestQuerySmartContractPanics(t *testing.T) {
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
+			// the gas of the smart query is charged to the query it's part of
+			ctx.SetGasMeter(sdk.NewGasMeter(2 * DefaultInstanceCost))
 			keepers.WasmKeeper.wasmVM = &wasmtesting.MockWasmer{QueryFn: func(checksum wasmvm.Checksum, env wasmvmtypes.Env, queryMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) ([]byte, uint64, error) {
 				spec.doInContract()
 				return nil, 0, nil
@@ -199,6 +200,27 @@ func TestQuerySmartContractPanics(t *testing.T) {
 	}
 }
 
+func TestSmartQueryGasMeter(t *testing.T) {
+	ctx := sdk.Context{}
+
+	// the smart query is limited by the gas left to the query it's part of
+	ctx.SetGasMeter(sdk.NewGasMeter(1000))
+	ctx.GasMeter().ConsumeGas(400, "testing")
+	gasMeter, chargeQueryGas := newSmartQueryGasMeter(ctx, 5000)
+	assert.Equal(t, sdk.Gas(600), gasMeter.Limit())
+	gasMeter.ConsumeGas(100, "testing")
+	chargeQueryGas()
+	assert.Equal(t, sdk.Gas(500), ctx.GasMeter().GasConsumed())
+
+	// and by its own gas limit
+	gasMeter, _ = newSmartQueryGasMeter(ctx, 100)
+	assert.Equal(t, sdk.Gas(100), gasMeter.Limit())
+
+	ctx.SetGasMeter(sdk.NewInfiniteGasMeter())
+	gasMeter, _ = newSmartQueryGasMeter(ctx, 5000)
+	assert.Equal(t, sdk.Gas(5000), gasMeter.Limit())
+}
+
 func TestQueryRawContractState(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, SupportedFeatures)
 	keeper := keepers.WasmKeeper
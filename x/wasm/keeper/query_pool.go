@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/okex/exchain/x/wasm/types"
+)
+
+// QueryPool serves the smart queries of the grpc querier from a fixed number of workers, so that a heavy query load
+// runs concurrently on at most size goroutines instead of competing with the execution of the blocks. The workers
+// share the wasmvm of the keeper, which caches the compiled modules and creates an instance per query.
+type QueryPool struct {
+	tasks   chan *queryTask
+	timeout time.Duration
+}
+
+type queryTask struct {
+	fn       func()
+	panicked interface{}
+	done     chan struct{}
+}
+
+// NewQueryPool starts size workers serving the queries, a query waiting longer than timeout for a worker or for its
+// result fails with ErrQueryTimeout, the timeout is disabled when it's 0
+func NewQueryPool(size int, timeout time.Duration) *QueryPool {
+	p := &QueryPool{
+		tasks:   make(chan *queryTask),
+		timeout: timeout,
+	}
+	for i := 0; i < size; i++ {
+		go p.worker()
+	}
+	return p
+}
+
+func (p *QueryPool) worker() {
+	for task := range p.tasks {
+		p.run(task)
+	}
+}
+
+func (p *QueryPool) run(task *queryTask) {
+	defer close(task.done)
+	defer func() {
+		// the panics, e.g. out of gas, are raised again in the goroutine of the query
+		task.panicked = recover()
+	}()
+	task.fn()
+}
+
+// Do runs fn in a worker of the pool and waits for it. The wasmvm can't be interrupted, so a timed out query keeps
+// its worker until its gas limit is exhausted, and fn must not write anything read by the caller after the timeout.
+func (p *QueryPool) Do(fn func()) error {
+	task := &queryTask{fn: fn, done: make(chan struct{})}
+
+	var timeout <-chan time.Time
+	if p.timeout > 0 {
+		timer := time.NewTimer(p.timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case p.tasks <- task:
+	case <-timeout:
+		return types.ErrQueryTimeout
+	}
+	select {
+	case <-task.done:
+	case <-timeout:
+		return types.ErrQueryTimeout
+	}
+	if task.panicked != nil {
+		panic(task.panicked)
+	}
+	return nil
+}
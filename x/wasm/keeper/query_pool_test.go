@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+	"github.com/okex/exchain/x/wasm/types"
+)
+
+func TestQueryPool(t *testing.T) {
+	pool := NewQueryPool(2, 0)
+
+	// at most size queries run concurrently
+	var running, maxRunning int32
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, pool.Do(func() {
+				n := atomic.AddInt32(&running, 1)
+				for {
+					max := atomic.LoadInt32(&maxRunning)
+					if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+			}))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), maxRunning)
+
+	// the panics are raised in the goroutine of the query
+	assert.PanicsWithValue(t, sdk.ErrorOutOfGas{Descriptor: "query"}, func() {
+		_ = pool.Do(func() { panic(sdk.ErrorOutOfGas{Descriptor: "query"}) })
+	})
+	require.NoError(t, pool.Do(func() {}))
+}
+
+func TestQueryPoolTimeout(t *testing.T) {
+	pool := NewQueryPool(1, 20*time.Millisecond)
+
+	release := make(chan struct{})
+	defer close(release)
+	err := pool.Do(func() { <-release })
+	assert.True(t, types.ErrQueryTimeout.Is(err))
+	// the timed out query still holds the only worker
+	err = pool.Do(func() {})
+	assert.True(t, types.ErrQueryTimeout.Is(err))
+}
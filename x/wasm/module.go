@@ -38,6 +38,8 @@ const (
 	flagWasmMemoryCacheSize    = "wasm.memory_cache_size"
 	flagWasmQueryGasLimit      = "wasm.query_gas_limit"
 	flagWasmSimulationGasLimit = "wasm.simulation_gas_limit"
+	flagWasmQueryPoolSize      = "wasm.query_pool_size"
+	flagWasmQueryTimeout       = "wasm.query_timeout"
 )
 
 // AppModuleBasic defines the basic application module used by the wasm module.
@@ -217,9 +219,9 @@ func (am AppModule) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
 func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
 }
 
-// WeightedOperations returns the all the gov module operations with their respective weights.
+// WeightedOperations returns the all the wasm module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+	return simulation.WeightedOperations(simState.AppParams, simState.Cdc, am.keeper.GetAccountKeeper(), am.keeper)
 }
 
 // ____________________________________________________________________________
@@ -230,6 +232,8 @@ func AddModuleInitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Uint32(flagWasmMemoryCacheSize, defaults.MemoryCacheSize, "Sets the size in MiB (NOT bytes) of an in-memory cache for Wasm modules. Set to 0 to disable.")
 	startCmd.Flags().Uint64(flagWasmQueryGasLimit, defaults.SmartQueryGasLimit, "Set the max gas that can be spent on executing a query with a Wasm contract")
 	startCmd.Flags().String(flagWasmSimulationGasLimit, "", "Set the max gas that can be spent when executing a simulation TX")
+	startCmd.Flags().Uint32(flagWasmQueryPoolSize, defaults.SmartQueryPoolSize, "Set the number of workers serving the smart queries concurrently. Set to 0 to serve them in the goroutine of the caller.")
+	startCmd.Flags().Duration(flagWasmQueryTimeout, defaults.SmartQueryTimeout, "Set the max time a smart query served by the workers waits for its result. Set to 0 to disable.")
 }
 
 //// ReadWasmConfig reads the wasm specifig configuration
@@ -200,6 +200,16 @@ func ReadWasmConfig() (types.WasmConfig, error) {
 			return cfg, err
 		}
 	}
+	if v := viper.Get(flagWasmQueryPoolSize); v != nil {
+		if cfg.SmartQueryPoolSize, err = cast.ToUint32E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := viper.Get(flagWasmQueryTimeout); v != nil {
+		if cfg.SmartQueryTimeout, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
 	if v := viper.Get(flagWasmSimulationGasLimit); v != nil {
 		if raw, ok := v.(string); ok && raw != "" {
 			limit, err := cast.ToUint64E(v) // non empty string set
@@ -104,6 +104,11 @@ func (s SubspaceProxy) GetParamSet(ctx sdk.Context, ps params.ParamSet) {
 }
 func (s SubspaceProxy) SetParamSet(ctx sdk.Context, ps params.ParamSet) {}
 
+// Has returns false, so that the params absent from the watcher take their default values
+func (s SubspaceProxy) Has(ctx sdk.Context, key []byte) bool { return false }
+func (s SubspaceProxy) Get(ctx sdk.Context, key []byte, ptr interface{}) {}
+func (s SubspaceProxy) Set(ctx sdk.Context, key []byte, value interface{}) {}
+
 type BankKeeperProxy struct {
 	blacklistedAddrs map[string]bool
 	akp              AccountKeeperProxy
@@ -1,6 +1,8 @@
 package wasm
 
 import (
+	"fmt"
+
 	"github.com/okex/exchain/app/rpc/simulator"
 	"github.com/okex/exchain/libs/cosmos-sdk/baseapp"
 	"github.com/okex/exchain/libs/cosmos-sdk/codec"
@@ -22,6 +24,10 @@ func NewWasmSimulator() simulator.Simulator {
 	k := NewProxyKeeper()
 	h := NewHandler(keeper.NewDefaultPermissionKeeper(k))
 	ctx := proxy.MakeContext(k.GetStoreKey())
+	// the simulations are limited to the same gas as the simulations of the app
+	if limit := WasmConfig().SimulationGasLimit; limit != nil {
+		ctx.SetGasMeter(sdk.NewGasMeter(*limit))
+	}
 	return &Simulator{
 		handler: h,
 		k:       &k,
@@ -29,7 +35,14 @@ func NewWasmSimulator() simulator.Simulator {
 	}
 }
 
-func (w *Simulator) Simulate(msgs []sdk.Msg) (*sdk.Result, error) {
+func (w *Simulator) Simulate(msgs []sdk.Msg) (res *sdk.Result, err error) {
+	// running out of gas panics
+	defer func() {
+		if r := recover(); r != nil {
+			res, err = nil, fmt.Errorf("panic in wasm simulation: %v", r)
+		}
+	}()
+
 	//wasm Result has no Logs
 	data := make([]byte, 0, len(msgs))
 	events := sdk.EmptyEvents()
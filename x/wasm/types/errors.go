@@ -88,6 +88,9 @@ var (
 
 	// ErrExceedMaxQueryStackSize error if max query stack size is exceeded
 	ErrExceedMaxQueryStackSize = sdkErrors.Register(DefaultCodespace, 27, "max query stack size exceeded")
+
+	// ErrQueryTimeout error if a smart query isn't served in time by the query pool
+	ErrQueryTimeout = sdkErrors.Register(DefaultCodespace, 28, "query timeout")
 )
 
 type ErrNoSuchContract struct {
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// schemas of the typed events emitted by the wasm module
+var (
+	attrSchemaCodeID       = sdk.NewEventAttributeSchema(AttributeKeyCodeID, sdk.EventValueTypeUint, "id of the code")
+	attrSchemaContractAddr = sdk.NewEventAttributeSchema(AttributeKeyContractAddr, sdk.EventValueTypeAddress,
+		"bech32 address of the contract")
+
+	EventSchemaStoreCode = sdk.NewEventSchema(ModuleName, EventTypeStoreCode,
+		"a wasm code is stored",
+		attrSchemaCodeID,
+		sdk.NewEventAttributeSchema(AttributeKeyFeature, sdk.EventValueTypeString, "capability required by the code").
+			AsOptional().AsRepeated(),
+	)
+	EventSchemaInstantiate = sdk.NewEventSchema(ModuleName, EventTypeInstantiate,
+		"a contract is instantiated from a code",
+		attrSchemaContractAddr,
+		attrSchemaCodeID,
+	)
+	EventSchemaExecute = sdk.NewEventSchema(ModuleName, EventTypeExecute,
+		"a contract is executed",
+		attrSchemaContractAddr,
+	)
+	EventSchemaMigrate = sdk.NewEventSchema(ModuleName, EventTypeMigrate,
+		"a contract is migrated to a new code",
+		attrSchemaCodeID,
+		attrSchemaContractAddr,
+	)
+	EventSchemaSudo = sdk.NewEventSchema(ModuleName, EventTypeSudo,
+		"the sudo entry point of a contract is called",
+		attrSchemaContractAddr,
+	)
+	EventSchemaReply = sdk.NewEventSchema(ModuleName, EventTypeReply,
+		"the reply entry point of a contract is called with the result of a sub message",
+		attrSchemaContractAddr,
+	)
+	EventSchemaPinCode = sdk.NewEventSchema(ModuleName, EventTypePinCode,
+		"a code is pinned in the wasm vm cache",
+		attrSchemaCodeID,
+	)
+	EventSchemaUnpinCode = sdk.NewEventSchema(ModuleName, EventTypeUnpinCode,
+		"a code is unpinned from the wasm vm cache",
+		attrSchemaCodeID,
+	)
+	EventSchemaGovContractResult = sdk.NewEventSchema(ModuleName, EventTypeGovContractResult,
+		"a contract operation of a proposal is executed",
+		sdk.NewEventAttributeSchema(AttributeKeyResultDataHex, sdk.EventValueTypeString,
+			"hex encoded data returned by the contract"),
+	)
+)
+
+func init() {
+	sdk.RegisterEventSchemas(
+		EventSchemaStoreCode,
+		EventSchemaInstantiate,
+		EventSchemaExecute,
+		EventSchemaMigrate,
+		EventSchemaSudo,
+		EventSchemaReply,
+		EventSchemaPinCode,
+		EventSchemaUnpinCode,
+		EventSchemaGovContractResult,
+	)
+}
+
+func codeIDAttribute(codeID uint64) sdk.Attribute {
+	return sdk.NewAttribute(AttributeKeyCodeID, strconv.FormatUint(codeID, 10))
+}
+
+func contractAddrAttribute(contractAddr sdk.AccAddress) sdk.Attribute {
+	return sdk.NewAttribute(AttributeKeyContractAddr, contractAddr.String())
+}
+
+// NewStoreCodeEvent creates the event of a code stored
+func NewStoreCodeEvent(codeID uint64) sdk.Event {
+	return EventSchemaStoreCode.NewEvent(codeIDAttribute(codeID))
+}
+
+// NewInstantiateEvent creates the event of a contract instantiated
+func NewInstantiateEvent(contractAddr sdk.AccAddress, codeID uint64) sdk.Event {
+	return EventSchemaInstantiate.NewEvent(contractAddrAttribute(contractAddr), codeIDAttribute(codeID))
+}
+
+// NewExecuteEvent creates the event of a contract executed
+func NewExecuteEvent(contractAddr sdk.AccAddress) sdk.Event {
+	return EventSchemaExecute.NewEvent(contractAddrAttribute(contractAddr))
+}
+
+// NewMigrateEvent creates the event of a contract migrated
+func NewMigrateEvent(contractAddr sdk.AccAddress, newCodeID uint64) sdk.Event {
+	return EventSchemaMigrate.NewEvent(codeIDAttribute(newCodeID), contractAddrAttribute(contractAddr))
+}
+
+// NewSudoEvent creates the event of the sudo entry point of a contract called
+func NewSudoEvent(contractAddr sdk.AccAddress) sdk.Event {
+	return EventSchemaSudo.NewEvent(contractAddrAttribute(contractAddr))
+}
+
+// NewReplyEvent creates the event of the reply entry point of a contract called
+func NewReplyEvent(contractAddr sdk.AccAddress) sdk.Event {
+	return EventSchemaReply.NewEvent(contractAddrAttribute(contractAddr))
+}
+
+// NewPinCodeEvent creates the event of a code pinned
+func NewPinCodeEvent(codeID uint64) sdk.Event {
+	return EventSchemaPinCode.NewEvent(codeIDAttribute(codeID))
+}
+
+// NewUnpinCodeEvent creates the event of a code unpinned
+func NewUnpinCodeEvent(codeID uint64) sdk.Event {
+	return EventSchemaUnpinCode.NewEvent(codeIDAttribute(codeID))
+}
+
+// NewGovContractResultEvent creates the event of a contract operation of a proposal executed
+func NewGovContractResultEvent(data []byte) sdk.Event {
+	return EventSchemaGovContractResult.NewEvent(sdk.NewAttribute(AttributeKeyResultDataHex, hex.EncodeToString(data)))
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/okex/exchain/libs/cosmos-sdk/types"
+)
+
+// EvmAddress returns the 20 bytes evm representation of a contract address, which is the address converted with
+// common.BytesToAddress as the vmbridge does, i.e. the last 20 bytes of a 32 bytes contract address. Different
+// contracts may have the same evm representation, so the contracts of an evm address are looked up by iterating them.
+func EvmAddress(contract sdk.AccAddress) common.Address {
+	return common.BytesToAddress(contract)
+}
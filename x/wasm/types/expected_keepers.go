@@ -105,6 +105,9 @@ type ICS20TransferPortSource interface {
 type Subspace interface {
 	GetParamSet(ctx sdk.Context, ps params.ParamSet)
 	SetParamSet(ctx sdk.Context, ps params.ParamSet)
+	Has(ctx sdk.Context, key []byte) bool
+	Get(ctx sdk.Context, key []byte, ptr interface{})
+	Set(ctx sdk.Context, key []byte, value interface{})
 }
 
 type DBAdapter interface {
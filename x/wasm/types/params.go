@@ -18,6 +18,7 @@ var (
 	ParamStoreKeyInstantiateAccess   = []byte("instantiateAccess")
 	ParamStoreKeyContractBlockedList = []byte("EnableContractBlockedList")
 	ParamStoreKeyVMBridgeEnable      = []byte("VMBridgeEnable")
+	ParamStoreKeyMaxWasmCodeSize     = []byte("maxWasmCodeSize")
 )
 
 var AllAccessTypes = []AccessType{
@@ -86,9 +87,11 @@ var (
 	AllowNobody         = AccessConfig{Permission: AccessTypeNobody}
 )
 
-// ParamKeyTable returns the parameter key table.
+// ParamKeyTable returns the parameter key table. The max wasm code size isn't part of Params, it's absent on the
+// existing chains until it's set by governance.
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{}).
+		RegisterType(paramtypes.NewParamSetPair(ParamStoreKeyMaxWasmCodeSize, new(uint64), validateMaxWasmCodeSize))
 }
 
 // DefaultParams returns default wasm parameters
@@ -146,6 +149,17 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+func validateMaxWasmCodeSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 || v > uint64(MaxWasmSize) {
+		return sdkerrors.Wrapf(ErrLimit, "max wasm code size must be in (0, %d]: %d", MaxWasmSize, v)
+	}
+	return nil
+}
+
 func validateAccessType(i interface{}) error {
 	a, ok := i.(AccessType)
 	if !ok {
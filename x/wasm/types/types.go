@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	"github.com/gogo/protobuf/proto"
@@ -311,6 +312,11 @@ type WasmConfig struct {
 	MemoryCacheSize uint32
 	// ContractDebugMode log what contract print
 	ContractDebugMode bool
+	// SmartQueryPoolSize is the number of workers serving the smart queries of the grpc querier concurrently.
+	// When not set the queries are served in the goroutine of the caller
+	SmartQueryPoolSize uint32
+	// SmartQueryTimeout is the max time a smart query of the query pool waits for its result, 0 means no timeout
+	SmartQueryTimeout time.Duration
 }
 
 // DefaultWasmConfig returns the default settings for WasmConfig
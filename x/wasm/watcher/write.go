@@ -26,6 +26,28 @@ func Save(err error) {
 	txCacheMtx.Unlock()
 }
 
+// Snapshot returns an identifier of the writes of the tx recorded so far
+func Snapshot() int {
+	if !Enable() {
+		return 0
+	}
+	txCacheMtx.Lock()
+	defer txCacheMtx.Unlock()
+	return len(txStateCache)
+}
+
+// RevertToSnapshot drops the writes of the tx recorded after the snapshot, whose state is reverted
+func RevertToSnapshot(snapshot int) {
+	if !Enable() {
+		return
+	}
+	txCacheMtx.Lock()
+	if snapshot < len(txStateCache) {
+		txStateCache = txStateCache[:snapshot]
+	}
+	txCacheMtx.Unlock()
+}
+
 func Commit() {
 	if !Enable() {
 		return
@@ -0,0 +1,32 @@
+package watcher
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/exchain/libs/cosmos-sdk/client/flags"
+	"github.com/okex/exchain/libs/cosmos-sdk/store/mem"
+	"github.com/okex/exchain/x/evm/watcher"
+)
+
+func TestRevertToSnapshot(t *testing.T) {
+	viper.Set(watcher.FlagFastQuery, true)
+	viper.Set(flags.FlagHome, t.TempDir())
+	require.True(t, Enable())
+	NewHeight()
+
+	store := WrapWriteKVStore(mem.NewStore())
+	store.Set([]byte("a"), []byte("1"))
+	snapshot := Snapshot()
+	store.Set([]byte("b"), []byte("2"))
+	store.Delete([]byte("a"))
+
+	// the writes of a reverted sub message aren't saved with the tx
+	RevertToSnapshot(snapshot)
+	Save(nil)
+	require.Len(t, blockStateCache, 1)
+	require.Equal(t, []byte("1"), blockStateCache["a"].Value)
+	require.False(t, blockStateCache["a"].IsDelete)
+}